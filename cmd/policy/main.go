@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/arifullov/auth/internal/client/db/pg"
+	"github.com/arifullov/auth/internal/client/db/transaction"
+	"github.com/arifullov/auth/internal/config"
+	"github.com/arifullov/auth/internal/logger"
+	"github.com/arifullov/auth/internal/policy"
+	"github.com/arifullov/auth/internal/service"
+	"github.com/arifullov/auth/internal/service/policyfile"
+
+	accessRepository "github.com/arifullov/auth/internal/repository/access"
+	auditRepository "github.com/arifullov/auth/internal/repository/audit"
+	roleRepository "github.com/arifullov/auth/internal/repository/role"
+)
+
+const usage = `usage: policy [-env path] command
+
+commands:
+  plan <file>     print the changes applying the policy file would make
+  apply <file>    apply the policy file in one transaction and print the changes
+  export [file]   write the stored policy to the file, or to stdout as YAML
+`
+
+func main() {
+	envPath := flag.String("env", ".env", "path to the env file with the database settings")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) == 0 || (args[0] != "export" && len(args) != 2) || len(args) > 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(context.Background(), *envPath, args[0], args[1:]); err != nil {
+		log.Fatalf("policy %s: %s", args[0], err.Error())
+	}
+}
+
+func run(ctx context.Context, envPath string, command string, args []string) error {
+	policyFileService, closeDB, err := newPolicyFileService(ctx, envPath)
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	switch command {
+	case "plan":
+		doc, err := policy.ReadDocumentFile(args[0])
+		if err != nil {
+			return err
+		}
+		plan, err := policyFileService.Plan(ctx, doc)
+		if err != nil {
+			return err
+		}
+		return policy.WritePlan(os.Stdout, plan)
+	case "apply":
+		doc, err := policy.ReadDocumentFile(args[0])
+		if err != nil {
+			return err
+		}
+		plan, err := policyFileService.Apply(ctx, doc)
+		if err != nil {
+			return err
+		}
+		return policy.WritePlan(os.Stdout, plan)
+	case "export":
+		doc, err := policyFileService.Export(ctx)
+		if err != nil {
+			return err
+		}
+		if len(args) == 0 {
+			data, err := policy.EncodeDocument(doc, policy.YAMLFormat)
+			if err != nil {
+				return err
+			}
+			_, err = os.Stdout.Write(data)
+			return err
+		}
+		format, err := policy.FormatOf(args[0])
+		if err != nil {
+			return err
+		}
+		data, err := policy.EncodeDocument(doc, format)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(args[0], data, 0o644)
+	default:
+		return fmt.Errorf("unknown command %q", command)
+	}
+}
+
+func newPolicyFileService(ctx context.Context, envPath string) (service.PolicyFileService, func(), error) {
+	if err := config.Load(envPath); err != nil {
+		return nil, nil, err
+	}
+	loggerConfig, err := config.NewLoggingConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = logger.Init(loggerConfig.Level(), loggerConfig.Format()); err != nil {
+		return nil, nil, err
+	}
+	pgConfig, err := config.NewPGConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dbClient, err := pg.New(ctx, pgConfig.DSN())
+	if err != nil {
+		return nil, nil, err
+	}
+	closeDB := func() {
+		_ = dbClient.Close()
+	}
+	if err = dbClient.DB().Ping(ctx); err != nil {
+		closeDB()
+		return nil, nil, err
+	}
+
+	return policyfile.NewPolicyFileService(
+		roleRepository.NewRepository(dbClient),
+		accessRepository.NewRepository(dbClient),
+		auditRepository.NewRepository(dbClient),
+		transaction.NewTransactionManager(dbClient.DB()),
+	), closeDB, nil
+}
@@ -0,0 +1,21 @@
+package access
+
+import (
+	"context"
+
+	"github.com/arifullov/auth/internal/converter"
+	desc "github.com/arifullov/auth/pkg/access_v1"
+)
+
+func (i *Implementation) BatchCheck(ctx context.Context, req *desc.BatchCheckRequest) (*desc.BatchCheckResponse, error) {
+	accessToken, err := accessTokenFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	decisions, err := i.accessService.BatchCheck(ctx, accessToken, converter.ToAccessRequestsFromDesc(req.GetChecks()))
+	if err != nil {
+		return nil, err
+	}
+	return converter.ToBatchCheckResponseFromService(decisions, req.GetExplain()), nil
+}
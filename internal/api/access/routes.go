@@ -0,0 +1,34 @@
+package access
+
+import (
+	"context"
+
+	"github.com/arifullov/auth/internal/converter"
+	desc "github.com/arifullov/auth/pkg/access_v1"
+)
+
+func (i *Implementation) ListAccessibleRoutes(ctx context.Context, req *desc.ListAccessibleRoutesRequest) (*desc.ListAccessibleRoutesResponse, error) {
+	accessToken, err := accessTokenFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := i.accessService.ListAccessibleRoutes(ctx, accessToken, req.GetUserId(), req.GetPageToken(), converter.ToRouteGrantFilterFromDesc(req))
+	if err != nil {
+		return nil, err
+	}
+	return converter.ToListAccessibleRoutesResponseFromService(page), nil
+}
+
+func (i *Implementation) ListPrincipalsForRoute(ctx context.Context, req *desc.ListPrincipalsForRouteRequest) (*desc.ListPrincipalsForRouteResponse, error) {
+	accessToken, err := accessTokenFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	principals, err := i.accessService.ListPrincipalsForRoute(ctx, accessToken, req.GetRoute(), req.GetMethod(), req.GetPageToken(), uint64(req.GetPageSize()))
+	if err != nil {
+		return nil, err
+	}
+	return converter.ToListPrincipalsForRouteResponseFromService(principals), nil
+}
@@ -0,0 +1,33 @@
+package accessadmin
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+)
+
+const (
+	authPrefix = "Bearer "
+)
+
+func accessTokenFromMetadata(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", sys.NewCommonError(codes.Unauthenticated, "metadata is not provided")
+	}
+
+	authHeader := md.Get("authorization")
+	if len(authHeader) == 0 {
+		return "", sys.NewCommonError(codes.Unauthenticated, "authorization header is not provided")
+	}
+
+	if !strings.HasPrefix(authHeader[0], authPrefix) {
+		return "", sys.NewCommonError(codes.Unauthenticated, "invalid authorization header format")
+	}
+
+	return strings.TrimPrefix(authHeader[0], authPrefix), nil
+}
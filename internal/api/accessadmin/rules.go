@@ -0,0 +1,88 @@
+package accessadmin
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/arifullov/auth/internal/converter"
+	desc "github.com/arifullov/auth/pkg/access_admin_v1"
+)
+
+func (i *Implementation) ListRouteRules(ctx context.Context, req *desc.ListRouteRulesRequest) (*desc.ListRouteRulesResponse, error) {
+	accessToken, err := accessTokenFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := i.accessAdminService.ListRouteRules(ctx, accessToken, req.GetPageToken(), converter.ToRouteRuleFilterFromDesc(req))
+	if err != nil {
+		return nil, err
+	}
+	return converter.ToListRouteRulesResponseFromService(page), nil
+}
+
+func (i *Implementation) GetRouteRule(ctx context.Context, req *desc.GetRouteRuleRequest) (*desc.RouteRule, error) {
+	accessToken, err := accessTokenFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := i.accessAdminService.GetRouteRule(ctx, accessToken, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return converter.ToRouteRuleFromService(rule), nil
+}
+
+func (i *Implementation) GrantRouteRule(ctx context.Context, req *desc.GrantRouteRuleRequest) (*desc.RouteRule, error) {
+	accessToken, err := accessTokenFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := i.accessAdminService.GrantRouteRule(ctx, accessToken, converter.ToRouteRuleFromDesc(0, req.GetInfo()))
+	if err != nil {
+		return nil, err
+	}
+	return converter.ToRouteRuleFromService(rule), nil
+}
+
+func (i *Implementation) UpdateRouteRule(ctx context.Context, req *desc.UpdateRouteRuleRequest) (*desc.RouteRule, error) {
+	accessToken, err := accessTokenFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := i.accessAdminService.UpdateRouteRule(ctx, accessToken, converter.ToRouteRuleFromDesc(req.GetId(), req.GetInfo()))
+	if err != nil {
+		return nil, err
+	}
+	return converter.ToRouteRuleFromService(rule), nil
+}
+
+func (i *Implementation) RevokeRouteRule(ctx context.Context, req *desc.RevokeRouteRuleRequest) (*emptypb.Empty, error) {
+	accessToken, err := accessTokenFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.accessAdminService.RevokeRouteRule(ctx, accessToken, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (i *Implementation) ReplaceRouteRules(ctx context.Context, req *desc.ReplaceRouteRulesRequest) (*desc.ReplaceRouteRulesResponse, error) {
+	accessToken, err := accessTokenFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := i.accessAdminService.ReplaceRouteRules(ctx, accessToken, converter.ToRouteRulesFromDesc(req.GetRules()))
+	if err != nil {
+		return nil, err
+	}
+	return converter.ToReplaceRouteRulesResponseFromService(result), nil
+}
@@ -0,0 +1,17 @@
+package accessadmin
+
+import (
+	"github.com/arifullov/auth/internal/service"
+	desc "github.com/arifullov/auth/pkg/access_admin_v1"
+)
+
+type Implementation struct {
+	desc.UnimplementedAccessAdminV1Server
+	accessAdminService service.AccessAdminService
+}
+
+func NewImplementation(accessAdminService service.AccessAdminService) *Implementation {
+	return &Implementation{
+		accessAdminService: accessAdminService,
+	}
+}
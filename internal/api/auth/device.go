@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/arifullov/auth/internal/converter"
+	"github.com/arifullov/auth/internal/model"
+	desc "github.com/arifullov/auth/pkg/auth_v1"
+)
+
+func (i *Implementation) DeviceAuthorize(ctx context.Context, req *desc.DeviceAuthorizeRequest) (*desc.DeviceAuthorizeResponse, error) {
+	scope := model.ScopeRequest{ClientID: req.GetClientId(), Scopes: strings.Fields(req.GetScope())}
+	authorization, err := i.deviceService.Authorize(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	return converter.ToDeviceAuthorizeResponseFromService(authorization), nil
+}
+
+func (i *Implementation) ApproveDevice(ctx context.Context, req *desc.ApproveDeviceRequest) (*emptypb.Empty, error) {
+	accessToken, err := accessTokenFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.deviceService.Approve(ctx, accessToken, req.GetUserCode(), !req.GetDeny())
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (i *Implementation) DeviceToken(ctx context.Context, req *desc.DeviceTokenRequest) (*desc.DeviceTokenResponse, error) {
+	tokens, err := i.deviceService.Token(ctx, req.GetGrantType(), req.GetDeviceCode(), req.GetClientId())
+	if err != nil {
+		return nil, err
+	}
+	return converter.ToDeviceTokenResponseFromService(tokens), nil
+}
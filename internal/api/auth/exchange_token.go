@@ -0,0 +1,16 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/arifullov/auth/internal/converter"
+	desc "github.com/arifullov/auth/pkg/auth_v1"
+)
+
+func (i *Implementation) ExchangeToken(ctx context.Context, req *desc.ExchangeTokenRequest) (*desc.ExchangeTokenResponse, error) {
+	token, err := i.authService.ExchangeToken(ctx, converter.ToTokenExchangeFromDesc(req))
+	if err != nil {
+		return nil, err
+	}
+	return converter.ToExchangeTokenResponseFromService(token), nil
+}
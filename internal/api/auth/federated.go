@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/arifullov/auth/internal/gateway"
+	desc "github.com/arifullov/auth/pkg/auth_v1"
+)
+
+func (i *Implementation) StartFederatedLogin(ctx context.Context, req *desc.StartFederatedLoginRequest) (*desc.StartFederatedLoginResponse, error) {
+	login, err := i.federatedService.Start(ctx, req.GetProvider())
+	if err != nil {
+		return nil, err
+	}
+	// The gateway keeps the binding in a cookie, gRPC clients send it back as metadata.
+	if err = grpc.SetHeader(ctx, metadata.Pairs(gateway.FederatedBindingMetadataKey, login.Binding)); err != nil {
+		return nil, err
+	}
+	return &desc.StartFederatedLoginResponse{AuthorizationUrl: login.AuthorizationURL}, nil
+}
+
+func (i *Implementation) FinishFederatedLogin(ctx context.Context, req *desc.FinishFederatedLoginRequest) (*desc.LoginResponse, error) {
+	refreshToken, err := i.federatedService.Finish(ctx, req.GetProvider(), req.GetCode(), req.GetState(), federatedBinding(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &desc.LoginResponse{RefreshToken: refreshToken}, nil
+}
+
+func federatedBinding(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(gateway.FederatedBindingMetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
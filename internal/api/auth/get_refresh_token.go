@@ -7,7 +7,7 @@ import (
 )
 
 func (i *Implementation) GetRefreshToken(ctx context.Context, req *desc.GetRefreshTokenRequest) (*desc.GetRefreshTokenResponse, error) {
-	refreshToken, err := i.authService.GetRefreshToken(ctx, req.GetOldRefreshToken())
+	refreshToken, err := i.authService.GetRefreshToken(ctx, refreshTokenOrCookie(ctx, req.GetOldRefreshToken()))
 	if err != nil {
 		return nil, err
 	}
@@ -2,12 +2,15 @@ package auth
 
 import (
 	"context"
+	"strings"
 
+	"github.com/arifullov/auth/internal/model"
 	desc "github.com/arifullov/auth/pkg/auth_v1"
 )
 
 func (i *Implementation) Login(ctx context.Context, req *desc.LoginRequest) (*desc.LoginResponse, error) {
-	refreshToken, err := i.authService.Login(ctx, req.GetUsername(), req.GetPassword())
+	scope := model.ScopeRequest{ClientID: req.GetClientId(), Scopes: strings.Fields(req.GetScope())}
+	refreshToken, err := i.authService.Login(ctx, req.GetUsername(), req.GetPassword(), req.GetTenant(), scope)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/arifullov/auth/internal/gateway"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+)
+
+const (
+	authPrefix = "Bearer "
+)
+
+// refreshTokenOrCookie returns the refresh token from the request body,
+// falling back to the cookie forwarded by the HTTP gateway.
+func refreshTokenOrCookie(ctx context.Context, refreshToken string) string {
+	if refreshToken != "" {
+		return refreshToken
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(gateway.RefreshTokenMetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func accessTokenFromMetadata(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", sys.NewCommonError(codes.Unauthenticated, "metadata is not provided")
+	}
+
+	authHeader := md.Get("authorization")
+	if len(authHeader) == 0 {
+		return "", sys.NewCommonError(codes.Unauthenticated, "authorization header is not provided")
+	}
+
+	if !strings.HasPrefix(authHeader[0], authPrefix) {
+		return "", sys.NewCommonError(codes.Unauthenticated, "invalid authorization header format")
+	}
+
+	return strings.TrimPrefix(authHeader[0], authPrefix), nil
+}
@@ -7,11 +7,19 @@ import (
 
 type Implementation struct {
 	desc.UnimplementedAuthV1Server
-	authService service.AuthService
+	authService      service.AuthService
+	deviceService    service.DeviceService
+	federatedService service.FederatedService
 }
 
-func NewImplementation(authService service.AuthService) *Implementation {
+func NewImplementation(
+	authService service.AuthService,
+	deviceService service.DeviceService,
+	federatedService service.FederatedService,
+) *Implementation {
 	return &Implementation{
-		authService: authService,
+		authService:      authService,
+		deviceService:    deviceService,
+		federatedService: federatedService,
 	}
 }
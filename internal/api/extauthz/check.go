@@ -0,0 +1,111 @@
+package extauthz
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	rpcstatus "google.golang.org/genproto/googleapis/rpc/status"
+	grpcCodes "google.golang.org/grpc/codes"
+
+	"github.com/arifullov/auth/internal/converter"
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+)
+
+// Check authorizes the HTTP request Envoy is about to forward. Denials are returned as
+// responses with the HTTP status for Envoy to send, errors are left to the failure mode
+// configured in Envoy.
+func (i *Implementation) Check(ctx context.Context, req *authv3.CheckRequest) (*authv3.CheckResponse, error) {
+	httpReq := req.GetAttributes().GetRequest().GetHttp()
+
+	accessToken, ok := strings.CutPrefix(httpReq.GetHeaders()[authorizationHeader], bearerPrefix)
+	if !ok {
+		return denied(grpcCodes.Unauthenticated, "authorization header is not provided"), nil
+	}
+
+	accessReq, err := converter.ToAccessRequestFromCheckRequest(req)
+	if err != nil {
+		return deniedWith(err)
+	}
+	claims, err := i.accessService.Authorize(ctx, accessToken, accessReq)
+	if err != nil {
+		return deniedWith(err)
+	}
+
+	return allowed(claims), nil
+}
+
+// deniedWith answers with the denial an error stands for, other errors are returned.
+func deniedWith(err error) (*authv3.CheckResponse, error) {
+	commonErr := sys.GetCommonError(err)
+	if commonErr == nil {
+		return nil, err
+	}
+	switch commonErr.Code() {
+	case codes.Unauthenticated:
+		return denied(grpcCodes.Unauthenticated, commonErr.Error()), nil
+	case codes.PermissionDenied:
+		return denied(grpcCodes.PermissionDenied, commonErr.Error()), nil
+	default:
+		return nil, err
+	}
+}
+
+// allowed passes the claims to the upstream, headers of claims that are not set are removed
+// so that the client cannot send them in their place.
+func allowed(claims *model.UserClaims) *authv3.CheckResponse {
+	ok := &authv3.OkHttpResponse{}
+	for name, value := range model.ClaimHeaders(claims) {
+		if value == "" {
+			ok.HeadersToRemove = append(ok.HeadersToRemove, strings.ToLower(name))
+			continue
+		}
+		ok.Headers = append(ok.Headers, &corev3.HeaderValueOption{
+			Header:       &corev3.HeaderValue{Key: name, Value: value},
+			AppendAction: corev3.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD,
+		})
+	}
+	return &authv3.CheckResponse{
+		Status:       &rpcstatus.Status{Code: int32(grpcCodes.OK)},
+		HttpResponse: &authv3.CheckResponse_OkResponse{OkResponse: ok},
+	}
+}
+
+func denied(code grpcCodes.Code, message string) *authv3.CheckResponse {
+	httpStatus := typev3.StatusCode_Forbidden
+	var headers []*corev3.HeaderValueOption
+	if code == grpcCodes.Unauthenticated {
+		httpStatus = typev3.StatusCode_Unauthorized
+		headers = append(headers, &corev3.HeaderValueOption{
+			Header: &corev3.HeaderValue{Key: "WWW-Authenticate", Value: "Bearer"},
+		})
+	}
+	if model.IsInsufficientScopeChallenge(message) {
+		headers = append(headers, &corev3.HeaderValueOption{
+			Header: &corev3.HeaderValue{Key: "WWW-Authenticate", Value: message},
+		})
+	}
+	headers = append(headers, &corev3.HeaderValueOption{
+		Header: &corev3.HeaderValue{Key: "Content-Type", Value: "application/json"},
+	})
+
+	body, _ := json.Marshal(map[string]string{"error": message})
+	return &authv3.CheckResponse{
+		Status: &rpcstatus.Status{Code: int32(code), Message: message},
+		HttpResponse: &authv3.CheckResponse_DeniedResponse{DeniedResponse: &authv3.DeniedHttpResponse{
+			Status:  &typev3.HttpStatus{Code: httpStatus},
+			Headers: headers,
+			Body:    string(body),
+		}},
+	}
+}
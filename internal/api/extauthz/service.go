@@ -0,0 +1,23 @@
+package extauthz
+
+import (
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+
+	"github.com/arifullov/auth/internal/service"
+)
+
+// CheckFullMethodName is the full gRPC method name of Check, the generated Envoy code does not define it.
+const CheckFullMethodName = "/envoy.service.auth.v3.Authorization/Check"
+
+// Implementation is the Envoy external authorization service, Envoy calls it for every
+// request of the routes it fronts and forwards the request only when it is allowed.
+type Implementation struct {
+	authv3.UnimplementedAuthorizationServer
+	accessService service.AccessService
+}
+
+func NewImplementation(accessService service.AccessService) *Implementation {
+	return &Implementation{
+		accessService: accessService,
+	}
+}
@@ -0,0 +1,133 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arifullov/auth/internal/api/extauthz"
+	"github.com/arifullov/auth/internal/model"
+	serviceMocks "github.com/arifullov/auth/internal/service/mocks"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+)
+
+const requestPath = "/orders/42?user_id=7"
+
+func checkRequest(authorization string, path string) *authv3.CheckRequest {
+	headers := map[string]string{"x-tenant": "acme", "x-role": "admin"}
+	if authorization != "" {
+		headers["authorization"] = authorization
+	}
+	return &authv3.CheckRequest{
+		Attributes: &authv3.AttributeContext{
+			Source: &authv3.AttributeContext_Peer{
+				Address: &corev3.Address{Address: &corev3.Address_SocketAddress{
+					SocketAddress: &corev3.SocketAddress{Address: "10.0.0.7"},
+				}},
+			},
+			Request: &authv3.AttributeContext_Request{
+				Http: &authv3.AttributeContext_HttpRequest{
+					Method:  "GET",
+					Path:    path,
+					Headers: headers,
+				},
+			},
+			ContextExtensions: map[string]string{"audience": "orders"},
+		},
+	}
+}
+
+func TestCheck(t *testing.T) {
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		accessReq = &model.AccessRequest{
+			EndpointAddress: "/orders/42",
+			Method:          "GET",
+			Audience:        "orders",
+			// The owner comes from the path, the user_id query parameter cannot override it.
+			PathParams: true,
+			IP:         "10.0.0.7",
+			Headers:    map[string]string{"x-tenant": "acme"},
+			Tenant:     "acme",
+		}
+		claims = &model.UserClaims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: "7"},
+			Username:         "user@example.com",
+			Roles:            []model.Role{model.UserRole, "viewer"},
+		}
+	)
+
+	tests := []struct {
+		name       string
+		req        *authv3.CheckRequest
+		claims     *model.UserClaims
+		err        error
+		httpStatus typev3.StatusCode
+	}{
+		{
+			name:   "allowed",
+			req:    checkRequest("Bearer token", requestPath),
+			claims: claims,
+		},
+		{
+			name:       "no token",
+			req:        checkRequest("", requestPath),
+			httpStatus: typev3.StatusCode_Unauthorized,
+		},
+		{
+			name:       "denied",
+			req:        checkRequest("Bearer token", requestPath),
+			err:        sys.NewCommonError(codes.PermissionDenied, "no access rule matches route"),
+			httpStatus: typev3.StatusCode_Forbidden,
+		},
+		{
+			name:       "dot segments",
+			req:        checkRequest("Bearer token", "/public/../orders/42"),
+			httpStatus: typev3.StatusCode_Forbidden,
+		},
+		{
+			name:       "escaped dot segments",
+			req:        checkRequest("Bearer token", "/public/%2e%2e/orders/42"),
+			httpStatus: typev3.StatusCode_Forbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			accessServiceMock := serviceMocks.NewAccessServiceMock(mc)
+			if tt.claims != nil || tt.err != nil {
+				accessServiceMock.AuthorizeMock.Expect(ctx, "token", accessReq).Return(tt.claims, tt.err)
+			}
+
+			res, err := extauthz.NewImplementation(accessServiceMock).Check(ctx, tt.req)
+			require.NoError(t, err)
+
+			if tt.httpStatus != 0 {
+				require.Equal(t, tt.httpStatus, res.GetDeniedResponse().GetStatus().GetCode())
+				return
+			}
+
+			headers := make(map[string]string)
+			for _, header := range res.GetOkResponse().GetHeaders() {
+				headers[header.GetHeader().GetKey()] = header.GetHeader().GetValue()
+			}
+			require.Equal(t, map[string]string{
+				model.UserIDHeader:   "7",
+				model.UserNameHeader: "user@example.com",
+				model.UserRoleHeader: "user,viewer",
+			}, headers)
+			// The tenant claim is not set, a tenant header sent by the client must not reach the upstream.
+			require.Equal(t, []string{"x-user-tenant"}, res.GetOkResponse().GetHeadersToRemove())
+		})
+	}
+}
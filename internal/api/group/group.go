@@ -0,0 +1,38 @@
+package group
+
+import (
+	"context"
+
+	"github.com/arifullov/auth/internal/converter"
+	desc "github.com/arifullov/auth/pkg/group_v1"
+)
+
+func (i *Implementation) CreateGroup(ctx context.Context, req *desc.CreateGroupRequest) (*desc.CreateGroupResponse, error) {
+	id, err := i.groupService.Create(ctx, converter.ToCreateGroupFromDesc(req))
+	if err != nil {
+		return nil, err
+	}
+	return &desc.CreateGroupResponse{Id: id}, nil
+}
+
+func (i *Implementation) GetGroup(ctx context.Context, req *desc.GetGroupRequest) (*desc.GetGroupResponse, error) {
+	group, err := i.groupService.Get(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &desc.GetGroupResponse{Group: converter.ToGroupFromService(group)}, nil
+}
+
+func (i *Implementation) DeleteGroup(ctx context.Context, req *desc.DeleteGroupRequest) (*desc.DeleteGroupResponse, error) {
+	if err := i.groupService.Delete(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	return &desc.DeleteGroupResponse{}, nil
+}
+
+func (i *Implementation) SetGroupRoles(ctx context.Context, req *desc.SetGroupRolesRequest) (*desc.SetGroupRolesResponse, error) {
+	if err := i.groupService.SetRoles(ctx, req.GetId(), converter.ToRolesFromDesc(req.GetRoles())); err != nil {
+		return nil, err
+	}
+	return &desc.SetGroupRolesResponse{}, nil
+}
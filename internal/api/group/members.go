@@ -0,0 +1,44 @@
+package group
+
+import (
+	"context"
+
+	"github.com/arifullov/auth/internal/converter"
+	desc "github.com/arifullov/auth/pkg/group_v1"
+)
+
+func (i *Implementation) AddGroupMember(ctx context.Context, req *desc.AddGroupMemberRequest) (*desc.AddGroupMemberResponse, error) {
+	if err := i.groupService.AddMember(ctx, req.GetGroupId(), req.GetUserId()); err != nil {
+		return nil, err
+	}
+	return &desc.AddGroupMemberResponse{}, nil
+}
+
+func (i *Implementation) RemoveGroupMember(ctx context.Context, req *desc.RemoveGroupMemberRequest) (*desc.RemoveGroupMemberResponse, error) {
+	if err := i.groupService.RemoveMember(ctx, req.GetGroupId(), req.GetUserId()); err != nil {
+		return nil, err
+	}
+	return &desc.RemoveGroupMemberResponse{}, nil
+}
+
+func (i *Implementation) NestGroup(ctx context.Context, req *desc.NestGroupRequest) (*desc.NestGroupResponse, error) {
+	if err := i.groupService.AddParent(ctx, req.GetGroupId(), req.GetParentId()); err != nil {
+		return nil, err
+	}
+	return &desc.NestGroupResponse{}, nil
+}
+
+func (i *Implementation) UnnestGroup(ctx context.Context, req *desc.UnnestGroupRequest) (*desc.UnnestGroupResponse, error) {
+	if err := i.groupService.RemoveParent(ctx, req.GetGroupId(), req.GetParentId()); err != nil {
+		return nil, err
+	}
+	return &desc.UnnestGroupResponse{}, nil
+}
+
+func (i *Implementation) ListEffectiveRoles(ctx context.Context, req *desc.ListEffectiveRolesRequest) (*desc.ListEffectiveRolesResponse, error) {
+	roles, err := i.groupService.ListEffectiveRoles(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return &desc.ListEffectiveRolesResponse{Roles: converter.ToEffectiveRolesFromService(roles)}, nil
+}
@@ -0,0 +1,17 @@
+package group
+
+import (
+	"github.com/arifullov/auth/internal/service"
+	desc "github.com/arifullov/auth/pkg/group_v1"
+)
+
+type Implementation struct {
+	desc.UnimplementedGroupV1Server
+	groupService service.GroupService
+}
+
+func NewImplementation(groupService service.GroupService) *Implementation {
+	return &Implementation{
+		groupService: groupService,
+	}
+}
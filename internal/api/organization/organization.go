@@ -0,0 +1,39 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/arifullov/auth/internal/converter"
+	desc "github.com/arifullov/auth/pkg/organization_v1"
+)
+
+func (i *Implementation) CreateOrganization(ctx context.Context, req *desc.CreateOrganizationRequest) (*desc.CreateOrganizationResponse, error) {
+	id, err := i.orgService.Create(ctx, converter.ToOrganizationFromDesc(req))
+	if err != nil {
+		return nil, err
+	}
+	return &desc.CreateOrganizationResponse{Id: id}, nil
+}
+
+func (i *Implementation) AddMember(ctx context.Context, req *desc.AddMemberRequest) (*desc.AddMemberResponse, error) {
+	err := i.orgService.AddMember(ctx, req.GetSlug(), req.GetUserId(), converter.ToRolesFromDesc(req.GetRoles()))
+	if err != nil {
+		return nil, err
+	}
+	return &desc.AddMemberResponse{}, nil
+}
+
+func (i *Implementation) RemoveMember(ctx context.Context, req *desc.RemoveMemberRequest) (*desc.RemoveMemberResponse, error) {
+	if err := i.orgService.RemoveMember(ctx, req.GetSlug(), req.GetUserId()); err != nil {
+		return nil, err
+	}
+	return &desc.RemoveMemberResponse{}, nil
+}
+
+func (i *Implementation) ListOrganizations(ctx context.Context, _ *desc.ListOrganizationsRequest) (*desc.ListOrganizationsResponse, error) {
+	orgs, err := i.orgService.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &desc.ListOrganizationsResponse{Organizations: converter.ToOrganizationsFromService(orgs)}, nil
+}
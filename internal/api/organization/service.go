@@ -0,0 +1,17 @@
+package organization
+
+import (
+	"github.com/arifullov/auth/internal/service"
+	desc "github.com/arifullov/auth/pkg/organization_v1"
+)
+
+type Implementation struct {
+	desc.UnimplementedOrganizationV1Server
+	orgService service.OrganizationService
+}
+
+func NewImplementation(orgService service.OrganizationService) *Implementation {
+	return &Implementation{
+		orgService: orgService,
+	}
+}
@@ -0,0 +1,68 @@
+package relation
+
+import (
+	"context"
+
+	"github.com/arifullov/auth/internal/converter"
+	desc "github.com/arifullov/auth/pkg/relation_v1"
+)
+
+func (i *Implementation) CheckRelation(ctx context.Context, req *desc.CheckRelationRequest) (*desc.CheckRelationResponse, error) {
+	allowed, token, err := i.relationService.Check(
+		ctx,
+		converter.ToRelationObjectFromDesc(req.GetObject()),
+		req.GetRelation(),
+		req.GetUserId(),
+		converter.ToRelationConsistencyFromDesc(req.GetConsistency()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &desc.CheckRelationResponse{
+		Allowed:   allowed,
+		CheckedAt: token,
+	}, nil
+}
+
+func (i *Implementation) WriteTuples(ctx context.Context, req *desc.WriteTuplesRequest) (*desc.WriteTuplesResponse, error) {
+	token, err := i.relationService.WriteTuples(
+		ctx,
+		converter.ToRelationTuplesFromDesc(req.GetWrites()),
+		converter.ToRelationTuplesFromDesc(req.GetDeletes()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &desc.WriteTuplesResponse{
+		WrittenAt: token,
+	}, nil
+}
+
+func (i *Implementation) ReadTuples(ctx context.Context, req *desc.ReadTuplesRequest) (*desc.ReadTuplesResponse, error) {
+	page, err := i.relationService.ReadTuples(
+		ctx,
+		converter.ToRelationTupleFilterFromDesc(req),
+		req.GetPageToken(),
+		converter.ToRelationConsistencyFromDesc(req.GetConsistency()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return converter.ToReadTuplesResponseFromService(page), nil
+}
+
+func (i *Implementation) ExpandRelation(ctx context.Context, req *desc.ExpandRelationRequest) (*desc.ExpandRelationResponse, error) {
+	node, token, err := i.relationService.Expand(
+		ctx,
+		converter.ToRelationObjectFromDesc(req.GetObject()),
+		req.GetRelation(),
+		converter.ToRelationConsistencyFromDesc(req.GetConsistency()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &desc.ExpandRelationResponse{
+		Tree:       converter.ToExpandNodeFromService(node),
+		ExpandedAt: token,
+	}, nil
+}
@@ -0,0 +1,17 @@
+package relation
+
+import (
+	"github.com/arifullov/auth/internal/service"
+	desc "github.com/arifullov/auth/pkg/relation_v1"
+)
+
+type Implementation struct {
+	desc.UnimplementedRelationV1Server
+	relationService service.RelationService
+}
+
+func NewImplementation(relationService service.RelationService) *Implementation {
+	return &Implementation{
+		relationService: relationService,
+	}
+}
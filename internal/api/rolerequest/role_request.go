@@ -0,0 +1,51 @@
+package rolerequest
+
+import (
+	"context"
+
+	"github.com/arifullov/auth/internal/converter"
+	desc "github.com/arifullov/auth/pkg/role_request_v1"
+)
+
+func (i *Implementation) RequestRole(ctx context.Context, req *desc.RequestRoleRequest) (*desc.RequestRoleResponse, error) {
+	id, err := i.roleRequestService.Request(ctx, converter.ToCreateRoleRequestFromDesc(req))
+	if err != nil {
+		return nil, err
+	}
+	return &desc.RequestRoleResponse{Id: id}, nil
+}
+
+func (i *Implementation) ApproveRoleRequest(ctx context.Context, req *desc.ApproveRoleRequestRequest) (*desc.ApproveRoleRequestResponse, error) {
+	decided, err := i.roleRequestService.Approve(ctx, req.GetId(), req.GetComment())
+	if err != nil {
+		return nil, err
+	}
+	return &desc.ApproveRoleRequestResponse{Request: converter.ToRoleRequestFromService(decided)}, nil
+}
+
+func (i *Implementation) DenyRoleRequest(ctx context.Context, req *desc.DenyRoleRequestRequest) (*desc.DenyRoleRequestResponse, error) {
+	decided, err := i.roleRequestService.Deny(ctx, req.GetId(), req.GetComment())
+	if err != nil {
+		return nil, err
+	}
+	return &desc.DenyRoleRequestResponse{Request: converter.ToRoleRequestFromService(decided)}, nil
+}
+
+func (i *Implementation) GetRoleRequest(ctx context.Context, req *desc.GetRoleRequestRequest) (*desc.GetRoleRequestResponse, error) {
+	roleRequest, err := i.roleRequestService.Get(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &desc.GetRoleRequestResponse{Request: converter.ToRoleRequestFromService(roleRequest)}, nil
+}
+
+func (i *Implementation) ListRoleRequests(ctx context.Context, req *desc.ListRoleRequestsRequest) (*desc.ListRoleRequestsResponse, error) {
+	page, err := i.roleRequestService.List(ctx, req.GetPageToken(), converter.ToRoleRequestFilterFromDesc(req))
+	if err != nil {
+		return nil, err
+	}
+	return &desc.ListRoleRequestsResponse{
+		Requests:      converter.ToRoleRequestsFromService(page.Requests),
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
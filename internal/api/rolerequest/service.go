@@ -0,0 +1,17 @@
+package rolerequest
+
+import (
+	"github.com/arifullov/auth/internal/service"
+	desc "github.com/arifullov/auth/pkg/role_request_v1"
+)
+
+type Implementation struct {
+	desc.UnimplementedRoleRequestV1Server
+	roleRequestService service.RoleRequestService
+}
+
+func NewImplementation(roleRequestService service.RoleRequestService) *Implementation {
+	return &Implementation{
+		roleRequestService: roleRequestService,
+	}
+}
@@ -0,0 +1,18 @@
+package user
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/arifullov/auth/internal/converter"
+	desc "github.com/arifullov/auth/pkg/user_v1"
+)
+
+func (i *Implementation) SetUserRole(ctx context.Context, req *desc.SetUserRoleRequest) (*emptypb.Empty, error) {
+	err := i.userService.SetRoles(ctx, req.GetId(), converter.ToRolesFromDesc(req.GetRoles()))
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,16 @@
+package user
+
+import (
+	"context"
+
+	"github.com/arifullov/auth/internal/converter"
+	desc "github.com/arifullov/auth/pkg/user_v1"
+)
+
+func (i *Implementation) SignUp(ctx context.Context, req *desc.SignUpRequest) (*desc.CreateResponse, error) {
+	id, err := i.userService.SignUp(ctx, converter.ToUserSignUpFromDesc(req))
+	if err != nil {
+		return nil, err
+	}
+	return &desc.CreateResponse{Id: id}, nil
+}
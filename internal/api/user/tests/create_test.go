@@ -8,8 +8,6 @@ import (
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/require"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 
 	"github.com/arifullov/auth/internal/api/user"
 	"github.com/arifullov/auth/internal/model"
@@ -50,7 +48,7 @@ func TestCreate(t *testing.T) {
 			Email:           email,
 			Password:        password,
 			PasswordConfirm: password,
-			Role:            model.AdminRole,
+			Roles:           []model.Role{model.AdminRole},
 		}
 
 		res = &desc.CreateResponse{
@@ -86,7 +84,7 @@ func TestCreate(t *testing.T) {
 				req: req,
 			},
 			want: nil,
-			err:  status.Errorf(codes.Unavailable, "failed to create user"),
+			err:  serviceErr,
 			userServiceMock: func(mc *minimock.Controller) service.UserService {
 				mock := serviceMocks.NewUserServiceMock(mc)
 				mock.CreateMock.Expect(ctx, userObj).Return(0, serviceErr)
@@ -6,9 +6,12 @@ import (
 	"net"
 	"net/http"
 	"sync"
+	"time"
 
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rakyll/statik/fs"
 	"github.com/rs/cors"
@@ -18,15 +21,23 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/arifullov/auth/internal/api/extauthz"
 	"github.com/arifullov/auth/internal/closer"
 	"github.com/arifullov/auth/internal/config"
+	"github.com/arifullov/auth/internal/gateway"
 	"github.com/arifullov/auth/internal/interceptor"
 	"github.com/arifullov/auth/internal/logger"
 	"github.com/arifullov/auth/internal/metric"
+	"github.com/arifullov/auth/internal/policy"
 	"github.com/arifullov/auth/internal/rate_limiter"
 	"github.com/arifullov/auth/internal/tracing"
+	descAccessAdmin "github.com/arifullov/auth/pkg/access_admin_v1"
 	descAccess "github.com/arifullov/auth/pkg/access_v1"
 	descAuth "github.com/arifullov/auth/pkg/auth_v1"
+	descGroup "github.com/arifullov/auth/pkg/group_v1"
+	descOrganization "github.com/arifullov/auth/pkg/organization_v1"
+	descRelation "github.com/arifullov/auth/pkg/relation_v1"
+	descRoleRequest "github.com/arifullov/auth/pkg/role_request_v1"
 	descUser "github.com/arifullov/auth/pkg/user_v1"
 	_ "github.com/arifullov/auth/statik"
 )
@@ -99,6 +110,10 @@ func (a *App) initDeps(ctx context.Context) error {
 		a.initConfig,
 		a.initServiceProvider,
 		a.initLogger,
+		a.initPolicyFile,
+		a.initPolicyCache,
+		a.initRevocationList,
+		a.initRoleGrantExpiry,
 		a.initGRPCServer,
 		a.initHTTPServer,
 		a.initSwaggerServer,
@@ -126,6 +141,151 @@ func (a *App) initServiceProvider(_ context.Context) error {
 	return nil
 }
 
+// initPolicyFile applies the policy file when it is synced on startup, then keeps reporting
+// how many changes the stored policy is away from the file. The file is read again every
+// time, so a file updated in place is picked up without a restart.
+func (a *App) initPolicyFile(ctx context.Context) error {
+	cfg := a.serviceProvider.PolicyConfig()
+	if cfg.File() == "" {
+		return nil
+	}
+	policyFileService := a.serviceProvider.PolicyFileService(ctx)
+
+	if cfg.SyncOnStartup() {
+		doc, err := policy.ReadDocumentFile(cfg.File())
+		if err != nil {
+			return err
+		}
+		plan, err := policyFileService.Apply(ctx, doc)
+		if err != nil {
+			return errors.Wrap(err, "failed to apply policy file")
+		}
+		logger.Infof("applied policy file %s: %d roles and %d rules changed", cfg.File(), len(plan.Roles), len(plan.Rules))
+	}
+
+	reportDrift := func(ctx context.Context) {
+		doc, err := policy.ReadDocumentFile(cfg.File())
+		if err != nil {
+			logger.Errorf("failed to read policy file: %s", err.Error())
+			return
+		}
+		plan, err := policyFileService.Plan(ctx, doc)
+		if err != nil {
+			logger.Errorf("failed to compare policy file: %s", err.Error())
+			return
+		}
+		metric.SetPolicyDrift(plan.Len())
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	closer.Add(func() error {
+		cancel()
+		return nil
+	})
+	go func() {
+		reportDrift(ctx)
+		ticker := time.NewTicker(cfg.ReloadInterval())
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				reportDrift(ctx)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// initPolicyCache loads the route policy and keeps it fresh in the background. A failed
+// initial load is not fatal, the cache is loaded again on the first check.
+func (a *App) initPolicyCache(ctx context.Context) error {
+	cache := a.serviceProvider.PolicyCache(ctx)
+	if err := cache.Reload(ctx); err != nil {
+		logger.Errorf("failed to load policy: %s", err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	closer.Add(func() error {
+		cancel()
+		return nil
+	})
+	go cache.Watch(ctx, a.serviceProvider.DBClient(ctx).DB(), a.serviceProvider.PolicyConfig().ReloadInterval())
+
+	return nil
+}
+
+// initRevocationList loads the recent token revocations and keeps them fresh in the background,
+// a failed initial load is retried on the first check like for the policy cache.
+func (a *App) initRevocationList(ctx context.Context) error {
+	list := a.serviceProvider.RevocationList(ctx)
+	if err := list.Reload(ctx); err != nil {
+		logger.Errorf("failed to load token revocations: %s", err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	closer.Add(func() error {
+		cancel()
+		return nil
+	})
+	go list.Watch(ctx, a.serviceProvider.DBClient(ctx).DB(), a.serviceProvider.PolicyConfig().ReloadInterval())
+
+	return nil
+}
+
+// initRoleGrantExpiry removes the expired time-bound roles in the background.
+func (a *App) initRoleGrantExpiry(ctx context.Context) error {
+	roleRequestService := a.serviceProvider.RoleRequestService(ctx)
+	interval := a.serviceProvider.RoleRequestConfig().ExpiryInterval()
+
+	ctx, cancel := context.WithCancel(ctx)
+	closer.Add(func() error {
+		cancel()
+		return nil
+	})
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				removed, err := roleRequestService.ExpireGrants(ctx)
+				if err != nil {
+					logger.Errorf("failed to expire role grants: %s", err.Error())
+					continue
+				}
+				if removed > 0 {
+					logger.Infof("removed %d expired role grants", removed)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+// publicMethods are callable without an access token, every other method
+// is authorized against the route rules.
+var publicMethods = []string{
+	descAuth.AuthV1_Login_FullMethodName,
+	descAuth.AuthV1_GetRefreshToken_FullMethodName,
+	descAuth.AuthV1_GetAccessToken_FullMethodName,
+	descAuth.AuthV1_ExchangeToken_FullMethodName,
+	descAuth.AuthV1_DeviceAuthorize_FullMethodName,
+	descAuth.AuthV1_DeviceToken_FullMethodName,
+	descAuth.AuthV1_StartFederatedLogin_FullMethodName,
+	descAuth.AuthV1_FinishFederatedLogin_FullMethodName,
+	descAccess.AccessV1_Check_FullMethodName,
+	descAccess.AccessV1_BatchCheck_FullMethodName,
+	// Envoy passes the token of the request being authorized in the request itself.
+	extauthz.CheckFullMethodName,
+	descUser.UserV1_SignUp_FullMethodName,
+}
+
 func (a *App) initGRPCServer(ctx context.Context) error {
 	opts := []logging.Option{
 		logging.WithLogOnEvents(logging.StartCall, logging.FinishCall),
@@ -159,6 +319,7 @@ func (a *App) initGRPCServer(ctx context.Context) error {
 			interceptor.MetricsInterceptor,
 			interceptor.NewCircuitBreakerInterceptor(circuitBreaker).Unary,
 			interceptor.NewRateLimiterInterceptor(rateLimiter).Unary,
+			interceptor.NewAuthInterceptor(a.serviceProvider.AccessService(ctx), publicMethods...).Unary,
 			interceptor.ValidateInterceptor,
 		),
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
@@ -167,11 +328,34 @@ func (a *App) initGRPCServer(ctx context.Context) error {
 	descUser.RegisterUserV1Server(a.grpcServer, a.serviceProvider.UserImpl(ctx))
 	descAccess.RegisterAccessV1Server(a.grpcServer, a.serviceProvider.AccessImpl(ctx))
 	descAuth.RegisterAuthV1Server(a.grpcServer, a.serviceProvider.AuthImpl(ctx))
+	descAccessAdmin.RegisterAccessAdminV1Server(a.grpcServer, a.serviceProvider.AccessAdminImpl(ctx))
+	descRelation.RegisterRelationV1Server(a.grpcServer, a.serviceProvider.RelationImpl(ctx))
+	descOrganization.RegisterOrganizationV1Server(a.grpcServer, a.serviceProvider.OrganizationImpl(ctx))
+	descGroup.RegisterGroupV1Server(a.grpcServer, a.serviceProvider.GroupImpl(ctx))
+	descRoleRequest.RegisterRoleRequestV1Server(a.grpcServer, a.serviceProvider.RoleRequestImpl(ctx))
+	authv3.RegisterAuthorizationServer(a.grpcServer, a.serviceProvider.ExtAuthzImpl(ctx))
 	return nil
 }
 
 func (a *App) initHTTPServer(ctx context.Context) error {
-	mux := runtime.NewServeMux()
+	muxOpts := []runtime.ServeMuxOption{
+		runtime.WithIncomingHeaderMatcher(gateway.IncomingHeaderMatcher),
+		runtime.WithForwardResponseOption(gateway.FederatedBindingForwardResponse),
+		runtime.WithMetadata(gateway.FederatedBindingMetadata),
+		runtime.WithMetadata(gateway.ClientIPMetadata),
+	}
+	var refreshTokenCookie *gateway.RefreshTokenCookie
+	if a.serviceProvider.CookieConfig().Enabled() {
+		refreshTokenCookie = gateway.NewRefreshTokenCookie(
+			a.serviceProvider.CookieConfig(),
+			a.serviceProvider.TokenConfig().RefreshTokenExpiration(),
+		)
+		muxOpts = append(muxOpts,
+			runtime.WithForwardResponseOption(refreshTokenCookie.ForwardResponse),
+			runtime.WithMetadata(refreshTokenCookie.Metadata),
+		)
+	}
+	mux := runtime.NewServeMux(muxOpts...)
 
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
@@ -182,16 +366,65 @@ func (a *App) initHTTPServer(ctx context.Context) error {
 		return err
 	}
 
+	err = descAuth.RegisterAuthV1HandlerFromEndpoint(ctx, mux, a.serviceProvider.GRPCConfig().Address(), opts)
+	if err != nil {
+		return err
+	}
+
+	err = descAccess.RegisterAccessV1HandlerFromEndpoint(ctx, mux, a.serviceProvider.GRPCConfig().Address(), opts)
+	if err != nil {
+		return err
+	}
+
+	err = descAccessAdmin.RegisterAccessAdminV1HandlerFromEndpoint(ctx, mux, a.serviceProvider.GRPCConfig().Address(), opts)
+	if err != nil {
+		return err
+	}
+
+	err = descRelation.RegisterRelationV1HandlerFromEndpoint(ctx, mux, a.serviceProvider.GRPCConfig().Address(), opts)
+	if err != nil {
+		return err
+	}
+
+	err = descOrganization.RegisterOrganizationV1HandlerFromEndpoint(ctx, mux, a.serviceProvider.GRPCConfig().Address(), opts)
+	if err != nil {
+		return err
+	}
+
+	err = descGroup.RegisterGroupV1HandlerFromEndpoint(ctx, mux, a.serviceProvider.GRPCConfig().Address(), opts)
+	if err != nil {
+		return err
+	}
+	err = descRoleRequest.RegisterRoleRequestV1HandlerFromEndpoint(ctx, mux, a.serviceProvider.GRPCConfig().Address(), opts)
+	if err != nil {
+		return err
+	}
+
 	corsMiddleware := cors.New(cors.Options{
 		AllowedOrigins:   []string{"*"},
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Content-Type", "Content-Length", "Authorization"},
+		AllowedHeaders:   []string{"Accept", "Content-Type", "Content-Length", "Authorization", gateway.CSRFHeader, gateway.TenantHeader},
 		AllowCredentials: true,
 	})
 
+	httpMux := http.NewServeMux()
+	httpMux.Handle("/", mux)
+	httpMux.HandleFunc("GET /device", gateway.DeviceVerificationPage(a.serviceProvider.CookieConfig().CSRFName()))
+	httpMux.Handle(gateway.ForwardAuthPath, gateway.NewForwardAuth(
+		a.serviceProvider.AccessService(ctx),
+		a.serviceProvider.ForwardAuthConfig().TrustedProxies(),
+		a.serviceProvider.ForwardAuthConfig().CacheTTL(),
+		a.serviceProvider.ForwardAuthConfig().CacheSize(),
+	))
+
+	var handler http.Handler = httpMux
+	if refreshTokenCookie != nil {
+		handler = refreshTokenCookie.CSRF(handler)
+	}
+
 	a.httpServer = &http.Server{
 		Addr:    a.serviceProvider.HTTPConfig().Address(),
-		Handler: corsMiddleware.Handler(mux),
+		Handler: corsMiddleware.Handler(handler),
 	}
 	return nil
 }
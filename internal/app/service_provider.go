@@ -4,48 +4,123 @@ import (
 	"context"
 
 	"github.com/arifullov/auth/internal/api/access"
+	"github.com/arifullov/auth/internal/api/accessadmin"
 	"github.com/arifullov/auth/internal/api/auth"
+	"github.com/arifullov/auth/internal/api/extauthz"
+	"github.com/arifullov/auth/internal/api/group"
+	"github.com/arifullov/auth/internal/api/organization"
+	"github.com/arifullov/auth/internal/api/relation"
+	"github.com/arifullov/auth/internal/api/rolerequest"
 	"github.com/arifullov/auth/internal/api/user"
+	"github.com/arifullov/auth/internal/authenticator"
 	"github.com/arifullov/auth/internal/client/db"
 	"github.com/arifullov/auth/internal/client/db/pg"
 	"github.com/arifullov/auth/internal/client/db/transaction"
 	"github.com/arifullov/auth/internal/closer"
 	"github.com/arifullov/auth/internal/config"
+	"github.com/arifullov/auth/internal/idp"
 	"github.com/arifullov/auth/internal/logger"
+	"github.com/arifullov/auth/internal/policy"
+	relationEngine "github.com/arifullov/auth/internal/relation"
 	"github.com/arifullov/auth/internal/repository"
+	"github.com/arifullov/auth/internal/revocation"
 	"github.com/arifullov/auth/internal/service"
 
 	accessRepository "github.com/arifullov/auth/internal/repository/access"
+	auditRepository "github.com/arifullov/auth/internal/repository/audit"
+	deviceRepository "github.com/arifullov/auth/internal/repository/device"
+	federatedStateRepository "github.com/arifullov/auth/internal/repository/federatedstate"
+	groupRepository "github.com/arifullov/auth/internal/repository/group"
+	identityRepository "github.com/arifullov/auth/internal/repository/identity"
+	organizationRepository "github.com/arifullov/auth/internal/repository/organization"
+	relationRepository "github.com/arifullov/auth/internal/repository/relation"
+	roleRepository "github.com/arifullov/auth/internal/repository/role"
+	roleRequestRepository "github.com/arifullov/auth/internal/repository/rolerequest"
 	userRepository "github.com/arifullov/auth/internal/repository/user"
 	userService "github.com/arifullov/auth/internal/service/user"
 
 	accessService "github.com/arifullov/auth/internal/service/access"
 
 	authService "github.com/arifullov/auth/internal/service/auth"
-)
 
-type serviceProvider struct {
-	pgConfig         config.PGConfig
-	grpcConfig       config.GRPCConfig
-	httpConfig       config.HTTPConfig
-	swaggerConfig    config.SwaggerConfig
-	prometheusConfig config.HTTPConfig
-	tokenConfig      config.TokenConfig
-	loggerConfig     config.LoggerConfig
-	jaegerConfig     config.JaegerConfig
+	deviceService "github.com/arifullov/auth/internal/service/device"
+
+	federatedService "github.com/arifullov/auth/internal/service/federated"
+
+	accessAdminService "github.com/arifullov/auth/internal/service/accessadmin"
+
+	relationService "github.com/arifullov/auth/internal/service/relation"
+
+	organizationService "github.com/arifullov/auth/internal/service/organization"
 
-	dbClient         db.Client
-	txManager        db.TxManager
-	userRepository   repository.UserRepository
-	accessRepository repository.AccessRepository
+	groupService "github.com/arifullov/auth/internal/service/group"
 
-	userService   service.UserService
-	accessService service.AccessService
-	authService   service.AuthService
+	roleRequestService "github.com/arifullov/auth/internal/service/rolerequest"
 
-	userImpl  *user.Implementation
-	authImpl  *auth.Implementation
-	accessImp *access.Implementation
+	policyFileService "github.com/arifullov/auth/internal/service/policyfile"
+)
+
+type serviceProvider struct {
+	pgConfig          config.PGConfig
+	grpcConfig        config.GRPCConfig
+	httpConfig        config.HTTPConfig
+	cookieConfig      config.CookieConfig
+	swaggerConfig     config.SwaggerConfig
+	prometheusConfig  config.HTTPConfig
+	tokenConfig       config.TokenConfig
+	loggerConfig      config.LoggerConfig
+	jaegerConfig      config.JaegerConfig
+	deviceConfig      config.DeviceConfig
+	idpConfig         config.IdentityProvidersConfig
+	clientsConfig     config.OAuthClientsConfig
+	authnConfig       config.AuthenticatorConfig
+	ldapConfig        config.LDAPConfig
+	policyConfig      config.PolicyConfig
+	relationConfig    config.RelationNamespacesConfig
+	roleRequestConfig config.RoleRequestConfig
+	forwardAuthConfig config.ForwardAuthConfig
+
+	dbClient              db.Client
+	txManager             db.TxManager
+	userRepository        repository.UserRepository
+	accessRepository      repository.AccessRepository
+	deviceRepository      repository.DeviceCodeRepository
+	identityRepository    repository.IdentityRepository
+	stateRepository       repository.FederatedStateRepository
+	roleRepository        repository.RoleRepository
+	auditRepository       repository.AuditRepository
+	relationRepository    repository.RelationRepository
+	orgRepository         repository.OrganizationRepository
+	groupRepository       repository.GroupRepository
+	roleRequestRepository repository.RoleRequestRepository
+
+	userService        service.UserService
+	accessService      service.AccessService
+	authService        service.AuthService
+	deviceService      service.DeviceService
+	federatedService   service.FederatedService
+	accessAdminService service.AccessAdminService
+	relationService    service.RelationService
+	orgService         service.OrganizationService
+	groupService       service.GroupService
+	roleRequestService service.RoleRequestService
+	policyFileService  service.PolicyFileService
+
+	idpRegistry    *idp.Registry
+	authenticator  authenticator.Authenticator
+	policyCache    *policy.Cache
+	revocationList *revocation.List
+	relationSchema *relationEngine.Schema
+
+	userImpl        *user.Implementation
+	authImpl        *auth.Implementation
+	accessImp       *access.Implementation
+	accessAdminImpl *accessadmin.Implementation
+	relationImpl    *relation.Implementation
+	orgImpl         *organization.Implementation
+	groupImpl       *group.Implementation
+	roleRequestImpl *rolerequest.Implementation
+	extAuthzImpl    *extauthz.Implementation
 }
 
 func newServiceProvider() *serviceProvider {
@@ -85,6 +160,17 @@ func (s *serviceProvider) HTTPConfig() config.HTTPConfig {
 	return s.httpConfig
 }
 
+func (s *serviceProvider) CookieConfig() config.CookieConfig {
+	if s.cookieConfig == nil {
+		cfg, err := config.NewCookieConfig()
+		if err != nil {
+			logger.Fatalf("failed to get cookie config: %s", err.Error())
+		}
+		s.cookieConfig = cfg
+	}
+	return s.cookieConfig
+}
+
 func (s *serviceProvider) SwaggerConfig() config.SwaggerConfig {
 	if s.swaggerConfig == nil {
 		cfg, err := config.NewSwaggerConfig()
@@ -146,6 +232,153 @@ func (s *serviceProvider) JaegerConfig() config.JaegerConfig {
 	return s.jaegerConfig
 }
 
+func (s *serviceProvider) DeviceConfig() config.DeviceConfig {
+	if s.deviceConfig == nil {
+		cfg, err := config.NewDeviceConfig()
+		if err != nil {
+			logger.Fatalf("failed to get device config: %s", err.Error())
+		}
+		s.deviceConfig = cfg
+	}
+	return s.deviceConfig
+}
+
+func (s *serviceProvider) IdentityProvidersConfig() config.IdentityProvidersConfig {
+	if s.idpConfig == nil {
+		cfg, err := config.NewIdentityProvidersConfig()
+		if err != nil {
+			logger.Fatalf("failed to get identity providers config: %s", err.Error())
+		}
+		s.idpConfig = cfg
+	}
+	return s.idpConfig
+}
+
+func (s *serviceProvider) OAuthClientsConfig() config.OAuthClientsConfig {
+	if s.clientsConfig == nil {
+		cfg, err := config.NewOAuthClientsConfig()
+		if err != nil {
+			logger.Fatalf("failed to get oauth clients config: %s", err.Error())
+		}
+		s.clientsConfig = cfg
+	}
+	return s.clientsConfig
+}
+
+func (s *serviceProvider) IdentityProviderRegistry() *idp.Registry {
+	if s.idpRegistry == nil {
+		s.idpRegistry = idp.NewRegistry(s.IdentityProvidersConfig())
+	}
+	return s.idpRegistry
+}
+
+func (s *serviceProvider) AuthenticatorConfig() config.AuthenticatorConfig {
+	if s.authnConfig == nil {
+		cfg, err := config.NewAuthenticatorConfig()
+		if err != nil {
+			logger.Fatalf("failed to get authenticator config: %s", err.Error())
+		}
+		s.authnConfig = cfg
+	}
+	return s.authnConfig
+}
+
+func (s *serviceProvider) LDAPConfig() config.LDAPConfig {
+	if s.ldapConfig == nil {
+		cfg, err := config.NewLDAPConfig()
+		if err != nil {
+			logger.Fatalf("failed to get ldap config: %s", err.Error())
+		}
+		s.ldapConfig = cfg
+	}
+	return s.ldapConfig
+}
+
+func (s *serviceProvider) PolicyConfig() config.PolicyConfig {
+	if s.policyConfig == nil {
+		cfg, err := config.NewPolicyConfig()
+		if err != nil {
+			logger.Fatalf("failed to get policy config: %s", err.Error())
+		}
+		s.policyConfig = cfg
+	}
+	return s.policyConfig
+}
+
+func (s *serviceProvider) RelationNamespacesConfig() config.RelationNamespacesConfig {
+	if s.relationConfig == nil {
+		cfg, err := config.NewRelationNamespacesConfig()
+		if err != nil {
+			logger.Fatalf("failed to get relation namespaces config: %s", err.Error())
+		}
+		s.relationConfig = cfg
+	}
+	return s.relationConfig
+}
+
+func (s *serviceProvider) RoleRequestConfig() config.RoleRequestConfig {
+	if s.roleRequestConfig == nil {
+		cfg, err := config.NewRoleRequestConfig()
+		if err != nil {
+			logger.Fatalf("failed to get role request config: %s", err.Error())
+		}
+		s.roleRequestConfig = cfg
+	}
+	return s.roleRequestConfig
+}
+
+func (s *serviceProvider) ForwardAuthConfig() config.ForwardAuthConfig {
+	if s.forwardAuthConfig == nil {
+		cfg, err := config.NewForwardAuthConfig()
+		if err != nil {
+			logger.Fatalf("failed to get forward auth config: %s", err.Error())
+		}
+		s.forwardAuthConfig = cfg
+	}
+	return s.forwardAuthConfig
+}
+
+func (s *serviceProvider) RelationSchema() *relationEngine.Schema {
+	if s.relationSchema == nil {
+		schema, err := relationEngine.NewSchema(s.RelationNamespacesConfig().Namespaces())
+		if err != nil {
+			logger.Fatalf("failed to build relation schema: %s", err.Error())
+		}
+		s.relationSchema = schema
+	}
+	return s.relationSchema
+}
+
+func (s *serviceProvider) PolicyCache(ctx context.Context) *policy.Cache {
+	if s.policyCache == nil {
+		s.policyCache = policy.NewCache(s.AccessRepository(ctx))
+	}
+	return s.policyCache
+}
+
+func (s *serviceProvider) RevocationList(ctx context.Context) *revocation.List {
+	if s.revocationList == nil {
+		s.revocationList = revocation.NewList(s.UserRepository(ctx), s.TokenConfig().AccessTokenExpiration())
+	}
+	return s.revocationList
+}
+
+func (s *serviceProvider) Authenticator(ctx context.Context) authenticator.Authenticator {
+	if s.authenticator == nil {
+		var backends []authenticator.Authenticator
+		for _, backend := range s.AuthenticatorConfig().Backends() {
+			switch backend {
+			case config.AuthBackendLocal:
+				backends = append(backends, authenticator.NewLocal(s.UserRepository(ctx)))
+			case config.AuthBackendLDAP:
+				backends = append(backends, authenticator.NewLDAP(s.LDAPConfig(), s.UserRepository(ctx), s.IdentityRepository(ctx), s.TxManager(ctx)))
+			}
+		}
+		s.authenticator = authenticator.NewChain(backends...)
+	}
+	return s.authenticator
+}
+
 func (s *serviceProvider) DBClient(ctx context.Context) db.Client {
 	if s.dbClient == nil {
 		cl, err := pg.New(ctx, s.PGConfig().DSN())
@@ -177,6 +410,69 @@ func (s *serviceProvider) AccessRepository(ctx context.Context) repository.Acces
 	return s.accessRepository
 }
 
+func (s *serviceProvider) DeviceCodeRepository(ctx context.Context) repository.DeviceCodeRepository {
+	if s.deviceRepository == nil {
+		s.deviceRepository = deviceRepository.NewRepository(s.DBClient(ctx))
+	}
+	return s.deviceRepository
+}
+
+func (s *serviceProvider) IdentityRepository(ctx context.Context) repository.IdentityRepository {
+	if s.identityRepository == nil {
+		s.identityRepository = identityRepository.NewRepository(s.DBClient(ctx))
+	}
+	return s.identityRepository
+}
+
+func (s *serviceProvider) FederatedStateRepository(ctx context.Context) repository.FederatedStateRepository {
+	if s.stateRepository == nil {
+		s.stateRepository = federatedStateRepository.NewRepository(s.DBClient(ctx))
+	}
+	return s.stateRepository
+}
+
+func (s *serviceProvider) RoleRepository(ctx context.Context) repository.RoleRepository {
+	if s.roleRepository == nil {
+		s.roleRepository = roleRepository.NewRepository(s.DBClient(ctx))
+	}
+	return s.roleRepository
+}
+
+func (s *serviceProvider) AuditRepository(ctx context.Context) repository.AuditRepository {
+	if s.auditRepository == nil {
+		s.auditRepository = auditRepository.NewRepository(s.DBClient(ctx))
+	}
+	return s.auditRepository
+}
+
+func (s *serviceProvider) RelationRepository(ctx context.Context) repository.RelationRepository {
+	if s.relationRepository == nil {
+		s.relationRepository = relationRepository.NewRepository(s.DBClient(ctx))
+	}
+	return s.relationRepository
+}
+
+func (s *serviceProvider) OrganizationRepository(ctx context.Context) repository.OrganizationRepository {
+	if s.orgRepository == nil {
+		s.orgRepository = organizationRepository.NewRepository(s.DBClient(ctx))
+	}
+	return s.orgRepository
+}
+
+func (s *serviceProvider) GroupRepository(ctx context.Context) repository.GroupRepository {
+	if s.groupRepository == nil {
+		s.groupRepository = groupRepository.NewRepository(s.DBClient(ctx))
+	}
+	return s.groupRepository
+}
+
+func (s *serviceProvider) RoleRequestRepository(ctx context.Context) repository.RoleRequestRepository {
+	if s.roleRequestRepository == nil {
+		s.roleRequestRepository = roleRequestRepository.NewRepository(s.DBClient(ctx))
+	}
+	return s.roleRequestRepository
+}
+
 func (s *serviceProvider) TxManager(ctx context.Context) db.TxManager {
 	if s.txManager == nil {
 		s.txManager = transaction.NewTransactionManager(s.DBClient(ctx).DB())
@@ -187,28 +483,141 @@ func (s *serviceProvider) TxManager(ctx context.Context) db.TxManager {
 func (s *serviceProvider) AccessService(ctx context.Context) service.AccessService {
 	if s.accessService == nil {
 		s.accessService = accessService.NewAccessService(
-			s.AccessRepository(ctx),
+			s.PolicyCache(ctx),
+			s.RevocationList(ctx),
+			s.RoleRepository(ctx),
 			s.TokenConfig().AccessTokenSecretKey(),
 		)
 	}
 	return s.accessService
 }
 
+func (s *serviceProvider) AccessAdminService(ctx context.Context) service.AccessAdminService {
+	if s.accessAdminService == nil {
+		s.accessAdminService = accessAdminService.NewAccessAdminService(
+			s.AccessRepository(ctx),
+			s.RoleRepository(ctx),
+			s.AuditRepository(ctx),
+			s.TxManager(ctx),
+			s.TokenConfig(),
+			s.RevocationList(ctx),
+		)
+	}
+	return s.accessAdminService
+}
+
+func (s *serviceProvider) RelationService(ctx context.Context) service.RelationService {
+	if s.relationService == nil {
+		s.relationService = relationService.NewRelationService(
+			s.RelationRepository(ctx),
+			s.RelationSchema(),
+			s.TxManager(ctx),
+		)
+	}
+	return s.relationService
+}
+
+func (s *serviceProvider) OrganizationService(ctx context.Context) service.OrganizationService {
+	if s.orgService == nil {
+		s.orgService = organizationService.NewOrganizationService(
+			s.OrganizationRepository(ctx),
+			s.UserRepository(ctx),
+			s.RoleRepository(ctx),
+			s.TxManager(ctx),
+		)
+	}
+	return s.orgService
+}
+
+func (s *serviceProvider) GroupService(ctx context.Context) service.GroupService {
+	if s.groupService == nil {
+		s.groupService = groupService.NewGroupService(
+			s.GroupRepository(ctx),
+			s.UserRepository(ctx),
+			s.RoleRepository(ctx),
+			s.TxManager(ctx),
+		)
+	}
+	return s.groupService
+}
+
+func (s *serviceProvider) RoleRequestService(ctx context.Context) service.RoleRequestService {
+	if s.roleRequestService == nil {
+		s.roleRequestService = roleRequestService.NewRoleRequestService(
+			s.RoleRequestRepository(ctx),
+			s.GroupRepository(ctx),
+			s.UserRepository(ctx),
+			s.AuditRepository(ctx),
+			s.TxManager(ctx),
+			s.RoleRequestConfig(),
+		)
+	}
+	return s.roleRequestService
+}
+
+func (s *serviceProvider) PolicyFileService(ctx context.Context) service.PolicyFileService {
+	if s.policyFileService == nil {
+		s.policyFileService = policyFileService.NewPolicyFileService(
+			s.RoleRepository(ctx),
+			s.AccessRepository(ctx),
+			s.AuditRepository(ctx),
+			s.TxManager(ctx),
+		)
+	}
+	return s.policyFileService
+}
+
 func (s *serviceProvider) AuthService(ctx context.Context) service.AuthService {
 	if s.authService == nil {
 		s.authService = authService.NewAuthService(
 			s.UserRepository(ctx),
+			s.RoleRepository(ctx),
+			s.OrganizationRepository(ctx),
 			s.TxManager(ctx),
 			s.TokenConfig(),
+			s.OAuthClientsConfig(),
+			s.Authenticator(ctx),
+			s.RevocationList(ctx),
 		)
 	}
 	return s.authService
 }
 
+func (s *serviceProvider) DeviceService(ctx context.Context) service.DeviceService {
+	if s.deviceService == nil {
+		s.deviceService = deviceService.NewDeviceService(
+			s.DeviceCodeRepository(ctx),
+			s.UserRepository(ctx),
+			s.RoleRepository(ctx),
+			s.TxManager(ctx),
+			s.TokenConfig(),
+			s.DeviceConfig(),
+			s.OAuthClientsConfig(),
+		)
+	}
+	return s.deviceService
+}
+
+func (s *serviceProvider) FederatedService(ctx context.Context) service.FederatedService {
+	if s.federatedService == nil {
+		s.federatedService = federatedService.NewFederatedService(
+			s.IdentityProviderRegistry(),
+			s.UserRepository(ctx),
+			s.IdentityRepository(ctx),
+			s.FederatedStateRepository(ctx),
+			s.TxManager(ctx),
+			s.TokenConfig(),
+			s.IdentityProvidersConfig(),
+		)
+	}
+	return s.federatedService
+}
+
 func (s *serviceProvider) UserService(ctx context.Context) service.UserService {
 	if s.userService == nil {
 		s.userService = userService.NewUserService(
 			s.UserRepository(ctx),
+			s.RoleRepository(ctx),
 			s.TxManager(ctx),
 		)
 	}
@@ -231,7 +640,49 @@ func (s *serviceProvider) AccessImpl(ctx context.Context) *access.Implementation
 
 func (s *serviceProvider) AuthImpl(ctx context.Context) *auth.Implementation {
 	if s.authImpl == nil {
-		s.authImpl = auth.NewImplementation(s.AuthService(ctx))
+		s.authImpl = auth.NewImplementation(s.AuthService(ctx), s.DeviceService(ctx), s.FederatedService(ctx))
 	}
 	return s.authImpl
 }
+
+func (s *serviceProvider) AccessAdminImpl(ctx context.Context) *accessadmin.Implementation {
+	if s.accessAdminImpl == nil {
+		s.accessAdminImpl = accessadmin.NewImplementation(s.AccessAdminService(ctx))
+	}
+	return s.accessAdminImpl
+}
+
+func (s *serviceProvider) RelationImpl(ctx context.Context) *relation.Implementation {
+	if s.relationImpl == nil {
+		s.relationImpl = relation.NewImplementation(s.RelationService(ctx))
+	}
+	return s.relationImpl
+}
+
+func (s *serviceProvider) OrganizationImpl(ctx context.Context) *organization.Implementation {
+	if s.orgImpl == nil {
+		s.orgImpl = organization.NewImplementation(s.OrganizationService(ctx))
+	}
+	return s.orgImpl
+}
+
+func (s *serviceProvider) GroupImpl(ctx context.Context) *group.Implementation {
+	if s.groupImpl == nil {
+		s.groupImpl = group.NewImplementation(s.GroupService(ctx))
+	}
+	return s.groupImpl
+}
+
+func (s *serviceProvider) RoleRequestImpl(ctx context.Context) *rolerequest.Implementation {
+	if s.roleRequestImpl == nil {
+		s.roleRequestImpl = rolerequest.NewImplementation(s.RoleRequestService(ctx))
+	}
+	return s.roleRequestImpl
+}
+
+func (s *serviceProvider) ExtAuthzImpl(ctx context.Context) *extauthz.Implementation {
+	if s.extAuthzImpl == nil {
+		s.extAuthzImpl = extauthz.NewImplementation(s.AccessService(ctx))
+	}
+	return s.extAuthzImpl
+}
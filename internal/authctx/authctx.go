@@ -0,0 +1,34 @@
+package authctx
+
+import (
+	"context"
+
+	"github.com/arifullov/auth/internal/model"
+)
+
+type key string
+
+const (
+	claimsKey key = "claims"
+)
+
+// WithClaims returns a context carrying the verified claims of the caller.
+func WithClaims(ctx context.Context, claims *model.UserClaims) context.Context {
+	return context.WithValue(ctx, claimsKey, claims)
+}
+
+// Claims returns the claims of the authenticated caller, false for public methods.
+func Claims(ctx context.Context) (*model.UserClaims, bool) {
+	claims, ok := ctx.Value(claimsKey).(*model.UserClaims)
+	return claims, ok
+}
+
+// Tenant returns the organization the caller token was issued for, empty for public
+// methods and tokens issued outside of organizations.
+func Tenant(ctx context.Context) string {
+	claims, ok := Claims(ctx)
+	if !ok || claims == nil {
+		return ""
+	}
+	return claims.Tenant
+}
@@ -0,0 +1,58 @@
+package authenticator
+
+import (
+	"context"
+
+	"github.com/arifullov/auth/internal/logger"
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+)
+
+// Authenticator checks user credentials against a single backend.
+type Authenticator interface {
+	Name() string
+	// Authenticate returns the local user the credentials belong to.
+	// Rejected credentials are reported as an Unauthenticated error.
+	Authenticate(ctx context.Context, username string, password string) (*model.User, error)
+}
+
+type chain struct {
+	authenticators []Authenticator
+}
+
+// NewChain returns an authenticator trying the given backends in order until one of them accepts the credentials.
+func NewChain(authenticators ...Authenticator) Authenticator {
+	return &chain{
+		authenticators: authenticators,
+	}
+}
+
+func (c *chain) Name() string {
+	return "chain"
+}
+
+func (c *chain) Authenticate(ctx context.Context, username string, password string) (*model.User, error) {
+	for _, a := range c.authenticators {
+		user, err := a.Authenticate(ctx, username, password)
+		if err == nil {
+			return user, nil
+		}
+		if !isUnauthenticated(err) {
+			logger.Warnf("auth backend %s failed: %s", a.Name(), err.Error())
+		}
+	}
+	return nil, errWrongCredentials()
+}
+
+func errWrongCredentials() error {
+	return sys.NewCommonError(codes.Unauthenticated, "wrong credentials")
+}
+
+func isUnauthenticated(err error) bool {
+	return sys.IsCommonError(err) && sys.GetCommonError(err).Code() == codes.Unauthenticated
+}
+
+func isNotFound(err error) bool {
+	return sys.IsCommonError(err) && sys.GetCommonError(err).Code() == codes.NotFound
+}
@@ -0,0 +1,222 @@
+package authenticator
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"slices"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/pkg/errors"
+
+	"github.com/arifullov/auth/internal/client/db"
+	"github.com/arifullov/auth/internal/config"
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/repository"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+	"github.com/arifullov/auth/internal/utils"
+)
+
+const (
+	randomPasswordSize = 32
+	// ldapProvider is the provider of the identities linking local users to directory entries.
+	ldapProvider = "ldap"
+)
+
+type ldapEntry struct {
+	dn     string
+	email  string
+	name   string
+	groups []string
+}
+
+type ldapAuthenticator struct {
+	cfg                config.LDAPConfig
+	userRepository     repository.UserRepository
+	identityRepository repository.IdentityRepository
+	txManager          db.TxManager
+}
+
+// NewLDAP returns an authenticator binding to the directory as the user.
+// Directory entries sign in as the local users provisioned for them, they never take over
+// local accounts with the same email.
+func NewLDAP(
+	cfg config.LDAPConfig,
+	userRepository repository.UserRepository,
+	identityRepository repository.IdentityRepository,
+	txManager db.TxManager,
+) Authenticator {
+	return &ldapAuthenticator{
+		cfg:                cfg,
+		userRepository:     userRepository,
+		identityRepository: identityRepository,
+		txManager:          txManager,
+	}
+}
+
+func (a *ldapAuthenticator) Name() string {
+	return "ldap"
+}
+
+func (a *ldapAuthenticator) Authenticate(ctx context.Context, username string, password string) (*model.User, error) {
+	// An empty password makes an unauthenticated bind which most servers accept.
+	if username == "" || password == "" {
+		return nil, errWrongCredentials()
+	}
+
+	entry, err := a.bind(username, password)
+	if err != nil {
+		return nil, err
+	}
+	if entry.email == "" {
+		return nil, errors.Errorf("ldap entry %s has no %s attribute", entry.dn, a.cfg.EmailAttribute())
+	}
+
+	userID, err := a.identityRepository.GetUserID(ctx, ldapProvider, entry.dn)
+	if err == nil {
+		return a.userRepository.Get(ctx, userID, "")
+	}
+	if !isNotFound(err) {
+		return nil, err
+	}
+
+	// Whoever controls the mail attribute of an entry must not sign in as the local user
+	// with that email, e.g. an admin created locally.
+	_, err = a.userRepository.GetByEmail(ctx, entry.email)
+	if err == nil {
+		return nil, sys.NewCommonError(codes.FailedPrecondition, "email of the ldap entry is used by a local account")
+	}
+	if !isNotFound(err) {
+		return nil, err
+	}
+	if !a.cfg.SyncUsers() {
+		return nil, sys.NewCommonError(codes.Unauthenticated, "user is not provisioned")
+	}
+
+	var user *model.User
+	err = a.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		user, errTx = a.provision(ctx, entry)
+		return errTx
+	})
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// bind looks the user up with the service account and checks the password by binding as the found entry.
+func (a *ldapAuthenticator) bind(username string, password string) (*ldapEntry, error) {
+	conn, err := ldap.DialURL(a.cfg.URL(), ldap.DialWithDialer(&net.Dialer{Timeout: a.cfg.Timeout()}))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to ldap")
+	}
+	defer conn.Close()
+	conn.SetTimeout(a.cfg.Timeout())
+
+	if a.cfg.BindDN() != "" {
+		if err = conn.Bind(a.cfg.BindDN(), a.cfg.BindPassword()); err != nil {
+			return nil, errors.Wrap(err, "failed to bind ldap service account")
+		}
+	}
+
+	res, err := conn.Search(ldap.NewSearchRequest(
+		a.cfg.BaseDN(),
+		ldap.ScopeWholeSubtree,
+		ldap.NeverDerefAliases,
+		2,
+		int(a.cfg.Timeout()/time.Second),
+		false,
+		fmt.Sprintf(a.cfg.UserFilter(), ldap.EscapeFilter(username)),
+		[]string{a.cfg.EmailAttribute(), a.cfg.NameAttribute(), a.cfg.GroupAttribute()},
+		nil,
+	))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to search ldap user")
+	}
+	if len(res.Entries) != 1 {
+		return nil, errWrongCredentials()
+	}
+
+	e := res.Entries[0]
+	if err = conn.Bind(e.DN, password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return nil, errWrongCredentials()
+		}
+		return nil, errors.Wrap(err, "failed to bind ldap user")
+	}
+
+	return &ldapEntry{
+		dn:     e.DN,
+		email:  e.GetAttributeValue(a.cfg.EmailAttribute()),
+		name:   e.GetAttributeValue(a.cfg.NameAttribute()),
+		groups: e.GetAttributeValues(a.cfg.GroupAttribute()),
+	}, nil
+}
+
+// role returns the role of the first mapping rule matching a group of the entry.
+func (a *ldapAuthenticator) role(entry *ldapEntry) model.Role {
+	for _, rule := range a.cfg.RoleMapping() {
+		if slices.ContainsFunc(entry.groups, func(group string) bool {
+			return equalDN(group, rule.Value)
+		}) {
+			return model.Role(rule.Role)
+		}
+	}
+	return model.UserRole
+}
+
+// provision creates a local user for the directory entry and links it to the entry. The
+// password is random, the user keeps signing in through the directory.
+func (a *ldapAuthenticator) provision(ctx context.Context, entry *ldapEntry) (*model.User, error) {
+	b := make([]byte, randomPasswordSize)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+
+	name := entry.name
+	if name == "" {
+		name = entry.email
+	}
+
+	now := time.Now()
+	id, err := a.userRepository.Create(ctx, &model.CreateUser{
+		Name:         name,
+		Email:        entry.email,
+		PasswordHash: utils.MakePbkdf2SHA256(base64.RawURLEncoding.EncodeToString(b)),
+		Roles:        []model.Role{a.role(entry)},
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}, "")
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = a.identityRepository.Create(ctx, &model.UserIdentity{
+		UserID:    id,
+		Provider:  ldapProvider,
+		Subject:   entry.dn,
+		Email:     entry.email,
+		CreatedAt: now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return a.userRepository.Get(ctx, id, "")
+}
+
+func equalDN(a string, b string) bool {
+	dnA, err := ldap.ParseDN(a)
+	if err != nil {
+		return a == b
+	}
+	dnB, err := ldap.ParseDN(b)
+	if err != nil {
+		return a == b
+	}
+	return dnA.EqualFold(dnB)
+}
@@ -0,0 +1,43 @@
+package authenticator
+
+import (
+	"context"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/repository"
+	"github.com/arifullov/auth/internal/utils"
+)
+
+type local struct {
+	userRepository repository.UserRepository
+}
+
+// NewLocal returns an authenticator checking the password against the hash stored in the users table.
+func NewLocal(userRepository repository.UserRepository) Authenticator {
+	return &local{
+		userRepository: userRepository,
+	}
+}
+
+func (a *local) Name() string {
+	return "local"
+}
+
+func (a *local) Authenticate(ctx context.Context, username string, password string) (*model.User, error) {
+	user, err := a.userRepository.GetByEmail(ctx, username)
+	if isNotFound(err) {
+		return nil, errWrongCredentials()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	isPasswordEqual, err := utils.CheckPbkdf2SHA256(password, user.PasswordHash)
+	if err != nil {
+		return nil, err
+	}
+	if !isPasswordEqual {
+		return nil, errWrongCredentials()
+	}
+	return user, nil
+}
@@ -0,0 +1,132 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arifullov/auth/internal/authenticator"
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/repository"
+	repositoryMocks "github.com/arifullov/auth/internal/repository/mocks"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+	"github.com/arifullov/auth/internal/utils"
+)
+
+type stubAuthenticator struct {
+	user *model.User
+	err  error
+}
+
+func (a stubAuthenticator) Name() string {
+	return "stub"
+}
+
+func (a stubAuthenticator) Authenticate(_ context.Context, _ string, _ string) (*model.User, error) {
+	return a.user, a.err
+}
+
+func TestChain(t *testing.T) {
+	type userRepositoryMockFunc func(mc *minimock.Controller) repository.UserRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		email    = gofakeit.Email()
+		password = gofakeit.Password(true, true, true, false, false, 12)
+
+		userObj = &model.User{
+			ID:           gofakeit.Int64(),
+			Email:        email,
+			PasswordHash: utils.MakePbkdf2SHA256(password),
+			Roles:        []model.Role{model.UserRole},
+		}
+		directoryUser = &model.User{
+			ID:    gofakeit.Int64(),
+			Email: email,
+			Roles: []model.Role{model.AdminRole},
+		}
+
+		wrongCredentials = sys.NewCommonError(codes.Unauthenticated, "wrong credentials")
+	)
+
+	tests := []struct {
+		name               string
+		password           string
+		first              authenticator.Authenticator
+		want               *model.User
+		err                error
+		userRepositoryMock userRepositoryMockFunc
+	}{
+		{
+			name:     "first backend accepts",
+			password: password,
+			first:    stubAuthenticator{user: directoryUser},
+			want:     directoryUser,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repositoryMocks.NewUserRepositoryMock(mc)
+			},
+		},
+		{
+			name:     "fall back to local on rejected credentials",
+			password: password,
+			first:    stubAuthenticator{err: wrongCredentials},
+			want:     userObj,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetByEmailMock.Expect(ctx, email).Return(userObj, nil)
+				return mock
+			},
+		},
+		{
+			name:     "fall back to local on backend failure",
+			password: password,
+			first:    stubAuthenticator{err: fmt.Errorf("ldap unavailable")},
+			want:     userObj,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetByEmailMock.Expect(ctx, email).Return(userObj, nil)
+				return mock
+			},
+		},
+		{
+			name:     "wrong local password",
+			password: password + "x",
+			first:    stubAuthenticator{err: wrongCredentials},
+			err:      wrongCredentials,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetByEmailMock.Expect(ctx, email).Return(userObj, nil)
+				return mock
+			},
+		},
+		{
+			name:     "unknown local user",
+			password: password,
+			first:    stubAuthenticator{err: wrongCredentials},
+			err:      wrongCredentials,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetByEmailMock.Expect(ctx, email).Return(nil, sys.NewCommonError(codes.NotFound, "user not found"))
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chain := authenticator.NewChain(tt.first, authenticator.NewLocal(tt.userRepositoryMock(mc)))
+			user, err := chain.Authenticate(ctx, email, tt.password)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, user)
+		})
+	}
+}
@@ -52,6 +52,17 @@ type QueryExecer interface {
 	QueryRowContext(ctx context.Context, q Query, args ...any) pgx.Row
 }
 
+// NotificationHandler обрабатывает payload уведомления Postgres
+type NotificationHandler func(payload string)
+
+// Listener интерфейс для подписки на уведомления LISTEN/NOTIFY
+type Listener interface {
+	// Listen блокируется до отмены контекста и переподключается при ошибках.
+	// После каждого (пере)подключения handler вызывается с пустым payload,
+	// так как уведомления, отправленные без подключения, теряются.
+	Listen(ctx context.Context, channel string, handler NotificationHandler) error
+}
+
 // Pinger интерфейс для проверки соединения с БД
 type Pinger interface {
 	Ping(ctx context.Context) error
@@ -73,6 +84,7 @@ type Tr interface {
 type DB interface {
 	SQLExecer
 	Transactor
+	Listener
 	Pinger
 	Close()
 }
@@ -0,0 +1,57 @@
+package pg
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"github.com/arifullov/auth/internal/client/db"
+	"github.com/arifullov/auth/internal/logger"
+)
+
+const (
+	listenRetryDelay = 5 * time.Second
+)
+
+func (p *pg) Listen(ctx context.Context, channel string, handler db.NotificationHandler) error {
+	for {
+		err := p.listen(ctx, channel, handler)
+		if ctx.Err() != nil {
+			return nil
+		}
+		logger.Warnf("listen %s: %s, reconnecting in %s", channel, err.Error(), listenRetryDelay)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(listenRetryDelay):
+		}
+	}
+}
+
+// listen держит отдельное соединение, изъятое из пула, так как LISTEN - состояние сессии.
+func (p *pg) listen(ctx context.Context, channel string, handler db.NotificationHandler) error {
+	poolConn, err := p.dbc.Acquire(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to acquire connection")
+	}
+	conn := poolConn.Hijack()
+	defer func() {
+		_ = conn.Close(context.Background())
+	}()
+
+	if _, err = conn.Exec(ctx, "listen "+pgx.Identifier{channel}.Sanitize()); err != nil {
+		return errors.Wrap(err, "failed to listen")
+	}
+	handler("")
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to wait for notification")
+		}
+		handler(notification.Payload)
+	}
+}
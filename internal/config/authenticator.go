@@ -0,0 +1,50 @@
+package config
+
+import (
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	authBackendsEnvName = "AUTH_BACKENDS"
+
+	AuthBackendLocal = "local"
+	AuthBackendLDAP  = "ldap"
+)
+
+type AuthenticatorConfig interface {
+	// Backends returns authentication backends in the order Login tries them.
+	Backends() []string
+}
+
+type authenticatorConfig struct {
+	backends []string
+}
+
+func NewAuthenticatorConfig() (AuthenticatorConfig, error) {
+	backendsStr := os.Getenv(authBackendsEnvName)
+	if backendsStr == "" {
+		backendsStr = AuthBackendLocal
+	}
+
+	var backends []string
+	for _, backend := range strings.Split(backendsStr, ",") {
+		backend = strings.TrimSpace(backend)
+		switch backend {
+		case AuthBackendLocal, AuthBackendLDAP:
+			backends = append(backends, backend)
+		default:
+			return nil, errors.Errorf("unknown auth backend %q", backend)
+		}
+	}
+
+	return &authenticatorConfig{
+		backends: backends,
+	}, nil
+}
+
+func (cfg *authenticatorConfig) Backends() []string {
+	return cfg.backends
+}
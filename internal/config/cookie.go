@@ -0,0 +1,99 @@
+package config
+
+import (
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	refreshTokenCookieEnabledEnvName  = "REFRESH_TOKEN_COOKIE_ENABLED"
+	refreshTokenCookieNameEnvName     = "REFRESH_TOKEN_COOKIE_NAME"
+	refreshTokenCookieDomainEnvName   = "REFRESH_TOKEN_COOKIE_DOMAIN"
+	refreshTokenCookieSameSiteEnvName = "REFRESH_TOKEN_COOKIE_SAME_SITE"
+	csrfCookieNameEnvName             = "CSRF_COOKIE_NAME"
+
+	defaultRefreshTokenCookieName = "refresh_token"
+	defaultCSRFCookieName         = "csrf_token"
+)
+
+type CookieConfig interface {
+	Enabled() bool
+	RefreshTokenName() string
+	CSRFName() string
+	Domain() string
+	SameSite() http.SameSite
+}
+
+type cookieConfig struct {
+	enabled          bool
+	refreshTokenName string
+	csrfName         string
+	domain           string
+	sameSite         http.SameSite
+}
+
+func NewCookieConfig() (CookieConfig, error) {
+	enabled := false
+	enabledStr := os.Getenv(refreshTokenCookieEnabledEnvName)
+	if enabledStr != "" {
+		var err error
+		enabled, err = strconv.ParseBool(enabledStr)
+		if err != nil {
+			return nil, errors.New("invalid refresh token cookie enabled flag")
+		}
+	}
+
+	refreshTokenName := os.Getenv(refreshTokenCookieNameEnvName)
+	if refreshTokenName == "" {
+		refreshTokenName = defaultRefreshTokenCookieName
+	}
+
+	csrfName := os.Getenv(csrfCookieNameEnvName)
+	if csrfName == "" {
+		csrfName = defaultCSRFCookieName
+	}
+
+	var sameSite http.SameSite
+	switch strings.ToLower(os.Getenv(refreshTokenCookieSameSiteEnvName)) {
+	case "", "strict":
+		sameSite = http.SameSiteStrictMode
+	case "lax":
+		sameSite = http.SameSiteLaxMode
+	case "none":
+		sameSite = http.SameSiteNoneMode
+	default:
+		return nil, errors.New("invalid refresh token cookie same site mode")
+	}
+
+	return &cookieConfig{
+		enabled:          enabled,
+		refreshTokenName: refreshTokenName,
+		csrfName:         csrfName,
+		domain:           os.Getenv(refreshTokenCookieDomainEnvName),
+		sameSite:         sameSite,
+	}, nil
+}
+
+func (cfg *cookieConfig) Enabled() bool {
+	return cfg.enabled
+}
+
+func (cfg *cookieConfig) RefreshTokenName() string {
+	return cfg.refreshTokenName
+}
+
+func (cfg *cookieConfig) CSRFName() string {
+	return cfg.csrfName
+}
+
+func (cfg *cookieConfig) Domain() string {
+	return cfg.domain
+}
+
+func (cfg *cookieConfig) SameSite() http.SameSite {
+	return cfg.sameSite
+}
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	deviceCodeExpirationEnvName   = "DEVICE_CODE_EXPIRATION"
+	deviceCodePollIntervalEnvName = "DEVICE_CODE_POLL_INTERVAL"
+	deviceVerificationURIEnvName  = "DEVICE_VERIFICATION_URI"
+)
+
+type DeviceConfig interface {
+	CodeExpiration() time.Duration
+	PollInterval() time.Duration
+	VerificationURI() string
+}
+
+type deviceConfig struct {
+	codeExpiration  time.Duration
+	pollInterval    time.Duration
+	verificationURI string
+}
+
+func NewDeviceConfig() (DeviceConfig, error) {
+	codeExpirationStr := os.Getenv(deviceCodeExpirationEnvName)
+	if codeExpirationStr == "" {
+		return nil, errors.New("device code expiration not found")
+	}
+	codeExpiration, err := time.ParseDuration(codeExpirationStr)
+	if err != nil {
+		return nil, errors.New("invalid device code expiration")
+	}
+
+	pollIntervalStr := os.Getenv(deviceCodePollIntervalEnvName)
+	if pollIntervalStr == "" {
+		return nil, errors.New("device code poll interval not found")
+	}
+	pollInterval, err := time.ParseDuration(pollIntervalStr)
+	if err != nil {
+		return nil, errors.New("invalid device code poll interval")
+	}
+
+	verificationURI := os.Getenv(deviceVerificationURIEnvName)
+	if verificationURI == "" {
+		return nil, errors.New("device verification uri not found")
+	}
+
+	return &deviceConfig{
+		codeExpiration:  codeExpiration,
+		pollInterval:    pollInterval,
+		verificationURI: verificationURI,
+	}, nil
+}
+
+func (cfg *deviceConfig) CodeExpiration() time.Duration {
+	return cfg.codeExpiration
+}
+
+func (cfg *deviceConfig) PollInterval() time.Duration {
+	return cfg.pollInterval
+}
+
+func (cfg *deviceConfig) VerificationURI() string {
+	return cfg.verificationURI
+}
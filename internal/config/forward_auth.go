@@ -0,0 +1,84 @@
+package config
+
+import (
+	"net/netip"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	forwardAuthCacheTTLEnvName       = "FORWARD_AUTH_CACHE_TTL"
+	forwardAuthCacheSizeEnvName      = "FORWARD_AUTH_CACHE_SIZE"
+	forwardAuthTrustedProxiesEnvName = "FORWARD_AUTH_TRUSTED_PROXIES"
+)
+
+type ForwardAuthConfig interface {
+	// CacheTTL is how long a decision of the forward-auth endpoint is reused for the same token
+	// and request, zero disables the cache. Revoked tokens and policy changes take effect only
+	// once cached decisions expire.
+	CacheTTL() time.Duration
+	// CacheSize bounds the number of cached decisions.
+	CacheSize() int
+	// TrustedProxies are the networks of the proxies whose X-Forwarded-For entries are trusted,
+	// the client address is the rightmost entry not added by one of them.
+	TrustedProxies() []netip.Prefix
+}
+
+type forwardAuthConfig struct {
+	cacheTTL       time.Duration
+	cacheSize      int
+	trustedProxies []netip.Prefix
+}
+
+func NewForwardAuthConfig() (ForwardAuthConfig, error) {
+	var cacheTTL time.Duration
+	if cacheTTLStr := os.Getenv(forwardAuthCacheTTLEnvName); cacheTTLStr != "" {
+		var err error
+		cacheTTL, err = time.ParseDuration(cacheTTLStr)
+		if err != nil || cacheTTL < 0 {
+			return nil, errors.New("invalid forward auth cache ttl")
+		}
+	}
+
+	cacheSize := 10000
+	if cacheSizeStr := os.Getenv(forwardAuthCacheSizeEnvName); cacheSizeStr != "" {
+		var err error
+		cacheSize, err = strconv.Atoi(cacheSizeStr)
+		if err != nil || cacheSize <= 0 {
+			return nil, errors.New("invalid forward auth cache size")
+		}
+	}
+
+	var trustedProxies []netip.Prefix
+	if trustedProxiesStr := os.Getenv(forwardAuthTrustedProxiesEnvName); trustedProxiesStr != "" {
+		for _, item := range strings.Split(trustedProxiesStr, ",") {
+			prefix, err := netip.ParsePrefix(strings.TrimSpace(item))
+			if err != nil {
+				return nil, errors.Errorf("invalid forward auth trusted proxy %q", item)
+			}
+			trustedProxies = append(trustedProxies, prefix.Masked())
+		}
+	}
+
+	return &forwardAuthConfig{
+		cacheTTL:       cacheTTL,
+		cacheSize:      cacheSize,
+		trustedProxies: trustedProxies,
+	}, nil
+}
+
+func (cfg *forwardAuthConfig) CacheTTL() time.Duration {
+	return cfg.cacheTTL
+}
+
+func (cfg *forwardAuthConfig) CacheSize() int {
+	return cfg.cacheSize
+}
+
+func (cfg *forwardAuthConfig) TrustedProxies() []netip.Prefix {
+	return cfg.trustedProxies
+}
@@ -0,0 +1,109 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	identityProvidersConfigEnvName = "IDENTITY_PROVIDERS_CONFIG"
+	federatedStateSecretKeyEnvName = "FEDERATED_STATE_SECRET_KEY"
+
+	IdentityProviderTypeOIDC   = "oidc"
+	IdentityProviderTypeGitHub = "github"
+)
+
+// RoleMappingRule assigns Role to users whose Claim equals Value or, for list claims, contains it.
+type RoleMappingRule struct {
+	Claim string `json:"claim"`
+	Value string `json:"value"`
+	Role  string `json:"role"`
+}
+
+type IdentityProviderConfig struct {
+	Name         string            `json:"name"`
+	Type         string            `json:"type"`
+	Issuer       string            `json:"issuer"`
+	AuthURL      string            `json:"auth_url"`
+	TokenURL     string            `json:"token_url"`
+	APIURL       string            `json:"api_url"`
+	ClientID     string            `json:"client_id"`
+	ClientSecret string            `json:"client_secret"`
+	RedirectURL  string            `json:"redirect_url"`
+	Scopes       []string          `json:"scopes"`
+	DefaultRole  string            `json:"default_role"`
+	RoleMapping  []RoleMappingRule `json:"role_mapping"`
+}
+
+type IdentityProvidersConfig interface {
+	Providers() []IdentityProviderConfig
+	// StateSecretKey signs the state passed through the identity providers, it is not used
+	// for any other token.
+	StateSecretKey() string
+}
+
+type identityProvidersConfig struct {
+	providers      []IdentityProviderConfig
+	stateSecretKey string
+}
+
+// NewIdentityProvidersConfig reads upstream identity providers from the JSON file set in
+// IDENTITY_PROVIDERS_CONFIG. Environment variables in the file are expanded, so client
+// secrets can be kept out of it. Federated login is disabled when the variable is not set.
+func NewIdentityProvidersConfig() (IdentityProvidersConfig, error) {
+	path := os.Getenv(identityProvidersConfigEnvName)
+	if path == "" {
+		return &identityProvidersConfig{}, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read identity providers config")
+	}
+
+	stateSecretKey := os.Getenv(federatedStateSecretKeyEnvName)
+	if stateSecretKey == "" {
+		return nil, errors.New("federated state secret key not found")
+	}
+
+	var providers []IdentityProviderConfig
+	if err = json.Unmarshal([]byte(os.ExpandEnv(string(data))), &providers); err != nil {
+		return nil, errors.Wrap(err, "invalid identity providers config")
+	}
+
+	names := make(map[string]struct{}, len(providers))
+	for _, provider := range providers {
+		if provider.Name == "" {
+			return nil, errors.New("identity provider name not found")
+		}
+		if _, ok := names[provider.Name]; ok {
+			return nil, errors.Errorf("duplicate identity provider %q", provider.Name)
+		}
+		names[provider.Name] = struct{}{}
+
+		switch provider.Type {
+		case IdentityProviderTypeOIDC:
+			if provider.Issuer == "" {
+				return nil, errors.Errorf("identity provider %q issuer not found", provider.Name)
+			}
+		case IdentityProviderTypeGitHub:
+		default:
+			return nil, errors.Errorf("identity provider %q has unknown type %q", provider.Name, provider.Type)
+		}
+	}
+
+	return &identityProvidersConfig{
+		providers:      providers,
+		stateSecretKey: stateSecretKey,
+	}, nil
+}
+
+func (cfg *identityProvidersConfig) Providers() []IdentityProviderConfig {
+	return cfg.providers
+}
+
+func (cfg *identityProvidersConfig) StateSecretKey() string {
+	return cfg.stateSecretKey
+}
@@ -0,0 +1,180 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	ldapURLEnvName            = "LDAP_URL"
+	ldapBindDNEnvName         = "LDAP_BIND_DN"
+	ldapBindPasswordEnvName   = "LDAP_BIND_PASSWORD"
+	ldapBaseDNEnvName         = "LDAP_BASE_DN"
+	ldapUserFilterEnvName     = "LDAP_USER_FILTER"
+	ldapEmailAttributeEnvName = "LDAP_EMAIL_ATTRIBUTE"
+	ldapNameAttributeEnvName  = "LDAP_NAME_ATTRIBUTE"
+	ldapGroupAttributeEnvName = "LDAP_GROUP_ATTRIBUTE"
+	ldapRoleMappingEnvName    = "LDAP_ROLE_MAPPING"
+	ldapSyncUsersEnvName      = "LDAP_SYNC_USERS"
+	ldapTimeoutEnvName        = "LDAP_TIMEOUT"
+)
+
+type LDAPConfig interface {
+	URL() string
+	BindDN() string
+	BindPassword() string
+	BaseDN() string
+	// UserFilter is a search filter with a single %s replaced by the escaped login.
+	UserFilter() string
+	EmailAttribute() string
+	NameAttribute() string
+	GroupAttribute() string
+	// RoleMapping maps group DNs to roles, the first group of the user found in the mapping wins.
+	RoleMapping() []RoleMappingRule
+	SyncUsers() bool
+	Timeout() time.Duration
+}
+
+type ldapConfig struct {
+	url            string
+	bindDN         string
+	bindPassword   string
+	baseDN         string
+	userFilter     string
+	emailAttribute string
+	nameAttribute  string
+	groupAttribute string
+	roleMapping    []RoleMappingRule
+	syncUsers      bool
+	timeout        time.Duration
+}
+
+func NewLDAPConfig() (LDAPConfig, error) {
+	url := os.Getenv(ldapURLEnvName)
+	if url == "" {
+		return nil, errors.New("ldap url not found")
+	}
+
+	baseDN := os.Getenv(ldapBaseDNEnvName)
+	if baseDN == "" {
+		return nil, errors.New("ldap base dn not found")
+	}
+
+	userFilter := os.Getenv(ldapUserFilterEnvName)
+	if userFilter == "" {
+		return nil, errors.New("ldap user filter not found")
+	}
+	if strings.Count(userFilter, "%s") != 1 {
+		return nil, errors.New("ldap user filter must contain exactly one %s")
+	}
+
+	emailAttribute := os.Getenv(ldapEmailAttributeEnvName)
+	if emailAttribute == "" {
+		emailAttribute = "mail"
+	}
+	nameAttribute := os.Getenv(ldapNameAttributeEnvName)
+	if nameAttribute == "" {
+		nameAttribute = "cn"
+	}
+	groupAttribute := os.Getenv(ldapGroupAttributeEnvName)
+	if groupAttribute == "" {
+		groupAttribute = "memberOf"
+	}
+
+	// LDAP_ROLE_MAPPING=cn=admins,ou=groups,dc=example,dc=org:admin;cn=staff,ou=groups,dc=example,dc=org:user
+	var roleMapping []RoleMappingRule
+	for _, item := range strings.Split(os.Getenv(ldapRoleMappingEnvName), ";") {
+		if strings.TrimSpace(item) == "" {
+			continue
+		}
+		idx := strings.LastIndex(item, ":")
+		if idx <= 0 || idx == len(item)-1 {
+			return nil, errors.Errorf("invalid ldap role mapping %q", item)
+		}
+		roleMapping = append(roleMapping, RoleMappingRule{
+			Claim: groupAttribute,
+			Value: strings.TrimSpace(item[:idx]),
+			Role:  strings.TrimSpace(item[idx+1:]),
+		})
+	}
+
+	syncUsers := false
+	if syncUsersStr := os.Getenv(ldapSyncUsersEnvName); syncUsersStr != "" {
+		var err error
+		syncUsers, err = strconv.ParseBool(syncUsersStr)
+		if err != nil {
+			return nil, errors.New("invalid ldap sync users flag")
+		}
+	}
+
+	timeout := 5 * time.Second
+	if timeoutStr := os.Getenv(ldapTimeoutEnvName); timeoutStr != "" {
+		var err error
+		timeout, err = time.ParseDuration(timeoutStr)
+		if err != nil {
+			return nil, errors.New("invalid ldap timeout")
+		}
+	}
+
+	return &ldapConfig{
+		url:            url,
+		bindDN:         os.Getenv(ldapBindDNEnvName),
+		bindPassword:   os.Getenv(ldapBindPasswordEnvName),
+		baseDN:         baseDN,
+		userFilter:     userFilter,
+		emailAttribute: emailAttribute,
+		nameAttribute:  nameAttribute,
+		groupAttribute: groupAttribute,
+		roleMapping:    roleMapping,
+		syncUsers:      syncUsers,
+		timeout:        timeout,
+	}, nil
+}
+
+func (cfg *ldapConfig) URL() string {
+	return cfg.url
+}
+
+func (cfg *ldapConfig) BindDN() string {
+	return cfg.bindDN
+}
+
+func (cfg *ldapConfig) BindPassword() string {
+	return cfg.bindPassword
+}
+
+func (cfg *ldapConfig) BaseDN() string {
+	return cfg.baseDN
+}
+
+func (cfg *ldapConfig) UserFilter() string {
+	return cfg.userFilter
+}
+
+func (cfg *ldapConfig) EmailAttribute() string {
+	return cfg.emailAttribute
+}
+
+func (cfg *ldapConfig) NameAttribute() string {
+	return cfg.nameAttribute
+}
+
+func (cfg *ldapConfig) GroupAttribute() string {
+	return cfg.groupAttribute
+}
+
+func (cfg *ldapConfig) RoleMapping() []RoleMappingRule {
+	return cfg.roleMapping
+}
+
+func (cfg *ldapConfig) SyncUsers() bool {
+	return cfg.syncUsers
+}
+
+func (cfg *ldapConfig) Timeout() time.Duration {
+	return cfg.timeout
+}
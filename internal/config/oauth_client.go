@@ -0,0 +1,67 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	oauthClientsConfigEnvName = "OAUTH_CLIENTS_CONFIG"
+)
+
+// OAuthClientConfig is an application users log in to, tokens issued to it carry at most Scopes.
+type OAuthClientConfig struct {
+	ClientID string   `json:"client_id"`
+	Scopes   []string `json:"scopes"`
+}
+
+type OAuthClientsConfig interface {
+	Clients() []OAuthClientConfig
+}
+
+type oauthClientsConfig struct {
+	clients []OAuthClientConfig
+}
+
+// NewOAuthClientsConfig reads the registered clients from the JSON file set in
+// OAUTH_CLIENTS_CONFIG. Only first-party logins are allowed when the variable is not set.
+func NewOAuthClientsConfig() (OAuthClientsConfig, error) {
+	path := os.Getenv(oauthClientsConfigEnvName)
+	if path == "" {
+		return &oauthClientsConfig{}, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read oauth clients config")
+	}
+
+	var clients []OAuthClientConfig
+	if err = json.Unmarshal(data, &clients); err != nil {
+		return nil, errors.Wrap(err, "invalid oauth clients config")
+	}
+
+	ids := make(map[string]struct{}, len(clients))
+	for i, client := range clients {
+		if client.ClientID == "" {
+			return nil, errors.New("oauth client id not found")
+		}
+		if _, ok := ids[client.ClientID]; ok {
+			return nil, errors.Errorf("duplicate oauth client %q", client.ClientID)
+		}
+		ids[client.ClientID] = struct{}{}
+		// A client registered without scopes may not have any.
+		if client.Scopes == nil {
+			clients[i].Scopes = []string{}
+		}
+	}
+
+	return &oauthClientsConfig{clients: clients}, nil
+}
+
+// Clients returns the registered clients, none when OAUTH_CLIENTS_CONFIG is not set.
+func (cfg *oauthClientsConfig) Clients() []OAuthClientConfig {
+	return cfg.clients
+}
@@ -0,0 +1,73 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	policyReloadIntervalEnvName = "POLICY_RELOAD_INTERVAL"
+	policyFileEnvName           = "POLICY_FILE"
+	policyFileSyncEnvName       = "POLICY_FILE_SYNC"
+)
+
+type PolicyConfig interface {
+	// ReloadInterval is how often the cached policy is fully reloaded in case a notification was lost.
+	ReloadInterval() time.Duration
+	// File is the path to a YAML or JSON policy file the stored policy is compared with,
+	// empty when the policy is not kept in a file.
+	File() string
+	// SyncOnStartup applies the policy file on startup, otherwise differences are only reported.
+	SyncOnStartup() bool
+}
+
+type policyConfig struct {
+	reloadInterval time.Duration
+	file           string
+	syncOnStartup  bool
+}
+
+func NewPolicyConfig() (PolicyConfig, error) {
+	reloadInterval := time.Minute
+	if reloadIntervalStr := os.Getenv(policyReloadIntervalEnvName); reloadIntervalStr != "" {
+		var err error
+		reloadInterval, err = time.ParseDuration(reloadIntervalStr)
+		if err != nil || reloadInterval <= 0 {
+			return nil, errors.New("invalid policy reload interval")
+		}
+	}
+
+	syncOnStartup := false
+	if syncStr := os.Getenv(policyFileSyncEnvName); syncStr != "" {
+		var err error
+		syncOnStartup, err = strconv.ParseBool(syncStr)
+		if err != nil {
+			return nil, errors.New("invalid policy file sync flag")
+		}
+	}
+	file := os.Getenv(policyFileEnvName)
+	if syncOnStartup && file == "" {
+		return nil, errors.New("policy file sync requires a policy file")
+	}
+
+	return &policyConfig{
+		reloadInterval: reloadInterval,
+		file:           file,
+		syncOnStartup:  syncOnStartup,
+	}, nil
+}
+
+func (cfg *policyConfig) ReloadInterval() time.Duration {
+	return cfg.reloadInterval
+}
+
+func (cfg *policyConfig) File() string {
+	return cfg.file
+}
+
+func (cfg *policyConfig) SyncOnStartup() bool {
+	return cfg.syncOnStartup
+}
@@ -0,0 +1,75 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	relationNamespacesConfigEnvName = "RELATION_NAMESPACES_CONFIG"
+)
+
+// RelationRewriteConfig is one operand of the union a relation is computed from, exactly one
+// of the fields is set:
+//   - This: subjects of the tuples stored for the relation;
+//   - ComputedUserset: subjects having another relation to the same object;
+//   - TupleToUserset: subjects having ComputedUserset to the objects found in the
+//     Tupleset relation of the object, e.g. viewers of the parent folder.
+type RelationRewriteConfig struct {
+	This            bool                  `json:"this"`
+	ComputedUserset string                `json:"computed_userset"`
+	TupleToUserset  *TupleToUsersetConfig `json:"tuple_to_userset"`
+}
+
+type TupleToUsersetConfig struct {
+	Tupleset        string `json:"tupleset"`
+	ComputedUserset string `json:"computed_userset"`
+}
+
+// RelationDefinitionConfig defines a relation, a relation without Union holds only its stored tuples.
+type RelationDefinitionConfig struct {
+	Name  string                  `json:"name"`
+	Union []RelationRewriteConfig `json:"union"`
+}
+
+type RelationNamespaceConfig struct {
+	Name      string                     `json:"name"`
+	Relations []RelationDefinitionConfig `json:"relations"`
+}
+
+type RelationNamespacesConfig interface {
+	Namespaces() []RelationNamespaceConfig
+}
+
+type relationNamespacesConfig struct {
+	namespaces []RelationNamespaceConfig
+}
+
+// NewRelationNamespacesConfig reads relation namespaces from the JSON file set in
+// RELATION_NAMESPACES_CONFIG. No relation tuples can be written when the variable is not set.
+func NewRelationNamespacesConfig() (RelationNamespacesConfig, error) {
+	path := os.Getenv(relationNamespacesConfigEnvName)
+	if path == "" {
+		return &relationNamespacesConfig{}, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read relation namespaces config")
+	}
+
+	var namespaces []RelationNamespaceConfig
+	if err = json.Unmarshal(data, &namespaces); err != nil {
+		return nil, errors.Wrap(err, "invalid relation namespaces config")
+	}
+
+	return &relationNamespacesConfig{
+		namespaces: namespaces,
+	}, nil
+}
+
+func (cfg *relationNamespacesConfig) Namespaces() []RelationNamespaceConfig {
+	return cfg.namespaces
+}
@@ -0,0 +1,85 @@
+package config
+
+import (
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	roleRequestApproverGroupEnvName = "ROLE_REQUEST_APPROVER_GROUP"
+	roleRequestMaxDurationEnvName   = "ROLE_REQUEST_MAX_DURATION"
+	roleRequestRolesEnvName         = "ROLE_REQUEST_ROLES"
+	roleGrantExpiryIntervalEnvName  = "ROLE_GRANT_EXPIRY_INTERVAL"
+)
+
+type RoleRequestConfig interface {
+	// ApproverGroup is the name of the group whose members decide on role requests,
+	// members of the groups nested in it decide as well. Empty disables approvals.
+	ApproverGroup() string
+	// MaxDuration bounds how long a requested role may be granted for.
+	MaxDuration() time.Duration
+	// RequestableRoles are the roles users may request, none when not configured.
+	RequestableRoles() []string
+	// ExpiryInterval is how often expired role grants are removed.
+	ExpiryInterval() time.Duration
+}
+
+type roleRequestConfig struct {
+	approverGroup    string
+	maxDuration      time.Duration
+	requestableRoles []string
+	expiryInterval   time.Duration
+}
+
+func NewRoleRequestConfig() (RoleRequestConfig, error) {
+	maxDuration := 8 * time.Hour
+	if maxDurationStr := os.Getenv(roleRequestMaxDurationEnvName); maxDurationStr != "" {
+		var err error
+		maxDuration, err = time.ParseDuration(maxDurationStr)
+		if err != nil || maxDuration <= 0 {
+			return nil, errors.New("invalid role request max duration")
+		}
+	}
+
+	var requestableRoles []string
+	for _, role := range strings.Split(os.Getenv(roleRequestRolesEnvName), ",") {
+		if role = strings.TrimSpace(role); role != "" {
+			requestableRoles = append(requestableRoles, role)
+		}
+	}
+
+	expiryInterval := time.Minute
+	if expiryIntervalStr := os.Getenv(roleGrantExpiryIntervalEnvName); expiryIntervalStr != "" {
+		var err error
+		expiryInterval, err = time.ParseDuration(expiryIntervalStr)
+		if err != nil || expiryInterval <= 0 {
+			return nil, errors.New("invalid role grant expiry interval")
+		}
+	}
+
+	return &roleRequestConfig{
+		approverGroup:    os.Getenv(roleRequestApproverGroupEnvName),
+		maxDuration:      maxDuration,
+		requestableRoles: requestableRoles,
+		expiryInterval:   expiryInterval,
+	}, nil
+}
+
+func (cfg *roleRequestConfig) ApproverGroup() string {
+	return cfg.approverGroup
+}
+
+func (cfg *roleRequestConfig) MaxDuration() time.Duration {
+	return cfg.maxDuration
+}
+
+func (cfg *roleRequestConfig) RequestableRoles() []string {
+	return cfg.requestableRoles
+}
+
+func (cfg *roleRequestConfig) ExpiryInterval() time.Duration {
+	return cfg.expiryInterval
+}
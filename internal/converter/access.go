@@ -0,0 +1,139 @@
+package converter
+
+import (
+	"strings"
+
+	"github.com/arifullov/auth/internal/model"
+	desc "github.com/arifullov/auth/pkg/access_v1"
+)
+
+func ToAccessRequestFromDesc(req *desc.CheckRequest) *model.AccessRequest {
+	headers := make(map[string]string, len(req.GetHeaders()))
+	for name, value := range req.GetHeaders() {
+		headers[strings.ToLower(name)] = value
+	}
+	return &model.AccessRequest{
+		EndpointAddress: req.GetEndpointAddress(),
+		Method:          req.GetMethod(),
+		Audience:        req.GetAudience(),
+		Fields:          model.MapRequestFields(req.GetFields()),
+		IP:              req.GetIp(),
+		Headers:         headers,
+		Tenant:          req.GetTenant(),
+	}
+}
+
+func ToAccessRequestsFromDesc(checks []*desc.CheckRequest) []model.AccessRequest {
+	reqs := make([]model.AccessRequest, 0, len(checks))
+	for _, check := range checks {
+		reqs = append(reqs, *ToAccessRequestFromDesc(check))
+	}
+	return reqs
+}
+
+var denyReasons = map[model.DenyReason]desc.DenyReason{
+	model.DenyReasonNoMatchingRule:    desc.DenyReason_NO_MATCHING_RULE,
+	model.DenyReasonDenyRule:          desc.DenyReason_DENY_RULE,
+	model.DenyReasonRoleNotGranted:    desc.DenyReason_ROLE_NOT_GRANTED,
+	model.DenyReasonInvalidAudience:   desc.DenyReason_INVALID_AUDIENCE,
+	model.DenyReasonTenantMismatch:    desc.DenyReason_TENANT_MISMATCH,
+	model.DenyReasonInsufficientScope: desc.DenyReason_INSUFFICIENT_SCOPE,
+}
+
+func ToBatchCheckResponseFromService(decisions []model.AccessDecision, explain bool) *desc.BatchCheckResponse {
+	results := make([]*desc.CheckResult, 0, len(decisions))
+	for _, decision := range decisions {
+		result := &desc.CheckResult{
+			Allowed: decision.Allowed,
+		}
+		if explain {
+			result.Explanation = toExplanationFromService(decision)
+		}
+		results = append(results, result)
+	}
+	return &desc.BatchCheckResponse{
+		Results: results,
+	}
+}
+
+func toExplanationFromService(decision model.AccessDecision) *desc.Explanation {
+	roles := make([]string, 0, len(decision.Roles))
+	for _, role := range decision.Roles {
+		roles = append(roles, string(role))
+	}
+	explanation := &desc.Explanation{
+		Roles:         roles,
+		Reason:        denyReasons[decision.Reason],
+		MissingScopes: decision.MissingScopes,
+	}
+	if rule := decision.Rule; rule != nil {
+		method := rule.Method
+		if method == model.AnyMethod {
+			method = ""
+		}
+		explanation.Rule = &desc.MatchedRule{
+			Id:     rule.ID,
+			Route:  rule.Route,
+			Method: method,
+			Role:   string(rule.Role),
+			Effect: string(rule.Effect),
+			Scopes: rule.Scopes,
+		}
+	}
+	return explanation
+}
+
+func ToRouteGrantFilterFromDesc(req *desc.ListAccessibleRoutesRequest) model.RouteGrantFilter {
+	return model.RouteGrantFilter{
+		RoutePrefix: req.GetRoutePrefix(),
+		Limit:       uint64(req.GetPageSize()),
+	}
+}
+
+func toRouteGrantFromService(grant model.RouteGrant) *desc.RouteGrant {
+	method := grant.Method
+	if method == model.AnyMethod {
+		method = ""
+	}
+	return &desc.RouteGrant{
+		Route:       grant.Route,
+		Method:      method,
+		RuleId:      grant.RuleID,
+		Role:        string(grant.Role),
+		Conditional: grant.Conditional,
+	}
+}
+
+func ToListAccessibleRoutesResponseFromService(page *model.RouteGrantPage) *desc.ListAccessibleRoutesResponse {
+	routes := make([]*desc.RouteGrant, 0, len(page.Grants))
+	for _, grant := range page.Grants {
+		routes = append(routes, toRouteGrantFromService(grant))
+	}
+	return &desc.ListAccessibleRoutesResponse{
+		Routes:        routes,
+		NextPageToken: page.NextPageToken,
+	}
+}
+
+func ToListPrincipalsForRouteResponseFromService(principals *model.RoutePrincipals) *desc.ListPrincipalsForRouteResponse {
+	roles := make([]*desc.RolePrincipal, 0, len(principals.Roles))
+	for _, role := range principals.Roles {
+		roles = append(roles, &desc.RolePrincipal{
+			Role:  string(role.Role),
+			Grant: toRouteGrantFromService(role.Grant),
+		})
+	}
+	users := make([]*desc.UserPrincipal, 0, len(principals.Users))
+	for _, user := range principals.Users {
+		users = append(users, &desc.UserPrincipal{
+			UserId: user.UserID,
+			Name:   user.Name,
+			Grant:  toRouteGrantFromService(user.Grant),
+		})
+	}
+	return &desc.ListPrincipalsForRouteResponse{
+		Roles:         roles,
+		Users:         users,
+		NextPageToken: principals.NextPageToken,
+	}
+}
@@ -0,0 +1,83 @@
+package converter
+
+import (
+	"strings"
+
+	"github.com/arifullov/auth/internal/model"
+	desc "github.com/arifullov/auth/pkg/access_admin_v1"
+)
+
+func ToRouteRuleFromDesc(id int64, info *desc.RouteRuleInfo) *model.RouteRule {
+	effect := model.AllowEffect
+	if info.GetEffect() == desc.RouteEffect_DENY {
+		effect = model.DenyEffect
+	}
+	return &model.RouteRule{
+		ID:         id,
+		Route:      info.GetRoute(),
+		Method:     info.GetMethod(),
+		Role:       model.Role(info.GetRole()),
+		Effect:     effect,
+		OwnerField: info.GetOwnerField(),
+		Condition:  info.GetCondition(),
+		Scopes:     info.GetScopes(),
+	}
+}
+
+func ToRouteRulesFromDesc(infos []*desc.RouteRuleInfo) []model.RouteRule {
+	rules := make([]model.RouteRule, 0, len(infos))
+	for _, info := range infos {
+		rules = append(rules, *ToRouteRuleFromDesc(0, info))
+	}
+	return rules
+}
+
+func ToRouteRuleFromService(rule *model.RouteRule) *desc.RouteRule {
+	effect := desc.RouteEffect_ALLOW
+	if rule.Effect == model.DenyEffect {
+		effect = desc.RouteEffect_DENY
+	}
+	method := rule.Method
+	if method == model.AnyMethod {
+		method = ""
+	}
+	return &desc.RouteRule{
+		Id: rule.ID,
+		Info: &desc.RouteRuleInfo{
+			Route:      rule.Route,
+			Method:     method,
+			Role:       string(rule.Role),
+			Effect:     effect,
+			OwnerField: rule.OwnerField,
+			Condition:  rule.Condition,
+			Scopes:     rule.Scopes,
+		},
+	}
+}
+
+func ToRouteRuleFilterFromDesc(req *desc.ListRouteRulesRequest) model.RouteRuleFilter {
+	return model.RouteRuleFilter{
+		RoutePrefix: req.GetRoutePrefix(),
+		Role:        model.Role(strings.TrimSpace(req.GetRole())),
+		Limit:       uint64(req.GetPageSize()),
+	}
+}
+
+func ToListRouteRulesResponseFromService(page *model.RouteRulePage) *desc.ListRouteRulesResponse {
+	rules := make([]*desc.RouteRule, 0, len(page.Rules))
+	for i := range page.Rules {
+		rules = append(rules, ToRouteRuleFromService(&page.Rules[i]))
+	}
+	return &desc.ListRouteRulesResponse{
+		Rules:         rules,
+		NextPageToken: page.NextPageToken,
+	}
+}
+
+func ToReplaceRouteRulesResponseFromService(result *model.ReplaceResult) *desc.ReplaceRouteRulesResponse {
+	return &desc.ReplaceRouteRulesResponse{
+		Created: result.Created,
+		Updated: result.Updated,
+		Deleted: result.Deleted,
+	}
+}
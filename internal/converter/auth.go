@@ -0,0 +1,57 @@
+package converter
+
+import (
+	"strings"
+
+	"github.com/arifullov/auth/internal/model"
+	desc "github.com/arifullov/auth/pkg/auth_v1"
+)
+
+const (
+	bearerTokenType = "Bearer"
+)
+
+func ToTokenExchangeFromDesc(req *desc.ExchangeTokenRequest) *model.TokenExchange {
+	return &model.TokenExchange{
+		GrantType:          req.GetGrantType(),
+		SubjectToken:       req.GetSubjectToken(),
+		SubjectTokenType:   req.GetSubjectTokenType(),
+		ActorToken:         req.GetActorToken(),
+		ActorTokenType:     req.GetActorTokenType(),
+		RequestedTokenType: req.GetRequestedTokenType(),
+		Audience:           req.GetAudience(),
+		Scopes:             strings.Fields(req.GetScope()),
+		Tenant:             req.GetTenant(),
+		Roles:              ToRolesFromDesc(req.GetRoles()),
+	}
+}
+
+func ToExchangeTokenResponseFromService(token *model.ExchangedToken) *desc.ExchangeTokenResponse {
+	return &desc.ExchangeTokenResponse{
+		AccessToken:     token.AccessToken,
+		IssuedTokenType: token.IssuedTokenType,
+		TokenType:       bearerTokenType,
+		ExpiresIn:       int64(token.ExpiresIn.Seconds()),
+		Scope:           strings.Join(token.Scopes, " "),
+	}
+}
+
+func ToDeviceAuthorizeResponseFromService(authorization *model.DeviceAuthorization) *desc.DeviceAuthorizeResponse {
+	return &desc.DeviceAuthorizeResponse{
+		DeviceCode:              authorization.DeviceCode,
+		UserCode:                authorization.UserCode,
+		VerificationUri:         authorization.VerificationURI,
+		VerificationUriComplete: authorization.VerificationURIComplete,
+		ExpiresIn:               int64(authorization.ExpiresIn.Seconds()),
+		Interval:                int64(authorization.Interval.Seconds()),
+	}
+}
+
+func ToDeviceTokenResponseFromService(tokens *model.TokenPair) *desc.DeviceTokenResponse {
+	return &desc.DeviceTokenResponse{
+		RefreshToken: tokens.RefreshToken,
+		AccessToken:  tokens.AccessToken,
+		TokenType:    bearerTokenType,
+		ExpiresIn:    int64(tokens.ExpiresIn.Seconds()),
+	}
+}
@@ -0,0 +1,50 @@
+package converter
+
+import (
+	"strings"
+
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+
+	"github.com/arifullov/auth/internal/model"
+)
+
+const (
+	// audienceExtension is the context extension naming the service behind the Envoy route,
+	// set per route in the ext_authz filter config. Without it exchanged tokens, which are
+	// issued for a service, are not accepted.
+	audienceExtension = "audience"
+
+	tenantHeader = "x-tenant"
+)
+
+// ToAccessRequestFromCheckRequest maps the HTTP request seen by Envoy onto an access request,
+// it fails when the path is not canonical. Ownership rules take the owner from the path
+// parameters, never from the query string.
+func ToAccessRequestFromCheckRequest(req *authv3.CheckRequest) (*model.AccessRequest, error) {
+	attrs := req.GetAttributes()
+	httpReq := attrs.GetRequest().GetHttp()
+
+	// The path is the request target, with the query string unless Envoy sends it apart.
+	rawPath, _, _ := strings.Cut(httpReq.GetPath(), "?")
+	requestPath, err := canonicalPath(rawPath)
+	if err != nil {
+		return nil, err
+	}
+	// Envoy sends the header names in lower case.
+	headers := make(map[string]string, len(model.ConditionHeaders))
+	for _, name := range model.ConditionHeaders {
+		if value, ok := httpReq.GetHeaders()[name]; ok {
+			headers[name] = value
+		}
+	}
+
+	return &model.AccessRequest{
+		EndpointAddress: requestPath,
+		Method:          httpReq.GetMethod(),
+		Audience:        attrs.GetContextExtensions()[audienceExtension],
+		PathParams:      true,
+		IP:              attrs.GetSource().GetAddress().GetSocketAddress().GetAddress(),
+		Headers:         headers,
+		Tenant:          headers[tenantHeader],
+	}, nil
+}
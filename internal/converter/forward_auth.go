@@ -0,0 +1,112 @@
+package converter
+
+import (
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+)
+
+// Headers set by proxies asking the forward-auth endpoint about a request, X-Forwarded-* by
+// Traefik and X-Original-* by the usual nginx auth_request configuration.
+const (
+	forwardedURIHeader    = "X-Forwarded-Uri"
+	originalURIHeader     = "X-Original-Uri"
+	forwardedMethodHeader = "X-Forwarded-Method"
+	originalMethodHeader  = "X-Original-Method"
+	forwardedForHeader    = "X-Forwarded-For"
+
+	audienceParam = "audience"
+)
+
+// ToAccessRequestFromForwardedRequest maps the request a proxy asks about onto an access
+// request, it fails when the proxy does not pass the original URI or its path is not canonical.
+// Ownership rules take the owner from the path parameters, never from the query string. The
+// audience is a query parameter of the endpoint, e.g. /forward-auth?audience=orders.
+func ToAccessRequestFromForwardedRequest(r *http.Request, trustedProxies []netip.Prefix) (*model.AccessRequest, error) {
+	uri := r.Header.Get(forwardedURIHeader)
+	if uri == "" {
+		uri = r.Header.Get(originalURIHeader)
+	}
+	if uri == "" {
+		return nil, sys.NewCommonError(codes.InvalidArgument, "X-Forwarded-Uri or X-Original-URI header is not provided")
+	}
+	rawPath, _, _ := strings.Cut(uri, "?")
+	requestPath, err := canonicalPath(rawPath)
+	if err != nil {
+		return nil, err
+	}
+
+	method := r.Header.Get(forwardedMethodHeader)
+	if method == "" {
+		method = r.Header.Get(originalMethodHeader)
+	}
+	if method == "" {
+		method = r.Method
+	}
+
+	headers := make(map[string]string, len(model.ConditionHeaders))
+	for _, name := range model.ConditionHeaders {
+		if value := r.Header.Get(name); value != "" {
+			headers[name] = value
+		}
+	}
+
+	return &model.AccessRequest{
+		EndpointAddress: requestPath,
+		Method:          method,
+		Audience:        r.URL.Query().Get(audienceParam),
+		PathParams:      true,
+		IP:              clientIP(r, trustedProxies),
+		Headers:         headers,
+		Tenant:          headers[tenantHeader],
+	}, nil
+}
+
+// clientIP returns the address of the client of the proxy. X-Forwarded-For is walked from the
+// right over the entries added by trusted proxies, entries further left may be set by the client.
+func clientIP(r *http.Request, trustedProxies []netip.Prefix) string {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+	trusted := func(ip string) bool {
+		addr, err := netip.ParseAddr(ip)
+		if err != nil {
+			return false
+		}
+		for _, prefix := range trustedProxies {
+			if prefix.Contains(addr.Unmap()) {
+				return true
+			}
+		}
+		return false
+	}
+
+	hops := strings.Split(strings.Join(r.Header.Values(forwardedForHeader), ","), ",")
+	for i := len(hops) - 1; i >= 0 && trusted(ip); i-- {
+		hop := strings.TrimSpace(hops[i])
+		if _, err := netip.ParseAddr(hop); err != nil {
+			break
+		}
+		ip = hop
+	}
+	return ip
+}
+
+// canonicalPath returns the path of an HTTP request when upstreams resolve it to the same
+// path. Escaped characters, dot segments and repeated slashes are denied: such a path could
+// match the rules of one route and reach another one, e.g. /public/../admin.
+func canonicalPath(rawPath string) (string, error) {
+	unescaped, err := url.PathUnescape(rawPath)
+	if err != nil || path.Clean(unescaped) != rawPath {
+		return "", sys.NewCommonError(codes.PermissionDenied, "request path is not canonical")
+	}
+	return rawPath, nil
+}
@@ -0,0 +1,56 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/arifullov/auth/internal/model"
+	desc "github.com/arifullov/auth/pkg/group_v1"
+)
+
+func ToCreateGroupFromDesc(req *desc.CreateGroupRequest) *model.CreateGroup {
+	return &model.CreateGroup{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Roles:       ToRolesFromDesc(req.GetRoles()),
+	}
+}
+
+func ToGroupFromService(group *model.Group) *desc.Group {
+	roles := make([]string, 0, len(group.Roles))
+	for _, role := range group.Roles {
+		roles = append(roles, string(role))
+	}
+	return &desc.Group{
+		Id:          group.ID,
+		Name:        group.Name,
+		Description: group.Description,
+		Roles:       roles,
+		ParentIds:   group.ParentIDs,
+		CreatedAt:   timestamppb.New(group.CreatedAt),
+	}
+}
+
+var roleSourceKinds = map[model.RoleSourceKind]desc.RoleSourceKind{
+	model.RoleSourceRole:         desc.RoleSourceKind_ROLE,
+	model.RoleSourceGroup:        desc.RoleSourceKind_GROUP,
+	model.RoleSourceOrganization: desc.RoleSourceKind_ORGANIZATION,
+}
+
+func ToEffectiveRolesFromService(roles []model.EffectiveRole) []*desc.EffectiveRole {
+	res := make([]*desc.EffectiveRole, 0, len(roles))
+	for _, role := range roles {
+		path := make([]*desc.RolePathStep, 0, len(role.Path))
+		for _, step := range role.Path {
+			path = append(path, &desc.RolePathStep{
+				Kind: roleSourceKinds[step.Kind],
+				Id:   step.ID,
+				Name: step.Name,
+			})
+		}
+		res = append(res, &desc.EffectiveRole{
+			Role: string(role.Role),
+			Path: path,
+		})
+	}
+	return res
+}
@@ -0,0 +1,28 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/arifullov/auth/internal/model"
+	desc "github.com/arifullov/auth/pkg/organization_v1"
+)
+
+func ToOrganizationFromDesc(req *desc.CreateOrganizationRequest) *model.Organization {
+	return &model.Organization{
+		Slug: req.GetSlug(),
+		Name: req.GetName(),
+	}
+}
+
+func ToOrganizationsFromService(orgs []model.Organization) []*desc.Organization {
+	res := make([]*desc.Organization, 0, len(orgs))
+	for _, org := range orgs {
+		res = append(res, &desc.Organization{
+			Id:        org.ID,
+			Slug:      org.Slug,
+			Name:      org.Name,
+			CreatedAt: timestamppb.New(org.CreatedAt),
+		})
+	}
+	return res
+}
@@ -0,0 +1,121 @@
+package converter
+
+import (
+	"github.com/arifullov/auth/internal/model"
+	desc "github.com/arifullov/auth/pkg/relation_v1"
+)
+
+func ToRelationObjectFromDesc(object *desc.RelationObject) model.RelationObject {
+	return model.RelationObject{
+		Namespace: object.GetNamespace(),
+		ID:        object.GetId(),
+	}
+}
+
+func ToRelationObjectFromService(object model.RelationObject) *desc.RelationObject {
+	return &desc.RelationObject{
+		Namespace: object.Namespace,
+		Id:        object.ID,
+	}
+}
+
+func ToRelationSubjectFromDesc(subject *desc.Subject) model.RelationSubject {
+	if set := subject.GetSet(); set != nil {
+		return model.RelationSubject{
+			Object:   ToRelationObjectFromDesc(set.GetObject()),
+			Relation: set.GetRelation(),
+		}
+	}
+	return model.RelationSubject{
+		UserID: subject.GetUserId(),
+	}
+}
+
+func ToRelationSubjectFromService(subject model.RelationSubject) *desc.Subject {
+	if subject.IsUser() {
+		return &desc.Subject{
+			Subject: &desc.Subject_UserId{UserId: subject.UserID},
+		}
+	}
+	return &desc.Subject{
+		Subject: &desc.Subject_Set{Set: &desc.SubjectSet{
+			Object:   ToRelationObjectFromService(subject.Object),
+			Relation: subject.Relation,
+		}},
+	}
+}
+
+func ToRelationTuplesFromDesc(tuples []*desc.RelationTuple) []model.RelationTuple {
+	res := make([]model.RelationTuple, 0, len(tuples))
+	for _, tuple := range tuples {
+		res = append(res, model.RelationTuple{
+			Object:   ToRelationObjectFromDesc(tuple.GetObject()),
+			Relation: tuple.GetRelation(),
+			Subject:  ToRelationSubjectFromDesc(tuple.GetSubject()),
+		})
+	}
+	return res
+}
+
+func ToRelationTuplesFromService(tuples []model.RelationTuple) []*desc.RelationTuple {
+	res := make([]*desc.RelationTuple, 0, len(tuples))
+	for _, tuple := range tuples {
+		res = append(res, &desc.RelationTuple{
+			Object:   ToRelationObjectFromService(tuple.Object),
+			Relation: tuple.Relation,
+			Subject:  ToRelationSubjectFromService(tuple.Subject),
+		})
+	}
+	return res
+}
+
+func ToRelationConsistencyFromDesc(consistency *desc.Consistency) model.RelationConsistency {
+	return model.RelationConsistency{
+		AtLeastAsFresh:  consistency.GetAtLeastAsFresh(),
+		AtExactSnapshot: consistency.GetAtExactSnapshot(),
+	}
+}
+
+func ToRelationTupleFilterFromDesc(req *desc.ReadTuplesRequest) model.RelationTupleFilter {
+	filter := model.RelationTupleFilter{
+		Namespace: req.GetNamespace(),
+		ObjectID:  req.GetObjectId(),
+		Relation:  req.GetRelation(),
+		Limit:     uint64(req.GetPageSize()),
+	}
+	if req.GetSubject() != nil {
+		subject := ToRelationSubjectFromDesc(req.GetSubject())
+		filter.Subject = &subject
+	}
+	return filter
+}
+
+func ToReadTuplesResponseFromService(page *model.RelationTuplePage) *desc.ReadTuplesResponse {
+	return &desc.ReadTuplesResponse{
+		Tuples:        ToRelationTuplesFromService(page.Tuples),
+		NextPageToken: page.NextPageToken,
+		ReadAt:        page.Token,
+	}
+}
+
+func ToExpandNodeFromService(node *model.RelationExpandNode) *desc.ExpandNode {
+	operation := desc.ExpandNode_UNION
+	if node.Operation == model.RelationLeaf {
+		operation = desc.ExpandNode_LEAF
+	}
+	subjects := make([]*desc.Subject, 0, len(node.Subjects))
+	for _, subject := range node.Subjects {
+		subjects = append(subjects, ToRelationSubjectFromService(subject))
+	}
+	children := make([]*desc.ExpandNode, 0, len(node.Children))
+	for _, child := range node.Children {
+		children = append(children, ToExpandNodeFromService(child))
+	}
+	return &desc.ExpandNode{
+		Operation: operation,
+		Object:    ToRelationObjectFromService(node.Object),
+		Relation:  node.Relation,
+		Subjects:  subjects,
+		Children:  children,
+	}
+}
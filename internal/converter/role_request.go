@@ -0,0 +1,67 @@
+package converter
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/arifullov/auth/internal/model"
+	desc "github.com/arifullov/auth/pkg/role_request_v1"
+)
+
+var roleRequestStatuses = map[model.RoleRequestStatus]desc.RoleRequestStatus{
+	model.RoleRequestPending:  desc.RoleRequestStatus_PENDING,
+	model.RoleRequestApproved: desc.RoleRequestStatus_APPROVED,
+	model.RoleRequestDenied:   desc.RoleRequestStatus_DENIED,
+	model.RoleRequestExpired:  desc.RoleRequestStatus_EXPIRED,
+}
+
+func ToCreateRoleRequestFromDesc(req *desc.RequestRoleRequest) *model.CreateRoleRequest {
+	return &model.CreateRoleRequest{
+		Role:          model.Role(req.GetRole()),
+		Justification: req.GetJustification(),
+		Duration:      time.Duration(req.GetDurationSeconds()) * time.Second,
+	}
+}
+
+func ToRoleRequestFilterFromDesc(req *desc.ListRoleRequestsRequest) model.RoleRequestFilter {
+	filter := model.RoleRequestFilter{
+		UserID: req.GetUserId(),
+		Limit:  req.GetPageSize(),
+	}
+	for status, descStatus := range roleRequestStatuses {
+		if descStatus == req.GetStatus() {
+			filter.Status = status
+		}
+	}
+	return filter
+}
+
+func ToRoleRequestFromService(req *model.RoleRequest) *desc.RoleRequest {
+	res := &desc.RoleRequest{
+		Id:              req.ID,
+		UserId:          req.UserID,
+		Role:            string(req.Role),
+		Justification:   req.Justification,
+		DurationSeconds: int64(req.Duration / time.Second),
+		Status:          roleRequestStatuses[req.Status],
+		ApproverId:      req.ApproverID,
+		DecisionComment: req.DecisionComment,
+		CreatedAt:       timestamppb.New(req.CreatedAt),
+	}
+	if req.DecidedAt != nil {
+		res.DecidedAt = timestamppb.New(*req.DecidedAt)
+	}
+	if req.ExpiresAt != nil {
+		res.ExpiresAt = timestamppb.New(*req.ExpiresAt)
+	}
+	return res
+}
+
+func ToRoleRequestsFromService(reqs []model.RoleRequest) []*desc.RoleRequest {
+	res := make([]*desc.RoleRequest, 0, len(reqs))
+	for i := range reqs {
+		res = append(res, ToRoleRequestFromService(&reqs[i]))
+	}
+	return res
+}
@@ -11,30 +11,56 @@ import (
 
 func ToUserFromService(user *model.User) *desc.GetResponse {
 	role := desc.UserRole_USER
-	if user.Role == model.AdminRole {
+	if user.HasRole(model.AdminRole) {
 		role = desc.UserRole_ADMIN
 	}
+	roles := make([]string, 0, len(user.Roles))
+	for _, r := range user.Roles {
+		roles = append(roles, string(r))
+	}
 	return &desc.GetResponse{
 		Id:        user.ID,
 		Name:      user.Name,
 		Email:     user.Email,
 		Role:      role,
+		Roles:     roles,
 		CreatedAt: timestamppb.New(user.CreatedAt),
 		UpdatedAt: timestamppb.New(user.UpdatedAt),
 	}
 }
 
+func ToUserSignUpFromDesc(user *desc.SignUpRequest) *model.CreateUser {
+	return &model.CreateUser{
+		Name:            user.Name,
+		Email:           user.Email,
+		Password:        user.Password,
+		PasswordConfirm: user.PasswordConfirm,
+	}
+}
+
+func ToRolesFromDesc(names []string) []model.Role {
+	roles := make([]model.Role, 0, len(names))
+	for _, r := range names {
+		roles = append(roles, model.Role(r))
+	}
+	return roles
+}
+
 func ToUserCreateFromDesc(user *desc.CreateRequest) *model.CreateUser {
-	role := model.UserRole
-	if user.Role == desc.UserRole_ADMIN {
-		role = model.AdminRole
+	roles := ToRolesFromDesc(user.GetRoles())
+	if len(roles) == 0 {
+		role := model.UserRole
+		if user.GetRole() == desc.UserRole_ADMIN {
+			role = model.AdminRole
+		}
+		roles = append(roles, role)
 	}
 	return &model.CreateUser{
 		Name:            user.Name,
 		Email:           user.Email,
 		Password:        user.Password,
 		PasswordConfirm: user.PasswordConfirm,
-		Role:            role,
+		Roles:           roles,
 	}
 }
 
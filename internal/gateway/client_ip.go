@@ -0,0 +1,31 @@
+package gateway
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/textproto"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/metadata"
+)
+
+// ClientIPMetadataKey carries the address of the HTTP client to the gRPC handlers, the gRPC
+// peer of a call through the gateway is the gateway itself.
+const ClientIPMetadataKey = "x-gateway-client-ip"
+
+// clientIPHeader is the header a client would send to forge the client address metadata.
+var clientIPHeader = textproto.CanonicalMIMEHeaderKey(runtime.MetadataHeaderPrefix + ClientIPMetadataKey)
+
+// ClientIPMetadata is used as runtime.WithMetadata: it passes the address of the HTTP client
+// to the gRPC handlers.
+func ClientIPMetadata(_ context.Context, r *http.Request) metadata.MD {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	if host == "" {
+		return nil
+	}
+	return metadata.Pairs(ClientIPMetadataKey, host)
+}
@@ -0,0 +1,99 @@
+package gateway
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/arifullov/auth/internal/config"
+	descAuth "github.com/arifullov/auth/pkg/auth_v1"
+)
+
+const (
+	// RefreshTokenMetadataKey carries the refresh token taken from the cookie to the gRPC handlers.
+	RefreshTokenMetadataKey = "x-refresh-token"
+
+	refreshTokenCookiePath = "/auth/v1"
+	csrfTokenSize          = 32
+)
+
+// RefreshTokenCookie moves refresh tokens between the response body and an HttpOnly cookie.
+type RefreshTokenCookie struct {
+	cfg    config.CookieConfig
+	maxAge time.Duration
+}
+
+func NewRefreshTokenCookie(cfg config.CookieConfig, maxAge time.Duration) *RefreshTokenCookie {
+	return &RefreshTokenCookie{
+		cfg:    cfg,
+		maxAge: maxAge,
+	}
+}
+
+// ForwardResponse is used as runtime.WithForwardResponseOption: it strips the refresh token
+// from Login and GetRefreshToken responses and sets it as a cookie together with a new CSRF token.
+func (c *RefreshTokenCookie) ForwardResponse(_ context.Context, w http.ResponseWriter, m proto.Message) error {
+	var refreshToken string
+	switch resp := m.(type) {
+	case *descAuth.LoginResponse:
+		refreshToken = resp.GetRefreshToken()
+		resp.RefreshToken = ""
+	case *descAuth.GetRefreshTokenResponse:
+		refreshToken = resp.GetRefreshToken()
+		resp.RefreshToken = ""
+	default:
+		return nil
+	}
+	if refreshToken == "" {
+		return nil
+	}
+
+	csrfToken, err := newCSRFToken()
+	if err != nil {
+		return err
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     c.cfg.RefreshTokenName(),
+		Value:    refreshToken,
+		Path:     refreshTokenCookiePath,
+		Domain:   c.cfg.Domain(),
+		MaxAge:   int(c.maxAge.Seconds()),
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: c.cfg.SameSite(),
+	})
+	// CSRF cookie must be readable by the client so it can echo it back in the header.
+	http.SetCookie(w, &http.Cookie{
+		Name:     c.cfg.CSRFName(),
+		Value:    csrfToken,
+		Path:     "/",
+		Domain:   c.cfg.Domain(),
+		MaxAge:   int(c.maxAge.Seconds()),
+		Secure:   true,
+		SameSite: c.cfg.SameSite(),
+	})
+	return nil
+}
+
+// Metadata is used as runtime.WithMetadata: it passes the refresh token cookie to the gRPC handlers.
+func (c *RefreshTokenCookie) Metadata(_ context.Context, r *http.Request) metadata.MD {
+	cookie, err := r.Cookie(c.cfg.RefreshTokenName())
+	if err != nil || cookie.Value == "" {
+		return nil
+	}
+	return metadata.Pairs(RefreshTokenMetadataKey, cookie.Value)
+}
+
+func newCSRFToken() (string, error) {
+	b := make([]byte, csrfTokenSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,43 @@
+package gateway
+
+import (
+	"crypto/subtle"
+	"net/http"
+)
+
+const (
+	CSRFHeader = "X-CSRF-Token"
+)
+
+var cookieAuthenticatedPaths = map[string]struct{}{
+	"/auth/v1/refresh-token": {},
+	"/auth/v1/access-token":  {},
+}
+
+// CSRF protects endpoints that accept the refresh token cookie using the double submit cookie pattern:
+// a request carrying the refresh token cookie must repeat the CSRF cookie value in the X-CSRF-Token header.
+func (c *RefreshTokenCookie) CSRF(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := cookieAuthenticatedPaths[r.URL.Path]; !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if _, err := r.Cookie(c.cfg.RefreshTokenName()); err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		csrfCookie, err := r.Cookie(c.cfg.CSRFName())
+		if err != nil || csrfCookie.Value == "" {
+			http.Error(w, "csrf token is not provided", http.StatusForbidden)
+			return
+		}
+		csrfHeader := r.Header.Get(CSRFHeader)
+		if subtle.ConstantTimeCompare([]byte(csrfHeader), []byte(csrfCookie.Value)) != 1 {
+			http.Error(w, "invalid csrf token", http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
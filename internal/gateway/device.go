@@ -0,0 +1,28 @@
+package gateway
+
+import (
+	_ "embed"
+	"html/template"
+	"net/http"
+)
+
+//go:embed device.html
+var devicePageHTML string
+
+var devicePage = template.Must(template.New("device").Parse(devicePageHTML))
+
+type devicePageData struct {
+	CSRFCookieName string
+	CSRFHeader     string
+}
+
+// DeviceVerificationPage lets a logged-in user enter the user code shown by a CLI and approve or deny it.
+// The page echoes the CSRF cookie named csrfCookieName when it renews the access token.
+func DeviceVerificationPage(csrfCookieName string) http.HandlerFunc {
+	data := devicePageData{CSRFCookieName: csrfCookieName, CSRFHeader: CSRFHeader}
+	return func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("X-Frame-Options", "DENY")
+		_ = devicePage.Execute(w, data)
+	}
+}
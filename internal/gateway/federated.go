@@ -0,0 +1,68 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"net/textproto"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	descAuth "github.com/arifullov/auth/pkg/auth_v1"
+)
+
+const (
+	// FederatedBindingMetadataKey carries the binding of a federated login between the gRPC
+	// handlers and the browser cookie, the browser never sees it outside of the cookie.
+	FederatedBindingMetadataKey = "x-federated-binding"
+
+	federatedBindingCookieName = "federated_binding"
+	federatedBindingCookiePath = "/auth/v1/federated"
+	// federatedBindingMaxAge outlives the federated login state.
+	federatedBindingMaxAge = 15 * time.Minute
+)
+
+// federatedBindingHeader is the header the gateway would forward the binding metadata in.
+var federatedBindingHeader = textproto.CanonicalMIMEHeaderKey(runtime.MetadataHeaderPrefix + FederatedBindingMetadataKey)
+
+// FederatedBindingForwardResponse is used as runtime.WithForwardResponseOption: it moves the
+// binding of a started federated login from the response metadata to an HttpOnly cookie.
+func FederatedBindingForwardResponse(ctx context.Context, w http.ResponseWriter, m proto.Message) error {
+	if _, ok := m.(*descAuth.StartFederatedLoginResponse); !ok {
+		return nil
+	}
+	w.Header().Del(federatedBindingHeader)
+
+	md, ok := runtime.ServerMetadataFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	values := md.HeaderMD.Get(FederatedBindingMetadataKey)
+	if len(values) == 0 {
+		return nil
+	}
+
+	// Lax lets the cookie through the top-level redirect back from the identity provider.
+	http.SetCookie(w, &http.Cookie{
+		Name:     federatedBindingCookieName,
+		Value:    values[0],
+		Path:     federatedBindingCookiePath,
+		MaxAge:   int(federatedBindingMaxAge.Seconds()),
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	})
+	return nil
+}
+
+// FederatedBindingMetadata is used as runtime.WithMetadata: it passes the binding cookie to the
+// gRPC handlers.
+func FederatedBindingMetadata(_ context.Context, r *http.Request) metadata.MD {
+	cookie, err := r.Cookie(federatedBindingCookieName)
+	if err != nil || cookie.Value == "" {
+		return nil
+	}
+	return metadata.Pairs(FederatedBindingMetadataKey, cookie.Value)
+}
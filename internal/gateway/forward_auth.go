@@ -0,0 +1,198 @@
+package gateway
+
+import (
+	"crypto/sha256"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/arifullov/auth/internal/converter"
+	"github.com/arifullov/auth/internal/logger"
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/service"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+)
+
+// ForwardAuthPath is where proxies doing subrequest auth, such as nginx auth_request and
+// Traefik ForwardAuth, ask whether to forward a request.
+const ForwardAuthPath = "/forward-auth"
+
+// ForwardAuth answers 200 with the claim headers of the caller when the request is allowed,
+// 401 without a valid token and 403 when the policy denies it.
+type ForwardAuth struct {
+	accessService  service.AccessService
+	trustedProxies []netip.Prefix
+	cache          *decisionCache
+}
+
+// NewForwardAuth returns the forward-auth handler caching decisions for cacheTTL, zero disables
+// the cache. X-Forwarded-For entries are only trusted when added by trustedProxies.
+func NewForwardAuth(accessService service.AccessService, trustedProxies []netip.Prefix, cacheTTL time.Duration, cacheSize int) *ForwardAuth {
+	f := &ForwardAuth{
+		accessService:  accessService,
+		trustedProxies: trustedProxies,
+	}
+	if cacheTTL > 0 {
+		f.cache = newDecisionCache(cacheTTL, cacheSize)
+	}
+	return f
+}
+
+func (f *ForwardAuth) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := converter.ToAccessRequestFromForwardedRequest(r, f.trustedProxies)
+	if err != nil {
+		status := http.StatusForbidden
+		if sys.GetCommonError(err).Code() == codes.InvalidArgument {
+			status = http.StatusBadRequest
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+	accessToken, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		unauthorized(w, "authorization header is not provided")
+		return
+	}
+
+	key := decisionKey(accessToken, req)
+	d, ok := f.cache.get(key)
+	if !ok {
+		d, err = f.decide(r, accessToken, req)
+		if err != nil {
+			logger.Errorf("failed to authorize forwarded request: %s", err.Error())
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		f.cache.put(key, d)
+	}
+
+	switch d.status {
+	case http.StatusOK:
+		for name, value := range d.headers {
+			w.Header().Set(name, value)
+		}
+		w.WriteHeader(http.StatusOK)
+	case http.StatusUnauthorized:
+		unauthorized(w, d.message)
+	default:
+		if model.IsInsufficientScopeChallenge(d.message) {
+			w.Header().Set("WWW-Authenticate", d.message)
+		}
+		http.Error(w, d.message, d.status)
+	}
+}
+
+type decision struct {
+	status int
+	// headers of an allowed request, message of a denied one.
+	headers map[string]string
+	message string
+}
+
+func (f *ForwardAuth) decide(r *http.Request, accessToken string, req *model.AccessRequest) (decision, error) {
+	claims, err := f.accessService.Authorize(r.Context(), accessToken, req)
+	if err == nil {
+		return decision{status: http.StatusOK, headers: model.ClaimHeaders(claims)}, nil
+	}
+
+	commonErr := sys.GetCommonError(err)
+	if commonErr == nil {
+		return decision{}, err
+	}
+	switch commonErr.Code() {
+	case codes.Unauthenticated:
+		return decision{status: http.StatusUnauthorized, message: commonErr.Error()}, nil
+	case codes.PermissionDenied:
+		return decision{status: http.StatusForbidden, message: commonErr.Error()}, nil
+	default:
+		return decision{}, err
+	}
+}
+
+func unauthorized(w http.ResponseWriter, message string) {
+	w.Header().Set("WWW-Authenticate", "Bearer")
+	http.Error(w, message, http.StatusUnauthorized)
+}
+
+// decisionKey identifies what a decision depends on, the headers used by rule conditions
+// included. Tokens are hashed so they are not kept in memory.
+func decisionKey(accessToken string, req *model.AccessRequest) [sha256.Size]byte {
+	mapFields, _ := req.Fields.(model.MapRequestFields)
+	return sha256.Sum256([]byte(strings.Join([]string{
+		accessToken, req.Method, req.EndpointAddress, encodeMap(mapFields), req.Audience, req.Tenant, req.IP,
+		encodeMap(req.Headers),
+	}, "\x00")))
+}
+
+func encodeMap(values map[string]string) string {
+	pairs := make([]string, 0, len(values))
+	for name, value := range values {
+		pairs = append(pairs, url.QueryEscape(name)+"="+url.QueryEscape(value))
+	}
+	slices.Sort(pairs)
+	return strings.Join(pairs, "&")
+}
+
+type cachedDecision struct {
+	decision  decision
+	expiresAt time.Time
+}
+
+// decisionCache keeps decisions for a short time. When full, expired decisions are dropped
+// and, if none has expired, the whole cache.
+type decisionCache struct {
+	ttl  time.Duration
+	size int
+
+	mu        sync.Mutex
+	decisions map[[sha256.Size]byte]cachedDecision
+}
+
+func newDecisionCache(ttl time.Duration, size int) *decisionCache {
+	return &decisionCache{
+		ttl:       ttl,
+		size:      size,
+		decisions: make(map[[sha256.Size]byte]cachedDecision),
+	}
+}
+
+// get finds an unexpired decision, a nil cache finds none.
+func (c *decisionCache) get(key [sha256.Size]byte) (decision, bool) {
+	if c == nil {
+		return decision{}, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached, ok := c.decisions[key]
+	if !ok || time.Now().After(cached.expiresAt) {
+		return decision{}, false
+	}
+	return cached.decision, true
+}
+
+func (c *decisionCache) put(key [sha256.Size]byte, d decision) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if len(c.decisions) >= c.size {
+		for k, cached := range c.decisions {
+			if now.After(cached.expiresAt) {
+				delete(c.decisions, k)
+			}
+		}
+		if len(c.decisions) >= c.size {
+			clear(c.decisions)
+		}
+	}
+	c.decisions[key] = cachedDecision{decision: d, expiresAt: now.Add(c.ttl)}
+}
@@ -0,0 +1,24 @@
+package gateway
+
+import (
+	"net/textproto"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+// TenantHeader names the organization a call is made in.
+const TenantHeader = "X-Tenant"
+
+// IncomingHeaderMatcher forwards the tenant header to the gRPC metadata
+// in addition to the headers forwarded by default. The federated login binding is only
+// taken from its cookie and the client address from the connection, a client cannot send
+// them as headers.
+func IncomingHeaderMatcher(key string) (string, bool) {
+	switch textproto.CanonicalMIMEHeaderKey(key) {
+	case TenantHeader:
+		return "x-tenant", true
+	case federatedBindingHeader, clientIPHeader:
+		return "", false
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
@@ -0,0 +1,105 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arifullov/auth/internal/gateway"
+)
+
+type cookieConfig struct{}
+
+func (cookieConfig) Enabled() bool            { return true }
+func (cookieConfig) RefreshTokenName() string { return "refresh_token" }
+func (cookieConfig) CSRFName() string         { return "csrf_token" }
+func (cookieConfig) Domain() string           { return "" }
+func (cookieConfig) SameSite() http.SameSite  { return http.SameSiteStrictMode }
+
+func TestCSRF(t *testing.T) {
+	type args struct {
+		path         string
+		refreshToken string
+		csrfCookie   string
+		csrfHeader   string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "refresh without cookie",
+			args: args{
+				path: "/auth/v1/refresh-token",
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "refresh with matching csrf token",
+			args: args{
+				path:         "/auth/v1/refresh-token",
+				refreshToken: "token",
+				csrfCookie:   "csrf",
+				csrfHeader:   "csrf",
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "refresh with missing csrf header",
+			args: args{
+				path:         "/auth/v1/access-token",
+				refreshToken: "token",
+				csrfCookie:   "csrf",
+			},
+			want: http.StatusForbidden,
+		},
+		{
+			name: "refresh with wrong csrf header",
+			args: args{
+				path:         "/auth/v1/refresh-token",
+				refreshToken: "token",
+				csrfCookie:   "csrf",
+				csrfHeader:   "other",
+			},
+			want: http.StatusForbidden,
+		},
+		{
+			name: "unprotected path",
+			args: args{
+				path:         "/user/v1",
+				refreshToken: "token",
+			},
+			want: http.StatusOK,
+		},
+	}
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := gateway.NewRefreshTokenCookie(cookieConfig{}, time.Hour).CSRF(next)
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, tt.args.path, nil)
+			if tt.args.refreshToken != "" {
+				req.AddCookie(&http.Cookie{Name: "refresh_token", Value: tt.args.refreshToken})
+			}
+			if tt.args.csrfCookie != "" {
+				req.AddCookie(&http.Cookie{Name: "csrf_token", Value: tt.args.csrfCookie})
+			}
+			if tt.args.csrfHeader != "" {
+				req.Header.Set(gateway.CSRFHeader, tt.args.csrfHeader)
+			}
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			require.Equal(t, tt.want, rec.Code)
+		})
+	}
+}
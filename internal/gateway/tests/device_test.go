@@ -0,0 +1,20 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arifullov/auth/internal/gateway"
+)
+
+func TestDeviceVerificationPage(t *testing.T) {
+	rec := httptest.NewRecorder()
+	gateway.DeviceVerificationPage("__Host-csrf")(rec, httptest.NewRequest(http.MethodGet, "/device", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "DENY", rec.Header().Get("X-Frame-Options"))
+	require.Contains(t, rec.Body.String(), `["X-CSRF-Token"]: cookie("__Host-csrf")`)
+}
@@ -0,0 +1,230 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arifullov/auth/internal/gateway"
+	"github.com/arifullov/auth/internal/model"
+	serviceMocks "github.com/arifullov/auth/internal/service/mocks"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+)
+
+func TestForwardAuth(t *testing.T) {
+	type args struct {
+		uriHeader     string
+		uri           string
+		authorization string
+	}
+
+	var (
+		mc = minimock.NewController(t)
+
+		claims = &model.UserClaims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: "7"},
+			Username:         "user@example.com",
+			Roles:            []model.Role{model.UserRole},
+		}
+	)
+
+	tests := []struct {
+		name    string
+		args    args
+		claims  *model.UserClaims
+		err     error
+		want    int
+		headers map[string]string
+	}{
+		{
+			name:    "allowed through traefik",
+			args:    args{uriHeader: "X-Forwarded-Uri", authorization: "Bearer token"},
+			claims:  claims,
+			want:    http.StatusOK,
+			headers: map[string]string{model.UserIDHeader: "7", model.UserRoleHeader: "user"},
+		},
+		{
+			name:    "allowed through nginx",
+			args:    args{uriHeader: "X-Original-URI", authorization: "Bearer token"},
+			claims:  claims,
+			want:    http.StatusOK,
+			headers: map[string]string{model.UserIDHeader: "7", model.UserRoleHeader: "user"},
+		},
+		{
+			name: "no token",
+			args: args{uriHeader: "X-Forwarded-Uri"},
+			want: http.StatusUnauthorized,
+		},
+		{
+			name: "invalid token",
+			args: args{uriHeader: "X-Forwarded-Uri", authorization: "Bearer token"},
+			err:  sys.NewCommonError(codes.Unauthenticated, "token revoked"),
+			want: http.StatusUnauthorized,
+		},
+		{
+			name: "denied",
+			args: args{uriHeader: "X-Forwarded-Uri", authorization: "Bearer token"},
+			err:  sys.NewCommonError(codes.PermissionDenied, "no access rule matches route"),
+			want: http.StatusForbidden,
+		},
+		{
+			name: "no original uri",
+			args: args{authorization: "Bearer token"},
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "dot segments",
+			args: args{uriHeader: "X-Forwarded-Uri", uri: "/public/../orders/42", authorization: "Bearer token"},
+			want: http.StatusForbidden,
+		},
+		{
+			name: "escaped dot segments",
+			args: args{uriHeader: "X-Forwarded-Uri", uri: "/public/%2e%2e/orders/42", authorization: "Bearer token"},
+			want: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			accessServiceMock := serviceMocks.NewAccessServiceMock(mc)
+			if tt.claims != nil || tt.err != nil {
+				accessServiceMock.AuthorizeMock.Set(func(_ context.Context, accessToken string, req *model.AccessRequest) (*model.UserClaims, error) {
+					require.Equal(t, "token", accessToken)
+					require.Equal(t, "/orders/42", req.EndpointAddress)
+					require.Equal(t, http.MethodDelete, req.Method)
+					require.Equal(t, "orders", req.Audience)
+					// The owner comes from the path, the query string cannot override it.
+					require.Nil(t, req.Fields)
+					require.True(t, req.PathParams)
+					require.Equal(t, map[string]string{"user-agent": "curl/8"}, req.Headers)
+					return tt.claims, tt.err
+				})
+			}
+			handler := gateway.NewForwardAuth(accessServiceMock, nil, 0, 0)
+
+			r := httptest.NewRequest(http.MethodGet, "/forward-auth?audience=orders", nil)
+			if tt.args.uriHeader != "" {
+				uri := tt.args.uri
+				if uri == "" {
+					uri = "/orders/42?user_id=7"
+				}
+				r.Header.Set(tt.args.uriHeader, uri)
+			}
+			r.Header.Set("X-Forwarded-Method", http.MethodDelete)
+			r.Header.Set("User-Agent", "curl/8")
+			r.Header.Set("X-Role", "admin")
+			if tt.args.authorization != "" {
+				r.Header.Set("Authorization", tt.args.authorization)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			require.Equal(t, tt.want, w.Code)
+			for name, value := range tt.headers {
+				require.Equal(t, value, w.Header().Get(name))
+			}
+		})
+	}
+}
+
+func TestForwardAuthCache(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	accessServiceMock := serviceMocks.NewAccessServiceMock(mc)
+	accessServiceMock.AuthorizeMock.Return(&model.UserClaims{Roles: []model.Role{model.UserRole}}, nil)
+	handler := gateway.NewForwardAuth(accessServiceMock, nil, time.Minute, 10)
+
+	requests := []struct {
+		uri       string
+		userAgent string
+	}{
+		{uri: "/orders/1", userAgent: "app/1"},
+		{uri: "/orders/1", userAgent: "app/1"},
+		{uri: "/orders/2", userAgent: "app/1"},
+		{uri: "/orders/1", userAgent: "app/2"},
+	}
+	for _, req := range requests {
+		r := httptest.NewRequest(http.MethodGet, "/forward-auth", nil)
+		r.Header.Set("X-Forwarded-Uri", req.uri)
+		r.Header.Set("User-Agent", req.userAgent)
+		r.Header.Set("Authorization", "Bearer token")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		require.Equal(t, http.StatusOK, w.Code)
+	}
+	// The repeated request is answered from the cache, the same request with other condition
+	// headers is not.
+	require.Equal(t, uint64(3), accessServiceMock.AuthorizeAfterCounter())
+}
+
+func TestForwardAuthClientIP(t *testing.T) {
+	var (
+		mc = minimock.NewController(t)
+
+		// proxy is the network of httptest requests.
+		proxy    = netip.MustParsePrefix("192.0.2.0/24")
+		internal = netip.MustParsePrefix("10.0.0.0/8")
+	)
+
+	tests := []struct {
+		name           string
+		trustedProxies []netip.Prefix
+		headers        map[string]string
+		ip             string
+	}{
+		{
+			name:    "untrusted proxy",
+			headers: map[string]string{"X-Forwarded-For": "203.0.113.7"},
+			ip:      "192.0.2.1",
+		},
+		{
+			name:           "client entry forged",
+			trustedProxies: []netip.Prefix{proxy},
+			headers:        map[string]string{"X-Forwarded-For": "198.51.100.1, 203.0.113.7"},
+			ip:             "203.0.113.7",
+		},
+		{
+			name:           "chain of trusted proxies",
+			trustedProxies: []netip.Prefix{proxy, internal},
+			headers:        map[string]string{"X-Forwarded-For": "198.51.100.1, 203.0.113.7, 10.0.0.3"},
+			ip:             "203.0.113.7",
+		},
+		{
+			name:           "real ip header",
+			trustedProxies: []netip.Prefix{proxy},
+			headers:        map[string]string{"X-Real-Ip": "198.51.100.1"},
+			ip:             "192.0.2.1",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			accessServiceMock := serviceMocks.NewAccessServiceMock(mc)
+			accessServiceMock.AuthorizeMock.Set(func(_ context.Context, _ string, req *model.AccessRequest) (*model.UserClaims, error) {
+				require.Equal(t, tt.ip, req.IP)
+				return &model.UserClaims{}, nil
+			})
+			handler := gateway.NewForwardAuth(accessServiceMock, tt.trustedProxies, 0, 0)
+
+			r := httptest.NewRequest(http.MethodGet, "/forward-auth", nil)
+			r.Header.Set("X-Forwarded-Uri", "/orders/1")
+			r.Header.Set("Authorization", "Bearer token")
+			for name, value := range tt.headers {
+				r.Header.Set(name, value)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			require.Equal(t, http.StatusOK, w.Code)
+		})
+	}
+}
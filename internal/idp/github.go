@@ -0,0 +1,137 @@
+package idp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+
+	"github.com/arifullov/auth/internal/config"
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+)
+
+const (
+	gitHubAuthURL  = "https://github.com/login/oauth/authorize"
+	gitHubTokenURL = "https://github.com/login/oauth/access_token"
+	gitHubAPIURL   = "https://api.github.com"
+)
+
+var gitHubDefaultScopes = []string{"read:user", "user:email"}
+
+// gitHubProvider implements plain OAuth2 providers that expose the user through a GitHub-style REST API.
+type gitHubProvider struct {
+	roleMapper
+	cfg    config.IdentityProviderConfig
+	oauth2 *oauth2.Config
+	apiURL string
+}
+
+type gitHubEmail struct {
+	Email    string `json:"email"`
+	Primary  bool   `json:"primary"`
+	Verified bool   `json:"verified"`
+}
+
+func NewGitHubProvider(cfg config.IdentityProviderConfig) Provider {
+	authURL, tokenURL, apiURL := cfg.AuthURL, cfg.TokenURL, cfg.APIURL
+	if authURL == "" {
+		authURL = gitHubAuthURL
+	}
+	if tokenURL == "" {
+		tokenURL = gitHubTokenURL
+	}
+	if apiURL == "" {
+		apiURL = gitHubAPIURL
+	}
+	scopes := cfg.Scopes
+	if len(scopes) == 0 {
+		scopes = gitHubDefaultScopes
+	}
+
+	return &gitHubProvider{
+		roleMapper: roleMapper{defaultRole: cfg.DefaultRole, rules: cfg.RoleMapping},
+		cfg:        cfg,
+		oauth2: &oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			RedirectURL:  cfg.RedirectURL,
+			Endpoint:     oauth2.Endpoint{AuthURL: authURL, TokenURL: tokenURL},
+			Scopes:       scopes,
+		},
+		apiURL: strings.TrimSuffix(apiURL, "/"),
+	}
+}
+
+func (p *gitHubProvider) Name() string {
+	return p.cfg.Name
+}
+
+// AuthCodeURL ignores the nonce, plain OAuth2 providers are protected by the state only.
+func (p *gitHubProvider) AuthCodeURL(_ context.Context, state string, _ string) (string, error) {
+	return p.oauth2.AuthCodeURL(state), nil
+}
+
+func (p *gitHubProvider) Exchange(ctx context.Context, code string, _ string) (*model.ExternalIdentity, error) {
+	token, err := p.oauth2.Exchange(ctx, code)
+	if err != nil {
+		return nil, sys.NewCommonError(codes.Unauthenticated, "failed to exchange authorization code")
+	}
+	client := p.oauth2.Client(ctx, token)
+
+	var user map[string]any
+	if err = p.get(ctx, client, "/user", &user); err != nil {
+		return nil, err
+	}
+	var emails []gitHubEmail
+	if err = p.get(ctx, client, "/user/emails", &emails); err != nil {
+		return nil, err
+	}
+
+	id, ok := user["id"].(float64)
+	if !ok {
+		return nil, sys.NewCommonError(codes.Unauthenticated, "user id is not provided")
+	}
+
+	identity := &model.ExternalIdentity{
+		Provider: p.cfg.Name,
+		Subject:  strconv.FormatInt(int64(id), 10),
+		Claims:   user,
+	}
+	identity.Name, _ = user["name"].(string)
+	if identity.Name == "" {
+		identity.Name, _ = user["login"].(string)
+	}
+	for _, email := range emails {
+		if email.Primary {
+			identity.Email = email.Email
+			identity.EmailVerified = email.Verified
+			break
+		}
+	}
+	return identity, nil
+}
+
+func (p *gitHubProvider) get(ctx context.Context, client *http.Client, path string, dest any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.apiURL+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "identity provider %q request failed", p.cfg.Name)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("identity provider %q returned %s for %s", p.cfg.Name, resp.Status, path)
+	}
+	return json.NewDecoder(resp.Body).Decode(dest)
+}
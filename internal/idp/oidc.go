@@ -0,0 +1,106 @@
+package idp
+
+import (
+	"context"
+	"sync"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+
+	"github.com/arifullov/auth/internal/config"
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+)
+
+type oidcProvider struct {
+	roleMapper
+	cfg config.IdentityProviderConfig
+
+	mu       sync.Mutex
+	oauth2   *oauth2.Config
+	verifier *oidc.IDTokenVerifier
+}
+
+func NewOIDCProvider(cfg config.IdentityProviderConfig) Provider {
+	return &oidcProvider{
+		roleMapper: roleMapper{defaultRole: cfg.DefaultRole, rules: cfg.RoleMapping},
+		cfg:        cfg,
+	}
+}
+
+func (p *oidcProvider) Name() string {
+	return p.cfg.Name
+}
+
+// discover fetches the provider metadata on first use so that an unavailable
+// identity provider does not prevent the service from starting.
+func (p *oidcProvider) discover(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.oauth2 != nil {
+		return nil
+	}
+
+	provider, err := oidc.NewProvider(ctx, p.cfg.Issuer)
+	if err != nil {
+		return errors.Wrapf(err, "failed to discover identity provider %q", p.cfg.Name)
+	}
+
+	p.verifier = provider.Verifier(&oidc.Config{ClientID: p.cfg.ClientID})
+	p.oauth2 = &oauth2.Config{
+		ClientID:     p.cfg.ClientID,
+		ClientSecret: p.cfg.ClientSecret,
+		RedirectURL:  p.cfg.RedirectURL,
+		Endpoint:     provider.Endpoint(),
+		Scopes:       append([]string{oidc.ScopeOpenID}, p.cfg.Scopes...),
+	}
+	return nil
+}
+
+func (p *oidcProvider) AuthCodeURL(ctx context.Context, state string, nonce string) (string, error) {
+	if err := p.discover(ctx); err != nil {
+		return "", err
+	}
+	return p.oauth2.AuthCodeURL(state, oidc.Nonce(nonce)), nil
+}
+
+func (p *oidcProvider) Exchange(ctx context.Context, code string, nonce string) (*model.ExternalIdentity, error) {
+	if err := p.discover(ctx); err != nil {
+		return nil, err
+	}
+
+	token, err := p.oauth2.Exchange(ctx, code)
+	if err != nil {
+		return nil, sys.NewCommonError(codes.Unauthenticated, "failed to exchange authorization code")
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return nil, sys.NewCommonError(codes.Unauthenticated, "id token is not provided")
+	}
+	idToken, err := p.verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, sys.NewCommonError(codes.Unauthenticated, "invalid id token")
+	}
+	if idToken.Nonce != nonce {
+		return nil, sys.NewCommonError(codes.Unauthenticated, "invalid id token nonce")
+	}
+
+	var claims map[string]any
+	if err = idToken.Claims(&claims); err != nil {
+		return nil, err
+	}
+
+	identity := &model.ExternalIdentity{
+		Provider: p.cfg.Name,
+		Subject:  idToken.Subject,
+		Claims:   claims,
+	}
+	identity.Email, _ = claims["email"].(string)
+	identity.EmailVerified, _ = claims["email_verified"].(bool)
+	identity.Name, _ = claims["name"].(string)
+	return identity, nil
+}
@@ -0,0 +1,88 @@
+package idp
+
+import (
+	"context"
+	"slices"
+
+	"github.com/arifullov/auth/internal/config"
+	"github.com/arifullov/auth/internal/model"
+)
+
+// Provider is an upstream identity provider users can sign in with.
+type Provider interface {
+	Name() string
+	// AuthCodeURL returns the URL of the provider login page the user is redirected to.
+	AuthCodeURL(ctx context.Context, state string, nonce string) (string, error)
+	// Exchange trades the authorization code returned to the callback for the user identity.
+	Exchange(ctx context.Context, code string, nonce string) (*model.ExternalIdentity, error)
+	// Role maps the identity to the role of a just-in-time provisioned user.
+	Role(identity *model.ExternalIdentity) model.Role
+}
+
+type Registry struct {
+	providers map[string]Provider
+}
+
+func NewRegistry(cfg config.IdentityProvidersConfig) *Registry {
+	providers := make(map[string]Provider, len(cfg.Providers()))
+	for _, providerCfg := range cfg.Providers() {
+		switch providerCfg.Type {
+		case config.IdentityProviderTypeOIDC:
+			providers[providerCfg.Name] = NewOIDCProvider(providerCfg)
+		case config.IdentityProviderTypeGitHub:
+			providers[providerCfg.Name] = NewGitHubProvider(providerCfg)
+		default:
+		}
+	}
+	return &Registry{
+		providers: providers,
+	}
+}
+
+// NewStaticRegistry returns a registry of the providers, e.g. of fakes in tests.
+func NewStaticRegistry(providers ...Provider) *Registry {
+	registry := &Registry{providers: make(map[string]Provider, len(providers))}
+	for _, provider := range providers {
+		registry.providers[provider.Name()] = provider
+	}
+	return registry
+}
+
+func (r *Registry) Get(name string) (Provider, bool) {
+	provider, ok := r.providers[name]
+	return provider, ok
+}
+
+type roleMapper struct {
+	defaultRole string
+	rules       []config.RoleMappingRule
+}
+
+// Role returns the role of the first matching rule, falling back to the provider default role.
+func (m roleMapper) Role(identity *model.ExternalIdentity) model.Role {
+	for _, rule := range m.rules {
+		if claimMatches(identity.Claims[rule.Claim], rule.Value) {
+			return model.Role(rule.Role)
+		}
+	}
+	if m.defaultRole != "" {
+		return model.Role(m.defaultRole)
+	}
+	return model.UserRole
+}
+
+func claimMatches(claim any, value string) bool {
+	switch v := claim.(type) {
+	case string:
+		return v == value
+	case []any:
+		return slices.ContainsFunc(v, func(item any) bool {
+			s, ok := item.(string)
+			return ok && s == value
+		})
+	case []string:
+		return slices.Contains(v, value)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,112 @@
+package tests
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	fakeClientID = "auth-service"
+	fakeCode     = "code"
+	fakeKeyID    = "key"
+)
+
+// fakeIdP is a local identity provider serving OIDC discovery, JWKS, the token
+// endpoint and GitHub-style user endpoints.
+type fakeIdP struct {
+	*httptest.Server
+	t      *testing.T
+	key    *rsa.PrivateKey
+	claims map[string]any
+	nonce  string
+	user   map[string]any
+	emails []map[string]any
+}
+
+func newFakeIdP(t *testing.T) *fakeIdP {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	idp := &fakeIdP{t: t, key: key}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", idp.discovery)
+	mux.HandleFunc("/jwks", idp.jwks)
+	mux.HandleFunc("/token", idp.token)
+	mux.HandleFunc("/user", idp.writeJSON(func() any { return idp.user }))
+	mux.HandleFunc("/user/emails", idp.writeJSON(func() any { return idp.emails }))
+	idp.Server = httptest.NewServer(mux)
+	t.Cleanup(idp.Close)
+	return idp
+}
+
+func (f *fakeIdP) discovery(w http.ResponseWriter, _ *http.Request) {
+	f.writeJSON(func() any {
+		return map[string]any{
+			"issuer":                                f.URL,
+			"authorization_endpoint":                f.URL + "/authorize",
+			"token_endpoint":                        f.URL + "/token",
+			"jwks_uri":                              f.URL + "/jwks",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		}
+	})(w, nil)
+}
+
+func (f *fakeIdP) jwks(w http.ResponseWriter, _ *http.Request) {
+	f.writeJSON(func() any {
+		return jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: &f.key.PublicKey, KeyID: fakeKeyID, Algorithm: string(jose.RS256), Use: "sig"},
+		}}
+	})(w, nil)
+}
+
+func (f *fakeIdP) token(w http.ResponseWriter, r *http.Request) {
+	require.NoError(f.t, r.ParseForm())
+	if r.PostForm.Get("code") != fakeCode {
+		http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+		return
+	}
+
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: f.key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", fakeKeyID),
+	)
+	require.NoError(f.t, err)
+
+	claims := map[string]any{
+		"iss":   f.URL,
+		"aud":   fakeClientID,
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"iat":   time.Now().Unix(),
+		"nonce": f.nonce,
+	}
+	for k, v := range f.claims {
+		claims[k] = v
+	}
+	idToken, err := jwt.Signed(signer).Claims(claims).Serialize()
+	require.NoError(f.t, err)
+
+	f.writeJSON(func() any {
+		return map[string]any{
+			"access_token": "access-token",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     idToken,
+		}
+	})(w, r)
+}
+
+func (f *fakeIdP) writeJSON(body func() any) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(f.t, json.NewEncoder(w).Encode(body()))
+	}
+}
@@ -0,0 +1,137 @@
+package tests
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arifullov/auth/internal/config"
+	"github.com/arifullov/auth/internal/idp"
+	"github.com/arifullov/auth/internal/model"
+)
+
+var roleMapping = []config.RoleMappingRule{
+	{Claim: "groups", Value: "auth-admins", Role: string(model.AdminRole)},
+	{Claim: "login", Value: "octocat", Role: string(model.AdminRole)},
+}
+
+func TestOIDCProvider(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		fakeIdP = newFakeIdP(t)
+	)
+
+	provider := idp.NewOIDCProvider(config.IdentityProviderConfig{
+		Name:        "corp",
+		Type:        config.IdentityProviderTypeOIDC,
+		Issuer:      fakeIdP.URL,
+		ClientID:    fakeClientID,
+		RedirectURL: "http://localhost/callback",
+		RoleMapping: roleMapping,
+	})
+
+	authURL, err := provider.AuthCodeURL(ctx, "state", "nonce")
+	require.NoError(t, err)
+	parsed, err := url.Parse(authURL)
+	require.NoError(t, err)
+	require.Equal(t, "state", parsed.Query().Get("state"))
+	require.Equal(t, "nonce", parsed.Query().Get("nonce"))
+
+	tests := []struct {
+		name     string
+		code     string
+		nonce    string
+		claims   map[string]any
+		wantRole model.Role
+		wantErr  bool
+	}{
+		{
+			name:  "admin group",
+			code:  fakeCode,
+			nonce: "nonce",
+			claims: map[string]any{
+				"sub":            "42",
+				"email":          "admin@example.com",
+				"email_verified": true,
+				"groups":         []string{"staff", "auth-admins"},
+			},
+			wantRole: model.AdminRole,
+		},
+		{
+			name:  "default role",
+			code:  fakeCode,
+			nonce: "nonce",
+			claims: map[string]any{
+				"sub":   "43",
+				"email": "user@example.com",
+			},
+			wantRole: model.UserRole,
+		},
+		{
+			name:    "invalid code",
+			code:    "invalid",
+			nonce:   "nonce",
+			claims:  map[string]any{"sub": "44"},
+			wantErr: true,
+		},
+		{
+			name:    "nonce mismatch",
+			code:    fakeCode,
+			nonce:   "other",
+			claims:  map[string]any{"sub": "45"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			fakeIdP.claims = tt.claims
+			fakeIdP.nonce = "nonce"
+
+			identity, err := provider.Exchange(ctx, tt.code, tt.nonce)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.claims["sub"], identity.Subject)
+			require.Equal(t, tt.claims["email"], identity.Email)
+			require.Equal(t, tt.claims["email_verified"] == true, identity.EmailVerified)
+			require.Equal(t, tt.wantRole, provider.Role(identity))
+		})
+	}
+}
+
+func TestGitHubProvider(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		fakeIdP = newFakeIdP(t)
+	)
+
+	fakeIdP.user = map[string]any{"id": 583231, "login": "octocat", "name": "The Octocat"}
+	fakeIdP.emails = []map[string]any{
+		{"email": "octocat@users.noreply.github.com", "primary": false, "verified": true},
+		{"email": "octocat@github.com", "primary": true, "verified": true},
+	}
+
+	provider := idp.NewGitHubProvider(config.IdentityProviderConfig{
+		Name:        "github",
+		Type:        config.IdentityProviderTypeGitHub,
+		AuthURL:     fakeIdP.URL + "/authorize",
+		TokenURL:    fakeIdP.URL + "/token",
+		APIURL:      fakeIdP.URL,
+		ClientID:    fakeClientID,
+		RoleMapping: roleMapping,
+	})
+
+	identity, err := provider.Exchange(ctx, fakeCode, "")
+	require.NoError(t, err)
+	require.Equal(t, "583231", identity.Subject)
+	require.Equal(t, "octocat@github.com", identity.Email)
+	require.True(t, identity.EmailVerified)
+	require.Equal(t, "The Octocat", identity.Name)
+	require.Equal(t, model.AdminRole, provider.Role(identity))
+}
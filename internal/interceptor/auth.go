@@ -0,0 +1,128 @@
+package interceptor
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/arifullov/auth/internal/authctx"
+	"github.com/arifullov/auth/internal/gateway"
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/service"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+	// tenantHeader names the organization a call is made in.
+	tenantHeader = "x-tenant"
+
+	// ownAudience is empty, so tokens exchanged for other services are not accepted here.
+	ownAudience = ""
+)
+
+type AuthInterceptor struct {
+	accessService service.AccessService
+	publicMethods map[string]struct{}
+}
+
+// NewAuthInterceptor returns an interceptor authorizing every call against the route rules
+// except publicMethods, full gRPC method names callable without a token.
+func NewAuthInterceptor(accessService service.AccessService, publicMethods ...string) *AuthInterceptor {
+	public := make(map[string]struct{}, len(publicMethods))
+	for _, method := range publicMethods {
+		public[method] = struct{}{}
+	}
+	return &AuthInterceptor{
+		accessService: accessService,
+		publicMethods: public,
+	}
+}
+
+func (i *AuthInterceptor) Unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if _, ok := i.publicMethods[info.FullMethod]; ok {
+		return handler(ctx, req)
+	}
+
+	accessToken, err := bearerToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := requestHeaders(ctx)
+	claims, err := i.accessService.Authorize(ctx, accessToken, &model.AccessRequest{
+		EndpointAddress: info.FullMethod,
+		Audience:        ownAudience,
+		Fields:          requestFields(req),
+		IP:              peerIP(ctx),
+		Headers:         headers,
+		Tenant:          headers[tenantHeader],
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Handlers take the tenant from the claims, the header has to name the same organization
+	// so that a call is never made in another tenant than the client asked for.
+	if headers[tenantHeader] != claims.Tenant {
+		return nil, sys.NewCommonError(codes.PermissionDenied, "token is not valid for the tenant")
+	}
+
+	return handler(authctx.WithClaims(ctx, claims), req)
+}
+
+func bearerToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", sys.NewCommonError(codes.Unauthenticated, "metadata is not provided")
+	}
+
+	authHeader := md.Get(authorizationHeader)
+	if len(authHeader) == 0 {
+		return "", sys.NewCommonError(codes.Unauthenticated, "authorization header is not provided")
+	}
+
+	if !strings.HasPrefix(authHeader[0], bearerPrefix) {
+		return "", sys.NewCommonError(codes.Unauthenticated, "invalid authorization header format")
+	}
+
+	return strings.TrimPrefix(authHeader[0], bearerPrefix), nil
+}
+
+// peerIP returns the address of the client. Calls through the gateway come from the loopback
+// address, their client address is the one the gateway put into the metadata.
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return host
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(gateway.ClientIPMetadataKey); len(values) > 0 {
+		return values[0]
+	}
+	return host
+}
+
+// requestHeaders returns the first value of each metadata key in model.ConditionHeaders.
+func requestHeaders(ctx context.Context) map[string]string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	headers := make(map[string]string, len(model.ConditionHeaders))
+	for _, name := range model.ConditionHeaders {
+		if values := md.Get(name); len(values) > 0 {
+			headers[name] = values[0]
+		}
+	}
+	return headers
+}
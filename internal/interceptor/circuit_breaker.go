@@ -34,7 +34,8 @@ func (i *CircuitBreakerInterceptor) Unary(ctx context.Context, req any, info *gr
 		switch commErr.Code() {
 		// Skip this errors
 		case codes.InvalidArgument, codes.NotFound, codes.AlreadyExists,
-			codes.PermissionDenied, codes.Unauthenticated:
+			codes.PermissionDenied, codes.Unauthenticated,
+			codes.FailedPrecondition, codes.ResourceExhausted:
 			res, _ = i.circuitBreaker.Execute(func() (any, error) {
 				return res, nil
 			})
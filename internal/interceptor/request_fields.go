@@ -0,0 +1,72 @@
+package interceptor
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/arifullov/auth/internal/model"
+)
+
+// wrapperValueField is the field holding the value of google.protobuf wrapper messages.
+const wrapperValueField = "value"
+
+// messageFields exposes the scalar fields of a request message to ownership rules.
+type messageFields struct {
+	msg protoreflect.Message
+}
+
+func requestFields(req any) model.RequestFields {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+	return &messageFields{msg: msg.ProtoReflect()}
+}
+
+// Field returns the value of a field by its proto name, nested fields are addressed with dots.
+// Wrapper messages such as google.protobuf.Int64Value resolve to their value, repeated and
+// map fields are not supported.
+func (f *messageFields) Field(name string) (string, bool) {
+	msg := f.msg
+	names := strings.Split(name, ".")
+	for i, fieldName := range names {
+		fd := msg.Descriptor().Fields().ByName(protoreflect.Name(fieldName))
+		if fd == nil || fd.IsList() || fd.IsMap() {
+			return "", false
+		}
+
+		if fd.Kind() != protoreflect.MessageKind && fd.Kind() != protoreflect.GroupKind {
+			if i != len(names)-1 {
+				return "", false
+			}
+			return scalarString(msg.Get(fd)), true
+		}
+
+		if !msg.Has(fd) {
+			return "", false
+		}
+		msg = msg.Get(fd).Message()
+		if i == len(names)-1 {
+			valueFd := msg.Descriptor().Fields().ByName(wrapperValueField)
+			if valueFd == nil || valueFd.Kind() == protoreflect.MessageKind {
+				return "", false
+			}
+			return scalarString(msg.Get(valueFd)), true
+		}
+	}
+	return "", false
+}
+
+func scalarString(value protoreflect.Value) string {
+	switch v := value.Interface().(type) {
+	case protoreflect.EnumNumber:
+		return fmt.Sprint(int32(v))
+	case []byte:
+		return string(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
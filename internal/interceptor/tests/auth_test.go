@@ -0,0 +1,256 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/arifullov/auth/internal/authctx"
+	"github.com/arifullov/auth/internal/gateway"
+	"github.com/arifullov/auth/internal/interceptor"
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/service"
+	serviceMocks "github.com/arifullov/auth/internal/service/mocks"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+	userDesc "github.com/arifullov/auth/pkg/user_v1"
+)
+
+const (
+	publicMethod  = "/auth_v1.AuthV1/Login"
+	privateMethod = "/user_v1.UserV1/Get"
+)
+
+func TestAuthInterceptor(t *testing.T) {
+	type accessServiceMockFunc func(mc *minimock.Controller) service.AccessService
+	type args struct {
+		method string
+		header string
+		tenant string
+	}
+
+	var (
+		mc           = minimock.NewController(t)
+		claims       = &model.UserClaims{Username: "user@example.com", Roles: []model.Role{model.UserRole}}
+		tenantClaims = &model.UserClaims{Username: "user@example.com", Roles: []model.Role{model.AdminRole}, Tenant: "acme"}
+	)
+
+	tests := []struct {
+		name              string
+		args              args
+		code              codes.Code
+		claims            *model.UserClaims
+		accessServiceMock accessServiceMockFunc
+	}{
+		{
+			name: "public method without token",
+			args: args{
+				method: publicMethod,
+			},
+			code: codes.OK,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				return serviceMocks.NewAccessServiceMock(mc)
+			},
+		},
+		{
+			name: "private method without token",
+			args: args{
+				method: privateMethod,
+			},
+			code: codes.Unauthenticated,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				return serviceMocks.NewAccessServiceMock(mc)
+			},
+		},
+		{
+			name: "private method with malformed header",
+			args: args{
+				method: privateMethod,
+				header: "Basic token",
+			},
+			code: codes.Unauthenticated,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				return serviceMocks.NewAccessServiceMock(mc)
+			},
+		},
+		{
+			name: "private method denied",
+			args: args{
+				method: privateMethod,
+				header: "Bearer token",
+			},
+			code: codes.PermissionDenied,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.AuthorizeMock.ExpectAccessTokenParam2("token").
+					Return(nil, sys.NewCommonError(codes.PermissionDenied, "permission denied"))
+				return mock
+			},
+		},
+		{
+			name: "private method allowed",
+			args: args{
+				method: privateMethod,
+				header: "Bearer token",
+			},
+			code:   codes.OK,
+			claims: claims,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.AuthorizeMock.ExpectAccessTokenParam2("token").
+					Inspect(func(_ context.Context, _ string, req *model.AccessRequest) {
+						require.Equal(t, privateMethod, req.EndpointAddress)
+						require.NotContains(t, req.Headers, "authorization")
+						id, ok := req.Fields.Field("id")
+						require.True(t, ok)
+						require.Equal(t, "42", id)
+					}).
+					Return(claims, nil)
+				return mock
+			},
+		},
+		{
+			name: "organization token in its tenant",
+			args: args{
+				method: privateMethod,
+				header: "Bearer token",
+				tenant: "acme",
+			},
+			code:   codes.OK,
+			claims: tenantClaims,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.AuthorizeMock.ExpectAccessTokenParam2("token").
+					Inspect(func(_ context.Context, _ string, req *model.AccessRequest) {
+						require.Equal(t, "acme", req.Tenant)
+					}).
+					Return(tenantClaims, nil)
+				return mock
+			},
+		},
+		{
+			name: "organization token without tenant header",
+			args: args{
+				method: privateMethod,
+				header: "Bearer token",
+			},
+			code: codes.PermissionDenied,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.AuthorizeMock.ExpectAccessTokenParam2("token").Return(tenantClaims, nil)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			authInterceptor := interceptor.NewAuthInterceptor(tt.accessServiceMock(mc), publicMethod)
+
+			md := metadata.MD{}
+			if tt.args.header != "" {
+				md.Set("authorization", tt.args.header)
+			}
+			if tt.args.tenant != "" {
+				md.Set("x-tenant", tt.args.tenant)
+			}
+			ctx := metadata.NewIncomingContext(context.Background(), md)
+
+			var handlerClaims *model.UserClaims
+			handler := func(ctx context.Context, _ any) (any, error) {
+				handlerClaims, _ = authctx.Claims(ctx)
+				return nil, nil
+			}
+
+			_, err := authInterceptor.Unary(ctx, &userDesc.GetRequest{Id: 42}, &grpc.UnaryServerInfo{FullMethod: tt.args.method}, handler)
+			if tt.code == codes.OK {
+				require.NoError(t, err)
+			} else {
+				require.True(t, sys.IsCommonError(err))
+				require.Equal(t, tt.code, sys.GetCommonError(err).Code())
+			}
+			require.Equal(t, tt.claims, handlerClaims)
+		})
+	}
+}
+
+func TestAuthInterceptorRequestInput(t *testing.T) {
+	var (
+		mc     = minimock.NewController(t)
+		claims = &model.UserClaims{Username: "user@example.com", Roles: []model.Role{model.UserRole}}
+	)
+
+	tests := []struct {
+		name    string
+		peer    string
+		md      metadata.MD
+		ip      string
+		headers map[string]string
+	}{
+		{
+			name: "direct call",
+			peer: "10.0.0.5:50000",
+			md:   metadata.Pairs("x-tenant-id", "7", "user-agent", "grpc-go"),
+			ip:   "10.0.0.5",
+			headers: map[string]string{
+				"x-tenant-id": "7",
+				"user-agent":  "grpc-go",
+			},
+		},
+		{
+			name:    "call through the gateway",
+			peer:    "127.0.0.1:50000",
+			md:      metadata.Pairs(gateway.ClientIPMetadataKey, "203.0.113.7"),
+			ip:      "203.0.113.7",
+			headers: map[string]string{},
+		},
+		{
+			name:    "forged client address",
+			peer:    "10.0.0.5:50000",
+			md:      metadata.Pairs(gateway.ClientIPMetadataKey, "203.0.113.7"),
+			ip:      "10.0.0.5",
+			headers: map[string]string{},
+		},
+		{
+			name:    "metadata outside the allow-list",
+			peer:    "10.0.0.5:50000",
+			md:      metadata.Pairs("x-role", "admin", "x-request-id", "42"),
+			ip:      "10.0.0.5",
+			headers: map[string]string{"x-request-id": "42"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			accessService := serviceMocks.NewAccessServiceMock(mc)
+			accessService.AuthorizeMock.ExpectAccessTokenParam2("token").
+				Inspect(func(_ context.Context, _ string, req *model.AccessRequest) {
+					require.Equal(t, tt.ip, req.IP)
+					require.Equal(t, tt.headers, req.Headers)
+				}).
+				Return(claims, nil)
+			authInterceptor := interceptor.NewAuthInterceptor(accessService, publicMethod)
+
+			addr, err := net.ResolveTCPAddr("tcp", tt.peer)
+			require.NoError(t, err)
+			md := tt.md.Copy()
+			md.Set("authorization", "Bearer token")
+			ctx := metadata.NewIncomingContext(context.Background(), md)
+			ctx = peer.NewContext(ctx, &peer.Peer{Addr: addr})
+
+			handler := func(context.Context, any) (any, error) {
+				return nil, nil
+			}
+			_, err = authInterceptor.Unary(ctx, &userDesc.GetRequest{Id: 42}, &grpc.UnaryServerInfo{FullMethod: privateMethod}, handler)
+			require.NoError(t, err)
+		})
+	}
+}
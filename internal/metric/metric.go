@@ -2,6 +2,8 @@ package metric
 
 import (
 	"context"
+	"sync/atomic"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -11,16 +13,25 @@ const (
 	namespace = "my_space"
 	appName   = "my_app"
 
-	labelStatus = "status"
-	labelMethod = "method"
+	labelStatus   = "status"
+	labelMethod   = "method"
+	labelDecision = "decision"
 )
 
 type Metrics struct {
 	requestCounter        prometheus.Counter
 	responseCounter       *prometheus.CounterVec
 	histogramResponseTime *prometheus.HistogramVec
+
+	policyVersion             prometheus.Gauge
+	policyReloadCounter       *prometheus.CounterVec
+	histogramPolicyEvaluation *prometheus.HistogramVec
+	policyDrift               prometheus.Gauge
 }
 
+// policyLoadedAt is read by the cache age gauge on scrape, unix nanoseconds.
+var policyLoadedAt atomic.Int64
+
 var metrics *Metrics
 
 func Init(_ context.Context) error {
@@ -52,8 +63,59 @@ func Init(_ context.Context) error {
 			},
 			[]string{labelStatus},
 		),
+		policyVersion: promauto.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: "policy",
+				Name:      appName + "_version",
+				Help:      "Версия закешированной политики доступа",
+			},
+		),
+		policyReloadCounter: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "policy",
+				Name:      appName + "_reloads_total",
+				Help:      "Количество перезагрузок политики доступа",
+			},
+			[]string{labelStatus},
+		),
+		histogramPolicyEvaluation: promauto.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Subsystem: "policy",
+				Name:      appName + "_evaluation_time_seconds",
+				Help:      "Время проверки доступа по политике",
+				Buckets:   prometheus.ExponentialBuckets(0.000001, 2, 16),
+			},
+			[]string{labelDecision},
+		),
+		policyDrift: promauto.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: "policy",
+				Name:      appName + "_drift_changes",
+				Help:      "Количество расхождений сохраненной политики доступа с файлом политики",
+			},
+		),
 	}
 
+	promauto.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "policy",
+			Name:      appName + "_cache_age_seconds",
+			Help:      "Время с последней загрузки политики доступа",
+		},
+		func() float64 {
+			loadedAt := policyLoadedAt.Load()
+			if loadedAt == 0 {
+				return 0
+			}
+			return time.Since(time.Unix(0, loadedAt)).Seconds()
+		},
+	)
+
 	return nil
 }
 
@@ -68,3 +130,35 @@ func IncResponseCounter(status string, method string) {
 func HistogramResponseTimeObserve(status string, time float64) {
 	metrics.histogramResponseTime.WithLabelValues(status).Observe(time)
 }
+
+// Policy metrics are reported from components that also run in tests without Init.
+
+func SetPolicyVersion(version int64, loadedAt time.Time) {
+	policyLoadedAt.Store(loadedAt.UnixNano())
+	if metrics == nil {
+		return
+	}
+	metrics.policyVersion.Set(float64(version))
+}
+
+func IncPolicyReloadCounter(status string) {
+	if metrics == nil {
+		return
+	}
+	metrics.policyReloadCounter.WithLabelValues(status).Inc()
+}
+
+func HistogramPolicyEvaluationObserve(decision string, time float64) {
+	if metrics == nil {
+		return
+	}
+	metrics.histogramPolicyEvaluation.WithLabelValues(decision).Observe(time)
+}
+
+// SetPolicyDrift reports how many changes applying the policy file would make.
+func SetPolicyDrift(changes int) {
+	if metrics == nil {
+		return
+	}
+	metrics.policyDrift.Set(float64(changes))
+}
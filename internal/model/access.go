@@ -0,0 +1,227 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	AllowEffect RouteEffect = "allow"
+	DenyEffect  RouteEffect = "deny"
+
+	// AnyMethod matches every HTTP method as well as gRPC calls, which have none.
+	AnyMethod = "*"
+)
+
+type RouteEffect string
+
+// RouteRule grants (or explicitly denies) Role access to the routes matching the Route pattern.
+type RouteRule struct {
+	ID     int64  `json:"id"`
+	Route  string `json:"route"`
+	Method string `json:"method"`
+	// Role is empty for rules applying to callers with any role, such rules must require scopes.
+	Role   Role        `json:"role"`
+	Effect RouteEffect `json:"effect"`
+	// Scopes the token of the caller must grant, all of them, for an allow rule to grant access.
+	// Deny rules ignore them.
+	Scopes []string `json:"scopes,omitempty"`
+	// OwnerField limits the rule to requests whose field of that name equals the
+	// subject of the caller, e.g. "id" lets users reach only their own record.
+	OwnerField string `json:"owner_field,omitempty"`
+	// Condition is an expression that must hold for the rule to apply, e.g.
+	// `inCIDR(ip, "10.0.0.0/8") && time.Hour() >= 9`.
+	Condition string `json:"condition,omitempty"`
+}
+
+// AccessRequest is a call of an endpoint to authorize.
+type AccessRequest struct {
+	// EndpointAddress is a gRPC full method name or an HTTP path.
+	EndpointAddress string
+	// Method is the HTTP method, empty for gRPC calls.
+	Method string
+	// Audience is the service performing the check.
+	Audience string
+	// Fields of the request, used by ownership rules, may be nil.
+	Fields RequestFields
+	// PathParams takes the fields used by ownership rules from the named parameters of the
+	// rule route in EndpointAddress, Fields is not used. Set for HTTP paths.
+	PathParams bool
+	// IP of the client and Headers of the request, used by rule conditions.
+	IP      string
+	Headers map[string]string
+	// Tenant the call is made in, tokens issued for another organization are denied.
+	// Empty when the call is not scoped to an organization.
+	Tenant string
+}
+
+// ConditionHeaders are the request headers, in lower case, passed to rule conditions. Other
+// headers are not part of the decision input.
+var ConditionHeaders = []string{
+	"x-tenant",
+	"x-tenant-id",
+	"x-request-id",
+	"user-agent",
+}
+
+// DenyReason tells why a call is denied.
+type DenyReason string
+
+const (
+	// DenyReasonNoMatchingRule: no rule matches the route, calls are denied by default.
+	DenyReasonNoMatchingRule DenyReason = "no_matching_rule"
+	// DenyReasonDenyRule: a deny rule matches one of the roles of the caller.
+	DenyReasonDenyRule DenyReason = "deny_rule"
+	// DenyReasonRoleNotGranted: none of the roles of the caller is granted by the most specific rules.
+	DenyReasonRoleNotGranted DenyReason = "role_not_granted"
+	// DenyReasonInvalidAudience: the token was issued for another service.
+	DenyReasonInvalidAudience DenyReason = "invalid_audience"
+	// DenyReasonTenantMismatch: the token was issued for another organization, or for an
+	// organization while the route manages the whole service.
+	DenyReasonTenantMismatch DenyReason = "tenant_mismatch"
+	// DenyReasonInsufficientScope: a role of the caller is granted, but the token lacks scopes the rules require.
+	DenyReasonInsufficientScope DenyReason = "insufficient_scope"
+)
+
+const insufficientScopeChallengePrefix = `Bearer error="insufficient_scope"`
+
+// InsufficientScopeChallenge is the RFC 6750 WWW-Authenticate challenge for a token lacking the scopes.
+func InsufficientScopeChallenge(scopes []string) string {
+	return fmt.Sprintf(`%s, scope="%s"`, insufficientScopeChallengePrefix, strings.Join(scopes, " "))
+}
+
+// IsInsufficientScopeChallenge reports whether a denial message is an InsufficientScopeChallenge,
+// HTTP frontends send it in the WWW-Authenticate header of the 403 response.
+func IsInsufficientScopeChallenge(message string) bool {
+	return strings.HasPrefix(message, insufficientScopeChallengePrefix)
+}
+
+// AccessDecision is the outcome of authorizing an AccessRequest.
+type AccessDecision struct {
+	Allowed bool
+	// Rule the decision is based on, nil when no rule matches the route.
+	Rule *RouteRule
+	// Roles of the caller the rules were evaluated for.
+	Roles []Role
+	// Reason of a denial, empty when allowed.
+	Reason DenyReason
+	// MissingScopes the token must also grant to be allowed, set with DenyReasonInsufficientScope.
+	MissingScopes []string
+}
+
+// RequestFields gives access to the fields of the request being authorized.
+// Nested fields are addressed with dots, e.g. "user.id".
+type RequestFields interface {
+	Field(name string) (string, bool)
+}
+
+// MapRequestFields are request fields passed explicitly, e.g. by services calling Check.
+type MapRequestFields map[string]string
+
+func (f MapRequestFields) Field(name string) (string, bool) {
+	value, ok := f[name]
+	return value, ok
+}
+
+// Headers carrying the verified claims of the caller to upstream services behind a proxy
+// that delegates authorization here.
+const (
+	UserIDHeader     = "X-User-Id"
+	UserNameHeader   = "X-User-Name"
+	UserRoleHeader   = "X-User-Role"
+	UserTenantHeader = "X-User-Tenant"
+)
+
+// ClaimHeaders returns the claim headers for the caller. Every header is present, empty when
+// the claim is not set, so that proxies drop the values sent by the client instead of passing
+// them on. Roles are separated by commas.
+func ClaimHeaders(claims *UserClaims) map[string]string {
+	roles := make([]string, 0, len(claims.Roles))
+	for _, role := range claims.Roles {
+		roles = append(roles, string(role))
+	}
+	return map[string]string{
+		UserIDHeader:     claims.Subject,
+		UserNameHeader:   claims.Username,
+		UserRoleHeader:   strings.Join(roles, ","),
+		UserTenantHeader: claims.Tenant,
+	}
+}
+
+// Policy is the full set of route rules at a version, the version grows with every change.
+type Policy struct {
+	Version int64
+	Rules   []RouteRule
+}
+
+// RouteRuleFilter selects a page of route rules ordered by id.
+type RouteRuleFilter struct {
+	RoutePrefix string
+	Role        Role
+	AfterID     int64
+	Limit       uint64
+}
+
+type RouteRulePage struct {
+	Rules []RouteRule
+	// NextPageToken is empty on the last page.
+	NextPageToken string
+}
+
+// ReplaceResult counts the changes made by a bulk replace of route rules.
+type ReplaceResult struct {
+	Created int64
+	Updated int64
+	Deleted int64
+}
+
+// RouteGrant is a route pattern of the policy reachable with a set of roles.
+type RouteGrant struct {
+	Route  string
+	Method string
+	// RuleID and Role of the allow rule granting the route.
+	RuleID int64
+	Role   Role
+	// Conditional grants depend on the call: the granting rule has an owner field, a
+	// condition or requires scopes, or a deny rule with a condition may apply.
+	Conditional bool
+}
+
+// RouteGrantFilter selects a page of route grants ordered by route and method.
+type RouteGrantFilter struct {
+	RoutePrefix string
+	Limit       uint64
+}
+
+type RouteGrantPage struct {
+	Grants []RouteGrant
+	// NextPageToken is empty on the last page.
+	NextPageToken string
+}
+
+// UserRoles are the roles of a user together with the roles they inherit.
+type UserRoles struct {
+	UserID int64
+	Name   string
+	Roles  []Role
+}
+
+type RoleGrant struct {
+	Role  Role
+	Grant RouteGrant
+}
+
+type UserGrant struct {
+	UserID int64
+	Name   string
+	Grant  RouteGrant
+}
+
+// RoutePrincipals are the roles and a page of the users that can reach a route.
+type RoutePrincipals struct {
+	// Roles are only returned with the first page.
+	Roles []RoleGrant
+	Users []UserGrant
+	// NextPageToken is empty on the last page.
+	NextPageToken string
+}
@@ -0,0 +1,38 @@
+package model
+
+import (
+	"time"
+)
+
+const (
+	AuditActionCreate = "create"
+	AuditActionUpdate = "update"
+	AuditActionDelete = "delete"
+
+	// Actions on role requests: requested, approved with the role granted, denied, and the
+	// granted role removed once expired.
+	AuditActionRequest = "request"
+	AuditActionApprove = "approve"
+	AuditActionDeny    = "deny"
+	AuditActionExpire  = "expire"
+
+	// AuditActorSystem is the actor of changes made by background jobs and policy files.
+	AuditActorSystem = "system"
+
+	AuditResourceRouteRule   = "route_rule"
+	AuditResourceRoleRequest = "role_request"
+	AuditResourceRole        = "role"
+)
+
+// AuditRecord is a change made through an admin API. Before and After are stored as JSON,
+// Before is nil for created resources and After is nil for deleted ones.
+type AuditRecord struct {
+	ID           int64
+	Actor        string
+	Action       string
+	ResourceType string
+	ResourceID   string
+	Before       any
+	After        any
+	CreatedAt    time.Time
+}
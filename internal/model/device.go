@@ -0,0 +1,46 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+const (
+	DeviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+	DeviceCodePending  DeviceCodeStatus = "pending"
+	DeviceCodeApproved DeviceCodeStatus = "approved"
+	DeviceCodeDenied   DeviceCodeStatus = "denied"
+)
+
+type DeviceCodeStatus string
+
+type DeviceCode struct {
+	ID             int64
+	DeviceCodeHash string
+	UserCode       string
+	ClientID       string
+	// Scope is the space-delimited list of scopes requested by the device.
+	Scope        string
+	Status       DeviceCodeStatus
+	UserID       sql.NullInt64
+	PollInterval time.Duration
+	ExpiresAt    time.Time
+	LastPolledAt sql.NullTime
+	CreatedAt    time.Time
+}
+
+type DeviceAuthorization struct {
+	DeviceCode              string
+	UserCode                string
+	VerificationURI         string
+	VerificationURIComplete string
+	ExpiresIn               time.Duration
+	Interval                time.Duration
+}
+
+type TokenPair struct {
+	RefreshToken string
+	AccessToken  string
+	ExpiresIn    time.Duration
+}
@@ -0,0 +1,68 @@
+package model
+
+import (
+	"time"
+)
+
+// Group is a set of users sharing the roles bound to the group and to the groups it is nested in.
+type Group struct {
+	ID          int64
+	Name        string
+	Description string
+	Roles       []Role
+	// ParentIDs are the groups this group is nested in.
+	ParentIDs []int64
+	CreatedAt time.Time
+}
+
+type CreateGroup struct {
+	Name        string
+	Description string
+	Roles       []Role
+}
+
+// RoleSourceKind is a kind of step on the path granting a role to a user.
+type RoleSourceKind string
+
+const (
+	RoleSourceGroup        RoleSourceKind = "group"
+	RoleSourceOrganization RoleSourceKind = "organization"
+	RoleSourceRole         RoleSourceKind = "role"
+)
+
+type RolePathStep struct {
+	Kind RoleSourceKind
+	// ID of the group, zero for other kinds.
+	ID   int64
+	Name string
+}
+
+// EffectiveRole is a role of a user with the shortest path granting it, e.g. the groups
+// the user is a member of followed by the roles the last group binds and inherits.
+// The path ends with the role itself.
+type EffectiveRole struct {
+	Role Role
+	Path []RolePathStep
+}
+
+// GroupNode is a group reachable from the groups of a user.
+type GroupNode struct {
+	Name      string
+	ParentIDs []int64
+	Roles     []Role
+}
+
+// RoleGraph is everything granting roles to a user.
+type RoleGraph struct {
+	// Roles assigned to the user directly.
+	Roles []Role
+	// Tenant the access is computed for and the roles assigned to the user there.
+	Tenant      string
+	TenantRoles []Role
+	// GroupIDs are the groups the user is a member of, Groups holds them together with
+	// all the groups they are nested in.
+	GroupIDs []int64
+	Groups   map[int64]GroupNode
+	// RoleParents maps every role to the roles it inherits directly.
+	RoleParents map[Role][]Role
+}
@@ -0,0 +1,38 @@
+package model
+
+import (
+	"time"
+)
+
+// ExternalIdentity is a user authenticated by an upstream identity provider.
+type ExternalIdentity struct {
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Claims        map[string]any
+}
+
+// FederatedState is a federated login in progress, it is consumed by the callback
+// so that the state cannot be replayed.
+type FederatedState struct {
+	ID        string
+	ExpiresAt time.Time
+}
+
+// FederatedLogin is a started federated login: the user is redirected to AuthorizationURL and
+// the browser keeps Binding, which the callback must present with the state.
+type FederatedLogin struct {
+	AuthorizationURL string
+	Binding          string
+}
+
+type UserIdentity struct {
+	ID        int64
+	UserID    int64
+	Provider  string
+	Subject   string
+	Email     string
+	CreatedAt time.Time
+}
@@ -0,0 +1,20 @@
+package model
+
+import (
+	"time"
+)
+
+// Organization is a tenant of the service, tokens are issued for one organization at a time.
+type Organization struct {
+	ID        int64
+	Slug      string
+	Name      string
+	CreatedAt time.Time
+}
+
+// OrganizationMember is a user belonging to an organization with the roles assigned there.
+type OrganizationMember struct {
+	OrganizationID int64
+	UserID         int64
+	Roles          []Role
+}
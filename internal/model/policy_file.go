@@ -0,0 +1,49 @@
+package model
+
+// RoleDefinition is a role with the roles it inherits from and its permissions.
+type RoleDefinition struct {
+	Name        Role     `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Parents     []Role   `json:"parents,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+// PolicyDocument is the whole route policy as kept in a policy file: every role and every
+// route rule, anything missing from it is removed when the document is applied.
+type PolicyDocument struct {
+	Roles []RoleDefinition
+	Rules []RouteRule
+}
+
+type PolicyChangeKind string
+
+const (
+	PolicyChangeCreate PolicyChangeKind = "create"
+	PolicyChangeUpdate PolicyChangeKind = "update"
+	PolicyChangeDelete PolicyChangeKind = "delete"
+)
+
+// RoleChange is a difference of a role, Before is nil for created roles and After is nil for deleted ones.
+type RoleChange struct {
+	Kind   PolicyChangeKind
+	Before *RoleDefinition
+	After  *RoleDefinition
+}
+
+// RuleChange is a difference of a route rule, rules are matched by route, method and role.
+type RuleChange struct {
+	Kind   PolicyChangeKind
+	Before *RouteRule
+	After  *RouteRule
+}
+
+// PolicyPlan is what applying a policy document changes in the stored policy.
+type PolicyPlan struct {
+	Roles []RoleChange
+	Rules []RuleChange
+}
+
+// Len is the number of changes, zero when the stored policy matches the document.
+func (p *PolicyPlan) Len() int {
+	return len(p.Roles) + len(p.Rules)
+}
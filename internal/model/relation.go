@@ -0,0 +1,92 @@
+package model
+
+const (
+	// RelationUnion nodes of an expanded relation hold the operands of its union.
+	RelationUnion = "union"
+	// RelationLeaf nodes of an expanded relation hold the subjects of stored tuples.
+	RelationLeaf = "leaf"
+)
+
+// RelationObject is an object of a namespace, written as namespace:id.
+type RelationObject struct {
+	Namespace string
+	ID        string
+}
+
+func (o RelationObject) String() string {
+	return o.Namespace + ":" + o.ID
+}
+
+// RelationSubject is who a relation tuple is granted to: a user or, with Object set, the
+// subjects having Relation to that object (a userset). The relation is empty when the
+// subject is the object itself, e.g. the parent folder in document:7#parent@folder:3.
+type RelationSubject struct {
+	UserID   string
+	Object   RelationObject
+	Relation string
+}
+
+func (s RelationSubject) IsUser() bool {
+	return s.UserID != ""
+}
+
+func (s RelationSubject) String() string {
+	if s.IsUser() {
+		return s.UserID
+	}
+	if s.Relation == "" {
+		return s.Object.String()
+	}
+	return s.Object.String() + "#" + s.Relation
+}
+
+// RelationTuple states that Subject has Relation to Object, written as object#relation@subject.
+type RelationTuple struct {
+	// ID is set for stored tuples only.
+	ID       int64
+	Object   RelationObject
+	Relation string
+	Subject  RelationSubject
+}
+
+func (t RelationTuple) String() string {
+	return t.Object.String() + "#" + t.Relation + "@" + t.Subject.String()
+}
+
+// RelationTupleFilter selects tuples ordered by id, empty fields match any value.
+type RelationTupleFilter struct {
+	Namespace string
+	ObjectID  string
+	Relation  string
+	Subject   *RelationSubject
+	AfterID   int64
+	Limit     uint64
+}
+
+// RelationConsistency selects the snapshot relation reads are evaluated at, the latest
+// one when no token is set. Tokens are returned by writes and reads.
+type RelationConsistency struct {
+	// AtLeastAsFresh requires the snapshot to include the writes made up to the token.
+	AtLeastAsFresh string
+	// AtExactSnapshot evaluates at the snapshot of the token.
+	AtExactSnapshot string
+}
+
+type RelationTuplePage struct {
+	Tuples []RelationTuple
+	// NextPageToken is empty on the last page.
+	NextPageToken string
+	// Token is the consistency token of the snapshot read.
+	Token string
+}
+
+// RelationExpandNode is a node of the userset tree of an expanded relation.
+type RelationExpandNode struct {
+	Operation string
+	Object    RelationObject
+	Relation  string
+	// Subjects of a leaf node, usersets among them are not expanded further.
+	Subjects []RelationSubject
+	// Children of a union node.
+	Children []*RelationExpandNode
+}
@@ -0,0 +1,68 @@
+package model
+
+import (
+	"time"
+)
+
+type RoleRequestStatus string
+
+const (
+	RoleRequestPending  RoleRequestStatus = "pending"
+	RoleRequestApproved RoleRequestStatus = "approved"
+	RoleRequestDenied   RoleRequestStatus = "denied"
+	// RoleRequestExpired is an approved request whose grant has been removed.
+	RoleRequestExpired RoleRequestStatus = "expired"
+)
+
+// RoleRequest asks for a role for a limited time, an approver grants or denies it.
+type RoleRequest struct {
+	ID            int64
+	UserID        int64
+	Role          Role
+	Justification string
+	Duration      time.Duration
+	Status        RoleRequestStatus
+	// ApproverID decided on the request, zero while pending.
+	ApproverID      int64
+	DecisionComment string
+	CreatedAt       time.Time
+	DecidedAt       *time.Time
+	// ExpiresAt is when the granted role is removed, set once approved.
+	ExpiresAt *time.Time
+}
+
+type CreateRoleRequest struct {
+	UserID        int64
+	Role          Role
+	Justification string
+	Duration      time.Duration
+}
+
+// RoleRequestDecision resolves a pending request.
+type RoleRequestDecision struct {
+	ID         int64
+	Status     RoleRequestStatus
+	ApproverID int64
+	Comment    string
+	DecidedAt  time.Time
+}
+
+// RoleRequestFilter selects a page of role requests ordered by id, zero fields match any request.
+type RoleRequestFilter struct {
+	UserID  int64
+	Status  RoleRequestStatus
+	AfterID int64
+	Limit   uint64
+}
+
+type RoleRequestPage struct {
+	Requests []RoleRequest
+	// NextPageToken is empty on the last page.
+	NextPageToken string
+}
+
+// ExpiredRoleGrant is a time-bound role removed from a user once expired.
+type ExpiredRoleGrant struct {
+	UserID int64
+	Role   Role
+}
@@ -0,0 +1,67 @@
+package model
+
+import (
+	"slices"
+	"strings"
+)
+
+// OAuthClient is an application users log in to, tokens issued to it carry its scopes at most.
+type OAuthClient struct {
+	ID string
+	// Scopes is nil when the client is not limited.
+	Scopes []string
+}
+
+// ScopeRequest is what a token is requested for: the client, empty for first-party logins,
+// and the scopes it asks for, none to get every scope it may have.
+type ScopeRequest struct {
+	ClientID string
+	Scopes   []string
+}
+
+// TokenGrant is what a token is issued for besides the user.
+type TokenGrant struct {
+	// ClientID is the client the token is issued to, empty for first-party logins.
+	ClientID string
+	// Scopes the token is restricted to, nil when it is not scope restricted.
+	Scopes []string
+}
+
+// Scope returns the space-delimited scope claim.
+func (g TokenGrant) Scope() string {
+	return strings.Join(g.Scopes, " ")
+}
+
+// GrantScopes returns the requested scopes that both the client and the user, through the
+// permissions of their roles, may have. Without requested scopes a client gets every scope it
+// may have and a first-party login a token that is not scope restricted. It fails when scopes
+// are requested but none of them can be granted.
+func GrantScopes(client *OAuthClient, requested []string, permissions []string) ([]string, bool) {
+	if client == nil && len(requested) == 0 {
+		return nil, true
+	}
+
+	allowed := permissions
+	if client != nil && client.Scopes != nil {
+		allowed = make([]string, 0, len(client.Scopes))
+		for _, scope := range client.Scopes {
+			if slices.Contains(permissions, scope) {
+				allowed = append(allowed, scope)
+			}
+		}
+	}
+	if len(requested) == 0 {
+		return allowed, true
+	}
+
+	granted := make([]string, 0, len(requested))
+	for _, scope := range requested {
+		if slices.Contains(allowed, scope) && !slices.Contains(granted, scope) {
+			granted = append(granted, scope)
+		}
+	}
+	if len(granted) == 0 {
+		return nil, false
+	}
+	return granted, true
+}
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"time"
+)
+
+const (
+	TokenExchangeGrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+	AccessTokenType        = "urn:ietf:params:oauth:token-type:access_token"
+
+	// TokenExchangePermission is granted to the service principals that may act in token
+	// exchanges, clients need it as a scope.
+	TokenExchangePermission = "tokens:exchange"
+)
+
+// Actor is the RFC 8693 "act" claim, nested actors record the whole delegation chain.
+type Actor struct {
+	Username string `json:"sub"`
+	Act      *Actor `json:"act,omitempty"`
+}
+
+type TokenExchange struct {
+	GrantType          string
+	SubjectToken       string
+	SubjectTokenType   string
+	ActorToken         string
+	ActorTokenType     string
+	RequestedTokenType string
+	Audience           []string
+	Scopes             []string
+	// Tenant switches the issued token to another organization of the subject, the
+	// tenant of the subject token is kept when empty.
+	Tenant string
+	// Roles the issued token keeps, all roles of the subject when empty.
+	Roles []Role
+}
+
+type ExchangedToken struct {
+	AccessToken     string
+	IssuedTokenType string
+	ExpiresIn       time.Duration
+	Scopes          []string
+}
@@ -2,11 +2,14 @@ package model
 
 import (
 	"database/sql"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// Built-in roles, other roles are created as data in the roles table.
 const (
 	UserRole  Role = "user"
 	AdminRole Role = "admin"
@@ -14,13 +17,23 @@ const (
 
 type Role string
 
+// UserAccess is what a user is allowed to do: the assigned roles together with
+// the roles they inherit and the permissions granted to all of them.
+type UserAccess struct {
+	// Tenant is the organization the access is computed for, its roles add to the
+	// roles assigned in every organization. Empty outside of organizations.
+	Tenant      string
+	Roles       []Role
+	Permissions []string
+}
+
 type CreateUser struct {
 	Name            string
 	Email           string
 	Password        string
 	PasswordConfirm string
 	PasswordHash    string
-	Role            Role
+	Roles           []Role
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 }
@@ -36,13 +49,92 @@ type User struct {
 	Name         string
 	Email        string
 	PasswordHash string
-	Role         Role
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+	Roles        []Role
+	// TokensRevokedAt is when the tokens issued to the user were last revoked, zero if never.
+	TokensRevokedAt time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// TokenRevocation is the moment the tokens of a user issued before it became invalid.
+type TokenRevocation struct {
+	UserID    int64
+	RevokedAt time.Time
 }
 
 type UserClaims struct {
 	jwt.RegisteredClaims
-	Username string `json:"username"`
-	Role     Role   `json:"role"`
+	Username    string   `json:"username"`
+	Roles       []Role   `json:"roles,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+	Scope       string   `json:"scope,omitempty"`
+	Act         *Actor   `json:"act,omitempty"`
+	// Tenant is the slug of the organization the token was issued for.
+	Tenant string `json:"tenant,omitempty"`
+	// ClientID is the OAuth client the token was issued to, empty for first-party logins.
+	ClientID string `json:"client_id,omitempty"`
+}
+
+// HasRole reports whether the role is assigned to the user directly.
+func (u *User) HasRole(role Role) bool {
+	return slices.Contains(u.Roles, role)
+}
+
+// HasRole reports whether the token grants the role, assigned or inherited.
+func (c *UserClaims) HasRole(role Role) bool {
+	return slices.Contains(c.Roles, role)
+}
+
+// HasGlobalRole reports whether the token grants the role outside of organizations. Roles of
+// tokens issued for an organization may be assigned in the organization only.
+func (c *UserClaims) HasGlobalRole(role Role) bool {
+	return c.Tenant == "" && c.HasRole(role)
+}
+
+// HasPermission reports whether the token grants the permission.
+func (c *UserClaims) HasPermission(permission string) bool {
+	return slices.Contains(c.Permissions, permission)
+}
+
+// Unrestricted reports whether the token is a first-party token of the user that is not
+// narrowed by a scope, a client or an exchange.
+func (c *UserClaims) Unrestricted() bool {
+	return c.Scopes() == nil && c.ClientID == "" && c.Act == nil
+}
+
+// IssuedBefore reports whether the token was issued before t, tokens without the iat claim
+// are treated as issued before any revocation. A zero t never matches. Token timestamps have
+// a precision of seconds, so t is rounded up to a whole second: tokens issued within the second
+// of t cannot be told apart and count as issued before it.
+func (c *UserClaims) IssuedBefore(t time.Time) bool {
+	if t.IsZero() {
+		return false
+	}
+	revokedAt := t.Truncate(time.Second)
+	if revokedAt.Before(t) {
+		revokedAt = revokedAt.Add(time.Second)
+	}
+	return c.IssuedAt == nil || c.IssuedAt.Time.Before(revokedAt)
+}
+
+// Scopes returns the space-delimited scope claim as a list, nil means the token is not scope
+// restricted. Tokens issued to a client are always restricted, possibly to no scope at all.
+func (c *UserClaims) Scopes() []string {
+	if c.Scope == "" {
+		if c.ClientID != "" {
+			return []string{}
+		}
+		return nil
+	}
+	return strings.Fields(c.Scope)
+}
+
+// HasScope reports whether the token grants the scope, tokens that are not scope restricted
+// grant the permissions of the user.
+func (c *UserClaims) HasScope(scope string) bool {
+	scopes := c.Scopes()
+	if scopes == nil {
+		return c.HasPermission(scope)
+	}
+	return slices.Contains(scopes, scope)
 }
@@ -0,0 +1,157 @@
+package policy
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/arifullov/auth/internal/client/db"
+	"github.com/arifullov/auth/internal/logger"
+	"github.com/arifullov/auth/internal/metric"
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/repository"
+)
+
+const (
+	// NotifyChannel receives the new policy version on every change of route_accesses.
+	NotifyChannel = "policy_changed"
+
+	reloadStatusOK    = "ok"
+	reloadStatusError = "error"
+)
+
+// Snapshot is an immutable compiled policy shared by concurrent checks.
+type Snapshot struct {
+	Index    *Index
+	Version  int64
+	LoadedAt time.Time
+}
+
+// Cache keeps the compiled route policy in memory. Readers load the current snapshot
+// without locking, reloads build a new snapshot and swap it in.
+type Cache struct {
+	accessRepository repository.AccessRepository
+
+	current atomic.Pointer[Snapshot]
+	// reloadMu serializes reloads, so an older policy never replaces a newer one.
+	reloadMu sync.Mutex
+}
+
+func NewCache(accessRepository repository.AccessRepository) *Cache {
+	return &Cache{
+		accessRepository: accessRepository,
+	}
+}
+
+// Snapshot returns the current policy, loading it on first use.
+func (c *Cache) Snapshot(ctx context.Context) (*Snapshot, error) {
+	if snapshot := c.current.Load(); snapshot != nil {
+		return snapshot, nil
+	}
+	if err := c.Reload(ctx); err != nil {
+		return nil, err
+	}
+	return c.current.Load(), nil
+}
+
+// Reload loads the full policy from the database. A policy with invalid rules is not loaded,
+// leaving out an invalid deny rule would grant what it denies, so the previous policy is kept.
+func (c *Cache) Reload(ctx context.Context) error {
+	c.reloadMu.Lock()
+	defer c.reloadMu.Unlock()
+
+	policy, err := c.load(ctx)
+	if err != nil {
+		metric.IncPolicyReloadCounter(reloadStatusError)
+		return err
+	}
+
+	index, err := NewIndex(policy.Rules)
+	if err != nil {
+		metric.IncPolicyReloadCounter(reloadStatusError)
+		return errors.Wrapf(err, "policy version %d is not loaded", policy.Version)
+	}
+
+	snapshot := &Snapshot{
+		Index:    index,
+		Version:  policy.Version,
+		LoadedAt: time.Now(),
+	}
+	c.current.Store(snapshot)
+	metric.IncPolicyReloadCounter(reloadStatusOK)
+	metric.SetPolicyVersion(snapshot.Version, snapshot.LoadedAt)
+	return nil
+}
+
+// load reads the version before the rules. A change committed in between makes the rules
+// newer than the version, its notification then carries a greater version and triggers
+// another reload, so the cache never stays behind.
+func (c *Cache) load(ctx context.Context) (*model.Policy, error) {
+	version, err := c.accessRepository.GetPolicyVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := c.accessRepository.ListRouteRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &model.Policy{
+		Version: version,
+		Rules:   rules,
+	}, nil
+}
+
+// Watch keeps the cache fresh until the context is canceled: it reloads on notifications
+// about a newer version and fully every reloadInterval in case a notification was lost.
+func (c *Cache) Watch(ctx context.Context, listener db.Listener, reloadInterval time.Duration) {
+	notifications := make(chan string, 1)
+	go func() {
+		err := listener.Listen(ctx, NotifyChannel, func(payload string) {
+			// Coalesce bursts of changes into a single pending reload.
+			select {
+			case notifications <- payload:
+			default:
+			}
+		})
+		if err != nil {
+			logger.Errorf("failed to listen for policy changes: %s", err.Error())
+		}
+	}()
+
+	ticker := time.NewTicker(reloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case payload := <-notifications:
+			if !c.isStale(payload) {
+				continue
+			}
+		case <-ticker.C:
+		}
+
+		if err := c.Reload(ctx); err != nil && ctx.Err() == nil {
+			logger.Errorf("failed to reload policy: %s", err.Error())
+		}
+	}
+}
+
+// isStale reports whether a notification payload is newer than the cached policy.
+// An empty or unknown payload is treated as stale.
+func (c *Cache) isStale(payload string) bool {
+	snapshot := c.current.Load()
+	if snapshot == nil {
+		return true
+	}
+	version, err := strconv.ParseInt(payload, 10, 64)
+	if err != nil {
+		return true
+	}
+	return version > snapshot.Version
+}
@@ -0,0 +1,109 @@
+package policy
+
+import (
+	"net/netip"
+	"time"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
+	"github.com/pkg/errors"
+
+	"github.com/arifullov/auth/internal/logger"
+)
+
+// conditionEnv is what rule conditions are evaluated against, e.g.
+//
+//	time.Hour() >= 9 && time.Hour() < 18
+//	inCIDR(ip, "10.0.0.0/8")
+//	headers["x-tenant-id"] == claims.sub
+type conditionEnv struct {
+	Claims  conditionClaims   `expr:"claims"`
+	Route   string            `expr:"route"`
+	Method  string            `expr:"method"`
+	IP      string            `expr:"ip"`
+	Time    time.Time         `expr:"time"`
+	Headers map[string]string `expr:"headers"`
+}
+
+type conditionClaims struct {
+	Subject     string   `expr:"sub"`
+	Username    string   `expr:"username"`
+	Audience    []string `expr:"aud"`
+	Roles       []string `expr:"roles"`
+	Permissions []string `expr:"permissions"`
+	Scopes      []string `expr:"scopes"`
+	Tenant      string   `expr:"tenant"`
+}
+
+var conditionOptions = []expr.Option{
+	expr.Env(conditionEnv{}),
+	expr.AsBool(),
+	expr.Function("inCIDR", inCIDR, new(func(string, string) bool)),
+}
+
+// compileCondition type checks the expression, an empty expression is always true.
+func compileCondition(condition string) (*vm.Program, error) {
+	if condition == "" {
+		return nil, nil
+	}
+	program, err := expr.Compile(condition, conditionOptions...)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid rule condition")
+	}
+	return program, nil
+}
+
+func newConditionEnv(req Request) conditionEnv {
+	env := conditionEnv{
+		Route:   req.Route,
+		Method:  req.Method,
+		IP:      req.IP,
+		Time:    req.Time,
+		Headers: req.Headers,
+	}
+	if env.Time.IsZero() {
+		env.Time = time.Now()
+	}
+	if req.Claims != nil {
+		roles := make([]string, 0, len(req.Claims.Roles))
+		for _, role := range req.Claims.Roles {
+			roles = append(roles, string(role))
+		}
+		env.Claims = conditionClaims{
+			Subject:     req.Claims.Subject,
+			Username:    req.Claims.Username,
+			Audience:    req.Claims.Audience,
+			Roles:       roles,
+			Permissions: req.Claims.Permissions,
+			Scopes:      req.Claims.Scopes(),
+			Tenant:      req.Claims.Tenant,
+		}
+	}
+	return env
+}
+
+func inCIDR(params ...any) (any, error) {
+	ip, err := netip.ParseAddr(params[0].(string))
+	if err != nil {
+		return false, nil
+	}
+	prefix, err := netip.ParsePrefix(params[1].(string))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid cidr %q", params[1])
+	}
+	return prefix.Contains(ip.Unmap()), nil
+}
+
+// holds evaluates the condition of the rule, a failing evaluation counts as not holding.
+func (r *compiledRule) holds(env *conditionEnv) bool {
+	if r.condition == nil {
+		return true
+	}
+	out, err := expr.Run(r.condition, *env)
+	if err != nil {
+		logger.Warnf("failed to evaluate condition of route rule %d: %s", r.rule.ID, err.Error())
+		return false
+	}
+	allowed, _ := out.(bool)
+	return allowed
+}
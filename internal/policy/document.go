@@ -0,0 +1,282 @@
+package policy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/arifullov/auth/internal/model"
+)
+
+type DocumentFormat string
+
+const (
+	YAMLFormat DocumentFormat = "yaml"
+	JSONFormat DocumentFormat = "json"
+)
+
+// document is the file format of a policy document, e.g.
+//
+//	roles:
+//	  - name: auditor
+//	    parents: [user]
+//	    permissions: [audit.read]
+//	conditions:
+//	  office: inCIDR(ip, "10.0.0.0/8")
+//	rules:
+//	  - route: /audit_v1.AuditV1/**
+//	    role: auditor
+//	    condition_ref: office
+//
+// Conditions are named expressions shared by rules, a rule has either its own condition
+// or a reference to a named one.
+type document struct {
+	Roles      []documentRole    `json:"roles" yaml:"roles"`
+	Conditions map[string]string `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+	Rules      []documentRule    `json:"rules" yaml:"rules"`
+}
+
+type documentRole struct {
+	Name        string   `json:"name" yaml:"name"`
+	Description string   `json:"description,omitempty" yaml:"description,omitempty"`
+	Parents     []string `json:"parents,omitempty" yaml:"parents,omitempty,flow"`
+	Permissions []string `json:"permissions,omitempty" yaml:"permissions,omitempty,flow"`
+}
+
+type documentRule struct {
+	Route        string   `json:"route" yaml:"route"`
+	Method       string   `json:"method,omitempty" yaml:"method,omitempty"`
+	Role         string   `json:"role,omitempty" yaml:"role,omitempty"`
+	Effect       string   `json:"effect,omitempty" yaml:"effect,omitempty"`
+	Scopes       []string `json:"scopes,omitempty" yaml:"scopes,omitempty,flow"`
+	OwnerField   string   `json:"owner_field,omitempty" yaml:"owner_field,omitempty"`
+	Condition    string   `json:"condition,omitempty" yaml:"condition,omitempty"`
+	ConditionRef string   `json:"condition_ref,omitempty" yaml:"condition_ref,omitempty"`
+}
+
+// FormatOf tells the format of a policy file by its extension.
+func FormatOf(path string) (DocumentFormat, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return YAMLFormat, nil
+	case ".json":
+		return JSONFormat, nil
+	default:
+		return "", errors.Errorf("unknown policy file format of %q, expected .yaml, .yml or .json", path)
+	}
+}
+
+// ReadDocumentFile reads the policy document from a YAML or JSON file.
+func ReadDocumentFile(path string) (*model.PolicyDocument, error) {
+	format, err := FormatOf(path)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read policy file")
+	}
+	return DecodeDocument(data, format)
+}
+
+// DecodeDocument parses the policy document and resolves the named conditions of its rules,
+// unknown fields are rejected so that a misspelled field is not silently dropped.
+func DecodeDocument(data []byte, format DocumentFormat) (*model.PolicyDocument, error) {
+	var doc document
+	switch format {
+	case YAMLFormat:
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&doc); err != nil && !errors.Is(err, io.EOF) {
+			return nil, errors.Wrap(err, "invalid policy document")
+		}
+	case JSONFormat:
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&doc); err != nil {
+			return nil, errors.Wrap(err, "invalid policy document")
+		}
+	default:
+		return nil, errors.Errorf("unknown policy document format %q", format)
+	}
+
+	res := &model.PolicyDocument{
+		Roles: make([]model.RoleDefinition, 0, len(doc.Roles)),
+		Rules: make([]model.RouteRule, 0, len(doc.Rules)),
+	}
+	for _, role := range doc.Roles {
+		res.Roles = append(res.Roles, model.RoleDefinition{
+			Name:        model.Role(role.Name),
+			Description: role.Description,
+			Parents:     toRoles(role.Parents),
+			Permissions: role.Permissions,
+		})
+	}
+	for _, rule := range doc.Rules {
+		condition := rule.Condition
+		if rule.ConditionRef != "" {
+			if condition != "" {
+				return nil, errors.Errorf("rule for route %s has both a condition and a condition_ref", rule.Route)
+			}
+			var ok bool
+			if condition, ok = doc.Conditions[rule.ConditionRef]; !ok {
+				return nil, errors.Errorf("rule for route %s refers to unknown condition %q", rule.Route, rule.ConditionRef)
+			}
+		}
+		res.Rules = append(res.Rules, model.RouteRule{
+			Route:      rule.Route,
+			Method:     rule.Method,
+			Role:       model.Role(rule.Role),
+			Effect:     model.RouteEffect(rule.Effect),
+			Scopes:     rule.Scopes,
+			OwnerField: rule.OwnerField,
+			Condition:  condition,
+		})
+	}
+	return res, nil
+}
+
+// EncodeDocument writes the policy document with every condition inline.
+func EncodeDocument(doc *model.PolicyDocument, format DocumentFormat) ([]byte, error) {
+	out := document{
+		Roles: make([]documentRole, 0, len(doc.Roles)),
+		Rules: make([]documentRule, 0, len(doc.Rules)),
+	}
+	for _, role := range doc.Roles {
+		parents := make([]string, 0, len(role.Parents))
+		for _, parent := range role.Parents {
+			parents = append(parents, string(parent))
+		}
+		out.Roles = append(out.Roles, documentRole{
+			Name:        string(role.Name),
+			Description: role.Description,
+			Parents:     parents,
+			Permissions: role.Permissions,
+		})
+	}
+	for _, rule := range doc.Rules {
+		out.Rules = append(out.Rules, documentRule{
+			Route:      rule.Route,
+			Method:     rule.Method,
+			Role:       string(rule.Role),
+			Effect:     string(rule.Effect),
+			Scopes:     rule.Scopes,
+			OwnerField: rule.OwnerField,
+			Condition:  rule.Condition,
+		})
+	}
+
+	switch format {
+	case YAMLFormat:
+		var buf bytes.Buffer
+		encoder := yaml.NewEncoder(&buf)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(out); err != nil {
+			return nil, err
+		}
+		if err := encoder.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case JSONFormat:
+		data, err := json.MarshalIndent(out, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(data, '\n'), nil
+	default:
+		return nil, errors.Errorf("unknown policy document format %q", format)
+	}
+}
+
+// WritePlan writes the plan as a diff, one line per change prefixed with +, ~ or -.
+func WritePlan(w io.Writer, plan *model.PolicyPlan) error {
+	if plan.Len() == 0 {
+		_, err := fmt.Fprintln(w, "no changes, the stored policy matches the document")
+		return err
+	}
+	for _, change := range plan.Roles {
+		var err error
+		switch change.Kind {
+		case model.PolicyChangeCreate:
+			_, err = fmt.Fprintf(w, "+ role %s\n", describeRole(change.After))
+		case model.PolicyChangeUpdate:
+			_, err = fmt.Fprintf(w, "~ role %s\n    was %s\n", describeRole(change.After), describeRole(change.Before))
+		case model.PolicyChangeDelete:
+			_, err = fmt.Fprintf(w, "- role %s\n", describeRole(change.Before))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	for _, change := range plan.Rules {
+		var err error
+		switch change.Kind {
+		case model.PolicyChangeCreate:
+			_, err = fmt.Fprintf(w, "+ rule %s\n", describeRule(change.After))
+		case model.PolicyChangeUpdate:
+			_, err = fmt.Fprintf(w, "~ rule %s\n    was %s\n", describeRule(change.After), describeRule(change.Before))
+		case model.PolicyChangeDelete:
+			_, err = fmt.Fprintf(w, "- rule %s\n", describeRule(change.Before))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "%d roles and %d rules to change\n", len(plan.Roles), len(plan.Rules))
+	return err
+}
+
+func describeRole(role *model.RoleDefinition) string {
+	s := string(role.Name)
+	if len(role.Parents) > 0 {
+		parents := make([]string, 0, len(role.Parents))
+		for _, parent := range role.Parents {
+			parents = append(parents, string(parent))
+		}
+		s += " parents=" + strings.Join(parents, ",")
+	}
+	if len(role.Permissions) > 0 {
+		s += " permissions=" + strings.Join(role.Permissions, ",")
+	}
+	if role.Description != "" {
+		s += fmt.Sprintf(" description=%q", role.Description)
+	}
+	return s
+}
+
+func describeRule(rule *model.RouteRule) string {
+	role := string(rule.Role)
+	if role == "" {
+		role = "(any role)"
+	}
+	s := fmt.Sprintf("%s %s %s %s", rule.Method, rule.Route, role, rule.Effect)
+	if len(rule.Scopes) > 0 {
+		s += " scopes=" + strings.Join(rule.Scopes, ",")
+	}
+	if rule.OwnerField != "" {
+		s += " owner_field=" + rule.OwnerField
+	}
+	if rule.Condition != "" {
+		s += fmt.Sprintf(" condition=%q", rule.Condition)
+	}
+	return s
+}
+
+func toRoles(names []string) []model.Role {
+	if len(names) == 0 {
+		return nil
+	}
+	roles := make([]model.Role, 0, len(names))
+	for _, name := range names {
+		roles = append(roles, model.Role(name))
+	}
+	return roles
+}
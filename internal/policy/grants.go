@@ -0,0 +1,116 @@
+package policy
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/arifullov/auth/internal/model"
+)
+
+// AccessibleRoutes returns the route patterns of the policy the roles may call, ordered by
+// route and method. Each pattern is evaluated as a route of its own, so "/user_v1.UserV1/*"
+// may be granted while the more specific "/user_v1.UserV1/Delete" is not.
+func (idx *Index) AccessibleRoutes(roles []model.Role) []model.RouteGrant {
+	type routeKey struct {
+		route  string
+		method string
+	}
+	seen := make(map[routeKey]struct{}, len(idx.rules))
+	var grants []model.RouteGrant
+	for i := range idx.rules {
+		r := &idx.rules[i]
+		key := routeKey{route: r.rule.Route, method: r.rule.Method}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		if grant, ok := idx.grant(r.rule.Route, r.pattern, r.rule.Method, roles); ok {
+			grants = append(grants, grant)
+		}
+	}
+
+	slices.SortFunc(grants, func(a, b model.RouteGrant) int {
+		if c := strings.Compare(a.Route, b.Route); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Method, b.Method)
+	})
+	return grants
+}
+
+// Grant tells whether the roles may call the route, which may be a pattern as well.
+func (idx *Index) Grant(route string, method string, roles []model.Role) (model.RouteGrant, bool, error) {
+	p, err := compilePattern(route)
+	if err != nil {
+		return model.RouteGrant{}, false, err
+	}
+	method = strings.ToUpper(method)
+	if method == "" {
+		method = model.AnyMethod
+	}
+	grant, ok := idx.grant(route, p, method, roles)
+	return grant, ok, nil
+}
+
+// grant evaluates the rules like Decide does, with rules covering the pattern in place of
+// rules matching a route. Owner fields, conditions and token scopes are not known in advance:
+// allow rules are taken as applying and deny rules with a condition or an owner field as not
+// applying, both make the grant conditional.
+func (idx *Index) grant(route string, p *pattern, method string, roles []model.Role) (model.RouteGrant, bool) {
+	var (
+		topAllow    *compiledRule
+		conditional bool
+	)
+	for i := range idx.rules {
+		r := &idx.rules[i]
+		if !r.covers(p, method) {
+			continue
+		}
+		if r.rule.Effect == model.DenyEffect {
+			if r.grantsRole(roles) {
+				if !r.conditional() {
+					return model.RouteGrant{}, false
+				}
+				conditional = true
+			}
+			continue
+		}
+		if topAllow == nil {
+			topAllow = r
+		}
+	}
+	if topAllow == nil {
+		return model.RouteGrant{}, false
+	}
+
+	for i := range idx.rules {
+		r := &idx.rules[i]
+		if r.rule.Effect != model.AllowEffect || !r.grantsRole(roles) || !r.covers(p, method) {
+			continue
+		}
+		if compareRules(*r, *topAllow) != 0 {
+			break
+		}
+		return model.RouteGrant{
+			Route:       route,
+			Method:      method,
+			RuleID:      r.rule.ID,
+			Role:        r.rule.Role,
+			Conditional: conditional || r.conditional(),
+		}, true
+	}
+	return model.RouteGrant{}, false
+}
+
+// covers reports whether the rule applies to every call of the pattern and method,
+// a rule for a method only covers that method while a rule for any method covers all.
+func (r *compiledRule) covers(p *pattern, method string) bool {
+	if r.rule.Method != model.AnyMethod && r.rule.Method != method {
+		return false
+	}
+	return r.pattern.covers(p)
+}
+
+func (r *compiledRule) conditional() bool {
+	return r.rule.OwnerField != "" || r.rule.Condition != "" || len(r.rule.Scopes) > 0
+}
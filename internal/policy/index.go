@@ -0,0 +1,284 @@
+package policy
+
+import (
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/expr-lang/expr/vm"
+	"github.com/pkg/errors"
+
+	"github.com/arifullov/auth/internal/model"
+)
+
+// Decision is the outcome of evaluating route rules for a caller.
+type Decision struct {
+	Allowed bool
+	// Rule is the rule the decision is based on, nil when no rule matches the route.
+	Rule *model.RouteRule
+	// Reason of a denial, empty when allowed.
+	Reason model.DenyReason
+	// MissingScopes the token must also grant, set with model.DenyReasonInsufficientScope.
+	MissingScopes []string
+}
+
+// Request is the call being authorized.
+type Request struct {
+	Route  string
+	Method string
+	// Claims of the caller, the subject is compared with the owner field of rules.
+	Claims *model.UserClaims
+	// Fields of the request message, nil when the caller passed none.
+	Fields model.RequestFields
+	// PathParams takes the owner fields from the named parameters of the rule pattern in the
+	// route instead of Fields, for HTTP requests upstreams route on the path of.
+	PathParams bool
+	// IP, Headers and Time are only used by rule conditions, zero Time means now.
+	IP      string
+	Headers map[string]string
+	Time    time.Time
+}
+
+// roles returns the roles of the caller, none for anonymous requests.
+func (req *Request) roles() []model.Role {
+	if req.Claims == nil {
+		return nil
+	}
+	return req.Claims.Roles
+}
+
+type compiledRule struct {
+	rule      model.RouteRule
+	pattern   *pattern
+	condition *vm.Program
+}
+
+var (
+	ownerFieldRe = regexp.MustCompile(`^[a-z_][a-z0-9_]*(\.[a-z_][a-z0-9_]*)*$`)
+	// scopeRe accepts the scope token characters of RFC 6749, section 3.3.
+	scopeRe = regexp.MustCompile(`^[\x21\x23-\x5B\x5D-\x7E]+$`)
+)
+
+// Index evaluates route rules. Rules are kept ordered from the most to the least specific one.
+type Index struct {
+	rules []compiledRule
+}
+
+// ValidateRule checks that the rule can be evaluated.
+func ValidateRule(rule model.RouteRule) error {
+	if _, err := compilePattern(rule.Route); err != nil {
+		return err
+	}
+	if rule.Effect != model.AllowEffect && rule.Effect != model.DenyEffect {
+		return errors.Errorf("invalid rule effect %q", rule.Effect)
+	}
+	if rule.Method == "" || strings.ContainsAny(rule.Method, " /") {
+		return errors.Errorf("invalid rule method %q", rule.Method)
+	}
+	if rule.OwnerField != "" && !ownerFieldRe.MatchString(rule.OwnerField) {
+		return errors.Errorf("invalid rule owner field %q", rule.OwnerField)
+	}
+	if _, err := compileCondition(rule.Condition); err != nil {
+		return err
+	}
+	for _, scope := range rule.Scopes {
+		if !scopeRe.MatchString(scope) {
+			return errors.Errorf("invalid rule scope %q", scope)
+		}
+	}
+	if rule.Effect == model.DenyEffect && len(rule.Scopes) > 0 {
+		return errors.New("deny rules cannot require scopes")
+	}
+	if rule.Role == "" && len(rule.Scopes) == 0 {
+		return errors.New("rules without a role must require scopes")
+	}
+	return nil
+}
+
+// NewIndex compiles the rules, invalid rules are returned as an error and left out of the index.
+func NewIndex(rules []model.RouteRule) (*Index, error) {
+	var errs []error
+	compiled := make([]compiledRule, 0, len(rules))
+	for _, rule := range rules {
+		if err := ValidateRule(rule); err != nil {
+			errs = append(errs, errors.Wrapf(err, "route rule %d", rule.ID))
+			continue
+		}
+		p, _ := compilePattern(rule.Route)
+		condition, _ := compileCondition(rule.Condition)
+		rule.Method = strings.ToUpper(rule.Method)
+		compiled = append(compiled, compiledRule{rule: rule, pattern: p, condition: condition})
+	}
+
+	sort.SliceStable(compiled, func(i, j int) bool {
+		if c := compareRules(compiled[i], compiled[j]); c != 0 {
+			return c > 0
+		}
+		if compiled[i].rule.Route != compiled[j].rule.Route {
+			return compiled[i].rule.Route < compiled[j].rule.Route
+		}
+		return compiled[i].rule.ID < compiled[j].rule.ID
+	})
+
+	return &Index{
+		rules: compiled,
+	}, joinErrors(errs)
+}
+
+// Decide evaluates the rules matching the route and method of the request for the caller roles.
+//
+// A matching deny rule for any of the roles always wins. Otherwise the caller is allowed when
+// one of the roles is granted by the most specific matching allow rules, so
+// "/user_v1.UserV1/Delete" restricts what "/user_v1.UserV1/*" grants. Rules with an owner
+// field the caller does not own or a condition that does not hold are left out as if they
+// did not exist. Rules without a role grant every role, the scopes an allow rule requires
+// must all be granted by the token as well, otherwise the call is denied for insufficient scope.
+func (idx *Index) Decide(req Request) Decision {
+	parts := splitRoute(req.Route)
+	method := strings.ToUpper(req.Method)
+	roles := req.roles()
+	// The condition environment is only built once a rule with a condition matches.
+	var env *conditionEnv
+	applies := func(r *compiledRule) bool {
+		if !r.matches(parts, method) || !r.ownedBy(req, parts) {
+			return false
+		}
+		if r.condition != nil && env == nil {
+			e := newConditionEnv(req)
+			env = &e
+		}
+		return r.holds(env)
+	}
+
+	var (
+		matched  *compiledRule
+		topAllow *compiledRule
+	)
+	for i := range idx.rules {
+		r := &idx.rules[i]
+		if !applies(r) {
+			continue
+		}
+		if matched == nil {
+			matched = r
+		}
+		if r.rule.Effect == model.DenyEffect {
+			if r.grantsRole(roles) {
+				return Decision{Allowed: false, Rule: &r.rule, Reason: model.DenyReasonDenyRule}
+			}
+			continue
+		}
+		if topAllow == nil {
+			topAllow = r
+		}
+	}
+
+	if matched == nil {
+		return Decision{Reason: model.DenyReasonNoMatchingRule}
+	}
+	if topAllow == nil {
+		return Decision{Allowed: false, Rule: &matched.rule, Reason: model.DenyReasonRoleNotGranted}
+	}
+
+	var insufficient *compiledRule
+	for i := range idx.rules {
+		r := &idx.rules[i]
+		if r.rule.Effect != model.AllowEffect || !r.grantsRole(roles) || !applies(r) {
+			continue
+		}
+		if compareRules(*r, *topAllow) != 0 {
+			break
+		}
+		missing := r.missingScopes(req.Claims)
+		if len(missing) == 0 {
+			return Decision{Allowed: true, Rule: &r.rule}
+		}
+		if insufficient == nil {
+			insufficient = r
+		}
+	}
+	if insufficient != nil {
+		return Decision{
+			Allowed:       false,
+			Rule:          &insufficient.rule,
+			Reason:        model.DenyReasonInsufficientScope,
+			MissingScopes: insufficient.missingScopes(req.Claims),
+		}
+	}
+	return Decision{Allowed: false, Rule: &topAllow.rule, Reason: model.DenyReasonRoleNotGranted}
+}
+
+// grantsRole reports whether the rule is for one of the roles, rules without a role are for all.
+func (r *compiledRule) grantsRole(roles []model.Role) bool {
+	return r.rule.Role == "" || slices.Contains(roles, r.rule.Role)
+}
+
+// missingScopes returns the scopes of the rule the caller token does not grant.
+func (r *compiledRule) missingScopes(claims *model.UserClaims) []string {
+	var missing []string
+	for _, scope := range r.rule.Scopes {
+		if claims == nil || !claims.HasScope(scope) {
+			missing = append(missing, scope)
+		}
+	}
+	return missing
+}
+
+func (r *compiledRule) matches(parts []string, method string) bool {
+	if r.rule.Method != model.AnyMethod && r.rule.Method != method {
+		return false
+	}
+	return r.pattern.match(parts)
+}
+
+// ownedBy reports whether the owner field of the request holds the caller subject,
+// always true for rules without an owner field.
+func (r *compiledRule) ownedBy(req Request, parts []string) bool {
+	if r.rule.OwnerField == "" {
+		return true
+	}
+	if req.Claims == nil || req.Claims.Subject == "" {
+		return false
+	}
+	var (
+		value string
+		ok    bool
+	)
+	switch {
+	case req.PathParams:
+		value, ok = r.pattern.param(parts, r.rule.OwnerField)
+	case req.Fields != nil:
+		value, ok = req.Fields.Field(r.rule.OwnerField)
+	}
+	return ok && value == req.Claims.Subject
+}
+
+// compareRules orders rules by route specificity, rules qualified with a method are
+// more specific than rules for any method on the same route.
+func compareRules(a compiledRule, b compiledRule) int {
+	if c := a.pattern.compare(b.pattern); c != 0 {
+		return c
+	}
+	aAny, bAny := a.rule.Method == model.AnyMethod, b.rule.Method == model.AnyMethod
+	switch {
+	case aAny == bAny:
+		return 0
+	case bAny:
+		return 1
+	default:
+		return -1
+	}
+}
+
+func joinErrors(errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	msgs := make([]string, 0, len(errs))
+	for _, err := range errs {
+		msgs = append(msgs, err.Error())
+	}
+	return errors.New(strings.Join(msgs, "; "))
+}
@@ -0,0 +1,170 @@
+package policy
+
+import (
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type segmentKind int
+
+// Segment kinds are ordered by specificity, a pattern is more specific than another
+// when its first differing segment has a greater kind.
+const (
+	anySuffixSegment segmentKind = iota
+	wildcardSegment
+	globSegment
+	literalSegment
+	// endSegment ranks a pattern that ended before a "**" of the other one.
+	endSegment
+)
+
+type segment struct {
+	kind  segmentKind
+	value string
+	// name of a "{name}" parameter, empty for other segments.
+	name string
+}
+
+// pattern is a compiled route pattern. Segments are separated by "/" and may be
+//   - a literal: "user_v1.UserV1", "orders";
+//   - a glob matching within the segment: "Get*", "v[12]";
+//   - "*" or a named parameter "{id}" matching exactly one segment;
+//   - "**" as the last segment matching any number of remaining segments.
+type pattern struct {
+	segments []segment
+}
+
+func compilePattern(route string) (*pattern, error) {
+	if !strings.HasPrefix(route, "/") {
+		return nil, errors.Errorf("route pattern %q must start with /", route)
+	}
+
+	parts := strings.Split(route[1:], "/")
+	segments := make([]segment, 0, len(parts))
+	for i, part := range parts {
+		switch {
+		case part == "**":
+			if i != len(parts)-1 {
+				return nil, errors.Errorf("route pattern %q: ** is only allowed as the last segment", route)
+			}
+			segments = append(segments, segment{kind: anySuffixSegment})
+		case part == "*":
+			segments = append(segments, segment{kind: wildcardSegment})
+		case strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}"):
+			if len(part) == 2 || strings.ContainsAny(part[1:len(part)-1], "{}*?[]") {
+				return nil, errors.Errorf("route pattern %q: invalid parameter %q", route, part)
+			}
+			segments = append(segments, segment{kind: wildcardSegment, name: part[1 : len(part)-1]})
+		case strings.ContainsAny(part, "*?["):
+			if _, err := path.Match(part, ""); err != nil {
+				return nil, errors.Errorf("route pattern %q: invalid glob %q", route, part)
+			}
+			segments = append(segments, segment{kind: globSegment, value: part})
+		default:
+			segments = append(segments, segment{kind: literalSegment, value: part})
+		}
+	}
+
+	return &pattern{
+		segments: segments,
+	}, nil
+}
+
+func (p *pattern) match(parts []string) bool {
+	for i, s := range p.segments {
+		if s.kind == anySuffixSegment {
+			return true
+		}
+		if i >= len(parts) {
+			return false
+		}
+		switch s.kind {
+		case literalSegment:
+			if parts[i] != s.value {
+				return false
+			}
+		case globSegment:
+			if ok, _ := path.Match(s.value, parts[i]); !ok {
+				return false
+			}
+		case wildcardSegment:
+			if parts[i] == "" {
+				return false
+			}
+		default:
+		}
+	}
+	return len(parts) == len(p.segments)
+}
+
+// param returns the value of the named parameter in the route parts the pattern matches.
+func (p *pattern) param(parts []string, name string) (string, bool) {
+	for i, s := range p.segments {
+		if s.kind == anySuffixSegment || i >= len(parts) {
+			break
+		}
+		if s.name == name {
+			return parts[i], true
+		}
+	}
+	return "", false
+}
+
+// compare returns a positive number when p is more specific than other,
+// a negative one when it is less specific and zero when they are equally specific.
+func (p *pattern) compare(other *pattern) int {
+	for i := 0; i < len(p.segments) || i < len(other.segments); i++ {
+		kind, otherKind := endSegment, endSegment
+		if i < len(p.segments) {
+			kind = p.segments[i].kind
+		}
+		if i < len(other.segments) {
+			otherKind = other.segments[i].kind
+		}
+		if kind != otherKind {
+			return int(kind) - int(otherKind)
+		}
+	}
+	return 0
+}
+
+// splitRoute splits a gRPC full method name or an HTTP path into segments, dropping the query string.
+func splitRoute(route string) []string {
+	route, _, _ = strings.Cut(route, "?")
+	return strings.Split(strings.TrimPrefix(route, "/"), "/")
+}
+
+// covers reports whether p matches every route other matches.
+func (p *pattern) covers(other *pattern) bool {
+	for i, s := range p.segments {
+		if s.kind == anySuffixSegment {
+			return true
+		}
+		if i >= len(other.segments) {
+			return false
+		}
+		o := other.segments[i]
+		switch s.kind {
+		case literalSegment:
+			if o.kind != literalSegment || o.value != s.value {
+				return false
+			}
+		case globSegment:
+			if o.kind == literalSegment {
+				if ok, _ := path.Match(s.value, o.value); !ok {
+					return false
+				}
+			} else if o.kind != globSegment || o.value != s.value {
+				return false
+			}
+		case wildcardSegment:
+			if o.kind == anySuffixSegment {
+				return false
+			}
+		default:
+		}
+	}
+	return len(other.segments) == len(p.segments)
+}
@@ -0,0 +1,118 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arifullov/auth/internal/client/db"
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/policy"
+	repositoryMocks "github.com/arifullov/auth/internal/repository/mocks"
+)
+
+type fakeListener struct {
+	payloads []string
+}
+
+func (l fakeListener) Listen(ctx context.Context, _ string, handler db.NotificationHandler) error {
+	for _, payload := range l.payloads {
+		handler(payload)
+	}
+	<-ctx.Done()
+	return nil
+}
+
+func TestCacheWatch(t *testing.T) {
+	var (
+		ctx, cancel = context.WithCancel(context.Background())
+		mc          = minimock.NewController(t)
+
+		userRule  = model.RouteRule{ID: 1, Route: "/user_v1.UserV1/*", Method: model.AnyMethod, Role: model.UserRole, Effect: model.AllowEffect}
+		adminRule = model.RouteRule{ID: 2, Route: "/user_v1.UserV1/*", Method: model.AnyMethod, Role: model.AdminRole, Effect: model.AllowEffect}
+	)
+	defer cancel()
+
+	version := int64(1)
+	accessRepositoryMock := repositoryMocks.NewAccessRepositoryMock(mc)
+	accessRepositoryMock.GetPolicyVersionMock.Set(func(_ context.Context) (int64, error) {
+		return version, nil
+	})
+	accessRepositoryMock.ListRouteRulesMock.Set(func(_ context.Context) ([]model.RouteRule, error) {
+		if version == 1 {
+			return []model.RouteRule{userRule}, nil
+		}
+		return []model.RouteRule{userRule, adminRule}, nil
+	})
+
+	cache := policy.NewCache(accessRepositoryMock)
+	snapshot, err := cache.Snapshot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), snapshot.Version)
+	require.False(t, snapshot.Index.Decide(policy.Request{Route: "/user_v1.UserV1/Get", Claims: &model.UserClaims{Roles: []model.Role{model.AdminRole}}}).Allowed)
+
+	// The policy changes before the notification about it is delivered.
+	version = 2
+	go cache.Watch(ctx, fakeListener{payloads: []string{"2"}}, time.Hour)
+
+	require.Eventually(t, func() bool {
+		snapshot, err = cache.Snapshot(ctx)
+		return err == nil && snapshot.Version == 2
+	}, time.Second, 10*time.Millisecond)
+	require.True(t, snapshot.Index.Decide(policy.Request{Route: "/user_v1.UserV1/Get", Claims: &model.UserClaims{Roles: []model.Role{model.AdminRole}}}).Allowed)
+}
+
+func TestCacheKeepsPolicyWithInvalidRules(t *testing.T) {
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		allowRule = model.RouteRule{ID: 1, Route: "/user_v1.UserV1/*", Method: model.AnyMethod, Role: model.UserRole, Effect: model.AllowEffect}
+		denyRule  = model.RouteRule{ID: 2, Route: "/user_v1.UserV1/Delete", Method: model.AnyMethod, Role: model.UserRole, Effect: model.DenyEffect}
+		// invalidDenyRule is left out if the policy was loaded, allowing what it denies.
+		invalidDenyRule = model.RouteRule{ID: 2, Route: "/user_v1.UserV1/Delete", Method: model.AnyMethod, Role: model.UserRole, Effect: model.DenyEffect, Condition: "fields.owner =="}
+		deleteRequest   = policy.Request{Route: "/user_v1.UserV1/Delete", Claims: &model.UserClaims{Roles: []model.Role{model.UserRole}}}
+	)
+
+	version := int64(1)
+	accessRepositoryMock := repositoryMocks.NewAccessRepositoryMock(mc)
+	accessRepositoryMock.GetPolicyVersionMock.Set(func(_ context.Context) (int64, error) {
+		return version, nil
+	})
+	accessRepositoryMock.ListRouteRulesMock.Set(func(_ context.Context) ([]model.RouteRule, error) {
+		if version == 1 {
+			return []model.RouteRule{allowRule, denyRule}, nil
+		}
+		return []model.RouteRule{allowRule, invalidDenyRule}, nil
+	})
+
+	cache := policy.NewCache(accessRepositoryMock)
+	require.NoError(t, cache.Reload(ctx))
+
+	version = 2
+	require.Error(t, cache.Reload(ctx))
+
+	snapshot, err := cache.Snapshot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), snapshot.Version)
+	require.False(t, snapshot.Index.Decide(deleteRequest).Allowed)
+}
+
+func TestCacheFailsWithoutValidPolicy(t *testing.T) {
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+	)
+
+	accessRepositoryMock := repositoryMocks.NewAccessRepositoryMock(mc)
+	accessRepositoryMock.GetPolicyVersionMock.Return(1, nil)
+	accessRepositoryMock.ListRouteRulesMock.Return([]model.RouteRule{
+		{ID: 1, Route: "/user_v1.UserV1/*", Method: model.AnyMethod, Role: model.UserRole, Effect: "maybe"},
+	}, nil)
+
+	_, err := policy.NewCache(accessRepositoryMock).Snapshot(ctx)
+	require.Error(t, err)
+}
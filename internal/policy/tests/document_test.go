@@ -0,0 +1,86 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/policy"
+)
+
+const document = `
+roles:
+  - name: user
+  - name: auditor
+    parents: [user]
+    permissions: [audit.read]
+conditions:
+  office: inCIDR(ip, "10.0.0.0/8")
+rules:
+  - route: /audit_v1.AuditV1/**
+    role: auditor
+    condition_ref: office
+  - route: /user_v1.UserV1/Get
+    method: GET
+    role: user
+    owner_field: id
+`
+
+func TestDecodeDocument(t *testing.T) {
+	want := &model.PolicyDocument{
+		Roles: []model.RoleDefinition{
+			{Name: "user"},
+			{Name: "auditor", Parents: []model.Role{"user"}, Permissions: []string{"audit.read"}},
+		},
+		Rules: []model.RouteRule{
+			{Route: "/audit_v1.AuditV1/**", Role: "auditor", Condition: `inCIDR(ip, "10.0.0.0/8")`},
+			{Route: "/user_v1.UserV1/Get", Method: "GET", Role: "user", OwnerField: "id"},
+		},
+	}
+
+	doc, err := policy.DecodeDocument([]byte(document), policy.YAMLFormat)
+	require.NoError(t, err)
+	require.Equal(t, want, doc)
+
+	// Conditions are exported inline, so both formats decode back to the same document.
+	for _, format := range []policy.DocumentFormat{policy.YAMLFormat, policy.JSONFormat} {
+		data, err := policy.EncodeDocument(doc, format)
+		require.NoError(t, err)
+		decoded, err := policy.DecodeDocument(data, format)
+		require.NoError(t, err)
+		require.Equal(t, want, decoded)
+	}
+}
+
+func TestDecodeDocumentErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+		format   policy.DocumentFormat
+	}{
+		{
+			name:     "unknown field",
+			document: "rules:\n  - route: /a\n    role: user\n    efect: deny\n",
+			format:   policy.YAMLFormat,
+		},
+		{
+			name:     "unknown condition",
+			document: `{"rules": [{"route": "/a", "role": "user", "condition_ref": "office"}]}`,
+			format:   policy.JSONFormat,
+		},
+		{
+			name:     "condition and reference",
+			document: "conditions:\n  office: 'true'\nrules:\n  - route: /a\n    role: user\n    condition: 'false'\n    condition_ref: office\n",
+			format:   policy.YAMLFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := policy.DecodeDocument([]byte(tt.document), tt.format)
+			require.Error(t, err)
+		})
+	}
+}
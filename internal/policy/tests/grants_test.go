@@ -0,0 +1,100 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/policy"
+)
+
+func TestIndexGrants(t *testing.T) {
+	rules := []model.RouteRule{
+		{ID: 1, Route: "/user_v1.UserV1/*", Method: model.AnyMethod, Role: model.UserRole, Effect: model.AllowEffect},
+		{ID: 2, Route: "/user_v1.UserV1/*", Method: model.AnyMethod, Role: model.AdminRole, Effect: model.AllowEffect},
+		{ID: 3, Route: "/user_v1.UserV1/Delete", Method: model.AnyMethod, Role: model.AdminRole, Effect: model.AllowEffect},
+		{ID: 4, Route: "/orders/**", Method: model.AnyMethod, Role: model.AdminRole, Effect: model.AllowEffect},
+		{ID: 5, Route: "/orders/**", Method: "DELETE", Role: model.AdminRole, Effect: model.DenyEffect},
+		{ID: 6, Route: "/reports/Get*", Method: model.AnyMethod, Role: model.UserRole, Effect: model.AllowEffect},
+		{ID: 7, Route: "/profiles/Get", Method: model.AnyMethod, Role: model.UserRole, Effect: model.AllowEffect, OwnerField: "id"},
+	}
+	index, err := policy.NewIndex(rules)
+	require.NoError(t, err)
+
+	t.Run("accessible routes", func(t *testing.T) {
+		require.Equal(t, []model.RouteGrant{
+			{Route: "/profiles/Get", Method: model.AnyMethod, RuleID: 7, Role: model.UserRole, Conditional: true},
+			{Route: "/reports/Get*", Method: model.AnyMethod, RuleID: 6, Role: model.UserRole},
+			{Route: "/user_v1.UserV1/*", Method: model.AnyMethod, RuleID: 1, Role: model.UserRole},
+		}, index.AccessibleRoutes([]model.Role{model.UserRole}))
+
+		require.Equal(t, []model.RouteGrant{
+			{Route: "/orders/**", Method: model.AnyMethod, RuleID: 4, Role: model.AdminRole},
+			{Route: "/user_v1.UserV1/*", Method: model.AnyMethod, RuleID: 2, Role: model.AdminRole},
+			{Route: "/user_v1.UserV1/Delete", Method: model.AnyMethod, RuleID: 3, Role: model.AdminRole},
+		}, index.AccessibleRoutes([]model.Role{model.AdminRole}))
+	})
+
+	tests := []struct {
+		name    string
+		route   string
+		method  string
+		roles   []model.Role
+		allowed bool
+		ruleID  int64
+	}{
+		{
+			name:    "route covered by glob",
+			route:   "/user_v1.UserV1/Get",
+			roles:   []model.Role{model.UserRole},
+			allowed: true,
+			ruleID:  1,
+		},
+		{
+			name:    "more specific rule restricts glob",
+			route:   "/user_v1.UserV1/Delete",
+			roles:   []model.Role{model.UserRole},
+			allowed: false,
+		},
+		{
+			name:    "inherited role",
+			route:   "/user_v1.UserV1/Delete",
+			roles:   []model.Role{model.AdminRole, model.UserRole},
+			allowed: true,
+			ruleID:  3,
+		},
+		{
+			name:    "deny rule for method",
+			route:   "/orders/42",
+			method:  "delete",
+			roles:   []model.Role{model.AdminRole},
+			allowed: false,
+		},
+		{
+			name:    "pattern covered by pattern",
+			route:   "/orders/{id}/items",
+			method:  "get",
+			roles:   []model.Role{model.AdminRole},
+			allowed: true,
+			ruleID:  4,
+		},
+		{
+			name:    "glob does not cover wider pattern",
+			route:   "/reports/*",
+			roles:   []model.Role{model.UserRole},
+			allowed: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grant, ok, err := index.Grant(tt.route, tt.method, tt.roles)
+			require.NoError(t, err)
+			require.Equal(t, tt.allowed, ok)
+			if ok {
+				require.Equal(t, tt.ruleID, grant.RuleID)
+			}
+		})
+	}
+}
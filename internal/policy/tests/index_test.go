@@ -0,0 +1,388 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/policy"
+)
+
+func TestIndexDecide(t *testing.T) {
+	rules := []model.RouteRule{
+		{ID: 1, Route: "/user_v1.UserV1/*", Method: model.AnyMethod, Role: model.UserRole, Effect: model.AllowEffect},
+		{ID: 2, Route: "/user_v1.UserV1/*", Method: model.AnyMethod, Role: model.AdminRole, Effect: model.AllowEffect},
+		{ID: 3, Route: "/user_v1.UserV1/Delete", Method: model.AnyMethod, Role: model.AdminRole, Effect: model.AllowEffect},
+		{ID: 4, Route: "/orders/{id}/items", Method: "get", Role: model.UserRole, Effect: model.AllowEffect},
+		{ID: 5, Route: "/orders/{id}/items", Method: model.AnyMethod, Role: model.AdminRole, Effect: model.AllowEffect},
+		{ID: 6, Route: "/orders/**", Method: model.AnyMethod, Role: model.AdminRole, Effect: model.AllowEffect},
+		{ID: 7, Route: "/orders/**", Method: "DELETE", Role: model.AdminRole, Effect: model.DenyEffect},
+		{ID: 8, Route: "/reports/Get*", Method: model.AnyMethod, Role: model.UserRole, Effect: model.AllowEffect},
+		{ID: 9, Route: "/profiles/Get", Method: model.AnyMethod, Role: model.UserRole, Effect: model.AllowEffect, OwnerField: "user.id"},
+		{ID: 10, Route: "/profiles/Get", Method: model.AnyMethod, Role: model.AdminRole, Effect: model.AllowEffect},
+		{ID: 11, Route: "/office/**", Method: model.AnyMethod, Role: model.UserRole, Effect: model.AllowEffect,
+			Condition: `inCIDR(ip, "10.0.0.0/8") && time.Hour() >= 9 && time.Hour() < 18`},
+		{ID: 12, Route: "/invoices/**", Method: model.AnyMethod, Role: model.UserRole, Effect: model.AllowEffect, Scopes: []string{"invoices:read"}},
+		{ID: 13, Route: "/integrations/**", Method: model.AnyMethod, Effect: model.AllowEffect, Scopes: []string{"integrations:write"}},
+		{ID: 14, Route: "/users/{id}", Method: model.AnyMethod, Role: model.UserRole, Effect: model.AllowEffect, OwnerField: "id"},
+		{ID: 15, Route: "/relations/Check", Method: model.AnyMethod, Role: model.UserRole, Effect: model.AllowEffect, OwnerField: "user_id"},
+		{ID: 16, Route: "/relations/Check", Method: model.AnyMethod, Role: model.AdminRole, Effect: model.AllowEffect},
+		{ID: 17, Route: "/relations/Check", Method: model.AnyMethod, Effect: model.AllowEffect, Scopes: []string{"relations:check"}},
+	}
+
+	var (
+		businessHours = time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC)
+		night         = time.Date(2024, 7, 1, 23, 0, 0, 0, time.UTC)
+	)
+
+	tests := []struct {
+		name    string
+		route   string
+		method  string
+		role    model.Role
+		subject string
+		fields  model.RequestFields
+		// pathParams takes the owner from the route instead of fields.
+		pathParams bool
+		ip         string
+		time       time.Time
+		// scope, clientID and permissions of the token.
+		scope       string
+		clientID    string
+		permissions []string
+		allowed     bool
+		ruleID      int64
+		reason      model.DenyReason
+		missing     []string
+	}{
+		{
+			name:    "glob allows user",
+			route:   "/user_v1.UserV1/Get",
+			role:    model.UserRole,
+			allowed: true,
+			ruleID:  1,
+		},
+		{
+			name:    "most specific rule restricts glob",
+			route:   "/user_v1.UserV1/Delete",
+			role:    model.UserRole,
+			allowed: false,
+			ruleID:  3,
+			reason:  model.DenyReasonRoleNotGranted,
+		},
+		{
+			name:    "most specific rule allows admin",
+			route:   "/user_v1.UserV1/Delete",
+			role:    model.AdminRole,
+			allowed: true,
+			ruleID:  3,
+		},
+		{
+			name:    "parameter and method qualifier",
+			route:   "/orders/42/items",
+			method:  "GET",
+			role:    model.UserRole,
+			allowed: true,
+			ruleID:  4,
+		},
+		{
+			name:    "method qualifier does not match",
+			route:   "/orders/42/items",
+			method:  "POST",
+			role:    model.UserRole,
+			allowed: false,
+			ruleID:  5,
+		},
+		{
+			name:    "prefix allows admin",
+			route:   "/orders/42/items/7?expand=true",
+			method:  "PATCH",
+			role:    model.AdminRole,
+			allowed: true,
+			ruleID:  6,
+		},
+		{
+			name:    "deny overrides more specific allow",
+			route:   "/orders/42/items",
+			method:  "DELETE",
+			role:    model.AdminRole,
+			allowed: false,
+			ruleID:  7,
+			reason:  model.DenyReasonDenyRule,
+		},
+		{
+			name:    "segment glob",
+			route:   "/reports/GetDaily",
+			role:    model.UserRole,
+			allowed: true,
+			ruleID:  8,
+		},
+		{
+			name:    "owner rule allows own record",
+			route:   "/profiles/Get",
+			role:    model.UserRole,
+			subject: "42",
+			fields:  model.MapRequestFields{"user.id": "42"},
+			allowed: true,
+			ruleID:  9,
+		},
+		{
+			name:    "owner rule skipped for other record",
+			route:   "/profiles/Get",
+			role:    model.UserRole,
+			subject: "42",
+			fields:  model.MapRequestFields{"user.id": "7"},
+			allowed: false,
+			ruleID:  10,
+		},
+		{
+			name:    "owner rule skipped without fields",
+			route:   "/profiles/Get",
+			role:    model.UserRole,
+			subject: "42",
+			allowed: false,
+			ruleID:  10,
+		},
+		{
+			name:       "owner rule takes owner from path",
+			route:      "/users/42",
+			role:       model.UserRole,
+			subject:    "42",
+			pathParams: true,
+			allowed:    true,
+			ruleID:     14,
+		},
+		{
+			name:       "owner rule ignores fields of path requests",
+			route:      "/users/7",
+			role:       model.UserRole,
+			subject:    "42",
+			fields:     model.MapRequestFields{"id": "42"},
+			pathParams: true,
+			allowed:    false,
+		},
+		{
+			name:    "admin reaches other record",
+			route:   "/profiles/Get",
+			role:    model.AdminRole,
+			subject: "1",
+			fields:  model.MapRequestFields{"user.id": "7"},
+			allowed: true,
+			ruleID:  10,
+		},
+		{
+			name:    "condition holds",
+			route:   "/office/printers",
+			role:    model.UserRole,
+			ip:      "10.1.2.3",
+			time:    businessHours,
+			allowed: true,
+			ruleID:  11,
+		},
+		{
+			name:   "condition does not hold outside business hours",
+			route:  "/office/printers",
+			role:   model.UserRole,
+			ip:     "10.1.2.3",
+			time:   night,
+			ruleID: 0,
+		},
+		{
+			name:   "condition does not hold outside office network",
+			route:  "/office/printers",
+			role:   model.UserRole,
+			ip:     "192.168.1.1",
+			time:   businessHours,
+			ruleID: 0,
+		},
+		{
+			name:   "no rule matches",
+			route:  "/reports/Delete",
+			role:   model.AdminRole,
+			ruleID: 0,
+		},
+		{
+			name:    "scope granted by restricted token",
+			route:   "/invoices/1",
+			role:    model.UserRole,
+			scope:   "profile invoices:read",
+			allowed: true,
+			ruleID:  12,
+		},
+		{
+			name:    "scope missing from restricted token",
+			route:   "/invoices/1",
+			role:    model.UserRole,
+			scope:   "profile",
+			ruleID:  12,
+			reason:  model.DenyReasonInsufficientScope,
+			missing: []string{"invoices:read"},
+		},
+		{
+			name:        "permission as scope of unrestricted token",
+			route:       "/invoices/1",
+			role:        model.UserRole,
+			permissions: []string{"invoices:read"},
+			allowed:     true,
+			ruleID:      12,
+		},
+		{
+			name:        "client token without scopes",
+			route:       "/invoices/1",
+			role:        model.UserRole,
+			clientID:    "reports-app",
+			permissions: []string{"invoices:read"},
+			ruleID:      12,
+			reason:      model.DenyReasonInsufficientScope,
+			missing:     []string{"invoices:read"},
+		},
+		{
+			name:    "scopes required instead of role",
+			route:   "/integrations/hooks",
+			role:    model.AdminRole,
+			scope:   "integrations:write",
+			allowed: true,
+			ruleID:  13,
+		},
+		{
+			name:    "scopes missing without role",
+			route:   "/integrations/hooks",
+			role:    model.AdminRole,
+			scope:   "profile",
+			ruleID:  13,
+			reason:  model.DenyReasonInsufficientScope,
+			missing: []string{"integrations:write"},
+		},
+		{
+			name:    "owner rule allows own subject",
+			route:   "/relations/Check",
+			role:    model.UserRole,
+			subject: "42",
+			fields:  model.MapRequestFields{"user_id": "42"},
+			allowed: true,
+			ruleID:  15,
+		},
+		{
+			name:    "subject of another user requires scope",
+			route:   "/relations/Check",
+			role:    model.UserRole,
+			subject: "42",
+			fields:  model.MapRequestFields{"user_id": "7"},
+			ruleID:  17,
+			reason:  model.DenyReasonInsufficientScope,
+			missing: []string{"relations:check"},
+		},
+		{
+			name:        "permission allows subject of another user",
+			route:       "/relations/Check",
+			role:        model.UserRole,
+			subject:     "42",
+			fields:      model.MapRequestFields{"user_id": "7"},
+			permissions: []string{"relations:check"},
+			allowed:     true,
+			ruleID:      17,
+		},
+		{
+			name:    "admin rule next to owner and scope rules",
+			route:   "/relations/Check",
+			role:    model.AdminRole,
+			subject: "42",
+			fields:  model.MapRequestFields{"user_id": "7"},
+			allowed: true,
+			ruleID:  16,
+		},
+	}
+
+	index, err := policy.NewIndex(rules)
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			decision := index.Decide(policy.Request{
+				Route:  tt.route,
+				Method: tt.method,
+				Claims: &model.UserClaims{
+					RegisteredClaims: jwt.RegisteredClaims{Subject: tt.subject},
+					Roles:            []model.Role{tt.role},
+					Permissions:      tt.permissions,
+					Scope:            tt.scope,
+					ClientID:         tt.clientID,
+				},
+				Fields:     tt.fields,
+				PathParams: tt.pathParams,
+				IP:         tt.ip,
+				Time:       tt.time,
+			})
+			require.Equal(t, tt.allowed, decision.Allowed)
+			if tt.ruleID == 0 {
+				require.Nil(t, decision.Rule)
+				require.Equal(t, model.DenyReasonNoMatchingRule, decision.Reason)
+				return
+			}
+			if tt.allowed || tt.reason != "" {
+				require.Equal(t, tt.reason, decision.Reason)
+			}
+			require.NotNil(t, decision.Rule)
+			require.Equal(t, tt.ruleID, decision.Rule.ID)
+			require.Equal(t, tt.missing, decision.MissingScopes)
+		})
+	}
+}
+
+func TestValidateRule(t *testing.T) {
+	tests := []struct {
+		name      string
+		route     string
+		anyRole   bool
+		effect    model.RouteEffect
+		condition string
+		scopes    []string
+		valid     bool
+	}{
+		{name: "literal", route: "/auth_v1.AuthV1/Login", valid: true},
+		{name: "prefix", route: "/user_v1.UserV1/**", valid: true},
+		{name: "parameter", route: "/orders/{id}", valid: true},
+		{name: "relative", route: "orders", valid: false},
+		{name: "prefix in the middle", route: "/orders/**/items", valid: false},
+		{name: "bad glob", route: "/orders/[a", valid: false},
+		{name: "empty parameter", route: "/orders/{}", valid: false},
+		{name: "condition", route: "/orders", condition: `"admin" in claims.roles || headers["x-tenant-id"] == "acme"`, valid: true},
+		{name: "condition syntax error", route: "/orders", condition: `ip ==`, valid: false},
+		{name: "condition unknown variable", route: "/orders", condition: `tenant == "acme"`, valid: false},
+		{name: "condition not boolean", route: "/orders", condition: `time.Hour()`, valid: false},
+		{name: "role and scopes", route: "/orders", scopes: []string{"orders:read"}, valid: true},
+		{name: "scopes instead of role", route: "/orders", anyRole: true, scopes: []string{"orders:read"}, valid: true},
+		{name: "neither role nor scopes", route: "/orders", anyRole: true, valid: false},
+		{name: "invalid scope", route: "/orders", scopes: []string{"orders read"}, valid: false},
+		{name: "deny rule with scopes", route: "/orders", effect: model.DenyEffect, scopes: []string{"orders:read"}, valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			role := model.UserRole
+			if tt.anyRole {
+				role = ""
+			}
+			effect := model.AllowEffect
+			if tt.effect != "" {
+				effect = tt.effect
+			}
+			err := policy.ValidateRule(model.RouteRule{
+				Route:     tt.route,
+				Method:    model.AnyMethod,
+				Role:      role,
+				Effect:    effect,
+				Condition: tt.condition,
+				Scopes:    tt.scopes,
+			})
+			require.Equal(t, tt.valid, err == nil)
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package relation
+
+import (
+	"context"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+)
+
+// maxDepth bounds the nesting of usersets and rewrites.
+const maxDepth = 25
+
+// TupleReader reads the tuples stored at a revision.
+type TupleReader interface {
+	FindTuples(ctx context.Context, filter model.RelationTupleFilter, revision int64) ([]model.RelationTuple, error)
+}
+
+// Checker evaluates relations of the schema over the stored tuples.
+type Checker struct {
+	schema *Schema
+	tuples TupleReader
+}
+
+func NewChecker(schema *Schema, tuples TupleReader) *Checker {
+	return &Checker{
+		schema: schema,
+		tuples: tuples,
+	}
+}
+
+// Check reports whether the user has the relation to the object at the revision.
+func (c *Checker) Check(ctx context.Context, object model.RelationObject, relation string, userID string, revision int64) (bool, error) {
+	chk := &checkRun{
+		Checker:  c,
+		userID:   userID,
+		revision: revision,
+		visiting: make(map[string]struct{}),
+	}
+	return chk.check(ctx, object, relation, 0)
+}
+
+// checkRun is the state of one Check call. Relations being evaluated up the call stack are
+// visiting: reaching one of them again is a cycle in the stored tuples and grants nothing.
+type checkRun struct {
+	*Checker
+	userID   string
+	revision int64
+	visiting map[string]struct{}
+}
+
+func (c *checkRun) check(ctx context.Context, object model.RelationObject, relation string, depth int) (bool, error) {
+	if depth > maxDepth {
+		return false, sys.NewCommonError(codes.FailedPrecondition, "relation nesting is too deep")
+	}
+	def, ok := c.schema.definition(object.Namespace, relation)
+	if !ok {
+		return false, nil
+	}
+
+	key := object.String() + "#" + relation
+	if _, ok = c.visiting[key]; ok {
+		return false, nil
+	}
+	c.visiting[key] = struct{}{}
+	defer delete(c.visiting, key)
+
+	for _, rw := range def.rewrites {
+		var (
+			allowed bool
+			err     error
+		)
+		switch {
+		case rw.this:
+			allowed, err = c.checkThis(ctx, object, relation, depth)
+		case rw.tupleset != "":
+			allowed, err = c.checkTupleToUserset(ctx, object, rw, depth)
+		default:
+			allowed, err = c.check(ctx, object, rw.computedUserset, depth+1)
+		}
+		if err != nil || allowed {
+			return allowed, err
+		}
+	}
+	return false, nil
+}
+
+func (c *checkRun) checkThis(ctx context.Context, object model.RelationObject, relation string, depth int) (bool, error) {
+	tuples, err := c.find(ctx, object, relation, c.revision)
+	if err != nil {
+		return false, err
+	}
+	for _, tuple := range tuples {
+		if tuple.Subject.UserID == c.userID {
+			return true, nil
+		}
+	}
+	for _, tuple := range tuples {
+		if tuple.Subject.IsUser() || tuple.Subject.Relation == "" {
+			continue
+		}
+		allowed, err := c.check(ctx, tuple.Subject.Object, tuple.Subject.Relation, depth+1)
+		if err != nil || allowed {
+			return allowed, err
+		}
+	}
+	return false, nil
+}
+
+func (c *checkRun) checkTupleToUserset(ctx context.Context, object model.RelationObject, rw rewrite, depth int) (bool, error) {
+	tuples, err := c.find(ctx, object, rw.tupleset, c.revision)
+	if err != nil {
+		return false, err
+	}
+	for _, tuple := range tuples {
+		if tuple.Subject.IsUser() {
+			continue
+		}
+		allowed, err := c.check(ctx, tuple.Subject.Object, rw.computedUserset, depth+1)
+		if err != nil || allowed {
+			return allowed, err
+		}
+	}
+	return false, nil
+}
+
+// Expand returns the userset tree of the relation: rewrites are expanded recursively,
+// usersets found in stored tuples are left as leaves.
+func (c *Checker) Expand(ctx context.Context, object model.RelationObject, relation string, revision int64) (*model.RelationExpandNode, error) {
+	return c.expand(ctx, object, relation, revision, 0)
+}
+
+func (c *Checker) expand(ctx context.Context, object model.RelationObject, relation string, revision int64, depth int) (*model.RelationExpandNode, error) {
+	if depth > maxDepth {
+		return nil, sys.NewCommonError(codes.FailedPrecondition, "relation nesting is too deep")
+	}
+	node := &model.RelationExpandNode{
+		Operation: model.RelationUnion,
+		Object:    object,
+		Relation:  relation,
+	}
+	def, ok := c.schema.definition(object.Namespace, relation)
+	if !ok {
+		return node, nil
+	}
+
+	for _, rw := range def.rewrites {
+		switch {
+		case rw.this:
+			tuples, err := c.find(ctx, object, relation, revision)
+			if err != nil {
+				return nil, err
+			}
+			leaf := &model.RelationExpandNode{
+				Operation: model.RelationLeaf,
+				Object:    object,
+				Relation:  relation,
+				Subjects:  make([]model.RelationSubject, 0, len(tuples)),
+			}
+			for _, tuple := range tuples {
+				leaf.Subjects = append(leaf.Subjects, tuple.Subject)
+			}
+			node.Children = append(node.Children, leaf)
+		case rw.tupleset != "":
+			tuples, err := c.find(ctx, object, rw.tupleset, revision)
+			if err != nil {
+				return nil, err
+			}
+			for _, tuple := range tuples {
+				if tuple.Subject.IsUser() {
+					continue
+				}
+				child, err := c.expand(ctx, tuple.Subject.Object, rw.computedUserset, revision, depth+1)
+				if err != nil {
+					return nil, err
+				}
+				node.Children = append(node.Children, child)
+			}
+		default:
+			child, err := c.expand(ctx, object, rw.computedUserset, revision, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			node.Children = append(node.Children, child)
+		}
+	}
+	return node, nil
+}
+
+func (c *Checker) find(ctx context.Context, object model.RelationObject, relation string, revision int64) ([]model.RelationTuple, error) {
+	return c.tuples.FindTuples(ctx, model.RelationTupleFilter{
+		Namespace: object.Namespace,
+		ObjectID:  object.ID,
+		Relation:  relation,
+	}, revision)
+}
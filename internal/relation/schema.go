@@ -0,0 +1,153 @@
+package relation
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/arifullov/auth/internal/config"
+	"github.com/arifullov/auth/internal/model"
+)
+
+// rewrite is one operand of the union a relation is computed from.
+type rewrite struct {
+	this            bool
+	computedUserset string
+	tupleset        string
+}
+
+type definition struct {
+	rewrites []rewrite
+}
+
+// Schema describes the namespaces, their relations and how relations are computed.
+type Schema struct {
+	namespaces map[string]map[string]*definition
+}
+
+// NewSchema builds the schema and checks that every relation referenced by a rewrite exists.
+// A relation referenced by tuple_to_userset must exist in some namespace, it is looked up in
+// the namespace of each object found in the tupleset at check time.
+func NewSchema(namespaces []config.RelationNamespaceConfig) (*Schema, error) {
+	s := &Schema{
+		namespaces: make(map[string]map[string]*definition, len(namespaces)),
+	}
+	known := make(map[string]struct{})
+	for _, ns := range namespaces {
+		if ns.Name == "" {
+			return nil, errors.New("relation namespace name not found")
+		}
+		if _, ok := s.namespaces[ns.Name]; ok {
+			return nil, errors.Errorf("duplicate relation namespace %q", ns.Name)
+		}
+		relations := make(map[string]*definition, len(ns.Relations))
+		for _, rel := range ns.Relations {
+			if rel.Name == "" {
+				return nil, errors.Errorf("relation name not found in namespace %q", ns.Name)
+			}
+			if _, ok := relations[rel.Name]; ok {
+				return nil, errors.Errorf("duplicate relation %s#%s", ns.Name, rel.Name)
+			}
+			def, err := newDefinition(rel)
+			if err != nil {
+				return nil, errors.Wrapf(err, "relation %s#%s", ns.Name, rel.Name)
+			}
+			relations[rel.Name] = def
+			known[rel.Name] = struct{}{}
+		}
+		s.namespaces[ns.Name] = relations
+	}
+
+	for nsName, relations := range s.namespaces {
+		for relName, def := range relations {
+			for _, rw := range def.rewrites {
+				if _, ok := relations[rw.computedUserset]; rw.computedUserset != "" && rw.tupleset == "" && !ok {
+					return nil, errors.Errorf("relation %s#%s computes unknown relation %q", nsName, relName, rw.computedUserset)
+				}
+				if rw.tupleset == "" {
+					continue
+				}
+				if _, ok := relations[rw.tupleset]; !ok {
+					return nil, errors.Errorf("relation %s#%s uses unknown tupleset %q", nsName, relName, rw.tupleset)
+				}
+				if _, ok := known[rw.computedUserset]; !ok {
+					return nil, errors.Errorf("relation %s#%s computes unknown relation %q", nsName, relName, rw.computedUserset)
+				}
+			}
+		}
+	}
+	return s, nil
+}
+
+func newDefinition(rel config.RelationDefinitionConfig) (*definition, error) {
+	if len(rel.Union) == 0 {
+		return &definition{rewrites: []rewrite{{this: true}}}, nil
+	}
+
+	def := &definition{rewrites: make([]rewrite, 0, len(rel.Union))}
+	for _, rw := range rel.Union {
+		set := 0
+		if rw.This {
+			set++
+			def.rewrites = append(def.rewrites, rewrite{this: true})
+		}
+		if rw.ComputedUserset != "" {
+			set++
+			def.rewrites = append(def.rewrites, rewrite{computedUserset: rw.ComputedUserset})
+		}
+		if rw.TupleToUserset != nil {
+			set++
+			if rw.TupleToUserset.Tupleset == "" || rw.TupleToUserset.ComputedUserset == "" {
+				return nil, errors.New("tuple_to_userset requires tupleset and computed_userset")
+			}
+			def.rewrites = append(def.rewrites, rewrite{
+				tupleset:        rw.TupleToUserset.Tupleset,
+				computedUserset: rw.TupleToUserset.ComputedUserset,
+			})
+		}
+		if set != 1 {
+			return nil, errors.New("union operand must set exactly one of this, computed_userset and tuple_to_userset")
+		}
+	}
+	return def, nil
+}
+
+func (s *Schema) definition(namespace string, relation string) (*definition, bool) {
+	def, ok := s.namespaces[namespace][relation]
+	return def, ok
+}
+
+// ValidateObjectRelation checks that the relation is defined in the namespace of the object.
+func (s *Schema) ValidateObjectRelation(object model.RelationObject, relation string) error {
+	if object.Namespace == "" || object.ID == "" {
+		return errors.Errorf("invalid relation object %q", object.String())
+	}
+	if _, ok := s.namespaces[object.Namespace]; !ok {
+		return errors.Errorf("unknown relation namespace %q", object.Namespace)
+	}
+	if _, ok := s.definition(object.Namespace, relation); !ok {
+		return errors.Errorf("unknown relation %s#%s", object.Namespace, relation)
+	}
+	return nil
+}
+
+// ValidateTuple checks that the tuple refers to defined namespaces and relations.
+func (s *Schema) ValidateTuple(tuple model.RelationTuple) error {
+	if err := s.ValidateObjectRelation(tuple.Object, tuple.Relation); err != nil {
+		return err
+	}
+	if tuple.Subject.IsUser() {
+		if tuple.Subject.Object != (model.RelationObject{}) || tuple.Subject.Relation != "" {
+			return errors.Errorf("invalid relation subject %q", tuple.Subject.String())
+		}
+		return nil
+	}
+	if tuple.Subject.Relation == "" {
+		if tuple.Subject.Object.ID == "" {
+			return errors.Errorf("invalid relation subject %q", tuple.Subject.String())
+		}
+		if _, ok := s.namespaces[tuple.Subject.Object.Namespace]; !ok {
+			return errors.Errorf("unknown relation namespace %q", tuple.Subject.Object.Namespace)
+		}
+		return nil
+	}
+	return s.ValidateObjectRelation(tuple.Subject.Object, tuple.Subject.Relation)
+}
@@ -0,0 +1,215 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arifullov/auth/internal/config"
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/relation"
+)
+
+// storedTuple is a tuple visible from created up to, not including, deleted.
+type storedTuple struct {
+	tuple   model.RelationTuple
+	created int64
+	deleted int64
+}
+
+type memoryTuples []storedTuple
+
+func (m memoryTuples) FindTuples(_ context.Context, filter model.RelationTupleFilter, revision int64) ([]model.RelationTuple, error) {
+	var res []model.RelationTuple
+	for _, t := range m {
+		if t.created > revision || (t.deleted != 0 && t.deleted <= revision) {
+			continue
+		}
+		if t.tuple.Object.Namespace == filter.Namespace && t.tuple.Object.ID == filter.ObjectID && t.tuple.Relation == filter.Relation {
+			res = append(res, t.tuple)
+		}
+	}
+	return res, nil
+}
+
+func object(namespace, id string) model.RelationObject {
+	return model.RelationObject{Namespace: namespace, ID: id}
+}
+
+func userTuple(obj model.RelationObject, relation string, userID string) model.RelationTuple {
+	return model.RelationTuple{Object: obj, Relation: relation, Subject: model.RelationSubject{UserID: userID}}
+}
+
+func setTuple(obj model.RelationObject, relation string, subject model.RelationObject, subjectRelation string) model.RelationTuple {
+	return model.RelationTuple{Object: obj, Relation: relation, Subject: model.RelationSubject{Object: subject, Relation: subjectRelation}}
+}
+
+func newSchema(t *testing.T) *relation.Schema {
+	schema, err := relation.NewSchema([]config.RelationNamespaceConfig{
+		{Name: "group", Relations: []config.RelationDefinitionConfig{{Name: "member"}}},
+		{Name: "folder", Relations: []config.RelationDefinitionConfig{
+			{Name: "viewer"},
+		}},
+		{Name: "document", Relations: []config.RelationDefinitionConfig{
+			{Name: "owner"},
+			{Name: "parent"},
+			{Name: "viewer", Union: []config.RelationRewriteConfig{
+				{This: true},
+				{ComputedUserset: "owner"},
+				{TupleToUserset: &config.TupleToUsersetConfig{Tupleset: "parent", ComputedUserset: "viewer"}},
+			}},
+		}},
+	})
+	require.NoError(t, err)
+	return schema
+}
+
+func TestCheckerCheck(t *testing.T) {
+	var (
+		doc    = object("document", "1")
+		folder = object("folder", "7")
+		eng    = object("group", "eng")
+		ops    = object("group", "ops")
+	)
+	tuples := memoryTuples{
+		{tuple: userTuple(doc, "owner", "1"), created: 1},
+		{tuple: userTuple(doc, "viewer", "2"), created: 1, deleted: 3},
+		{tuple: setTuple(doc, "viewer", eng, "member"), created: 1},
+		{tuple: userTuple(eng, "member", "3"), created: 2},
+		{tuple: setTuple(doc, "parent", folder, ""), created: 1},
+		{tuple: userTuple(folder, "viewer", "4"), created: 1},
+		// Groups eng and ops contain each other.
+		{tuple: setTuple(eng, "member", ops, "member"), created: 1},
+		{tuple: setTuple(ops, "member", eng, "member"), created: 1},
+	}
+	checker := relation.NewChecker(newSchema(t), tuples)
+
+	tests := []struct {
+		name     string
+		relation string
+		userID   string
+		revision int64
+		allowed  bool
+	}{
+		{
+			name:     "direct tuple",
+			relation: "viewer",
+			userID:   "2",
+			revision: 2,
+			allowed:  true,
+		},
+		{
+			name:     "deleted tuple",
+			relation: "viewer",
+			userID:   "2",
+			revision: 3,
+			allowed:  false,
+		},
+		{
+			name:     "computed userset",
+			relation: "viewer",
+			userID:   "1",
+			revision: 1,
+			allowed:  true,
+		},
+		{
+			name:     "userset of a group",
+			relation: "viewer",
+			userID:   "3",
+			revision: 2,
+			allowed:  true,
+		},
+		{
+			name:     "userset before the member was added",
+			relation: "viewer",
+			userID:   "3",
+			revision: 1,
+			allowed:  false,
+		},
+		{
+			name:     "tuple to userset",
+			relation: "viewer",
+			userID:   "4",
+			revision: 1,
+			allowed:  true,
+		},
+		{
+			name:     "relation not granted",
+			relation: "owner",
+			userID:   "4",
+			revision: 1,
+			allowed:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allowed, err := checker.Check(context.Background(), doc, tt.relation, tt.userID, tt.revision)
+			require.NoError(t, err)
+			require.Equal(t, tt.allowed, allowed)
+		})
+	}
+}
+
+func TestCheckerExpand(t *testing.T) {
+	var (
+		doc    = object("document", "1")
+		folder = object("folder", "7")
+		eng    = object("group", "eng")
+	)
+	tuples := memoryTuples{
+		{tuple: userTuple(doc, "owner", "1"), created: 1},
+		{tuple: setTuple(doc, "viewer", eng, "member"), created: 1},
+		{tuple: setTuple(doc, "parent", folder, ""), created: 1},
+		{tuple: userTuple(folder, "viewer", "4"), created: 1},
+	}
+	checker := relation.NewChecker(newSchema(t), tuples)
+
+	tree, err := checker.Expand(context.Background(), doc, "viewer", 1)
+	require.NoError(t, err)
+	require.Equal(t, &model.RelationExpandNode{
+		Operation: model.RelationUnion,
+		Object:    doc,
+		Relation:  "viewer",
+		Children: []*model.RelationExpandNode{
+			{
+				Operation: model.RelationLeaf,
+				Object:    doc,
+				Relation:  "viewer",
+				Subjects:  []model.RelationSubject{{Object: eng, Relation: "member"}},
+			},
+			{
+				Operation: model.RelationUnion,
+				Object:    doc,
+				Relation:  "owner",
+				Children: []*model.RelationExpandNode{{
+					Operation: model.RelationLeaf,
+					Object:    doc,
+					Relation:  "owner",
+					Subjects:  []model.RelationSubject{{UserID: "1"}},
+				}},
+			},
+			{
+				Operation: model.RelationUnion,
+				Object:    folder,
+				Relation:  "viewer",
+				Children: []*model.RelationExpandNode{{
+					Operation: model.RelationLeaf,
+					Object:    folder,
+					Relation:  "viewer",
+					Subjects:  []model.RelationSubject{{UserID: "4"}},
+				}},
+			},
+		},
+	}, tree)
+}
+
+func TestNewSchemaRejectsUnknownRelation(t *testing.T) {
+	_, err := relation.NewSchema([]config.RelationNamespaceConfig{
+		{Name: "document", Relations: []config.RelationDefinitionConfig{
+			{Name: "viewer", Union: []config.RelationRewriteConfig{{ComputedUserset: "editor"}}},
+		}},
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,27 @@
+package converter
+
+import (
+	"github.com/arifullov/auth/internal/model"
+	modelRepo "github.com/arifullov/auth/internal/repository/access/model"
+)
+
+func ToRouteRulesFromRepo(routeAccesses []modelRepo.RouteAccess) []model.RouteRule {
+	rules := make([]model.RouteRule, 0, len(routeAccesses))
+	for _, routeAccess := range routeAccesses {
+		rules = append(rules, *ToRouteRuleFromRepo(routeAccess))
+	}
+	return rules
+}
+
+func ToRouteRuleFromRepo(routeAccess modelRepo.RouteAccess) *model.RouteRule {
+	return &model.RouteRule{
+		ID:         routeAccess.ID,
+		Route:      routeAccess.Route,
+		Method:     routeAccess.Method,
+		Role:       model.Role(routeAccess.Role.String),
+		Effect:     model.RouteEffect(routeAccess.Effect),
+		Scopes:     routeAccess.Scopes,
+		OwnerField: routeAccess.OwnerField,
+		Condition:  routeAccess.Condition,
+	}
+}
@@ -0,0 +1,17 @@
+package model
+
+import (
+	"database/sql"
+)
+
+type RouteAccess struct {
+	ID     int64  `db:"id"`
+	Route  string `db:"route"`
+	Method string `db:"method"`
+	// Role is null for rules applying to any role.
+	Role       sql.NullString `db:"role"`
+	Effect     string         `db:"effect"`
+	Scopes     []string       `db:"scopes"`
+	OwnerField string         `db:"owner_field"`
+	Condition  string         `db:"condition"`
+}
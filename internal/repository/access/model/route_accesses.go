@@ -1,5 +0,0 @@
-package model
-
-type RouteAccesses struct {
-	Role string `db:"role"`
-}
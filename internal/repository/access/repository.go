@@ -2,21 +2,36 @@ package access
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"strings"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 
 	"github.com/arifullov/auth/internal/client/db"
 	"github.com/arifullov/auth/internal/model"
 	"github.com/arifullov/auth/internal/repository"
 	"github.com/arifullov/auth/internal/repository/access/converter"
 	modelRepo "github.com/arifullov/auth/internal/repository/access/model"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
 )
 
 const (
 	routeAccessesTable = "route_accesses"
+	policyVersionTable = "policy_version"
 
-	roleColumn  = "role"
-	routeColumn = "route"
+	idColumn         = "id"
+	routeColumn      = "route"
+	methodColumn     = "method"
+	roleColumn       = "role"
+	effectColumn     = "effect"
+	scopesColumn     = "scopes"
+	ownerFieldColumn = "owner_field"
+	conditionColumn  = "condition"
+	versionColumn    = "version"
 )
 
 type repo struct {
@@ -29,11 +44,11 @@ func NewRepository(db db.Client) repository.AccessRepository {
 	}
 }
 
-func (r repo) GetRouteRoles(ctx context.Context, route string) ([]model.Role, error) {
-	builderSelect := sq.Select(roleColumn).
+func (r repo) ListRouteRules(ctx context.Context) ([]model.RouteRule, error) {
+	builderSelect := sq.Select(idColumn, routeColumn, methodColumn, roleColumn, effectColumn, scopesColumn, ownerFieldColumn, conditionColumn).
 		PlaceholderFormat(sq.Dollar).
 		From(routeAccessesTable).
-		Where(sq.Eq{routeColumn: route})
+		OrderBy(idColumn)
 
 	query, args, err := builderSelect.ToSql()
 	if err != nil {
@@ -41,15 +56,212 @@ func (r repo) GetRouteRoles(ctx context.Context, route string) ([]model.Role, er
 	}
 
 	q := db.Query{
-		Name:     "access_repository.GetRouteRoles",
+		Name:     "access_repository.ListRouteRules",
 		QueryRaw: query,
 	}
 
-	var routeAccesses []modelRepo.RouteAccesses
+	var routeAccesses []modelRepo.RouteAccess
 	err = r.db.DB().ScanAllContext(ctx, &routeAccesses, q, args...)
 	if err != nil {
 		return nil, err
 	}
 
-	return converter.ToRolesFromRepo(routeAccesses), nil
+	return converter.ToRouteRulesFromRepo(routeAccesses), nil
+}
+
+func (r repo) GetPolicyVersion(ctx context.Context) (int64, error) {
+	builderSelect := sq.Select(versionColumn).
+		PlaceholderFormat(sq.Dollar).
+		From(policyVersionTable)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "access_repository.GetPolicyVersion",
+		QueryRaw: query,
+	}
+
+	var version int64
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&version)
+	if err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+func (r repo) FindRouteRules(ctx context.Context, filter model.RouteRuleFilter) ([]model.RouteRule, error) {
+	builderSelect := sq.Select(idColumn, routeColumn, methodColumn, roleColumn, effectColumn, scopesColumn, ownerFieldColumn, conditionColumn).
+		PlaceholderFormat(sq.Dollar).
+		From(routeAccessesTable).
+		Where(sq.Gt{idColumn: filter.AfterID}).
+		OrderBy(idColumn).
+		Limit(filter.Limit)
+	if filter.RoutePrefix != "" {
+		builderSelect = builderSelect.Where(sq.Like{routeColumn: likePrefixReplacer.Replace(filter.RoutePrefix) + "%"})
+	}
+	if filter.Role != "" {
+		builderSelect = builderSelect.Where(sq.Eq{roleColumn: filter.Role})
+	}
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "access_repository.FindRouteRules",
+		QueryRaw: query,
+	}
+
+	var routeAccesses []modelRepo.RouteAccess
+	err = r.db.DB().ScanAllContext(ctx, &routeAccesses, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToRouteRulesFromRepo(routeAccesses), nil
+}
+
+func (r repo) GetRouteRule(ctx context.Context, id int64) (*model.RouteRule, error) {
+	builderSelect := sq.Select(idColumn, routeColumn, methodColumn, roleColumn, effectColumn, scopesColumn, ownerFieldColumn, conditionColumn).
+		PlaceholderFormat(sq.Dollar).
+		From(routeAccessesTable).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "access_repository.GetRouteRule",
+		QueryRaw: query,
+	}
+
+	var routeAccess modelRepo.RouteAccess
+	err = r.db.DB().ScanOneContext(ctx, &routeAccess, q, args...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, sys.NewCommonError(codes.NotFound, "route rule not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToRouteRuleFromRepo(routeAccess), nil
+}
+
+func (r repo) CreateRouteRule(ctx context.Context, rule *model.RouteRule) (int64, error) {
+	builderInsert := sq.Insert(routeAccessesTable).
+		PlaceholderFormat(sq.Dollar).
+		Columns(routeColumn, methodColumn, roleColumn, effectColumn, scopesColumn, ownerFieldColumn, conditionColumn).
+		Values(rule.Route, rule.Method, nullRole(rule.Role), rule.Effect, scopes(rule.Scopes), rule.OwnerField, rule.Condition).
+		Suffix("RETURNING id")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "access_repository.CreateRouteRule",
+		QueryRaw: query,
+	}
+
+	var id int64
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&id)
+	if err != nil {
+		return 0, toRouteRuleError(err)
+	}
+	return id, nil
+}
+
+func (r repo) UpdateRouteRule(ctx context.Context, rule *model.RouteRule) error {
+	builderUpdate := sq.Update(routeAccessesTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(routeColumn, rule.Route).
+		Set(methodColumn, rule.Method).
+		Set(roleColumn, nullRole(rule.Role)).
+		Set(effectColumn, rule.Effect).
+		Set(scopesColumn, scopes(rule.Scopes)).
+		Set(ownerFieldColumn, rule.OwnerField).
+		Set(conditionColumn, rule.Condition).
+		Where(sq.Eq{idColumn: rule.ID})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "access_repository.UpdateRouteRule",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return toRouteRuleError(err)
+	}
+	if res.RowsAffected() == 0 {
+		return sys.NewCommonError(codes.NotFound, "route rule not found")
+	}
+	return nil
+}
+
+func (r repo) DeleteRouteRule(ctx context.Context, id int64) error {
+	builderDelete := sq.Delete(routeAccessesTable).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "access_repository.DeleteRouteRule",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return sys.NewCommonError(codes.NotFound, "route rule not found")
+	}
+	return nil
+}
+
+// nullRole stores rules for any role with a null role.
+func nullRole(role model.Role) sql.NullString {
+	return sql.NullString{String: string(role), Valid: role != ""}
+}
+
+// scopes keeps the column not null for rules without scopes.
+func scopes(scopes []string) []string {
+	if scopes == nil {
+		return []string{}
+	}
+	return scopes
+}
+
+// likePrefixReplacer escapes LIKE wildcards so a prefix filter matches literally.
+var likePrefixReplacer = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func toRouteRuleError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+	switch pgErr.Code {
+	case "23505":
+		return sys.NewCommonError(codes.AlreadyExists, "route rule already exists")
+	case "23503":
+		return sys.NewCommonError(codes.InvalidArgument, "role not found")
+	default:
+		return err
+	}
 }
@@ -0,0 +1,36 @@
+package converter
+
+import (
+	"encoding/json"
+
+	"github.com/arifullov/auth/internal/model"
+	modelRepo "github.com/arifullov/auth/internal/repository/audit/model"
+)
+
+func ToAuditRecordFromService(record *model.AuditRecord) (*modelRepo.AuditRecord, error) {
+	before, err := marshalState(record.Before)
+	if err != nil {
+		return nil, err
+	}
+	after, err := marshalState(record.After)
+	if err != nil {
+		return nil, err
+	}
+	return &modelRepo.AuditRecord{
+		Actor:        record.Actor,
+		Action:       record.Action,
+		ResourceType: record.ResourceType,
+		ResourceID:   record.ResourceID,
+		Before:       before,
+		After:        after,
+		CreatedAt:    record.CreatedAt,
+	}, nil
+}
+
+// marshalState keeps a missing state as SQL NULL instead of the JSON null.
+func marshalState(state any) ([]byte, error) {
+	if state == nil {
+		return nil, nil
+	}
+	return json.Marshal(state)
+}
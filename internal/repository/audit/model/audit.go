@@ -0,0 +1,16 @@
+package model
+
+import (
+	"time"
+)
+
+type AuditRecord struct {
+	ID           int64     `db:"id"`
+	Actor        string    `db:"actor"`
+	Action       string    `db:"action"`
+	ResourceType string    `db:"resource_type"`
+	ResourceID   string    `db:"resource_id"`
+	Before       []byte    `db:"before"`
+	After        []byte    `db:"after"`
+	CreatedAt    time.Time `db:"created_at"`
+}
@@ -0,0 +1,64 @@
+package audit
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/arifullov/auth/internal/client/db"
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/repository"
+	"github.com/arifullov/auth/internal/repository/audit/converter"
+)
+
+const (
+	tableName = "audit_log"
+
+	actorColumn        = "actor"
+	actionColumn       = "action"
+	resourceTypeColumn = "resource_type"
+	resourceIDColumn   = "resource_id"
+	beforeColumn       = "before"
+	afterColumn        = "after"
+	createdAtColumn    = "created_at"
+)
+
+type repo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) repository.AuditRepository {
+	return &repo{
+		db: db,
+	}
+}
+
+func (r *repo) Create(ctx context.Context, record *model.AuditRecord) (int64, error) {
+	repoRecord, err := converter.ToAuditRecordFromService(record)
+	if err != nil {
+		return 0, err
+	}
+
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(actorColumn, actionColumn, resourceTypeColumn, resourceIDColumn, beforeColumn, afterColumn, createdAtColumn).
+		Values(repoRecord.Actor, repoRecord.Action, repoRecord.ResourceType, repoRecord.ResourceID, repoRecord.Before, repoRecord.After, repoRecord.CreatedAt).
+		Suffix("RETURNING id")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "audit_repository.Create",
+		QueryRaw: query,
+	}
+
+	var id int64
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
@@ -0,0 +1,24 @@
+package converter
+
+import (
+	"time"
+
+	"github.com/arifullov/auth/internal/model"
+	modelRepo "github.com/arifullov/auth/internal/repository/device/model"
+)
+
+func ToDeviceCodeFromRepo(code modelRepo.DeviceCode) *model.DeviceCode {
+	return &model.DeviceCode{
+		ID:             code.ID,
+		DeviceCodeHash: code.DeviceCodeHash,
+		UserCode:       code.UserCode,
+		ClientID:       code.ClientID,
+		Scope:          code.Scope,
+		Status:         model.DeviceCodeStatus(code.Status),
+		UserID:         code.UserID,
+		PollInterval:   time.Duration(code.PollInterval) * time.Second,
+		ExpiresAt:      code.ExpiresAt,
+		LastPolledAt:   code.LastPolledAt,
+		CreatedAt:      code.CreatedAt,
+	}
+}
@@ -0,0 +1,20 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+type DeviceCode struct {
+	ID             int64         `db:"id"`
+	DeviceCodeHash string        `db:"device_code_hash"`
+	UserCode       string        `db:"user_code"`
+	ClientID       string        `db:"client_id"`
+	Scope          string        `db:"scope"`
+	Status         string        `db:"status"`
+	UserID         sql.NullInt64 `db:"user_id"`
+	PollInterval   int64         `db:"poll_interval"`
+	ExpiresAt      time.Time     `db:"expires_at"`
+	LastPolledAt   sql.NullTime  `db:"last_polled_at"`
+	CreatedAt      time.Time     `db:"created_at"`
+}
@@ -0,0 +1,188 @@
+package device
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/arifullov/auth/internal/client/db"
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/repository"
+	"github.com/arifullov/auth/internal/repository/device/converter"
+	modelRepo "github.com/arifullov/auth/internal/repository/device/model"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+)
+
+const (
+	tableName = "device_codes"
+
+	idColumn             = "id"
+	deviceCodeHashColumn = "device_code_hash"
+	userCodeColumn       = "user_code"
+	clientIDColumn       = "client_id"
+	scopeColumn          = "scope"
+	statusColumn         = "status"
+	userIDColumn         = "user_id"
+	pollIntervalColumn   = "poll_interval"
+	expiresAtColumn      = "expires_at"
+	lastPolledAtColumn   = "last_polled_at"
+	createdAtColumn      = "created_at"
+)
+
+type repo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) repository.DeviceCodeRepository {
+	return &repo{db: db}
+}
+
+func (r *repo) Create(ctx context.Context, code *model.DeviceCode) (int64, error) {
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(deviceCodeHashColumn, userCodeColumn, clientIDColumn, scopeColumn, pollIntervalColumn, expiresAtColumn, createdAtColumn).
+		Values(code.DeviceCodeHash, code.UserCode, code.ClientID, code.Scope, int64(code.PollInterval.Seconds()), code.ExpiresAt, code.CreatedAt).
+		Suffix("RETURNING id")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "device_repository.Create",
+		QueryRaw: query,
+	}
+
+	var id int64
+	if err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&id); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *repo) GetByDeviceCodeHash(ctx context.Context, deviceCodeHash string) (*model.DeviceCode, error) {
+	builderSelect := sq.Select(idColumn, deviceCodeHashColumn, userCodeColumn, clientIDColumn, scopeColumn, statusColumn, userIDColumn,
+		pollIntervalColumn, expiresAtColumn, lastPolledAtColumn, createdAtColumn).
+		PlaceholderFormat(sq.Dollar).
+		From(tableName).
+		Where(sq.Eq{deviceCodeHashColumn: deviceCodeHash})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "device_repository.GetByDeviceCodeHash",
+		QueryRaw: query,
+	}
+
+	var code modelRepo.DeviceCode
+	err = r.db.DB().ScanOneContext(ctx, &code, q, args...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, sys.NewCommonError(codes.NotFound, "device code not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToDeviceCodeFromRepo(code), nil
+}
+
+func (r *repo) Resolve(ctx context.Context, userCode string, status model.DeviceCodeStatus, userID int64) error {
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(statusColumn, status).
+		Set(userIDColumn, userID).
+		Where(sq.Eq{userCodeColumn: userCode, statusColumn: model.DeviceCodePending}).
+		Where(sq.Gt{expiresAtColumn: time.Now()})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "device_repository.Resolve",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return sys.NewCommonError(codes.NotFound, "user code not found or expired")
+	}
+	return nil
+}
+
+func (r *repo) UpdatePoll(ctx context.Context, id int64, polledAt time.Time, pollInterval time.Duration) error {
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(lastPolledAtColumn, polledAt).
+		Set(pollIntervalColumn, int64(pollInterval.Seconds())).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "device_repository.UpdatePoll",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
+
+func (r *repo) Delete(ctx context.Context, id int64) error {
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "device_repository.Delete",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return sys.NewCommonError(codes.NotFound, "device code not found")
+	}
+	return nil
+}
+
+func (r *repo) DeleteExpired(ctx context.Context, now time.Time) error {
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.LtOrEq{expiresAtColumn: now})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "device_repository.DeleteExpired",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
@@ -0,0 +1,60 @@
+package converter
+
+import (
+	"github.com/arifullov/auth/internal/model"
+	modelRepo "github.com/arifullov/auth/internal/repository/group/model"
+)
+
+func ToRolesFromRepo(names []string) []model.Role {
+	roles := make([]model.Role, 0, len(names))
+	for _, name := range names {
+		roles = append(roles, model.Role(name))
+	}
+	return roles
+}
+
+func ToRoleNamesFromService(roles []model.Role) []string {
+	names := make([]string, 0, len(roles))
+	for _, role := range roles {
+		names = append(names, string(role))
+	}
+	return names
+}
+
+func ToGroupFromRepo(group modelRepo.Group) *model.Group {
+	return &model.Group{
+		ID:          group.ID,
+		Name:        group.Name,
+		Description: group.Description,
+		Roles:       ToRolesFromRepo(group.Roles),
+		ParentIDs:   group.ParentIDs,
+		CreatedAt:   group.CreatedAt,
+	}
+}
+
+func ToRoleGraphFromRepo(
+	sources modelRepo.UserRoleSources,
+	tenant string,
+	groups []modelRepo.Group,
+	roleParents []modelRepo.RoleParents,
+) *model.RoleGraph {
+	graph := &model.RoleGraph{
+		Roles:       ToRolesFromRepo(sources.Roles),
+		Tenant:      tenant,
+		TenantRoles: ToRolesFromRepo(sources.TenantRoles),
+		GroupIDs:    sources.GroupIDs,
+		Groups:      make(map[int64]model.GroupNode, len(groups)),
+		RoleParents: make(map[model.Role][]model.Role, len(roleParents)),
+	}
+	for _, group := range groups {
+		graph.Groups[group.ID] = model.GroupNode{
+			Name:      group.Name,
+			ParentIDs: group.ParentIDs,
+			Roles:     ToRolesFromRepo(group.Roles),
+		}
+	}
+	for _, rp := range roleParents {
+		graph.RoleParents[model.Role(rp.Role)] = ToRolesFromRepo(rp.Parents)
+	}
+	return graph
+}
@@ -0,0 +1,25 @@
+package model
+
+import (
+	"time"
+)
+
+type Group struct {
+	ID          int64     `db:"id"`
+	Name        string    `db:"name"`
+	Description string    `db:"description"`
+	Roles       []string  `db:"roles"`
+	ParentIDs   []int64   `db:"parent_ids"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+type UserRoleSources struct {
+	Roles       []string `db:"roles"`
+	TenantRoles []string `db:"tenant_roles"`
+	GroupIDs    []int64  `db:"group_ids"`
+}
+
+type RoleParents struct {
+	Role    string   `db:"role"`
+	Parents []string `db:"parents"`
+}
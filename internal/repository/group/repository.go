@@ -0,0 +1,401 @@
+package group
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/arifullov/auth/internal/client/db"
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/repository"
+	"github.com/arifullov/auth/internal/repository/group/converter"
+	modelRepo "github.com/arifullov/auth/internal/repository/group/model"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+)
+
+const (
+	tableName   = "groups"
+	membersName = "group_members"
+	parentsName = "group_parents"
+
+	idColumn          = "id"
+	nameColumn        = "name"
+	descriptionColumn = "description"
+	rolesColumn       = "coalesce((select array_agg(r.name order by r.name) from group_roles gr join roles r on r.id = gr.role_id where gr.group_id = groups.id), '{}') as roles"
+	parentIDsColumn   = "coalesce((select array_agg(gp.parent_id::bigint order by gp.parent_id) from group_parents gp where gp.group_id = groups.id), '{}') as parent_ids"
+	createdAtColumn   = "created_at"
+	groupIDColumn     = "group_id"
+	userIDColumn      = "user_id"
+	parentIDColumn    = "parent_id"
+)
+
+// createQuery inserts the group and binds the roles in one statement, names missing in the roles table are ignored.
+const createQuery = `
+with new_group as (
+	insert into groups (name, description)
+	values ($1, $2)
+	returning id
+), new_group_roles as (
+	insert into group_roles (group_id, role_id)
+	select new_group.id, roles.id
+	from new_group, roles
+	where roles.name = any($3)
+)
+select id from new_group`
+
+// setRolesQuery makes the roles bound to the group equal to the given names, names missing in the roles table are ignored.
+const setRolesQuery = `
+with removed as (
+	delete from group_roles gr
+	using roles r
+	where gr.role_id = r.id and gr.group_id = $1 and r.name <> all($2)
+)
+insert into group_roles (group_id, role_id)
+select $1, r.id
+from roles r
+where r.name = any($2)
+on conflict do nothing`
+
+// lockParentsQuery takes the transaction lock guarding group_parents, the cycle check of
+// addParentQuery cannot see the rows inserted by concurrent transactions.
+const lockParentsQuery = `select pg_advisory_xact_lock(hashtext('group_parents'))`
+
+// addParentQuery nests the group $1 in the group $2 unless $1 is $2 or one of the groups
+// $2 is nested in, which would close a cycle.
+const addParentQuery = `
+with recursive ancestors (id) as (
+	select $2::integer
+	union
+	select gp.parent_id
+	from group_parents gp
+	join ancestors a on a.id = gp.group_id
+)
+insert into group_parents (group_id, parent_id)
+select $1, $2
+where not exists (select 1 from ancestors where id = $1)
+returning group_id`
+
+// revokeMemberTokensQuery revokes the tokens of the members of the group $1 and of every
+// group nested in it, since they all get the roles of the group.
+const revokeMemberTokensQuery = `
+with recursive descendants (id) as (
+	select $1::integer
+	union
+	select gp.group_id
+	from group_parents gp
+	join descendants d on d.id = gp.parent_id
+)
+update users set tokens_revoked_at = $2
+where id in (
+	select gm.user_id
+	from group_members gm
+	join descendants d on d.id = gm.group_id
+)`
+
+// roleSourcesQuery returns the roles assigned to the user $1 directly and in the tenant $2
+// and the groups the user is a member of.
+const roleSourcesQuery = `
+select
+	coalesce((
+		select array_agg(r.name order by r.name)
+		from user_roles ur
+		join roles r on r.id = ur.role_id
+		where ur.user_id = $1 and (ur.expires_at is null or ur.expires_at > now())
+	), '{}') as roles,
+	coalesce((
+		select array_agg(r.name order by r.name)
+		from organization_member_roles mr
+		join organizations o on o.id = mr.organization_id
+		join roles r on r.id = mr.role_id
+		where mr.user_id = $1 and o.slug = $2
+	), '{}') as tenant_roles,
+	coalesce((
+		select array_agg(group_id::bigint order by group_id)
+		from group_members
+		where user_id = $1
+	), '{}') as group_ids`
+
+// userGroupsQuery returns the groups of the user $1 and all the groups they are nested in.
+const userGroupsQuery = `
+with recursive user_groups (id) as (
+	select group_id from group_members where user_id = $1
+	union
+	select gp.parent_id
+	from group_parents gp
+	join user_groups ug on ug.id = gp.group_id
+)
+select g.id, g.name, g.description, g.created_at,
+	coalesce((
+		select array_agg(r.name order by r.name)
+		from group_roles gr
+		join roles r on r.id = gr.role_id
+		where gr.group_id = g.id
+	), '{}') as roles,
+	coalesce((
+		select array_agg(gp.parent_id::bigint order by gp.parent_id)
+		from group_parents gp
+		where gp.group_id = g.id
+	), '{}') as parent_ids
+from groups g
+join user_groups ug on ug.id = g.id
+order by g.id`
+
+// isMemberQuery tells whether the user $2 is a member of the group named $1 or of a group nested in it.
+const isMemberQuery = `
+with recursive user_groups (id) as (
+	select group_id from group_members where user_id = $2
+	union
+	select gp.parent_id
+	from group_parents gp
+	join user_groups ug on ug.id = gp.group_id
+)
+select exists (
+	select 1
+	from groups g
+	join user_groups ug on ug.id = g.id
+	where g.name = $1
+)`
+
+const roleParentsQuery = `
+select r.name as role, array_agg(p.name order by p.name) as parents
+from role_parents rp
+join roles r on r.id = rp.role_id
+join roles p on p.id = rp.parent_id
+group by r.name
+order by r.name`
+
+type repo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) repository.GroupRepository {
+	return &repo{db: db}
+}
+
+func (r *repo) Create(ctx context.Context, group *model.CreateGroup) (int64, error) {
+	q := db.Query{
+		Name:     "group_repository.Create",
+		QueryRaw: createQuery,
+	}
+
+	var id int64
+	var pgErr *pgconn.PgError
+	err := r.db.DB().QueryRowContext(ctx, q, group.Name, group.Description, converter.ToRoleNamesFromService(group.Roles)).Scan(&id)
+	if err != nil && errors.As(err, &pgErr) {
+		if pgErr.Code == "23505" {
+			return 0, sys.NewCommonError(codes.AlreadyExists, "group already exists")
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *repo) Get(ctx context.Context, id int64) (*model.Group, error) {
+	builderSelect := sq.Select(idColumn, nameColumn, descriptionColumn, rolesColumn, parentIDsColumn, createdAtColumn).
+		PlaceholderFormat(sq.Dollar).
+		From(tableName).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "group_repository.Get",
+		QueryRaw: query,
+	}
+
+	var group modelRepo.Group
+	err = r.db.DB().ScanOneContext(ctx, &group, q, args...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, sys.NewCommonError(codes.NotFound, "group not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return converter.ToGroupFromRepo(group), nil
+}
+
+func (r *repo) Delete(ctx context.Context, id int64) error {
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: id})
+
+	return r.execDelete(ctx, "group_repository.Delete", builderDelete, "group not found")
+}
+
+func (r *repo) SetRoles(ctx context.Context, id int64, roles []model.Role) error {
+	q := db.Query{
+		Name:     "group_repository.SetRoles",
+		QueryRaw: setRolesQuery,
+	}
+
+	_, err := r.db.DB().ExecContext(ctx, q, id, converter.ToRoleNamesFromService(roles))
+	return err
+}
+
+func (r *repo) AddMember(ctx context.Context, groupID int64, userID int64) error {
+	builderInsert := sq.Insert(membersName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(groupIDColumn, userIDColumn).
+		Values(groupID, userID)
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "group_repository.AddMember",
+		QueryRaw: query,
+	}
+
+	var pgErr *pgconn.PgError
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil && errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23505":
+			return sys.NewCommonError(codes.AlreadyExists, "user is already a member of the group")
+		case "23503":
+			return sys.NewCommonError(codes.NotFound, "group or user not found")
+		}
+	}
+	return err
+}
+
+func (r *repo) RemoveMember(ctx context.Context, groupID int64, userID int64) error {
+	builderDelete := sq.Delete(membersName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{groupIDColumn: groupID, userIDColumn: userID})
+
+	return r.execDelete(ctx, "group_repository.RemoveMember", builderDelete, "user is not a member of the group")
+}
+
+func (r *repo) LockParents(ctx context.Context) error {
+	q := db.Query{
+		Name:     "group_repository.LockParents",
+		QueryRaw: lockParentsQuery,
+	}
+
+	_, err := r.db.DB().ExecContext(ctx, q)
+	return err
+}
+
+func (r *repo) AddParent(ctx context.Context, groupID int64, parentID int64) error {
+	q := db.Query{
+		Name:     "group_repository.AddParent",
+		QueryRaw: addParentQuery,
+	}
+
+	var id int64
+	var pgErr *pgconn.PgError
+	err := r.db.DB().QueryRowContext(ctx, q, groupID, parentID).Scan(&id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return sys.NewCommonError(codes.FailedPrecondition, "nesting the group would create a cycle")
+	}
+	if err != nil && errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23505":
+			return sys.NewCommonError(codes.AlreadyExists, "group is already nested in the parent group")
+		case "23503":
+			return sys.NewCommonError(codes.NotFound, "group not found")
+		}
+	}
+	return err
+}
+
+func (r *repo) RemoveParent(ctx context.Context, groupID int64, parentID int64) error {
+	builderDelete := sq.Delete(parentsName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{groupIDColumn: groupID, parentIDColumn: parentID})
+
+	return r.execDelete(ctx, "group_repository.RemoveParent", builderDelete, "group is not nested in the parent group")
+}
+
+func (r *repo) RevokeMemberTokens(ctx context.Context, id int64, revokedAt time.Time) error {
+	q := db.Query{
+		Name:     "group_repository.RevokeMemberTokens",
+		QueryRaw: revokeMemberTokensQuery,
+	}
+
+	_, err := r.db.DB().ExecContext(ctx, q, id, revokedAt)
+	return err
+}
+
+func (r *repo) GetRoleGraph(ctx context.Context, userID int64, tenant string) (*model.RoleGraph, error) {
+	q := db.Query{
+		Name:     "group_repository.GetRoleSources",
+		QueryRaw: roleSourcesQuery,
+	}
+	var sources modelRepo.UserRoleSources
+	err := r.db.DB().ScanOneContext(ctx, &sources, q, userID, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	q = db.Query{
+		Name:     "group_repository.ListUserGroups",
+		QueryRaw: userGroupsQuery,
+	}
+	var groups []modelRepo.Group
+	err = r.db.DB().ScanAllContext(ctx, &groups, q, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	q = db.Query{
+		Name:     "group_repository.ListRoleParents",
+		QueryRaw: roleParentsQuery,
+	}
+	var roleParents []modelRepo.RoleParents
+	err = r.db.DB().ScanAllContext(ctx, &roleParents, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToRoleGraphFromRepo(sources, tenant, groups, roleParents), nil
+}
+
+func (r *repo) IsMember(ctx context.Context, name string, userID int64) (bool, error) {
+	q := db.Query{
+		Name:     "group_repository.IsMember",
+		QueryRaw: isMemberQuery,
+	}
+
+	var member bool
+	err := r.db.DB().QueryRowContext(ctx, q, name, userID).Scan(&member)
+	if err != nil {
+		return false, err
+	}
+	return member, nil
+}
+
+// execDelete runs the delete and returns NotFound with the message when no row is deleted.
+func (r *repo) execDelete(ctx context.Context, name string, builder sq.DeleteBuilder, notFound string) error {
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     name,
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return sys.NewCommonError(codes.NotFound, notFound)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package identity
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/arifullov/auth/internal/client/db"
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/repository"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+)
+
+const (
+	tableName = "user_identities"
+
+	userIDColumn    = "user_id"
+	providerColumn  = "provider"
+	subjectColumn   = "subject"
+	emailColumn     = "email"
+	createdAtColumn = "created_at"
+)
+
+type repo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) repository.IdentityRepository {
+	return &repo{db: db}
+}
+
+func (r *repo) Create(ctx context.Context, identity *model.UserIdentity) (int64, error) {
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(userIDColumn, providerColumn, subjectColumn, emailColumn, createdAtColumn).
+		Values(identity.UserID, identity.Provider, identity.Subject, identity.Email, identity.CreatedAt).
+		Suffix("RETURNING id")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "identity_repository.Create",
+		QueryRaw: query,
+	}
+
+	var id int64
+	var pgErr *pgconn.PgError
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&id)
+	if err != nil && errors.As(err, &pgErr) {
+		if pgErr.Code == "23505" {
+			return 0, sys.NewCommonError(codes.AlreadyExists, "identity already linked")
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *repo) GetUserID(ctx context.Context, provider string, subject string) (int64, error) {
+	builderSelect := sq.Select(userIDColumn).
+		PlaceholderFormat(sq.Dollar).
+		From(tableName).
+		Where(sq.Eq{providerColumn: provider, subjectColumn: subject})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "identity_repository.GetUserID",
+		QueryRaw: query,
+	}
+
+	var userID int64
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, sys.NewCommonError(codes.NotFound, "identity not found")
+	}
+	if err != nil {
+		return 0, err
+	}
+	return userID, nil
+}
@@ -0,0 +1,2084 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.8). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/arifullov/auth/internal/repository.AccessRepository -o access_repository_minimock.go -n AccessRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// AccessRepositoryMock implements repository.AccessRepository
+type AccessRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateRouteRule          func(ctx context.Context, rule *model.RouteRule) (i1 int64, err error)
+	inspectFuncCreateRouteRule   func(ctx context.Context, rule *model.RouteRule)
+	afterCreateRouteRuleCounter  uint64
+	beforeCreateRouteRuleCounter uint64
+	CreateRouteRuleMock          mAccessRepositoryMockCreateRouteRule
+
+	funcDeleteRouteRule          func(ctx context.Context, id int64) (err error)
+	inspectFuncDeleteRouteRule   func(ctx context.Context, id int64)
+	afterDeleteRouteRuleCounter  uint64
+	beforeDeleteRouteRuleCounter uint64
+	DeleteRouteRuleMock          mAccessRepositoryMockDeleteRouteRule
+
+	funcFindRouteRules          func(ctx context.Context, filter model.RouteRuleFilter) (ra1 []model.RouteRule, err error)
+	inspectFuncFindRouteRules   func(ctx context.Context, filter model.RouteRuleFilter)
+	afterFindRouteRulesCounter  uint64
+	beforeFindRouteRulesCounter uint64
+	FindRouteRulesMock          mAccessRepositoryMockFindRouteRules
+
+	funcGetPolicyVersion          func(ctx context.Context) (i1 int64, err error)
+	inspectFuncGetPolicyVersion   func(ctx context.Context)
+	afterGetPolicyVersionCounter  uint64
+	beforeGetPolicyVersionCounter uint64
+	GetPolicyVersionMock          mAccessRepositoryMockGetPolicyVersion
+
+	funcGetRouteRule          func(ctx context.Context, id int64) (rp1 *model.RouteRule, err error)
+	inspectFuncGetRouteRule   func(ctx context.Context, id int64)
+	afterGetRouteRuleCounter  uint64
+	beforeGetRouteRuleCounter uint64
+	GetRouteRuleMock          mAccessRepositoryMockGetRouteRule
+
+	funcListRouteRules          func(ctx context.Context) (ra1 []model.RouteRule, err error)
+	inspectFuncListRouteRules   func(ctx context.Context)
+	afterListRouteRulesCounter  uint64
+	beforeListRouteRulesCounter uint64
+	ListRouteRulesMock          mAccessRepositoryMockListRouteRules
+
+	funcUpdateRouteRule          func(ctx context.Context, rule *model.RouteRule) (err error)
+	inspectFuncUpdateRouteRule   func(ctx context.Context, rule *model.RouteRule)
+	afterUpdateRouteRuleCounter  uint64
+	beforeUpdateRouteRuleCounter uint64
+	UpdateRouteRuleMock          mAccessRepositoryMockUpdateRouteRule
+}
+
+// NewAccessRepositoryMock returns a mock for repository.AccessRepository
+func NewAccessRepositoryMock(t minimock.Tester) *AccessRepositoryMock {
+	m := &AccessRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateRouteRuleMock = mAccessRepositoryMockCreateRouteRule{mock: m}
+	m.CreateRouteRuleMock.callArgs = []*AccessRepositoryMockCreateRouteRuleParams{}
+
+	m.DeleteRouteRuleMock = mAccessRepositoryMockDeleteRouteRule{mock: m}
+	m.DeleteRouteRuleMock.callArgs = []*AccessRepositoryMockDeleteRouteRuleParams{}
+
+	m.FindRouteRulesMock = mAccessRepositoryMockFindRouteRules{mock: m}
+	m.FindRouteRulesMock.callArgs = []*AccessRepositoryMockFindRouteRulesParams{}
+
+	m.GetPolicyVersionMock = mAccessRepositoryMockGetPolicyVersion{mock: m}
+	m.GetPolicyVersionMock.callArgs = []*AccessRepositoryMockGetPolicyVersionParams{}
+
+	m.GetRouteRuleMock = mAccessRepositoryMockGetRouteRule{mock: m}
+	m.GetRouteRuleMock.callArgs = []*AccessRepositoryMockGetRouteRuleParams{}
+
+	m.ListRouteRulesMock = mAccessRepositoryMockListRouteRules{mock: m}
+	m.ListRouteRulesMock.callArgs = []*AccessRepositoryMockListRouteRulesParams{}
+
+	m.UpdateRouteRuleMock = mAccessRepositoryMockUpdateRouteRule{mock: m}
+	m.UpdateRouteRuleMock.callArgs = []*AccessRepositoryMockUpdateRouteRuleParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAccessRepositoryMockCreateRouteRule struct {
+	mock               *AccessRepositoryMock
+	defaultExpectation *AccessRepositoryMockCreateRouteRuleExpectation
+	expectations       []*AccessRepositoryMockCreateRouteRuleExpectation
+
+	callArgs []*AccessRepositoryMockCreateRouteRuleParams
+	mutex    sync.RWMutex
+}
+
+// AccessRepositoryMockCreateRouteRuleExpectation specifies expectation struct of the AccessRepository.CreateRouteRule
+type AccessRepositoryMockCreateRouteRuleExpectation struct {
+	mock      *AccessRepositoryMock
+	params    *AccessRepositoryMockCreateRouteRuleParams
+	paramPtrs *AccessRepositoryMockCreateRouteRuleParamPtrs
+	results   *AccessRepositoryMockCreateRouteRuleResults
+	Counter   uint64
+}
+
+// AccessRepositoryMockCreateRouteRuleParams contains parameters of the AccessRepository.CreateRouteRule
+type AccessRepositoryMockCreateRouteRuleParams struct {
+	ctx  context.Context
+	rule *model.RouteRule
+}
+
+// AccessRepositoryMockCreateRouteRuleParamPtrs contains pointers to parameters of the AccessRepository.CreateRouteRule
+type AccessRepositoryMockCreateRouteRuleParamPtrs struct {
+	ctx  *context.Context
+	rule **model.RouteRule
+}
+
+// AccessRepositoryMockCreateRouteRuleResults contains results of the AccessRepository.CreateRouteRule
+type AccessRepositoryMockCreateRouteRuleResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for AccessRepository.CreateRouteRule
+func (mmCreateRouteRule *mAccessRepositoryMockCreateRouteRule) Expect(ctx context.Context, rule *model.RouteRule) *mAccessRepositoryMockCreateRouteRule {
+	if mmCreateRouteRule.mock.funcCreateRouteRule != nil {
+		mmCreateRouteRule.mock.t.Fatalf("AccessRepositoryMock.CreateRouteRule mock is already set by Set")
+	}
+
+	if mmCreateRouteRule.defaultExpectation == nil {
+		mmCreateRouteRule.defaultExpectation = &AccessRepositoryMockCreateRouteRuleExpectation{}
+	}
+
+	if mmCreateRouteRule.defaultExpectation.paramPtrs != nil {
+		mmCreateRouteRule.mock.t.Fatalf("AccessRepositoryMock.CreateRouteRule mock is already set by ExpectParams functions")
+	}
+
+	mmCreateRouteRule.defaultExpectation.params = &AccessRepositoryMockCreateRouteRuleParams{ctx, rule}
+	for _, e := range mmCreateRouteRule.expectations {
+		if minimock.Equal(e.params, mmCreateRouteRule.defaultExpectation.params) {
+			mmCreateRouteRule.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateRouteRule.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateRouteRule
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessRepository.CreateRouteRule
+func (mmCreateRouteRule *mAccessRepositoryMockCreateRouteRule) ExpectCtxParam1(ctx context.Context) *mAccessRepositoryMockCreateRouteRule {
+	if mmCreateRouteRule.mock.funcCreateRouteRule != nil {
+		mmCreateRouteRule.mock.t.Fatalf("AccessRepositoryMock.CreateRouteRule mock is already set by Set")
+	}
+
+	if mmCreateRouteRule.defaultExpectation == nil {
+		mmCreateRouteRule.defaultExpectation = &AccessRepositoryMockCreateRouteRuleExpectation{}
+	}
+
+	if mmCreateRouteRule.defaultExpectation.params != nil {
+		mmCreateRouteRule.mock.t.Fatalf("AccessRepositoryMock.CreateRouteRule mock is already set by Expect")
+	}
+
+	if mmCreateRouteRule.defaultExpectation.paramPtrs == nil {
+		mmCreateRouteRule.defaultExpectation.paramPtrs = &AccessRepositoryMockCreateRouteRuleParamPtrs{}
+	}
+	mmCreateRouteRule.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateRouteRule
+}
+
+// ExpectRuleParam2 sets up expected param rule for AccessRepository.CreateRouteRule
+func (mmCreateRouteRule *mAccessRepositoryMockCreateRouteRule) ExpectRuleParam2(rule *model.RouteRule) *mAccessRepositoryMockCreateRouteRule {
+	if mmCreateRouteRule.mock.funcCreateRouteRule != nil {
+		mmCreateRouteRule.mock.t.Fatalf("AccessRepositoryMock.CreateRouteRule mock is already set by Set")
+	}
+
+	if mmCreateRouteRule.defaultExpectation == nil {
+		mmCreateRouteRule.defaultExpectation = &AccessRepositoryMockCreateRouteRuleExpectation{}
+	}
+
+	if mmCreateRouteRule.defaultExpectation.params != nil {
+		mmCreateRouteRule.mock.t.Fatalf("AccessRepositoryMock.CreateRouteRule mock is already set by Expect")
+	}
+
+	if mmCreateRouteRule.defaultExpectation.paramPtrs == nil {
+		mmCreateRouteRule.defaultExpectation.paramPtrs = &AccessRepositoryMockCreateRouteRuleParamPtrs{}
+	}
+	mmCreateRouteRule.defaultExpectation.paramPtrs.rule = &rule
+
+	return mmCreateRouteRule
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessRepository.CreateRouteRule
+func (mmCreateRouteRule *mAccessRepositoryMockCreateRouteRule) Inspect(f func(ctx context.Context, rule *model.RouteRule)) *mAccessRepositoryMockCreateRouteRule {
+	if mmCreateRouteRule.mock.inspectFuncCreateRouteRule != nil {
+		mmCreateRouteRule.mock.t.Fatalf("Inspect function is already set for AccessRepositoryMock.CreateRouteRule")
+	}
+
+	mmCreateRouteRule.mock.inspectFuncCreateRouteRule = f
+
+	return mmCreateRouteRule
+}
+
+// Return sets up results that will be returned by AccessRepository.CreateRouteRule
+func (mmCreateRouteRule *mAccessRepositoryMockCreateRouteRule) Return(i1 int64, err error) *AccessRepositoryMock {
+	if mmCreateRouteRule.mock.funcCreateRouteRule != nil {
+		mmCreateRouteRule.mock.t.Fatalf("AccessRepositoryMock.CreateRouteRule mock is already set by Set")
+	}
+
+	if mmCreateRouteRule.defaultExpectation == nil {
+		mmCreateRouteRule.defaultExpectation = &AccessRepositoryMockCreateRouteRuleExpectation{mock: mmCreateRouteRule.mock}
+	}
+	mmCreateRouteRule.defaultExpectation.results = &AccessRepositoryMockCreateRouteRuleResults{i1, err}
+	return mmCreateRouteRule.mock
+}
+
+// Set uses given function f to mock the AccessRepository.CreateRouteRule method
+func (mmCreateRouteRule *mAccessRepositoryMockCreateRouteRule) Set(f func(ctx context.Context, rule *model.RouteRule) (i1 int64, err error)) *AccessRepositoryMock {
+	if mmCreateRouteRule.defaultExpectation != nil {
+		mmCreateRouteRule.mock.t.Fatalf("Default expectation is already set for the AccessRepository.CreateRouteRule method")
+	}
+
+	if len(mmCreateRouteRule.expectations) > 0 {
+		mmCreateRouteRule.mock.t.Fatalf("Some expectations are already set for the AccessRepository.CreateRouteRule method")
+	}
+
+	mmCreateRouteRule.mock.funcCreateRouteRule = f
+	return mmCreateRouteRule.mock
+}
+
+// When sets expectation for the AccessRepository.CreateRouteRule which will trigger the result defined by the following
+// Then helper
+func (mmCreateRouteRule *mAccessRepositoryMockCreateRouteRule) When(ctx context.Context, rule *model.RouteRule) *AccessRepositoryMockCreateRouteRuleExpectation {
+	if mmCreateRouteRule.mock.funcCreateRouteRule != nil {
+		mmCreateRouteRule.mock.t.Fatalf("AccessRepositoryMock.CreateRouteRule mock is already set by Set")
+	}
+
+	expectation := &AccessRepositoryMockCreateRouteRuleExpectation{
+		mock:   mmCreateRouteRule.mock,
+		params: &AccessRepositoryMockCreateRouteRuleParams{ctx, rule},
+	}
+	mmCreateRouteRule.expectations = append(mmCreateRouteRule.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessRepository.CreateRouteRule return parameters for the expectation previously defined by the When method
+func (e *AccessRepositoryMockCreateRouteRuleExpectation) Then(i1 int64, err error) *AccessRepositoryMock {
+	e.results = &AccessRepositoryMockCreateRouteRuleResults{i1, err}
+	return e.mock
+}
+
+// CreateRouteRule implements repository.AccessRepository
+func (mmCreateRouteRule *AccessRepositoryMock) CreateRouteRule(ctx context.Context, rule *model.RouteRule) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreateRouteRule.beforeCreateRouteRuleCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateRouteRule.afterCreateRouteRuleCounter, 1)
+
+	if mmCreateRouteRule.inspectFuncCreateRouteRule != nil {
+		mmCreateRouteRule.inspectFuncCreateRouteRule(ctx, rule)
+	}
+
+	mm_params := AccessRepositoryMockCreateRouteRuleParams{ctx, rule}
+
+	// Record call args
+	mmCreateRouteRule.CreateRouteRuleMock.mutex.Lock()
+	mmCreateRouteRule.CreateRouteRuleMock.callArgs = append(mmCreateRouteRule.CreateRouteRuleMock.callArgs, &mm_params)
+	mmCreateRouteRule.CreateRouteRuleMock.mutex.Unlock()
+
+	for _, e := range mmCreateRouteRule.CreateRouteRuleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreateRouteRule.CreateRouteRuleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateRouteRule.CreateRouteRuleMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateRouteRule.CreateRouteRuleMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateRouteRule.CreateRouteRuleMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessRepositoryMockCreateRouteRuleParams{ctx, rule}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateRouteRule.t.Errorf("AccessRepositoryMock.CreateRouteRule got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.rule != nil && !minimock.Equal(*mm_want_ptrs.rule, mm_got.rule) {
+				mmCreateRouteRule.t.Errorf("AccessRepositoryMock.CreateRouteRule got unexpected parameter rule, want: %#v, got: %#v%s\n", *mm_want_ptrs.rule, mm_got.rule, minimock.Diff(*mm_want_ptrs.rule, mm_got.rule))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateRouteRule.t.Errorf("AccessRepositoryMock.CreateRouteRule got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateRouteRule.CreateRouteRuleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateRouteRule.t.Fatal("No results are set for the AccessRepositoryMock.CreateRouteRule")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreateRouteRule.funcCreateRouteRule != nil {
+		return mmCreateRouteRule.funcCreateRouteRule(ctx, rule)
+	}
+	mmCreateRouteRule.t.Fatalf("Unexpected call to AccessRepositoryMock.CreateRouteRule. %v %v", ctx, rule)
+	return
+}
+
+// CreateRouteRuleAfterCounter returns a count of finished AccessRepositoryMock.CreateRouteRule invocations
+func (mmCreateRouteRule *AccessRepositoryMock) CreateRouteRuleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRouteRule.afterCreateRouteRuleCounter)
+}
+
+// CreateRouteRuleBeforeCounter returns a count of AccessRepositoryMock.CreateRouteRule invocations
+func (mmCreateRouteRule *AccessRepositoryMock) CreateRouteRuleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRouteRule.beforeCreateRouteRuleCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessRepositoryMock.CreateRouteRule.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateRouteRule *mAccessRepositoryMockCreateRouteRule) Calls() []*AccessRepositoryMockCreateRouteRuleParams {
+	mmCreateRouteRule.mutex.RLock()
+
+	argCopy := make([]*AccessRepositoryMockCreateRouteRuleParams, len(mmCreateRouteRule.callArgs))
+	copy(argCopy, mmCreateRouteRule.callArgs)
+
+	mmCreateRouteRule.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateRouteRuleDone returns true if the count of the CreateRouteRule invocations corresponds
+// the number of defined expectations
+func (m *AccessRepositoryMock) MinimockCreateRouteRuleDone() bool {
+	for _, e := range m.CreateRouteRuleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateRouteRuleMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateRouteRuleCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateRouteRule != nil && mm_atomic.LoadUint64(&m.afterCreateRouteRuleCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCreateRouteRuleInspect logs each unmet expectation
+func (m *AccessRepositoryMock) MinimockCreateRouteRuleInspect() {
+	for _, e := range m.CreateRouteRuleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessRepositoryMock.CreateRouteRule with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateRouteRuleMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateRouteRuleCounter) < 1 {
+		if m.CreateRouteRuleMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessRepositoryMock.CreateRouteRule")
+		} else {
+			m.t.Errorf("Expected call to AccessRepositoryMock.CreateRouteRule with params: %#v", *m.CreateRouteRuleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateRouteRule != nil && mm_atomic.LoadUint64(&m.afterCreateRouteRuleCounter) < 1 {
+		m.t.Error("Expected call to AccessRepositoryMock.CreateRouteRule")
+	}
+}
+
+type mAccessRepositoryMockDeleteRouteRule struct {
+	mock               *AccessRepositoryMock
+	defaultExpectation *AccessRepositoryMockDeleteRouteRuleExpectation
+	expectations       []*AccessRepositoryMockDeleteRouteRuleExpectation
+
+	callArgs []*AccessRepositoryMockDeleteRouteRuleParams
+	mutex    sync.RWMutex
+}
+
+// AccessRepositoryMockDeleteRouteRuleExpectation specifies expectation struct of the AccessRepository.DeleteRouteRule
+type AccessRepositoryMockDeleteRouteRuleExpectation struct {
+	mock      *AccessRepositoryMock
+	params    *AccessRepositoryMockDeleteRouteRuleParams
+	paramPtrs *AccessRepositoryMockDeleteRouteRuleParamPtrs
+	results   *AccessRepositoryMockDeleteRouteRuleResults
+	Counter   uint64
+}
+
+// AccessRepositoryMockDeleteRouteRuleParams contains parameters of the AccessRepository.DeleteRouteRule
+type AccessRepositoryMockDeleteRouteRuleParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// AccessRepositoryMockDeleteRouteRuleParamPtrs contains pointers to parameters of the AccessRepository.DeleteRouteRule
+type AccessRepositoryMockDeleteRouteRuleParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// AccessRepositoryMockDeleteRouteRuleResults contains results of the AccessRepository.DeleteRouteRule
+type AccessRepositoryMockDeleteRouteRuleResults struct {
+	err error
+}
+
+// Expect sets up expected params for AccessRepository.DeleteRouteRule
+func (mmDeleteRouteRule *mAccessRepositoryMockDeleteRouteRule) Expect(ctx context.Context, id int64) *mAccessRepositoryMockDeleteRouteRule {
+	if mmDeleteRouteRule.mock.funcDeleteRouteRule != nil {
+		mmDeleteRouteRule.mock.t.Fatalf("AccessRepositoryMock.DeleteRouteRule mock is already set by Set")
+	}
+
+	if mmDeleteRouteRule.defaultExpectation == nil {
+		mmDeleteRouteRule.defaultExpectation = &AccessRepositoryMockDeleteRouteRuleExpectation{}
+	}
+
+	if mmDeleteRouteRule.defaultExpectation.paramPtrs != nil {
+		mmDeleteRouteRule.mock.t.Fatalf("AccessRepositoryMock.DeleteRouteRule mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteRouteRule.defaultExpectation.params = &AccessRepositoryMockDeleteRouteRuleParams{ctx, id}
+	for _, e := range mmDeleteRouteRule.expectations {
+		if minimock.Equal(e.params, mmDeleteRouteRule.defaultExpectation.params) {
+			mmDeleteRouteRule.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteRouteRule.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteRouteRule
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessRepository.DeleteRouteRule
+func (mmDeleteRouteRule *mAccessRepositoryMockDeleteRouteRule) ExpectCtxParam1(ctx context.Context) *mAccessRepositoryMockDeleteRouteRule {
+	if mmDeleteRouteRule.mock.funcDeleteRouteRule != nil {
+		mmDeleteRouteRule.mock.t.Fatalf("AccessRepositoryMock.DeleteRouteRule mock is already set by Set")
+	}
+
+	if mmDeleteRouteRule.defaultExpectation == nil {
+		mmDeleteRouteRule.defaultExpectation = &AccessRepositoryMockDeleteRouteRuleExpectation{}
+	}
+
+	if mmDeleteRouteRule.defaultExpectation.params != nil {
+		mmDeleteRouteRule.mock.t.Fatalf("AccessRepositoryMock.DeleteRouteRule mock is already set by Expect")
+	}
+
+	if mmDeleteRouteRule.defaultExpectation.paramPtrs == nil {
+		mmDeleteRouteRule.defaultExpectation.paramPtrs = &AccessRepositoryMockDeleteRouteRuleParamPtrs{}
+	}
+	mmDeleteRouteRule.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeleteRouteRule
+}
+
+// ExpectIdParam2 sets up expected param id for AccessRepository.DeleteRouteRule
+func (mmDeleteRouteRule *mAccessRepositoryMockDeleteRouteRule) ExpectIdParam2(id int64) *mAccessRepositoryMockDeleteRouteRule {
+	if mmDeleteRouteRule.mock.funcDeleteRouteRule != nil {
+		mmDeleteRouteRule.mock.t.Fatalf("AccessRepositoryMock.DeleteRouteRule mock is already set by Set")
+	}
+
+	if mmDeleteRouteRule.defaultExpectation == nil {
+		mmDeleteRouteRule.defaultExpectation = &AccessRepositoryMockDeleteRouteRuleExpectation{}
+	}
+
+	if mmDeleteRouteRule.defaultExpectation.params != nil {
+		mmDeleteRouteRule.mock.t.Fatalf("AccessRepositoryMock.DeleteRouteRule mock is already set by Expect")
+	}
+
+	if mmDeleteRouteRule.defaultExpectation.paramPtrs == nil {
+		mmDeleteRouteRule.defaultExpectation.paramPtrs = &AccessRepositoryMockDeleteRouteRuleParamPtrs{}
+	}
+	mmDeleteRouteRule.defaultExpectation.paramPtrs.id = &id
+
+	return mmDeleteRouteRule
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessRepository.DeleteRouteRule
+func (mmDeleteRouteRule *mAccessRepositoryMockDeleteRouteRule) Inspect(f func(ctx context.Context, id int64)) *mAccessRepositoryMockDeleteRouteRule {
+	if mmDeleteRouteRule.mock.inspectFuncDeleteRouteRule != nil {
+		mmDeleteRouteRule.mock.t.Fatalf("Inspect function is already set for AccessRepositoryMock.DeleteRouteRule")
+	}
+
+	mmDeleteRouteRule.mock.inspectFuncDeleteRouteRule = f
+
+	return mmDeleteRouteRule
+}
+
+// Return sets up results that will be returned by AccessRepository.DeleteRouteRule
+func (mmDeleteRouteRule *mAccessRepositoryMockDeleteRouteRule) Return(err error) *AccessRepositoryMock {
+	if mmDeleteRouteRule.mock.funcDeleteRouteRule != nil {
+		mmDeleteRouteRule.mock.t.Fatalf("AccessRepositoryMock.DeleteRouteRule mock is already set by Set")
+	}
+
+	if mmDeleteRouteRule.defaultExpectation == nil {
+		mmDeleteRouteRule.defaultExpectation = &AccessRepositoryMockDeleteRouteRuleExpectation{mock: mmDeleteRouteRule.mock}
+	}
+	mmDeleteRouteRule.defaultExpectation.results = &AccessRepositoryMockDeleteRouteRuleResults{err}
+	return mmDeleteRouteRule.mock
+}
+
+// Set uses given function f to mock the AccessRepository.DeleteRouteRule method
+func (mmDeleteRouteRule *mAccessRepositoryMockDeleteRouteRule) Set(f func(ctx context.Context, id int64) (err error)) *AccessRepositoryMock {
+	if mmDeleteRouteRule.defaultExpectation != nil {
+		mmDeleteRouteRule.mock.t.Fatalf("Default expectation is already set for the AccessRepository.DeleteRouteRule method")
+	}
+
+	if len(mmDeleteRouteRule.expectations) > 0 {
+		mmDeleteRouteRule.mock.t.Fatalf("Some expectations are already set for the AccessRepository.DeleteRouteRule method")
+	}
+
+	mmDeleteRouteRule.mock.funcDeleteRouteRule = f
+	return mmDeleteRouteRule.mock
+}
+
+// When sets expectation for the AccessRepository.DeleteRouteRule which will trigger the result defined by the following
+// Then helper
+func (mmDeleteRouteRule *mAccessRepositoryMockDeleteRouteRule) When(ctx context.Context, id int64) *AccessRepositoryMockDeleteRouteRuleExpectation {
+	if mmDeleteRouteRule.mock.funcDeleteRouteRule != nil {
+		mmDeleteRouteRule.mock.t.Fatalf("AccessRepositoryMock.DeleteRouteRule mock is already set by Set")
+	}
+
+	expectation := &AccessRepositoryMockDeleteRouteRuleExpectation{
+		mock:   mmDeleteRouteRule.mock,
+		params: &AccessRepositoryMockDeleteRouteRuleParams{ctx, id},
+	}
+	mmDeleteRouteRule.expectations = append(mmDeleteRouteRule.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessRepository.DeleteRouteRule return parameters for the expectation previously defined by the When method
+func (e *AccessRepositoryMockDeleteRouteRuleExpectation) Then(err error) *AccessRepositoryMock {
+	e.results = &AccessRepositoryMockDeleteRouteRuleResults{err}
+	return e.mock
+}
+
+// DeleteRouteRule implements repository.AccessRepository
+func (mmDeleteRouteRule *AccessRepositoryMock) DeleteRouteRule(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteRouteRule.beforeDeleteRouteRuleCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteRouteRule.afterDeleteRouteRuleCounter, 1)
+
+	if mmDeleteRouteRule.inspectFuncDeleteRouteRule != nil {
+		mmDeleteRouteRule.inspectFuncDeleteRouteRule(ctx, id)
+	}
+
+	mm_params := AccessRepositoryMockDeleteRouteRuleParams{ctx, id}
+
+	// Record call args
+	mmDeleteRouteRule.DeleteRouteRuleMock.mutex.Lock()
+	mmDeleteRouteRule.DeleteRouteRuleMock.callArgs = append(mmDeleteRouteRule.DeleteRouteRuleMock.callArgs, &mm_params)
+	mmDeleteRouteRule.DeleteRouteRuleMock.mutex.Unlock()
+
+	for _, e := range mmDeleteRouteRule.DeleteRouteRuleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteRouteRule.DeleteRouteRuleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteRouteRule.DeleteRouteRuleMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteRouteRule.DeleteRouteRuleMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteRouteRule.DeleteRouteRuleMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessRepositoryMockDeleteRouteRuleParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteRouteRule.t.Errorf("AccessRepositoryMock.DeleteRouteRule got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteRouteRule.t.Errorf("AccessRepositoryMock.DeleteRouteRule got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteRouteRule.t.Errorf("AccessRepositoryMock.DeleteRouteRule got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteRouteRule.DeleteRouteRuleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteRouteRule.t.Fatal("No results are set for the AccessRepositoryMock.DeleteRouteRule")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteRouteRule.funcDeleteRouteRule != nil {
+		return mmDeleteRouteRule.funcDeleteRouteRule(ctx, id)
+	}
+	mmDeleteRouteRule.t.Fatalf("Unexpected call to AccessRepositoryMock.DeleteRouteRule. %v %v", ctx, id)
+	return
+}
+
+// DeleteRouteRuleAfterCounter returns a count of finished AccessRepositoryMock.DeleteRouteRule invocations
+func (mmDeleteRouteRule *AccessRepositoryMock) DeleteRouteRuleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRouteRule.afterDeleteRouteRuleCounter)
+}
+
+// DeleteRouteRuleBeforeCounter returns a count of AccessRepositoryMock.DeleteRouteRule invocations
+func (mmDeleteRouteRule *AccessRepositoryMock) DeleteRouteRuleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRouteRule.beforeDeleteRouteRuleCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessRepositoryMock.DeleteRouteRule.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteRouteRule *mAccessRepositoryMockDeleteRouteRule) Calls() []*AccessRepositoryMockDeleteRouteRuleParams {
+	mmDeleteRouteRule.mutex.RLock()
+
+	argCopy := make([]*AccessRepositoryMockDeleteRouteRuleParams, len(mmDeleteRouteRule.callArgs))
+	copy(argCopy, mmDeleteRouteRule.callArgs)
+
+	mmDeleteRouteRule.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteRouteRuleDone returns true if the count of the DeleteRouteRule invocations corresponds
+// the number of defined expectations
+func (m *AccessRepositoryMock) MinimockDeleteRouteRuleDone() bool {
+	for _, e := range m.DeleteRouteRuleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteRouteRuleMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteRouteRuleCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteRouteRule != nil && mm_atomic.LoadUint64(&m.afterDeleteRouteRuleCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockDeleteRouteRuleInspect logs each unmet expectation
+func (m *AccessRepositoryMock) MinimockDeleteRouteRuleInspect() {
+	for _, e := range m.DeleteRouteRuleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessRepositoryMock.DeleteRouteRule with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteRouteRuleMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteRouteRuleCounter) < 1 {
+		if m.DeleteRouteRuleMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessRepositoryMock.DeleteRouteRule")
+		} else {
+			m.t.Errorf("Expected call to AccessRepositoryMock.DeleteRouteRule with params: %#v", *m.DeleteRouteRuleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteRouteRule != nil && mm_atomic.LoadUint64(&m.afterDeleteRouteRuleCounter) < 1 {
+		m.t.Error("Expected call to AccessRepositoryMock.DeleteRouteRule")
+	}
+}
+
+type mAccessRepositoryMockFindRouteRules struct {
+	mock               *AccessRepositoryMock
+	defaultExpectation *AccessRepositoryMockFindRouteRulesExpectation
+	expectations       []*AccessRepositoryMockFindRouteRulesExpectation
+
+	callArgs []*AccessRepositoryMockFindRouteRulesParams
+	mutex    sync.RWMutex
+}
+
+// AccessRepositoryMockFindRouteRulesExpectation specifies expectation struct of the AccessRepository.FindRouteRules
+type AccessRepositoryMockFindRouteRulesExpectation struct {
+	mock      *AccessRepositoryMock
+	params    *AccessRepositoryMockFindRouteRulesParams
+	paramPtrs *AccessRepositoryMockFindRouteRulesParamPtrs
+	results   *AccessRepositoryMockFindRouteRulesResults
+	Counter   uint64
+}
+
+// AccessRepositoryMockFindRouteRulesParams contains parameters of the AccessRepository.FindRouteRules
+type AccessRepositoryMockFindRouteRulesParams struct {
+	ctx    context.Context
+	filter model.RouteRuleFilter
+}
+
+// AccessRepositoryMockFindRouteRulesParamPtrs contains pointers to parameters of the AccessRepository.FindRouteRules
+type AccessRepositoryMockFindRouteRulesParamPtrs struct {
+	ctx    *context.Context
+	filter *model.RouteRuleFilter
+}
+
+// AccessRepositoryMockFindRouteRulesResults contains results of the AccessRepository.FindRouteRules
+type AccessRepositoryMockFindRouteRulesResults struct {
+	ra1 []model.RouteRule
+	err error
+}
+
+// Expect sets up expected params for AccessRepository.FindRouteRules
+func (mmFindRouteRules *mAccessRepositoryMockFindRouteRules) Expect(ctx context.Context, filter model.RouteRuleFilter) *mAccessRepositoryMockFindRouteRules {
+	if mmFindRouteRules.mock.funcFindRouteRules != nil {
+		mmFindRouteRules.mock.t.Fatalf("AccessRepositoryMock.FindRouteRules mock is already set by Set")
+	}
+
+	if mmFindRouteRules.defaultExpectation == nil {
+		mmFindRouteRules.defaultExpectation = &AccessRepositoryMockFindRouteRulesExpectation{}
+	}
+
+	if mmFindRouteRules.defaultExpectation.paramPtrs != nil {
+		mmFindRouteRules.mock.t.Fatalf("AccessRepositoryMock.FindRouteRules mock is already set by ExpectParams functions")
+	}
+
+	mmFindRouteRules.defaultExpectation.params = &AccessRepositoryMockFindRouteRulesParams{ctx, filter}
+	for _, e := range mmFindRouteRules.expectations {
+		if minimock.Equal(e.params, mmFindRouteRules.defaultExpectation.params) {
+			mmFindRouteRules.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindRouteRules.defaultExpectation.params)
+		}
+	}
+
+	return mmFindRouteRules
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessRepository.FindRouteRules
+func (mmFindRouteRules *mAccessRepositoryMockFindRouteRules) ExpectCtxParam1(ctx context.Context) *mAccessRepositoryMockFindRouteRules {
+	if mmFindRouteRules.mock.funcFindRouteRules != nil {
+		mmFindRouteRules.mock.t.Fatalf("AccessRepositoryMock.FindRouteRules mock is already set by Set")
+	}
+
+	if mmFindRouteRules.defaultExpectation == nil {
+		mmFindRouteRules.defaultExpectation = &AccessRepositoryMockFindRouteRulesExpectation{}
+	}
+
+	if mmFindRouteRules.defaultExpectation.params != nil {
+		mmFindRouteRules.mock.t.Fatalf("AccessRepositoryMock.FindRouteRules mock is already set by Expect")
+	}
+
+	if mmFindRouteRules.defaultExpectation.paramPtrs == nil {
+		mmFindRouteRules.defaultExpectation.paramPtrs = &AccessRepositoryMockFindRouteRulesParamPtrs{}
+	}
+	mmFindRouteRules.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmFindRouteRules
+}
+
+// ExpectFilterParam2 sets up expected param filter for AccessRepository.FindRouteRules
+func (mmFindRouteRules *mAccessRepositoryMockFindRouteRules) ExpectFilterParam2(filter model.RouteRuleFilter) *mAccessRepositoryMockFindRouteRules {
+	if mmFindRouteRules.mock.funcFindRouteRules != nil {
+		mmFindRouteRules.mock.t.Fatalf("AccessRepositoryMock.FindRouteRules mock is already set by Set")
+	}
+
+	if mmFindRouteRules.defaultExpectation == nil {
+		mmFindRouteRules.defaultExpectation = &AccessRepositoryMockFindRouteRulesExpectation{}
+	}
+
+	if mmFindRouteRules.defaultExpectation.params != nil {
+		mmFindRouteRules.mock.t.Fatalf("AccessRepositoryMock.FindRouteRules mock is already set by Expect")
+	}
+
+	if mmFindRouteRules.defaultExpectation.paramPtrs == nil {
+		mmFindRouteRules.defaultExpectation.paramPtrs = &AccessRepositoryMockFindRouteRulesParamPtrs{}
+	}
+	mmFindRouteRules.defaultExpectation.paramPtrs.filter = &filter
+
+	return mmFindRouteRules
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessRepository.FindRouteRules
+func (mmFindRouteRules *mAccessRepositoryMockFindRouteRules) Inspect(f func(ctx context.Context, filter model.RouteRuleFilter)) *mAccessRepositoryMockFindRouteRules {
+	if mmFindRouteRules.mock.inspectFuncFindRouteRules != nil {
+		mmFindRouteRules.mock.t.Fatalf("Inspect function is already set for AccessRepositoryMock.FindRouteRules")
+	}
+
+	mmFindRouteRules.mock.inspectFuncFindRouteRules = f
+
+	return mmFindRouteRules
+}
+
+// Return sets up results that will be returned by AccessRepository.FindRouteRules
+func (mmFindRouteRules *mAccessRepositoryMockFindRouteRules) Return(ra1 []model.RouteRule, err error) *AccessRepositoryMock {
+	if mmFindRouteRules.mock.funcFindRouteRules != nil {
+		mmFindRouteRules.mock.t.Fatalf("AccessRepositoryMock.FindRouteRules mock is already set by Set")
+	}
+
+	if mmFindRouteRules.defaultExpectation == nil {
+		mmFindRouteRules.defaultExpectation = &AccessRepositoryMockFindRouteRulesExpectation{mock: mmFindRouteRules.mock}
+	}
+	mmFindRouteRules.defaultExpectation.results = &AccessRepositoryMockFindRouteRulesResults{ra1, err}
+	return mmFindRouteRules.mock
+}
+
+// Set uses given function f to mock the AccessRepository.FindRouteRules method
+func (mmFindRouteRules *mAccessRepositoryMockFindRouteRules) Set(f func(ctx context.Context, filter model.RouteRuleFilter) (ra1 []model.RouteRule, err error)) *AccessRepositoryMock {
+	if mmFindRouteRules.defaultExpectation != nil {
+		mmFindRouteRules.mock.t.Fatalf("Default expectation is already set for the AccessRepository.FindRouteRules method")
+	}
+
+	if len(mmFindRouteRules.expectations) > 0 {
+		mmFindRouteRules.mock.t.Fatalf("Some expectations are already set for the AccessRepository.FindRouteRules method")
+	}
+
+	mmFindRouteRules.mock.funcFindRouteRules = f
+	return mmFindRouteRules.mock
+}
+
+// When sets expectation for the AccessRepository.FindRouteRules which will trigger the result defined by the following
+// Then helper
+func (mmFindRouteRules *mAccessRepositoryMockFindRouteRules) When(ctx context.Context, filter model.RouteRuleFilter) *AccessRepositoryMockFindRouteRulesExpectation {
+	if mmFindRouteRules.mock.funcFindRouteRules != nil {
+		mmFindRouteRules.mock.t.Fatalf("AccessRepositoryMock.FindRouteRules mock is already set by Set")
+	}
+
+	expectation := &AccessRepositoryMockFindRouteRulesExpectation{
+		mock:   mmFindRouteRules.mock,
+		params: &AccessRepositoryMockFindRouteRulesParams{ctx, filter},
+	}
+	mmFindRouteRules.expectations = append(mmFindRouteRules.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessRepository.FindRouteRules return parameters for the expectation previously defined by the When method
+func (e *AccessRepositoryMockFindRouteRulesExpectation) Then(ra1 []model.RouteRule, err error) *AccessRepositoryMock {
+	e.results = &AccessRepositoryMockFindRouteRulesResults{ra1, err}
+	return e.mock
+}
+
+// FindRouteRules implements repository.AccessRepository
+func (mmFindRouteRules *AccessRepositoryMock) FindRouteRules(ctx context.Context, filter model.RouteRuleFilter) (ra1 []model.RouteRule, err error) {
+	mm_atomic.AddUint64(&mmFindRouteRules.beforeFindRouteRulesCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindRouteRules.afterFindRouteRulesCounter, 1)
+
+	if mmFindRouteRules.inspectFuncFindRouteRules != nil {
+		mmFindRouteRules.inspectFuncFindRouteRules(ctx, filter)
+	}
+
+	mm_params := AccessRepositoryMockFindRouteRulesParams{ctx, filter}
+
+	// Record call args
+	mmFindRouteRules.FindRouteRulesMock.mutex.Lock()
+	mmFindRouteRules.FindRouteRulesMock.callArgs = append(mmFindRouteRules.FindRouteRulesMock.callArgs, &mm_params)
+	mmFindRouteRules.FindRouteRulesMock.mutex.Unlock()
+
+	for _, e := range mmFindRouteRules.FindRouteRulesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ra1, e.results.err
+		}
+	}
+
+	if mmFindRouteRules.FindRouteRulesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindRouteRules.FindRouteRulesMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindRouteRules.FindRouteRulesMock.defaultExpectation.params
+		mm_want_ptrs := mmFindRouteRules.FindRouteRulesMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessRepositoryMockFindRouteRulesParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindRouteRules.t.Errorf("AccessRepositoryMock.FindRouteRules got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmFindRouteRules.t.Errorf("AccessRepositoryMock.FindRouteRules got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindRouteRules.t.Errorf("AccessRepositoryMock.FindRouteRules got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindRouteRules.FindRouteRulesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindRouteRules.t.Fatal("No results are set for the AccessRepositoryMock.FindRouteRules")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmFindRouteRules.funcFindRouteRules != nil {
+		return mmFindRouteRules.funcFindRouteRules(ctx, filter)
+	}
+	mmFindRouteRules.t.Fatalf("Unexpected call to AccessRepositoryMock.FindRouteRules. %v %v", ctx, filter)
+	return
+}
+
+// FindRouteRulesAfterCounter returns a count of finished AccessRepositoryMock.FindRouteRules invocations
+func (mmFindRouteRules *AccessRepositoryMock) FindRouteRulesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindRouteRules.afterFindRouteRulesCounter)
+}
+
+// FindRouteRulesBeforeCounter returns a count of AccessRepositoryMock.FindRouteRules invocations
+func (mmFindRouteRules *AccessRepositoryMock) FindRouteRulesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindRouteRules.beforeFindRouteRulesCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessRepositoryMock.FindRouteRules.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindRouteRules *mAccessRepositoryMockFindRouteRules) Calls() []*AccessRepositoryMockFindRouteRulesParams {
+	mmFindRouteRules.mutex.RLock()
+
+	argCopy := make([]*AccessRepositoryMockFindRouteRulesParams, len(mmFindRouteRules.callArgs))
+	copy(argCopy, mmFindRouteRules.callArgs)
+
+	mmFindRouteRules.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindRouteRulesDone returns true if the count of the FindRouteRules invocations corresponds
+// the number of defined expectations
+func (m *AccessRepositoryMock) MinimockFindRouteRulesDone() bool {
+	for _, e := range m.FindRouteRulesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindRouteRulesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterFindRouteRulesCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindRouteRules != nil && mm_atomic.LoadUint64(&m.afterFindRouteRulesCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockFindRouteRulesInspect logs each unmet expectation
+func (m *AccessRepositoryMock) MinimockFindRouteRulesInspect() {
+	for _, e := range m.FindRouteRulesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessRepositoryMock.FindRouteRules with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindRouteRulesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterFindRouteRulesCounter) < 1 {
+		if m.FindRouteRulesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessRepositoryMock.FindRouteRules")
+		} else {
+			m.t.Errorf("Expected call to AccessRepositoryMock.FindRouteRules with params: %#v", *m.FindRouteRulesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindRouteRules != nil && mm_atomic.LoadUint64(&m.afterFindRouteRulesCounter) < 1 {
+		m.t.Error("Expected call to AccessRepositoryMock.FindRouteRules")
+	}
+}
+
+type mAccessRepositoryMockGetPolicyVersion struct {
+	mock               *AccessRepositoryMock
+	defaultExpectation *AccessRepositoryMockGetPolicyVersionExpectation
+	expectations       []*AccessRepositoryMockGetPolicyVersionExpectation
+
+	callArgs []*AccessRepositoryMockGetPolicyVersionParams
+	mutex    sync.RWMutex
+}
+
+// AccessRepositoryMockGetPolicyVersionExpectation specifies expectation struct of the AccessRepository.GetPolicyVersion
+type AccessRepositoryMockGetPolicyVersionExpectation struct {
+	mock      *AccessRepositoryMock
+	params    *AccessRepositoryMockGetPolicyVersionParams
+	paramPtrs *AccessRepositoryMockGetPolicyVersionParamPtrs
+	results   *AccessRepositoryMockGetPolicyVersionResults
+	Counter   uint64
+}
+
+// AccessRepositoryMockGetPolicyVersionParams contains parameters of the AccessRepository.GetPolicyVersion
+type AccessRepositoryMockGetPolicyVersionParams struct {
+	ctx context.Context
+}
+
+// AccessRepositoryMockGetPolicyVersionParamPtrs contains pointers to parameters of the AccessRepository.GetPolicyVersion
+type AccessRepositoryMockGetPolicyVersionParamPtrs struct {
+	ctx *context.Context
+}
+
+// AccessRepositoryMockGetPolicyVersionResults contains results of the AccessRepository.GetPolicyVersion
+type AccessRepositoryMockGetPolicyVersionResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for AccessRepository.GetPolicyVersion
+func (mmGetPolicyVersion *mAccessRepositoryMockGetPolicyVersion) Expect(ctx context.Context) *mAccessRepositoryMockGetPolicyVersion {
+	if mmGetPolicyVersion.mock.funcGetPolicyVersion != nil {
+		mmGetPolicyVersion.mock.t.Fatalf("AccessRepositoryMock.GetPolicyVersion mock is already set by Set")
+	}
+
+	if mmGetPolicyVersion.defaultExpectation == nil {
+		mmGetPolicyVersion.defaultExpectation = &AccessRepositoryMockGetPolicyVersionExpectation{}
+	}
+
+	if mmGetPolicyVersion.defaultExpectation.paramPtrs != nil {
+		mmGetPolicyVersion.mock.t.Fatalf("AccessRepositoryMock.GetPolicyVersion mock is already set by ExpectParams functions")
+	}
+
+	mmGetPolicyVersion.defaultExpectation.params = &AccessRepositoryMockGetPolicyVersionParams{ctx}
+	for _, e := range mmGetPolicyVersion.expectations {
+		if minimock.Equal(e.params, mmGetPolicyVersion.defaultExpectation.params) {
+			mmGetPolicyVersion.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPolicyVersion.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPolicyVersion
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessRepository.GetPolicyVersion
+func (mmGetPolicyVersion *mAccessRepositoryMockGetPolicyVersion) ExpectCtxParam1(ctx context.Context) *mAccessRepositoryMockGetPolicyVersion {
+	if mmGetPolicyVersion.mock.funcGetPolicyVersion != nil {
+		mmGetPolicyVersion.mock.t.Fatalf("AccessRepositoryMock.GetPolicyVersion mock is already set by Set")
+	}
+
+	if mmGetPolicyVersion.defaultExpectation == nil {
+		mmGetPolicyVersion.defaultExpectation = &AccessRepositoryMockGetPolicyVersionExpectation{}
+	}
+
+	if mmGetPolicyVersion.defaultExpectation.params != nil {
+		mmGetPolicyVersion.mock.t.Fatalf("AccessRepositoryMock.GetPolicyVersion mock is already set by Expect")
+	}
+
+	if mmGetPolicyVersion.defaultExpectation.paramPtrs == nil {
+		mmGetPolicyVersion.defaultExpectation.paramPtrs = &AccessRepositoryMockGetPolicyVersionParamPtrs{}
+	}
+	mmGetPolicyVersion.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetPolicyVersion
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessRepository.GetPolicyVersion
+func (mmGetPolicyVersion *mAccessRepositoryMockGetPolicyVersion) Inspect(f func(ctx context.Context)) *mAccessRepositoryMockGetPolicyVersion {
+	if mmGetPolicyVersion.mock.inspectFuncGetPolicyVersion != nil {
+		mmGetPolicyVersion.mock.t.Fatalf("Inspect function is already set for AccessRepositoryMock.GetPolicyVersion")
+	}
+
+	mmGetPolicyVersion.mock.inspectFuncGetPolicyVersion = f
+
+	return mmGetPolicyVersion
+}
+
+// Return sets up results that will be returned by AccessRepository.GetPolicyVersion
+func (mmGetPolicyVersion *mAccessRepositoryMockGetPolicyVersion) Return(i1 int64, err error) *AccessRepositoryMock {
+	if mmGetPolicyVersion.mock.funcGetPolicyVersion != nil {
+		mmGetPolicyVersion.mock.t.Fatalf("AccessRepositoryMock.GetPolicyVersion mock is already set by Set")
+	}
+
+	if mmGetPolicyVersion.defaultExpectation == nil {
+		mmGetPolicyVersion.defaultExpectation = &AccessRepositoryMockGetPolicyVersionExpectation{mock: mmGetPolicyVersion.mock}
+	}
+	mmGetPolicyVersion.defaultExpectation.results = &AccessRepositoryMockGetPolicyVersionResults{i1, err}
+	return mmGetPolicyVersion.mock
+}
+
+// Set uses given function f to mock the AccessRepository.GetPolicyVersion method
+func (mmGetPolicyVersion *mAccessRepositoryMockGetPolicyVersion) Set(f func(ctx context.Context) (i1 int64, err error)) *AccessRepositoryMock {
+	if mmGetPolicyVersion.defaultExpectation != nil {
+		mmGetPolicyVersion.mock.t.Fatalf("Default expectation is already set for the AccessRepository.GetPolicyVersion method")
+	}
+
+	if len(mmGetPolicyVersion.expectations) > 0 {
+		mmGetPolicyVersion.mock.t.Fatalf("Some expectations are already set for the AccessRepository.GetPolicyVersion method")
+	}
+
+	mmGetPolicyVersion.mock.funcGetPolicyVersion = f
+	return mmGetPolicyVersion.mock
+}
+
+// When sets expectation for the AccessRepository.GetPolicyVersion which will trigger the result defined by the following
+// Then helper
+func (mmGetPolicyVersion *mAccessRepositoryMockGetPolicyVersion) When(ctx context.Context) *AccessRepositoryMockGetPolicyVersionExpectation {
+	if mmGetPolicyVersion.mock.funcGetPolicyVersion != nil {
+		mmGetPolicyVersion.mock.t.Fatalf("AccessRepositoryMock.GetPolicyVersion mock is already set by Set")
+	}
+
+	expectation := &AccessRepositoryMockGetPolicyVersionExpectation{
+		mock:   mmGetPolicyVersion.mock,
+		params: &AccessRepositoryMockGetPolicyVersionParams{ctx},
+	}
+	mmGetPolicyVersion.expectations = append(mmGetPolicyVersion.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessRepository.GetPolicyVersion return parameters for the expectation previously defined by the When method
+func (e *AccessRepositoryMockGetPolicyVersionExpectation) Then(i1 int64, err error) *AccessRepositoryMock {
+	e.results = &AccessRepositoryMockGetPolicyVersionResults{i1, err}
+	return e.mock
+}
+
+// GetPolicyVersion implements repository.AccessRepository
+func (mmGetPolicyVersion *AccessRepositoryMock) GetPolicyVersion(ctx context.Context) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmGetPolicyVersion.beforeGetPolicyVersionCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPolicyVersion.afterGetPolicyVersionCounter, 1)
+
+	if mmGetPolicyVersion.inspectFuncGetPolicyVersion != nil {
+		mmGetPolicyVersion.inspectFuncGetPolicyVersion(ctx)
+	}
+
+	mm_params := AccessRepositoryMockGetPolicyVersionParams{ctx}
+
+	// Record call args
+	mmGetPolicyVersion.GetPolicyVersionMock.mutex.Lock()
+	mmGetPolicyVersion.GetPolicyVersionMock.callArgs = append(mmGetPolicyVersion.GetPolicyVersionMock.callArgs, &mm_params)
+	mmGetPolicyVersion.GetPolicyVersionMock.mutex.Unlock()
+
+	for _, e := range mmGetPolicyVersion.GetPolicyVersionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmGetPolicyVersion.GetPolicyVersionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPolicyVersion.GetPolicyVersionMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPolicyVersion.GetPolicyVersionMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPolicyVersion.GetPolicyVersionMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessRepositoryMockGetPolicyVersionParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPolicyVersion.t.Errorf("AccessRepositoryMock.GetPolicyVersion got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPolicyVersion.t.Errorf("AccessRepositoryMock.GetPolicyVersion got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPolicyVersion.GetPolicyVersionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPolicyVersion.t.Fatal("No results are set for the AccessRepositoryMock.GetPolicyVersion")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmGetPolicyVersion.funcGetPolicyVersion != nil {
+		return mmGetPolicyVersion.funcGetPolicyVersion(ctx)
+	}
+	mmGetPolicyVersion.t.Fatalf("Unexpected call to AccessRepositoryMock.GetPolicyVersion. %v", ctx)
+	return
+}
+
+// GetPolicyVersionAfterCounter returns a count of finished AccessRepositoryMock.GetPolicyVersion invocations
+func (mmGetPolicyVersion *AccessRepositoryMock) GetPolicyVersionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPolicyVersion.afterGetPolicyVersionCounter)
+}
+
+// GetPolicyVersionBeforeCounter returns a count of AccessRepositoryMock.GetPolicyVersion invocations
+func (mmGetPolicyVersion *AccessRepositoryMock) GetPolicyVersionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPolicyVersion.beforeGetPolicyVersionCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessRepositoryMock.GetPolicyVersion.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPolicyVersion *mAccessRepositoryMockGetPolicyVersion) Calls() []*AccessRepositoryMockGetPolicyVersionParams {
+	mmGetPolicyVersion.mutex.RLock()
+
+	argCopy := make([]*AccessRepositoryMockGetPolicyVersionParams, len(mmGetPolicyVersion.callArgs))
+	copy(argCopy, mmGetPolicyVersion.callArgs)
+
+	mmGetPolicyVersion.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPolicyVersionDone returns true if the count of the GetPolicyVersion invocations corresponds
+// the number of defined expectations
+func (m *AccessRepositoryMock) MinimockGetPolicyVersionDone() bool {
+	for _, e := range m.GetPolicyVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPolicyVersionMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetPolicyVersionCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPolicyVersion != nil && mm_atomic.LoadUint64(&m.afterGetPolicyVersionCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetPolicyVersionInspect logs each unmet expectation
+func (m *AccessRepositoryMock) MinimockGetPolicyVersionInspect() {
+	for _, e := range m.GetPolicyVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessRepositoryMock.GetPolicyVersion with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPolicyVersionMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetPolicyVersionCounter) < 1 {
+		if m.GetPolicyVersionMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessRepositoryMock.GetPolicyVersion")
+		} else {
+			m.t.Errorf("Expected call to AccessRepositoryMock.GetPolicyVersion with params: %#v", *m.GetPolicyVersionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPolicyVersion != nil && mm_atomic.LoadUint64(&m.afterGetPolicyVersionCounter) < 1 {
+		m.t.Error("Expected call to AccessRepositoryMock.GetPolicyVersion")
+	}
+}
+
+type mAccessRepositoryMockGetRouteRule struct {
+	mock               *AccessRepositoryMock
+	defaultExpectation *AccessRepositoryMockGetRouteRuleExpectation
+	expectations       []*AccessRepositoryMockGetRouteRuleExpectation
+
+	callArgs []*AccessRepositoryMockGetRouteRuleParams
+	mutex    sync.RWMutex
+}
+
+// AccessRepositoryMockGetRouteRuleExpectation specifies expectation struct of the AccessRepository.GetRouteRule
+type AccessRepositoryMockGetRouteRuleExpectation struct {
+	mock      *AccessRepositoryMock
+	params    *AccessRepositoryMockGetRouteRuleParams
+	paramPtrs *AccessRepositoryMockGetRouteRuleParamPtrs
+	results   *AccessRepositoryMockGetRouteRuleResults
+	Counter   uint64
+}
+
+// AccessRepositoryMockGetRouteRuleParams contains parameters of the AccessRepository.GetRouteRule
+type AccessRepositoryMockGetRouteRuleParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// AccessRepositoryMockGetRouteRuleParamPtrs contains pointers to parameters of the AccessRepository.GetRouteRule
+type AccessRepositoryMockGetRouteRuleParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// AccessRepositoryMockGetRouteRuleResults contains results of the AccessRepository.GetRouteRule
+type AccessRepositoryMockGetRouteRuleResults struct {
+	rp1 *model.RouteRule
+	err error
+}
+
+// Expect sets up expected params for AccessRepository.GetRouteRule
+func (mmGetRouteRule *mAccessRepositoryMockGetRouteRule) Expect(ctx context.Context, id int64) *mAccessRepositoryMockGetRouteRule {
+	if mmGetRouteRule.mock.funcGetRouteRule != nil {
+		mmGetRouteRule.mock.t.Fatalf("AccessRepositoryMock.GetRouteRule mock is already set by Set")
+	}
+
+	if mmGetRouteRule.defaultExpectation == nil {
+		mmGetRouteRule.defaultExpectation = &AccessRepositoryMockGetRouteRuleExpectation{}
+	}
+
+	if mmGetRouteRule.defaultExpectation.paramPtrs != nil {
+		mmGetRouteRule.mock.t.Fatalf("AccessRepositoryMock.GetRouteRule mock is already set by ExpectParams functions")
+	}
+
+	mmGetRouteRule.defaultExpectation.params = &AccessRepositoryMockGetRouteRuleParams{ctx, id}
+	for _, e := range mmGetRouteRule.expectations {
+		if minimock.Equal(e.params, mmGetRouteRule.defaultExpectation.params) {
+			mmGetRouteRule.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetRouteRule.defaultExpectation.params)
+		}
+	}
+
+	return mmGetRouteRule
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessRepository.GetRouteRule
+func (mmGetRouteRule *mAccessRepositoryMockGetRouteRule) ExpectCtxParam1(ctx context.Context) *mAccessRepositoryMockGetRouteRule {
+	if mmGetRouteRule.mock.funcGetRouteRule != nil {
+		mmGetRouteRule.mock.t.Fatalf("AccessRepositoryMock.GetRouteRule mock is already set by Set")
+	}
+
+	if mmGetRouteRule.defaultExpectation == nil {
+		mmGetRouteRule.defaultExpectation = &AccessRepositoryMockGetRouteRuleExpectation{}
+	}
+
+	if mmGetRouteRule.defaultExpectation.params != nil {
+		mmGetRouteRule.mock.t.Fatalf("AccessRepositoryMock.GetRouteRule mock is already set by Expect")
+	}
+
+	if mmGetRouteRule.defaultExpectation.paramPtrs == nil {
+		mmGetRouteRule.defaultExpectation.paramPtrs = &AccessRepositoryMockGetRouteRuleParamPtrs{}
+	}
+	mmGetRouteRule.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetRouteRule
+}
+
+// ExpectIdParam2 sets up expected param id for AccessRepository.GetRouteRule
+func (mmGetRouteRule *mAccessRepositoryMockGetRouteRule) ExpectIdParam2(id int64) *mAccessRepositoryMockGetRouteRule {
+	if mmGetRouteRule.mock.funcGetRouteRule != nil {
+		mmGetRouteRule.mock.t.Fatalf("AccessRepositoryMock.GetRouteRule mock is already set by Set")
+	}
+
+	if mmGetRouteRule.defaultExpectation == nil {
+		mmGetRouteRule.defaultExpectation = &AccessRepositoryMockGetRouteRuleExpectation{}
+	}
+
+	if mmGetRouteRule.defaultExpectation.params != nil {
+		mmGetRouteRule.mock.t.Fatalf("AccessRepositoryMock.GetRouteRule mock is already set by Expect")
+	}
+
+	if mmGetRouteRule.defaultExpectation.paramPtrs == nil {
+		mmGetRouteRule.defaultExpectation.paramPtrs = &AccessRepositoryMockGetRouteRuleParamPtrs{}
+	}
+	mmGetRouteRule.defaultExpectation.paramPtrs.id = &id
+
+	return mmGetRouteRule
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessRepository.GetRouteRule
+func (mmGetRouteRule *mAccessRepositoryMockGetRouteRule) Inspect(f func(ctx context.Context, id int64)) *mAccessRepositoryMockGetRouteRule {
+	if mmGetRouteRule.mock.inspectFuncGetRouteRule != nil {
+		mmGetRouteRule.mock.t.Fatalf("Inspect function is already set for AccessRepositoryMock.GetRouteRule")
+	}
+
+	mmGetRouteRule.mock.inspectFuncGetRouteRule = f
+
+	return mmGetRouteRule
+}
+
+// Return sets up results that will be returned by AccessRepository.GetRouteRule
+func (mmGetRouteRule *mAccessRepositoryMockGetRouteRule) Return(rp1 *model.RouteRule, err error) *AccessRepositoryMock {
+	if mmGetRouteRule.mock.funcGetRouteRule != nil {
+		mmGetRouteRule.mock.t.Fatalf("AccessRepositoryMock.GetRouteRule mock is already set by Set")
+	}
+
+	if mmGetRouteRule.defaultExpectation == nil {
+		mmGetRouteRule.defaultExpectation = &AccessRepositoryMockGetRouteRuleExpectation{mock: mmGetRouteRule.mock}
+	}
+	mmGetRouteRule.defaultExpectation.results = &AccessRepositoryMockGetRouteRuleResults{rp1, err}
+	return mmGetRouteRule.mock
+}
+
+// Set uses given function f to mock the AccessRepository.GetRouteRule method
+func (mmGetRouteRule *mAccessRepositoryMockGetRouteRule) Set(f func(ctx context.Context, id int64) (rp1 *model.RouteRule, err error)) *AccessRepositoryMock {
+	if mmGetRouteRule.defaultExpectation != nil {
+		mmGetRouteRule.mock.t.Fatalf("Default expectation is already set for the AccessRepository.GetRouteRule method")
+	}
+
+	if len(mmGetRouteRule.expectations) > 0 {
+		mmGetRouteRule.mock.t.Fatalf("Some expectations are already set for the AccessRepository.GetRouteRule method")
+	}
+
+	mmGetRouteRule.mock.funcGetRouteRule = f
+	return mmGetRouteRule.mock
+}
+
+// When sets expectation for the AccessRepository.GetRouteRule which will trigger the result defined by the following
+// Then helper
+func (mmGetRouteRule *mAccessRepositoryMockGetRouteRule) When(ctx context.Context, id int64) *AccessRepositoryMockGetRouteRuleExpectation {
+	if mmGetRouteRule.mock.funcGetRouteRule != nil {
+		mmGetRouteRule.mock.t.Fatalf("AccessRepositoryMock.GetRouteRule mock is already set by Set")
+	}
+
+	expectation := &AccessRepositoryMockGetRouteRuleExpectation{
+		mock:   mmGetRouteRule.mock,
+		params: &AccessRepositoryMockGetRouteRuleParams{ctx, id},
+	}
+	mmGetRouteRule.expectations = append(mmGetRouteRule.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessRepository.GetRouteRule return parameters for the expectation previously defined by the When method
+func (e *AccessRepositoryMockGetRouteRuleExpectation) Then(rp1 *model.RouteRule, err error) *AccessRepositoryMock {
+	e.results = &AccessRepositoryMockGetRouteRuleResults{rp1, err}
+	return e.mock
+}
+
+// GetRouteRule implements repository.AccessRepository
+func (mmGetRouteRule *AccessRepositoryMock) GetRouteRule(ctx context.Context, id int64) (rp1 *model.RouteRule, err error) {
+	mm_atomic.AddUint64(&mmGetRouteRule.beforeGetRouteRuleCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetRouteRule.afterGetRouteRuleCounter, 1)
+
+	if mmGetRouteRule.inspectFuncGetRouteRule != nil {
+		mmGetRouteRule.inspectFuncGetRouteRule(ctx, id)
+	}
+
+	mm_params := AccessRepositoryMockGetRouteRuleParams{ctx, id}
+
+	// Record call args
+	mmGetRouteRule.GetRouteRuleMock.mutex.Lock()
+	mmGetRouteRule.GetRouteRuleMock.callArgs = append(mmGetRouteRule.GetRouteRuleMock.callArgs, &mm_params)
+	mmGetRouteRule.GetRouteRuleMock.mutex.Unlock()
+
+	for _, e := range mmGetRouteRule.GetRouteRuleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmGetRouteRule.GetRouteRuleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetRouteRule.GetRouteRuleMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetRouteRule.GetRouteRuleMock.defaultExpectation.params
+		mm_want_ptrs := mmGetRouteRule.GetRouteRuleMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessRepositoryMockGetRouteRuleParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetRouteRule.t.Errorf("AccessRepositoryMock.GetRouteRule got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetRouteRule.t.Errorf("AccessRepositoryMock.GetRouteRule got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetRouteRule.t.Errorf("AccessRepositoryMock.GetRouteRule got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetRouteRule.GetRouteRuleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetRouteRule.t.Fatal("No results are set for the AccessRepositoryMock.GetRouteRule")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmGetRouteRule.funcGetRouteRule != nil {
+		return mmGetRouteRule.funcGetRouteRule(ctx, id)
+	}
+	mmGetRouteRule.t.Fatalf("Unexpected call to AccessRepositoryMock.GetRouteRule. %v %v", ctx, id)
+	return
+}
+
+// GetRouteRuleAfterCounter returns a count of finished AccessRepositoryMock.GetRouteRule invocations
+func (mmGetRouteRule *AccessRepositoryMock) GetRouteRuleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRouteRule.afterGetRouteRuleCounter)
+}
+
+// GetRouteRuleBeforeCounter returns a count of AccessRepositoryMock.GetRouteRule invocations
+func (mmGetRouteRule *AccessRepositoryMock) GetRouteRuleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRouteRule.beforeGetRouteRuleCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessRepositoryMock.GetRouteRule.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetRouteRule *mAccessRepositoryMockGetRouteRule) Calls() []*AccessRepositoryMockGetRouteRuleParams {
+	mmGetRouteRule.mutex.RLock()
+
+	argCopy := make([]*AccessRepositoryMockGetRouteRuleParams, len(mmGetRouteRule.callArgs))
+	copy(argCopy, mmGetRouteRule.callArgs)
+
+	mmGetRouteRule.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetRouteRuleDone returns true if the count of the GetRouteRule invocations corresponds
+// the number of defined expectations
+func (m *AccessRepositoryMock) MinimockGetRouteRuleDone() bool {
+	for _, e := range m.GetRouteRuleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRouteRuleMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetRouteRuleCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetRouteRule != nil && mm_atomic.LoadUint64(&m.afterGetRouteRuleCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetRouteRuleInspect logs each unmet expectation
+func (m *AccessRepositoryMock) MinimockGetRouteRuleInspect() {
+	for _, e := range m.GetRouteRuleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessRepositoryMock.GetRouteRule with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRouteRuleMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetRouteRuleCounter) < 1 {
+		if m.GetRouteRuleMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessRepositoryMock.GetRouteRule")
+		} else {
+			m.t.Errorf("Expected call to AccessRepositoryMock.GetRouteRule with params: %#v", *m.GetRouteRuleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetRouteRule != nil && mm_atomic.LoadUint64(&m.afterGetRouteRuleCounter) < 1 {
+		m.t.Error("Expected call to AccessRepositoryMock.GetRouteRule")
+	}
+}
+
+type mAccessRepositoryMockListRouteRules struct {
+	mock               *AccessRepositoryMock
+	defaultExpectation *AccessRepositoryMockListRouteRulesExpectation
+	expectations       []*AccessRepositoryMockListRouteRulesExpectation
+
+	callArgs []*AccessRepositoryMockListRouteRulesParams
+	mutex    sync.RWMutex
+}
+
+// AccessRepositoryMockListRouteRulesExpectation specifies expectation struct of the AccessRepository.ListRouteRules
+type AccessRepositoryMockListRouteRulesExpectation struct {
+	mock      *AccessRepositoryMock
+	params    *AccessRepositoryMockListRouteRulesParams
+	paramPtrs *AccessRepositoryMockListRouteRulesParamPtrs
+	results   *AccessRepositoryMockListRouteRulesResults
+	Counter   uint64
+}
+
+// AccessRepositoryMockListRouteRulesParams contains parameters of the AccessRepository.ListRouteRules
+type AccessRepositoryMockListRouteRulesParams struct {
+	ctx context.Context
+}
+
+// AccessRepositoryMockListRouteRulesParamPtrs contains pointers to parameters of the AccessRepository.ListRouteRules
+type AccessRepositoryMockListRouteRulesParamPtrs struct {
+	ctx *context.Context
+}
+
+// AccessRepositoryMockListRouteRulesResults contains results of the AccessRepository.ListRouteRules
+type AccessRepositoryMockListRouteRulesResults struct {
+	ra1 []model.RouteRule
+	err error
+}
+
+// Expect sets up expected params for AccessRepository.ListRouteRules
+func (mmListRouteRules *mAccessRepositoryMockListRouteRules) Expect(ctx context.Context) *mAccessRepositoryMockListRouteRules {
+	if mmListRouteRules.mock.funcListRouteRules != nil {
+		mmListRouteRules.mock.t.Fatalf("AccessRepositoryMock.ListRouteRules mock is already set by Set")
+	}
+
+	if mmListRouteRules.defaultExpectation == nil {
+		mmListRouteRules.defaultExpectation = &AccessRepositoryMockListRouteRulesExpectation{}
+	}
+
+	if mmListRouteRules.defaultExpectation.paramPtrs != nil {
+		mmListRouteRules.mock.t.Fatalf("AccessRepositoryMock.ListRouteRules mock is already set by ExpectParams functions")
+	}
+
+	mmListRouteRules.defaultExpectation.params = &AccessRepositoryMockListRouteRulesParams{ctx}
+	for _, e := range mmListRouteRules.expectations {
+		if minimock.Equal(e.params, mmListRouteRules.defaultExpectation.params) {
+			mmListRouteRules.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListRouteRules.defaultExpectation.params)
+		}
+	}
+
+	return mmListRouteRules
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessRepository.ListRouteRules
+func (mmListRouteRules *mAccessRepositoryMockListRouteRules) ExpectCtxParam1(ctx context.Context) *mAccessRepositoryMockListRouteRules {
+	if mmListRouteRules.mock.funcListRouteRules != nil {
+		mmListRouteRules.mock.t.Fatalf("AccessRepositoryMock.ListRouteRules mock is already set by Set")
+	}
+
+	if mmListRouteRules.defaultExpectation == nil {
+		mmListRouteRules.defaultExpectation = &AccessRepositoryMockListRouteRulesExpectation{}
+	}
+
+	if mmListRouteRules.defaultExpectation.params != nil {
+		mmListRouteRules.mock.t.Fatalf("AccessRepositoryMock.ListRouteRules mock is already set by Expect")
+	}
+
+	if mmListRouteRules.defaultExpectation.paramPtrs == nil {
+		mmListRouteRules.defaultExpectation.paramPtrs = &AccessRepositoryMockListRouteRulesParamPtrs{}
+	}
+	mmListRouteRules.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListRouteRules
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessRepository.ListRouteRules
+func (mmListRouteRules *mAccessRepositoryMockListRouteRules) Inspect(f func(ctx context.Context)) *mAccessRepositoryMockListRouteRules {
+	if mmListRouteRules.mock.inspectFuncListRouteRules != nil {
+		mmListRouteRules.mock.t.Fatalf("Inspect function is already set for AccessRepositoryMock.ListRouteRules")
+	}
+
+	mmListRouteRules.mock.inspectFuncListRouteRules = f
+
+	return mmListRouteRules
+}
+
+// Return sets up results that will be returned by AccessRepository.ListRouteRules
+func (mmListRouteRules *mAccessRepositoryMockListRouteRules) Return(ra1 []model.RouteRule, err error) *AccessRepositoryMock {
+	if mmListRouteRules.mock.funcListRouteRules != nil {
+		mmListRouteRules.mock.t.Fatalf("AccessRepositoryMock.ListRouteRules mock is already set by Set")
+	}
+
+	if mmListRouteRules.defaultExpectation == nil {
+		mmListRouteRules.defaultExpectation = &AccessRepositoryMockListRouteRulesExpectation{mock: mmListRouteRules.mock}
+	}
+	mmListRouteRules.defaultExpectation.results = &AccessRepositoryMockListRouteRulesResults{ra1, err}
+	return mmListRouteRules.mock
+}
+
+// Set uses given function f to mock the AccessRepository.ListRouteRules method
+func (mmListRouteRules *mAccessRepositoryMockListRouteRules) Set(f func(ctx context.Context) (ra1 []model.RouteRule, err error)) *AccessRepositoryMock {
+	if mmListRouteRules.defaultExpectation != nil {
+		mmListRouteRules.mock.t.Fatalf("Default expectation is already set for the AccessRepository.ListRouteRules method")
+	}
+
+	if len(mmListRouteRules.expectations) > 0 {
+		mmListRouteRules.mock.t.Fatalf("Some expectations are already set for the AccessRepository.ListRouteRules method")
+	}
+
+	mmListRouteRules.mock.funcListRouteRules = f
+	return mmListRouteRules.mock
+}
+
+// When sets expectation for the AccessRepository.ListRouteRules which will trigger the result defined by the following
+// Then helper
+func (mmListRouteRules *mAccessRepositoryMockListRouteRules) When(ctx context.Context) *AccessRepositoryMockListRouteRulesExpectation {
+	if mmListRouteRules.mock.funcListRouteRules != nil {
+		mmListRouteRules.mock.t.Fatalf("AccessRepositoryMock.ListRouteRules mock is already set by Set")
+	}
+
+	expectation := &AccessRepositoryMockListRouteRulesExpectation{
+		mock:   mmListRouteRules.mock,
+		params: &AccessRepositoryMockListRouteRulesParams{ctx},
+	}
+	mmListRouteRules.expectations = append(mmListRouteRules.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessRepository.ListRouteRules return parameters for the expectation previously defined by the When method
+func (e *AccessRepositoryMockListRouteRulesExpectation) Then(ra1 []model.RouteRule, err error) *AccessRepositoryMock {
+	e.results = &AccessRepositoryMockListRouteRulesResults{ra1, err}
+	return e.mock
+}
+
+// ListRouteRules implements repository.AccessRepository
+func (mmListRouteRules *AccessRepositoryMock) ListRouteRules(ctx context.Context) (ra1 []model.RouteRule, err error) {
+	mm_atomic.AddUint64(&mmListRouteRules.beforeListRouteRulesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListRouteRules.afterListRouteRulesCounter, 1)
+
+	if mmListRouteRules.inspectFuncListRouteRules != nil {
+		mmListRouteRules.inspectFuncListRouteRules(ctx)
+	}
+
+	mm_params := AccessRepositoryMockListRouteRulesParams{ctx}
+
+	// Record call args
+	mmListRouteRules.ListRouteRulesMock.mutex.Lock()
+	mmListRouteRules.ListRouteRulesMock.callArgs = append(mmListRouteRules.ListRouteRulesMock.callArgs, &mm_params)
+	mmListRouteRules.ListRouteRulesMock.mutex.Unlock()
+
+	for _, e := range mmListRouteRules.ListRouteRulesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ra1, e.results.err
+		}
+	}
+
+	if mmListRouteRules.ListRouteRulesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListRouteRules.ListRouteRulesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListRouteRules.ListRouteRulesMock.defaultExpectation.params
+		mm_want_ptrs := mmListRouteRules.ListRouteRulesMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessRepositoryMockListRouteRulesParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListRouteRules.t.Errorf("AccessRepositoryMock.ListRouteRules got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListRouteRules.t.Errorf("AccessRepositoryMock.ListRouteRules got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListRouteRules.ListRouteRulesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListRouteRules.t.Fatal("No results are set for the AccessRepositoryMock.ListRouteRules")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmListRouteRules.funcListRouteRules != nil {
+		return mmListRouteRules.funcListRouteRules(ctx)
+	}
+	mmListRouteRules.t.Fatalf("Unexpected call to AccessRepositoryMock.ListRouteRules. %v", ctx)
+	return
+}
+
+// ListRouteRulesAfterCounter returns a count of finished AccessRepositoryMock.ListRouteRules invocations
+func (mmListRouteRules *AccessRepositoryMock) ListRouteRulesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRouteRules.afterListRouteRulesCounter)
+}
+
+// ListRouteRulesBeforeCounter returns a count of AccessRepositoryMock.ListRouteRules invocations
+func (mmListRouteRules *AccessRepositoryMock) ListRouteRulesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRouteRules.beforeListRouteRulesCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessRepositoryMock.ListRouteRules.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListRouteRules *mAccessRepositoryMockListRouteRules) Calls() []*AccessRepositoryMockListRouteRulesParams {
+	mmListRouteRules.mutex.RLock()
+
+	argCopy := make([]*AccessRepositoryMockListRouteRulesParams, len(mmListRouteRules.callArgs))
+	copy(argCopy, mmListRouteRules.callArgs)
+
+	mmListRouteRules.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListRouteRulesDone returns true if the count of the ListRouteRules invocations corresponds
+// the number of defined expectations
+func (m *AccessRepositoryMock) MinimockListRouteRulesDone() bool {
+	for _, e := range m.ListRouteRulesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListRouteRulesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListRouteRulesCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListRouteRules != nil && mm_atomic.LoadUint64(&m.afterListRouteRulesCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockListRouteRulesInspect logs each unmet expectation
+func (m *AccessRepositoryMock) MinimockListRouteRulesInspect() {
+	for _, e := range m.ListRouteRulesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessRepositoryMock.ListRouteRules with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListRouteRulesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListRouteRulesCounter) < 1 {
+		if m.ListRouteRulesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessRepositoryMock.ListRouteRules")
+		} else {
+			m.t.Errorf("Expected call to AccessRepositoryMock.ListRouteRules with params: %#v", *m.ListRouteRulesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListRouteRules != nil && mm_atomic.LoadUint64(&m.afterListRouteRulesCounter) < 1 {
+		m.t.Error("Expected call to AccessRepositoryMock.ListRouteRules")
+	}
+}
+
+type mAccessRepositoryMockUpdateRouteRule struct {
+	mock               *AccessRepositoryMock
+	defaultExpectation *AccessRepositoryMockUpdateRouteRuleExpectation
+	expectations       []*AccessRepositoryMockUpdateRouteRuleExpectation
+
+	callArgs []*AccessRepositoryMockUpdateRouteRuleParams
+	mutex    sync.RWMutex
+}
+
+// AccessRepositoryMockUpdateRouteRuleExpectation specifies expectation struct of the AccessRepository.UpdateRouteRule
+type AccessRepositoryMockUpdateRouteRuleExpectation struct {
+	mock      *AccessRepositoryMock
+	params    *AccessRepositoryMockUpdateRouteRuleParams
+	paramPtrs *AccessRepositoryMockUpdateRouteRuleParamPtrs
+	results   *AccessRepositoryMockUpdateRouteRuleResults
+	Counter   uint64
+}
+
+// AccessRepositoryMockUpdateRouteRuleParams contains parameters of the AccessRepository.UpdateRouteRule
+type AccessRepositoryMockUpdateRouteRuleParams struct {
+	ctx  context.Context
+	rule *model.RouteRule
+}
+
+// AccessRepositoryMockUpdateRouteRuleParamPtrs contains pointers to parameters of the AccessRepository.UpdateRouteRule
+type AccessRepositoryMockUpdateRouteRuleParamPtrs struct {
+	ctx  *context.Context
+	rule **model.RouteRule
+}
+
+// AccessRepositoryMockUpdateRouteRuleResults contains results of the AccessRepository.UpdateRouteRule
+type AccessRepositoryMockUpdateRouteRuleResults struct {
+	err error
+}
+
+// Expect sets up expected params for AccessRepository.UpdateRouteRule
+func (mmUpdateRouteRule *mAccessRepositoryMockUpdateRouteRule) Expect(ctx context.Context, rule *model.RouteRule) *mAccessRepositoryMockUpdateRouteRule {
+	if mmUpdateRouteRule.mock.funcUpdateRouteRule != nil {
+		mmUpdateRouteRule.mock.t.Fatalf("AccessRepositoryMock.UpdateRouteRule mock is already set by Set")
+	}
+
+	if mmUpdateRouteRule.defaultExpectation == nil {
+		mmUpdateRouteRule.defaultExpectation = &AccessRepositoryMockUpdateRouteRuleExpectation{}
+	}
+
+	if mmUpdateRouteRule.defaultExpectation.paramPtrs != nil {
+		mmUpdateRouteRule.mock.t.Fatalf("AccessRepositoryMock.UpdateRouteRule mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateRouteRule.defaultExpectation.params = &AccessRepositoryMockUpdateRouteRuleParams{ctx, rule}
+	for _, e := range mmUpdateRouteRule.expectations {
+		if minimock.Equal(e.params, mmUpdateRouteRule.defaultExpectation.params) {
+			mmUpdateRouteRule.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateRouteRule.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateRouteRule
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessRepository.UpdateRouteRule
+func (mmUpdateRouteRule *mAccessRepositoryMockUpdateRouteRule) ExpectCtxParam1(ctx context.Context) *mAccessRepositoryMockUpdateRouteRule {
+	if mmUpdateRouteRule.mock.funcUpdateRouteRule != nil {
+		mmUpdateRouteRule.mock.t.Fatalf("AccessRepositoryMock.UpdateRouteRule mock is already set by Set")
+	}
+
+	if mmUpdateRouteRule.defaultExpectation == nil {
+		mmUpdateRouteRule.defaultExpectation = &AccessRepositoryMockUpdateRouteRuleExpectation{}
+	}
+
+	if mmUpdateRouteRule.defaultExpectation.params != nil {
+		mmUpdateRouteRule.mock.t.Fatalf("AccessRepositoryMock.UpdateRouteRule mock is already set by Expect")
+	}
+
+	if mmUpdateRouteRule.defaultExpectation.paramPtrs == nil {
+		mmUpdateRouteRule.defaultExpectation.paramPtrs = &AccessRepositoryMockUpdateRouteRuleParamPtrs{}
+	}
+	mmUpdateRouteRule.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmUpdateRouteRule
+}
+
+// ExpectRuleParam2 sets up expected param rule for AccessRepository.UpdateRouteRule
+func (mmUpdateRouteRule *mAccessRepositoryMockUpdateRouteRule) ExpectRuleParam2(rule *model.RouteRule) *mAccessRepositoryMockUpdateRouteRule {
+	if mmUpdateRouteRule.mock.funcUpdateRouteRule != nil {
+		mmUpdateRouteRule.mock.t.Fatalf("AccessRepositoryMock.UpdateRouteRule mock is already set by Set")
+	}
+
+	if mmUpdateRouteRule.defaultExpectation == nil {
+		mmUpdateRouteRule.defaultExpectation = &AccessRepositoryMockUpdateRouteRuleExpectation{}
+	}
+
+	if mmUpdateRouteRule.defaultExpectation.params != nil {
+		mmUpdateRouteRule.mock.t.Fatalf("AccessRepositoryMock.UpdateRouteRule mock is already set by Expect")
+	}
+
+	if mmUpdateRouteRule.defaultExpectation.paramPtrs == nil {
+		mmUpdateRouteRule.defaultExpectation.paramPtrs = &AccessRepositoryMockUpdateRouteRuleParamPtrs{}
+	}
+	mmUpdateRouteRule.defaultExpectation.paramPtrs.rule = &rule
+
+	return mmUpdateRouteRule
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessRepository.UpdateRouteRule
+func (mmUpdateRouteRule *mAccessRepositoryMockUpdateRouteRule) Inspect(f func(ctx context.Context, rule *model.RouteRule)) *mAccessRepositoryMockUpdateRouteRule {
+	if mmUpdateRouteRule.mock.inspectFuncUpdateRouteRule != nil {
+		mmUpdateRouteRule.mock.t.Fatalf("Inspect function is already set for AccessRepositoryMock.UpdateRouteRule")
+	}
+
+	mmUpdateRouteRule.mock.inspectFuncUpdateRouteRule = f
+
+	return mmUpdateRouteRule
+}
+
+// Return sets up results that will be returned by AccessRepository.UpdateRouteRule
+func (mmUpdateRouteRule *mAccessRepositoryMockUpdateRouteRule) Return(err error) *AccessRepositoryMock {
+	if mmUpdateRouteRule.mock.funcUpdateRouteRule != nil {
+		mmUpdateRouteRule.mock.t.Fatalf("AccessRepositoryMock.UpdateRouteRule mock is already set by Set")
+	}
+
+	if mmUpdateRouteRule.defaultExpectation == nil {
+		mmUpdateRouteRule.defaultExpectation = &AccessRepositoryMockUpdateRouteRuleExpectation{mock: mmUpdateRouteRule.mock}
+	}
+	mmUpdateRouteRule.defaultExpectation.results = &AccessRepositoryMockUpdateRouteRuleResults{err}
+	return mmUpdateRouteRule.mock
+}
+
+// Set uses given function f to mock the AccessRepository.UpdateRouteRule method
+func (mmUpdateRouteRule *mAccessRepositoryMockUpdateRouteRule) Set(f func(ctx context.Context, rule *model.RouteRule) (err error)) *AccessRepositoryMock {
+	if mmUpdateRouteRule.defaultExpectation != nil {
+		mmUpdateRouteRule.mock.t.Fatalf("Default expectation is already set for the AccessRepository.UpdateRouteRule method")
+	}
+
+	if len(mmUpdateRouteRule.expectations) > 0 {
+		mmUpdateRouteRule.mock.t.Fatalf("Some expectations are already set for the AccessRepository.UpdateRouteRule method")
+	}
+
+	mmUpdateRouteRule.mock.funcUpdateRouteRule = f
+	return mmUpdateRouteRule.mock
+}
+
+// When sets expectation for the AccessRepository.UpdateRouteRule which will trigger the result defined by the following
+// Then helper
+func (mmUpdateRouteRule *mAccessRepositoryMockUpdateRouteRule) When(ctx context.Context, rule *model.RouteRule) *AccessRepositoryMockUpdateRouteRuleExpectation {
+	if mmUpdateRouteRule.mock.funcUpdateRouteRule != nil {
+		mmUpdateRouteRule.mock.t.Fatalf("AccessRepositoryMock.UpdateRouteRule mock is already set by Set")
+	}
+
+	expectation := &AccessRepositoryMockUpdateRouteRuleExpectation{
+		mock:   mmUpdateRouteRule.mock,
+		params: &AccessRepositoryMockUpdateRouteRuleParams{ctx, rule},
+	}
+	mmUpdateRouteRule.expectations = append(mmUpdateRouteRule.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessRepository.UpdateRouteRule return parameters for the expectation previously defined by the When method
+func (e *AccessRepositoryMockUpdateRouteRuleExpectation) Then(err error) *AccessRepositoryMock {
+	e.results = &AccessRepositoryMockUpdateRouteRuleResults{err}
+	return e.mock
+}
+
+// UpdateRouteRule implements repository.AccessRepository
+func (mmUpdateRouteRule *AccessRepositoryMock) UpdateRouteRule(ctx context.Context, rule *model.RouteRule) (err error) {
+	mm_atomic.AddUint64(&mmUpdateRouteRule.beforeUpdateRouteRuleCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateRouteRule.afterUpdateRouteRuleCounter, 1)
+
+	if mmUpdateRouteRule.inspectFuncUpdateRouteRule != nil {
+		mmUpdateRouteRule.inspectFuncUpdateRouteRule(ctx, rule)
+	}
+
+	mm_params := AccessRepositoryMockUpdateRouteRuleParams{ctx, rule}
+
+	// Record call args
+	mmUpdateRouteRule.UpdateRouteRuleMock.mutex.Lock()
+	mmUpdateRouteRule.UpdateRouteRuleMock.callArgs = append(mmUpdateRouteRule.UpdateRouteRuleMock.callArgs, &mm_params)
+	mmUpdateRouteRule.UpdateRouteRuleMock.mutex.Unlock()
+
+	for _, e := range mmUpdateRouteRule.UpdateRouteRuleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateRouteRule.UpdateRouteRuleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateRouteRule.UpdateRouteRuleMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateRouteRule.UpdateRouteRuleMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateRouteRule.UpdateRouteRuleMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessRepositoryMockUpdateRouteRuleParams{ctx, rule}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateRouteRule.t.Errorf("AccessRepositoryMock.UpdateRouteRule got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.rule != nil && !minimock.Equal(*mm_want_ptrs.rule, mm_got.rule) {
+				mmUpdateRouteRule.t.Errorf("AccessRepositoryMock.UpdateRouteRule got unexpected parameter rule, want: %#v, got: %#v%s\n", *mm_want_ptrs.rule, mm_got.rule, minimock.Diff(*mm_want_ptrs.rule, mm_got.rule))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateRouteRule.t.Errorf("AccessRepositoryMock.UpdateRouteRule got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateRouteRule.UpdateRouteRuleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateRouteRule.t.Fatal("No results are set for the AccessRepositoryMock.UpdateRouteRule")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateRouteRule.funcUpdateRouteRule != nil {
+		return mmUpdateRouteRule.funcUpdateRouteRule(ctx, rule)
+	}
+	mmUpdateRouteRule.t.Fatalf("Unexpected call to AccessRepositoryMock.UpdateRouteRule. %v %v", ctx, rule)
+	return
+}
+
+// UpdateRouteRuleAfterCounter returns a count of finished AccessRepositoryMock.UpdateRouteRule invocations
+func (mmUpdateRouteRule *AccessRepositoryMock) UpdateRouteRuleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateRouteRule.afterUpdateRouteRuleCounter)
+}
+
+// UpdateRouteRuleBeforeCounter returns a count of AccessRepositoryMock.UpdateRouteRule invocations
+func (mmUpdateRouteRule *AccessRepositoryMock) UpdateRouteRuleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateRouteRule.beforeUpdateRouteRuleCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessRepositoryMock.UpdateRouteRule.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateRouteRule *mAccessRepositoryMockUpdateRouteRule) Calls() []*AccessRepositoryMockUpdateRouteRuleParams {
+	mmUpdateRouteRule.mutex.RLock()
+
+	argCopy := make([]*AccessRepositoryMockUpdateRouteRuleParams, len(mmUpdateRouteRule.callArgs))
+	copy(argCopy, mmUpdateRouteRule.callArgs)
+
+	mmUpdateRouteRule.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateRouteRuleDone returns true if the count of the UpdateRouteRule invocations corresponds
+// the number of defined expectations
+func (m *AccessRepositoryMock) MinimockUpdateRouteRuleDone() bool {
+	for _, e := range m.UpdateRouteRuleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateRouteRuleMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateRouteRuleCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateRouteRule != nil && mm_atomic.LoadUint64(&m.afterUpdateRouteRuleCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockUpdateRouteRuleInspect logs each unmet expectation
+func (m *AccessRepositoryMock) MinimockUpdateRouteRuleInspect() {
+	for _, e := range m.UpdateRouteRuleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessRepositoryMock.UpdateRouteRule with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateRouteRuleMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateRouteRuleCounter) < 1 {
+		if m.UpdateRouteRuleMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessRepositoryMock.UpdateRouteRule")
+		} else {
+			m.t.Errorf("Expected call to AccessRepositoryMock.UpdateRouteRule with params: %#v", *m.UpdateRouteRuleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateRouteRule != nil && mm_atomic.LoadUint64(&m.afterUpdateRouteRuleCounter) < 1 {
+		m.t.Error("Expected call to AccessRepositoryMock.UpdateRouteRule")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AccessRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateRouteRuleInspect()
+
+			m.MinimockDeleteRouteRuleInspect()
+
+			m.MinimockFindRouteRulesInspect()
+
+			m.MinimockGetPolicyVersionInspect()
+
+			m.MinimockGetRouteRuleInspect()
+
+			m.MinimockListRouteRulesInspect()
+
+			m.MinimockUpdateRouteRuleInspect()
+			m.t.FailNow()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AccessRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AccessRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateRouteRuleDone() &&
+		m.MinimockDeleteRouteRuleDone() &&
+		m.MinimockFindRouteRulesDone() &&
+		m.MinimockGetPolicyVersionDone() &&
+		m.MinimockGetRouteRuleDone() &&
+		m.MinimockListRouteRulesDone() &&
+		m.MinimockUpdateRouteRuleDone()
+}
@@ -0,0 +1,360 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.8). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/arifullov/auth/internal/repository.AuditRepository -o audit_repository_minimock.go -n AuditRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// AuditRepositoryMock implements repository.AuditRepository
+type AuditRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, record *model.AuditRecord) (i1 int64, err error)
+	inspectFuncCreate   func(ctx context.Context, record *model.AuditRecord)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mAuditRepositoryMockCreate
+}
+
+// NewAuditRepositoryMock returns a mock for repository.AuditRepository
+func NewAuditRepositoryMock(t minimock.Tester) *AuditRepositoryMock {
+	m := &AuditRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mAuditRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*AuditRepositoryMockCreateParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAuditRepositoryMockCreate struct {
+	mock               *AuditRepositoryMock
+	defaultExpectation *AuditRepositoryMockCreateExpectation
+	expectations       []*AuditRepositoryMockCreateExpectation
+
+	callArgs []*AuditRepositoryMockCreateParams
+	mutex    sync.RWMutex
+}
+
+// AuditRepositoryMockCreateExpectation specifies expectation struct of the AuditRepository.Create
+type AuditRepositoryMockCreateExpectation struct {
+	mock      *AuditRepositoryMock
+	params    *AuditRepositoryMockCreateParams
+	paramPtrs *AuditRepositoryMockCreateParamPtrs
+	results   *AuditRepositoryMockCreateResults
+	Counter   uint64
+}
+
+// AuditRepositoryMockCreateParams contains parameters of the AuditRepository.Create
+type AuditRepositoryMockCreateParams struct {
+	ctx    context.Context
+	record *model.AuditRecord
+}
+
+// AuditRepositoryMockCreateParamPtrs contains pointers to parameters of the AuditRepository.Create
+type AuditRepositoryMockCreateParamPtrs struct {
+	ctx    *context.Context
+	record **model.AuditRecord
+}
+
+// AuditRepositoryMockCreateResults contains results of the AuditRepository.Create
+type AuditRepositoryMockCreateResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for AuditRepository.Create
+func (mmCreate *mAuditRepositoryMockCreate) Expect(ctx context.Context, record *model.AuditRecord) *mAuditRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("AuditRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &AuditRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("AuditRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &AuditRepositoryMockCreateParams{ctx, record}
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditRepository.Create
+func (mmCreate *mAuditRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mAuditRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("AuditRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &AuditRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("AuditRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &AuditRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreate
+}
+
+// ExpectRecordParam2 sets up expected param record for AuditRepository.Create
+func (mmCreate *mAuditRepositoryMockCreate) ExpectRecordParam2(record *model.AuditRecord) *mAuditRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("AuditRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &AuditRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("AuditRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &AuditRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.record = &record
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditRepository.Create
+func (mmCreate *mAuditRepositoryMockCreate) Inspect(f func(ctx context.Context, record *model.AuditRecord)) *mAuditRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for AuditRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by AuditRepository.Create
+func (mmCreate *mAuditRepositoryMockCreate) Return(i1 int64, err error) *AuditRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("AuditRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &AuditRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &AuditRepositoryMockCreateResults{i1, err}
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the AuditRepository.Create method
+func (mmCreate *mAuditRepositoryMockCreate) Set(f func(ctx context.Context, record *model.AuditRecord) (i1 int64, err error)) *AuditRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the AuditRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the AuditRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	return mmCreate.mock
+}
+
+// When sets expectation for the AuditRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mAuditRepositoryMockCreate) When(ctx context.Context, record *model.AuditRecord) *AuditRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("AuditRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &AuditRepositoryMockCreateExpectation{
+		mock:   mmCreate.mock,
+		params: &AuditRepositoryMockCreateParams{ctx, record},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditRepository.Create return parameters for the expectation previously defined by the When method
+func (e *AuditRepositoryMockCreateExpectation) Then(i1 int64, err error) *AuditRepositoryMock {
+	e.results = &AuditRepositoryMockCreateResults{i1, err}
+	return e.mock
+}
+
+// Create implements repository.AuditRepository
+func (mmCreate *AuditRepositoryMock) Create(ctx context.Context, record *model.AuditRecord) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, record)
+	}
+
+	mm_params := AuditRepositoryMockCreateParams{ctx, record}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditRepositoryMockCreateParams{ctx, record}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("AuditRepositoryMock.Create got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.record != nil && !minimock.Equal(*mm_want_ptrs.record, mm_got.record) {
+				mmCreate.t.Errorf("AuditRepositoryMock.Create got unexpected parameter record, want: %#v, got: %#v%s\n", *mm_want_ptrs.record, mm_got.record, minimock.Diff(*mm_want_ptrs.record, mm_got.record))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("AuditRepositoryMock.Create got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the AuditRepositoryMock.Create")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, record)
+	}
+	mmCreate.t.Fatalf("Unexpected call to AuditRepositoryMock.Create. %v %v", ctx, record)
+	return
+}
+
+// CreateAfterCounter returns a count of finished AuditRepositoryMock.Create invocations
+func (mmCreate *AuditRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of AuditRepositoryMock.Create invocations
+func (mmCreate *AuditRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mAuditRepositoryMockCreate) Calls() []*AuditRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*AuditRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *AuditRepositoryMock) MinimockCreateDone() bool {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *AuditRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditRepositoryMock.Create with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuditRepositoryMock.Create")
+		} else {
+			m.t.Errorf("Expected call to AuditRepositoryMock.Create with params: %#v", *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		m.t.Error("Expected call to AuditRepositoryMock.Create")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AuditRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+			m.t.FailNow()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AuditRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AuditRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone()
+}
@@ -0,0 +1,1954 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.8). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/arifullov/auth/internal/repository.DeviceCodeRepository -o device_code_repository_minimock.go -n DeviceCodeRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// DeviceCodeRepositoryMock implements repository.DeviceCodeRepository
+type DeviceCodeRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, code *model.DeviceCode) (i1 int64, err error)
+	inspectFuncCreate   func(ctx context.Context, code *model.DeviceCode)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mDeviceCodeRepositoryMockCreate
+
+	funcDelete          func(ctx context.Context, id int64) (err error)
+	inspectFuncDelete   func(ctx context.Context, id int64)
+	afterDeleteCounter  uint64
+	beforeDeleteCounter uint64
+	DeleteMock          mDeviceCodeRepositoryMockDelete
+
+	funcDeleteExpired          func(ctx context.Context, now time.Time) (err error)
+	inspectFuncDeleteExpired   func(ctx context.Context, now time.Time)
+	afterDeleteExpiredCounter  uint64
+	beforeDeleteExpiredCounter uint64
+	DeleteExpiredMock          mDeviceCodeRepositoryMockDeleteExpired
+
+	funcGetByDeviceCodeHash          func(ctx context.Context, deviceCodeHash string) (dp1 *model.DeviceCode, err error)
+	inspectFuncGetByDeviceCodeHash   func(ctx context.Context, deviceCodeHash string)
+	afterGetByDeviceCodeHashCounter  uint64
+	beforeGetByDeviceCodeHashCounter uint64
+	GetByDeviceCodeHashMock          mDeviceCodeRepositoryMockGetByDeviceCodeHash
+
+	funcResolve          func(ctx context.Context, userCode string, status model.DeviceCodeStatus, userID int64) (err error)
+	inspectFuncResolve   func(ctx context.Context, userCode string, status model.DeviceCodeStatus, userID int64)
+	afterResolveCounter  uint64
+	beforeResolveCounter uint64
+	ResolveMock          mDeviceCodeRepositoryMockResolve
+
+	funcUpdatePoll          func(ctx context.Context, id int64, polledAt time.Time, pollInterval time.Duration) (err error)
+	inspectFuncUpdatePoll   func(ctx context.Context, id int64, polledAt time.Time, pollInterval time.Duration)
+	afterUpdatePollCounter  uint64
+	beforeUpdatePollCounter uint64
+	UpdatePollMock          mDeviceCodeRepositoryMockUpdatePoll
+}
+
+// NewDeviceCodeRepositoryMock returns a mock for repository.DeviceCodeRepository
+func NewDeviceCodeRepositoryMock(t minimock.Tester) *DeviceCodeRepositoryMock {
+	m := &DeviceCodeRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mDeviceCodeRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*DeviceCodeRepositoryMockCreateParams{}
+
+	m.DeleteMock = mDeviceCodeRepositoryMockDelete{mock: m}
+	m.DeleteMock.callArgs = []*DeviceCodeRepositoryMockDeleteParams{}
+
+	m.DeleteExpiredMock = mDeviceCodeRepositoryMockDeleteExpired{mock: m}
+	m.DeleteExpiredMock.callArgs = []*DeviceCodeRepositoryMockDeleteExpiredParams{}
+
+	m.GetByDeviceCodeHashMock = mDeviceCodeRepositoryMockGetByDeviceCodeHash{mock: m}
+	m.GetByDeviceCodeHashMock.callArgs = []*DeviceCodeRepositoryMockGetByDeviceCodeHashParams{}
+
+	m.ResolveMock = mDeviceCodeRepositoryMockResolve{mock: m}
+	m.ResolveMock.callArgs = []*DeviceCodeRepositoryMockResolveParams{}
+
+	m.UpdatePollMock = mDeviceCodeRepositoryMockUpdatePoll{mock: m}
+	m.UpdatePollMock.callArgs = []*DeviceCodeRepositoryMockUpdatePollParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mDeviceCodeRepositoryMockCreate struct {
+	mock               *DeviceCodeRepositoryMock
+	defaultExpectation *DeviceCodeRepositoryMockCreateExpectation
+	expectations       []*DeviceCodeRepositoryMockCreateExpectation
+
+	callArgs []*DeviceCodeRepositoryMockCreateParams
+	mutex    sync.RWMutex
+}
+
+// DeviceCodeRepositoryMockCreateExpectation specifies expectation struct of the DeviceCodeRepository.Create
+type DeviceCodeRepositoryMockCreateExpectation struct {
+	mock      *DeviceCodeRepositoryMock
+	params    *DeviceCodeRepositoryMockCreateParams
+	paramPtrs *DeviceCodeRepositoryMockCreateParamPtrs
+	results   *DeviceCodeRepositoryMockCreateResults
+	Counter   uint64
+}
+
+// DeviceCodeRepositoryMockCreateParams contains parameters of the DeviceCodeRepository.Create
+type DeviceCodeRepositoryMockCreateParams struct {
+	ctx  context.Context
+	code *model.DeviceCode
+}
+
+// DeviceCodeRepositoryMockCreateParamPtrs contains pointers to parameters of the DeviceCodeRepository.Create
+type DeviceCodeRepositoryMockCreateParamPtrs struct {
+	ctx  *context.Context
+	code **model.DeviceCode
+}
+
+// DeviceCodeRepositoryMockCreateResults contains results of the DeviceCodeRepository.Create
+type DeviceCodeRepositoryMockCreateResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for DeviceCodeRepository.Create
+func (mmCreate *mDeviceCodeRepositoryMockCreate) Expect(ctx context.Context, code *model.DeviceCode) *mDeviceCodeRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("DeviceCodeRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &DeviceCodeRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("DeviceCodeRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &DeviceCodeRepositoryMockCreateParams{ctx, code}
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for DeviceCodeRepository.Create
+func (mmCreate *mDeviceCodeRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mDeviceCodeRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("DeviceCodeRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &DeviceCodeRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("DeviceCodeRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &DeviceCodeRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreate
+}
+
+// ExpectCodeParam2 sets up expected param code for DeviceCodeRepository.Create
+func (mmCreate *mDeviceCodeRepositoryMockCreate) ExpectCodeParam2(code *model.DeviceCode) *mDeviceCodeRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("DeviceCodeRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &DeviceCodeRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("DeviceCodeRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &DeviceCodeRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.code = &code
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the DeviceCodeRepository.Create
+func (mmCreate *mDeviceCodeRepositoryMockCreate) Inspect(f func(ctx context.Context, code *model.DeviceCode)) *mDeviceCodeRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for DeviceCodeRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by DeviceCodeRepository.Create
+func (mmCreate *mDeviceCodeRepositoryMockCreate) Return(i1 int64, err error) *DeviceCodeRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("DeviceCodeRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &DeviceCodeRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &DeviceCodeRepositoryMockCreateResults{i1, err}
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the DeviceCodeRepository.Create method
+func (mmCreate *mDeviceCodeRepositoryMockCreate) Set(f func(ctx context.Context, code *model.DeviceCode) (i1 int64, err error)) *DeviceCodeRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the DeviceCodeRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the DeviceCodeRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	return mmCreate.mock
+}
+
+// When sets expectation for the DeviceCodeRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mDeviceCodeRepositoryMockCreate) When(ctx context.Context, code *model.DeviceCode) *DeviceCodeRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("DeviceCodeRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &DeviceCodeRepositoryMockCreateExpectation{
+		mock:   mmCreate.mock,
+		params: &DeviceCodeRepositoryMockCreateParams{ctx, code},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up DeviceCodeRepository.Create return parameters for the expectation previously defined by the When method
+func (e *DeviceCodeRepositoryMockCreateExpectation) Then(i1 int64, err error) *DeviceCodeRepositoryMock {
+	e.results = &DeviceCodeRepositoryMockCreateResults{i1, err}
+	return e.mock
+}
+
+// Create implements repository.DeviceCodeRepository
+func (mmCreate *DeviceCodeRepositoryMock) Create(ctx context.Context, code *model.DeviceCode) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, code)
+	}
+
+	mm_params := DeviceCodeRepositoryMockCreateParams{ctx, code}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := DeviceCodeRepositoryMockCreateParams{ctx, code}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("DeviceCodeRepositoryMock.Create got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.code != nil && !minimock.Equal(*mm_want_ptrs.code, mm_got.code) {
+				mmCreate.t.Errorf("DeviceCodeRepositoryMock.Create got unexpected parameter code, want: %#v, got: %#v%s\n", *mm_want_ptrs.code, mm_got.code, minimock.Diff(*mm_want_ptrs.code, mm_got.code))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("DeviceCodeRepositoryMock.Create got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the DeviceCodeRepositoryMock.Create")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, code)
+	}
+	mmCreate.t.Fatalf("Unexpected call to DeviceCodeRepositoryMock.Create. %v %v", ctx, code)
+	return
+}
+
+// CreateAfterCounter returns a count of finished DeviceCodeRepositoryMock.Create invocations
+func (mmCreate *DeviceCodeRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of DeviceCodeRepositoryMock.Create invocations
+func (mmCreate *DeviceCodeRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to DeviceCodeRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mDeviceCodeRepositoryMockCreate) Calls() []*DeviceCodeRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*DeviceCodeRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *DeviceCodeRepositoryMock) MinimockCreateDone() bool {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *DeviceCodeRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to DeviceCodeRepositoryMock.Create with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to DeviceCodeRepositoryMock.Create")
+		} else {
+			m.t.Errorf("Expected call to DeviceCodeRepositoryMock.Create with params: %#v", *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		m.t.Error("Expected call to DeviceCodeRepositoryMock.Create")
+	}
+}
+
+type mDeviceCodeRepositoryMockDelete struct {
+	mock               *DeviceCodeRepositoryMock
+	defaultExpectation *DeviceCodeRepositoryMockDeleteExpectation
+	expectations       []*DeviceCodeRepositoryMockDeleteExpectation
+
+	callArgs []*DeviceCodeRepositoryMockDeleteParams
+	mutex    sync.RWMutex
+}
+
+// DeviceCodeRepositoryMockDeleteExpectation specifies expectation struct of the DeviceCodeRepository.Delete
+type DeviceCodeRepositoryMockDeleteExpectation struct {
+	mock      *DeviceCodeRepositoryMock
+	params    *DeviceCodeRepositoryMockDeleteParams
+	paramPtrs *DeviceCodeRepositoryMockDeleteParamPtrs
+	results   *DeviceCodeRepositoryMockDeleteResults
+	Counter   uint64
+}
+
+// DeviceCodeRepositoryMockDeleteParams contains parameters of the DeviceCodeRepository.Delete
+type DeviceCodeRepositoryMockDeleteParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// DeviceCodeRepositoryMockDeleteParamPtrs contains pointers to parameters of the DeviceCodeRepository.Delete
+type DeviceCodeRepositoryMockDeleteParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// DeviceCodeRepositoryMockDeleteResults contains results of the DeviceCodeRepository.Delete
+type DeviceCodeRepositoryMockDeleteResults struct {
+	err error
+}
+
+// Expect sets up expected params for DeviceCodeRepository.Delete
+func (mmDelete *mDeviceCodeRepositoryMockDelete) Expect(ctx context.Context, id int64) *mDeviceCodeRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("DeviceCodeRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &DeviceCodeRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("DeviceCodeRepositoryMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &DeviceCodeRepositoryMockDeleteParams{ctx, id}
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for DeviceCodeRepository.Delete
+func (mmDelete *mDeviceCodeRepositoryMockDelete) ExpectCtxParam1(ctx context.Context) *mDeviceCodeRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("DeviceCodeRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &DeviceCodeRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("DeviceCodeRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &DeviceCodeRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDelete
+}
+
+// ExpectIdParam2 sets up expected param id for DeviceCodeRepository.Delete
+func (mmDelete *mDeviceCodeRepositoryMockDelete) ExpectIdParam2(id int64) *mDeviceCodeRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("DeviceCodeRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &DeviceCodeRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("DeviceCodeRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &DeviceCodeRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.id = &id
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the DeviceCodeRepository.Delete
+func (mmDelete *mDeviceCodeRepositoryMockDelete) Inspect(f func(ctx context.Context, id int64)) *mDeviceCodeRepositoryMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for DeviceCodeRepositoryMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by DeviceCodeRepository.Delete
+func (mmDelete *mDeviceCodeRepositoryMockDelete) Return(err error) *DeviceCodeRepositoryMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("DeviceCodeRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &DeviceCodeRepositoryMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &DeviceCodeRepositoryMockDeleteResults{err}
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the DeviceCodeRepository.Delete method
+func (mmDelete *mDeviceCodeRepositoryMockDelete) Set(f func(ctx context.Context, id int64) (err error)) *DeviceCodeRepositoryMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the DeviceCodeRepository.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the DeviceCodeRepository.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	return mmDelete.mock
+}
+
+// When sets expectation for the DeviceCodeRepository.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mDeviceCodeRepositoryMockDelete) When(ctx context.Context, id int64) *DeviceCodeRepositoryMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("DeviceCodeRepositoryMock.Delete mock is already set by Set")
+	}
+
+	expectation := &DeviceCodeRepositoryMockDeleteExpectation{
+		mock:   mmDelete.mock,
+		params: &DeviceCodeRepositoryMockDeleteParams{ctx, id},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up DeviceCodeRepository.Delete return parameters for the expectation previously defined by the When method
+func (e *DeviceCodeRepositoryMockDeleteExpectation) Then(err error) *DeviceCodeRepositoryMock {
+	e.results = &DeviceCodeRepositoryMockDeleteResults{err}
+	return e.mock
+}
+
+// Delete implements repository.DeviceCodeRepository
+func (mmDelete *DeviceCodeRepositoryMock) Delete(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, id)
+	}
+
+	mm_params := DeviceCodeRepositoryMockDeleteParams{ctx, id}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := DeviceCodeRepositoryMockDeleteParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("DeviceCodeRepositoryMock.Delete got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDelete.t.Errorf("DeviceCodeRepositoryMock.Delete got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("DeviceCodeRepositoryMock.Delete got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the DeviceCodeRepositoryMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, id)
+	}
+	mmDelete.t.Fatalf("Unexpected call to DeviceCodeRepositoryMock.Delete. %v %v", ctx, id)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished DeviceCodeRepositoryMock.Delete invocations
+func (mmDelete *DeviceCodeRepositoryMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of DeviceCodeRepositoryMock.Delete invocations
+func (mmDelete *DeviceCodeRepositoryMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to DeviceCodeRepositoryMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mDeviceCodeRepositoryMockDelete) Calls() []*DeviceCodeRepositoryMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*DeviceCodeRepositoryMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *DeviceCodeRepositoryMock) MinimockDeleteDone() bool {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && mm_atomic.LoadUint64(&m.afterDeleteCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *DeviceCodeRepositoryMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to DeviceCodeRepositoryMock.Delete with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteCounter) < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to DeviceCodeRepositoryMock.Delete")
+		} else {
+			m.t.Errorf("Expected call to DeviceCodeRepositoryMock.Delete with params: %#v", *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && mm_atomic.LoadUint64(&m.afterDeleteCounter) < 1 {
+		m.t.Error("Expected call to DeviceCodeRepositoryMock.Delete")
+	}
+}
+
+type mDeviceCodeRepositoryMockDeleteExpired struct {
+	mock               *DeviceCodeRepositoryMock
+	defaultExpectation *DeviceCodeRepositoryMockDeleteExpiredExpectation
+	expectations       []*DeviceCodeRepositoryMockDeleteExpiredExpectation
+
+	callArgs []*DeviceCodeRepositoryMockDeleteExpiredParams
+	mutex    sync.RWMutex
+}
+
+// DeviceCodeRepositoryMockDeleteExpiredExpectation specifies expectation struct of the DeviceCodeRepository.DeleteExpired
+type DeviceCodeRepositoryMockDeleteExpiredExpectation struct {
+	mock      *DeviceCodeRepositoryMock
+	params    *DeviceCodeRepositoryMockDeleteExpiredParams
+	paramPtrs *DeviceCodeRepositoryMockDeleteExpiredParamPtrs
+	results   *DeviceCodeRepositoryMockDeleteExpiredResults
+	Counter   uint64
+}
+
+// DeviceCodeRepositoryMockDeleteExpiredParams contains parameters of the DeviceCodeRepository.DeleteExpired
+type DeviceCodeRepositoryMockDeleteExpiredParams struct {
+	ctx context.Context
+	now time.Time
+}
+
+// DeviceCodeRepositoryMockDeleteExpiredParamPtrs contains pointers to parameters of the DeviceCodeRepository.DeleteExpired
+type DeviceCodeRepositoryMockDeleteExpiredParamPtrs struct {
+	ctx *context.Context
+	now *time.Time
+}
+
+// DeviceCodeRepositoryMockDeleteExpiredResults contains results of the DeviceCodeRepository.DeleteExpired
+type DeviceCodeRepositoryMockDeleteExpiredResults struct {
+	err error
+}
+
+// Expect sets up expected params for DeviceCodeRepository.DeleteExpired
+func (mmDeleteExpired *mDeviceCodeRepositoryMockDeleteExpired) Expect(ctx context.Context, now time.Time) *mDeviceCodeRepositoryMockDeleteExpired {
+	if mmDeleteExpired.mock.funcDeleteExpired != nil {
+		mmDeleteExpired.mock.t.Fatalf("DeviceCodeRepositoryMock.DeleteExpired mock is already set by Set")
+	}
+
+	if mmDeleteExpired.defaultExpectation == nil {
+		mmDeleteExpired.defaultExpectation = &DeviceCodeRepositoryMockDeleteExpiredExpectation{}
+	}
+
+	if mmDeleteExpired.defaultExpectation.paramPtrs != nil {
+		mmDeleteExpired.mock.t.Fatalf("DeviceCodeRepositoryMock.DeleteExpired mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteExpired.defaultExpectation.params = &DeviceCodeRepositoryMockDeleteExpiredParams{ctx, now}
+	for _, e := range mmDeleteExpired.expectations {
+		if minimock.Equal(e.params, mmDeleteExpired.defaultExpectation.params) {
+			mmDeleteExpired.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteExpired.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteExpired
+}
+
+// ExpectCtxParam1 sets up expected param ctx for DeviceCodeRepository.DeleteExpired
+func (mmDeleteExpired *mDeviceCodeRepositoryMockDeleteExpired) ExpectCtxParam1(ctx context.Context) *mDeviceCodeRepositoryMockDeleteExpired {
+	if mmDeleteExpired.mock.funcDeleteExpired != nil {
+		mmDeleteExpired.mock.t.Fatalf("DeviceCodeRepositoryMock.DeleteExpired mock is already set by Set")
+	}
+
+	if mmDeleteExpired.defaultExpectation == nil {
+		mmDeleteExpired.defaultExpectation = &DeviceCodeRepositoryMockDeleteExpiredExpectation{}
+	}
+
+	if mmDeleteExpired.defaultExpectation.params != nil {
+		mmDeleteExpired.mock.t.Fatalf("DeviceCodeRepositoryMock.DeleteExpired mock is already set by Expect")
+	}
+
+	if mmDeleteExpired.defaultExpectation.paramPtrs == nil {
+		mmDeleteExpired.defaultExpectation.paramPtrs = &DeviceCodeRepositoryMockDeleteExpiredParamPtrs{}
+	}
+	mmDeleteExpired.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeleteExpired
+}
+
+// ExpectNowParam2 sets up expected param now for DeviceCodeRepository.DeleteExpired
+func (mmDeleteExpired *mDeviceCodeRepositoryMockDeleteExpired) ExpectNowParam2(now time.Time) *mDeviceCodeRepositoryMockDeleteExpired {
+	if mmDeleteExpired.mock.funcDeleteExpired != nil {
+		mmDeleteExpired.mock.t.Fatalf("DeviceCodeRepositoryMock.DeleteExpired mock is already set by Set")
+	}
+
+	if mmDeleteExpired.defaultExpectation == nil {
+		mmDeleteExpired.defaultExpectation = &DeviceCodeRepositoryMockDeleteExpiredExpectation{}
+	}
+
+	if mmDeleteExpired.defaultExpectation.params != nil {
+		mmDeleteExpired.mock.t.Fatalf("DeviceCodeRepositoryMock.DeleteExpired mock is already set by Expect")
+	}
+
+	if mmDeleteExpired.defaultExpectation.paramPtrs == nil {
+		mmDeleteExpired.defaultExpectation.paramPtrs = &DeviceCodeRepositoryMockDeleteExpiredParamPtrs{}
+	}
+	mmDeleteExpired.defaultExpectation.paramPtrs.now = &now
+
+	return mmDeleteExpired
+}
+
+// Inspect accepts an inspector function that has same arguments as the DeviceCodeRepository.DeleteExpired
+func (mmDeleteExpired *mDeviceCodeRepositoryMockDeleteExpired) Inspect(f func(ctx context.Context, now time.Time)) *mDeviceCodeRepositoryMockDeleteExpired {
+	if mmDeleteExpired.mock.inspectFuncDeleteExpired != nil {
+		mmDeleteExpired.mock.t.Fatalf("Inspect function is already set for DeviceCodeRepositoryMock.DeleteExpired")
+	}
+
+	mmDeleteExpired.mock.inspectFuncDeleteExpired = f
+
+	return mmDeleteExpired
+}
+
+// Return sets up results that will be returned by DeviceCodeRepository.DeleteExpired
+func (mmDeleteExpired *mDeviceCodeRepositoryMockDeleteExpired) Return(err error) *DeviceCodeRepositoryMock {
+	if mmDeleteExpired.mock.funcDeleteExpired != nil {
+		mmDeleteExpired.mock.t.Fatalf("DeviceCodeRepositoryMock.DeleteExpired mock is already set by Set")
+	}
+
+	if mmDeleteExpired.defaultExpectation == nil {
+		mmDeleteExpired.defaultExpectation = &DeviceCodeRepositoryMockDeleteExpiredExpectation{mock: mmDeleteExpired.mock}
+	}
+	mmDeleteExpired.defaultExpectation.results = &DeviceCodeRepositoryMockDeleteExpiredResults{err}
+	return mmDeleteExpired.mock
+}
+
+// Set uses given function f to mock the DeviceCodeRepository.DeleteExpired method
+func (mmDeleteExpired *mDeviceCodeRepositoryMockDeleteExpired) Set(f func(ctx context.Context, now time.Time) (err error)) *DeviceCodeRepositoryMock {
+	if mmDeleteExpired.defaultExpectation != nil {
+		mmDeleteExpired.mock.t.Fatalf("Default expectation is already set for the DeviceCodeRepository.DeleteExpired method")
+	}
+
+	if len(mmDeleteExpired.expectations) > 0 {
+		mmDeleteExpired.mock.t.Fatalf("Some expectations are already set for the DeviceCodeRepository.DeleteExpired method")
+	}
+
+	mmDeleteExpired.mock.funcDeleteExpired = f
+	return mmDeleteExpired.mock
+}
+
+// When sets expectation for the DeviceCodeRepository.DeleteExpired which will trigger the result defined by the following
+// Then helper
+func (mmDeleteExpired *mDeviceCodeRepositoryMockDeleteExpired) When(ctx context.Context, now time.Time) *DeviceCodeRepositoryMockDeleteExpiredExpectation {
+	if mmDeleteExpired.mock.funcDeleteExpired != nil {
+		mmDeleteExpired.mock.t.Fatalf("DeviceCodeRepositoryMock.DeleteExpired mock is already set by Set")
+	}
+
+	expectation := &DeviceCodeRepositoryMockDeleteExpiredExpectation{
+		mock:   mmDeleteExpired.mock,
+		params: &DeviceCodeRepositoryMockDeleteExpiredParams{ctx, now},
+	}
+	mmDeleteExpired.expectations = append(mmDeleteExpired.expectations, expectation)
+	return expectation
+}
+
+// Then sets up DeviceCodeRepository.DeleteExpired return parameters for the expectation previously defined by the When method
+func (e *DeviceCodeRepositoryMockDeleteExpiredExpectation) Then(err error) *DeviceCodeRepositoryMock {
+	e.results = &DeviceCodeRepositoryMockDeleteExpiredResults{err}
+	return e.mock
+}
+
+// DeleteExpired implements repository.DeviceCodeRepository
+func (mmDeleteExpired *DeviceCodeRepositoryMock) DeleteExpired(ctx context.Context, now time.Time) (err error) {
+	mm_atomic.AddUint64(&mmDeleteExpired.beforeDeleteExpiredCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteExpired.afterDeleteExpiredCounter, 1)
+
+	if mmDeleteExpired.inspectFuncDeleteExpired != nil {
+		mmDeleteExpired.inspectFuncDeleteExpired(ctx, now)
+	}
+
+	mm_params := DeviceCodeRepositoryMockDeleteExpiredParams{ctx, now}
+
+	// Record call args
+	mmDeleteExpired.DeleteExpiredMock.mutex.Lock()
+	mmDeleteExpired.DeleteExpiredMock.callArgs = append(mmDeleteExpired.DeleteExpiredMock.callArgs, &mm_params)
+	mmDeleteExpired.DeleteExpiredMock.mutex.Unlock()
+
+	for _, e := range mmDeleteExpired.DeleteExpiredMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteExpired.DeleteExpiredMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteExpired.DeleteExpiredMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteExpired.DeleteExpiredMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteExpired.DeleteExpiredMock.defaultExpectation.paramPtrs
+
+		mm_got := DeviceCodeRepositoryMockDeleteExpiredParams{ctx, now}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteExpired.t.Errorf("DeviceCodeRepositoryMock.DeleteExpired got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.now != nil && !minimock.Equal(*mm_want_ptrs.now, mm_got.now) {
+				mmDeleteExpired.t.Errorf("DeviceCodeRepositoryMock.DeleteExpired got unexpected parameter now, want: %#v, got: %#v%s\n", *mm_want_ptrs.now, mm_got.now, minimock.Diff(*mm_want_ptrs.now, mm_got.now))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteExpired.t.Errorf("DeviceCodeRepositoryMock.DeleteExpired got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteExpired.DeleteExpiredMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteExpired.t.Fatal("No results are set for the DeviceCodeRepositoryMock.DeleteExpired")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteExpired.funcDeleteExpired != nil {
+		return mmDeleteExpired.funcDeleteExpired(ctx, now)
+	}
+	mmDeleteExpired.t.Fatalf("Unexpected call to DeviceCodeRepositoryMock.DeleteExpired. %v %v", ctx, now)
+	return
+}
+
+// DeleteExpiredAfterCounter returns a count of finished DeviceCodeRepositoryMock.DeleteExpired invocations
+func (mmDeleteExpired *DeviceCodeRepositoryMock) DeleteExpiredAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteExpired.afterDeleteExpiredCounter)
+}
+
+// DeleteExpiredBeforeCounter returns a count of DeviceCodeRepositoryMock.DeleteExpired invocations
+func (mmDeleteExpired *DeviceCodeRepositoryMock) DeleteExpiredBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteExpired.beforeDeleteExpiredCounter)
+}
+
+// Calls returns a list of arguments used in each call to DeviceCodeRepositoryMock.DeleteExpired.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteExpired *mDeviceCodeRepositoryMockDeleteExpired) Calls() []*DeviceCodeRepositoryMockDeleteExpiredParams {
+	mmDeleteExpired.mutex.RLock()
+
+	argCopy := make([]*DeviceCodeRepositoryMockDeleteExpiredParams, len(mmDeleteExpired.callArgs))
+	copy(argCopy, mmDeleteExpired.callArgs)
+
+	mmDeleteExpired.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteExpiredDone returns true if the count of the DeleteExpired invocations corresponds
+// the number of defined expectations
+func (m *DeviceCodeRepositoryMock) MinimockDeleteExpiredDone() bool {
+	for _, e := range m.DeleteExpiredMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteExpiredMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteExpiredCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteExpired != nil && mm_atomic.LoadUint64(&m.afterDeleteExpiredCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockDeleteExpiredInspect logs each unmet expectation
+func (m *DeviceCodeRepositoryMock) MinimockDeleteExpiredInspect() {
+	for _, e := range m.DeleteExpiredMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to DeviceCodeRepositoryMock.DeleteExpired with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteExpiredMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteExpiredCounter) < 1 {
+		if m.DeleteExpiredMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to DeviceCodeRepositoryMock.DeleteExpired")
+		} else {
+			m.t.Errorf("Expected call to DeviceCodeRepositoryMock.DeleteExpired with params: %#v", *m.DeleteExpiredMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteExpired != nil && mm_atomic.LoadUint64(&m.afterDeleteExpiredCounter) < 1 {
+		m.t.Error("Expected call to DeviceCodeRepositoryMock.DeleteExpired")
+	}
+}
+
+type mDeviceCodeRepositoryMockGetByDeviceCodeHash struct {
+	mock               *DeviceCodeRepositoryMock
+	defaultExpectation *DeviceCodeRepositoryMockGetByDeviceCodeHashExpectation
+	expectations       []*DeviceCodeRepositoryMockGetByDeviceCodeHashExpectation
+
+	callArgs []*DeviceCodeRepositoryMockGetByDeviceCodeHashParams
+	mutex    sync.RWMutex
+}
+
+// DeviceCodeRepositoryMockGetByDeviceCodeHashExpectation specifies expectation struct of the DeviceCodeRepository.GetByDeviceCodeHash
+type DeviceCodeRepositoryMockGetByDeviceCodeHashExpectation struct {
+	mock      *DeviceCodeRepositoryMock
+	params    *DeviceCodeRepositoryMockGetByDeviceCodeHashParams
+	paramPtrs *DeviceCodeRepositoryMockGetByDeviceCodeHashParamPtrs
+	results   *DeviceCodeRepositoryMockGetByDeviceCodeHashResults
+	Counter   uint64
+}
+
+// DeviceCodeRepositoryMockGetByDeviceCodeHashParams contains parameters of the DeviceCodeRepository.GetByDeviceCodeHash
+type DeviceCodeRepositoryMockGetByDeviceCodeHashParams struct {
+	ctx            context.Context
+	deviceCodeHash string
+}
+
+// DeviceCodeRepositoryMockGetByDeviceCodeHashParamPtrs contains pointers to parameters of the DeviceCodeRepository.GetByDeviceCodeHash
+type DeviceCodeRepositoryMockGetByDeviceCodeHashParamPtrs struct {
+	ctx            *context.Context
+	deviceCodeHash *string
+}
+
+// DeviceCodeRepositoryMockGetByDeviceCodeHashResults contains results of the DeviceCodeRepository.GetByDeviceCodeHash
+type DeviceCodeRepositoryMockGetByDeviceCodeHashResults struct {
+	dp1 *model.DeviceCode
+	err error
+}
+
+// Expect sets up expected params for DeviceCodeRepository.GetByDeviceCodeHash
+func (mmGetByDeviceCodeHash *mDeviceCodeRepositoryMockGetByDeviceCodeHash) Expect(ctx context.Context, deviceCodeHash string) *mDeviceCodeRepositoryMockGetByDeviceCodeHash {
+	if mmGetByDeviceCodeHash.mock.funcGetByDeviceCodeHash != nil {
+		mmGetByDeviceCodeHash.mock.t.Fatalf("DeviceCodeRepositoryMock.GetByDeviceCodeHash mock is already set by Set")
+	}
+
+	if mmGetByDeviceCodeHash.defaultExpectation == nil {
+		mmGetByDeviceCodeHash.defaultExpectation = &DeviceCodeRepositoryMockGetByDeviceCodeHashExpectation{}
+	}
+
+	if mmGetByDeviceCodeHash.defaultExpectation.paramPtrs != nil {
+		mmGetByDeviceCodeHash.mock.t.Fatalf("DeviceCodeRepositoryMock.GetByDeviceCodeHash mock is already set by ExpectParams functions")
+	}
+
+	mmGetByDeviceCodeHash.defaultExpectation.params = &DeviceCodeRepositoryMockGetByDeviceCodeHashParams{ctx, deviceCodeHash}
+	for _, e := range mmGetByDeviceCodeHash.expectations {
+		if minimock.Equal(e.params, mmGetByDeviceCodeHash.defaultExpectation.params) {
+			mmGetByDeviceCodeHash.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetByDeviceCodeHash.defaultExpectation.params)
+		}
+	}
+
+	return mmGetByDeviceCodeHash
+}
+
+// ExpectCtxParam1 sets up expected param ctx for DeviceCodeRepository.GetByDeviceCodeHash
+func (mmGetByDeviceCodeHash *mDeviceCodeRepositoryMockGetByDeviceCodeHash) ExpectCtxParam1(ctx context.Context) *mDeviceCodeRepositoryMockGetByDeviceCodeHash {
+	if mmGetByDeviceCodeHash.mock.funcGetByDeviceCodeHash != nil {
+		mmGetByDeviceCodeHash.mock.t.Fatalf("DeviceCodeRepositoryMock.GetByDeviceCodeHash mock is already set by Set")
+	}
+
+	if mmGetByDeviceCodeHash.defaultExpectation == nil {
+		mmGetByDeviceCodeHash.defaultExpectation = &DeviceCodeRepositoryMockGetByDeviceCodeHashExpectation{}
+	}
+
+	if mmGetByDeviceCodeHash.defaultExpectation.params != nil {
+		mmGetByDeviceCodeHash.mock.t.Fatalf("DeviceCodeRepositoryMock.GetByDeviceCodeHash mock is already set by Expect")
+	}
+
+	if mmGetByDeviceCodeHash.defaultExpectation.paramPtrs == nil {
+		mmGetByDeviceCodeHash.defaultExpectation.paramPtrs = &DeviceCodeRepositoryMockGetByDeviceCodeHashParamPtrs{}
+	}
+	mmGetByDeviceCodeHash.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetByDeviceCodeHash
+}
+
+// ExpectDeviceCodeHashParam2 sets up expected param deviceCodeHash for DeviceCodeRepository.GetByDeviceCodeHash
+func (mmGetByDeviceCodeHash *mDeviceCodeRepositoryMockGetByDeviceCodeHash) ExpectDeviceCodeHashParam2(deviceCodeHash string) *mDeviceCodeRepositoryMockGetByDeviceCodeHash {
+	if mmGetByDeviceCodeHash.mock.funcGetByDeviceCodeHash != nil {
+		mmGetByDeviceCodeHash.mock.t.Fatalf("DeviceCodeRepositoryMock.GetByDeviceCodeHash mock is already set by Set")
+	}
+
+	if mmGetByDeviceCodeHash.defaultExpectation == nil {
+		mmGetByDeviceCodeHash.defaultExpectation = &DeviceCodeRepositoryMockGetByDeviceCodeHashExpectation{}
+	}
+
+	if mmGetByDeviceCodeHash.defaultExpectation.params != nil {
+		mmGetByDeviceCodeHash.mock.t.Fatalf("DeviceCodeRepositoryMock.GetByDeviceCodeHash mock is already set by Expect")
+	}
+
+	if mmGetByDeviceCodeHash.defaultExpectation.paramPtrs == nil {
+		mmGetByDeviceCodeHash.defaultExpectation.paramPtrs = &DeviceCodeRepositoryMockGetByDeviceCodeHashParamPtrs{}
+	}
+	mmGetByDeviceCodeHash.defaultExpectation.paramPtrs.deviceCodeHash = &deviceCodeHash
+
+	return mmGetByDeviceCodeHash
+}
+
+// Inspect accepts an inspector function that has same arguments as the DeviceCodeRepository.GetByDeviceCodeHash
+func (mmGetByDeviceCodeHash *mDeviceCodeRepositoryMockGetByDeviceCodeHash) Inspect(f func(ctx context.Context, deviceCodeHash string)) *mDeviceCodeRepositoryMockGetByDeviceCodeHash {
+	if mmGetByDeviceCodeHash.mock.inspectFuncGetByDeviceCodeHash != nil {
+		mmGetByDeviceCodeHash.mock.t.Fatalf("Inspect function is already set for DeviceCodeRepositoryMock.GetByDeviceCodeHash")
+	}
+
+	mmGetByDeviceCodeHash.mock.inspectFuncGetByDeviceCodeHash = f
+
+	return mmGetByDeviceCodeHash
+}
+
+// Return sets up results that will be returned by DeviceCodeRepository.GetByDeviceCodeHash
+func (mmGetByDeviceCodeHash *mDeviceCodeRepositoryMockGetByDeviceCodeHash) Return(dp1 *model.DeviceCode, err error) *DeviceCodeRepositoryMock {
+	if mmGetByDeviceCodeHash.mock.funcGetByDeviceCodeHash != nil {
+		mmGetByDeviceCodeHash.mock.t.Fatalf("DeviceCodeRepositoryMock.GetByDeviceCodeHash mock is already set by Set")
+	}
+
+	if mmGetByDeviceCodeHash.defaultExpectation == nil {
+		mmGetByDeviceCodeHash.defaultExpectation = &DeviceCodeRepositoryMockGetByDeviceCodeHashExpectation{mock: mmGetByDeviceCodeHash.mock}
+	}
+	mmGetByDeviceCodeHash.defaultExpectation.results = &DeviceCodeRepositoryMockGetByDeviceCodeHashResults{dp1, err}
+	return mmGetByDeviceCodeHash.mock
+}
+
+// Set uses given function f to mock the DeviceCodeRepository.GetByDeviceCodeHash method
+func (mmGetByDeviceCodeHash *mDeviceCodeRepositoryMockGetByDeviceCodeHash) Set(f func(ctx context.Context, deviceCodeHash string) (dp1 *model.DeviceCode, err error)) *DeviceCodeRepositoryMock {
+	if mmGetByDeviceCodeHash.defaultExpectation != nil {
+		mmGetByDeviceCodeHash.mock.t.Fatalf("Default expectation is already set for the DeviceCodeRepository.GetByDeviceCodeHash method")
+	}
+
+	if len(mmGetByDeviceCodeHash.expectations) > 0 {
+		mmGetByDeviceCodeHash.mock.t.Fatalf("Some expectations are already set for the DeviceCodeRepository.GetByDeviceCodeHash method")
+	}
+
+	mmGetByDeviceCodeHash.mock.funcGetByDeviceCodeHash = f
+	return mmGetByDeviceCodeHash.mock
+}
+
+// When sets expectation for the DeviceCodeRepository.GetByDeviceCodeHash which will trigger the result defined by the following
+// Then helper
+func (mmGetByDeviceCodeHash *mDeviceCodeRepositoryMockGetByDeviceCodeHash) When(ctx context.Context, deviceCodeHash string) *DeviceCodeRepositoryMockGetByDeviceCodeHashExpectation {
+	if mmGetByDeviceCodeHash.mock.funcGetByDeviceCodeHash != nil {
+		mmGetByDeviceCodeHash.mock.t.Fatalf("DeviceCodeRepositoryMock.GetByDeviceCodeHash mock is already set by Set")
+	}
+
+	expectation := &DeviceCodeRepositoryMockGetByDeviceCodeHashExpectation{
+		mock:   mmGetByDeviceCodeHash.mock,
+		params: &DeviceCodeRepositoryMockGetByDeviceCodeHashParams{ctx, deviceCodeHash},
+	}
+	mmGetByDeviceCodeHash.expectations = append(mmGetByDeviceCodeHash.expectations, expectation)
+	return expectation
+}
+
+// Then sets up DeviceCodeRepository.GetByDeviceCodeHash return parameters for the expectation previously defined by the When method
+func (e *DeviceCodeRepositoryMockGetByDeviceCodeHashExpectation) Then(dp1 *model.DeviceCode, err error) *DeviceCodeRepositoryMock {
+	e.results = &DeviceCodeRepositoryMockGetByDeviceCodeHashResults{dp1, err}
+	return e.mock
+}
+
+// GetByDeviceCodeHash implements repository.DeviceCodeRepository
+func (mmGetByDeviceCodeHash *DeviceCodeRepositoryMock) GetByDeviceCodeHash(ctx context.Context, deviceCodeHash string) (dp1 *model.DeviceCode, err error) {
+	mm_atomic.AddUint64(&mmGetByDeviceCodeHash.beforeGetByDeviceCodeHashCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetByDeviceCodeHash.afterGetByDeviceCodeHashCounter, 1)
+
+	if mmGetByDeviceCodeHash.inspectFuncGetByDeviceCodeHash != nil {
+		mmGetByDeviceCodeHash.inspectFuncGetByDeviceCodeHash(ctx, deviceCodeHash)
+	}
+
+	mm_params := DeviceCodeRepositoryMockGetByDeviceCodeHashParams{ctx, deviceCodeHash}
+
+	// Record call args
+	mmGetByDeviceCodeHash.GetByDeviceCodeHashMock.mutex.Lock()
+	mmGetByDeviceCodeHash.GetByDeviceCodeHashMock.callArgs = append(mmGetByDeviceCodeHash.GetByDeviceCodeHashMock.callArgs, &mm_params)
+	mmGetByDeviceCodeHash.GetByDeviceCodeHashMock.mutex.Unlock()
+
+	for _, e := range mmGetByDeviceCodeHash.GetByDeviceCodeHashMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.dp1, e.results.err
+		}
+	}
+
+	if mmGetByDeviceCodeHash.GetByDeviceCodeHashMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetByDeviceCodeHash.GetByDeviceCodeHashMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetByDeviceCodeHash.GetByDeviceCodeHashMock.defaultExpectation.params
+		mm_want_ptrs := mmGetByDeviceCodeHash.GetByDeviceCodeHashMock.defaultExpectation.paramPtrs
+
+		mm_got := DeviceCodeRepositoryMockGetByDeviceCodeHashParams{ctx, deviceCodeHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetByDeviceCodeHash.t.Errorf("DeviceCodeRepositoryMock.GetByDeviceCodeHash got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.deviceCodeHash != nil && !minimock.Equal(*mm_want_ptrs.deviceCodeHash, mm_got.deviceCodeHash) {
+				mmGetByDeviceCodeHash.t.Errorf("DeviceCodeRepositoryMock.GetByDeviceCodeHash got unexpected parameter deviceCodeHash, want: %#v, got: %#v%s\n", *mm_want_ptrs.deviceCodeHash, mm_got.deviceCodeHash, minimock.Diff(*mm_want_ptrs.deviceCodeHash, mm_got.deviceCodeHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetByDeviceCodeHash.t.Errorf("DeviceCodeRepositoryMock.GetByDeviceCodeHash got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetByDeviceCodeHash.GetByDeviceCodeHashMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetByDeviceCodeHash.t.Fatal("No results are set for the DeviceCodeRepositoryMock.GetByDeviceCodeHash")
+		}
+		return (*mm_results).dp1, (*mm_results).err
+	}
+	if mmGetByDeviceCodeHash.funcGetByDeviceCodeHash != nil {
+		return mmGetByDeviceCodeHash.funcGetByDeviceCodeHash(ctx, deviceCodeHash)
+	}
+	mmGetByDeviceCodeHash.t.Fatalf("Unexpected call to DeviceCodeRepositoryMock.GetByDeviceCodeHash. %v %v", ctx, deviceCodeHash)
+	return
+}
+
+// GetByDeviceCodeHashAfterCounter returns a count of finished DeviceCodeRepositoryMock.GetByDeviceCodeHash invocations
+func (mmGetByDeviceCodeHash *DeviceCodeRepositoryMock) GetByDeviceCodeHashAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetByDeviceCodeHash.afterGetByDeviceCodeHashCounter)
+}
+
+// GetByDeviceCodeHashBeforeCounter returns a count of DeviceCodeRepositoryMock.GetByDeviceCodeHash invocations
+func (mmGetByDeviceCodeHash *DeviceCodeRepositoryMock) GetByDeviceCodeHashBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetByDeviceCodeHash.beforeGetByDeviceCodeHashCounter)
+}
+
+// Calls returns a list of arguments used in each call to DeviceCodeRepositoryMock.GetByDeviceCodeHash.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetByDeviceCodeHash *mDeviceCodeRepositoryMockGetByDeviceCodeHash) Calls() []*DeviceCodeRepositoryMockGetByDeviceCodeHashParams {
+	mmGetByDeviceCodeHash.mutex.RLock()
+
+	argCopy := make([]*DeviceCodeRepositoryMockGetByDeviceCodeHashParams, len(mmGetByDeviceCodeHash.callArgs))
+	copy(argCopy, mmGetByDeviceCodeHash.callArgs)
+
+	mmGetByDeviceCodeHash.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetByDeviceCodeHashDone returns true if the count of the GetByDeviceCodeHash invocations corresponds
+// the number of defined expectations
+func (m *DeviceCodeRepositoryMock) MinimockGetByDeviceCodeHashDone() bool {
+	for _, e := range m.GetByDeviceCodeHashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetByDeviceCodeHashMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetByDeviceCodeHashCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetByDeviceCodeHash != nil && mm_atomic.LoadUint64(&m.afterGetByDeviceCodeHashCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetByDeviceCodeHashInspect logs each unmet expectation
+func (m *DeviceCodeRepositoryMock) MinimockGetByDeviceCodeHashInspect() {
+	for _, e := range m.GetByDeviceCodeHashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to DeviceCodeRepositoryMock.GetByDeviceCodeHash with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetByDeviceCodeHashMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetByDeviceCodeHashCounter) < 1 {
+		if m.GetByDeviceCodeHashMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to DeviceCodeRepositoryMock.GetByDeviceCodeHash")
+		} else {
+			m.t.Errorf("Expected call to DeviceCodeRepositoryMock.GetByDeviceCodeHash with params: %#v", *m.GetByDeviceCodeHashMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetByDeviceCodeHash != nil && mm_atomic.LoadUint64(&m.afterGetByDeviceCodeHashCounter) < 1 {
+		m.t.Error("Expected call to DeviceCodeRepositoryMock.GetByDeviceCodeHash")
+	}
+}
+
+type mDeviceCodeRepositoryMockResolve struct {
+	mock               *DeviceCodeRepositoryMock
+	defaultExpectation *DeviceCodeRepositoryMockResolveExpectation
+	expectations       []*DeviceCodeRepositoryMockResolveExpectation
+
+	callArgs []*DeviceCodeRepositoryMockResolveParams
+	mutex    sync.RWMutex
+}
+
+// DeviceCodeRepositoryMockResolveExpectation specifies expectation struct of the DeviceCodeRepository.Resolve
+type DeviceCodeRepositoryMockResolveExpectation struct {
+	mock      *DeviceCodeRepositoryMock
+	params    *DeviceCodeRepositoryMockResolveParams
+	paramPtrs *DeviceCodeRepositoryMockResolveParamPtrs
+	results   *DeviceCodeRepositoryMockResolveResults
+	Counter   uint64
+}
+
+// DeviceCodeRepositoryMockResolveParams contains parameters of the DeviceCodeRepository.Resolve
+type DeviceCodeRepositoryMockResolveParams struct {
+	ctx      context.Context
+	userCode string
+	status   model.DeviceCodeStatus
+	userID   int64
+}
+
+// DeviceCodeRepositoryMockResolveParamPtrs contains pointers to parameters of the DeviceCodeRepository.Resolve
+type DeviceCodeRepositoryMockResolveParamPtrs struct {
+	ctx      *context.Context
+	userCode *string
+	status   *model.DeviceCodeStatus
+	userID   *int64
+}
+
+// DeviceCodeRepositoryMockResolveResults contains results of the DeviceCodeRepository.Resolve
+type DeviceCodeRepositoryMockResolveResults struct {
+	err error
+}
+
+// Expect sets up expected params for DeviceCodeRepository.Resolve
+func (mmResolve *mDeviceCodeRepositoryMockResolve) Expect(ctx context.Context, userCode string, status model.DeviceCodeStatus, userID int64) *mDeviceCodeRepositoryMockResolve {
+	if mmResolve.mock.funcResolve != nil {
+		mmResolve.mock.t.Fatalf("DeviceCodeRepositoryMock.Resolve mock is already set by Set")
+	}
+
+	if mmResolve.defaultExpectation == nil {
+		mmResolve.defaultExpectation = &DeviceCodeRepositoryMockResolveExpectation{}
+	}
+
+	if mmResolve.defaultExpectation.paramPtrs != nil {
+		mmResolve.mock.t.Fatalf("DeviceCodeRepositoryMock.Resolve mock is already set by ExpectParams functions")
+	}
+
+	mmResolve.defaultExpectation.params = &DeviceCodeRepositoryMockResolveParams{ctx, userCode, status, userID}
+	for _, e := range mmResolve.expectations {
+		if minimock.Equal(e.params, mmResolve.defaultExpectation.params) {
+			mmResolve.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmResolve.defaultExpectation.params)
+		}
+	}
+
+	return mmResolve
+}
+
+// ExpectCtxParam1 sets up expected param ctx for DeviceCodeRepository.Resolve
+func (mmResolve *mDeviceCodeRepositoryMockResolve) ExpectCtxParam1(ctx context.Context) *mDeviceCodeRepositoryMockResolve {
+	if mmResolve.mock.funcResolve != nil {
+		mmResolve.mock.t.Fatalf("DeviceCodeRepositoryMock.Resolve mock is already set by Set")
+	}
+
+	if mmResolve.defaultExpectation == nil {
+		mmResolve.defaultExpectation = &DeviceCodeRepositoryMockResolveExpectation{}
+	}
+
+	if mmResolve.defaultExpectation.params != nil {
+		mmResolve.mock.t.Fatalf("DeviceCodeRepositoryMock.Resolve mock is already set by Expect")
+	}
+
+	if mmResolve.defaultExpectation.paramPtrs == nil {
+		mmResolve.defaultExpectation.paramPtrs = &DeviceCodeRepositoryMockResolveParamPtrs{}
+	}
+	mmResolve.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmResolve
+}
+
+// ExpectUserCodeParam2 sets up expected param userCode for DeviceCodeRepository.Resolve
+func (mmResolve *mDeviceCodeRepositoryMockResolve) ExpectUserCodeParam2(userCode string) *mDeviceCodeRepositoryMockResolve {
+	if mmResolve.mock.funcResolve != nil {
+		mmResolve.mock.t.Fatalf("DeviceCodeRepositoryMock.Resolve mock is already set by Set")
+	}
+
+	if mmResolve.defaultExpectation == nil {
+		mmResolve.defaultExpectation = &DeviceCodeRepositoryMockResolveExpectation{}
+	}
+
+	if mmResolve.defaultExpectation.params != nil {
+		mmResolve.mock.t.Fatalf("DeviceCodeRepositoryMock.Resolve mock is already set by Expect")
+	}
+
+	if mmResolve.defaultExpectation.paramPtrs == nil {
+		mmResolve.defaultExpectation.paramPtrs = &DeviceCodeRepositoryMockResolveParamPtrs{}
+	}
+	mmResolve.defaultExpectation.paramPtrs.userCode = &userCode
+
+	return mmResolve
+}
+
+// ExpectStatusParam3 sets up expected param status for DeviceCodeRepository.Resolve
+func (mmResolve *mDeviceCodeRepositoryMockResolve) ExpectStatusParam3(status model.DeviceCodeStatus) *mDeviceCodeRepositoryMockResolve {
+	if mmResolve.mock.funcResolve != nil {
+		mmResolve.mock.t.Fatalf("DeviceCodeRepositoryMock.Resolve mock is already set by Set")
+	}
+
+	if mmResolve.defaultExpectation == nil {
+		mmResolve.defaultExpectation = &DeviceCodeRepositoryMockResolveExpectation{}
+	}
+
+	if mmResolve.defaultExpectation.params != nil {
+		mmResolve.mock.t.Fatalf("DeviceCodeRepositoryMock.Resolve mock is already set by Expect")
+	}
+
+	if mmResolve.defaultExpectation.paramPtrs == nil {
+		mmResolve.defaultExpectation.paramPtrs = &DeviceCodeRepositoryMockResolveParamPtrs{}
+	}
+	mmResolve.defaultExpectation.paramPtrs.status = &status
+
+	return mmResolve
+}
+
+// ExpectUserIDParam4 sets up expected param userID for DeviceCodeRepository.Resolve
+func (mmResolve *mDeviceCodeRepositoryMockResolve) ExpectUserIDParam4(userID int64) *mDeviceCodeRepositoryMockResolve {
+	if mmResolve.mock.funcResolve != nil {
+		mmResolve.mock.t.Fatalf("DeviceCodeRepositoryMock.Resolve mock is already set by Set")
+	}
+
+	if mmResolve.defaultExpectation == nil {
+		mmResolve.defaultExpectation = &DeviceCodeRepositoryMockResolveExpectation{}
+	}
+
+	if mmResolve.defaultExpectation.params != nil {
+		mmResolve.mock.t.Fatalf("DeviceCodeRepositoryMock.Resolve mock is already set by Expect")
+	}
+
+	if mmResolve.defaultExpectation.paramPtrs == nil {
+		mmResolve.defaultExpectation.paramPtrs = &DeviceCodeRepositoryMockResolveParamPtrs{}
+	}
+	mmResolve.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmResolve
+}
+
+// Inspect accepts an inspector function that has same arguments as the DeviceCodeRepository.Resolve
+func (mmResolve *mDeviceCodeRepositoryMockResolve) Inspect(f func(ctx context.Context, userCode string, status model.DeviceCodeStatus, userID int64)) *mDeviceCodeRepositoryMockResolve {
+	if mmResolve.mock.inspectFuncResolve != nil {
+		mmResolve.mock.t.Fatalf("Inspect function is already set for DeviceCodeRepositoryMock.Resolve")
+	}
+
+	mmResolve.mock.inspectFuncResolve = f
+
+	return mmResolve
+}
+
+// Return sets up results that will be returned by DeviceCodeRepository.Resolve
+func (mmResolve *mDeviceCodeRepositoryMockResolve) Return(err error) *DeviceCodeRepositoryMock {
+	if mmResolve.mock.funcResolve != nil {
+		mmResolve.mock.t.Fatalf("DeviceCodeRepositoryMock.Resolve mock is already set by Set")
+	}
+
+	if mmResolve.defaultExpectation == nil {
+		mmResolve.defaultExpectation = &DeviceCodeRepositoryMockResolveExpectation{mock: mmResolve.mock}
+	}
+	mmResolve.defaultExpectation.results = &DeviceCodeRepositoryMockResolveResults{err}
+	return mmResolve.mock
+}
+
+// Set uses given function f to mock the DeviceCodeRepository.Resolve method
+func (mmResolve *mDeviceCodeRepositoryMockResolve) Set(f func(ctx context.Context, userCode string, status model.DeviceCodeStatus, userID int64) (err error)) *DeviceCodeRepositoryMock {
+	if mmResolve.defaultExpectation != nil {
+		mmResolve.mock.t.Fatalf("Default expectation is already set for the DeviceCodeRepository.Resolve method")
+	}
+
+	if len(mmResolve.expectations) > 0 {
+		mmResolve.mock.t.Fatalf("Some expectations are already set for the DeviceCodeRepository.Resolve method")
+	}
+
+	mmResolve.mock.funcResolve = f
+	return mmResolve.mock
+}
+
+// When sets expectation for the DeviceCodeRepository.Resolve which will trigger the result defined by the following
+// Then helper
+func (mmResolve *mDeviceCodeRepositoryMockResolve) When(ctx context.Context, userCode string, status model.DeviceCodeStatus, userID int64) *DeviceCodeRepositoryMockResolveExpectation {
+	if mmResolve.mock.funcResolve != nil {
+		mmResolve.mock.t.Fatalf("DeviceCodeRepositoryMock.Resolve mock is already set by Set")
+	}
+
+	expectation := &DeviceCodeRepositoryMockResolveExpectation{
+		mock:   mmResolve.mock,
+		params: &DeviceCodeRepositoryMockResolveParams{ctx, userCode, status, userID},
+	}
+	mmResolve.expectations = append(mmResolve.expectations, expectation)
+	return expectation
+}
+
+// Then sets up DeviceCodeRepository.Resolve return parameters for the expectation previously defined by the When method
+func (e *DeviceCodeRepositoryMockResolveExpectation) Then(err error) *DeviceCodeRepositoryMock {
+	e.results = &DeviceCodeRepositoryMockResolveResults{err}
+	return e.mock
+}
+
+// Resolve implements repository.DeviceCodeRepository
+func (mmResolve *DeviceCodeRepositoryMock) Resolve(ctx context.Context, userCode string, status model.DeviceCodeStatus, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmResolve.beforeResolveCounter, 1)
+	defer mm_atomic.AddUint64(&mmResolve.afterResolveCounter, 1)
+
+	if mmResolve.inspectFuncResolve != nil {
+		mmResolve.inspectFuncResolve(ctx, userCode, status, userID)
+	}
+
+	mm_params := DeviceCodeRepositoryMockResolveParams{ctx, userCode, status, userID}
+
+	// Record call args
+	mmResolve.ResolveMock.mutex.Lock()
+	mmResolve.ResolveMock.callArgs = append(mmResolve.ResolveMock.callArgs, &mm_params)
+	mmResolve.ResolveMock.mutex.Unlock()
+
+	for _, e := range mmResolve.ResolveMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmResolve.ResolveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmResolve.ResolveMock.defaultExpectation.Counter, 1)
+		mm_want := mmResolve.ResolveMock.defaultExpectation.params
+		mm_want_ptrs := mmResolve.ResolveMock.defaultExpectation.paramPtrs
+
+		mm_got := DeviceCodeRepositoryMockResolveParams{ctx, userCode, status, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmResolve.t.Errorf("DeviceCodeRepositoryMock.Resolve got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userCode != nil && !minimock.Equal(*mm_want_ptrs.userCode, mm_got.userCode) {
+				mmResolve.t.Errorf("DeviceCodeRepositoryMock.Resolve got unexpected parameter userCode, want: %#v, got: %#v%s\n", *mm_want_ptrs.userCode, mm_got.userCode, minimock.Diff(*mm_want_ptrs.userCode, mm_got.userCode))
+			}
+
+			if mm_want_ptrs.status != nil && !minimock.Equal(*mm_want_ptrs.status, mm_got.status) {
+				mmResolve.t.Errorf("DeviceCodeRepositoryMock.Resolve got unexpected parameter status, want: %#v, got: %#v%s\n", *mm_want_ptrs.status, mm_got.status, minimock.Diff(*mm_want_ptrs.status, mm_got.status))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmResolve.t.Errorf("DeviceCodeRepositoryMock.Resolve got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmResolve.t.Errorf("DeviceCodeRepositoryMock.Resolve got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmResolve.ResolveMock.defaultExpectation.results
+		if mm_results == nil {
+			mmResolve.t.Fatal("No results are set for the DeviceCodeRepositoryMock.Resolve")
+		}
+		return (*mm_results).err
+	}
+	if mmResolve.funcResolve != nil {
+		return mmResolve.funcResolve(ctx, userCode, status, userID)
+	}
+	mmResolve.t.Fatalf("Unexpected call to DeviceCodeRepositoryMock.Resolve. %v %v %v %v", ctx, userCode, status, userID)
+	return
+}
+
+// ResolveAfterCounter returns a count of finished DeviceCodeRepositoryMock.Resolve invocations
+func (mmResolve *DeviceCodeRepositoryMock) ResolveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResolve.afterResolveCounter)
+}
+
+// ResolveBeforeCounter returns a count of DeviceCodeRepositoryMock.Resolve invocations
+func (mmResolve *DeviceCodeRepositoryMock) ResolveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResolve.beforeResolveCounter)
+}
+
+// Calls returns a list of arguments used in each call to DeviceCodeRepositoryMock.Resolve.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmResolve *mDeviceCodeRepositoryMockResolve) Calls() []*DeviceCodeRepositoryMockResolveParams {
+	mmResolve.mutex.RLock()
+
+	argCopy := make([]*DeviceCodeRepositoryMockResolveParams, len(mmResolve.callArgs))
+	copy(argCopy, mmResolve.callArgs)
+
+	mmResolve.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockResolveDone returns true if the count of the Resolve invocations corresponds
+// the number of defined expectations
+func (m *DeviceCodeRepositoryMock) MinimockResolveDone() bool {
+	for _, e := range m.ResolveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ResolveMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterResolveCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcResolve != nil && mm_atomic.LoadUint64(&m.afterResolveCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockResolveInspect logs each unmet expectation
+func (m *DeviceCodeRepositoryMock) MinimockResolveInspect() {
+	for _, e := range m.ResolveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to DeviceCodeRepositoryMock.Resolve with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ResolveMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterResolveCounter) < 1 {
+		if m.ResolveMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to DeviceCodeRepositoryMock.Resolve")
+		} else {
+			m.t.Errorf("Expected call to DeviceCodeRepositoryMock.Resolve with params: %#v", *m.ResolveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcResolve != nil && mm_atomic.LoadUint64(&m.afterResolveCounter) < 1 {
+		m.t.Error("Expected call to DeviceCodeRepositoryMock.Resolve")
+	}
+}
+
+type mDeviceCodeRepositoryMockUpdatePoll struct {
+	mock               *DeviceCodeRepositoryMock
+	defaultExpectation *DeviceCodeRepositoryMockUpdatePollExpectation
+	expectations       []*DeviceCodeRepositoryMockUpdatePollExpectation
+
+	callArgs []*DeviceCodeRepositoryMockUpdatePollParams
+	mutex    sync.RWMutex
+}
+
+// DeviceCodeRepositoryMockUpdatePollExpectation specifies expectation struct of the DeviceCodeRepository.UpdatePoll
+type DeviceCodeRepositoryMockUpdatePollExpectation struct {
+	mock      *DeviceCodeRepositoryMock
+	params    *DeviceCodeRepositoryMockUpdatePollParams
+	paramPtrs *DeviceCodeRepositoryMockUpdatePollParamPtrs
+	results   *DeviceCodeRepositoryMockUpdatePollResults
+	Counter   uint64
+}
+
+// DeviceCodeRepositoryMockUpdatePollParams contains parameters of the DeviceCodeRepository.UpdatePoll
+type DeviceCodeRepositoryMockUpdatePollParams struct {
+	ctx          context.Context
+	id           int64
+	polledAt     time.Time
+	pollInterval time.Duration
+}
+
+// DeviceCodeRepositoryMockUpdatePollParamPtrs contains pointers to parameters of the DeviceCodeRepository.UpdatePoll
+type DeviceCodeRepositoryMockUpdatePollParamPtrs struct {
+	ctx          *context.Context
+	id           *int64
+	polledAt     *time.Time
+	pollInterval *time.Duration
+}
+
+// DeviceCodeRepositoryMockUpdatePollResults contains results of the DeviceCodeRepository.UpdatePoll
+type DeviceCodeRepositoryMockUpdatePollResults struct {
+	err error
+}
+
+// Expect sets up expected params for DeviceCodeRepository.UpdatePoll
+func (mmUpdatePoll *mDeviceCodeRepositoryMockUpdatePoll) Expect(ctx context.Context, id int64, polledAt time.Time, pollInterval time.Duration) *mDeviceCodeRepositoryMockUpdatePoll {
+	if mmUpdatePoll.mock.funcUpdatePoll != nil {
+		mmUpdatePoll.mock.t.Fatalf("DeviceCodeRepositoryMock.UpdatePoll mock is already set by Set")
+	}
+
+	if mmUpdatePoll.defaultExpectation == nil {
+		mmUpdatePoll.defaultExpectation = &DeviceCodeRepositoryMockUpdatePollExpectation{}
+	}
+
+	if mmUpdatePoll.defaultExpectation.paramPtrs != nil {
+		mmUpdatePoll.mock.t.Fatalf("DeviceCodeRepositoryMock.UpdatePoll mock is already set by ExpectParams functions")
+	}
+
+	mmUpdatePoll.defaultExpectation.params = &DeviceCodeRepositoryMockUpdatePollParams{ctx, id, polledAt, pollInterval}
+	for _, e := range mmUpdatePoll.expectations {
+		if minimock.Equal(e.params, mmUpdatePoll.defaultExpectation.params) {
+			mmUpdatePoll.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdatePoll.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdatePoll
+}
+
+// ExpectCtxParam1 sets up expected param ctx for DeviceCodeRepository.UpdatePoll
+func (mmUpdatePoll *mDeviceCodeRepositoryMockUpdatePoll) ExpectCtxParam1(ctx context.Context) *mDeviceCodeRepositoryMockUpdatePoll {
+	if mmUpdatePoll.mock.funcUpdatePoll != nil {
+		mmUpdatePoll.mock.t.Fatalf("DeviceCodeRepositoryMock.UpdatePoll mock is already set by Set")
+	}
+
+	if mmUpdatePoll.defaultExpectation == nil {
+		mmUpdatePoll.defaultExpectation = &DeviceCodeRepositoryMockUpdatePollExpectation{}
+	}
+
+	if mmUpdatePoll.defaultExpectation.params != nil {
+		mmUpdatePoll.mock.t.Fatalf("DeviceCodeRepositoryMock.UpdatePoll mock is already set by Expect")
+	}
+
+	if mmUpdatePoll.defaultExpectation.paramPtrs == nil {
+		mmUpdatePoll.defaultExpectation.paramPtrs = &DeviceCodeRepositoryMockUpdatePollParamPtrs{}
+	}
+	mmUpdatePoll.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmUpdatePoll
+}
+
+// ExpectIdParam2 sets up expected param id for DeviceCodeRepository.UpdatePoll
+func (mmUpdatePoll *mDeviceCodeRepositoryMockUpdatePoll) ExpectIdParam2(id int64) *mDeviceCodeRepositoryMockUpdatePoll {
+	if mmUpdatePoll.mock.funcUpdatePoll != nil {
+		mmUpdatePoll.mock.t.Fatalf("DeviceCodeRepositoryMock.UpdatePoll mock is already set by Set")
+	}
+
+	if mmUpdatePoll.defaultExpectation == nil {
+		mmUpdatePoll.defaultExpectation = &DeviceCodeRepositoryMockUpdatePollExpectation{}
+	}
+
+	if mmUpdatePoll.defaultExpectation.params != nil {
+		mmUpdatePoll.mock.t.Fatalf("DeviceCodeRepositoryMock.UpdatePoll mock is already set by Expect")
+	}
+
+	if mmUpdatePoll.defaultExpectation.paramPtrs == nil {
+		mmUpdatePoll.defaultExpectation.paramPtrs = &DeviceCodeRepositoryMockUpdatePollParamPtrs{}
+	}
+	mmUpdatePoll.defaultExpectation.paramPtrs.id = &id
+
+	return mmUpdatePoll
+}
+
+// ExpectPolledAtParam3 sets up expected param polledAt for DeviceCodeRepository.UpdatePoll
+func (mmUpdatePoll *mDeviceCodeRepositoryMockUpdatePoll) ExpectPolledAtParam3(polledAt time.Time) *mDeviceCodeRepositoryMockUpdatePoll {
+	if mmUpdatePoll.mock.funcUpdatePoll != nil {
+		mmUpdatePoll.mock.t.Fatalf("DeviceCodeRepositoryMock.UpdatePoll mock is already set by Set")
+	}
+
+	if mmUpdatePoll.defaultExpectation == nil {
+		mmUpdatePoll.defaultExpectation = &DeviceCodeRepositoryMockUpdatePollExpectation{}
+	}
+
+	if mmUpdatePoll.defaultExpectation.params != nil {
+		mmUpdatePoll.mock.t.Fatalf("DeviceCodeRepositoryMock.UpdatePoll mock is already set by Expect")
+	}
+
+	if mmUpdatePoll.defaultExpectation.paramPtrs == nil {
+		mmUpdatePoll.defaultExpectation.paramPtrs = &DeviceCodeRepositoryMockUpdatePollParamPtrs{}
+	}
+	mmUpdatePoll.defaultExpectation.paramPtrs.polledAt = &polledAt
+
+	return mmUpdatePoll
+}
+
+// ExpectPollIntervalParam4 sets up expected param pollInterval for DeviceCodeRepository.UpdatePoll
+func (mmUpdatePoll *mDeviceCodeRepositoryMockUpdatePoll) ExpectPollIntervalParam4(pollInterval time.Duration) *mDeviceCodeRepositoryMockUpdatePoll {
+	if mmUpdatePoll.mock.funcUpdatePoll != nil {
+		mmUpdatePoll.mock.t.Fatalf("DeviceCodeRepositoryMock.UpdatePoll mock is already set by Set")
+	}
+
+	if mmUpdatePoll.defaultExpectation == nil {
+		mmUpdatePoll.defaultExpectation = &DeviceCodeRepositoryMockUpdatePollExpectation{}
+	}
+
+	if mmUpdatePoll.defaultExpectation.params != nil {
+		mmUpdatePoll.mock.t.Fatalf("DeviceCodeRepositoryMock.UpdatePoll mock is already set by Expect")
+	}
+
+	if mmUpdatePoll.defaultExpectation.paramPtrs == nil {
+		mmUpdatePoll.defaultExpectation.paramPtrs = &DeviceCodeRepositoryMockUpdatePollParamPtrs{}
+	}
+	mmUpdatePoll.defaultExpectation.paramPtrs.pollInterval = &pollInterval
+
+	return mmUpdatePoll
+}
+
+// Inspect accepts an inspector function that has same arguments as the DeviceCodeRepository.UpdatePoll
+func (mmUpdatePoll *mDeviceCodeRepositoryMockUpdatePoll) Inspect(f func(ctx context.Context, id int64, polledAt time.Time, pollInterval time.Duration)) *mDeviceCodeRepositoryMockUpdatePoll {
+	if mmUpdatePoll.mock.inspectFuncUpdatePoll != nil {
+		mmUpdatePoll.mock.t.Fatalf("Inspect function is already set for DeviceCodeRepositoryMock.UpdatePoll")
+	}
+
+	mmUpdatePoll.mock.inspectFuncUpdatePoll = f
+
+	return mmUpdatePoll
+}
+
+// Return sets up results that will be returned by DeviceCodeRepository.UpdatePoll
+func (mmUpdatePoll *mDeviceCodeRepositoryMockUpdatePoll) Return(err error) *DeviceCodeRepositoryMock {
+	if mmUpdatePoll.mock.funcUpdatePoll != nil {
+		mmUpdatePoll.mock.t.Fatalf("DeviceCodeRepositoryMock.UpdatePoll mock is already set by Set")
+	}
+
+	if mmUpdatePoll.defaultExpectation == nil {
+		mmUpdatePoll.defaultExpectation = &DeviceCodeRepositoryMockUpdatePollExpectation{mock: mmUpdatePoll.mock}
+	}
+	mmUpdatePoll.defaultExpectation.results = &DeviceCodeRepositoryMockUpdatePollResults{err}
+	return mmUpdatePoll.mock
+}
+
+// Set uses given function f to mock the DeviceCodeRepository.UpdatePoll method
+func (mmUpdatePoll *mDeviceCodeRepositoryMockUpdatePoll) Set(f func(ctx context.Context, id int64, polledAt time.Time, pollInterval time.Duration) (err error)) *DeviceCodeRepositoryMock {
+	if mmUpdatePoll.defaultExpectation != nil {
+		mmUpdatePoll.mock.t.Fatalf("Default expectation is already set for the DeviceCodeRepository.UpdatePoll method")
+	}
+
+	if len(mmUpdatePoll.expectations) > 0 {
+		mmUpdatePoll.mock.t.Fatalf("Some expectations are already set for the DeviceCodeRepository.UpdatePoll method")
+	}
+
+	mmUpdatePoll.mock.funcUpdatePoll = f
+	return mmUpdatePoll.mock
+}
+
+// When sets expectation for the DeviceCodeRepository.UpdatePoll which will trigger the result defined by the following
+// Then helper
+func (mmUpdatePoll *mDeviceCodeRepositoryMockUpdatePoll) When(ctx context.Context, id int64, polledAt time.Time, pollInterval time.Duration) *DeviceCodeRepositoryMockUpdatePollExpectation {
+	if mmUpdatePoll.mock.funcUpdatePoll != nil {
+		mmUpdatePoll.mock.t.Fatalf("DeviceCodeRepositoryMock.UpdatePoll mock is already set by Set")
+	}
+
+	expectation := &DeviceCodeRepositoryMockUpdatePollExpectation{
+		mock:   mmUpdatePoll.mock,
+		params: &DeviceCodeRepositoryMockUpdatePollParams{ctx, id, polledAt, pollInterval},
+	}
+	mmUpdatePoll.expectations = append(mmUpdatePoll.expectations, expectation)
+	return expectation
+}
+
+// Then sets up DeviceCodeRepository.UpdatePoll return parameters for the expectation previously defined by the When method
+func (e *DeviceCodeRepositoryMockUpdatePollExpectation) Then(err error) *DeviceCodeRepositoryMock {
+	e.results = &DeviceCodeRepositoryMockUpdatePollResults{err}
+	return e.mock
+}
+
+// UpdatePoll implements repository.DeviceCodeRepository
+func (mmUpdatePoll *DeviceCodeRepositoryMock) UpdatePoll(ctx context.Context, id int64, polledAt time.Time, pollInterval time.Duration) (err error) {
+	mm_atomic.AddUint64(&mmUpdatePoll.beforeUpdatePollCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdatePoll.afterUpdatePollCounter, 1)
+
+	if mmUpdatePoll.inspectFuncUpdatePoll != nil {
+		mmUpdatePoll.inspectFuncUpdatePoll(ctx, id, polledAt, pollInterval)
+	}
+
+	mm_params := DeviceCodeRepositoryMockUpdatePollParams{ctx, id, polledAt, pollInterval}
+
+	// Record call args
+	mmUpdatePoll.UpdatePollMock.mutex.Lock()
+	mmUpdatePoll.UpdatePollMock.callArgs = append(mmUpdatePoll.UpdatePollMock.callArgs, &mm_params)
+	mmUpdatePoll.UpdatePollMock.mutex.Unlock()
+
+	for _, e := range mmUpdatePoll.UpdatePollMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdatePoll.UpdatePollMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdatePoll.UpdatePollMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdatePoll.UpdatePollMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdatePoll.UpdatePollMock.defaultExpectation.paramPtrs
+
+		mm_got := DeviceCodeRepositoryMockUpdatePollParams{ctx, id, polledAt, pollInterval}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdatePoll.t.Errorf("DeviceCodeRepositoryMock.UpdatePoll got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUpdatePoll.t.Errorf("DeviceCodeRepositoryMock.UpdatePoll got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.polledAt != nil && !minimock.Equal(*mm_want_ptrs.polledAt, mm_got.polledAt) {
+				mmUpdatePoll.t.Errorf("DeviceCodeRepositoryMock.UpdatePoll got unexpected parameter polledAt, want: %#v, got: %#v%s\n", *mm_want_ptrs.polledAt, mm_got.polledAt, minimock.Diff(*mm_want_ptrs.polledAt, mm_got.polledAt))
+			}
+
+			if mm_want_ptrs.pollInterval != nil && !minimock.Equal(*mm_want_ptrs.pollInterval, mm_got.pollInterval) {
+				mmUpdatePoll.t.Errorf("DeviceCodeRepositoryMock.UpdatePoll got unexpected parameter pollInterval, want: %#v, got: %#v%s\n", *mm_want_ptrs.pollInterval, mm_got.pollInterval, minimock.Diff(*mm_want_ptrs.pollInterval, mm_got.pollInterval))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdatePoll.t.Errorf("DeviceCodeRepositoryMock.UpdatePoll got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdatePoll.UpdatePollMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdatePoll.t.Fatal("No results are set for the DeviceCodeRepositoryMock.UpdatePoll")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdatePoll.funcUpdatePoll != nil {
+		return mmUpdatePoll.funcUpdatePoll(ctx, id, polledAt, pollInterval)
+	}
+	mmUpdatePoll.t.Fatalf("Unexpected call to DeviceCodeRepositoryMock.UpdatePoll. %v %v %v %v", ctx, id, polledAt, pollInterval)
+	return
+}
+
+// UpdatePollAfterCounter returns a count of finished DeviceCodeRepositoryMock.UpdatePoll invocations
+func (mmUpdatePoll *DeviceCodeRepositoryMock) UpdatePollAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePoll.afterUpdatePollCounter)
+}
+
+// UpdatePollBeforeCounter returns a count of DeviceCodeRepositoryMock.UpdatePoll invocations
+func (mmUpdatePoll *DeviceCodeRepositoryMock) UpdatePollBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePoll.beforeUpdatePollCounter)
+}
+
+// Calls returns a list of arguments used in each call to DeviceCodeRepositoryMock.UpdatePoll.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdatePoll *mDeviceCodeRepositoryMockUpdatePoll) Calls() []*DeviceCodeRepositoryMockUpdatePollParams {
+	mmUpdatePoll.mutex.RLock()
+
+	argCopy := make([]*DeviceCodeRepositoryMockUpdatePollParams, len(mmUpdatePoll.callArgs))
+	copy(argCopy, mmUpdatePoll.callArgs)
+
+	mmUpdatePoll.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdatePollDone returns true if the count of the UpdatePoll invocations corresponds
+// the number of defined expectations
+func (m *DeviceCodeRepositoryMock) MinimockUpdatePollDone() bool {
+	for _, e := range m.UpdatePollMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdatePollMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdatePollCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdatePoll != nil && mm_atomic.LoadUint64(&m.afterUpdatePollCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockUpdatePollInspect logs each unmet expectation
+func (m *DeviceCodeRepositoryMock) MinimockUpdatePollInspect() {
+	for _, e := range m.UpdatePollMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to DeviceCodeRepositoryMock.UpdatePoll with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdatePollMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdatePollCounter) < 1 {
+		if m.UpdatePollMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to DeviceCodeRepositoryMock.UpdatePoll")
+		} else {
+			m.t.Errorf("Expected call to DeviceCodeRepositoryMock.UpdatePoll with params: %#v", *m.UpdatePollMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdatePoll != nil && mm_atomic.LoadUint64(&m.afterUpdatePollCounter) < 1 {
+		m.t.Error("Expected call to DeviceCodeRepositoryMock.UpdatePoll")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *DeviceCodeRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockDeleteInspect()
+
+			m.MinimockDeleteExpiredInspect()
+
+			m.MinimockGetByDeviceCodeHashInspect()
+
+			m.MinimockResolveInspect()
+
+			m.MinimockUpdatePollInspect()
+			m.t.FailNow()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *DeviceCodeRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *DeviceCodeRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockDeleteDone() &&
+		m.MinimockDeleteExpiredDone() &&
+		m.MinimockGetByDeviceCodeHashDone() &&
+		m.MinimockResolveDone() &&
+		m.MinimockUpdatePollDone()
+}
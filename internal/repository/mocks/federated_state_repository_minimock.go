@@ -0,0 +1,980 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.8). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/arifullov/auth/internal/repository.FederatedStateRepository -o federated_state_repository_minimock.go -n FederatedStateRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// FederatedStateRepositoryMock implements repository.FederatedStateRepository
+type FederatedStateRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcConsume          func(ctx context.Context, id string, now time.Time) (err error)
+	inspectFuncConsume   func(ctx context.Context, id string, now time.Time)
+	afterConsumeCounter  uint64
+	beforeConsumeCounter uint64
+	ConsumeMock          mFederatedStateRepositoryMockConsume
+
+	funcCreate          func(ctx context.Context, state *model.FederatedState) (err error)
+	inspectFuncCreate   func(ctx context.Context, state *model.FederatedState)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mFederatedStateRepositoryMockCreate
+
+	funcDeleteExpired          func(ctx context.Context, now time.Time) (err error)
+	inspectFuncDeleteExpired   func(ctx context.Context, now time.Time)
+	afterDeleteExpiredCounter  uint64
+	beforeDeleteExpiredCounter uint64
+	DeleteExpiredMock          mFederatedStateRepositoryMockDeleteExpired
+}
+
+// NewFederatedStateRepositoryMock returns a mock for repository.FederatedStateRepository
+func NewFederatedStateRepositoryMock(t minimock.Tester) *FederatedStateRepositoryMock {
+	m := &FederatedStateRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ConsumeMock = mFederatedStateRepositoryMockConsume{mock: m}
+	m.ConsumeMock.callArgs = []*FederatedStateRepositoryMockConsumeParams{}
+
+	m.CreateMock = mFederatedStateRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*FederatedStateRepositoryMockCreateParams{}
+
+	m.DeleteExpiredMock = mFederatedStateRepositoryMockDeleteExpired{mock: m}
+	m.DeleteExpiredMock.callArgs = []*FederatedStateRepositoryMockDeleteExpiredParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mFederatedStateRepositoryMockConsume struct {
+	mock               *FederatedStateRepositoryMock
+	defaultExpectation *FederatedStateRepositoryMockConsumeExpectation
+	expectations       []*FederatedStateRepositoryMockConsumeExpectation
+
+	callArgs []*FederatedStateRepositoryMockConsumeParams
+	mutex    sync.RWMutex
+}
+
+// FederatedStateRepositoryMockConsumeExpectation specifies expectation struct of the FederatedStateRepository.Consume
+type FederatedStateRepositoryMockConsumeExpectation struct {
+	mock      *FederatedStateRepositoryMock
+	params    *FederatedStateRepositoryMockConsumeParams
+	paramPtrs *FederatedStateRepositoryMockConsumeParamPtrs
+	results   *FederatedStateRepositoryMockConsumeResults
+	Counter   uint64
+}
+
+// FederatedStateRepositoryMockConsumeParams contains parameters of the FederatedStateRepository.Consume
+type FederatedStateRepositoryMockConsumeParams struct {
+	ctx context.Context
+	id  string
+	now time.Time
+}
+
+// FederatedStateRepositoryMockConsumeParamPtrs contains pointers to parameters of the FederatedStateRepository.Consume
+type FederatedStateRepositoryMockConsumeParamPtrs struct {
+	ctx *context.Context
+	id  *string
+	now *time.Time
+}
+
+// FederatedStateRepositoryMockConsumeResults contains results of the FederatedStateRepository.Consume
+type FederatedStateRepositoryMockConsumeResults struct {
+	err error
+}
+
+// Expect sets up expected params for FederatedStateRepository.Consume
+func (mmConsume *mFederatedStateRepositoryMockConsume) Expect(ctx context.Context, id string, now time.Time) *mFederatedStateRepositoryMockConsume {
+	if mmConsume.mock.funcConsume != nil {
+		mmConsume.mock.t.Fatalf("FederatedStateRepositoryMock.Consume mock is already set by Set")
+	}
+
+	if mmConsume.defaultExpectation == nil {
+		mmConsume.defaultExpectation = &FederatedStateRepositoryMockConsumeExpectation{}
+	}
+
+	if mmConsume.defaultExpectation.paramPtrs != nil {
+		mmConsume.mock.t.Fatalf("FederatedStateRepositoryMock.Consume mock is already set by ExpectParams functions")
+	}
+
+	mmConsume.defaultExpectation.params = &FederatedStateRepositoryMockConsumeParams{ctx, id, now}
+	for _, e := range mmConsume.expectations {
+		if minimock.Equal(e.params, mmConsume.defaultExpectation.params) {
+			mmConsume.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmConsume.defaultExpectation.params)
+		}
+	}
+
+	return mmConsume
+}
+
+// ExpectCtxParam1 sets up expected param ctx for FederatedStateRepository.Consume
+func (mmConsume *mFederatedStateRepositoryMockConsume) ExpectCtxParam1(ctx context.Context) *mFederatedStateRepositoryMockConsume {
+	if mmConsume.mock.funcConsume != nil {
+		mmConsume.mock.t.Fatalf("FederatedStateRepositoryMock.Consume mock is already set by Set")
+	}
+
+	if mmConsume.defaultExpectation == nil {
+		mmConsume.defaultExpectation = &FederatedStateRepositoryMockConsumeExpectation{}
+	}
+
+	if mmConsume.defaultExpectation.params != nil {
+		mmConsume.mock.t.Fatalf("FederatedStateRepositoryMock.Consume mock is already set by Expect")
+	}
+
+	if mmConsume.defaultExpectation.paramPtrs == nil {
+		mmConsume.defaultExpectation.paramPtrs = &FederatedStateRepositoryMockConsumeParamPtrs{}
+	}
+	mmConsume.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmConsume
+}
+
+// ExpectIdParam2 sets up expected param id for FederatedStateRepository.Consume
+func (mmConsume *mFederatedStateRepositoryMockConsume) ExpectIdParam2(id string) *mFederatedStateRepositoryMockConsume {
+	if mmConsume.mock.funcConsume != nil {
+		mmConsume.mock.t.Fatalf("FederatedStateRepositoryMock.Consume mock is already set by Set")
+	}
+
+	if mmConsume.defaultExpectation == nil {
+		mmConsume.defaultExpectation = &FederatedStateRepositoryMockConsumeExpectation{}
+	}
+
+	if mmConsume.defaultExpectation.params != nil {
+		mmConsume.mock.t.Fatalf("FederatedStateRepositoryMock.Consume mock is already set by Expect")
+	}
+
+	if mmConsume.defaultExpectation.paramPtrs == nil {
+		mmConsume.defaultExpectation.paramPtrs = &FederatedStateRepositoryMockConsumeParamPtrs{}
+	}
+	mmConsume.defaultExpectation.paramPtrs.id = &id
+
+	return mmConsume
+}
+
+// ExpectNowParam3 sets up expected param now for FederatedStateRepository.Consume
+func (mmConsume *mFederatedStateRepositoryMockConsume) ExpectNowParam3(now time.Time) *mFederatedStateRepositoryMockConsume {
+	if mmConsume.mock.funcConsume != nil {
+		mmConsume.mock.t.Fatalf("FederatedStateRepositoryMock.Consume mock is already set by Set")
+	}
+
+	if mmConsume.defaultExpectation == nil {
+		mmConsume.defaultExpectation = &FederatedStateRepositoryMockConsumeExpectation{}
+	}
+
+	if mmConsume.defaultExpectation.params != nil {
+		mmConsume.mock.t.Fatalf("FederatedStateRepositoryMock.Consume mock is already set by Expect")
+	}
+
+	if mmConsume.defaultExpectation.paramPtrs == nil {
+		mmConsume.defaultExpectation.paramPtrs = &FederatedStateRepositoryMockConsumeParamPtrs{}
+	}
+	mmConsume.defaultExpectation.paramPtrs.now = &now
+
+	return mmConsume
+}
+
+// Inspect accepts an inspector function that has same arguments as the FederatedStateRepository.Consume
+func (mmConsume *mFederatedStateRepositoryMockConsume) Inspect(f func(ctx context.Context, id string, now time.Time)) *mFederatedStateRepositoryMockConsume {
+	if mmConsume.mock.inspectFuncConsume != nil {
+		mmConsume.mock.t.Fatalf("Inspect function is already set for FederatedStateRepositoryMock.Consume")
+	}
+
+	mmConsume.mock.inspectFuncConsume = f
+
+	return mmConsume
+}
+
+// Return sets up results that will be returned by FederatedStateRepository.Consume
+func (mmConsume *mFederatedStateRepositoryMockConsume) Return(err error) *FederatedStateRepositoryMock {
+	if mmConsume.mock.funcConsume != nil {
+		mmConsume.mock.t.Fatalf("FederatedStateRepositoryMock.Consume mock is already set by Set")
+	}
+
+	if mmConsume.defaultExpectation == nil {
+		mmConsume.defaultExpectation = &FederatedStateRepositoryMockConsumeExpectation{mock: mmConsume.mock}
+	}
+	mmConsume.defaultExpectation.results = &FederatedStateRepositoryMockConsumeResults{err}
+	return mmConsume.mock
+}
+
+// Set uses given function f to mock the FederatedStateRepository.Consume method
+func (mmConsume *mFederatedStateRepositoryMockConsume) Set(f func(ctx context.Context, id string, now time.Time) (err error)) *FederatedStateRepositoryMock {
+	if mmConsume.defaultExpectation != nil {
+		mmConsume.mock.t.Fatalf("Default expectation is already set for the FederatedStateRepository.Consume method")
+	}
+
+	if len(mmConsume.expectations) > 0 {
+		mmConsume.mock.t.Fatalf("Some expectations are already set for the FederatedStateRepository.Consume method")
+	}
+
+	mmConsume.mock.funcConsume = f
+	return mmConsume.mock
+}
+
+// When sets expectation for the FederatedStateRepository.Consume which will trigger the result defined by the following
+// Then helper
+func (mmConsume *mFederatedStateRepositoryMockConsume) When(ctx context.Context, id string, now time.Time) *FederatedStateRepositoryMockConsumeExpectation {
+	if mmConsume.mock.funcConsume != nil {
+		mmConsume.mock.t.Fatalf("FederatedStateRepositoryMock.Consume mock is already set by Set")
+	}
+
+	expectation := &FederatedStateRepositoryMockConsumeExpectation{
+		mock:   mmConsume.mock,
+		params: &FederatedStateRepositoryMockConsumeParams{ctx, id, now},
+	}
+	mmConsume.expectations = append(mmConsume.expectations, expectation)
+	return expectation
+}
+
+// Then sets up FederatedStateRepository.Consume return parameters for the expectation previously defined by the When method
+func (e *FederatedStateRepositoryMockConsumeExpectation) Then(err error) *FederatedStateRepositoryMock {
+	e.results = &FederatedStateRepositoryMockConsumeResults{err}
+	return e.mock
+}
+
+// Consume implements repository.FederatedStateRepository
+func (mmConsume *FederatedStateRepositoryMock) Consume(ctx context.Context, id string, now time.Time) (err error) {
+	mm_atomic.AddUint64(&mmConsume.beforeConsumeCounter, 1)
+	defer mm_atomic.AddUint64(&mmConsume.afterConsumeCounter, 1)
+
+	if mmConsume.inspectFuncConsume != nil {
+		mmConsume.inspectFuncConsume(ctx, id, now)
+	}
+
+	mm_params := FederatedStateRepositoryMockConsumeParams{ctx, id, now}
+
+	// Record call args
+	mmConsume.ConsumeMock.mutex.Lock()
+	mmConsume.ConsumeMock.callArgs = append(mmConsume.ConsumeMock.callArgs, &mm_params)
+	mmConsume.ConsumeMock.mutex.Unlock()
+
+	for _, e := range mmConsume.ConsumeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmConsume.ConsumeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmConsume.ConsumeMock.defaultExpectation.Counter, 1)
+		mm_want := mmConsume.ConsumeMock.defaultExpectation.params
+		mm_want_ptrs := mmConsume.ConsumeMock.defaultExpectation.paramPtrs
+
+		mm_got := FederatedStateRepositoryMockConsumeParams{ctx, id, now}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmConsume.t.Errorf("FederatedStateRepositoryMock.Consume got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmConsume.t.Errorf("FederatedStateRepositoryMock.Consume got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.now != nil && !minimock.Equal(*mm_want_ptrs.now, mm_got.now) {
+				mmConsume.t.Errorf("FederatedStateRepositoryMock.Consume got unexpected parameter now, want: %#v, got: %#v%s\n", *mm_want_ptrs.now, mm_got.now, minimock.Diff(*mm_want_ptrs.now, mm_got.now))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmConsume.t.Errorf("FederatedStateRepositoryMock.Consume got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmConsume.ConsumeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmConsume.t.Fatal("No results are set for the FederatedStateRepositoryMock.Consume")
+		}
+		return (*mm_results).err
+	}
+	if mmConsume.funcConsume != nil {
+		return mmConsume.funcConsume(ctx, id, now)
+	}
+	mmConsume.t.Fatalf("Unexpected call to FederatedStateRepositoryMock.Consume. %v %v %v", ctx, id, now)
+	return
+}
+
+// ConsumeAfterCounter returns a count of finished FederatedStateRepositoryMock.Consume invocations
+func (mmConsume *FederatedStateRepositoryMock) ConsumeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConsume.afterConsumeCounter)
+}
+
+// ConsumeBeforeCounter returns a count of FederatedStateRepositoryMock.Consume invocations
+func (mmConsume *FederatedStateRepositoryMock) ConsumeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConsume.beforeConsumeCounter)
+}
+
+// Calls returns a list of arguments used in each call to FederatedStateRepositoryMock.Consume.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmConsume *mFederatedStateRepositoryMockConsume) Calls() []*FederatedStateRepositoryMockConsumeParams {
+	mmConsume.mutex.RLock()
+
+	argCopy := make([]*FederatedStateRepositoryMockConsumeParams, len(mmConsume.callArgs))
+	copy(argCopy, mmConsume.callArgs)
+
+	mmConsume.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockConsumeDone returns true if the count of the Consume invocations corresponds
+// the number of defined expectations
+func (m *FederatedStateRepositoryMock) MinimockConsumeDone() bool {
+	for _, e := range m.ConsumeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ConsumeMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterConsumeCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcConsume != nil && mm_atomic.LoadUint64(&m.afterConsumeCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockConsumeInspect logs each unmet expectation
+func (m *FederatedStateRepositoryMock) MinimockConsumeInspect() {
+	for _, e := range m.ConsumeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to FederatedStateRepositoryMock.Consume with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ConsumeMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterConsumeCounter) < 1 {
+		if m.ConsumeMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to FederatedStateRepositoryMock.Consume")
+		} else {
+			m.t.Errorf("Expected call to FederatedStateRepositoryMock.Consume with params: %#v", *m.ConsumeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcConsume != nil && mm_atomic.LoadUint64(&m.afterConsumeCounter) < 1 {
+		m.t.Error("Expected call to FederatedStateRepositoryMock.Consume")
+	}
+}
+
+type mFederatedStateRepositoryMockCreate struct {
+	mock               *FederatedStateRepositoryMock
+	defaultExpectation *FederatedStateRepositoryMockCreateExpectation
+	expectations       []*FederatedStateRepositoryMockCreateExpectation
+
+	callArgs []*FederatedStateRepositoryMockCreateParams
+	mutex    sync.RWMutex
+}
+
+// FederatedStateRepositoryMockCreateExpectation specifies expectation struct of the FederatedStateRepository.Create
+type FederatedStateRepositoryMockCreateExpectation struct {
+	mock      *FederatedStateRepositoryMock
+	params    *FederatedStateRepositoryMockCreateParams
+	paramPtrs *FederatedStateRepositoryMockCreateParamPtrs
+	results   *FederatedStateRepositoryMockCreateResults
+	Counter   uint64
+}
+
+// FederatedStateRepositoryMockCreateParams contains parameters of the FederatedStateRepository.Create
+type FederatedStateRepositoryMockCreateParams struct {
+	ctx   context.Context
+	state *model.FederatedState
+}
+
+// FederatedStateRepositoryMockCreateParamPtrs contains pointers to parameters of the FederatedStateRepository.Create
+type FederatedStateRepositoryMockCreateParamPtrs struct {
+	ctx   *context.Context
+	state **model.FederatedState
+}
+
+// FederatedStateRepositoryMockCreateResults contains results of the FederatedStateRepository.Create
+type FederatedStateRepositoryMockCreateResults struct {
+	err error
+}
+
+// Expect sets up expected params for FederatedStateRepository.Create
+func (mmCreate *mFederatedStateRepositoryMockCreate) Expect(ctx context.Context, state *model.FederatedState) *mFederatedStateRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("FederatedStateRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &FederatedStateRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("FederatedStateRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &FederatedStateRepositoryMockCreateParams{ctx, state}
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for FederatedStateRepository.Create
+func (mmCreate *mFederatedStateRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mFederatedStateRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("FederatedStateRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &FederatedStateRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("FederatedStateRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &FederatedStateRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreate
+}
+
+// ExpectStateParam2 sets up expected param state for FederatedStateRepository.Create
+func (mmCreate *mFederatedStateRepositoryMockCreate) ExpectStateParam2(state *model.FederatedState) *mFederatedStateRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("FederatedStateRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &FederatedStateRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("FederatedStateRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &FederatedStateRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.state = &state
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the FederatedStateRepository.Create
+func (mmCreate *mFederatedStateRepositoryMockCreate) Inspect(f func(ctx context.Context, state *model.FederatedState)) *mFederatedStateRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for FederatedStateRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by FederatedStateRepository.Create
+func (mmCreate *mFederatedStateRepositoryMockCreate) Return(err error) *FederatedStateRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("FederatedStateRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &FederatedStateRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &FederatedStateRepositoryMockCreateResults{err}
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the FederatedStateRepository.Create method
+func (mmCreate *mFederatedStateRepositoryMockCreate) Set(f func(ctx context.Context, state *model.FederatedState) (err error)) *FederatedStateRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the FederatedStateRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the FederatedStateRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	return mmCreate.mock
+}
+
+// When sets expectation for the FederatedStateRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mFederatedStateRepositoryMockCreate) When(ctx context.Context, state *model.FederatedState) *FederatedStateRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("FederatedStateRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &FederatedStateRepositoryMockCreateExpectation{
+		mock:   mmCreate.mock,
+		params: &FederatedStateRepositoryMockCreateParams{ctx, state},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up FederatedStateRepository.Create return parameters for the expectation previously defined by the When method
+func (e *FederatedStateRepositoryMockCreateExpectation) Then(err error) *FederatedStateRepositoryMock {
+	e.results = &FederatedStateRepositoryMockCreateResults{err}
+	return e.mock
+}
+
+// Create implements repository.FederatedStateRepository
+func (mmCreate *FederatedStateRepositoryMock) Create(ctx context.Context, state *model.FederatedState) (err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, state)
+	}
+
+	mm_params := FederatedStateRepositoryMockCreateParams{ctx, state}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := FederatedStateRepositoryMockCreateParams{ctx, state}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("FederatedStateRepositoryMock.Create got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.state != nil && !minimock.Equal(*mm_want_ptrs.state, mm_got.state) {
+				mmCreate.t.Errorf("FederatedStateRepositoryMock.Create got unexpected parameter state, want: %#v, got: %#v%s\n", *mm_want_ptrs.state, mm_got.state, minimock.Diff(*mm_want_ptrs.state, mm_got.state))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("FederatedStateRepositoryMock.Create got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the FederatedStateRepositoryMock.Create")
+		}
+		return (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, state)
+	}
+	mmCreate.t.Fatalf("Unexpected call to FederatedStateRepositoryMock.Create. %v %v", ctx, state)
+	return
+}
+
+// CreateAfterCounter returns a count of finished FederatedStateRepositoryMock.Create invocations
+func (mmCreate *FederatedStateRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of FederatedStateRepositoryMock.Create invocations
+func (mmCreate *FederatedStateRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to FederatedStateRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mFederatedStateRepositoryMockCreate) Calls() []*FederatedStateRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*FederatedStateRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *FederatedStateRepositoryMock) MinimockCreateDone() bool {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *FederatedStateRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to FederatedStateRepositoryMock.Create with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to FederatedStateRepositoryMock.Create")
+		} else {
+			m.t.Errorf("Expected call to FederatedStateRepositoryMock.Create with params: %#v", *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		m.t.Error("Expected call to FederatedStateRepositoryMock.Create")
+	}
+}
+
+type mFederatedStateRepositoryMockDeleteExpired struct {
+	mock               *FederatedStateRepositoryMock
+	defaultExpectation *FederatedStateRepositoryMockDeleteExpiredExpectation
+	expectations       []*FederatedStateRepositoryMockDeleteExpiredExpectation
+
+	callArgs []*FederatedStateRepositoryMockDeleteExpiredParams
+	mutex    sync.RWMutex
+}
+
+// FederatedStateRepositoryMockDeleteExpiredExpectation specifies expectation struct of the FederatedStateRepository.DeleteExpired
+type FederatedStateRepositoryMockDeleteExpiredExpectation struct {
+	mock      *FederatedStateRepositoryMock
+	params    *FederatedStateRepositoryMockDeleteExpiredParams
+	paramPtrs *FederatedStateRepositoryMockDeleteExpiredParamPtrs
+	results   *FederatedStateRepositoryMockDeleteExpiredResults
+	Counter   uint64
+}
+
+// FederatedStateRepositoryMockDeleteExpiredParams contains parameters of the FederatedStateRepository.DeleteExpired
+type FederatedStateRepositoryMockDeleteExpiredParams struct {
+	ctx context.Context
+	now time.Time
+}
+
+// FederatedStateRepositoryMockDeleteExpiredParamPtrs contains pointers to parameters of the FederatedStateRepository.DeleteExpired
+type FederatedStateRepositoryMockDeleteExpiredParamPtrs struct {
+	ctx *context.Context
+	now *time.Time
+}
+
+// FederatedStateRepositoryMockDeleteExpiredResults contains results of the FederatedStateRepository.DeleteExpired
+type FederatedStateRepositoryMockDeleteExpiredResults struct {
+	err error
+}
+
+// Expect sets up expected params for FederatedStateRepository.DeleteExpired
+func (mmDeleteExpired *mFederatedStateRepositoryMockDeleteExpired) Expect(ctx context.Context, now time.Time) *mFederatedStateRepositoryMockDeleteExpired {
+	if mmDeleteExpired.mock.funcDeleteExpired != nil {
+		mmDeleteExpired.mock.t.Fatalf("FederatedStateRepositoryMock.DeleteExpired mock is already set by Set")
+	}
+
+	if mmDeleteExpired.defaultExpectation == nil {
+		mmDeleteExpired.defaultExpectation = &FederatedStateRepositoryMockDeleteExpiredExpectation{}
+	}
+
+	if mmDeleteExpired.defaultExpectation.paramPtrs != nil {
+		mmDeleteExpired.mock.t.Fatalf("FederatedStateRepositoryMock.DeleteExpired mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteExpired.defaultExpectation.params = &FederatedStateRepositoryMockDeleteExpiredParams{ctx, now}
+	for _, e := range mmDeleteExpired.expectations {
+		if minimock.Equal(e.params, mmDeleteExpired.defaultExpectation.params) {
+			mmDeleteExpired.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteExpired.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteExpired
+}
+
+// ExpectCtxParam1 sets up expected param ctx for FederatedStateRepository.DeleteExpired
+func (mmDeleteExpired *mFederatedStateRepositoryMockDeleteExpired) ExpectCtxParam1(ctx context.Context) *mFederatedStateRepositoryMockDeleteExpired {
+	if mmDeleteExpired.mock.funcDeleteExpired != nil {
+		mmDeleteExpired.mock.t.Fatalf("FederatedStateRepositoryMock.DeleteExpired mock is already set by Set")
+	}
+
+	if mmDeleteExpired.defaultExpectation == nil {
+		mmDeleteExpired.defaultExpectation = &FederatedStateRepositoryMockDeleteExpiredExpectation{}
+	}
+
+	if mmDeleteExpired.defaultExpectation.params != nil {
+		mmDeleteExpired.mock.t.Fatalf("FederatedStateRepositoryMock.DeleteExpired mock is already set by Expect")
+	}
+
+	if mmDeleteExpired.defaultExpectation.paramPtrs == nil {
+		mmDeleteExpired.defaultExpectation.paramPtrs = &FederatedStateRepositoryMockDeleteExpiredParamPtrs{}
+	}
+	mmDeleteExpired.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeleteExpired
+}
+
+// ExpectNowParam2 sets up expected param now for FederatedStateRepository.DeleteExpired
+func (mmDeleteExpired *mFederatedStateRepositoryMockDeleteExpired) ExpectNowParam2(now time.Time) *mFederatedStateRepositoryMockDeleteExpired {
+	if mmDeleteExpired.mock.funcDeleteExpired != nil {
+		mmDeleteExpired.mock.t.Fatalf("FederatedStateRepositoryMock.DeleteExpired mock is already set by Set")
+	}
+
+	if mmDeleteExpired.defaultExpectation == nil {
+		mmDeleteExpired.defaultExpectation = &FederatedStateRepositoryMockDeleteExpiredExpectation{}
+	}
+
+	if mmDeleteExpired.defaultExpectation.params != nil {
+		mmDeleteExpired.mock.t.Fatalf("FederatedStateRepositoryMock.DeleteExpired mock is already set by Expect")
+	}
+
+	if mmDeleteExpired.defaultExpectation.paramPtrs == nil {
+		mmDeleteExpired.defaultExpectation.paramPtrs = &FederatedStateRepositoryMockDeleteExpiredParamPtrs{}
+	}
+	mmDeleteExpired.defaultExpectation.paramPtrs.now = &now
+
+	return mmDeleteExpired
+}
+
+// Inspect accepts an inspector function that has same arguments as the FederatedStateRepository.DeleteExpired
+func (mmDeleteExpired *mFederatedStateRepositoryMockDeleteExpired) Inspect(f func(ctx context.Context, now time.Time)) *mFederatedStateRepositoryMockDeleteExpired {
+	if mmDeleteExpired.mock.inspectFuncDeleteExpired != nil {
+		mmDeleteExpired.mock.t.Fatalf("Inspect function is already set for FederatedStateRepositoryMock.DeleteExpired")
+	}
+
+	mmDeleteExpired.mock.inspectFuncDeleteExpired = f
+
+	return mmDeleteExpired
+}
+
+// Return sets up results that will be returned by FederatedStateRepository.DeleteExpired
+func (mmDeleteExpired *mFederatedStateRepositoryMockDeleteExpired) Return(err error) *FederatedStateRepositoryMock {
+	if mmDeleteExpired.mock.funcDeleteExpired != nil {
+		mmDeleteExpired.mock.t.Fatalf("FederatedStateRepositoryMock.DeleteExpired mock is already set by Set")
+	}
+
+	if mmDeleteExpired.defaultExpectation == nil {
+		mmDeleteExpired.defaultExpectation = &FederatedStateRepositoryMockDeleteExpiredExpectation{mock: mmDeleteExpired.mock}
+	}
+	mmDeleteExpired.defaultExpectation.results = &FederatedStateRepositoryMockDeleteExpiredResults{err}
+	return mmDeleteExpired.mock
+}
+
+// Set uses given function f to mock the FederatedStateRepository.DeleteExpired method
+func (mmDeleteExpired *mFederatedStateRepositoryMockDeleteExpired) Set(f func(ctx context.Context, now time.Time) (err error)) *FederatedStateRepositoryMock {
+	if mmDeleteExpired.defaultExpectation != nil {
+		mmDeleteExpired.mock.t.Fatalf("Default expectation is already set for the FederatedStateRepository.DeleteExpired method")
+	}
+
+	if len(mmDeleteExpired.expectations) > 0 {
+		mmDeleteExpired.mock.t.Fatalf("Some expectations are already set for the FederatedStateRepository.DeleteExpired method")
+	}
+
+	mmDeleteExpired.mock.funcDeleteExpired = f
+	return mmDeleteExpired.mock
+}
+
+// When sets expectation for the FederatedStateRepository.DeleteExpired which will trigger the result defined by the following
+// Then helper
+func (mmDeleteExpired *mFederatedStateRepositoryMockDeleteExpired) When(ctx context.Context, now time.Time) *FederatedStateRepositoryMockDeleteExpiredExpectation {
+	if mmDeleteExpired.mock.funcDeleteExpired != nil {
+		mmDeleteExpired.mock.t.Fatalf("FederatedStateRepositoryMock.DeleteExpired mock is already set by Set")
+	}
+
+	expectation := &FederatedStateRepositoryMockDeleteExpiredExpectation{
+		mock:   mmDeleteExpired.mock,
+		params: &FederatedStateRepositoryMockDeleteExpiredParams{ctx, now},
+	}
+	mmDeleteExpired.expectations = append(mmDeleteExpired.expectations, expectation)
+	return expectation
+}
+
+// Then sets up FederatedStateRepository.DeleteExpired return parameters for the expectation previously defined by the When method
+func (e *FederatedStateRepositoryMockDeleteExpiredExpectation) Then(err error) *FederatedStateRepositoryMock {
+	e.results = &FederatedStateRepositoryMockDeleteExpiredResults{err}
+	return e.mock
+}
+
+// DeleteExpired implements repository.FederatedStateRepository
+func (mmDeleteExpired *FederatedStateRepositoryMock) DeleteExpired(ctx context.Context, now time.Time) (err error) {
+	mm_atomic.AddUint64(&mmDeleteExpired.beforeDeleteExpiredCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteExpired.afterDeleteExpiredCounter, 1)
+
+	if mmDeleteExpired.inspectFuncDeleteExpired != nil {
+		mmDeleteExpired.inspectFuncDeleteExpired(ctx, now)
+	}
+
+	mm_params := FederatedStateRepositoryMockDeleteExpiredParams{ctx, now}
+
+	// Record call args
+	mmDeleteExpired.DeleteExpiredMock.mutex.Lock()
+	mmDeleteExpired.DeleteExpiredMock.callArgs = append(mmDeleteExpired.DeleteExpiredMock.callArgs, &mm_params)
+	mmDeleteExpired.DeleteExpiredMock.mutex.Unlock()
+
+	for _, e := range mmDeleteExpired.DeleteExpiredMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteExpired.DeleteExpiredMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteExpired.DeleteExpiredMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteExpired.DeleteExpiredMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteExpired.DeleteExpiredMock.defaultExpectation.paramPtrs
+
+		mm_got := FederatedStateRepositoryMockDeleteExpiredParams{ctx, now}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteExpired.t.Errorf("FederatedStateRepositoryMock.DeleteExpired got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.now != nil && !minimock.Equal(*mm_want_ptrs.now, mm_got.now) {
+				mmDeleteExpired.t.Errorf("FederatedStateRepositoryMock.DeleteExpired got unexpected parameter now, want: %#v, got: %#v%s\n", *mm_want_ptrs.now, mm_got.now, minimock.Diff(*mm_want_ptrs.now, mm_got.now))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteExpired.t.Errorf("FederatedStateRepositoryMock.DeleteExpired got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteExpired.DeleteExpiredMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteExpired.t.Fatal("No results are set for the FederatedStateRepositoryMock.DeleteExpired")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteExpired.funcDeleteExpired != nil {
+		return mmDeleteExpired.funcDeleteExpired(ctx, now)
+	}
+	mmDeleteExpired.t.Fatalf("Unexpected call to FederatedStateRepositoryMock.DeleteExpired. %v %v", ctx, now)
+	return
+}
+
+// DeleteExpiredAfterCounter returns a count of finished FederatedStateRepositoryMock.DeleteExpired invocations
+func (mmDeleteExpired *FederatedStateRepositoryMock) DeleteExpiredAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteExpired.afterDeleteExpiredCounter)
+}
+
+// DeleteExpiredBeforeCounter returns a count of FederatedStateRepositoryMock.DeleteExpired invocations
+func (mmDeleteExpired *FederatedStateRepositoryMock) DeleteExpiredBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteExpired.beforeDeleteExpiredCounter)
+}
+
+// Calls returns a list of arguments used in each call to FederatedStateRepositoryMock.DeleteExpired.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteExpired *mFederatedStateRepositoryMockDeleteExpired) Calls() []*FederatedStateRepositoryMockDeleteExpiredParams {
+	mmDeleteExpired.mutex.RLock()
+
+	argCopy := make([]*FederatedStateRepositoryMockDeleteExpiredParams, len(mmDeleteExpired.callArgs))
+	copy(argCopy, mmDeleteExpired.callArgs)
+
+	mmDeleteExpired.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteExpiredDone returns true if the count of the DeleteExpired invocations corresponds
+// the number of defined expectations
+func (m *FederatedStateRepositoryMock) MinimockDeleteExpiredDone() bool {
+	for _, e := range m.DeleteExpiredMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteExpiredMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteExpiredCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteExpired != nil && mm_atomic.LoadUint64(&m.afterDeleteExpiredCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockDeleteExpiredInspect logs each unmet expectation
+func (m *FederatedStateRepositoryMock) MinimockDeleteExpiredInspect() {
+	for _, e := range m.DeleteExpiredMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to FederatedStateRepositoryMock.DeleteExpired with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteExpiredMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteExpiredCounter) < 1 {
+		if m.DeleteExpiredMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to FederatedStateRepositoryMock.DeleteExpired")
+		} else {
+			m.t.Errorf("Expected call to FederatedStateRepositoryMock.DeleteExpired with params: %#v", *m.DeleteExpiredMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteExpired != nil && mm_atomic.LoadUint64(&m.afterDeleteExpiredCounter) < 1 {
+		m.t.Error("Expected call to FederatedStateRepositoryMock.DeleteExpired")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *FederatedStateRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockConsumeInspect()
+
+			m.MinimockCreateInspect()
+
+			m.MinimockDeleteExpiredInspect()
+			m.t.FailNow()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *FederatedStateRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *FederatedStateRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockConsumeDone() &&
+		m.MinimockCreateDone() &&
+		m.MinimockDeleteExpiredDone()
+}
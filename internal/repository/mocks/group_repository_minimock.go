@@ -0,0 +1,3816 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.8). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/arifullov/auth/internal/repository.GroupRepository -o group_repository_minimock.go -n GroupRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// GroupRepositoryMock implements repository.GroupRepository
+type GroupRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAddMember          func(ctx context.Context, groupID int64, userID int64) (err error)
+	inspectFuncAddMember   func(ctx context.Context, groupID int64, userID int64)
+	afterAddMemberCounter  uint64
+	beforeAddMemberCounter uint64
+	AddMemberMock          mGroupRepositoryMockAddMember
+
+	funcAddParent          func(ctx context.Context, groupID int64, parentID int64) (err error)
+	inspectFuncAddParent   func(ctx context.Context, groupID int64, parentID int64)
+	afterAddParentCounter  uint64
+	beforeAddParentCounter uint64
+	AddParentMock          mGroupRepositoryMockAddParent
+
+	funcCreate          func(ctx context.Context, group *model.CreateGroup) (i1 int64, err error)
+	inspectFuncCreate   func(ctx context.Context, group *model.CreateGroup)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mGroupRepositoryMockCreate
+
+	funcDelete          func(ctx context.Context, id int64) (err error)
+	inspectFuncDelete   func(ctx context.Context, id int64)
+	afterDeleteCounter  uint64
+	beforeDeleteCounter uint64
+	DeleteMock          mGroupRepositoryMockDelete
+
+	funcGet          func(ctx context.Context, id int64) (gp1 *model.Group, err error)
+	inspectFuncGet   func(ctx context.Context, id int64)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mGroupRepositoryMockGet
+
+	funcGetRoleGraph          func(ctx context.Context, userID int64, tenant string) (rp1 *model.RoleGraph, err error)
+	inspectFuncGetRoleGraph   func(ctx context.Context, userID int64, tenant string)
+	afterGetRoleGraphCounter  uint64
+	beforeGetRoleGraphCounter uint64
+	GetRoleGraphMock          mGroupRepositoryMockGetRoleGraph
+
+	funcIsMember          func(ctx context.Context, name string, userID int64) (b1 bool, err error)
+	inspectFuncIsMember   func(ctx context.Context, name string, userID int64)
+	afterIsMemberCounter  uint64
+	beforeIsMemberCounter uint64
+	IsMemberMock          mGroupRepositoryMockIsMember
+
+	funcLockParents          func(ctx context.Context) (err error)
+	inspectFuncLockParents   func(ctx context.Context)
+	afterLockParentsCounter  uint64
+	beforeLockParentsCounter uint64
+	LockParentsMock          mGroupRepositoryMockLockParents
+
+	funcRemoveMember          func(ctx context.Context, groupID int64, userID int64) (err error)
+	inspectFuncRemoveMember   func(ctx context.Context, groupID int64, userID int64)
+	afterRemoveMemberCounter  uint64
+	beforeRemoveMemberCounter uint64
+	RemoveMemberMock          mGroupRepositoryMockRemoveMember
+
+	funcRemoveParent          func(ctx context.Context, groupID int64, parentID int64) (err error)
+	inspectFuncRemoveParent   func(ctx context.Context, groupID int64, parentID int64)
+	afterRemoveParentCounter  uint64
+	beforeRemoveParentCounter uint64
+	RemoveParentMock          mGroupRepositoryMockRemoveParent
+
+	funcRevokeMemberTokens          func(ctx context.Context, id int64, revokedAt time.Time) (err error)
+	inspectFuncRevokeMemberTokens   func(ctx context.Context, id int64, revokedAt time.Time)
+	afterRevokeMemberTokensCounter  uint64
+	beforeRevokeMemberTokensCounter uint64
+	RevokeMemberTokensMock          mGroupRepositoryMockRevokeMemberTokens
+
+	funcSetRoles          func(ctx context.Context, id int64, roles []model.Role) (err error)
+	inspectFuncSetRoles   func(ctx context.Context, id int64, roles []model.Role)
+	afterSetRolesCounter  uint64
+	beforeSetRolesCounter uint64
+	SetRolesMock          mGroupRepositoryMockSetRoles
+}
+
+// NewGroupRepositoryMock returns a mock for repository.GroupRepository
+func NewGroupRepositoryMock(t minimock.Tester) *GroupRepositoryMock {
+	m := &GroupRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddMemberMock = mGroupRepositoryMockAddMember{mock: m}
+	m.AddMemberMock.callArgs = []*GroupRepositoryMockAddMemberParams{}
+
+	m.AddParentMock = mGroupRepositoryMockAddParent{mock: m}
+	m.AddParentMock.callArgs = []*GroupRepositoryMockAddParentParams{}
+
+	m.CreateMock = mGroupRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*GroupRepositoryMockCreateParams{}
+
+	m.DeleteMock = mGroupRepositoryMockDelete{mock: m}
+	m.DeleteMock.callArgs = []*GroupRepositoryMockDeleteParams{}
+
+	m.GetMock = mGroupRepositoryMockGet{mock: m}
+	m.GetMock.callArgs = []*GroupRepositoryMockGetParams{}
+
+	m.GetRoleGraphMock = mGroupRepositoryMockGetRoleGraph{mock: m}
+	m.GetRoleGraphMock.callArgs = []*GroupRepositoryMockGetRoleGraphParams{}
+
+	m.IsMemberMock = mGroupRepositoryMockIsMember{mock: m}
+	m.IsMemberMock.callArgs = []*GroupRepositoryMockIsMemberParams{}
+
+	m.LockParentsMock = mGroupRepositoryMockLockParents{mock: m}
+	m.LockParentsMock.callArgs = []*GroupRepositoryMockLockParentsParams{}
+
+	m.RemoveMemberMock = mGroupRepositoryMockRemoveMember{mock: m}
+	m.RemoveMemberMock.callArgs = []*GroupRepositoryMockRemoveMemberParams{}
+
+	m.RemoveParentMock = mGroupRepositoryMockRemoveParent{mock: m}
+	m.RemoveParentMock.callArgs = []*GroupRepositoryMockRemoveParentParams{}
+
+	m.RevokeMemberTokensMock = mGroupRepositoryMockRevokeMemberTokens{mock: m}
+	m.RevokeMemberTokensMock.callArgs = []*GroupRepositoryMockRevokeMemberTokensParams{}
+
+	m.SetRolesMock = mGroupRepositoryMockSetRoles{mock: m}
+	m.SetRolesMock.callArgs = []*GroupRepositoryMockSetRolesParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mGroupRepositoryMockAddMember struct {
+	mock               *GroupRepositoryMock
+	defaultExpectation *GroupRepositoryMockAddMemberExpectation
+	expectations       []*GroupRepositoryMockAddMemberExpectation
+
+	callArgs []*GroupRepositoryMockAddMemberParams
+	mutex    sync.RWMutex
+}
+
+// GroupRepositoryMockAddMemberExpectation specifies expectation struct of the GroupRepository.AddMember
+type GroupRepositoryMockAddMemberExpectation struct {
+	mock      *GroupRepositoryMock
+	params    *GroupRepositoryMockAddMemberParams
+	paramPtrs *GroupRepositoryMockAddMemberParamPtrs
+	results   *GroupRepositoryMockAddMemberResults
+	Counter   uint64
+}
+
+// GroupRepositoryMockAddMemberParams contains parameters of the GroupRepository.AddMember
+type GroupRepositoryMockAddMemberParams struct {
+	ctx     context.Context
+	groupID int64
+	userID  int64
+}
+
+// GroupRepositoryMockAddMemberParamPtrs contains pointers to parameters of the GroupRepository.AddMember
+type GroupRepositoryMockAddMemberParamPtrs struct {
+	ctx     *context.Context
+	groupID *int64
+	userID  *int64
+}
+
+// GroupRepositoryMockAddMemberResults contains results of the GroupRepository.AddMember
+type GroupRepositoryMockAddMemberResults struct {
+	err error
+}
+
+// Expect sets up expected params for GroupRepository.AddMember
+func (mmAddMember *mGroupRepositoryMockAddMember) Expect(ctx context.Context, groupID int64, userID int64) *mGroupRepositoryMockAddMember {
+	if mmAddMember.mock.funcAddMember != nil {
+		mmAddMember.mock.t.Fatalf("GroupRepositoryMock.AddMember mock is already set by Set")
+	}
+
+	if mmAddMember.defaultExpectation == nil {
+		mmAddMember.defaultExpectation = &GroupRepositoryMockAddMemberExpectation{}
+	}
+
+	if mmAddMember.defaultExpectation.paramPtrs != nil {
+		mmAddMember.mock.t.Fatalf("GroupRepositoryMock.AddMember mock is already set by ExpectParams functions")
+	}
+
+	mmAddMember.defaultExpectation.params = &GroupRepositoryMockAddMemberParams{ctx, groupID, userID}
+	for _, e := range mmAddMember.expectations {
+		if minimock.Equal(e.params, mmAddMember.defaultExpectation.params) {
+			mmAddMember.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddMember.defaultExpectation.params)
+		}
+	}
+
+	return mmAddMember
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GroupRepository.AddMember
+func (mmAddMember *mGroupRepositoryMockAddMember) ExpectCtxParam1(ctx context.Context) *mGroupRepositoryMockAddMember {
+	if mmAddMember.mock.funcAddMember != nil {
+		mmAddMember.mock.t.Fatalf("GroupRepositoryMock.AddMember mock is already set by Set")
+	}
+
+	if mmAddMember.defaultExpectation == nil {
+		mmAddMember.defaultExpectation = &GroupRepositoryMockAddMemberExpectation{}
+	}
+
+	if mmAddMember.defaultExpectation.params != nil {
+		mmAddMember.mock.t.Fatalf("GroupRepositoryMock.AddMember mock is already set by Expect")
+	}
+
+	if mmAddMember.defaultExpectation.paramPtrs == nil {
+		mmAddMember.defaultExpectation.paramPtrs = &GroupRepositoryMockAddMemberParamPtrs{}
+	}
+	mmAddMember.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmAddMember
+}
+
+// ExpectGroupIDParam2 sets up expected param groupID for GroupRepository.AddMember
+func (mmAddMember *mGroupRepositoryMockAddMember) ExpectGroupIDParam2(groupID int64) *mGroupRepositoryMockAddMember {
+	if mmAddMember.mock.funcAddMember != nil {
+		mmAddMember.mock.t.Fatalf("GroupRepositoryMock.AddMember mock is already set by Set")
+	}
+
+	if mmAddMember.defaultExpectation == nil {
+		mmAddMember.defaultExpectation = &GroupRepositoryMockAddMemberExpectation{}
+	}
+
+	if mmAddMember.defaultExpectation.params != nil {
+		mmAddMember.mock.t.Fatalf("GroupRepositoryMock.AddMember mock is already set by Expect")
+	}
+
+	if mmAddMember.defaultExpectation.paramPtrs == nil {
+		mmAddMember.defaultExpectation.paramPtrs = &GroupRepositoryMockAddMemberParamPtrs{}
+	}
+	mmAddMember.defaultExpectation.paramPtrs.groupID = &groupID
+
+	return mmAddMember
+}
+
+// ExpectUserIDParam3 sets up expected param userID for GroupRepository.AddMember
+func (mmAddMember *mGroupRepositoryMockAddMember) ExpectUserIDParam3(userID int64) *mGroupRepositoryMockAddMember {
+	if mmAddMember.mock.funcAddMember != nil {
+		mmAddMember.mock.t.Fatalf("GroupRepositoryMock.AddMember mock is already set by Set")
+	}
+
+	if mmAddMember.defaultExpectation == nil {
+		mmAddMember.defaultExpectation = &GroupRepositoryMockAddMemberExpectation{}
+	}
+
+	if mmAddMember.defaultExpectation.params != nil {
+		mmAddMember.mock.t.Fatalf("GroupRepositoryMock.AddMember mock is already set by Expect")
+	}
+
+	if mmAddMember.defaultExpectation.paramPtrs == nil {
+		mmAddMember.defaultExpectation.paramPtrs = &GroupRepositoryMockAddMemberParamPtrs{}
+	}
+	mmAddMember.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmAddMember
+}
+
+// Inspect accepts an inspector function that has same arguments as the GroupRepository.AddMember
+func (mmAddMember *mGroupRepositoryMockAddMember) Inspect(f func(ctx context.Context, groupID int64, userID int64)) *mGroupRepositoryMockAddMember {
+	if mmAddMember.mock.inspectFuncAddMember != nil {
+		mmAddMember.mock.t.Fatalf("Inspect function is already set for GroupRepositoryMock.AddMember")
+	}
+
+	mmAddMember.mock.inspectFuncAddMember = f
+
+	return mmAddMember
+}
+
+// Return sets up results that will be returned by GroupRepository.AddMember
+func (mmAddMember *mGroupRepositoryMockAddMember) Return(err error) *GroupRepositoryMock {
+	if mmAddMember.mock.funcAddMember != nil {
+		mmAddMember.mock.t.Fatalf("GroupRepositoryMock.AddMember mock is already set by Set")
+	}
+
+	if mmAddMember.defaultExpectation == nil {
+		mmAddMember.defaultExpectation = &GroupRepositoryMockAddMemberExpectation{mock: mmAddMember.mock}
+	}
+	mmAddMember.defaultExpectation.results = &GroupRepositoryMockAddMemberResults{err}
+	return mmAddMember.mock
+}
+
+// Set uses given function f to mock the GroupRepository.AddMember method
+func (mmAddMember *mGroupRepositoryMockAddMember) Set(f func(ctx context.Context, groupID int64, userID int64) (err error)) *GroupRepositoryMock {
+	if mmAddMember.defaultExpectation != nil {
+		mmAddMember.mock.t.Fatalf("Default expectation is already set for the GroupRepository.AddMember method")
+	}
+
+	if len(mmAddMember.expectations) > 0 {
+		mmAddMember.mock.t.Fatalf("Some expectations are already set for the GroupRepository.AddMember method")
+	}
+
+	mmAddMember.mock.funcAddMember = f
+	return mmAddMember.mock
+}
+
+// When sets expectation for the GroupRepository.AddMember which will trigger the result defined by the following
+// Then helper
+func (mmAddMember *mGroupRepositoryMockAddMember) When(ctx context.Context, groupID int64, userID int64) *GroupRepositoryMockAddMemberExpectation {
+	if mmAddMember.mock.funcAddMember != nil {
+		mmAddMember.mock.t.Fatalf("GroupRepositoryMock.AddMember mock is already set by Set")
+	}
+
+	expectation := &GroupRepositoryMockAddMemberExpectation{
+		mock:   mmAddMember.mock,
+		params: &GroupRepositoryMockAddMemberParams{ctx, groupID, userID},
+	}
+	mmAddMember.expectations = append(mmAddMember.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GroupRepository.AddMember return parameters for the expectation previously defined by the When method
+func (e *GroupRepositoryMockAddMemberExpectation) Then(err error) *GroupRepositoryMock {
+	e.results = &GroupRepositoryMockAddMemberResults{err}
+	return e.mock
+}
+
+// AddMember implements repository.GroupRepository
+func (mmAddMember *GroupRepositoryMock) AddMember(ctx context.Context, groupID int64, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmAddMember.beforeAddMemberCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddMember.afterAddMemberCounter, 1)
+
+	if mmAddMember.inspectFuncAddMember != nil {
+		mmAddMember.inspectFuncAddMember(ctx, groupID, userID)
+	}
+
+	mm_params := GroupRepositoryMockAddMemberParams{ctx, groupID, userID}
+
+	// Record call args
+	mmAddMember.AddMemberMock.mutex.Lock()
+	mmAddMember.AddMemberMock.callArgs = append(mmAddMember.AddMemberMock.callArgs, &mm_params)
+	mmAddMember.AddMemberMock.mutex.Unlock()
+
+	for _, e := range mmAddMember.AddMemberMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddMember.AddMemberMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddMember.AddMemberMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddMember.AddMemberMock.defaultExpectation.params
+		mm_want_ptrs := mmAddMember.AddMemberMock.defaultExpectation.paramPtrs
+
+		mm_got := GroupRepositoryMockAddMemberParams{ctx, groupID, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddMember.t.Errorf("GroupRepositoryMock.AddMember got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.groupID != nil && !minimock.Equal(*mm_want_ptrs.groupID, mm_got.groupID) {
+				mmAddMember.t.Errorf("GroupRepositoryMock.AddMember got unexpected parameter groupID, want: %#v, got: %#v%s\n", *mm_want_ptrs.groupID, mm_got.groupID, minimock.Diff(*mm_want_ptrs.groupID, mm_got.groupID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmAddMember.t.Errorf("GroupRepositoryMock.AddMember got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddMember.t.Errorf("GroupRepositoryMock.AddMember got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddMember.AddMemberMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddMember.t.Fatal("No results are set for the GroupRepositoryMock.AddMember")
+		}
+		return (*mm_results).err
+	}
+	if mmAddMember.funcAddMember != nil {
+		return mmAddMember.funcAddMember(ctx, groupID, userID)
+	}
+	mmAddMember.t.Fatalf("Unexpected call to GroupRepositoryMock.AddMember. %v %v %v", ctx, groupID, userID)
+	return
+}
+
+// AddMemberAfterCounter returns a count of finished GroupRepositoryMock.AddMember invocations
+func (mmAddMember *GroupRepositoryMock) AddMemberAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddMember.afterAddMemberCounter)
+}
+
+// AddMemberBeforeCounter returns a count of GroupRepositoryMock.AddMember invocations
+func (mmAddMember *GroupRepositoryMock) AddMemberBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddMember.beforeAddMemberCounter)
+}
+
+// Calls returns a list of arguments used in each call to GroupRepositoryMock.AddMember.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddMember *mGroupRepositoryMockAddMember) Calls() []*GroupRepositoryMockAddMemberParams {
+	mmAddMember.mutex.RLock()
+
+	argCopy := make([]*GroupRepositoryMockAddMemberParams, len(mmAddMember.callArgs))
+	copy(argCopy, mmAddMember.callArgs)
+
+	mmAddMember.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddMemberDone returns true if the count of the AddMember invocations corresponds
+// the number of defined expectations
+func (m *GroupRepositoryMock) MinimockAddMemberDone() bool {
+	for _, e := range m.AddMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddMemberMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterAddMemberCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddMember != nil && mm_atomic.LoadUint64(&m.afterAddMemberCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockAddMemberInspect logs each unmet expectation
+func (m *GroupRepositoryMock) MinimockAddMemberInspect() {
+	for _, e := range m.AddMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GroupRepositoryMock.AddMember with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddMemberMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterAddMemberCounter) < 1 {
+		if m.AddMemberMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GroupRepositoryMock.AddMember")
+		} else {
+			m.t.Errorf("Expected call to GroupRepositoryMock.AddMember with params: %#v", *m.AddMemberMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddMember != nil && mm_atomic.LoadUint64(&m.afterAddMemberCounter) < 1 {
+		m.t.Error("Expected call to GroupRepositoryMock.AddMember")
+	}
+}
+
+type mGroupRepositoryMockAddParent struct {
+	mock               *GroupRepositoryMock
+	defaultExpectation *GroupRepositoryMockAddParentExpectation
+	expectations       []*GroupRepositoryMockAddParentExpectation
+
+	callArgs []*GroupRepositoryMockAddParentParams
+	mutex    sync.RWMutex
+}
+
+// GroupRepositoryMockAddParentExpectation specifies expectation struct of the GroupRepository.AddParent
+type GroupRepositoryMockAddParentExpectation struct {
+	mock      *GroupRepositoryMock
+	params    *GroupRepositoryMockAddParentParams
+	paramPtrs *GroupRepositoryMockAddParentParamPtrs
+	results   *GroupRepositoryMockAddParentResults
+	Counter   uint64
+}
+
+// GroupRepositoryMockAddParentParams contains parameters of the GroupRepository.AddParent
+type GroupRepositoryMockAddParentParams struct {
+	ctx      context.Context
+	groupID  int64
+	parentID int64
+}
+
+// GroupRepositoryMockAddParentParamPtrs contains pointers to parameters of the GroupRepository.AddParent
+type GroupRepositoryMockAddParentParamPtrs struct {
+	ctx      *context.Context
+	groupID  *int64
+	parentID *int64
+}
+
+// GroupRepositoryMockAddParentResults contains results of the GroupRepository.AddParent
+type GroupRepositoryMockAddParentResults struct {
+	err error
+}
+
+// Expect sets up expected params for GroupRepository.AddParent
+func (mmAddParent *mGroupRepositoryMockAddParent) Expect(ctx context.Context, groupID int64, parentID int64) *mGroupRepositoryMockAddParent {
+	if mmAddParent.mock.funcAddParent != nil {
+		mmAddParent.mock.t.Fatalf("GroupRepositoryMock.AddParent mock is already set by Set")
+	}
+
+	if mmAddParent.defaultExpectation == nil {
+		mmAddParent.defaultExpectation = &GroupRepositoryMockAddParentExpectation{}
+	}
+
+	if mmAddParent.defaultExpectation.paramPtrs != nil {
+		mmAddParent.mock.t.Fatalf("GroupRepositoryMock.AddParent mock is already set by ExpectParams functions")
+	}
+
+	mmAddParent.defaultExpectation.params = &GroupRepositoryMockAddParentParams{ctx, groupID, parentID}
+	for _, e := range mmAddParent.expectations {
+		if minimock.Equal(e.params, mmAddParent.defaultExpectation.params) {
+			mmAddParent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddParent.defaultExpectation.params)
+		}
+	}
+
+	return mmAddParent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GroupRepository.AddParent
+func (mmAddParent *mGroupRepositoryMockAddParent) ExpectCtxParam1(ctx context.Context) *mGroupRepositoryMockAddParent {
+	if mmAddParent.mock.funcAddParent != nil {
+		mmAddParent.mock.t.Fatalf("GroupRepositoryMock.AddParent mock is already set by Set")
+	}
+
+	if mmAddParent.defaultExpectation == nil {
+		mmAddParent.defaultExpectation = &GroupRepositoryMockAddParentExpectation{}
+	}
+
+	if mmAddParent.defaultExpectation.params != nil {
+		mmAddParent.mock.t.Fatalf("GroupRepositoryMock.AddParent mock is already set by Expect")
+	}
+
+	if mmAddParent.defaultExpectation.paramPtrs == nil {
+		mmAddParent.defaultExpectation.paramPtrs = &GroupRepositoryMockAddParentParamPtrs{}
+	}
+	mmAddParent.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmAddParent
+}
+
+// ExpectGroupIDParam2 sets up expected param groupID for GroupRepository.AddParent
+func (mmAddParent *mGroupRepositoryMockAddParent) ExpectGroupIDParam2(groupID int64) *mGroupRepositoryMockAddParent {
+	if mmAddParent.mock.funcAddParent != nil {
+		mmAddParent.mock.t.Fatalf("GroupRepositoryMock.AddParent mock is already set by Set")
+	}
+
+	if mmAddParent.defaultExpectation == nil {
+		mmAddParent.defaultExpectation = &GroupRepositoryMockAddParentExpectation{}
+	}
+
+	if mmAddParent.defaultExpectation.params != nil {
+		mmAddParent.mock.t.Fatalf("GroupRepositoryMock.AddParent mock is already set by Expect")
+	}
+
+	if mmAddParent.defaultExpectation.paramPtrs == nil {
+		mmAddParent.defaultExpectation.paramPtrs = &GroupRepositoryMockAddParentParamPtrs{}
+	}
+	mmAddParent.defaultExpectation.paramPtrs.groupID = &groupID
+
+	return mmAddParent
+}
+
+// ExpectParentIDParam3 sets up expected param parentID for GroupRepository.AddParent
+func (mmAddParent *mGroupRepositoryMockAddParent) ExpectParentIDParam3(parentID int64) *mGroupRepositoryMockAddParent {
+	if mmAddParent.mock.funcAddParent != nil {
+		mmAddParent.mock.t.Fatalf("GroupRepositoryMock.AddParent mock is already set by Set")
+	}
+
+	if mmAddParent.defaultExpectation == nil {
+		mmAddParent.defaultExpectation = &GroupRepositoryMockAddParentExpectation{}
+	}
+
+	if mmAddParent.defaultExpectation.params != nil {
+		mmAddParent.mock.t.Fatalf("GroupRepositoryMock.AddParent mock is already set by Expect")
+	}
+
+	if mmAddParent.defaultExpectation.paramPtrs == nil {
+		mmAddParent.defaultExpectation.paramPtrs = &GroupRepositoryMockAddParentParamPtrs{}
+	}
+	mmAddParent.defaultExpectation.paramPtrs.parentID = &parentID
+
+	return mmAddParent
+}
+
+// Inspect accepts an inspector function that has same arguments as the GroupRepository.AddParent
+func (mmAddParent *mGroupRepositoryMockAddParent) Inspect(f func(ctx context.Context, groupID int64, parentID int64)) *mGroupRepositoryMockAddParent {
+	if mmAddParent.mock.inspectFuncAddParent != nil {
+		mmAddParent.mock.t.Fatalf("Inspect function is already set for GroupRepositoryMock.AddParent")
+	}
+
+	mmAddParent.mock.inspectFuncAddParent = f
+
+	return mmAddParent
+}
+
+// Return sets up results that will be returned by GroupRepository.AddParent
+func (mmAddParent *mGroupRepositoryMockAddParent) Return(err error) *GroupRepositoryMock {
+	if mmAddParent.mock.funcAddParent != nil {
+		mmAddParent.mock.t.Fatalf("GroupRepositoryMock.AddParent mock is already set by Set")
+	}
+
+	if mmAddParent.defaultExpectation == nil {
+		mmAddParent.defaultExpectation = &GroupRepositoryMockAddParentExpectation{mock: mmAddParent.mock}
+	}
+	mmAddParent.defaultExpectation.results = &GroupRepositoryMockAddParentResults{err}
+	return mmAddParent.mock
+}
+
+// Set uses given function f to mock the GroupRepository.AddParent method
+func (mmAddParent *mGroupRepositoryMockAddParent) Set(f func(ctx context.Context, groupID int64, parentID int64) (err error)) *GroupRepositoryMock {
+	if mmAddParent.defaultExpectation != nil {
+		mmAddParent.mock.t.Fatalf("Default expectation is already set for the GroupRepository.AddParent method")
+	}
+
+	if len(mmAddParent.expectations) > 0 {
+		mmAddParent.mock.t.Fatalf("Some expectations are already set for the GroupRepository.AddParent method")
+	}
+
+	mmAddParent.mock.funcAddParent = f
+	return mmAddParent.mock
+}
+
+// When sets expectation for the GroupRepository.AddParent which will trigger the result defined by the following
+// Then helper
+func (mmAddParent *mGroupRepositoryMockAddParent) When(ctx context.Context, groupID int64, parentID int64) *GroupRepositoryMockAddParentExpectation {
+	if mmAddParent.mock.funcAddParent != nil {
+		mmAddParent.mock.t.Fatalf("GroupRepositoryMock.AddParent mock is already set by Set")
+	}
+
+	expectation := &GroupRepositoryMockAddParentExpectation{
+		mock:   mmAddParent.mock,
+		params: &GroupRepositoryMockAddParentParams{ctx, groupID, parentID},
+	}
+	mmAddParent.expectations = append(mmAddParent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GroupRepository.AddParent return parameters for the expectation previously defined by the When method
+func (e *GroupRepositoryMockAddParentExpectation) Then(err error) *GroupRepositoryMock {
+	e.results = &GroupRepositoryMockAddParentResults{err}
+	return e.mock
+}
+
+// AddParent implements repository.GroupRepository
+func (mmAddParent *GroupRepositoryMock) AddParent(ctx context.Context, groupID int64, parentID int64) (err error) {
+	mm_atomic.AddUint64(&mmAddParent.beforeAddParentCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddParent.afterAddParentCounter, 1)
+
+	if mmAddParent.inspectFuncAddParent != nil {
+		mmAddParent.inspectFuncAddParent(ctx, groupID, parentID)
+	}
+
+	mm_params := GroupRepositoryMockAddParentParams{ctx, groupID, parentID}
+
+	// Record call args
+	mmAddParent.AddParentMock.mutex.Lock()
+	mmAddParent.AddParentMock.callArgs = append(mmAddParent.AddParentMock.callArgs, &mm_params)
+	mmAddParent.AddParentMock.mutex.Unlock()
+
+	for _, e := range mmAddParent.AddParentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddParent.AddParentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddParent.AddParentMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddParent.AddParentMock.defaultExpectation.params
+		mm_want_ptrs := mmAddParent.AddParentMock.defaultExpectation.paramPtrs
+
+		mm_got := GroupRepositoryMockAddParentParams{ctx, groupID, parentID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddParent.t.Errorf("GroupRepositoryMock.AddParent got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.groupID != nil && !minimock.Equal(*mm_want_ptrs.groupID, mm_got.groupID) {
+				mmAddParent.t.Errorf("GroupRepositoryMock.AddParent got unexpected parameter groupID, want: %#v, got: %#v%s\n", *mm_want_ptrs.groupID, mm_got.groupID, minimock.Diff(*mm_want_ptrs.groupID, mm_got.groupID))
+			}
+
+			if mm_want_ptrs.parentID != nil && !minimock.Equal(*mm_want_ptrs.parentID, mm_got.parentID) {
+				mmAddParent.t.Errorf("GroupRepositoryMock.AddParent got unexpected parameter parentID, want: %#v, got: %#v%s\n", *mm_want_ptrs.parentID, mm_got.parentID, minimock.Diff(*mm_want_ptrs.parentID, mm_got.parentID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddParent.t.Errorf("GroupRepositoryMock.AddParent got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddParent.AddParentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddParent.t.Fatal("No results are set for the GroupRepositoryMock.AddParent")
+		}
+		return (*mm_results).err
+	}
+	if mmAddParent.funcAddParent != nil {
+		return mmAddParent.funcAddParent(ctx, groupID, parentID)
+	}
+	mmAddParent.t.Fatalf("Unexpected call to GroupRepositoryMock.AddParent. %v %v %v", ctx, groupID, parentID)
+	return
+}
+
+// AddParentAfterCounter returns a count of finished GroupRepositoryMock.AddParent invocations
+func (mmAddParent *GroupRepositoryMock) AddParentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddParent.afterAddParentCounter)
+}
+
+// AddParentBeforeCounter returns a count of GroupRepositoryMock.AddParent invocations
+func (mmAddParent *GroupRepositoryMock) AddParentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddParent.beforeAddParentCounter)
+}
+
+// Calls returns a list of arguments used in each call to GroupRepositoryMock.AddParent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddParent *mGroupRepositoryMockAddParent) Calls() []*GroupRepositoryMockAddParentParams {
+	mmAddParent.mutex.RLock()
+
+	argCopy := make([]*GroupRepositoryMockAddParentParams, len(mmAddParent.callArgs))
+	copy(argCopy, mmAddParent.callArgs)
+
+	mmAddParent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddParentDone returns true if the count of the AddParent invocations corresponds
+// the number of defined expectations
+func (m *GroupRepositoryMock) MinimockAddParentDone() bool {
+	for _, e := range m.AddParentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddParentMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterAddParentCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddParent != nil && mm_atomic.LoadUint64(&m.afterAddParentCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockAddParentInspect logs each unmet expectation
+func (m *GroupRepositoryMock) MinimockAddParentInspect() {
+	for _, e := range m.AddParentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GroupRepositoryMock.AddParent with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddParentMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterAddParentCounter) < 1 {
+		if m.AddParentMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GroupRepositoryMock.AddParent")
+		} else {
+			m.t.Errorf("Expected call to GroupRepositoryMock.AddParent with params: %#v", *m.AddParentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddParent != nil && mm_atomic.LoadUint64(&m.afterAddParentCounter) < 1 {
+		m.t.Error("Expected call to GroupRepositoryMock.AddParent")
+	}
+}
+
+type mGroupRepositoryMockCreate struct {
+	mock               *GroupRepositoryMock
+	defaultExpectation *GroupRepositoryMockCreateExpectation
+	expectations       []*GroupRepositoryMockCreateExpectation
+
+	callArgs []*GroupRepositoryMockCreateParams
+	mutex    sync.RWMutex
+}
+
+// GroupRepositoryMockCreateExpectation specifies expectation struct of the GroupRepository.Create
+type GroupRepositoryMockCreateExpectation struct {
+	mock      *GroupRepositoryMock
+	params    *GroupRepositoryMockCreateParams
+	paramPtrs *GroupRepositoryMockCreateParamPtrs
+	results   *GroupRepositoryMockCreateResults
+	Counter   uint64
+}
+
+// GroupRepositoryMockCreateParams contains parameters of the GroupRepository.Create
+type GroupRepositoryMockCreateParams struct {
+	ctx   context.Context
+	group *model.CreateGroup
+}
+
+// GroupRepositoryMockCreateParamPtrs contains pointers to parameters of the GroupRepository.Create
+type GroupRepositoryMockCreateParamPtrs struct {
+	ctx   *context.Context
+	group **model.CreateGroup
+}
+
+// GroupRepositoryMockCreateResults contains results of the GroupRepository.Create
+type GroupRepositoryMockCreateResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for GroupRepository.Create
+func (mmCreate *mGroupRepositoryMockCreate) Expect(ctx context.Context, group *model.CreateGroup) *mGroupRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("GroupRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &GroupRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("GroupRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &GroupRepositoryMockCreateParams{ctx, group}
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GroupRepository.Create
+func (mmCreate *mGroupRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mGroupRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("GroupRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &GroupRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("GroupRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &GroupRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreate
+}
+
+// ExpectGroupParam2 sets up expected param group for GroupRepository.Create
+func (mmCreate *mGroupRepositoryMockCreate) ExpectGroupParam2(group *model.CreateGroup) *mGroupRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("GroupRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &GroupRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("GroupRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &GroupRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.group = &group
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the GroupRepository.Create
+func (mmCreate *mGroupRepositoryMockCreate) Inspect(f func(ctx context.Context, group *model.CreateGroup)) *mGroupRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for GroupRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by GroupRepository.Create
+func (mmCreate *mGroupRepositoryMockCreate) Return(i1 int64, err error) *GroupRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("GroupRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &GroupRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &GroupRepositoryMockCreateResults{i1, err}
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the GroupRepository.Create method
+func (mmCreate *mGroupRepositoryMockCreate) Set(f func(ctx context.Context, group *model.CreateGroup) (i1 int64, err error)) *GroupRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the GroupRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the GroupRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	return mmCreate.mock
+}
+
+// When sets expectation for the GroupRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mGroupRepositoryMockCreate) When(ctx context.Context, group *model.CreateGroup) *GroupRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("GroupRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &GroupRepositoryMockCreateExpectation{
+		mock:   mmCreate.mock,
+		params: &GroupRepositoryMockCreateParams{ctx, group},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GroupRepository.Create return parameters for the expectation previously defined by the When method
+func (e *GroupRepositoryMockCreateExpectation) Then(i1 int64, err error) *GroupRepositoryMock {
+	e.results = &GroupRepositoryMockCreateResults{i1, err}
+	return e.mock
+}
+
+// Create implements repository.GroupRepository
+func (mmCreate *GroupRepositoryMock) Create(ctx context.Context, group *model.CreateGroup) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, group)
+	}
+
+	mm_params := GroupRepositoryMockCreateParams{ctx, group}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := GroupRepositoryMockCreateParams{ctx, group}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("GroupRepositoryMock.Create got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.group != nil && !minimock.Equal(*mm_want_ptrs.group, mm_got.group) {
+				mmCreate.t.Errorf("GroupRepositoryMock.Create got unexpected parameter group, want: %#v, got: %#v%s\n", *mm_want_ptrs.group, mm_got.group, minimock.Diff(*mm_want_ptrs.group, mm_got.group))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("GroupRepositoryMock.Create got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the GroupRepositoryMock.Create")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, group)
+	}
+	mmCreate.t.Fatalf("Unexpected call to GroupRepositoryMock.Create. %v %v", ctx, group)
+	return
+}
+
+// CreateAfterCounter returns a count of finished GroupRepositoryMock.Create invocations
+func (mmCreate *GroupRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of GroupRepositoryMock.Create invocations
+func (mmCreate *GroupRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to GroupRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mGroupRepositoryMockCreate) Calls() []*GroupRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*GroupRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *GroupRepositoryMock) MinimockCreateDone() bool {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *GroupRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GroupRepositoryMock.Create with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GroupRepositoryMock.Create")
+		} else {
+			m.t.Errorf("Expected call to GroupRepositoryMock.Create with params: %#v", *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		m.t.Error("Expected call to GroupRepositoryMock.Create")
+	}
+}
+
+type mGroupRepositoryMockDelete struct {
+	mock               *GroupRepositoryMock
+	defaultExpectation *GroupRepositoryMockDeleteExpectation
+	expectations       []*GroupRepositoryMockDeleteExpectation
+
+	callArgs []*GroupRepositoryMockDeleteParams
+	mutex    sync.RWMutex
+}
+
+// GroupRepositoryMockDeleteExpectation specifies expectation struct of the GroupRepository.Delete
+type GroupRepositoryMockDeleteExpectation struct {
+	mock      *GroupRepositoryMock
+	params    *GroupRepositoryMockDeleteParams
+	paramPtrs *GroupRepositoryMockDeleteParamPtrs
+	results   *GroupRepositoryMockDeleteResults
+	Counter   uint64
+}
+
+// GroupRepositoryMockDeleteParams contains parameters of the GroupRepository.Delete
+type GroupRepositoryMockDeleteParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// GroupRepositoryMockDeleteParamPtrs contains pointers to parameters of the GroupRepository.Delete
+type GroupRepositoryMockDeleteParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// GroupRepositoryMockDeleteResults contains results of the GroupRepository.Delete
+type GroupRepositoryMockDeleteResults struct {
+	err error
+}
+
+// Expect sets up expected params for GroupRepository.Delete
+func (mmDelete *mGroupRepositoryMockDelete) Expect(ctx context.Context, id int64) *mGroupRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("GroupRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &GroupRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("GroupRepositoryMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &GroupRepositoryMockDeleteParams{ctx, id}
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GroupRepository.Delete
+func (mmDelete *mGroupRepositoryMockDelete) ExpectCtxParam1(ctx context.Context) *mGroupRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("GroupRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &GroupRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("GroupRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &GroupRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDelete
+}
+
+// ExpectIdParam2 sets up expected param id for GroupRepository.Delete
+func (mmDelete *mGroupRepositoryMockDelete) ExpectIdParam2(id int64) *mGroupRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("GroupRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &GroupRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("GroupRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &GroupRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.id = &id
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the GroupRepository.Delete
+func (mmDelete *mGroupRepositoryMockDelete) Inspect(f func(ctx context.Context, id int64)) *mGroupRepositoryMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for GroupRepositoryMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by GroupRepository.Delete
+func (mmDelete *mGroupRepositoryMockDelete) Return(err error) *GroupRepositoryMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("GroupRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &GroupRepositoryMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &GroupRepositoryMockDeleteResults{err}
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the GroupRepository.Delete method
+func (mmDelete *mGroupRepositoryMockDelete) Set(f func(ctx context.Context, id int64) (err error)) *GroupRepositoryMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the GroupRepository.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the GroupRepository.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	return mmDelete.mock
+}
+
+// When sets expectation for the GroupRepository.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mGroupRepositoryMockDelete) When(ctx context.Context, id int64) *GroupRepositoryMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("GroupRepositoryMock.Delete mock is already set by Set")
+	}
+
+	expectation := &GroupRepositoryMockDeleteExpectation{
+		mock:   mmDelete.mock,
+		params: &GroupRepositoryMockDeleteParams{ctx, id},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GroupRepository.Delete return parameters for the expectation previously defined by the When method
+func (e *GroupRepositoryMockDeleteExpectation) Then(err error) *GroupRepositoryMock {
+	e.results = &GroupRepositoryMockDeleteResults{err}
+	return e.mock
+}
+
+// Delete implements repository.GroupRepository
+func (mmDelete *GroupRepositoryMock) Delete(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, id)
+	}
+
+	mm_params := GroupRepositoryMockDeleteParams{ctx, id}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := GroupRepositoryMockDeleteParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("GroupRepositoryMock.Delete got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDelete.t.Errorf("GroupRepositoryMock.Delete got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("GroupRepositoryMock.Delete got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the GroupRepositoryMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, id)
+	}
+	mmDelete.t.Fatalf("Unexpected call to GroupRepositoryMock.Delete. %v %v", ctx, id)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished GroupRepositoryMock.Delete invocations
+func (mmDelete *GroupRepositoryMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of GroupRepositoryMock.Delete invocations
+func (mmDelete *GroupRepositoryMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to GroupRepositoryMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mGroupRepositoryMockDelete) Calls() []*GroupRepositoryMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*GroupRepositoryMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *GroupRepositoryMock) MinimockDeleteDone() bool {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && mm_atomic.LoadUint64(&m.afterDeleteCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *GroupRepositoryMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GroupRepositoryMock.Delete with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteCounter) < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GroupRepositoryMock.Delete")
+		} else {
+			m.t.Errorf("Expected call to GroupRepositoryMock.Delete with params: %#v", *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && mm_atomic.LoadUint64(&m.afterDeleteCounter) < 1 {
+		m.t.Error("Expected call to GroupRepositoryMock.Delete")
+	}
+}
+
+type mGroupRepositoryMockGet struct {
+	mock               *GroupRepositoryMock
+	defaultExpectation *GroupRepositoryMockGetExpectation
+	expectations       []*GroupRepositoryMockGetExpectation
+
+	callArgs []*GroupRepositoryMockGetParams
+	mutex    sync.RWMutex
+}
+
+// GroupRepositoryMockGetExpectation specifies expectation struct of the GroupRepository.Get
+type GroupRepositoryMockGetExpectation struct {
+	mock      *GroupRepositoryMock
+	params    *GroupRepositoryMockGetParams
+	paramPtrs *GroupRepositoryMockGetParamPtrs
+	results   *GroupRepositoryMockGetResults
+	Counter   uint64
+}
+
+// GroupRepositoryMockGetParams contains parameters of the GroupRepository.Get
+type GroupRepositoryMockGetParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// GroupRepositoryMockGetParamPtrs contains pointers to parameters of the GroupRepository.Get
+type GroupRepositoryMockGetParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// GroupRepositoryMockGetResults contains results of the GroupRepository.Get
+type GroupRepositoryMockGetResults struct {
+	gp1 *model.Group
+	err error
+}
+
+// Expect sets up expected params for GroupRepository.Get
+func (mmGet *mGroupRepositoryMockGet) Expect(ctx context.Context, id int64) *mGroupRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("GroupRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &GroupRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("GroupRepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &GroupRepositoryMockGetParams{ctx, id}
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GroupRepository.Get
+func (mmGet *mGroupRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mGroupRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("GroupRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &GroupRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("GroupRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &GroupRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGet
+}
+
+// ExpectIdParam2 sets up expected param id for GroupRepository.Get
+func (mmGet *mGroupRepositoryMockGet) ExpectIdParam2(id int64) *mGroupRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("GroupRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &GroupRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("GroupRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &GroupRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.id = &id
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the GroupRepository.Get
+func (mmGet *mGroupRepositoryMockGet) Inspect(f func(ctx context.Context, id int64)) *mGroupRepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for GroupRepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by GroupRepository.Get
+func (mmGet *mGroupRepositoryMockGet) Return(gp1 *model.Group, err error) *GroupRepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("GroupRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &GroupRepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &GroupRepositoryMockGetResults{gp1, err}
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the GroupRepository.Get method
+func (mmGet *mGroupRepositoryMockGet) Set(f func(ctx context.Context, id int64) (gp1 *model.Group, err error)) *GroupRepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the GroupRepository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the GroupRepository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	return mmGet.mock
+}
+
+// When sets expectation for the GroupRepository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mGroupRepositoryMockGet) When(ctx context.Context, id int64) *GroupRepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("GroupRepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &GroupRepositoryMockGetExpectation{
+		mock:   mmGet.mock,
+		params: &GroupRepositoryMockGetParams{ctx, id},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GroupRepository.Get return parameters for the expectation previously defined by the When method
+func (e *GroupRepositoryMockGetExpectation) Then(gp1 *model.Group, err error) *GroupRepositoryMock {
+	e.results = &GroupRepositoryMockGetResults{gp1, err}
+	return e.mock
+}
+
+// Get implements repository.GroupRepository
+func (mmGet *GroupRepositoryMock) Get(ctx context.Context, id int64) (gp1 *model.Group, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, id)
+	}
+
+	mm_params := GroupRepositoryMockGetParams{ctx, id}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.gp1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := GroupRepositoryMockGetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("GroupRepositoryMock.Get got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGet.t.Errorf("GroupRepositoryMock.Get got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("GroupRepositoryMock.Get got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the GroupRepositoryMock.Get")
+		}
+		return (*mm_results).gp1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, id)
+	}
+	mmGet.t.Fatalf("Unexpected call to GroupRepositoryMock.Get. %v %v", ctx, id)
+	return
+}
+
+// GetAfterCounter returns a count of finished GroupRepositoryMock.Get invocations
+func (mmGet *GroupRepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of GroupRepositoryMock.Get invocations
+func (mmGet *GroupRepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to GroupRepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mGroupRepositoryMockGet) Calls() []*GroupRepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*GroupRepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *GroupRepositoryMock) MinimockGetDone() bool {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && mm_atomic.LoadUint64(&m.afterGetCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *GroupRepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GroupRepositoryMock.Get with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetCounter) < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GroupRepositoryMock.Get")
+		} else {
+			m.t.Errorf("Expected call to GroupRepositoryMock.Get with params: %#v", *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && mm_atomic.LoadUint64(&m.afterGetCounter) < 1 {
+		m.t.Error("Expected call to GroupRepositoryMock.Get")
+	}
+}
+
+type mGroupRepositoryMockGetRoleGraph struct {
+	mock               *GroupRepositoryMock
+	defaultExpectation *GroupRepositoryMockGetRoleGraphExpectation
+	expectations       []*GroupRepositoryMockGetRoleGraphExpectation
+
+	callArgs []*GroupRepositoryMockGetRoleGraphParams
+	mutex    sync.RWMutex
+}
+
+// GroupRepositoryMockGetRoleGraphExpectation specifies expectation struct of the GroupRepository.GetRoleGraph
+type GroupRepositoryMockGetRoleGraphExpectation struct {
+	mock      *GroupRepositoryMock
+	params    *GroupRepositoryMockGetRoleGraphParams
+	paramPtrs *GroupRepositoryMockGetRoleGraphParamPtrs
+	results   *GroupRepositoryMockGetRoleGraphResults
+	Counter   uint64
+}
+
+// GroupRepositoryMockGetRoleGraphParams contains parameters of the GroupRepository.GetRoleGraph
+type GroupRepositoryMockGetRoleGraphParams struct {
+	ctx    context.Context
+	userID int64
+	tenant string
+}
+
+// GroupRepositoryMockGetRoleGraphParamPtrs contains pointers to parameters of the GroupRepository.GetRoleGraph
+type GroupRepositoryMockGetRoleGraphParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+	tenant *string
+}
+
+// GroupRepositoryMockGetRoleGraphResults contains results of the GroupRepository.GetRoleGraph
+type GroupRepositoryMockGetRoleGraphResults struct {
+	rp1 *model.RoleGraph
+	err error
+}
+
+// Expect sets up expected params for GroupRepository.GetRoleGraph
+func (mmGetRoleGraph *mGroupRepositoryMockGetRoleGraph) Expect(ctx context.Context, userID int64, tenant string) *mGroupRepositoryMockGetRoleGraph {
+	if mmGetRoleGraph.mock.funcGetRoleGraph != nil {
+		mmGetRoleGraph.mock.t.Fatalf("GroupRepositoryMock.GetRoleGraph mock is already set by Set")
+	}
+
+	if mmGetRoleGraph.defaultExpectation == nil {
+		mmGetRoleGraph.defaultExpectation = &GroupRepositoryMockGetRoleGraphExpectation{}
+	}
+
+	if mmGetRoleGraph.defaultExpectation.paramPtrs != nil {
+		mmGetRoleGraph.mock.t.Fatalf("GroupRepositoryMock.GetRoleGraph mock is already set by ExpectParams functions")
+	}
+
+	mmGetRoleGraph.defaultExpectation.params = &GroupRepositoryMockGetRoleGraphParams{ctx, userID, tenant}
+	for _, e := range mmGetRoleGraph.expectations {
+		if minimock.Equal(e.params, mmGetRoleGraph.defaultExpectation.params) {
+			mmGetRoleGraph.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetRoleGraph.defaultExpectation.params)
+		}
+	}
+
+	return mmGetRoleGraph
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GroupRepository.GetRoleGraph
+func (mmGetRoleGraph *mGroupRepositoryMockGetRoleGraph) ExpectCtxParam1(ctx context.Context) *mGroupRepositoryMockGetRoleGraph {
+	if mmGetRoleGraph.mock.funcGetRoleGraph != nil {
+		mmGetRoleGraph.mock.t.Fatalf("GroupRepositoryMock.GetRoleGraph mock is already set by Set")
+	}
+
+	if mmGetRoleGraph.defaultExpectation == nil {
+		mmGetRoleGraph.defaultExpectation = &GroupRepositoryMockGetRoleGraphExpectation{}
+	}
+
+	if mmGetRoleGraph.defaultExpectation.params != nil {
+		mmGetRoleGraph.mock.t.Fatalf("GroupRepositoryMock.GetRoleGraph mock is already set by Expect")
+	}
+
+	if mmGetRoleGraph.defaultExpectation.paramPtrs == nil {
+		mmGetRoleGraph.defaultExpectation.paramPtrs = &GroupRepositoryMockGetRoleGraphParamPtrs{}
+	}
+	mmGetRoleGraph.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetRoleGraph
+}
+
+// ExpectUserIDParam2 sets up expected param userID for GroupRepository.GetRoleGraph
+func (mmGetRoleGraph *mGroupRepositoryMockGetRoleGraph) ExpectUserIDParam2(userID int64) *mGroupRepositoryMockGetRoleGraph {
+	if mmGetRoleGraph.mock.funcGetRoleGraph != nil {
+		mmGetRoleGraph.mock.t.Fatalf("GroupRepositoryMock.GetRoleGraph mock is already set by Set")
+	}
+
+	if mmGetRoleGraph.defaultExpectation == nil {
+		mmGetRoleGraph.defaultExpectation = &GroupRepositoryMockGetRoleGraphExpectation{}
+	}
+
+	if mmGetRoleGraph.defaultExpectation.params != nil {
+		mmGetRoleGraph.mock.t.Fatalf("GroupRepositoryMock.GetRoleGraph mock is already set by Expect")
+	}
+
+	if mmGetRoleGraph.defaultExpectation.paramPtrs == nil {
+		mmGetRoleGraph.defaultExpectation.paramPtrs = &GroupRepositoryMockGetRoleGraphParamPtrs{}
+	}
+	mmGetRoleGraph.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmGetRoleGraph
+}
+
+// ExpectTenantParam3 sets up expected param tenant for GroupRepository.GetRoleGraph
+func (mmGetRoleGraph *mGroupRepositoryMockGetRoleGraph) ExpectTenantParam3(tenant string) *mGroupRepositoryMockGetRoleGraph {
+	if mmGetRoleGraph.mock.funcGetRoleGraph != nil {
+		mmGetRoleGraph.mock.t.Fatalf("GroupRepositoryMock.GetRoleGraph mock is already set by Set")
+	}
+
+	if mmGetRoleGraph.defaultExpectation == nil {
+		mmGetRoleGraph.defaultExpectation = &GroupRepositoryMockGetRoleGraphExpectation{}
+	}
+
+	if mmGetRoleGraph.defaultExpectation.params != nil {
+		mmGetRoleGraph.mock.t.Fatalf("GroupRepositoryMock.GetRoleGraph mock is already set by Expect")
+	}
+
+	if mmGetRoleGraph.defaultExpectation.paramPtrs == nil {
+		mmGetRoleGraph.defaultExpectation.paramPtrs = &GroupRepositoryMockGetRoleGraphParamPtrs{}
+	}
+	mmGetRoleGraph.defaultExpectation.paramPtrs.tenant = &tenant
+
+	return mmGetRoleGraph
+}
+
+// Inspect accepts an inspector function that has same arguments as the GroupRepository.GetRoleGraph
+func (mmGetRoleGraph *mGroupRepositoryMockGetRoleGraph) Inspect(f func(ctx context.Context, userID int64, tenant string)) *mGroupRepositoryMockGetRoleGraph {
+	if mmGetRoleGraph.mock.inspectFuncGetRoleGraph != nil {
+		mmGetRoleGraph.mock.t.Fatalf("Inspect function is already set for GroupRepositoryMock.GetRoleGraph")
+	}
+
+	mmGetRoleGraph.mock.inspectFuncGetRoleGraph = f
+
+	return mmGetRoleGraph
+}
+
+// Return sets up results that will be returned by GroupRepository.GetRoleGraph
+func (mmGetRoleGraph *mGroupRepositoryMockGetRoleGraph) Return(rp1 *model.RoleGraph, err error) *GroupRepositoryMock {
+	if mmGetRoleGraph.mock.funcGetRoleGraph != nil {
+		mmGetRoleGraph.mock.t.Fatalf("GroupRepositoryMock.GetRoleGraph mock is already set by Set")
+	}
+
+	if mmGetRoleGraph.defaultExpectation == nil {
+		mmGetRoleGraph.defaultExpectation = &GroupRepositoryMockGetRoleGraphExpectation{mock: mmGetRoleGraph.mock}
+	}
+	mmGetRoleGraph.defaultExpectation.results = &GroupRepositoryMockGetRoleGraphResults{rp1, err}
+	return mmGetRoleGraph.mock
+}
+
+// Set uses given function f to mock the GroupRepository.GetRoleGraph method
+func (mmGetRoleGraph *mGroupRepositoryMockGetRoleGraph) Set(f func(ctx context.Context, userID int64, tenant string) (rp1 *model.RoleGraph, err error)) *GroupRepositoryMock {
+	if mmGetRoleGraph.defaultExpectation != nil {
+		mmGetRoleGraph.mock.t.Fatalf("Default expectation is already set for the GroupRepository.GetRoleGraph method")
+	}
+
+	if len(mmGetRoleGraph.expectations) > 0 {
+		mmGetRoleGraph.mock.t.Fatalf("Some expectations are already set for the GroupRepository.GetRoleGraph method")
+	}
+
+	mmGetRoleGraph.mock.funcGetRoleGraph = f
+	return mmGetRoleGraph.mock
+}
+
+// When sets expectation for the GroupRepository.GetRoleGraph which will trigger the result defined by the following
+// Then helper
+func (mmGetRoleGraph *mGroupRepositoryMockGetRoleGraph) When(ctx context.Context, userID int64, tenant string) *GroupRepositoryMockGetRoleGraphExpectation {
+	if mmGetRoleGraph.mock.funcGetRoleGraph != nil {
+		mmGetRoleGraph.mock.t.Fatalf("GroupRepositoryMock.GetRoleGraph mock is already set by Set")
+	}
+
+	expectation := &GroupRepositoryMockGetRoleGraphExpectation{
+		mock:   mmGetRoleGraph.mock,
+		params: &GroupRepositoryMockGetRoleGraphParams{ctx, userID, tenant},
+	}
+	mmGetRoleGraph.expectations = append(mmGetRoleGraph.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GroupRepository.GetRoleGraph return parameters for the expectation previously defined by the When method
+func (e *GroupRepositoryMockGetRoleGraphExpectation) Then(rp1 *model.RoleGraph, err error) *GroupRepositoryMock {
+	e.results = &GroupRepositoryMockGetRoleGraphResults{rp1, err}
+	return e.mock
+}
+
+// GetRoleGraph implements repository.GroupRepository
+func (mmGetRoleGraph *GroupRepositoryMock) GetRoleGraph(ctx context.Context, userID int64, tenant string) (rp1 *model.RoleGraph, err error) {
+	mm_atomic.AddUint64(&mmGetRoleGraph.beforeGetRoleGraphCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetRoleGraph.afterGetRoleGraphCounter, 1)
+
+	if mmGetRoleGraph.inspectFuncGetRoleGraph != nil {
+		mmGetRoleGraph.inspectFuncGetRoleGraph(ctx, userID, tenant)
+	}
+
+	mm_params := GroupRepositoryMockGetRoleGraphParams{ctx, userID, tenant}
+
+	// Record call args
+	mmGetRoleGraph.GetRoleGraphMock.mutex.Lock()
+	mmGetRoleGraph.GetRoleGraphMock.callArgs = append(mmGetRoleGraph.GetRoleGraphMock.callArgs, &mm_params)
+	mmGetRoleGraph.GetRoleGraphMock.mutex.Unlock()
+
+	for _, e := range mmGetRoleGraph.GetRoleGraphMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmGetRoleGraph.GetRoleGraphMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetRoleGraph.GetRoleGraphMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetRoleGraph.GetRoleGraphMock.defaultExpectation.params
+		mm_want_ptrs := mmGetRoleGraph.GetRoleGraphMock.defaultExpectation.paramPtrs
+
+		mm_got := GroupRepositoryMockGetRoleGraphParams{ctx, userID, tenant}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetRoleGraph.t.Errorf("GroupRepositoryMock.GetRoleGraph got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetRoleGraph.t.Errorf("GroupRepositoryMock.GetRoleGraph got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.tenant != nil && !minimock.Equal(*mm_want_ptrs.tenant, mm_got.tenant) {
+				mmGetRoleGraph.t.Errorf("GroupRepositoryMock.GetRoleGraph got unexpected parameter tenant, want: %#v, got: %#v%s\n", *mm_want_ptrs.tenant, mm_got.tenant, minimock.Diff(*mm_want_ptrs.tenant, mm_got.tenant))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetRoleGraph.t.Errorf("GroupRepositoryMock.GetRoleGraph got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetRoleGraph.GetRoleGraphMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetRoleGraph.t.Fatal("No results are set for the GroupRepositoryMock.GetRoleGraph")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmGetRoleGraph.funcGetRoleGraph != nil {
+		return mmGetRoleGraph.funcGetRoleGraph(ctx, userID, tenant)
+	}
+	mmGetRoleGraph.t.Fatalf("Unexpected call to GroupRepositoryMock.GetRoleGraph. %v %v %v", ctx, userID, tenant)
+	return
+}
+
+// GetRoleGraphAfterCounter returns a count of finished GroupRepositoryMock.GetRoleGraph invocations
+func (mmGetRoleGraph *GroupRepositoryMock) GetRoleGraphAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRoleGraph.afterGetRoleGraphCounter)
+}
+
+// GetRoleGraphBeforeCounter returns a count of GroupRepositoryMock.GetRoleGraph invocations
+func (mmGetRoleGraph *GroupRepositoryMock) GetRoleGraphBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRoleGraph.beforeGetRoleGraphCounter)
+}
+
+// Calls returns a list of arguments used in each call to GroupRepositoryMock.GetRoleGraph.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetRoleGraph *mGroupRepositoryMockGetRoleGraph) Calls() []*GroupRepositoryMockGetRoleGraphParams {
+	mmGetRoleGraph.mutex.RLock()
+
+	argCopy := make([]*GroupRepositoryMockGetRoleGraphParams, len(mmGetRoleGraph.callArgs))
+	copy(argCopy, mmGetRoleGraph.callArgs)
+
+	mmGetRoleGraph.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetRoleGraphDone returns true if the count of the GetRoleGraph invocations corresponds
+// the number of defined expectations
+func (m *GroupRepositoryMock) MinimockGetRoleGraphDone() bool {
+	for _, e := range m.GetRoleGraphMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRoleGraphMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetRoleGraphCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetRoleGraph != nil && mm_atomic.LoadUint64(&m.afterGetRoleGraphCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetRoleGraphInspect logs each unmet expectation
+func (m *GroupRepositoryMock) MinimockGetRoleGraphInspect() {
+	for _, e := range m.GetRoleGraphMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GroupRepositoryMock.GetRoleGraph with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRoleGraphMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetRoleGraphCounter) < 1 {
+		if m.GetRoleGraphMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GroupRepositoryMock.GetRoleGraph")
+		} else {
+			m.t.Errorf("Expected call to GroupRepositoryMock.GetRoleGraph with params: %#v", *m.GetRoleGraphMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetRoleGraph != nil && mm_atomic.LoadUint64(&m.afterGetRoleGraphCounter) < 1 {
+		m.t.Error("Expected call to GroupRepositoryMock.GetRoleGraph")
+	}
+}
+
+type mGroupRepositoryMockIsMember struct {
+	mock               *GroupRepositoryMock
+	defaultExpectation *GroupRepositoryMockIsMemberExpectation
+	expectations       []*GroupRepositoryMockIsMemberExpectation
+
+	callArgs []*GroupRepositoryMockIsMemberParams
+	mutex    sync.RWMutex
+}
+
+// GroupRepositoryMockIsMemberExpectation specifies expectation struct of the GroupRepository.IsMember
+type GroupRepositoryMockIsMemberExpectation struct {
+	mock      *GroupRepositoryMock
+	params    *GroupRepositoryMockIsMemberParams
+	paramPtrs *GroupRepositoryMockIsMemberParamPtrs
+	results   *GroupRepositoryMockIsMemberResults
+	Counter   uint64
+}
+
+// GroupRepositoryMockIsMemberParams contains parameters of the GroupRepository.IsMember
+type GroupRepositoryMockIsMemberParams struct {
+	ctx    context.Context
+	name   string
+	userID int64
+}
+
+// GroupRepositoryMockIsMemberParamPtrs contains pointers to parameters of the GroupRepository.IsMember
+type GroupRepositoryMockIsMemberParamPtrs struct {
+	ctx    *context.Context
+	name   *string
+	userID *int64
+}
+
+// GroupRepositoryMockIsMemberResults contains results of the GroupRepository.IsMember
+type GroupRepositoryMockIsMemberResults struct {
+	b1  bool
+	err error
+}
+
+// Expect sets up expected params for GroupRepository.IsMember
+func (mmIsMember *mGroupRepositoryMockIsMember) Expect(ctx context.Context, name string, userID int64) *mGroupRepositoryMockIsMember {
+	if mmIsMember.mock.funcIsMember != nil {
+		mmIsMember.mock.t.Fatalf("GroupRepositoryMock.IsMember mock is already set by Set")
+	}
+
+	if mmIsMember.defaultExpectation == nil {
+		mmIsMember.defaultExpectation = &GroupRepositoryMockIsMemberExpectation{}
+	}
+
+	if mmIsMember.defaultExpectation.paramPtrs != nil {
+		mmIsMember.mock.t.Fatalf("GroupRepositoryMock.IsMember mock is already set by ExpectParams functions")
+	}
+
+	mmIsMember.defaultExpectation.params = &GroupRepositoryMockIsMemberParams{ctx, name, userID}
+	for _, e := range mmIsMember.expectations {
+		if minimock.Equal(e.params, mmIsMember.defaultExpectation.params) {
+			mmIsMember.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIsMember.defaultExpectation.params)
+		}
+	}
+
+	return mmIsMember
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GroupRepository.IsMember
+func (mmIsMember *mGroupRepositoryMockIsMember) ExpectCtxParam1(ctx context.Context) *mGroupRepositoryMockIsMember {
+	if mmIsMember.mock.funcIsMember != nil {
+		mmIsMember.mock.t.Fatalf("GroupRepositoryMock.IsMember mock is already set by Set")
+	}
+
+	if mmIsMember.defaultExpectation == nil {
+		mmIsMember.defaultExpectation = &GroupRepositoryMockIsMemberExpectation{}
+	}
+
+	if mmIsMember.defaultExpectation.params != nil {
+		mmIsMember.mock.t.Fatalf("GroupRepositoryMock.IsMember mock is already set by Expect")
+	}
+
+	if mmIsMember.defaultExpectation.paramPtrs == nil {
+		mmIsMember.defaultExpectation.paramPtrs = &GroupRepositoryMockIsMemberParamPtrs{}
+	}
+	mmIsMember.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmIsMember
+}
+
+// ExpectNameParam2 sets up expected param name for GroupRepository.IsMember
+func (mmIsMember *mGroupRepositoryMockIsMember) ExpectNameParam2(name string) *mGroupRepositoryMockIsMember {
+	if mmIsMember.mock.funcIsMember != nil {
+		mmIsMember.mock.t.Fatalf("GroupRepositoryMock.IsMember mock is already set by Set")
+	}
+
+	if mmIsMember.defaultExpectation == nil {
+		mmIsMember.defaultExpectation = &GroupRepositoryMockIsMemberExpectation{}
+	}
+
+	if mmIsMember.defaultExpectation.params != nil {
+		mmIsMember.mock.t.Fatalf("GroupRepositoryMock.IsMember mock is already set by Expect")
+	}
+
+	if mmIsMember.defaultExpectation.paramPtrs == nil {
+		mmIsMember.defaultExpectation.paramPtrs = &GroupRepositoryMockIsMemberParamPtrs{}
+	}
+	mmIsMember.defaultExpectation.paramPtrs.name = &name
+
+	return mmIsMember
+}
+
+// ExpectUserIDParam3 sets up expected param userID for GroupRepository.IsMember
+func (mmIsMember *mGroupRepositoryMockIsMember) ExpectUserIDParam3(userID int64) *mGroupRepositoryMockIsMember {
+	if mmIsMember.mock.funcIsMember != nil {
+		mmIsMember.mock.t.Fatalf("GroupRepositoryMock.IsMember mock is already set by Set")
+	}
+
+	if mmIsMember.defaultExpectation == nil {
+		mmIsMember.defaultExpectation = &GroupRepositoryMockIsMemberExpectation{}
+	}
+
+	if mmIsMember.defaultExpectation.params != nil {
+		mmIsMember.mock.t.Fatalf("GroupRepositoryMock.IsMember mock is already set by Expect")
+	}
+
+	if mmIsMember.defaultExpectation.paramPtrs == nil {
+		mmIsMember.defaultExpectation.paramPtrs = &GroupRepositoryMockIsMemberParamPtrs{}
+	}
+	mmIsMember.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmIsMember
+}
+
+// Inspect accepts an inspector function that has same arguments as the GroupRepository.IsMember
+func (mmIsMember *mGroupRepositoryMockIsMember) Inspect(f func(ctx context.Context, name string, userID int64)) *mGroupRepositoryMockIsMember {
+	if mmIsMember.mock.inspectFuncIsMember != nil {
+		mmIsMember.mock.t.Fatalf("Inspect function is already set for GroupRepositoryMock.IsMember")
+	}
+
+	mmIsMember.mock.inspectFuncIsMember = f
+
+	return mmIsMember
+}
+
+// Return sets up results that will be returned by GroupRepository.IsMember
+func (mmIsMember *mGroupRepositoryMockIsMember) Return(b1 bool, err error) *GroupRepositoryMock {
+	if mmIsMember.mock.funcIsMember != nil {
+		mmIsMember.mock.t.Fatalf("GroupRepositoryMock.IsMember mock is already set by Set")
+	}
+
+	if mmIsMember.defaultExpectation == nil {
+		mmIsMember.defaultExpectation = &GroupRepositoryMockIsMemberExpectation{mock: mmIsMember.mock}
+	}
+	mmIsMember.defaultExpectation.results = &GroupRepositoryMockIsMemberResults{b1, err}
+	return mmIsMember.mock
+}
+
+// Set uses given function f to mock the GroupRepository.IsMember method
+func (mmIsMember *mGroupRepositoryMockIsMember) Set(f func(ctx context.Context, name string, userID int64) (b1 bool, err error)) *GroupRepositoryMock {
+	if mmIsMember.defaultExpectation != nil {
+		mmIsMember.mock.t.Fatalf("Default expectation is already set for the GroupRepository.IsMember method")
+	}
+
+	if len(mmIsMember.expectations) > 0 {
+		mmIsMember.mock.t.Fatalf("Some expectations are already set for the GroupRepository.IsMember method")
+	}
+
+	mmIsMember.mock.funcIsMember = f
+	return mmIsMember.mock
+}
+
+// When sets expectation for the GroupRepository.IsMember which will trigger the result defined by the following
+// Then helper
+func (mmIsMember *mGroupRepositoryMockIsMember) When(ctx context.Context, name string, userID int64) *GroupRepositoryMockIsMemberExpectation {
+	if mmIsMember.mock.funcIsMember != nil {
+		mmIsMember.mock.t.Fatalf("GroupRepositoryMock.IsMember mock is already set by Set")
+	}
+
+	expectation := &GroupRepositoryMockIsMemberExpectation{
+		mock:   mmIsMember.mock,
+		params: &GroupRepositoryMockIsMemberParams{ctx, name, userID},
+	}
+	mmIsMember.expectations = append(mmIsMember.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GroupRepository.IsMember return parameters for the expectation previously defined by the When method
+func (e *GroupRepositoryMockIsMemberExpectation) Then(b1 bool, err error) *GroupRepositoryMock {
+	e.results = &GroupRepositoryMockIsMemberResults{b1, err}
+	return e.mock
+}
+
+// IsMember implements repository.GroupRepository
+func (mmIsMember *GroupRepositoryMock) IsMember(ctx context.Context, name string, userID int64) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmIsMember.beforeIsMemberCounter, 1)
+	defer mm_atomic.AddUint64(&mmIsMember.afterIsMemberCounter, 1)
+
+	if mmIsMember.inspectFuncIsMember != nil {
+		mmIsMember.inspectFuncIsMember(ctx, name, userID)
+	}
+
+	mm_params := GroupRepositoryMockIsMemberParams{ctx, name, userID}
+
+	// Record call args
+	mmIsMember.IsMemberMock.mutex.Lock()
+	mmIsMember.IsMemberMock.callArgs = append(mmIsMember.IsMemberMock.callArgs, &mm_params)
+	mmIsMember.IsMemberMock.mutex.Unlock()
+
+	for _, e := range mmIsMember.IsMemberMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmIsMember.IsMemberMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIsMember.IsMemberMock.defaultExpectation.Counter, 1)
+		mm_want := mmIsMember.IsMemberMock.defaultExpectation.params
+		mm_want_ptrs := mmIsMember.IsMemberMock.defaultExpectation.paramPtrs
+
+		mm_got := GroupRepositoryMockIsMemberParams{ctx, name, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmIsMember.t.Errorf("GroupRepositoryMock.IsMember got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmIsMember.t.Errorf("GroupRepositoryMock.IsMember got unexpected parameter name, want: %#v, got: %#v%s\n", *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmIsMember.t.Errorf("GroupRepositoryMock.IsMember got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmIsMember.t.Errorf("GroupRepositoryMock.IsMember got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmIsMember.IsMemberMock.defaultExpectation.results
+		if mm_results == nil {
+			mmIsMember.t.Fatal("No results are set for the GroupRepositoryMock.IsMember")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmIsMember.funcIsMember != nil {
+		return mmIsMember.funcIsMember(ctx, name, userID)
+	}
+	mmIsMember.t.Fatalf("Unexpected call to GroupRepositoryMock.IsMember. %v %v %v", ctx, name, userID)
+	return
+}
+
+// IsMemberAfterCounter returns a count of finished GroupRepositoryMock.IsMember invocations
+func (mmIsMember *GroupRepositoryMock) IsMemberAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsMember.afterIsMemberCounter)
+}
+
+// IsMemberBeforeCounter returns a count of GroupRepositoryMock.IsMember invocations
+func (mmIsMember *GroupRepositoryMock) IsMemberBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsMember.beforeIsMemberCounter)
+}
+
+// Calls returns a list of arguments used in each call to GroupRepositoryMock.IsMember.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmIsMember *mGroupRepositoryMockIsMember) Calls() []*GroupRepositoryMockIsMemberParams {
+	mmIsMember.mutex.RLock()
+
+	argCopy := make([]*GroupRepositoryMockIsMemberParams, len(mmIsMember.callArgs))
+	copy(argCopy, mmIsMember.callArgs)
+
+	mmIsMember.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockIsMemberDone returns true if the count of the IsMember invocations corresponds
+// the number of defined expectations
+func (m *GroupRepositoryMock) MinimockIsMemberDone() bool {
+	for _, e := range m.IsMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IsMemberMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterIsMemberCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIsMember != nil && mm_atomic.LoadUint64(&m.afterIsMemberCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockIsMemberInspect logs each unmet expectation
+func (m *GroupRepositoryMock) MinimockIsMemberInspect() {
+	for _, e := range m.IsMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GroupRepositoryMock.IsMember with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IsMemberMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterIsMemberCounter) < 1 {
+		if m.IsMemberMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GroupRepositoryMock.IsMember")
+		} else {
+			m.t.Errorf("Expected call to GroupRepositoryMock.IsMember with params: %#v", *m.IsMemberMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIsMember != nil && mm_atomic.LoadUint64(&m.afterIsMemberCounter) < 1 {
+		m.t.Error("Expected call to GroupRepositoryMock.IsMember")
+	}
+}
+
+type mGroupRepositoryMockLockParents struct {
+	mock               *GroupRepositoryMock
+	defaultExpectation *GroupRepositoryMockLockParentsExpectation
+	expectations       []*GroupRepositoryMockLockParentsExpectation
+
+	callArgs []*GroupRepositoryMockLockParentsParams
+	mutex    sync.RWMutex
+}
+
+// GroupRepositoryMockLockParentsExpectation specifies expectation struct of the GroupRepository.LockParents
+type GroupRepositoryMockLockParentsExpectation struct {
+	mock      *GroupRepositoryMock
+	params    *GroupRepositoryMockLockParentsParams
+	paramPtrs *GroupRepositoryMockLockParentsParamPtrs
+	results   *GroupRepositoryMockLockParentsResults
+	Counter   uint64
+}
+
+// GroupRepositoryMockLockParentsParams contains parameters of the GroupRepository.LockParents
+type GroupRepositoryMockLockParentsParams struct {
+	ctx context.Context
+}
+
+// GroupRepositoryMockLockParentsParamPtrs contains pointers to parameters of the GroupRepository.LockParents
+type GroupRepositoryMockLockParentsParamPtrs struct {
+	ctx *context.Context
+}
+
+// GroupRepositoryMockLockParentsResults contains results of the GroupRepository.LockParents
+type GroupRepositoryMockLockParentsResults struct {
+	err error
+}
+
+// Expect sets up expected params for GroupRepository.LockParents
+func (mmLockParents *mGroupRepositoryMockLockParents) Expect(ctx context.Context) *mGroupRepositoryMockLockParents {
+	if mmLockParents.mock.funcLockParents != nil {
+		mmLockParents.mock.t.Fatalf("GroupRepositoryMock.LockParents mock is already set by Set")
+	}
+
+	if mmLockParents.defaultExpectation == nil {
+		mmLockParents.defaultExpectation = &GroupRepositoryMockLockParentsExpectation{}
+	}
+
+	if mmLockParents.defaultExpectation.paramPtrs != nil {
+		mmLockParents.mock.t.Fatalf("GroupRepositoryMock.LockParents mock is already set by ExpectParams functions")
+	}
+
+	mmLockParents.defaultExpectation.params = &GroupRepositoryMockLockParentsParams{ctx}
+	for _, e := range mmLockParents.expectations {
+		if minimock.Equal(e.params, mmLockParents.defaultExpectation.params) {
+			mmLockParents.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLockParents.defaultExpectation.params)
+		}
+	}
+
+	return mmLockParents
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GroupRepository.LockParents
+func (mmLockParents *mGroupRepositoryMockLockParents) ExpectCtxParam1(ctx context.Context) *mGroupRepositoryMockLockParents {
+	if mmLockParents.mock.funcLockParents != nil {
+		mmLockParents.mock.t.Fatalf("GroupRepositoryMock.LockParents mock is already set by Set")
+	}
+
+	if mmLockParents.defaultExpectation == nil {
+		mmLockParents.defaultExpectation = &GroupRepositoryMockLockParentsExpectation{}
+	}
+
+	if mmLockParents.defaultExpectation.params != nil {
+		mmLockParents.mock.t.Fatalf("GroupRepositoryMock.LockParents mock is already set by Expect")
+	}
+
+	if mmLockParents.defaultExpectation.paramPtrs == nil {
+		mmLockParents.defaultExpectation.paramPtrs = &GroupRepositoryMockLockParentsParamPtrs{}
+	}
+	mmLockParents.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmLockParents
+}
+
+// Inspect accepts an inspector function that has same arguments as the GroupRepository.LockParents
+func (mmLockParents *mGroupRepositoryMockLockParents) Inspect(f func(ctx context.Context)) *mGroupRepositoryMockLockParents {
+	if mmLockParents.mock.inspectFuncLockParents != nil {
+		mmLockParents.mock.t.Fatalf("Inspect function is already set for GroupRepositoryMock.LockParents")
+	}
+
+	mmLockParents.mock.inspectFuncLockParents = f
+
+	return mmLockParents
+}
+
+// Return sets up results that will be returned by GroupRepository.LockParents
+func (mmLockParents *mGroupRepositoryMockLockParents) Return(err error) *GroupRepositoryMock {
+	if mmLockParents.mock.funcLockParents != nil {
+		mmLockParents.mock.t.Fatalf("GroupRepositoryMock.LockParents mock is already set by Set")
+	}
+
+	if mmLockParents.defaultExpectation == nil {
+		mmLockParents.defaultExpectation = &GroupRepositoryMockLockParentsExpectation{mock: mmLockParents.mock}
+	}
+	mmLockParents.defaultExpectation.results = &GroupRepositoryMockLockParentsResults{err}
+	return mmLockParents.mock
+}
+
+// Set uses given function f to mock the GroupRepository.LockParents method
+func (mmLockParents *mGroupRepositoryMockLockParents) Set(f func(ctx context.Context) (err error)) *GroupRepositoryMock {
+	if mmLockParents.defaultExpectation != nil {
+		mmLockParents.mock.t.Fatalf("Default expectation is already set for the GroupRepository.LockParents method")
+	}
+
+	if len(mmLockParents.expectations) > 0 {
+		mmLockParents.mock.t.Fatalf("Some expectations are already set for the GroupRepository.LockParents method")
+	}
+
+	mmLockParents.mock.funcLockParents = f
+	return mmLockParents.mock
+}
+
+// When sets expectation for the GroupRepository.LockParents which will trigger the result defined by the following
+// Then helper
+func (mmLockParents *mGroupRepositoryMockLockParents) When(ctx context.Context) *GroupRepositoryMockLockParentsExpectation {
+	if mmLockParents.mock.funcLockParents != nil {
+		mmLockParents.mock.t.Fatalf("GroupRepositoryMock.LockParents mock is already set by Set")
+	}
+
+	expectation := &GroupRepositoryMockLockParentsExpectation{
+		mock:   mmLockParents.mock,
+		params: &GroupRepositoryMockLockParentsParams{ctx},
+	}
+	mmLockParents.expectations = append(mmLockParents.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GroupRepository.LockParents return parameters for the expectation previously defined by the When method
+func (e *GroupRepositoryMockLockParentsExpectation) Then(err error) *GroupRepositoryMock {
+	e.results = &GroupRepositoryMockLockParentsResults{err}
+	return e.mock
+}
+
+// LockParents implements repository.GroupRepository
+func (mmLockParents *GroupRepositoryMock) LockParents(ctx context.Context) (err error) {
+	mm_atomic.AddUint64(&mmLockParents.beforeLockParentsCounter, 1)
+	defer mm_atomic.AddUint64(&mmLockParents.afterLockParentsCounter, 1)
+
+	if mmLockParents.inspectFuncLockParents != nil {
+		mmLockParents.inspectFuncLockParents(ctx)
+	}
+
+	mm_params := GroupRepositoryMockLockParentsParams{ctx}
+
+	// Record call args
+	mmLockParents.LockParentsMock.mutex.Lock()
+	mmLockParents.LockParentsMock.callArgs = append(mmLockParents.LockParentsMock.callArgs, &mm_params)
+	mmLockParents.LockParentsMock.mutex.Unlock()
+
+	for _, e := range mmLockParents.LockParentsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmLockParents.LockParentsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLockParents.LockParentsMock.defaultExpectation.Counter, 1)
+		mm_want := mmLockParents.LockParentsMock.defaultExpectation.params
+		mm_want_ptrs := mmLockParents.LockParentsMock.defaultExpectation.paramPtrs
+
+		mm_got := GroupRepositoryMockLockParentsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLockParents.t.Errorf("GroupRepositoryMock.LockParents got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLockParents.t.Errorf("GroupRepositoryMock.LockParents got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLockParents.LockParentsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLockParents.t.Fatal("No results are set for the GroupRepositoryMock.LockParents")
+		}
+		return (*mm_results).err
+	}
+	if mmLockParents.funcLockParents != nil {
+		return mmLockParents.funcLockParents(ctx)
+	}
+	mmLockParents.t.Fatalf("Unexpected call to GroupRepositoryMock.LockParents. %v", ctx)
+	return
+}
+
+// LockParentsAfterCounter returns a count of finished GroupRepositoryMock.LockParents invocations
+func (mmLockParents *GroupRepositoryMock) LockParentsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockParents.afterLockParentsCounter)
+}
+
+// LockParentsBeforeCounter returns a count of GroupRepositoryMock.LockParents invocations
+func (mmLockParents *GroupRepositoryMock) LockParentsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockParents.beforeLockParentsCounter)
+}
+
+// Calls returns a list of arguments used in each call to GroupRepositoryMock.LockParents.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLockParents *mGroupRepositoryMockLockParents) Calls() []*GroupRepositoryMockLockParentsParams {
+	mmLockParents.mutex.RLock()
+
+	argCopy := make([]*GroupRepositoryMockLockParentsParams, len(mmLockParents.callArgs))
+	copy(argCopy, mmLockParents.callArgs)
+
+	mmLockParents.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLockParentsDone returns true if the count of the LockParents invocations corresponds
+// the number of defined expectations
+func (m *GroupRepositoryMock) MinimockLockParentsDone() bool {
+	for _, e := range m.LockParentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LockParentsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterLockParentsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLockParents != nil && mm_atomic.LoadUint64(&m.afterLockParentsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockLockParentsInspect logs each unmet expectation
+func (m *GroupRepositoryMock) MinimockLockParentsInspect() {
+	for _, e := range m.LockParentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GroupRepositoryMock.LockParents with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LockParentsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterLockParentsCounter) < 1 {
+		if m.LockParentsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GroupRepositoryMock.LockParents")
+		} else {
+			m.t.Errorf("Expected call to GroupRepositoryMock.LockParents with params: %#v", *m.LockParentsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLockParents != nil && mm_atomic.LoadUint64(&m.afterLockParentsCounter) < 1 {
+		m.t.Error("Expected call to GroupRepositoryMock.LockParents")
+	}
+}
+
+type mGroupRepositoryMockRemoveMember struct {
+	mock               *GroupRepositoryMock
+	defaultExpectation *GroupRepositoryMockRemoveMemberExpectation
+	expectations       []*GroupRepositoryMockRemoveMemberExpectation
+
+	callArgs []*GroupRepositoryMockRemoveMemberParams
+	mutex    sync.RWMutex
+}
+
+// GroupRepositoryMockRemoveMemberExpectation specifies expectation struct of the GroupRepository.RemoveMember
+type GroupRepositoryMockRemoveMemberExpectation struct {
+	mock      *GroupRepositoryMock
+	params    *GroupRepositoryMockRemoveMemberParams
+	paramPtrs *GroupRepositoryMockRemoveMemberParamPtrs
+	results   *GroupRepositoryMockRemoveMemberResults
+	Counter   uint64
+}
+
+// GroupRepositoryMockRemoveMemberParams contains parameters of the GroupRepository.RemoveMember
+type GroupRepositoryMockRemoveMemberParams struct {
+	ctx     context.Context
+	groupID int64
+	userID  int64
+}
+
+// GroupRepositoryMockRemoveMemberParamPtrs contains pointers to parameters of the GroupRepository.RemoveMember
+type GroupRepositoryMockRemoveMemberParamPtrs struct {
+	ctx     *context.Context
+	groupID *int64
+	userID  *int64
+}
+
+// GroupRepositoryMockRemoveMemberResults contains results of the GroupRepository.RemoveMember
+type GroupRepositoryMockRemoveMemberResults struct {
+	err error
+}
+
+// Expect sets up expected params for GroupRepository.RemoveMember
+func (mmRemoveMember *mGroupRepositoryMockRemoveMember) Expect(ctx context.Context, groupID int64, userID int64) *mGroupRepositoryMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("GroupRepositoryMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &GroupRepositoryMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs != nil {
+		mmRemoveMember.mock.t.Fatalf("GroupRepositoryMock.RemoveMember mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveMember.defaultExpectation.params = &GroupRepositoryMockRemoveMemberParams{ctx, groupID, userID}
+	for _, e := range mmRemoveMember.expectations {
+		if minimock.Equal(e.params, mmRemoveMember.defaultExpectation.params) {
+			mmRemoveMember.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveMember.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveMember
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GroupRepository.RemoveMember
+func (mmRemoveMember *mGroupRepositoryMockRemoveMember) ExpectCtxParam1(ctx context.Context) *mGroupRepositoryMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("GroupRepositoryMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &GroupRepositoryMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.params != nil {
+		mmRemoveMember.mock.t.Fatalf("GroupRepositoryMock.RemoveMember mock is already set by Expect")
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveMember.defaultExpectation.paramPtrs = &GroupRepositoryMockRemoveMemberParamPtrs{}
+	}
+	mmRemoveMember.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRemoveMember
+}
+
+// ExpectGroupIDParam2 sets up expected param groupID for GroupRepository.RemoveMember
+func (mmRemoveMember *mGroupRepositoryMockRemoveMember) ExpectGroupIDParam2(groupID int64) *mGroupRepositoryMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("GroupRepositoryMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &GroupRepositoryMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.params != nil {
+		mmRemoveMember.mock.t.Fatalf("GroupRepositoryMock.RemoveMember mock is already set by Expect")
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveMember.defaultExpectation.paramPtrs = &GroupRepositoryMockRemoveMemberParamPtrs{}
+	}
+	mmRemoveMember.defaultExpectation.paramPtrs.groupID = &groupID
+
+	return mmRemoveMember
+}
+
+// ExpectUserIDParam3 sets up expected param userID for GroupRepository.RemoveMember
+func (mmRemoveMember *mGroupRepositoryMockRemoveMember) ExpectUserIDParam3(userID int64) *mGroupRepositoryMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("GroupRepositoryMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &GroupRepositoryMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.params != nil {
+		mmRemoveMember.mock.t.Fatalf("GroupRepositoryMock.RemoveMember mock is already set by Expect")
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveMember.defaultExpectation.paramPtrs = &GroupRepositoryMockRemoveMemberParamPtrs{}
+	}
+	mmRemoveMember.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmRemoveMember
+}
+
+// Inspect accepts an inspector function that has same arguments as the GroupRepository.RemoveMember
+func (mmRemoveMember *mGroupRepositoryMockRemoveMember) Inspect(f func(ctx context.Context, groupID int64, userID int64)) *mGroupRepositoryMockRemoveMember {
+	if mmRemoveMember.mock.inspectFuncRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("Inspect function is already set for GroupRepositoryMock.RemoveMember")
+	}
+
+	mmRemoveMember.mock.inspectFuncRemoveMember = f
+
+	return mmRemoveMember
+}
+
+// Return sets up results that will be returned by GroupRepository.RemoveMember
+func (mmRemoveMember *mGroupRepositoryMockRemoveMember) Return(err error) *GroupRepositoryMock {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("GroupRepositoryMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &GroupRepositoryMockRemoveMemberExpectation{mock: mmRemoveMember.mock}
+	}
+	mmRemoveMember.defaultExpectation.results = &GroupRepositoryMockRemoveMemberResults{err}
+	return mmRemoveMember.mock
+}
+
+// Set uses given function f to mock the GroupRepository.RemoveMember method
+func (mmRemoveMember *mGroupRepositoryMockRemoveMember) Set(f func(ctx context.Context, groupID int64, userID int64) (err error)) *GroupRepositoryMock {
+	if mmRemoveMember.defaultExpectation != nil {
+		mmRemoveMember.mock.t.Fatalf("Default expectation is already set for the GroupRepository.RemoveMember method")
+	}
+
+	if len(mmRemoveMember.expectations) > 0 {
+		mmRemoveMember.mock.t.Fatalf("Some expectations are already set for the GroupRepository.RemoveMember method")
+	}
+
+	mmRemoveMember.mock.funcRemoveMember = f
+	return mmRemoveMember.mock
+}
+
+// When sets expectation for the GroupRepository.RemoveMember which will trigger the result defined by the following
+// Then helper
+func (mmRemoveMember *mGroupRepositoryMockRemoveMember) When(ctx context.Context, groupID int64, userID int64) *GroupRepositoryMockRemoveMemberExpectation {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("GroupRepositoryMock.RemoveMember mock is already set by Set")
+	}
+
+	expectation := &GroupRepositoryMockRemoveMemberExpectation{
+		mock:   mmRemoveMember.mock,
+		params: &GroupRepositoryMockRemoveMemberParams{ctx, groupID, userID},
+	}
+	mmRemoveMember.expectations = append(mmRemoveMember.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GroupRepository.RemoveMember return parameters for the expectation previously defined by the When method
+func (e *GroupRepositoryMockRemoveMemberExpectation) Then(err error) *GroupRepositoryMock {
+	e.results = &GroupRepositoryMockRemoveMemberResults{err}
+	return e.mock
+}
+
+// RemoveMember implements repository.GroupRepository
+func (mmRemoveMember *GroupRepositoryMock) RemoveMember(ctx context.Context, groupID int64, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmRemoveMember.beforeRemoveMemberCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveMember.afterRemoveMemberCounter, 1)
+
+	if mmRemoveMember.inspectFuncRemoveMember != nil {
+		mmRemoveMember.inspectFuncRemoveMember(ctx, groupID, userID)
+	}
+
+	mm_params := GroupRepositoryMockRemoveMemberParams{ctx, groupID, userID}
+
+	// Record call args
+	mmRemoveMember.RemoveMemberMock.mutex.Lock()
+	mmRemoveMember.RemoveMemberMock.callArgs = append(mmRemoveMember.RemoveMemberMock.callArgs, &mm_params)
+	mmRemoveMember.RemoveMemberMock.mutex.Unlock()
+
+	for _, e := range mmRemoveMember.RemoveMemberMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRemoveMember.RemoveMemberMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveMember.RemoveMemberMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveMember.RemoveMemberMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveMember.RemoveMemberMock.defaultExpectation.paramPtrs
+
+		mm_got := GroupRepositoryMockRemoveMemberParams{ctx, groupID, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveMember.t.Errorf("GroupRepositoryMock.RemoveMember got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.groupID != nil && !minimock.Equal(*mm_want_ptrs.groupID, mm_got.groupID) {
+				mmRemoveMember.t.Errorf("GroupRepositoryMock.RemoveMember got unexpected parameter groupID, want: %#v, got: %#v%s\n", *mm_want_ptrs.groupID, mm_got.groupID, minimock.Diff(*mm_want_ptrs.groupID, mm_got.groupID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRemoveMember.t.Errorf("GroupRepositoryMock.RemoveMember got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveMember.t.Errorf("GroupRepositoryMock.RemoveMember got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveMember.RemoveMemberMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveMember.t.Fatal("No results are set for the GroupRepositoryMock.RemoveMember")
+		}
+		return (*mm_results).err
+	}
+	if mmRemoveMember.funcRemoveMember != nil {
+		return mmRemoveMember.funcRemoveMember(ctx, groupID, userID)
+	}
+	mmRemoveMember.t.Fatalf("Unexpected call to GroupRepositoryMock.RemoveMember. %v %v %v", ctx, groupID, userID)
+	return
+}
+
+// RemoveMemberAfterCounter returns a count of finished GroupRepositoryMock.RemoveMember invocations
+func (mmRemoveMember *GroupRepositoryMock) RemoveMemberAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveMember.afterRemoveMemberCounter)
+}
+
+// RemoveMemberBeforeCounter returns a count of GroupRepositoryMock.RemoveMember invocations
+func (mmRemoveMember *GroupRepositoryMock) RemoveMemberBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveMember.beforeRemoveMemberCounter)
+}
+
+// Calls returns a list of arguments used in each call to GroupRepositoryMock.RemoveMember.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveMember *mGroupRepositoryMockRemoveMember) Calls() []*GroupRepositoryMockRemoveMemberParams {
+	mmRemoveMember.mutex.RLock()
+
+	argCopy := make([]*GroupRepositoryMockRemoveMemberParams, len(mmRemoveMember.callArgs))
+	copy(argCopy, mmRemoveMember.callArgs)
+
+	mmRemoveMember.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveMemberDone returns true if the count of the RemoveMember invocations corresponds
+// the number of defined expectations
+func (m *GroupRepositoryMock) MinimockRemoveMemberDone() bool {
+	for _, e := range m.RemoveMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveMemberMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRemoveMemberCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveMember != nil && mm_atomic.LoadUint64(&m.afterRemoveMemberCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockRemoveMemberInspect logs each unmet expectation
+func (m *GroupRepositoryMock) MinimockRemoveMemberInspect() {
+	for _, e := range m.RemoveMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GroupRepositoryMock.RemoveMember with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveMemberMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRemoveMemberCounter) < 1 {
+		if m.RemoveMemberMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GroupRepositoryMock.RemoveMember")
+		} else {
+			m.t.Errorf("Expected call to GroupRepositoryMock.RemoveMember with params: %#v", *m.RemoveMemberMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveMember != nil && mm_atomic.LoadUint64(&m.afterRemoveMemberCounter) < 1 {
+		m.t.Error("Expected call to GroupRepositoryMock.RemoveMember")
+	}
+}
+
+type mGroupRepositoryMockRemoveParent struct {
+	mock               *GroupRepositoryMock
+	defaultExpectation *GroupRepositoryMockRemoveParentExpectation
+	expectations       []*GroupRepositoryMockRemoveParentExpectation
+
+	callArgs []*GroupRepositoryMockRemoveParentParams
+	mutex    sync.RWMutex
+}
+
+// GroupRepositoryMockRemoveParentExpectation specifies expectation struct of the GroupRepository.RemoveParent
+type GroupRepositoryMockRemoveParentExpectation struct {
+	mock      *GroupRepositoryMock
+	params    *GroupRepositoryMockRemoveParentParams
+	paramPtrs *GroupRepositoryMockRemoveParentParamPtrs
+	results   *GroupRepositoryMockRemoveParentResults
+	Counter   uint64
+}
+
+// GroupRepositoryMockRemoveParentParams contains parameters of the GroupRepository.RemoveParent
+type GroupRepositoryMockRemoveParentParams struct {
+	ctx      context.Context
+	groupID  int64
+	parentID int64
+}
+
+// GroupRepositoryMockRemoveParentParamPtrs contains pointers to parameters of the GroupRepository.RemoveParent
+type GroupRepositoryMockRemoveParentParamPtrs struct {
+	ctx      *context.Context
+	groupID  *int64
+	parentID *int64
+}
+
+// GroupRepositoryMockRemoveParentResults contains results of the GroupRepository.RemoveParent
+type GroupRepositoryMockRemoveParentResults struct {
+	err error
+}
+
+// Expect sets up expected params for GroupRepository.RemoveParent
+func (mmRemoveParent *mGroupRepositoryMockRemoveParent) Expect(ctx context.Context, groupID int64, parentID int64) *mGroupRepositoryMockRemoveParent {
+	if mmRemoveParent.mock.funcRemoveParent != nil {
+		mmRemoveParent.mock.t.Fatalf("GroupRepositoryMock.RemoveParent mock is already set by Set")
+	}
+
+	if mmRemoveParent.defaultExpectation == nil {
+		mmRemoveParent.defaultExpectation = &GroupRepositoryMockRemoveParentExpectation{}
+	}
+
+	if mmRemoveParent.defaultExpectation.paramPtrs != nil {
+		mmRemoveParent.mock.t.Fatalf("GroupRepositoryMock.RemoveParent mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveParent.defaultExpectation.params = &GroupRepositoryMockRemoveParentParams{ctx, groupID, parentID}
+	for _, e := range mmRemoveParent.expectations {
+		if minimock.Equal(e.params, mmRemoveParent.defaultExpectation.params) {
+			mmRemoveParent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveParent.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveParent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GroupRepository.RemoveParent
+func (mmRemoveParent *mGroupRepositoryMockRemoveParent) ExpectCtxParam1(ctx context.Context) *mGroupRepositoryMockRemoveParent {
+	if mmRemoveParent.mock.funcRemoveParent != nil {
+		mmRemoveParent.mock.t.Fatalf("GroupRepositoryMock.RemoveParent mock is already set by Set")
+	}
+
+	if mmRemoveParent.defaultExpectation == nil {
+		mmRemoveParent.defaultExpectation = &GroupRepositoryMockRemoveParentExpectation{}
+	}
+
+	if mmRemoveParent.defaultExpectation.params != nil {
+		mmRemoveParent.mock.t.Fatalf("GroupRepositoryMock.RemoveParent mock is already set by Expect")
+	}
+
+	if mmRemoveParent.defaultExpectation.paramPtrs == nil {
+		mmRemoveParent.defaultExpectation.paramPtrs = &GroupRepositoryMockRemoveParentParamPtrs{}
+	}
+	mmRemoveParent.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRemoveParent
+}
+
+// ExpectGroupIDParam2 sets up expected param groupID for GroupRepository.RemoveParent
+func (mmRemoveParent *mGroupRepositoryMockRemoveParent) ExpectGroupIDParam2(groupID int64) *mGroupRepositoryMockRemoveParent {
+	if mmRemoveParent.mock.funcRemoveParent != nil {
+		mmRemoveParent.mock.t.Fatalf("GroupRepositoryMock.RemoveParent mock is already set by Set")
+	}
+
+	if mmRemoveParent.defaultExpectation == nil {
+		mmRemoveParent.defaultExpectation = &GroupRepositoryMockRemoveParentExpectation{}
+	}
+
+	if mmRemoveParent.defaultExpectation.params != nil {
+		mmRemoveParent.mock.t.Fatalf("GroupRepositoryMock.RemoveParent mock is already set by Expect")
+	}
+
+	if mmRemoveParent.defaultExpectation.paramPtrs == nil {
+		mmRemoveParent.defaultExpectation.paramPtrs = &GroupRepositoryMockRemoveParentParamPtrs{}
+	}
+	mmRemoveParent.defaultExpectation.paramPtrs.groupID = &groupID
+
+	return mmRemoveParent
+}
+
+// ExpectParentIDParam3 sets up expected param parentID for GroupRepository.RemoveParent
+func (mmRemoveParent *mGroupRepositoryMockRemoveParent) ExpectParentIDParam3(parentID int64) *mGroupRepositoryMockRemoveParent {
+	if mmRemoveParent.mock.funcRemoveParent != nil {
+		mmRemoveParent.mock.t.Fatalf("GroupRepositoryMock.RemoveParent mock is already set by Set")
+	}
+
+	if mmRemoveParent.defaultExpectation == nil {
+		mmRemoveParent.defaultExpectation = &GroupRepositoryMockRemoveParentExpectation{}
+	}
+
+	if mmRemoveParent.defaultExpectation.params != nil {
+		mmRemoveParent.mock.t.Fatalf("GroupRepositoryMock.RemoveParent mock is already set by Expect")
+	}
+
+	if mmRemoveParent.defaultExpectation.paramPtrs == nil {
+		mmRemoveParent.defaultExpectation.paramPtrs = &GroupRepositoryMockRemoveParentParamPtrs{}
+	}
+	mmRemoveParent.defaultExpectation.paramPtrs.parentID = &parentID
+
+	return mmRemoveParent
+}
+
+// Inspect accepts an inspector function that has same arguments as the GroupRepository.RemoveParent
+func (mmRemoveParent *mGroupRepositoryMockRemoveParent) Inspect(f func(ctx context.Context, groupID int64, parentID int64)) *mGroupRepositoryMockRemoveParent {
+	if mmRemoveParent.mock.inspectFuncRemoveParent != nil {
+		mmRemoveParent.mock.t.Fatalf("Inspect function is already set for GroupRepositoryMock.RemoveParent")
+	}
+
+	mmRemoveParent.mock.inspectFuncRemoveParent = f
+
+	return mmRemoveParent
+}
+
+// Return sets up results that will be returned by GroupRepository.RemoveParent
+func (mmRemoveParent *mGroupRepositoryMockRemoveParent) Return(err error) *GroupRepositoryMock {
+	if mmRemoveParent.mock.funcRemoveParent != nil {
+		mmRemoveParent.mock.t.Fatalf("GroupRepositoryMock.RemoveParent mock is already set by Set")
+	}
+
+	if mmRemoveParent.defaultExpectation == nil {
+		mmRemoveParent.defaultExpectation = &GroupRepositoryMockRemoveParentExpectation{mock: mmRemoveParent.mock}
+	}
+	mmRemoveParent.defaultExpectation.results = &GroupRepositoryMockRemoveParentResults{err}
+	return mmRemoveParent.mock
+}
+
+// Set uses given function f to mock the GroupRepository.RemoveParent method
+func (mmRemoveParent *mGroupRepositoryMockRemoveParent) Set(f func(ctx context.Context, groupID int64, parentID int64) (err error)) *GroupRepositoryMock {
+	if mmRemoveParent.defaultExpectation != nil {
+		mmRemoveParent.mock.t.Fatalf("Default expectation is already set for the GroupRepository.RemoveParent method")
+	}
+
+	if len(mmRemoveParent.expectations) > 0 {
+		mmRemoveParent.mock.t.Fatalf("Some expectations are already set for the GroupRepository.RemoveParent method")
+	}
+
+	mmRemoveParent.mock.funcRemoveParent = f
+	return mmRemoveParent.mock
+}
+
+// When sets expectation for the GroupRepository.RemoveParent which will trigger the result defined by the following
+// Then helper
+func (mmRemoveParent *mGroupRepositoryMockRemoveParent) When(ctx context.Context, groupID int64, parentID int64) *GroupRepositoryMockRemoveParentExpectation {
+	if mmRemoveParent.mock.funcRemoveParent != nil {
+		mmRemoveParent.mock.t.Fatalf("GroupRepositoryMock.RemoveParent mock is already set by Set")
+	}
+
+	expectation := &GroupRepositoryMockRemoveParentExpectation{
+		mock:   mmRemoveParent.mock,
+		params: &GroupRepositoryMockRemoveParentParams{ctx, groupID, parentID},
+	}
+	mmRemoveParent.expectations = append(mmRemoveParent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GroupRepository.RemoveParent return parameters for the expectation previously defined by the When method
+func (e *GroupRepositoryMockRemoveParentExpectation) Then(err error) *GroupRepositoryMock {
+	e.results = &GroupRepositoryMockRemoveParentResults{err}
+	return e.mock
+}
+
+// RemoveParent implements repository.GroupRepository
+func (mmRemoveParent *GroupRepositoryMock) RemoveParent(ctx context.Context, groupID int64, parentID int64) (err error) {
+	mm_atomic.AddUint64(&mmRemoveParent.beforeRemoveParentCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveParent.afterRemoveParentCounter, 1)
+
+	if mmRemoveParent.inspectFuncRemoveParent != nil {
+		mmRemoveParent.inspectFuncRemoveParent(ctx, groupID, parentID)
+	}
+
+	mm_params := GroupRepositoryMockRemoveParentParams{ctx, groupID, parentID}
+
+	// Record call args
+	mmRemoveParent.RemoveParentMock.mutex.Lock()
+	mmRemoveParent.RemoveParentMock.callArgs = append(mmRemoveParent.RemoveParentMock.callArgs, &mm_params)
+	mmRemoveParent.RemoveParentMock.mutex.Unlock()
+
+	for _, e := range mmRemoveParent.RemoveParentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRemoveParent.RemoveParentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveParent.RemoveParentMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveParent.RemoveParentMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveParent.RemoveParentMock.defaultExpectation.paramPtrs
+
+		mm_got := GroupRepositoryMockRemoveParentParams{ctx, groupID, parentID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveParent.t.Errorf("GroupRepositoryMock.RemoveParent got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.groupID != nil && !minimock.Equal(*mm_want_ptrs.groupID, mm_got.groupID) {
+				mmRemoveParent.t.Errorf("GroupRepositoryMock.RemoveParent got unexpected parameter groupID, want: %#v, got: %#v%s\n", *mm_want_ptrs.groupID, mm_got.groupID, minimock.Diff(*mm_want_ptrs.groupID, mm_got.groupID))
+			}
+
+			if mm_want_ptrs.parentID != nil && !minimock.Equal(*mm_want_ptrs.parentID, mm_got.parentID) {
+				mmRemoveParent.t.Errorf("GroupRepositoryMock.RemoveParent got unexpected parameter parentID, want: %#v, got: %#v%s\n", *mm_want_ptrs.parentID, mm_got.parentID, minimock.Diff(*mm_want_ptrs.parentID, mm_got.parentID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveParent.t.Errorf("GroupRepositoryMock.RemoveParent got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveParent.RemoveParentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveParent.t.Fatal("No results are set for the GroupRepositoryMock.RemoveParent")
+		}
+		return (*mm_results).err
+	}
+	if mmRemoveParent.funcRemoveParent != nil {
+		return mmRemoveParent.funcRemoveParent(ctx, groupID, parentID)
+	}
+	mmRemoveParent.t.Fatalf("Unexpected call to GroupRepositoryMock.RemoveParent. %v %v %v", ctx, groupID, parentID)
+	return
+}
+
+// RemoveParentAfterCounter returns a count of finished GroupRepositoryMock.RemoveParent invocations
+func (mmRemoveParent *GroupRepositoryMock) RemoveParentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveParent.afterRemoveParentCounter)
+}
+
+// RemoveParentBeforeCounter returns a count of GroupRepositoryMock.RemoveParent invocations
+func (mmRemoveParent *GroupRepositoryMock) RemoveParentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveParent.beforeRemoveParentCounter)
+}
+
+// Calls returns a list of arguments used in each call to GroupRepositoryMock.RemoveParent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveParent *mGroupRepositoryMockRemoveParent) Calls() []*GroupRepositoryMockRemoveParentParams {
+	mmRemoveParent.mutex.RLock()
+
+	argCopy := make([]*GroupRepositoryMockRemoveParentParams, len(mmRemoveParent.callArgs))
+	copy(argCopy, mmRemoveParent.callArgs)
+
+	mmRemoveParent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveParentDone returns true if the count of the RemoveParent invocations corresponds
+// the number of defined expectations
+func (m *GroupRepositoryMock) MinimockRemoveParentDone() bool {
+	for _, e := range m.RemoveParentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveParentMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRemoveParentCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveParent != nil && mm_atomic.LoadUint64(&m.afterRemoveParentCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockRemoveParentInspect logs each unmet expectation
+func (m *GroupRepositoryMock) MinimockRemoveParentInspect() {
+	for _, e := range m.RemoveParentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GroupRepositoryMock.RemoveParent with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveParentMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRemoveParentCounter) < 1 {
+		if m.RemoveParentMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GroupRepositoryMock.RemoveParent")
+		} else {
+			m.t.Errorf("Expected call to GroupRepositoryMock.RemoveParent with params: %#v", *m.RemoveParentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveParent != nil && mm_atomic.LoadUint64(&m.afterRemoveParentCounter) < 1 {
+		m.t.Error("Expected call to GroupRepositoryMock.RemoveParent")
+	}
+}
+
+type mGroupRepositoryMockRevokeMemberTokens struct {
+	mock               *GroupRepositoryMock
+	defaultExpectation *GroupRepositoryMockRevokeMemberTokensExpectation
+	expectations       []*GroupRepositoryMockRevokeMemberTokensExpectation
+
+	callArgs []*GroupRepositoryMockRevokeMemberTokensParams
+	mutex    sync.RWMutex
+}
+
+// GroupRepositoryMockRevokeMemberTokensExpectation specifies expectation struct of the GroupRepository.RevokeMemberTokens
+type GroupRepositoryMockRevokeMemberTokensExpectation struct {
+	mock      *GroupRepositoryMock
+	params    *GroupRepositoryMockRevokeMemberTokensParams
+	paramPtrs *GroupRepositoryMockRevokeMemberTokensParamPtrs
+	results   *GroupRepositoryMockRevokeMemberTokensResults
+	Counter   uint64
+}
+
+// GroupRepositoryMockRevokeMemberTokensParams contains parameters of the GroupRepository.RevokeMemberTokens
+type GroupRepositoryMockRevokeMemberTokensParams struct {
+	ctx       context.Context
+	id        int64
+	revokedAt time.Time
+}
+
+// GroupRepositoryMockRevokeMemberTokensParamPtrs contains pointers to parameters of the GroupRepository.RevokeMemberTokens
+type GroupRepositoryMockRevokeMemberTokensParamPtrs struct {
+	ctx       *context.Context
+	id        *int64
+	revokedAt *time.Time
+}
+
+// GroupRepositoryMockRevokeMemberTokensResults contains results of the GroupRepository.RevokeMemberTokens
+type GroupRepositoryMockRevokeMemberTokensResults struct {
+	err error
+}
+
+// Expect sets up expected params for GroupRepository.RevokeMemberTokens
+func (mmRevokeMemberTokens *mGroupRepositoryMockRevokeMemberTokens) Expect(ctx context.Context, id int64, revokedAt time.Time) *mGroupRepositoryMockRevokeMemberTokens {
+	if mmRevokeMemberTokens.mock.funcRevokeMemberTokens != nil {
+		mmRevokeMemberTokens.mock.t.Fatalf("GroupRepositoryMock.RevokeMemberTokens mock is already set by Set")
+	}
+
+	if mmRevokeMemberTokens.defaultExpectation == nil {
+		mmRevokeMemberTokens.defaultExpectation = &GroupRepositoryMockRevokeMemberTokensExpectation{}
+	}
+
+	if mmRevokeMemberTokens.defaultExpectation.paramPtrs != nil {
+		mmRevokeMemberTokens.mock.t.Fatalf("GroupRepositoryMock.RevokeMemberTokens mock is already set by ExpectParams functions")
+	}
+
+	mmRevokeMemberTokens.defaultExpectation.params = &GroupRepositoryMockRevokeMemberTokensParams{ctx, id, revokedAt}
+	for _, e := range mmRevokeMemberTokens.expectations {
+		if minimock.Equal(e.params, mmRevokeMemberTokens.defaultExpectation.params) {
+			mmRevokeMemberTokens.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeMemberTokens.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokeMemberTokens
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GroupRepository.RevokeMemberTokens
+func (mmRevokeMemberTokens *mGroupRepositoryMockRevokeMemberTokens) ExpectCtxParam1(ctx context.Context) *mGroupRepositoryMockRevokeMemberTokens {
+	if mmRevokeMemberTokens.mock.funcRevokeMemberTokens != nil {
+		mmRevokeMemberTokens.mock.t.Fatalf("GroupRepositoryMock.RevokeMemberTokens mock is already set by Set")
+	}
+
+	if mmRevokeMemberTokens.defaultExpectation == nil {
+		mmRevokeMemberTokens.defaultExpectation = &GroupRepositoryMockRevokeMemberTokensExpectation{}
+	}
+
+	if mmRevokeMemberTokens.defaultExpectation.params != nil {
+		mmRevokeMemberTokens.mock.t.Fatalf("GroupRepositoryMock.RevokeMemberTokens mock is already set by Expect")
+	}
+
+	if mmRevokeMemberTokens.defaultExpectation.paramPtrs == nil {
+		mmRevokeMemberTokens.defaultExpectation.paramPtrs = &GroupRepositoryMockRevokeMemberTokensParamPtrs{}
+	}
+	mmRevokeMemberTokens.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRevokeMemberTokens
+}
+
+// ExpectIdParam2 sets up expected param id for GroupRepository.RevokeMemberTokens
+func (mmRevokeMemberTokens *mGroupRepositoryMockRevokeMemberTokens) ExpectIdParam2(id int64) *mGroupRepositoryMockRevokeMemberTokens {
+	if mmRevokeMemberTokens.mock.funcRevokeMemberTokens != nil {
+		mmRevokeMemberTokens.mock.t.Fatalf("GroupRepositoryMock.RevokeMemberTokens mock is already set by Set")
+	}
+
+	if mmRevokeMemberTokens.defaultExpectation == nil {
+		mmRevokeMemberTokens.defaultExpectation = &GroupRepositoryMockRevokeMemberTokensExpectation{}
+	}
+
+	if mmRevokeMemberTokens.defaultExpectation.params != nil {
+		mmRevokeMemberTokens.mock.t.Fatalf("GroupRepositoryMock.RevokeMemberTokens mock is already set by Expect")
+	}
+
+	if mmRevokeMemberTokens.defaultExpectation.paramPtrs == nil {
+		mmRevokeMemberTokens.defaultExpectation.paramPtrs = &GroupRepositoryMockRevokeMemberTokensParamPtrs{}
+	}
+	mmRevokeMemberTokens.defaultExpectation.paramPtrs.id = &id
+
+	return mmRevokeMemberTokens
+}
+
+// ExpectRevokedAtParam3 sets up expected param revokedAt for GroupRepository.RevokeMemberTokens
+func (mmRevokeMemberTokens *mGroupRepositoryMockRevokeMemberTokens) ExpectRevokedAtParam3(revokedAt time.Time) *mGroupRepositoryMockRevokeMemberTokens {
+	if mmRevokeMemberTokens.mock.funcRevokeMemberTokens != nil {
+		mmRevokeMemberTokens.mock.t.Fatalf("GroupRepositoryMock.RevokeMemberTokens mock is already set by Set")
+	}
+
+	if mmRevokeMemberTokens.defaultExpectation == nil {
+		mmRevokeMemberTokens.defaultExpectation = &GroupRepositoryMockRevokeMemberTokensExpectation{}
+	}
+
+	if mmRevokeMemberTokens.defaultExpectation.params != nil {
+		mmRevokeMemberTokens.mock.t.Fatalf("GroupRepositoryMock.RevokeMemberTokens mock is already set by Expect")
+	}
+
+	if mmRevokeMemberTokens.defaultExpectation.paramPtrs == nil {
+		mmRevokeMemberTokens.defaultExpectation.paramPtrs = &GroupRepositoryMockRevokeMemberTokensParamPtrs{}
+	}
+	mmRevokeMemberTokens.defaultExpectation.paramPtrs.revokedAt = &revokedAt
+
+	return mmRevokeMemberTokens
+}
+
+// Inspect accepts an inspector function that has same arguments as the GroupRepository.RevokeMemberTokens
+func (mmRevokeMemberTokens *mGroupRepositoryMockRevokeMemberTokens) Inspect(f func(ctx context.Context, id int64, revokedAt time.Time)) *mGroupRepositoryMockRevokeMemberTokens {
+	if mmRevokeMemberTokens.mock.inspectFuncRevokeMemberTokens != nil {
+		mmRevokeMemberTokens.mock.t.Fatalf("Inspect function is already set for GroupRepositoryMock.RevokeMemberTokens")
+	}
+
+	mmRevokeMemberTokens.mock.inspectFuncRevokeMemberTokens = f
+
+	return mmRevokeMemberTokens
+}
+
+// Return sets up results that will be returned by GroupRepository.RevokeMemberTokens
+func (mmRevokeMemberTokens *mGroupRepositoryMockRevokeMemberTokens) Return(err error) *GroupRepositoryMock {
+	if mmRevokeMemberTokens.mock.funcRevokeMemberTokens != nil {
+		mmRevokeMemberTokens.mock.t.Fatalf("GroupRepositoryMock.RevokeMemberTokens mock is already set by Set")
+	}
+
+	if mmRevokeMemberTokens.defaultExpectation == nil {
+		mmRevokeMemberTokens.defaultExpectation = &GroupRepositoryMockRevokeMemberTokensExpectation{mock: mmRevokeMemberTokens.mock}
+	}
+	mmRevokeMemberTokens.defaultExpectation.results = &GroupRepositoryMockRevokeMemberTokensResults{err}
+	return mmRevokeMemberTokens.mock
+}
+
+// Set uses given function f to mock the GroupRepository.RevokeMemberTokens method
+func (mmRevokeMemberTokens *mGroupRepositoryMockRevokeMemberTokens) Set(f func(ctx context.Context, id int64, revokedAt time.Time) (err error)) *GroupRepositoryMock {
+	if mmRevokeMemberTokens.defaultExpectation != nil {
+		mmRevokeMemberTokens.mock.t.Fatalf("Default expectation is already set for the GroupRepository.RevokeMemberTokens method")
+	}
+
+	if len(mmRevokeMemberTokens.expectations) > 0 {
+		mmRevokeMemberTokens.mock.t.Fatalf("Some expectations are already set for the GroupRepository.RevokeMemberTokens method")
+	}
+
+	mmRevokeMemberTokens.mock.funcRevokeMemberTokens = f
+	return mmRevokeMemberTokens.mock
+}
+
+// When sets expectation for the GroupRepository.RevokeMemberTokens which will trigger the result defined by the following
+// Then helper
+func (mmRevokeMemberTokens *mGroupRepositoryMockRevokeMemberTokens) When(ctx context.Context, id int64, revokedAt time.Time) *GroupRepositoryMockRevokeMemberTokensExpectation {
+	if mmRevokeMemberTokens.mock.funcRevokeMemberTokens != nil {
+		mmRevokeMemberTokens.mock.t.Fatalf("GroupRepositoryMock.RevokeMemberTokens mock is already set by Set")
+	}
+
+	expectation := &GroupRepositoryMockRevokeMemberTokensExpectation{
+		mock:   mmRevokeMemberTokens.mock,
+		params: &GroupRepositoryMockRevokeMemberTokensParams{ctx, id, revokedAt},
+	}
+	mmRevokeMemberTokens.expectations = append(mmRevokeMemberTokens.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GroupRepository.RevokeMemberTokens return parameters for the expectation previously defined by the When method
+func (e *GroupRepositoryMockRevokeMemberTokensExpectation) Then(err error) *GroupRepositoryMock {
+	e.results = &GroupRepositoryMockRevokeMemberTokensResults{err}
+	return e.mock
+}
+
+// RevokeMemberTokens implements repository.GroupRepository
+func (mmRevokeMemberTokens *GroupRepositoryMock) RevokeMemberTokens(ctx context.Context, id int64, revokedAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmRevokeMemberTokens.beforeRevokeMemberTokensCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeMemberTokens.afterRevokeMemberTokensCounter, 1)
+
+	if mmRevokeMemberTokens.inspectFuncRevokeMemberTokens != nil {
+		mmRevokeMemberTokens.inspectFuncRevokeMemberTokens(ctx, id, revokedAt)
+	}
+
+	mm_params := GroupRepositoryMockRevokeMemberTokensParams{ctx, id, revokedAt}
+
+	// Record call args
+	mmRevokeMemberTokens.RevokeMemberTokensMock.mutex.Lock()
+	mmRevokeMemberTokens.RevokeMemberTokensMock.callArgs = append(mmRevokeMemberTokens.RevokeMemberTokensMock.callArgs, &mm_params)
+	mmRevokeMemberTokens.RevokeMemberTokensMock.mutex.Unlock()
+
+	for _, e := range mmRevokeMemberTokens.RevokeMemberTokensMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevokeMemberTokens.RevokeMemberTokensMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeMemberTokens.RevokeMemberTokensMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeMemberTokens.RevokeMemberTokensMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokeMemberTokens.RevokeMemberTokensMock.defaultExpectation.paramPtrs
+
+		mm_got := GroupRepositoryMockRevokeMemberTokensParams{ctx, id, revokedAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevokeMemberTokens.t.Errorf("GroupRepositoryMock.RevokeMemberTokens got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmRevokeMemberTokens.t.Errorf("GroupRepositoryMock.RevokeMemberTokens got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.revokedAt != nil && !minimock.Equal(*mm_want_ptrs.revokedAt, mm_got.revokedAt) {
+				mmRevokeMemberTokens.t.Errorf("GroupRepositoryMock.RevokeMemberTokens got unexpected parameter revokedAt, want: %#v, got: %#v%s\n", *mm_want_ptrs.revokedAt, mm_got.revokedAt, minimock.Diff(*mm_want_ptrs.revokedAt, mm_got.revokedAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevokeMemberTokens.t.Errorf("GroupRepositoryMock.RevokeMemberTokens got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevokeMemberTokens.RevokeMemberTokensMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevokeMemberTokens.t.Fatal("No results are set for the GroupRepositoryMock.RevokeMemberTokens")
+		}
+		return (*mm_results).err
+	}
+	if mmRevokeMemberTokens.funcRevokeMemberTokens != nil {
+		return mmRevokeMemberTokens.funcRevokeMemberTokens(ctx, id, revokedAt)
+	}
+	mmRevokeMemberTokens.t.Fatalf("Unexpected call to GroupRepositoryMock.RevokeMemberTokens. %v %v %v", ctx, id, revokedAt)
+	return
+}
+
+// RevokeMemberTokensAfterCounter returns a count of finished GroupRepositoryMock.RevokeMemberTokens invocations
+func (mmRevokeMemberTokens *GroupRepositoryMock) RevokeMemberTokensAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeMemberTokens.afterRevokeMemberTokensCounter)
+}
+
+// RevokeMemberTokensBeforeCounter returns a count of GroupRepositoryMock.RevokeMemberTokens invocations
+func (mmRevokeMemberTokens *GroupRepositoryMock) RevokeMemberTokensBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeMemberTokens.beforeRevokeMemberTokensCounter)
+}
+
+// Calls returns a list of arguments used in each call to GroupRepositoryMock.RevokeMemberTokens.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevokeMemberTokens *mGroupRepositoryMockRevokeMemberTokens) Calls() []*GroupRepositoryMockRevokeMemberTokensParams {
+	mmRevokeMemberTokens.mutex.RLock()
+
+	argCopy := make([]*GroupRepositoryMockRevokeMemberTokensParams, len(mmRevokeMemberTokens.callArgs))
+	copy(argCopy, mmRevokeMemberTokens.callArgs)
+
+	mmRevokeMemberTokens.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeMemberTokensDone returns true if the count of the RevokeMemberTokens invocations corresponds
+// the number of defined expectations
+func (m *GroupRepositoryMock) MinimockRevokeMemberTokensDone() bool {
+	for _, e := range m.RevokeMemberTokensMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeMemberTokensMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRevokeMemberTokensCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokeMemberTokens != nil && mm_atomic.LoadUint64(&m.afterRevokeMemberTokensCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockRevokeMemberTokensInspect logs each unmet expectation
+func (m *GroupRepositoryMock) MinimockRevokeMemberTokensInspect() {
+	for _, e := range m.RevokeMemberTokensMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GroupRepositoryMock.RevokeMemberTokens with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeMemberTokensMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRevokeMemberTokensCounter) < 1 {
+		if m.RevokeMemberTokensMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GroupRepositoryMock.RevokeMemberTokens")
+		} else {
+			m.t.Errorf("Expected call to GroupRepositoryMock.RevokeMemberTokens with params: %#v", *m.RevokeMemberTokensMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokeMemberTokens != nil && mm_atomic.LoadUint64(&m.afterRevokeMemberTokensCounter) < 1 {
+		m.t.Error("Expected call to GroupRepositoryMock.RevokeMemberTokens")
+	}
+}
+
+type mGroupRepositoryMockSetRoles struct {
+	mock               *GroupRepositoryMock
+	defaultExpectation *GroupRepositoryMockSetRolesExpectation
+	expectations       []*GroupRepositoryMockSetRolesExpectation
+
+	callArgs []*GroupRepositoryMockSetRolesParams
+	mutex    sync.RWMutex
+}
+
+// GroupRepositoryMockSetRolesExpectation specifies expectation struct of the GroupRepository.SetRoles
+type GroupRepositoryMockSetRolesExpectation struct {
+	mock      *GroupRepositoryMock
+	params    *GroupRepositoryMockSetRolesParams
+	paramPtrs *GroupRepositoryMockSetRolesParamPtrs
+	results   *GroupRepositoryMockSetRolesResults
+	Counter   uint64
+}
+
+// GroupRepositoryMockSetRolesParams contains parameters of the GroupRepository.SetRoles
+type GroupRepositoryMockSetRolesParams struct {
+	ctx   context.Context
+	id    int64
+	roles []model.Role
+}
+
+// GroupRepositoryMockSetRolesParamPtrs contains pointers to parameters of the GroupRepository.SetRoles
+type GroupRepositoryMockSetRolesParamPtrs struct {
+	ctx   *context.Context
+	id    *int64
+	roles *[]model.Role
+}
+
+// GroupRepositoryMockSetRolesResults contains results of the GroupRepository.SetRoles
+type GroupRepositoryMockSetRolesResults struct {
+	err error
+}
+
+// Expect sets up expected params for GroupRepository.SetRoles
+func (mmSetRoles *mGroupRepositoryMockSetRoles) Expect(ctx context.Context, id int64, roles []model.Role) *mGroupRepositoryMockSetRoles {
+	if mmSetRoles.mock.funcSetRoles != nil {
+		mmSetRoles.mock.t.Fatalf("GroupRepositoryMock.SetRoles mock is already set by Set")
+	}
+
+	if mmSetRoles.defaultExpectation == nil {
+		mmSetRoles.defaultExpectation = &GroupRepositoryMockSetRolesExpectation{}
+	}
+
+	if mmSetRoles.defaultExpectation.paramPtrs != nil {
+		mmSetRoles.mock.t.Fatalf("GroupRepositoryMock.SetRoles mock is already set by ExpectParams functions")
+	}
+
+	mmSetRoles.defaultExpectation.params = &GroupRepositoryMockSetRolesParams{ctx, id, roles}
+	for _, e := range mmSetRoles.expectations {
+		if minimock.Equal(e.params, mmSetRoles.defaultExpectation.params) {
+			mmSetRoles.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetRoles.defaultExpectation.params)
+		}
+	}
+
+	return mmSetRoles
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GroupRepository.SetRoles
+func (mmSetRoles *mGroupRepositoryMockSetRoles) ExpectCtxParam1(ctx context.Context) *mGroupRepositoryMockSetRoles {
+	if mmSetRoles.mock.funcSetRoles != nil {
+		mmSetRoles.mock.t.Fatalf("GroupRepositoryMock.SetRoles mock is already set by Set")
+	}
+
+	if mmSetRoles.defaultExpectation == nil {
+		mmSetRoles.defaultExpectation = &GroupRepositoryMockSetRolesExpectation{}
+	}
+
+	if mmSetRoles.defaultExpectation.params != nil {
+		mmSetRoles.mock.t.Fatalf("GroupRepositoryMock.SetRoles mock is already set by Expect")
+	}
+
+	if mmSetRoles.defaultExpectation.paramPtrs == nil {
+		mmSetRoles.defaultExpectation.paramPtrs = &GroupRepositoryMockSetRolesParamPtrs{}
+	}
+	mmSetRoles.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmSetRoles
+}
+
+// ExpectIdParam2 sets up expected param id for GroupRepository.SetRoles
+func (mmSetRoles *mGroupRepositoryMockSetRoles) ExpectIdParam2(id int64) *mGroupRepositoryMockSetRoles {
+	if mmSetRoles.mock.funcSetRoles != nil {
+		mmSetRoles.mock.t.Fatalf("GroupRepositoryMock.SetRoles mock is already set by Set")
+	}
+
+	if mmSetRoles.defaultExpectation == nil {
+		mmSetRoles.defaultExpectation = &GroupRepositoryMockSetRolesExpectation{}
+	}
+
+	if mmSetRoles.defaultExpectation.params != nil {
+		mmSetRoles.mock.t.Fatalf("GroupRepositoryMock.SetRoles mock is already set by Expect")
+	}
+
+	if mmSetRoles.defaultExpectation.paramPtrs == nil {
+		mmSetRoles.defaultExpectation.paramPtrs = &GroupRepositoryMockSetRolesParamPtrs{}
+	}
+	mmSetRoles.defaultExpectation.paramPtrs.id = &id
+
+	return mmSetRoles
+}
+
+// ExpectRolesParam3 sets up expected param roles for GroupRepository.SetRoles
+func (mmSetRoles *mGroupRepositoryMockSetRoles) ExpectRolesParam3(roles []model.Role) *mGroupRepositoryMockSetRoles {
+	if mmSetRoles.mock.funcSetRoles != nil {
+		mmSetRoles.mock.t.Fatalf("GroupRepositoryMock.SetRoles mock is already set by Set")
+	}
+
+	if mmSetRoles.defaultExpectation == nil {
+		mmSetRoles.defaultExpectation = &GroupRepositoryMockSetRolesExpectation{}
+	}
+
+	if mmSetRoles.defaultExpectation.params != nil {
+		mmSetRoles.mock.t.Fatalf("GroupRepositoryMock.SetRoles mock is already set by Expect")
+	}
+
+	if mmSetRoles.defaultExpectation.paramPtrs == nil {
+		mmSetRoles.defaultExpectation.paramPtrs = &GroupRepositoryMockSetRolesParamPtrs{}
+	}
+	mmSetRoles.defaultExpectation.paramPtrs.roles = &roles
+
+	return mmSetRoles
+}
+
+// Inspect accepts an inspector function that has same arguments as the GroupRepository.SetRoles
+func (mmSetRoles *mGroupRepositoryMockSetRoles) Inspect(f func(ctx context.Context, id int64, roles []model.Role)) *mGroupRepositoryMockSetRoles {
+	if mmSetRoles.mock.inspectFuncSetRoles != nil {
+		mmSetRoles.mock.t.Fatalf("Inspect function is already set for GroupRepositoryMock.SetRoles")
+	}
+
+	mmSetRoles.mock.inspectFuncSetRoles = f
+
+	return mmSetRoles
+}
+
+// Return sets up results that will be returned by GroupRepository.SetRoles
+func (mmSetRoles *mGroupRepositoryMockSetRoles) Return(err error) *GroupRepositoryMock {
+	if mmSetRoles.mock.funcSetRoles != nil {
+		mmSetRoles.mock.t.Fatalf("GroupRepositoryMock.SetRoles mock is already set by Set")
+	}
+
+	if mmSetRoles.defaultExpectation == nil {
+		mmSetRoles.defaultExpectation = &GroupRepositoryMockSetRolesExpectation{mock: mmSetRoles.mock}
+	}
+	mmSetRoles.defaultExpectation.results = &GroupRepositoryMockSetRolesResults{err}
+	return mmSetRoles.mock
+}
+
+// Set uses given function f to mock the GroupRepository.SetRoles method
+func (mmSetRoles *mGroupRepositoryMockSetRoles) Set(f func(ctx context.Context, id int64, roles []model.Role) (err error)) *GroupRepositoryMock {
+	if mmSetRoles.defaultExpectation != nil {
+		mmSetRoles.mock.t.Fatalf("Default expectation is already set for the GroupRepository.SetRoles method")
+	}
+
+	if len(mmSetRoles.expectations) > 0 {
+		mmSetRoles.mock.t.Fatalf("Some expectations are already set for the GroupRepository.SetRoles method")
+	}
+
+	mmSetRoles.mock.funcSetRoles = f
+	return mmSetRoles.mock
+}
+
+// When sets expectation for the GroupRepository.SetRoles which will trigger the result defined by the following
+// Then helper
+func (mmSetRoles *mGroupRepositoryMockSetRoles) When(ctx context.Context, id int64, roles []model.Role) *GroupRepositoryMockSetRolesExpectation {
+	if mmSetRoles.mock.funcSetRoles != nil {
+		mmSetRoles.mock.t.Fatalf("GroupRepositoryMock.SetRoles mock is already set by Set")
+	}
+
+	expectation := &GroupRepositoryMockSetRolesExpectation{
+		mock:   mmSetRoles.mock,
+		params: &GroupRepositoryMockSetRolesParams{ctx, id, roles},
+	}
+	mmSetRoles.expectations = append(mmSetRoles.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GroupRepository.SetRoles return parameters for the expectation previously defined by the When method
+func (e *GroupRepositoryMockSetRolesExpectation) Then(err error) *GroupRepositoryMock {
+	e.results = &GroupRepositoryMockSetRolesResults{err}
+	return e.mock
+}
+
+// SetRoles implements repository.GroupRepository
+func (mmSetRoles *GroupRepositoryMock) SetRoles(ctx context.Context, id int64, roles []model.Role) (err error) {
+	mm_atomic.AddUint64(&mmSetRoles.beforeSetRolesCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetRoles.afterSetRolesCounter, 1)
+
+	if mmSetRoles.inspectFuncSetRoles != nil {
+		mmSetRoles.inspectFuncSetRoles(ctx, id, roles)
+	}
+
+	mm_params := GroupRepositoryMockSetRolesParams{ctx, id, roles}
+
+	// Record call args
+	mmSetRoles.SetRolesMock.mutex.Lock()
+	mmSetRoles.SetRolesMock.callArgs = append(mmSetRoles.SetRolesMock.callArgs, &mm_params)
+	mmSetRoles.SetRolesMock.mutex.Unlock()
+
+	for _, e := range mmSetRoles.SetRolesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetRoles.SetRolesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetRoles.SetRolesMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetRoles.SetRolesMock.defaultExpectation.params
+		mm_want_ptrs := mmSetRoles.SetRolesMock.defaultExpectation.paramPtrs
+
+		mm_got := GroupRepositoryMockSetRolesParams{ctx, id, roles}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetRoles.t.Errorf("GroupRepositoryMock.SetRoles got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmSetRoles.t.Errorf("GroupRepositoryMock.SetRoles got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.roles != nil && !minimock.Equal(*mm_want_ptrs.roles, mm_got.roles) {
+				mmSetRoles.t.Errorf("GroupRepositoryMock.SetRoles got unexpected parameter roles, want: %#v, got: %#v%s\n", *mm_want_ptrs.roles, mm_got.roles, minimock.Diff(*mm_want_ptrs.roles, mm_got.roles))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetRoles.t.Errorf("GroupRepositoryMock.SetRoles got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetRoles.SetRolesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetRoles.t.Fatal("No results are set for the GroupRepositoryMock.SetRoles")
+		}
+		return (*mm_results).err
+	}
+	if mmSetRoles.funcSetRoles != nil {
+		return mmSetRoles.funcSetRoles(ctx, id, roles)
+	}
+	mmSetRoles.t.Fatalf("Unexpected call to GroupRepositoryMock.SetRoles. %v %v %v", ctx, id, roles)
+	return
+}
+
+// SetRolesAfterCounter returns a count of finished GroupRepositoryMock.SetRoles invocations
+func (mmSetRoles *GroupRepositoryMock) SetRolesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetRoles.afterSetRolesCounter)
+}
+
+// SetRolesBeforeCounter returns a count of GroupRepositoryMock.SetRoles invocations
+func (mmSetRoles *GroupRepositoryMock) SetRolesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetRoles.beforeSetRolesCounter)
+}
+
+// Calls returns a list of arguments used in each call to GroupRepositoryMock.SetRoles.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetRoles *mGroupRepositoryMockSetRoles) Calls() []*GroupRepositoryMockSetRolesParams {
+	mmSetRoles.mutex.RLock()
+
+	argCopy := make([]*GroupRepositoryMockSetRolesParams, len(mmSetRoles.callArgs))
+	copy(argCopy, mmSetRoles.callArgs)
+
+	mmSetRoles.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetRolesDone returns true if the count of the SetRoles invocations corresponds
+// the number of defined expectations
+func (m *GroupRepositoryMock) MinimockSetRolesDone() bool {
+	for _, e := range m.SetRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetRolesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetRolesCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetRoles != nil && mm_atomic.LoadUint64(&m.afterSetRolesCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSetRolesInspect logs each unmet expectation
+func (m *GroupRepositoryMock) MinimockSetRolesInspect() {
+	for _, e := range m.SetRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GroupRepositoryMock.SetRoles with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetRolesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetRolesCounter) < 1 {
+		if m.SetRolesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GroupRepositoryMock.SetRoles")
+		} else {
+			m.t.Errorf("Expected call to GroupRepositoryMock.SetRoles with params: %#v", *m.SetRolesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetRoles != nil && mm_atomic.LoadUint64(&m.afterSetRolesCounter) < 1 {
+		m.t.Error("Expected call to GroupRepositoryMock.SetRoles")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *GroupRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddMemberInspect()
+
+			m.MinimockAddParentInspect()
+
+			m.MinimockCreateInspect()
+
+			m.MinimockDeleteInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockGetRoleGraphInspect()
+
+			m.MinimockIsMemberInspect()
+
+			m.MinimockLockParentsInspect()
+
+			m.MinimockRemoveMemberInspect()
+
+			m.MinimockRemoveParentInspect()
+
+			m.MinimockRevokeMemberTokensInspect()
+
+			m.MinimockSetRolesInspect()
+			m.t.FailNow()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *GroupRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *GroupRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddMemberDone() &&
+		m.MinimockAddParentDone() &&
+		m.MinimockCreateDone() &&
+		m.MinimockDeleteDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockGetRoleGraphDone() &&
+		m.MinimockIsMemberDone() &&
+		m.MinimockLockParentsDone() &&
+		m.MinimockRemoveMemberDone() &&
+		m.MinimockRemoveParentDone() &&
+		m.MinimockRevokeMemberTokensDone() &&
+		m.MinimockSetRolesDone()
+}
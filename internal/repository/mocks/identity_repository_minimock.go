@@ -0,0 +1,685 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.8). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/arifullov/auth/internal/repository.IdentityRepository -o identity_repository_minimock.go -n IdentityRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// IdentityRepositoryMock implements repository.IdentityRepository
+type IdentityRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, identity *model.UserIdentity) (i1 int64, err error)
+	inspectFuncCreate   func(ctx context.Context, identity *model.UserIdentity)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mIdentityRepositoryMockCreate
+
+	funcGetUserID          func(ctx context.Context, provider string, subject string) (i1 int64, err error)
+	inspectFuncGetUserID   func(ctx context.Context, provider string, subject string)
+	afterGetUserIDCounter  uint64
+	beforeGetUserIDCounter uint64
+	GetUserIDMock          mIdentityRepositoryMockGetUserID
+}
+
+// NewIdentityRepositoryMock returns a mock for repository.IdentityRepository
+func NewIdentityRepositoryMock(t minimock.Tester) *IdentityRepositoryMock {
+	m := &IdentityRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mIdentityRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*IdentityRepositoryMockCreateParams{}
+
+	m.GetUserIDMock = mIdentityRepositoryMockGetUserID{mock: m}
+	m.GetUserIDMock.callArgs = []*IdentityRepositoryMockGetUserIDParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mIdentityRepositoryMockCreate struct {
+	mock               *IdentityRepositoryMock
+	defaultExpectation *IdentityRepositoryMockCreateExpectation
+	expectations       []*IdentityRepositoryMockCreateExpectation
+
+	callArgs []*IdentityRepositoryMockCreateParams
+	mutex    sync.RWMutex
+}
+
+// IdentityRepositoryMockCreateExpectation specifies expectation struct of the IdentityRepository.Create
+type IdentityRepositoryMockCreateExpectation struct {
+	mock      *IdentityRepositoryMock
+	params    *IdentityRepositoryMockCreateParams
+	paramPtrs *IdentityRepositoryMockCreateParamPtrs
+	results   *IdentityRepositoryMockCreateResults
+	Counter   uint64
+}
+
+// IdentityRepositoryMockCreateParams contains parameters of the IdentityRepository.Create
+type IdentityRepositoryMockCreateParams struct {
+	ctx      context.Context
+	identity *model.UserIdentity
+}
+
+// IdentityRepositoryMockCreateParamPtrs contains pointers to parameters of the IdentityRepository.Create
+type IdentityRepositoryMockCreateParamPtrs struct {
+	ctx      *context.Context
+	identity **model.UserIdentity
+}
+
+// IdentityRepositoryMockCreateResults contains results of the IdentityRepository.Create
+type IdentityRepositoryMockCreateResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for IdentityRepository.Create
+func (mmCreate *mIdentityRepositoryMockCreate) Expect(ctx context.Context, identity *model.UserIdentity) *mIdentityRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("IdentityRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &IdentityRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("IdentityRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &IdentityRepositoryMockCreateParams{ctx, identity}
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IdentityRepository.Create
+func (mmCreate *mIdentityRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mIdentityRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("IdentityRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &IdentityRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("IdentityRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &IdentityRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreate
+}
+
+// ExpectIdentityParam2 sets up expected param identity for IdentityRepository.Create
+func (mmCreate *mIdentityRepositoryMockCreate) ExpectIdentityParam2(identity *model.UserIdentity) *mIdentityRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("IdentityRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &IdentityRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("IdentityRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &IdentityRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.identity = &identity
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the IdentityRepository.Create
+func (mmCreate *mIdentityRepositoryMockCreate) Inspect(f func(ctx context.Context, identity *model.UserIdentity)) *mIdentityRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for IdentityRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by IdentityRepository.Create
+func (mmCreate *mIdentityRepositoryMockCreate) Return(i1 int64, err error) *IdentityRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("IdentityRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &IdentityRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &IdentityRepositoryMockCreateResults{i1, err}
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the IdentityRepository.Create method
+func (mmCreate *mIdentityRepositoryMockCreate) Set(f func(ctx context.Context, identity *model.UserIdentity) (i1 int64, err error)) *IdentityRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the IdentityRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the IdentityRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	return mmCreate.mock
+}
+
+// When sets expectation for the IdentityRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mIdentityRepositoryMockCreate) When(ctx context.Context, identity *model.UserIdentity) *IdentityRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("IdentityRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &IdentityRepositoryMockCreateExpectation{
+		mock:   mmCreate.mock,
+		params: &IdentityRepositoryMockCreateParams{ctx, identity},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IdentityRepository.Create return parameters for the expectation previously defined by the When method
+func (e *IdentityRepositoryMockCreateExpectation) Then(i1 int64, err error) *IdentityRepositoryMock {
+	e.results = &IdentityRepositoryMockCreateResults{i1, err}
+	return e.mock
+}
+
+// Create implements repository.IdentityRepository
+func (mmCreate *IdentityRepositoryMock) Create(ctx context.Context, identity *model.UserIdentity) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, identity)
+	}
+
+	mm_params := IdentityRepositoryMockCreateParams{ctx, identity}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := IdentityRepositoryMockCreateParams{ctx, identity}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("IdentityRepositoryMock.Create got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.identity != nil && !minimock.Equal(*mm_want_ptrs.identity, mm_got.identity) {
+				mmCreate.t.Errorf("IdentityRepositoryMock.Create got unexpected parameter identity, want: %#v, got: %#v%s\n", *mm_want_ptrs.identity, mm_got.identity, minimock.Diff(*mm_want_ptrs.identity, mm_got.identity))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("IdentityRepositoryMock.Create got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the IdentityRepositoryMock.Create")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, identity)
+	}
+	mmCreate.t.Fatalf("Unexpected call to IdentityRepositoryMock.Create. %v %v", ctx, identity)
+	return
+}
+
+// CreateAfterCounter returns a count of finished IdentityRepositoryMock.Create invocations
+func (mmCreate *IdentityRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of IdentityRepositoryMock.Create invocations
+func (mmCreate *IdentityRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to IdentityRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mIdentityRepositoryMockCreate) Calls() []*IdentityRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*IdentityRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *IdentityRepositoryMock) MinimockCreateDone() bool {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *IdentityRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IdentityRepositoryMock.Create with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to IdentityRepositoryMock.Create")
+		} else {
+			m.t.Errorf("Expected call to IdentityRepositoryMock.Create with params: %#v", *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		m.t.Error("Expected call to IdentityRepositoryMock.Create")
+	}
+}
+
+type mIdentityRepositoryMockGetUserID struct {
+	mock               *IdentityRepositoryMock
+	defaultExpectation *IdentityRepositoryMockGetUserIDExpectation
+	expectations       []*IdentityRepositoryMockGetUserIDExpectation
+
+	callArgs []*IdentityRepositoryMockGetUserIDParams
+	mutex    sync.RWMutex
+}
+
+// IdentityRepositoryMockGetUserIDExpectation specifies expectation struct of the IdentityRepository.GetUserID
+type IdentityRepositoryMockGetUserIDExpectation struct {
+	mock      *IdentityRepositoryMock
+	params    *IdentityRepositoryMockGetUserIDParams
+	paramPtrs *IdentityRepositoryMockGetUserIDParamPtrs
+	results   *IdentityRepositoryMockGetUserIDResults
+	Counter   uint64
+}
+
+// IdentityRepositoryMockGetUserIDParams contains parameters of the IdentityRepository.GetUserID
+type IdentityRepositoryMockGetUserIDParams struct {
+	ctx      context.Context
+	provider string
+	subject  string
+}
+
+// IdentityRepositoryMockGetUserIDParamPtrs contains pointers to parameters of the IdentityRepository.GetUserID
+type IdentityRepositoryMockGetUserIDParamPtrs struct {
+	ctx      *context.Context
+	provider *string
+	subject  *string
+}
+
+// IdentityRepositoryMockGetUserIDResults contains results of the IdentityRepository.GetUserID
+type IdentityRepositoryMockGetUserIDResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for IdentityRepository.GetUserID
+func (mmGetUserID *mIdentityRepositoryMockGetUserID) Expect(ctx context.Context, provider string, subject string) *mIdentityRepositoryMockGetUserID {
+	if mmGetUserID.mock.funcGetUserID != nil {
+		mmGetUserID.mock.t.Fatalf("IdentityRepositoryMock.GetUserID mock is already set by Set")
+	}
+
+	if mmGetUserID.defaultExpectation == nil {
+		mmGetUserID.defaultExpectation = &IdentityRepositoryMockGetUserIDExpectation{}
+	}
+
+	if mmGetUserID.defaultExpectation.paramPtrs != nil {
+		mmGetUserID.mock.t.Fatalf("IdentityRepositoryMock.GetUserID mock is already set by ExpectParams functions")
+	}
+
+	mmGetUserID.defaultExpectation.params = &IdentityRepositoryMockGetUserIDParams{ctx, provider, subject}
+	for _, e := range mmGetUserID.expectations {
+		if minimock.Equal(e.params, mmGetUserID.defaultExpectation.params) {
+			mmGetUserID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUserID.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUserID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IdentityRepository.GetUserID
+func (mmGetUserID *mIdentityRepositoryMockGetUserID) ExpectCtxParam1(ctx context.Context) *mIdentityRepositoryMockGetUserID {
+	if mmGetUserID.mock.funcGetUserID != nil {
+		mmGetUserID.mock.t.Fatalf("IdentityRepositoryMock.GetUserID mock is already set by Set")
+	}
+
+	if mmGetUserID.defaultExpectation == nil {
+		mmGetUserID.defaultExpectation = &IdentityRepositoryMockGetUserIDExpectation{}
+	}
+
+	if mmGetUserID.defaultExpectation.params != nil {
+		mmGetUserID.mock.t.Fatalf("IdentityRepositoryMock.GetUserID mock is already set by Expect")
+	}
+
+	if mmGetUserID.defaultExpectation.paramPtrs == nil {
+		mmGetUserID.defaultExpectation.paramPtrs = &IdentityRepositoryMockGetUserIDParamPtrs{}
+	}
+	mmGetUserID.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetUserID
+}
+
+// ExpectProviderParam2 sets up expected param provider for IdentityRepository.GetUserID
+func (mmGetUserID *mIdentityRepositoryMockGetUserID) ExpectProviderParam2(provider string) *mIdentityRepositoryMockGetUserID {
+	if mmGetUserID.mock.funcGetUserID != nil {
+		mmGetUserID.mock.t.Fatalf("IdentityRepositoryMock.GetUserID mock is already set by Set")
+	}
+
+	if mmGetUserID.defaultExpectation == nil {
+		mmGetUserID.defaultExpectation = &IdentityRepositoryMockGetUserIDExpectation{}
+	}
+
+	if mmGetUserID.defaultExpectation.params != nil {
+		mmGetUserID.mock.t.Fatalf("IdentityRepositoryMock.GetUserID mock is already set by Expect")
+	}
+
+	if mmGetUserID.defaultExpectation.paramPtrs == nil {
+		mmGetUserID.defaultExpectation.paramPtrs = &IdentityRepositoryMockGetUserIDParamPtrs{}
+	}
+	mmGetUserID.defaultExpectation.paramPtrs.provider = &provider
+
+	return mmGetUserID
+}
+
+// ExpectSubjectParam3 sets up expected param subject for IdentityRepository.GetUserID
+func (mmGetUserID *mIdentityRepositoryMockGetUserID) ExpectSubjectParam3(subject string) *mIdentityRepositoryMockGetUserID {
+	if mmGetUserID.mock.funcGetUserID != nil {
+		mmGetUserID.mock.t.Fatalf("IdentityRepositoryMock.GetUserID mock is already set by Set")
+	}
+
+	if mmGetUserID.defaultExpectation == nil {
+		mmGetUserID.defaultExpectation = &IdentityRepositoryMockGetUserIDExpectation{}
+	}
+
+	if mmGetUserID.defaultExpectation.params != nil {
+		mmGetUserID.mock.t.Fatalf("IdentityRepositoryMock.GetUserID mock is already set by Expect")
+	}
+
+	if mmGetUserID.defaultExpectation.paramPtrs == nil {
+		mmGetUserID.defaultExpectation.paramPtrs = &IdentityRepositoryMockGetUserIDParamPtrs{}
+	}
+	mmGetUserID.defaultExpectation.paramPtrs.subject = &subject
+
+	return mmGetUserID
+}
+
+// Inspect accepts an inspector function that has same arguments as the IdentityRepository.GetUserID
+func (mmGetUserID *mIdentityRepositoryMockGetUserID) Inspect(f func(ctx context.Context, provider string, subject string)) *mIdentityRepositoryMockGetUserID {
+	if mmGetUserID.mock.inspectFuncGetUserID != nil {
+		mmGetUserID.mock.t.Fatalf("Inspect function is already set for IdentityRepositoryMock.GetUserID")
+	}
+
+	mmGetUserID.mock.inspectFuncGetUserID = f
+
+	return mmGetUserID
+}
+
+// Return sets up results that will be returned by IdentityRepository.GetUserID
+func (mmGetUserID *mIdentityRepositoryMockGetUserID) Return(i1 int64, err error) *IdentityRepositoryMock {
+	if mmGetUserID.mock.funcGetUserID != nil {
+		mmGetUserID.mock.t.Fatalf("IdentityRepositoryMock.GetUserID mock is already set by Set")
+	}
+
+	if mmGetUserID.defaultExpectation == nil {
+		mmGetUserID.defaultExpectation = &IdentityRepositoryMockGetUserIDExpectation{mock: mmGetUserID.mock}
+	}
+	mmGetUserID.defaultExpectation.results = &IdentityRepositoryMockGetUserIDResults{i1, err}
+	return mmGetUserID.mock
+}
+
+// Set uses given function f to mock the IdentityRepository.GetUserID method
+func (mmGetUserID *mIdentityRepositoryMockGetUserID) Set(f func(ctx context.Context, provider string, subject string) (i1 int64, err error)) *IdentityRepositoryMock {
+	if mmGetUserID.defaultExpectation != nil {
+		mmGetUserID.mock.t.Fatalf("Default expectation is already set for the IdentityRepository.GetUserID method")
+	}
+
+	if len(mmGetUserID.expectations) > 0 {
+		mmGetUserID.mock.t.Fatalf("Some expectations are already set for the IdentityRepository.GetUserID method")
+	}
+
+	mmGetUserID.mock.funcGetUserID = f
+	return mmGetUserID.mock
+}
+
+// When sets expectation for the IdentityRepository.GetUserID which will trigger the result defined by the following
+// Then helper
+func (mmGetUserID *mIdentityRepositoryMockGetUserID) When(ctx context.Context, provider string, subject string) *IdentityRepositoryMockGetUserIDExpectation {
+	if mmGetUserID.mock.funcGetUserID != nil {
+		mmGetUserID.mock.t.Fatalf("IdentityRepositoryMock.GetUserID mock is already set by Set")
+	}
+
+	expectation := &IdentityRepositoryMockGetUserIDExpectation{
+		mock:   mmGetUserID.mock,
+		params: &IdentityRepositoryMockGetUserIDParams{ctx, provider, subject},
+	}
+	mmGetUserID.expectations = append(mmGetUserID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IdentityRepository.GetUserID return parameters for the expectation previously defined by the When method
+func (e *IdentityRepositoryMockGetUserIDExpectation) Then(i1 int64, err error) *IdentityRepositoryMock {
+	e.results = &IdentityRepositoryMockGetUserIDResults{i1, err}
+	return e.mock
+}
+
+// GetUserID implements repository.IdentityRepository
+func (mmGetUserID *IdentityRepositoryMock) GetUserID(ctx context.Context, provider string, subject string) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmGetUserID.beforeGetUserIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUserID.afterGetUserIDCounter, 1)
+
+	if mmGetUserID.inspectFuncGetUserID != nil {
+		mmGetUserID.inspectFuncGetUserID(ctx, provider, subject)
+	}
+
+	mm_params := IdentityRepositoryMockGetUserIDParams{ctx, provider, subject}
+
+	// Record call args
+	mmGetUserID.GetUserIDMock.mutex.Lock()
+	mmGetUserID.GetUserIDMock.callArgs = append(mmGetUserID.GetUserIDMock.callArgs, &mm_params)
+	mmGetUserID.GetUserIDMock.mutex.Unlock()
+
+	for _, e := range mmGetUserID.GetUserIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmGetUserID.GetUserIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUserID.GetUserIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUserID.GetUserIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUserID.GetUserIDMock.defaultExpectation.paramPtrs
+
+		mm_got := IdentityRepositoryMockGetUserIDParams{ctx, provider, subject}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUserID.t.Errorf("IdentityRepositoryMock.GetUserID got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.provider != nil && !minimock.Equal(*mm_want_ptrs.provider, mm_got.provider) {
+				mmGetUserID.t.Errorf("IdentityRepositoryMock.GetUserID got unexpected parameter provider, want: %#v, got: %#v%s\n", *mm_want_ptrs.provider, mm_got.provider, minimock.Diff(*mm_want_ptrs.provider, mm_got.provider))
+			}
+
+			if mm_want_ptrs.subject != nil && !minimock.Equal(*mm_want_ptrs.subject, mm_got.subject) {
+				mmGetUserID.t.Errorf("IdentityRepositoryMock.GetUserID got unexpected parameter subject, want: %#v, got: %#v%s\n", *mm_want_ptrs.subject, mm_got.subject, minimock.Diff(*mm_want_ptrs.subject, mm_got.subject))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUserID.t.Errorf("IdentityRepositoryMock.GetUserID got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUserID.GetUserIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUserID.t.Fatal("No results are set for the IdentityRepositoryMock.GetUserID")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmGetUserID.funcGetUserID != nil {
+		return mmGetUserID.funcGetUserID(ctx, provider, subject)
+	}
+	mmGetUserID.t.Fatalf("Unexpected call to IdentityRepositoryMock.GetUserID. %v %v %v", ctx, provider, subject)
+	return
+}
+
+// GetUserIDAfterCounter returns a count of finished IdentityRepositoryMock.GetUserID invocations
+func (mmGetUserID *IdentityRepositoryMock) GetUserIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserID.afterGetUserIDCounter)
+}
+
+// GetUserIDBeforeCounter returns a count of IdentityRepositoryMock.GetUserID invocations
+func (mmGetUserID *IdentityRepositoryMock) GetUserIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserID.beforeGetUserIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to IdentityRepositoryMock.GetUserID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUserID *mIdentityRepositoryMockGetUserID) Calls() []*IdentityRepositoryMockGetUserIDParams {
+	mmGetUserID.mutex.RLock()
+
+	argCopy := make([]*IdentityRepositoryMockGetUserIDParams, len(mmGetUserID.callArgs))
+	copy(argCopy, mmGetUserID.callArgs)
+
+	mmGetUserID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserIDDone returns true if the count of the GetUserID invocations corresponds
+// the number of defined expectations
+func (m *IdentityRepositoryMock) MinimockGetUserIDDone() bool {
+	for _, e := range m.GetUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserIDMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetUserIDCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUserID != nil && mm_atomic.LoadUint64(&m.afterGetUserIDCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetUserIDInspect logs each unmet expectation
+func (m *IdentityRepositoryMock) MinimockGetUserIDInspect() {
+	for _, e := range m.GetUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IdentityRepositoryMock.GetUserID with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserIDMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetUserIDCounter) < 1 {
+		if m.GetUserIDMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to IdentityRepositoryMock.GetUserID")
+		} else {
+			m.t.Errorf("Expected call to IdentityRepositoryMock.GetUserID with params: %#v", *m.GetUserIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUserID != nil && mm_atomic.LoadUint64(&m.afterGetUserIDCounter) < 1 {
+		m.t.Error("Expected call to IdentityRepositoryMock.GetUserID")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *IdentityRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockGetUserIDInspect()
+			m.t.FailNow()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *IdentityRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *IdentityRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockGetUserIDDone()
+}
@@ -0,0 +1,1899 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.8). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/arifullov/auth/internal/repository.OrganizationRepository -o organization_repository_minimock.go -n OrganizationRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// OrganizationRepositoryMock implements repository.OrganizationRepository
+type OrganizationRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAddMember          func(ctx context.Context, member *model.OrganizationMember) (err error)
+	inspectFuncAddMember   func(ctx context.Context, member *model.OrganizationMember)
+	afterAddMemberCounter  uint64
+	beforeAddMemberCounter uint64
+	AddMemberMock          mOrganizationRepositoryMockAddMember
+
+	funcCreate          func(ctx context.Context, org *model.Organization) (i1 int64, err error)
+	inspectFuncCreate   func(ctx context.Context, org *model.Organization)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mOrganizationRepositoryMockCreate
+
+	funcGetBySlug          func(ctx context.Context, slug string) (op1 *model.Organization, err error)
+	inspectFuncGetBySlug   func(ctx context.Context, slug string)
+	afterGetBySlugCounter  uint64
+	beforeGetBySlugCounter uint64
+	GetBySlugMock          mOrganizationRepositoryMockGetBySlug
+
+	funcIsMember          func(ctx context.Context, slug string, userID int64) (b1 bool, err error)
+	inspectFuncIsMember   func(ctx context.Context, slug string, userID int64)
+	afterIsMemberCounter  uint64
+	beforeIsMemberCounter uint64
+	IsMemberMock          mOrganizationRepositoryMockIsMember
+
+	funcListByUser          func(ctx context.Context, userID int64) (oa1 []model.Organization, err error)
+	inspectFuncListByUser   func(ctx context.Context, userID int64)
+	afterListByUserCounter  uint64
+	beforeListByUserCounter uint64
+	ListByUserMock          mOrganizationRepositoryMockListByUser
+
+	funcRemoveMember          func(ctx context.Context, organizationID int64, userID int64) (err error)
+	inspectFuncRemoveMember   func(ctx context.Context, organizationID int64, userID int64)
+	afterRemoveMemberCounter  uint64
+	beforeRemoveMemberCounter uint64
+	RemoveMemberMock          mOrganizationRepositoryMockRemoveMember
+}
+
+// NewOrganizationRepositoryMock returns a mock for repository.OrganizationRepository
+func NewOrganizationRepositoryMock(t minimock.Tester) *OrganizationRepositoryMock {
+	m := &OrganizationRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddMemberMock = mOrganizationRepositoryMockAddMember{mock: m}
+	m.AddMemberMock.callArgs = []*OrganizationRepositoryMockAddMemberParams{}
+
+	m.CreateMock = mOrganizationRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*OrganizationRepositoryMockCreateParams{}
+
+	m.GetBySlugMock = mOrganizationRepositoryMockGetBySlug{mock: m}
+	m.GetBySlugMock.callArgs = []*OrganizationRepositoryMockGetBySlugParams{}
+
+	m.IsMemberMock = mOrganizationRepositoryMockIsMember{mock: m}
+	m.IsMemberMock.callArgs = []*OrganizationRepositoryMockIsMemberParams{}
+
+	m.ListByUserMock = mOrganizationRepositoryMockListByUser{mock: m}
+	m.ListByUserMock.callArgs = []*OrganizationRepositoryMockListByUserParams{}
+
+	m.RemoveMemberMock = mOrganizationRepositoryMockRemoveMember{mock: m}
+	m.RemoveMemberMock.callArgs = []*OrganizationRepositoryMockRemoveMemberParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mOrganizationRepositoryMockAddMember struct {
+	mock               *OrganizationRepositoryMock
+	defaultExpectation *OrganizationRepositoryMockAddMemberExpectation
+	expectations       []*OrganizationRepositoryMockAddMemberExpectation
+
+	callArgs []*OrganizationRepositoryMockAddMemberParams
+	mutex    sync.RWMutex
+}
+
+// OrganizationRepositoryMockAddMemberExpectation specifies expectation struct of the OrganizationRepository.AddMember
+type OrganizationRepositoryMockAddMemberExpectation struct {
+	mock      *OrganizationRepositoryMock
+	params    *OrganizationRepositoryMockAddMemberParams
+	paramPtrs *OrganizationRepositoryMockAddMemberParamPtrs
+	results   *OrganizationRepositoryMockAddMemberResults
+	Counter   uint64
+}
+
+// OrganizationRepositoryMockAddMemberParams contains parameters of the OrganizationRepository.AddMember
+type OrganizationRepositoryMockAddMemberParams struct {
+	ctx    context.Context
+	member *model.OrganizationMember
+}
+
+// OrganizationRepositoryMockAddMemberParamPtrs contains pointers to parameters of the OrganizationRepository.AddMember
+type OrganizationRepositoryMockAddMemberParamPtrs struct {
+	ctx    *context.Context
+	member **model.OrganizationMember
+}
+
+// OrganizationRepositoryMockAddMemberResults contains results of the OrganizationRepository.AddMember
+type OrganizationRepositoryMockAddMemberResults struct {
+	err error
+}
+
+// Expect sets up expected params for OrganizationRepository.AddMember
+func (mmAddMember *mOrganizationRepositoryMockAddMember) Expect(ctx context.Context, member *model.OrganizationMember) *mOrganizationRepositoryMockAddMember {
+	if mmAddMember.mock.funcAddMember != nil {
+		mmAddMember.mock.t.Fatalf("OrganizationRepositoryMock.AddMember mock is already set by Set")
+	}
+
+	if mmAddMember.defaultExpectation == nil {
+		mmAddMember.defaultExpectation = &OrganizationRepositoryMockAddMemberExpectation{}
+	}
+
+	if mmAddMember.defaultExpectation.paramPtrs != nil {
+		mmAddMember.mock.t.Fatalf("OrganizationRepositoryMock.AddMember mock is already set by ExpectParams functions")
+	}
+
+	mmAddMember.defaultExpectation.params = &OrganizationRepositoryMockAddMemberParams{ctx, member}
+	for _, e := range mmAddMember.expectations {
+		if minimock.Equal(e.params, mmAddMember.defaultExpectation.params) {
+			mmAddMember.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddMember.defaultExpectation.params)
+		}
+	}
+
+	return mmAddMember
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrganizationRepository.AddMember
+func (mmAddMember *mOrganizationRepositoryMockAddMember) ExpectCtxParam1(ctx context.Context) *mOrganizationRepositoryMockAddMember {
+	if mmAddMember.mock.funcAddMember != nil {
+		mmAddMember.mock.t.Fatalf("OrganizationRepositoryMock.AddMember mock is already set by Set")
+	}
+
+	if mmAddMember.defaultExpectation == nil {
+		mmAddMember.defaultExpectation = &OrganizationRepositoryMockAddMemberExpectation{}
+	}
+
+	if mmAddMember.defaultExpectation.params != nil {
+		mmAddMember.mock.t.Fatalf("OrganizationRepositoryMock.AddMember mock is already set by Expect")
+	}
+
+	if mmAddMember.defaultExpectation.paramPtrs == nil {
+		mmAddMember.defaultExpectation.paramPtrs = &OrganizationRepositoryMockAddMemberParamPtrs{}
+	}
+	mmAddMember.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmAddMember
+}
+
+// ExpectMemberParam2 sets up expected param member for OrganizationRepository.AddMember
+func (mmAddMember *mOrganizationRepositoryMockAddMember) ExpectMemberParam2(member *model.OrganizationMember) *mOrganizationRepositoryMockAddMember {
+	if mmAddMember.mock.funcAddMember != nil {
+		mmAddMember.mock.t.Fatalf("OrganizationRepositoryMock.AddMember mock is already set by Set")
+	}
+
+	if mmAddMember.defaultExpectation == nil {
+		mmAddMember.defaultExpectation = &OrganizationRepositoryMockAddMemberExpectation{}
+	}
+
+	if mmAddMember.defaultExpectation.params != nil {
+		mmAddMember.mock.t.Fatalf("OrganizationRepositoryMock.AddMember mock is already set by Expect")
+	}
+
+	if mmAddMember.defaultExpectation.paramPtrs == nil {
+		mmAddMember.defaultExpectation.paramPtrs = &OrganizationRepositoryMockAddMemberParamPtrs{}
+	}
+	mmAddMember.defaultExpectation.paramPtrs.member = &member
+
+	return mmAddMember
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrganizationRepository.AddMember
+func (mmAddMember *mOrganizationRepositoryMockAddMember) Inspect(f func(ctx context.Context, member *model.OrganizationMember)) *mOrganizationRepositoryMockAddMember {
+	if mmAddMember.mock.inspectFuncAddMember != nil {
+		mmAddMember.mock.t.Fatalf("Inspect function is already set for OrganizationRepositoryMock.AddMember")
+	}
+
+	mmAddMember.mock.inspectFuncAddMember = f
+
+	return mmAddMember
+}
+
+// Return sets up results that will be returned by OrganizationRepository.AddMember
+func (mmAddMember *mOrganizationRepositoryMockAddMember) Return(err error) *OrganizationRepositoryMock {
+	if mmAddMember.mock.funcAddMember != nil {
+		mmAddMember.mock.t.Fatalf("OrganizationRepositoryMock.AddMember mock is already set by Set")
+	}
+
+	if mmAddMember.defaultExpectation == nil {
+		mmAddMember.defaultExpectation = &OrganizationRepositoryMockAddMemberExpectation{mock: mmAddMember.mock}
+	}
+	mmAddMember.defaultExpectation.results = &OrganizationRepositoryMockAddMemberResults{err}
+	return mmAddMember.mock
+}
+
+// Set uses given function f to mock the OrganizationRepository.AddMember method
+func (mmAddMember *mOrganizationRepositoryMockAddMember) Set(f func(ctx context.Context, member *model.OrganizationMember) (err error)) *OrganizationRepositoryMock {
+	if mmAddMember.defaultExpectation != nil {
+		mmAddMember.mock.t.Fatalf("Default expectation is already set for the OrganizationRepository.AddMember method")
+	}
+
+	if len(mmAddMember.expectations) > 0 {
+		mmAddMember.mock.t.Fatalf("Some expectations are already set for the OrganizationRepository.AddMember method")
+	}
+
+	mmAddMember.mock.funcAddMember = f
+	return mmAddMember.mock
+}
+
+// When sets expectation for the OrganizationRepository.AddMember which will trigger the result defined by the following
+// Then helper
+func (mmAddMember *mOrganizationRepositoryMockAddMember) When(ctx context.Context, member *model.OrganizationMember) *OrganizationRepositoryMockAddMemberExpectation {
+	if mmAddMember.mock.funcAddMember != nil {
+		mmAddMember.mock.t.Fatalf("OrganizationRepositoryMock.AddMember mock is already set by Set")
+	}
+
+	expectation := &OrganizationRepositoryMockAddMemberExpectation{
+		mock:   mmAddMember.mock,
+		params: &OrganizationRepositoryMockAddMemberParams{ctx, member},
+	}
+	mmAddMember.expectations = append(mmAddMember.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrganizationRepository.AddMember return parameters for the expectation previously defined by the When method
+func (e *OrganizationRepositoryMockAddMemberExpectation) Then(err error) *OrganizationRepositoryMock {
+	e.results = &OrganizationRepositoryMockAddMemberResults{err}
+	return e.mock
+}
+
+// AddMember implements repository.OrganizationRepository
+func (mmAddMember *OrganizationRepositoryMock) AddMember(ctx context.Context, member *model.OrganizationMember) (err error) {
+	mm_atomic.AddUint64(&mmAddMember.beforeAddMemberCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddMember.afterAddMemberCounter, 1)
+
+	if mmAddMember.inspectFuncAddMember != nil {
+		mmAddMember.inspectFuncAddMember(ctx, member)
+	}
+
+	mm_params := OrganizationRepositoryMockAddMemberParams{ctx, member}
+
+	// Record call args
+	mmAddMember.AddMemberMock.mutex.Lock()
+	mmAddMember.AddMemberMock.callArgs = append(mmAddMember.AddMemberMock.callArgs, &mm_params)
+	mmAddMember.AddMemberMock.mutex.Unlock()
+
+	for _, e := range mmAddMember.AddMemberMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddMember.AddMemberMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddMember.AddMemberMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddMember.AddMemberMock.defaultExpectation.params
+		mm_want_ptrs := mmAddMember.AddMemberMock.defaultExpectation.paramPtrs
+
+		mm_got := OrganizationRepositoryMockAddMemberParams{ctx, member}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddMember.t.Errorf("OrganizationRepositoryMock.AddMember got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.member != nil && !minimock.Equal(*mm_want_ptrs.member, mm_got.member) {
+				mmAddMember.t.Errorf("OrganizationRepositoryMock.AddMember got unexpected parameter member, want: %#v, got: %#v%s\n", *mm_want_ptrs.member, mm_got.member, minimock.Diff(*mm_want_ptrs.member, mm_got.member))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddMember.t.Errorf("OrganizationRepositoryMock.AddMember got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddMember.AddMemberMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddMember.t.Fatal("No results are set for the OrganizationRepositoryMock.AddMember")
+		}
+		return (*mm_results).err
+	}
+	if mmAddMember.funcAddMember != nil {
+		return mmAddMember.funcAddMember(ctx, member)
+	}
+	mmAddMember.t.Fatalf("Unexpected call to OrganizationRepositoryMock.AddMember. %v %v", ctx, member)
+	return
+}
+
+// AddMemberAfterCounter returns a count of finished OrganizationRepositoryMock.AddMember invocations
+func (mmAddMember *OrganizationRepositoryMock) AddMemberAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddMember.afterAddMemberCounter)
+}
+
+// AddMemberBeforeCounter returns a count of OrganizationRepositoryMock.AddMember invocations
+func (mmAddMember *OrganizationRepositoryMock) AddMemberBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddMember.beforeAddMemberCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrganizationRepositoryMock.AddMember.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddMember *mOrganizationRepositoryMockAddMember) Calls() []*OrganizationRepositoryMockAddMemberParams {
+	mmAddMember.mutex.RLock()
+
+	argCopy := make([]*OrganizationRepositoryMockAddMemberParams, len(mmAddMember.callArgs))
+	copy(argCopy, mmAddMember.callArgs)
+
+	mmAddMember.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddMemberDone returns true if the count of the AddMember invocations corresponds
+// the number of defined expectations
+func (m *OrganizationRepositoryMock) MinimockAddMemberDone() bool {
+	for _, e := range m.AddMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddMemberMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterAddMemberCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddMember != nil && mm_atomic.LoadUint64(&m.afterAddMemberCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockAddMemberInspect logs each unmet expectation
+func (m *OrganizationRepositoryMock) MinimockAddMemberInspect() {
+	for _, e := range m.AddMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrganizationRepositoryMock.AddMember with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddMemberMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterAddMemberCounter) < 1 {
+		if m.AddMemberMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrganizationRepositoryMock.AddMember")
+		} else {
+			m.t.Errorf("Expected call to OrganizationRepositoryMock.AddMember with params: %#v", *m.AddMemberMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddMember != nil && mm_atomic.LoadUint64(&m.afterAddMemberCounter) < 1 {
+		m.t.Error("Expected call to OrganizationRepositoryMock.AddMember")
+	}
+}
+
+type mOrganizationRepositoryMockCreate struct {
+	mock               *OrganizationRepositoryMock
+	defaultExpectation *OrganizationRepositoryMockCreateExpectation
+	expectations       []*OrganizationRepositoryMockCreateExpectation
+
+	callArgs []*OrganizationRepositoryMockCreateParams
+	mutex    sync.RWMutex
+}
+
+// OrganizationRepositoryMockCreateExpectation specifies expectation struct of the OrganizationRepository.Create
+type OrganizationRepositoryMockCreateExpectation struct {
+	mock      *OrganizationRepositoryMock
+	params    *OrganizationRepositoryMockCreateParams
+	paramPtrs *OrganizationRepositoryMockCreateParamPtrs
+	results   *OrganizationRepositoryMockCreateResults
+	Counter   uint64
+}
+
+// OrganizationRepositoryMockCreateParams contains parameters of the OrganizationRepository.Create
+type OrganizationRepositoryMockCreateParams struct {
+	ctx context.Context
+	org *model.Organization
+}
+
+// OrganizationRepositoryMockCreateParamPtrs contains pointers to parameters of the OrganizationRepository.Create
+type OrganizationRepositoryMockCreateParamPtrs struct {
+	ctx *context.Context
+	org **model.Organization
+}
+
+// OrganizationRepositoryMockCreateResults contains results of the OrganizationRepository.Create
+type OrganizationRepositoryMockCreateResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for OrganizationRepository.Create
+func (mmCreate *mOrganizationRepositoryMockCreate) Expect(ctx context.Context, org *model.Organization) *mOrganizationRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("OrganizationRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &OrganizationRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("OrganizationRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &OrganizationRepositoryMockCreateParams{ctx, org}
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrganizationRepository.Create
+func (mmCreate *mOrganizationRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mOrganizationRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("OrganizationRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &OrganizationRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("OrganizationRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &OrganizationRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreate
+}
+
+// ExpectOrgParam2 sets up expected param org for OrganizationRepository.Create
+func (mmCreate *mOrganizationRepositoryMockCreate) ExpectOrgParam2(org *model.Organization) *mOrganizationRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("OrganizationRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &OrganizationRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("OrganizationRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &OrganizationRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.org = &org
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrganizationRepository.Create
+func (mmCreate *mOrganizationRepositoryMockCreate) Inspect(f func(ctx context.Context, org *model.Organization)) *mOrganizationRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for OrganizationRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by OrganizationRepository.Create
+func (mmCreate *mOrganizationRepositoryMockCreate) Return(i1 int64, err error) *OrganizationRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("OrganizationRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &OrganizationRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &OrganizationRepositoryMockCreateResults{i1, err}
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the OrganizationRepository.Create method
+func (mmCreate *mOrganizationRepositoryMockCreate) Set(f func(ctx context.Context, org *model.Organization) (i1 int64, err error)) *OrganizationRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the OrganizationRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the OrganizationRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	return mmCreate.mock
+}
+
+// When sets expectation for the OrganizationRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mOrganizationRepositoryMockCreate) When(ctx context.Context, org *model.Organization) *OrganizationRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("OrganizationRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &OrganizationRepositoryMockCreateExpectation{
+		mock:   mmCreate.mock,
+		params: &OrganizationRepositoryMockCreateParams{ctx, org},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrganizationRepository.Create return parameters for the expectation previously defined by the When method
+func (e *OrganizationRepositoryMockCreateExpectation) Then(i1 int64, err error) *OrganizationRepositoryMock {
+	e.results = &OrganizationRepositoryMockCreateResults{i1, err}
+	return e.mock
+}
+
+// Create implements repository.OrganizationRepository
+func (mmCreate *OrganizationRepositoryMock) Create(ctx context.Context, org *model.Organization) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, org)
+	}
+
+	mm_params := OrganizationRepositoryMockCreateParams{ctx, org}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := OrganizationRepositoryMockCreateParams{ctx, org}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("OrganizationRepositoryMock.Create got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.org != nil && !minimock.Equal(*mm_want_ptrs.org, mm_got.org) {
+				mmCreate.t.Errorf("OrganizationRepositoryMock.Create got unexpected parameter org, want: %#v, got: %#v%s\n", *mm_want_ptrs.org, mm_got.org, minimock.Diff(*mm_want_ptrs.org, mm_got.org))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("OrganizationRepositoryMock.Create got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the OrganizationRepositoryMock.Create")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, org)
+	}
+	mmCreate.t.Fatalf("Unexpected call to OrganizationRepositoryMock.Create. %v %v", ctx, org)
+	return
+}
+
+// CreateAfterCounter returns a count of finished OrganizationRepositoryMock.Create invocations
+func (mmCreate *OrganizationRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of OrganizationRepositoryMock.Create invocations
+func (mmCreate *OrganizationRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrganizationRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mOrganizationRepositoryMockCreate) Calls() []*OrganizationRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*OrganizationRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *OrganizationRepositoryMock) MinimockCreateDone() bool {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *OrganizationRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrganizationRepositoryMock.Create with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrganizationRepositoryMock.Create")
+		} else {
+			m.t.Errorf("Expected call to OrganizationRepositoryMock.Create with params: %#v", *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		m.t.Error("Expected call to OrganizationRepositoryMock.Create")
+	}
+}
+
+type mOrganizationRepositoryMockGetBySlug struct {
+	mock               *OrganizationRepositoryMock
+	defaultExpectation *OrganizationRepositoryMockGetBySlugExpectation
+	expectations       []*OrganizationRepositoryMockGetBySlugExpectation
+
+	callArgs []*OrganizationRepositoryMockGetBySlugParams
+	mutex    sync.RWMutex
+}
+
+// OrganizationRepositoryMockGetBySlugExpectation specifies expectation struct of the OrganizationRepository.GetBySlug
+type OrganizationRepositoryMockGetBySlugExpectation struct {
+	mock      *OrganizationRepositoryMock
+	params    *OrganizationRepositoryMockGetBySlugParams
+	paramPtrs *OrganizationRepositoryMockGetBySlugParamPtrs
+	results   *OrganizationRepositoryMockGetBySlugResults
+	Counter   uint64
+}
+
+// OrganizationRepositoryMockGetBySlugParams contains parameters of the OrganizationRepository.GetBySlug
+type OrganizationRepositoryMockGetBySlugParams struct {
+	ctx  context.Context
+	slug string
+}
+
+// OrganizationRepositoryMockGetBySlugParamPtrs contains pointers to parameters of the OrganizationRepository.GetBySlug
+type OrganizationRepositoryMockGetBySlugParamPtrs struct {
+	ctx  *context.Context
+	slug *string
+}
+
+// OrganizationRepositoryMockGetBySlugResults contains results of the OrganizationRepository.GetBySlug
+type OrganizationRepositoryMockGetBySlugResults struct {
+	op1 *model.Organization
+	err error
+}
+
+// Expect sets up expected params for OrganizationRepository.GetBySlug
+func (mmGetBySlug *mOrganizationRepositoryMockGetBySlug) Expect(ctx context.Context, slug string) *mOrganizationRepositoryMockGetBySlug {
+	if mmGetBySlug.mock.funcGetBySlug != nil {
+		mmGetBySlug.mock.t.Fatalf("OrganizationRepositoryMock.GetBySlug mock is already set by Set")
+	}
+
+	if mmGetBySlug.defaultExpectation == nil {
+		mmGetBySlug.defaultExpectation = &OrganizationRepositoryMockGetBySlugExpectation{}
+	}
+
+	if mmGetBySlug.defaultExpectation.paramPtrs != nil {
+		mmGetBySlug.mock.t.Fatalf("OrganizationRepositoryMock.GetBySlug mock is already set by ExpectParams functions")
+	}
+
+	mmGetBySlug.defaultExpectation.params = &OrganizationRepositoryMockGetBySlugParams{ctx, slug}
+	for _, e := range mmGetBySlug.expectations {
+		if minimock.Equal(e.params, mmGetBySlug.defaultExpectation.params) {
+			mmGetBySlug.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetBySlug.defaultExpectation.params)
+		}
+	}
+
+	return mmGetBySlug
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrganizationRepository.GetBySlug
+func (mmGetBySlug *mOrganizationRepositoryMockGetBySlug) ExpectCtxParam1(ctx context.Context) *mOrganizationRepositoryMockGetBySlug {
+	if mmGetBySlug.mock.funcGetBySlug != nil {
+		mmGetBySlug.mock.t.Fatalf("OrganizationRepositoryMock.GetBySlug mock is already set by Set")
+	}
+
+	if mmGetBySlug.defaultExpectation == nil {
+		mmGetBySlug.defaultExpectation = &OrganizationRepositoryMockGetBySlugExpectation{}
+	}
+
+	if mmGetBySlug.defaultExpectation.params != nil {
+		mmGetBySlug.mock.t.Fatalf("OrganizationRepositoryMock.GetBySlug mock is already set by Expect")
+	}
+
+	if mmGetBySlug.defaultExpectation.paramPtrs == nil {
+		mmGetBySlug.defaultExpectation.paramPtrs = &OrganizationRepositoryMockGetBySlugParamPtrs{}
+	}
+	mmGetBySlug.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetBySlug
+}
+
+// ExpectSlugParam2 sets up expected param slug for OrganizationRepository.GetBySlug
+func (mmGetBySlug *mOrganizationRepositoryMockGetBySlug) ExpectSlugParam2(slug string) *mOrganizationRepositoryMockGetBySlug {
+	if mmGetBySlug.mock.funcGetBySlug != nil {
+		mmGetBySlug.mock.t.Fatalf("OrganizationRepositoryMock.GetBySlug mock is already set by Set")
+	}
+
+	if mmGetBySlug.defaultExpectation == nil {
+		mmGetBySlug.defaultExpectation = &OrganizationRepositoryMockGetBySlugExpectation{}
+	}
+
+	if mmGetBySlug.defaultExpectation.params != nil {
+		mmGetBySlug.mock.t.Fatalf("OrganizationRepositoryMock.GetBySlug mock is already set by Expect")
+	}
+
+	if mmGetBySlug.defaultExpectation.paramPtrs == nil {
+		mmGetBySlug.defaultExpectation.paramPtrs = &OrganizationRepositoryMockGetBySlugParamPtrs{}
+	}
+	mmGetBySlug.defaultExpectation.paramPtrs.slug = &slug
+
+	return mmGetBySlug
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrganizationRepository.GetBySlug
+func (mmGetBySlug *mOrganizationRepositoryMockGetBySlug) Inspect(f func(ctx context.Context, slug string)) *mOrganizationRepositoryMockGetBySlug {
+	if mmGetBySlug.mock.inspectFuncGetBySlug != nil {
+		mmGetBySlug.mock.t.Fatalf("Inspect function is already set for OrganizationRepositoryMock.GetBySlug")
+	}
+
+	mmGetBySlug.mock.inspectFuncGetBySlug = f
+
+	return mmGetBySlug
+}
+
+// Return sets up results that will be returned by OrganizationRepository.GetBySlug
+func (mmGetBySlug *mOrganizationRepositoryMockGetBySlug) Return(op1 *model.Organization, err error) *OrganizationRepositoryMock {
+	if mmGetBySlug.mock.funcGetBySlug != nil {
+		mmGetBySlug.mock.t.Fatalf("OrganizationRepositoryMock.GetBySlug mock is already set by Set")
+	}
+
+	if mmGetBySlug.defaultExpectation == nil {
+		mmGetBySlug.defaultExpectation = &OrganizationRepositoryMockGetBySlugExpectation{mock: mmGetBySlug.mock}
+	}
+	mmGetBySlug.defaultExpectation.results = &OrganizationRepositoryMockGetBySlugResults{op1, err}
+	return mmGetBySlug.mock
+}
+
+// Set uses given function f to mock the OrganizationRepository.GetBySlug method
+func (mmGetBySlug *mOrganizationRepositoryMockGetBySlug) Set(f func(ctx context.Context, slug string) (op1 *model.Organization, err error)) *OrganizationRepositoryMock {
+	if mmGetBySlug.defaultExpectation != nil {
+		mmGetBySlug.mock.t.Fatalf("Default expectation is already set for the OrganizationRepository.GetBySlug method")
+	}
+
+	if len(mmGetBySlug.expectations) > 0 {
+		mmGetBySlug.mock.t.Fatalf("Some expectations are already set for the OrganizationRepository.GetBySlug method")
+	}
+
+	mmGetBySlug.mock.funcGetBySlug = f
+	return mmGetBySlug.mock
+}
+
+// When sets expectation for the OrganizationRepository.GetBySlug which will trigger the result defined by the following
+// Then helper
+func (mmGetBySlug *mOrganizationRepositoryMockGetBySlug) When(ctx context.Context, slug string) *OrganizationRepositoryMockGetBySlugExpectation {
+	if mmGetBySlug.mock.funcGetBySlug != nil {
+		mmGetBySlug.mock.t.Fatalf("OrganizationRepositoryMock.GetBySlug mock is already set by Set")
+	}
+
+	expectation := &OrganizationRepositoryMockGetBySlugExpectation{
+		mock:   mmGetBySlug.mock,
+		params: &OrganizationRepositoryMockGetBySlugParams{ctx, slug},
+	}
+	mmGetBySlug.expectations = append(mmGetBySlug.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrganizationRepository.GetBySlug return parameters for the expectation previously defined by the When method
+func (e *OrganizationRepositoryMockGetBySlugExpectation) Then(op1 *model.Organization, err error) *OrganizationRepositoryMock {
+	e.results = &OrganizationRepositoryMockGetBySlugResults{op1, err}
+	return e.mock
+}
+
+// GetBySlug implements repository.OrganizationRepository
+func (mmGetBySlug *OrganizationRepositoryMock) GetBySlug(ctx context.Context, slug string) (op1 *model.Organization, err error) {
+	mm_atomic.AddUint64(&mmGetBySlug.beforeGetBySlugCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetBySlug.afterGetBySlugCounter, 1)
+
+	if mmGetBySlug.inspectFuncGetBySlug != nil {
+		mmGetBySlug.inspectFuncGetBySlug(ctx, slug)
+	}
+
+	mm_params := OrganizationRepositoryMockGetBySlugParams{ctx, slug}
+
+	// Record call args
+	mmGetBySlug.GetBySlugMock.mutex.Lock()
+	mmGetBySlug.GetBySlugMock.callArgs = append(mmGetBySlug.GetBySlugMock.callArgs, &mm_params)
+	mmGetBySlug.GetBySlugMock.mutex.Unlock()
+
+	for _, e := range mmGetBySlug.GetBySlugMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.op1, e.results.err
+		}
+	}
+
+	if mmGetBySlug.GetBySlugMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetBySlug.GetBySlugMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetBySlug.GetBySlugMock.defaultExpectation.params
+		mm_want_ptrs := mmGetBySlug.GetBySlugMock.defaultExpectation.paramPtrs
+
+		mm_got := OrganizationRepositoryMockGetBySlugParams{ctx, slug}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetBySlug.t.Errorf("OrganizationRepositoryMock.GetBySlug got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.slug != nil && !minimock.Equal(*mm_want_ptrs.slug, mm_got.slug) {
+				mmGetBySlug.t.Errorf("OrganizationRepositoryMock.GetBySlug got unexpected parameter slug, want: %#v, got: %#v%s\n", *mm_want_ptrs.slug, mm_got.slug, minimock.Diff(*mm_want_ptrs.slug, mm_got.slug))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetBySlug.t.Errorf("OrganizationRepositoryMock.GetBySlug got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetBySlug.GetBySlugMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetBySlug.t.Fatal("No results are set for the OrganizationRepositoryMock.GetBySlug")
+		}
+		return (*mm_results).op1, (*mm_results).err
+	}
+	if mmGetBySlug.funcGetBySlug != nil {
+		return mmGetBySlug.funcGetBySlug(ctx, slug)
+	}
+	mmGetBySlug.t.Fatalf("Unexpected call to OrganizationRepositoryMock.GetBySlug. %v %v", ctx, slug)
+	return
+}
+
+// GetBySlugAfterCounter returns a count of finished OrganizationRepositoryMock.GetBySlug invocations
+func (mmGetBySlug *OrganizationRepositoryMock) GetBySlugAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBySlug.afterGetBySlugCounter)
+}
+
+// GetBySlugBeforeCounter returns a count of OrganizationRepositoryMock.GetBySlug invocations
+func (mmGetBySlug *OrganizationRepositoryMock) GetBySlugBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBySlug.beforeGetBySlugCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrganizationRepositoryMock.GetBySlug.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetBySlug *mOrganizationRepositoryMockGetBySlug) Calls() []*OrganizationRepositoryMockGetBySlugParams {
+	mmGetBySlug.mutex.RLock()
+
+	argCopy := make([]*OrganizationRepositoryMockGetBySlugParams, len(mmGetBySlug.callArgs))
+	copy(argCopy, mmGetBySlug.callArgs)
+
+	mmGetBySlug.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetBySlugDone returns true if the count of the GetBySlug invocations corresponds
+// the number of defined expectations
+func (m *OrganizationRepositoryMock) MinimockGetBySlugDone() bool {
+	for _, e := range m.GetBySlugMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetBySlugMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetBySlugCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetBySlug != nil && mm_atomic.LoadUint64(&m.afterGetBySlugCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetBySlugInspect logs each unmet expectation
+func (m *OrganizationRepositoryMock) MinimockGetBySlugInspect() {
+	for _, e := range m.GetBySlugMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrganizationRepositoryMock.GetBySlug with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetBySlugMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetBySlugCounter) < 1 {
+		if m.GetBySlugMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrganizationRepositoryMock.GetBySlug")
+		} else {
+			m.t.Errorf("Expected call to OrganizationRepositoryMock.GetBySlug with params: %#v", *m.GetBySlugMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetBySlug != nil && mm_atomic.LoadUint64(&m.afterGetBySlugCounter) < 1 {
+		m.t.Error("Expected call to OrganizationRepositoryMock.GetBySlug")
+	}
+}
+
+type mOrganizationRepositoryMockIsMember struct {
+	mock               *OrganizationRepositoryMock
+	defaultExpectation *OrganizationRepositoryMockIsMemberExpectation
+	expectations       []*OrganizationRepositoryMockIsMemberExpectation
+
+	callArgs []*OrganizationRepositoryMockIsMemberParams
+	mutex    sync.RWMutex
+}
+
+// OrganizationRepositoryMockIsMemberExpectation specifies expectation struct of the OrganizationRepository.IsMember
+type OrganizationRepositoryMockIsMemberExpectation struct {
+	mock      *OrganizationRepositoryMock
+	params    *OrganizationRepositoryMockIsMemberParams
+	paramPtrs *OrganizationRepositoryMockIsMemberParamPtrs
+	results   *OrganizationRepositoryMockIsMemberResults
+	Counter   uint64
+}
+
+// OrganizationRepositoryMockIsMemberParams contains parameters of the OrganizationRepository.IsMember
+type OrganizationRepositoryMockIsMemberParams struct {
+	ctx    context.Context
+	slug   string
+	userID int64
+}
+
+// OrganizationRepositoryMockIsMemberParamPtrs contains pointers to parameters of the OrganizationRepository.IsMember
+type OrganizationRepositoryMockIsMemberParamPtrs struct {
+	ctx    *context.Context
+	slug   *string
+	userID *int64
+}
+
+// OrganizationRepositoryMockIsMemberResults contains results of the OrganizationRepository.IsMember
+type OrganizationRepositoryMockIsMemberResults struct {
+	b1  bool
+	err error
+}
+
+// Expect sets up expected params for OrganizationRepository.IsMember
+func (mmIsMember *mOrganizationRepositoryMockIsMember) Expect(ctx context.Context, slug string, userID int64) *mOrganizationRepositoryMockIsMember {
+	if mmIsMember.mock.funcIsMember != nil {
+		mmIsMember.mock.t.Fatalf("OrganizationRepositoryMock.IsMember mock is already set by Set")
+	}
+
+	if mmIsMember.defaultExpectation == nil {
+		mmIsMember.defaultExpectation = &OrganizationRepositoryMockIsMemberExpectation{}
+	}
+
+	if mmIsMember.defaultExpectation.paramPtrs != nil {
+		mmIsMember.mock.t.Fatalf("OrganizationRepositoryMock.IsMember mock is already set by ExpectParams functions")
+	}
+
+	mmIsMember.defaultExpectation.params = &OrganizationRepositoryMockIsMemberParams{ctx, slug, userID}
+	for _, e := range mmIsMember.expectations {
+		if minimock.Equal(e.params, mmIsMember.defaultExpectation.params) {
+			mmIsMember.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIsMember.defaultExpectation.params)
+		}
+	}
+
+	return mmIsMember
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrganizationRepository.IsMember
+func (mmIsMember *mOrganizationRepositoryMockIsMember) ExpectCtxParam1(ctx context.Context) *mOrganizationRepositoryMockIsMember {
+	if mmIsMember.mock.funcIsMember != nil {
+		mmIsMember.mock.t.Fatalf("OrganizationRepositoryMock.IsMember mock is already set by Set")
+	}
+
+	if mmIsMember.defaultExpectation == nil {
+		mmIsMember.defaultExpectation = &OrganizationRepositoryMockIsMemberExpectation{}
+	}
+
+	if mmIsMember.defaultExpectation.params != nil {
+		mmIsMember.mock.t.Fatalf("OrganizationRepositoryMock.IsMember mock is already set by Expect")
+	}
+
+	if mmIsMember.defaultExpectation.paramPtrs == nil {
+		mmIsMember.defaultExpectation.paramPtrs = &OrganizationRepositoryMockIsMemberParamPtrs{}
+	}
+	mmIsMember.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmIsMember
+}
+
+// ExpectSlugParam2 sets up expected param slug for OrganizationRepository.IsMember
+func (mmIsMember *mOrganizationRepositoryMockIsMember) ExpectSlugParam2(slug string) *mOrganizationRepositoryMockIsMember {
+	if mmIsMember.mock.funcIsMember != nil {
+		mmIsMember.mock.t.Fatalf("OrganizationRepositoryMock.IsMember mock is already set by Set")
+	}
+
+	if mmIsMember.defaultExpectation == nil {
+		mmIsMember.defaultExpectation = &OrganizationRepositoryMockIsMemberExpectation{}
+	}
+
+	if mmIsMember.defaultExpectation.params != nil {
+		mmIsMember.mock.t.Fatalf("OrganizationRepositoryMock.IsMember mock is already set by Expect")
+	}
+
+	if mmIsMember.defaultExpectation.paramPtrs == nil {
+		mmIsMember.defaultExpectation.paramPtrs = &OrganizationRepositoryMockIsMemberParamPtrs{}
+	}
+	mmIsMember.defaultExpectation.paramPtrs.slug = &slug
+
+	return mmIsMember
+}
+
+// ExpectUserIDParam3 sets up expected param userID for OrganizationRepository.IsMember
+func (mmIsMember *mOrganizationRepositoryMockIsMember) ExpectUserIDParam3(userID int64) *mOrganizationRepositoryMockIsMember {
+	if mmIsMember.mock.funcIsMember != nil {
+		mmIsMember.mock.t.Fatalf("OrganizationRepositoryMock.IsMember mock is already set by Set")
+	}
+
+	if mmIsMember.defaultExpectation == nil {
+		mmIsMember.defaultExpectation = &OrganizationRepositoryMockIsMemberExpectation{}
+	}
+
+	if mmIsMember.defaultExpectation.params != nil {
+		mmIsMember.mock.t.Fatalf("OrganizationRepositoryMock.IsMember mock is already set by Expect")
+	}
+
+	if mmIsMember.defaultExpectation.paramPtrs == nil {
+		mmIsMember.defaultExpectation.paramPtrs = &OrganizationRepositoryMockIsMemberParamPtrs{}
+	}
+	mmIsMember.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmIsMember
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrganizationRepository.IsMember
+func (mmIsMember *mOrganizationRepositoryMockIsMember) Inspect(f func(ctx context.Context, slug string, userID int64)) *mOrganizationRepositoryMockIsMember {
+	if mmIsMember.mock.inspectFuncIsMember != nil {
+		mmIsMember.mock.t.Fatalf("Inspect function is already set for OrganizationRepositoryMock.IsMember")
+	}
+
+	mmIsMember.mock.inspectFuncIsMember = f
+
+	return mmIsMember
+}
+
+// Return sets up results that will be returned by OrganizationRepository.IsMember
+func (mmIsMember *mOrganizationRepositoryMockIsMember) Return(b1 bool, err error) *OrganizationRepositoryMock {
+	if mmIsMember.mock.funcIsMember != nil {
+		mmIsMember.mock.t.Fatalf("OrganizationRepositoryMock.IsMember mock is already set by Set")
+	}
+
+	if mmIsMember.defaultExpectation == nil {
+		mmIsMember.defaultExpectation = &OrganizationRepositoryMockIsMemberExpectation{mock: mmIsMember.mock}
+	}
+	mmIsMember.defaultExpectation.results = &OrganizationRepositoryMockIsMemberResults{b1, err}
+	return mmIsMember.mock
+}
+
+// Set uses given function f to mock the OrganizationRepository.IsMember method
+func (mmIsMember *mOrganizationRepositoryMockIsMember) Set(f func(ctx context.Context, slug string, userID int64) (b1 bool, err error)) *OrganizationRepositoryMock {
+	if mmIsMember.defaultExpectation != nil {
+		mmIsMember.mock.t.Fatalf("Default expectation is already set for the OrganizationRepository.IsMember method")
+	}
+
+	if len(mmIsMember.expectations) > 0 {
+		mmIsMember.mock.t.Fatalf("Some expectations are already set for the OrganizationRepository.IsMember method")
+	}
+
+	mmIsMember.mock.funcIsMember = f
+	return mmIsMember.mock
+}
+
+// When sets expectation for the OrganizationRepository.IsMember which will trigger the result defined by the following
+// Then helper
+func (mmIsMember *mOrganizationRepositoryMockIsMember) When(ctx context.Context, slug string, userID int64) *OrganizationRepositoryMockIsMemberExpectation {
+	if mmIsMember.mock.funcIsMember != nil {
+		mmIsMember.mock.t.Fatalf("OrganizationRepositoryMock.IsMember mock is already set by Set")
+	}
+
+	expectation := &OrganizationRepositoryMockIsMemberExpectation{
+		mock:   mmIsMember.mock,
+		params: &OrganizationRepositoryMockIsMemberParams{ctx, slug, userID},
+	}
+	mmIsMember.expectations = append(mmIsMember.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrganizationRepository.IsMember return parameters for the expectation previously defined by the When method
+func (e *OrganizationRepositoryMockIsMemberExpectation) Then(b1 bool, err error) *OrganizationRepositoryMock {
+	e.results = &OrganizationRepositoryMockIsMemberResults{b1, err}
+	return e.mock
+}
+
+// IsMember implements repository.OrganizationRepository
+func (mmIsMember *OrganizationRepositoryMock) IsMember(ctx context.Context, slug string, userID int64) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmIsMember.beforeIsMemberCounter, 1)
+	defer mm_atomic.AddUint64(&mmIsMember.afterIsMemberCounter, 1)
+
+	if mmIsMember.inspectFuncIsMember != nil {
+		mmIsMember.inspectFuncIsMember(ctx, slug, userID)
+	}
+
+	mm_params := OrganizationRepositoryMockIsMemberParams{ctx, slug, userID}
+
+	// Record call args
+	mmIsMember.IsMemberMock.mutex.Lock()
+	mmIsMember.IsMemberMock.callArgs = append(mmIsMember.IsMemberMock.callArgs, &mm_params)
+	mmIsMember.IsMemberMock.mutex.Unlock()
+
+	for _, e := range mmIsMember.IsMemberMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmIsMember.IsMemberMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIsMember.IsMemberMock.defaultExpectation.Counter, 1)
+		mm_want := mmIsMember.IsMemberMock.defaultExpectation.params
+		mm_want_ptrs := mmIsMember.IsMemberMock.defaultExpectation.paramPtrs
+
+		mm_got := OrganizationRepositoryMockIsMemberParams{ctx, slug, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmIsMember.t.Errorf("OrganizationRepositoryMock.IsMember got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.slug != nil && !minimock.Equal(*mm_want_ptrs.slug, mm_got.slug) {
+				mmIsMember.t.Errorf("OrganizationRepositoryMock.IsMember got unexpected parameter slug, want: %#v, got: %#v%s\n", *mm_want_ptrs.slug, mm_got.slug, minimock.Diff(*mm_want_ptrs.slug, mm_got.slug))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmIsMember.t.Errorf("OrganizationRepositoryMock.IsMember got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmIsMember.t.Errorf("OrganizationRepositoryMock.IsMember got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmIsMember.IsMemberMock.defaultExpectation.results
+		if mm_results == nil {
+			mmIsMember.t.Fatal("No results are set for the OrganizationRepositoryMock.IsMember")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmIsMember.funcIsMember != nil {
+		return mmIsMember.funcIsMember(ctx, slug, userID)
+	}
+	mmIsMember.t.Fatalf("Unexpected call to OrganizationRepositoryMock.IsMember. %v %v %v", ctx, slug, userID)
+	return
+}
+
+// IsMemberAfterCounter returns a count of finished OrganizationRepositoryMock.IsMember invocations
+func (mmIsMember *OrganizationRepositoryMock) IsMemberAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsMember.afterIsMemberCounter)
+}
+
+// IsMemberBeforeCounter returns a count of OrganizationRepositoryMock.IsMember invocations
+func (mmIsMember *OrganizationRepositoryMock) IsMemberBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsMember.beforeIsMemberCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrganizationRepositoryMock.IsMember.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmIsMember *mOrganizationRepositoryMockIsMember) Calls() []*OrganizationRepositoryMockIsMemberParams {
+	mmIsMember.mutex.RLock()
+
+	argCopy := make([]*OrganizationRepositoryMockIsMemberParams, len(mmIsMember.callArgs))
+	copy(argCopy, mmIsMember.callArgs)
+
+	mmIsMember.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockIsMemberDone returns true if the count of the IsMember invocations corresponds
+// the number of defined expectations
+func (m *OrganizationRepositoryMock) MinimockIsMemberDone() bool {
+	for _, e := range m.IsMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IsMemberMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterIsMemberCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIsMember != nil && mm_atomic.LoadUint64(&m.afterIsMemberCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockIsMemberInspect logs each unmet expectation
+func (m *OrganizationRepositoryMock) MinimockIsMemberInspect() {
+	for _, e := range m.IsMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrganizationRepositoryMock.IsMember with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IsMemberMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterIsMemberCounter) < 1 {
+		if m.IsMemberMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrganizationRepositoryMock.IsMember")
+		} else {
+			m.t.Errorf("Expected call to OrganizationRepositoryMock.IsMember with params: %#v", *m.IsMemberMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIsMember != nil && mm_atomic.LoadUint64(&m.afterIsMemberCounter) < 1 {
+		m.t.Error("Expected call to OrganizationRepositoryMock.IsMember")
+	}
+}
+
+type mOrganizationRepositoryMockListByUser struct {
+	mock               *OrganizationRepositoryMock
+	defaultExpectation *OrganizationRepositoryMockListByUserExpectation
+	expectations       []*OrganizationRepositoryMockListByUserExpectation
+
+	callArgs []*OrganizationRepositoryMockListByUserParams
+	mutex    sync.RWMutex
+}
+
+// OrganizationRepositoryMockListByUserExpectation specifies expectation struct of the OrganizationRepository.ListByUser
+type OrganizationRepositoryMockListByUserExpectation struct {
+	mock      *OrganizationRepositoryMock
+	params    *OrganizationRepositoryMockListByUserParams
+	paramPtrs *OrganizationRepositoryMockListByUserParamPtrs
+	results   *OrganizationRepositoryMockListByUserResults
+	Counter   uint64
+}
+
+// OrganizationRepositoryMockListByUserParams contains parameters of the OrganizationRepository.ListByUser
+type OrganizationRepositoryMockListByUserParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// OrganizationRepositoryMockListByUserParamPtrs contains pointers to parameters of the OrganizationRepository.ListByUser
+type OrganizationRepositoryMockListByUserParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// OrganizationRepositoryMockListByUserResults contains results of the OrganizationRepository.ListByUser
+type OrganizationRepositoryMockListByUserResults struct {
+	oa1 []model.Organization
+	err error
+}
+
+// Expect sets up expected params for OrganizationRepository.ListByUser
+func (mmListByUser *mOrganizationRepositoryMockListByUser) Expect(ctx context.Context, userID int64) *mOrganizationRepositoryMockListByUser {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("OrganizationRepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	if mmListByUser.defaultExpectation == nil {
+		mmListByUser.defaultExpectation = &OrganizationRepositoryMockListByUserExpectation{}
+	}
+
+	if mmListByUser.defaultExpectation.paramPtrs != nil {
+		mmListByUser.mock.t.Fatalf("OrganizationRepositoryMock.ListByUser mock is already set by ExpectParams functions")
+	}
+
+	mmListByUser.defaultExpectation.params = &OrganizationRepositoryMockListByUserParams{ctx, userID}
+	for _, e := range mmListByUser.expectations {
+		if minimock.Equal(e.params, mmListByUser.defaultExpectation.params) {
+			mmListByUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListByUser.defaultExpectation.params)
+		}
+	}
+
+	return mmListByUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrganizationRepository.ListByUser
+func (mmListByUser *mOrganizationRepositoryMockListByUser) ExpectCtxParam1(ctx context.Context) *mOrganizationRepositoryMockListByUser {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("OrganizationRepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	if mmListByUser.defaultExpectation == nil {
+		mmListByUser.defaultExpectation = &OrganizationRepositoryMockListByUserExpectation{}
+	}
+
+	if mmListByUser.defaultExpectation.params != nil {
+		mmListByUser.mock.t.Fatalf("OrganizationRepositoryMock.ListByUser mock is already set by Expect")
+	}
+
+	if mmListByUser.defaultExpectation.paramPtrs == nil {
+		mmListByUser.defaultExpectation.paramPtrs = &OrganizationRepositoryMockListByUserParamPtrs{}
+	}
+	mmListByUser.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListByUser
+}
+
+// ExpectUserIDParam2 sets up expected param userID for OrganizationRepository.ListByUser
+func (mmListByUser *mOrganizationRepositoryMockListByUser) ExpectUserIDParam2(userID int64) *mOrganizationRepositoryMockListByUser {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("OrganizationRepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	if mmListByUser.defaultExpectation == nil {
+		mmListByUser.defaultExpectation = &OrganizationRepositoryMockListByUserExpectation{}
+	}
+
+	if mmListByUser.defaultExpectation.params != nil {
+		mmListByUser.mock.t.Fatalf("OrganizationRepositoryMock.ListByUser mock is already set by Expect")
+	}
+
+	if mmListByUser.defaultExpectation.paramPtrs == nil {
+		mmListByUser.defaultExpectation.paramPtrs = &OrganizationRepositoryMockListByUserParamPtrs{}
+	}
+	mmListByUser.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmListByUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrganizationRepository.ListByUser
+func (mmListByUser *mOrganizationRepositoryMockListByUser) Inspect(f func(ctx context.Context, userID int64)) *mOrganizationRepositoryMockListByUser {
+	if mmListByUser.mock.inspectFuncListByUser != nil {
+		mmListByUser.mock.t.Fatalf("Inspect function is already set for OrganizationRepositoryMock.ListByUser")
+	}
+
+	mmListByUser.mock.inspectFuncListByUser = f
+
+	return mmListByUser
+}
+
+// Return sets up results that will be returned by OrganizationRepository.ListByUser
+func (mmListByUser *mOrganizationRepositoryMockListByUser) Return(oa1 []model.Organization, err error) *OrganizationRepositoryMock {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("OrganizationRepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	if mmListByUser.defaultExpectation == nil {
+		mmListByUser.defaultExpectation = &OrganizationRepositoryMockListByUserExpectation{mock: mmListByUser.mock}
+	}
+	mmListByUser.defaultExpectation.results = &OrganizationRepositoryMockListByUserResults{oa1, err}
+	return mmListByUser.mock
+}
+
+// Set uses given function f to mock the OrganizationRepository.ListByUser method
+func (mmListByUser *mOrganizationRepositoryMockListByUser) Set(f func(ctx context.Context, userID int64) (oa1 []model.Organization, err error)) *OrganizationRepositoryMock {
+	if mmListByUser.defaultExpectation != nil {
+		mmListByUser.mock.t.Fatalf("Default expectation is already set for the OrganizationRepository.ListByUser method")
+	}
+
+	if len(mmListByUser.expectations) > 0 {
+		mmListByUser.mock.t.Fatalf("Some expectations are already set for the OrganizationRepository.ListByUser method")
+	}
+
+	mmListByUser.mock.funcListByUser = f
+	return mmListByUser.mock
+}
+
+// When sets expectation for the OrganizationRepository.ListByUser which will trigger the result defined by the following
+// Then helper
+func (mmListByUser *mOrganizationRepositoryMockListByUser) When(ctx context.Context, userID int64) *OrganizationRepositoryMockListByUserExpectation {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("OrganizationRepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	expectation := &OrganizationRepositoryMockListByUserExpectation{
+		mock:   mmListByUser.mock,
+		params: &OrganizationRepositoryMockListByUserParams{ctx, userID},
+	}
+	mmListByUser.expectations = append(mmListByUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrganizationRepository.ListByUser return parameters for the expectation previously defined by the When method
+func (e *OrganizationRepositoryMockListByUserExpectation) Then(oa1 []model.Organization, err error) *OrganizationRepositoryMock {
+	e.results = &OrganizationRepositoryMockListByUserResults{oa1, err}
+	return e.mock
+}
+
+// ListByUser implements repository.OrganizationRepository
+func (mmListByUser *OrganizationRepositoryMock) ListByUser(ctx context.Context, userID int64) (oa1 []model.Organization, err error) {
+	mm_atomic.AddUint64(&mmListByUser.beforeListByUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmListByUser.afterListByUserCounter, 1)
+
+	if mmListByUser.inspectFuncListByUser != nil {
+		mmListByUser.inspectFuncListByUser(ctx, userID)
+	}
+
+	mm_params := OrganizationRepositoryMockListByUserParams{ctx, userID}
+
+	// Record call args
+	mmListByUser.ListByUserMock.mutex.Lock()
+	mmListByUser.ListByUserMock.callArgs = append(mmListByUser.ListByUserMock.callArgs, &mm_params)
+	mmListByUser.ListByUserMock.mutex.Unlock()
+
+	for _, e := range mmListByUser.ListByUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.oa1, e.results.err
+		}
+	}
+
+	if mmListByUser.ListByUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListByUser.ListByUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmListByUser.ListByUserMock.defaultExpectation.params
+		mm_want_ptrs := mmListByUser.ListByUserMock.defaultExpectation.paramPtrs
+
+		mm_got := OrganizationRepositoryMockListByUserParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListByUser.t.Errorf("OrganizationRepositoryMock.ListByUser got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListByUser.t.Errorf("OrganizationRepositoryMock.ListByUser got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListByUser.t.Errorf("OrganizationRepositoryMock.ListByUser got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListByUser.ListByUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListByUser.t.Fatal("No results are set for the OrganizationRepositoryMock.ListByUser")
+		}
+		return (*mm_results).oa1, (*mm_results).err
+	}
+	if mmListByUser.funcListByUser != nil {
+		return mmListByUser.funcListByUser(ctx, userID)
+	}
+	mmListByUser.t.Fatalf("Unexpected call to OrganizationRepositoryMock.ListByUser. %v %v", ctx, userID)
+	return
+}
+
+// ListByUserAfterCounter returns a count of finished OrganizationRepositoryMock.ListByUser invocations
+func (mmListByUser *OrganizationRepositoryMock) ListByUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListByUser.afterListByUserCounter)
+}
+
+// ListByUserBeforeCounter returns a count of OrganizationRepositoryMock.ListByUser invocations
+func (mmListByUser *OrganizationRepositoryMock) ListByUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListByUser.beforeListByUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrganizationRepositoryMock.ListByUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListByUser *mOrganizationRepositoryMockListByUser) Calls() []*OrganizationRepositoryMockListByUserParams {
+	mmListByUser.mutex.RLock()
+
+	argCopy := make([]*OrganizationRepositoryMockListByUserParams, len(mmListByUser.callArgs))
+	copy(argCopy, mmListByUser.callArgs)
+
+	mmListByUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListByUserDone returns true if the count of the ListByUser invocations corresponds
+// the number of defined expectations
+func (m *OrganizationRepositoryMock) MinimockListByUserDone() bool {
+	for _, e := range m.ListByUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListByUserMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListByUserCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListByUser != nil && mm_atomic.LoadUint64(&m.afterListByUserCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockListByUserInspect logs each unmet expectation
+func (m *OrganizationRepositoryMock) MinimockListByUserInspect() {
+	for _, e := range m.ListByUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrganizationRepositoryMock.ListByUser with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListByUserMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListByUserCounter) < 1 {
+		if m.ListByUserMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrganizationRepositoryMock.ListByUser")
+		} else {
+			m.t.Errorf("Expected call to OrganizationRepositoryMock.ListByUser with params: %#v", *m.ListByUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListByUser != nil && mm_atomic.LoadUint64(&m.afterListByUserCounter) < 1 {
+		m.t.Error("Expected call to OrganizationRepositoryMock.ListByUser")
+	}
+}
+
+type mOrganizationRepositoryMockRemoveMember struct {
+	mock               *OrganizationRepositoryMock
+	defaultExpectation *OrganizationRepositoryMockRemoveMemberExpectation
+	expectations       []*OrganizationRepositoryMockRemoveMemberExpectation
+
+	callArgs []*OrganizationRepositoryMockRemoveMemberParams
+	mutex    sync.RWMutex
+}
+
+// OrganizationRepositoryMockRemoveMemberExpectation specifies expectation struct of the OrganizationRepository.RemoveMember
+type OrganizationRepositoryMockRemoveMemberExpectation struct {
+	mock      *OrganizationRepositoryMock
+	params    *OrganizationRepositoryMockRemoveMemberParams
+	paramPtrs *OrganizationRepositoryMockRemoveMemberParamPtrs
+	results   *OrganizationRepositoryMockRemoveMemberResults
+	Counter   uint64
+}
+
+// OrganizationRepositoryMockRemoveMemberParams contains parameters of the OrganizationRepository.RemoveMember
+type OrganizationRepositoryMockRemoveMemberParams struct {
+	ctx            context.Context
+	organizationID int64
+	userID         int64
+}
+
+// OrganizationRepositoryMockRemoveMemberParamPtrs contains pointers to parameters of the OrganizationRepository.RemoveMember
+type OrganizationRepositoryMockRemoveMemberParamPtrs struct {
+	ctx            *context.Context
+	organizationID *int64
+	userID         *int64
+}
+
+// OrganizationRepositoryMockRemoveMemberResults contains results of the OrganizationRepository.RemoveMember
+type OrganizationRepositoryMockRemoveMemberResults struct {
+	err error
+}
+
+// Expect sets up expected params for OrganizationRepository.RemoveMember
+func (mmRemoveMember *mOrganizationRepositoryMockRemoveMember) Expect(ctx context.Context, organizationID int64, userID int64) *mOrganizationRepositoryMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("OrganizationRepositoryMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &OrganizationRepositoryMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs != nil {
+		mmRemoveMember.mock.t.Fatalf("OrganizationRepositoryMock.RemoveMember mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveMember.defaultExpectation.params = &OrganizationRepositoryMockRemoveMemberParams{ctx, organizationID, userID}
+	for _, e := range mmRemoveMember.expectations {
+		if minimock.Equal(e.params, mmRemoveMember.defaultExpectation.params) {
+			mmRemoveMember.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveMember.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveMember
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrganizationRepository.RemoveMember
+func (mmRemoveMember *mOrganizationRepositoryMockRemoveMember) ExpectCtxParam1(ctx context.Context) *mOrganizationRepositoryMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("OrganizationRepositoryMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &OrganizationRepositoryMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.params != nil {
+		mmRemoveMember.mock.t.Fatalf("OrganizationRepositoryMock.RemoveMember mock is already set by Expect")
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveMember.defaultExpectation.paramPtrs = &OrganizationRepositoryMockRemoveMemberParamPtrs{}
+	}
+	mmRemoveMember.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRemoveMember
+}
+
+// ExpectOrganizationIDParam2 sets up expected param organizationID for OrganizationRepository.RemoveMember
+func (mmRemoveMember *mOrganizationRepositoryMockRemoveMember) ExpectOrganizationIDParam2(organizationID int64) *mOrganizationRepositoryMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("OrganizationRepositoryMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &OrganizationRepositoryMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.params != nil {
+		mmRemoveMember.mock.t.Fatalf("OrganizationRepositoryMock.RemoveMember mock is already set by Expect")
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveMember.defaultExpectation.paramPtrs = &OrganizationRepositoryMockRemoveMemberParamPtrs{}
+	}
+	mmRemoveMember.defaultExpectation.paramPtrs.organizationID = &organizationID
+
+	return mmRemoveMember
+}
+
+// ExpectUserIDParam3 sets up expected param userID for OrganizationRepository.RemoveMember
+func (mmRemoveMember *mOrganizationRepositoryMockRemoveMember) ExpectUserIDParam3(userID int64) *mOrganizationRepositoryMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("OrganizationRepositoryMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &OrganizationRepositoryMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.params != nil {
+		mmRemoveMember.mock.t.Fatalf("OrganizationRepositoryMock.RemoveMember mock is already set by Expect")
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveMember.defaultExpectation.paramPtrs = &OrganizationRepositoryMockRemoveMemberParamPtrs{}
+	}
+	mmRemoveMember.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmRemoveMember
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrganizationRepository.RemoveMember
+func (mmRemoveMember *mOrganizationRepositoryMockRemoveMember) Inspect(f func(ctx context.Context, organizationID int64, userID int64)) *mOrganizationRepositoryMockRemoveMember {
+	if mmRemoveMember.mock.inspectFuncRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("Inspect function is already set for OrganizationRepositoryMock.RemoveMember")
+	}
+
+	mmRemoveMember.mock.inspectFuncRemoveMember = f
+
+	return mmRemoveMember
+}
+
+// Return sets up results that will be returned by OrganizationRepository.RemoveMember
+func (mmRemoveMember *mOrganizationRepositoryMockRemoveMember) Return(err error) *OrganizationRepositoryMock {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("OrganizationRepositoryMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &OrganizationRepositoryMockRemoveMemberExpectation{mock: mmRemoveMember.mock}
+	}
+	mmRemoveMember.defaultExpectation.results = &OrganizationRepositoryMockRemoveMemberResults{err}
+	return mmRemoveMember.mock
+}
+
+// Set uses given function f to mock the OrganizationRepository.RemoveMember method
+func (mmRemoveMember *mOrganizationRepositoryMockRemoveMember) Set(f func(ctx context.Context, organizationID int64, userID int64) (err error)) *OrganizationRepositoryMock {
+	if mmRemoveMember.defaultExpectation != nil {
+		mmRemoveMember.mock.t.Fatalf("Default expectation is already set for the OrganizationRepository.RemoveMember method")
+	}
+
+	if len(mmRemoveMember.expectations) > 0 {
+		mmRemoveMember.mock.t.Fatalf("Some expectations are already set for the OrganizationRepository.RemoveMember method")
+	}
+
+	mmRemoveMember.mock.funcRemoveMember = f
+	return mmRemoveMember.mock
+}
+
+// When sets expectation for the OrganizationRepository.RemoveMember which will trigger the result defined by the following
+// Then helper
+func (mmRemoveMember *mOrganizationRepositoryMockRemoveMember) When(ctx context.Context, organizationID int64, userID int64) *OrganizationRepositoryMockRemoveMemberExpectation {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("OrganizationRepositoryMock.RemoveMember mock is already set by Set")
+	}
+
+	expectation := &OrganizationRepositoryMockRemoveMemberExpectation{
+		mock:   mmRemoveMember.mock,
+		params: &OrganizationRepositoryMockRemoveMemberParams{ctx, organizationID, userID},
+	}
+	mmRemoveMember.expectations = append(mmRemoveMember.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrganizationRepository.RemoveMember return parameters for the expectation previously defined by the When method
+func (e *OrganizationRepositoryMockRemoveMemberExpectation) Then(err error) *OrganizationRepositoryMock {
+	e.results = &OrganizationRepositoryMockRemoveMemberResults{err}
+	return e.mock
+}
+
+// RemoveMember implements repository.OrganizationRepository
+func (mmRemoveMember *OrganizationRepositoryMock) RemoveMember(ctx context.Context, organizationID int64, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmRemoveMember.beforeRemoveMemberCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveMember.afterRemoveMemberCounter, 1)
+
+	if mmRemoveMember.inspectFuncRemoveMember != nil {
+		mmRemoveMember.inspectFuncRemoveMember(ctx, organizationID, userID)
+	}
+
+	mm_params := OrganizationRepositoryMockRemoveMemberParams{ctx, organizationID, userID}
+
+	// Record call args
+	mmRemoveMember.RemoveMemberMock.mutex.Lock()
+	mmRemoveMember.RemoveMemberMock.callArgs = append(mmRemoveMember.RemoveMemberMock.callArgs, &mm_params)
+	mmRemoveMember.RemoveMemberMock.mutex.Unlock()
+
+	for _, e := range mmRemoveMember.RemoveMemberMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRemoveMember.RemoveMemberMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveMember.RemoveMemberMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveMember.RemoveMemberMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveMember.RemoveMemberMock.defaultExpectation.paramPtrs
+
+		mm_got := OrganizationRepositoryMockRemoveMemberParams{ctx, organizationID, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveMember.t.Errorf("OrganizationRepositoryMock.RemoveMember got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.organizationID != nil && !minimock.Equal(*mm_want_ptrs.organizationID, mm_got.organizationID) {
+				mmRemoveMember.t.Errorf("OrganizationRepositoryMock.RemoveMember got unexpected parameter organizationID, want: %#v, got: %#v%s\n", *mm_want_ptrs.organizationID, mm_got.organizationID, minimock.Diff(*mm_want_ptrs.organizationID, mm_got.organizationID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRemoveMember.t.Errorf("OrganizationRepositoryMock.RemoveMember got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveMember.t.Errorf("OrganizationRepositoryMock.RemoveMember got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveMember.RemoveMemberMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveMember.t.Fatal("No results are set for the OrganizationRepositoryMock.RemoveMember")
+		}
+		return (*mm_results).err
+	}
+	if mmRemoveMember.funcRemoveMember != nil {
+		return mmRemoveMember.funcRemoveMember(ctx, organizationID, userID)
+	}
+	mmRemoveMember.t.Fatalf("Unexpected call to OrganizationRepositoryMock.RemoveMember. %v %v %v", ctx, organizationID, userID)
+	return
+}
+
+// RemoveMemberAfterCounter returns a count of finished OrganizationRepositoryMock.RemoveMember invocations
+func (mmRemoveMember *OrganizationRepositoryMock) RemoveMemberAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveMember.afterRemoveMemberCounter)
+}
+
+// RemoveMemberBeforeCounter returns a count of OrganizationRepositoryMock.RemoveMember invocations
+func (mmRemoveMember *OrganizationRepositoryMock) RemoveMemberBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveMember.beforeRemoveMemberCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrganizationRepositoryMock.RemoveMember.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveMember *mOrganizationRepositoryMockRemoveMember) Calls() []*OrganizationRepositoryMockRemoveMemberParams {
+	mmRemoveMember.mutex.RLock()
+
+	argCopy := make([]*OrganizationRepositoryMockRemoveMemberParams, len(mmRemoveMember.callArgs))
+	copy(argCopy, mmRemoveMember.callArgs)
+
+	mmRemoveMember.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveMemberDone returns true if the count of the RemoveMember invocations corresponds
+// the number of defined expectations
+func (m *OrganizationRepositoryMock) MinimockRemoveMemberDone() bool {
+	for _, e := range m.RemoveMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveMemberMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRemoveMemberCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveMember != nil && mm_atomic.LoadUint64(&m.afterRemoveMemberCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockRemoveMemberInspect logs each unmet expectation
+func (m *OrganizationRepositoryMock) MinimockRemoveMemberInspect() {
+	for _, e := range m.RemoveMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrganizationRepositoryMock.RemoveMember with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveMemberMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRemoveMemberCounter) < 1 {
+		if m.RemoveMemberMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrganizationRepositoryMock.RemoveMember")
+		} else {
+			m.t.Errorf("Expected call to OrganizationRepositoryMock.RemoveMember with params: %#v", *m.RemoveMemberMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveMember != nil && mm_atomic.LoadUint64(&m.afterRemoveMemberCounter) < 1 {
+		m.t.Error("Expected call to OrganizationRepositoryMock.RemoveMember")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *OrganizationRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddMemberInspect()
+
+			m.MinimockCreateInspect()
+
+			m.MinimockGetBySlugInspect()
+
+			m.MinimockIsMemberInspect()
+
+			m.MinimockListByUserInspect()
+
+			m.MinimockRemoveMemberInspect()
+			m.t.FailNow()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *OrganizationRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *OrganizationRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddMemberDone() &&
+		m.MinimockCreateDone() &&
+		m.MinimockGetBySlugDone() &&
+		m.MinimockIsMemberDone() &&
+		m.MinimockListByUserDone() &&
+		m.MinimockRemoveMemberDone()
+}
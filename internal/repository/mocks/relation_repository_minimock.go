@@ -0,0 +1,1574 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.8). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/arifullov/auth/internal/repository.RelationRepository -o relation_repository_minimock.go -n RelationRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RelationRepositoryMock implements repository.RelationRepository
+type RelationRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateTuples          func(ctx context.Context, tuples []model.RelationTuple, revision int64) (err error)
+	inspectFuncCreateTuples   func(ctx context.Context, tuples []model.RelationTuple, revision int64)
+	afterCreateTuplesCounter  uint64
+	beforeCreateTuplesCounter uint64
+	CreateTuplesMock          mRelationRepositoryMockCreateTuples
+
+	funcDeleteTuples          func(ctx context.Context, tuples []model.RelationTuple, revision int64) (err error)
+	inspectFuncDeleteTuples   func(ctx context.Context, tuples []model.RelationTuple, revision int64)
+	afterDeleteTuplesCounter  uint64
+	beforeDeleteTuplesCounter uint64
+	DeleteTuplesMock          mRelationRepositoryMockDeleteTuples
+
+	funcFindTuples          func(ctx context.Context, filter model.RelationTupleFilter, revision int64) (ra1 []model.RelationTuple, err error)
+	inspectFuncFindTuples   func(ctx context.Context, filter model.RelationTupleFilter, revision int64)
+	afterFindTuplesCounter  uint64
+	beforeFindTuplesCounter uint64
+	FindTuplesMock          mRelationRepositoryMockFindTuples
+
+	funcGetRevision          func(ctx context.Context) (i1 int64, err error)
+	inspectFuncGetRevision   func(ctx context.Context)
+	afterGetRevisionCounter  uint64
+	beforeGetRevisionCounter uint64
+	GetRevisionMock          mRelationRepositoryMockGetRevision
+
+	funcNextRevision          func(ctx context.Context) (i1 int64, err error)
+	inspectFuncNextRevision   func(ctx context.Context)
+	afterNextRevisionCounter  uint64
+	beforeNextRevisionCounter uint64
+	NextRevisionMock          mRelationRepositoryMockNextRevision
+}
+
+// NewRelationRepositoryMock returns a mock for repository.RelationRepository
+func NewRelationRepositoryMock(t minimock.Tester) *RelationRepositoryMock {
+	m := &RelationRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateTuplesMock = mRelationRepositoryMockCreateTuples{mock: m}
+	m.CreateTuplesMock.callArgs = []*RelationRepositoryMockCreateTuplesParams{}
+
+	m.DeleteTuplesMock = mRelationRepositoryMockDeleteTuples{mock: m}
+	m.DeleteTuplesMock.callArgs = []*RelationRepositoryMockDeleteTuplesParams{}
+
+	m.FindTuplesMock = mRelationRepositoryMockFindTuples{mock: m}
+	m.FindTuplesMock.callArgs = []*RelationRepositoryMockFindTuplesParams{}
+
+	m.GetRevisionMock = mRelationRepositoryMockGetRevision{mock: m}
+	m.GetRevisionMock.callArgs = []*RelationRepositoryMockGetRevisionParams{}
+
+	m.NextRevisionMock = mRelationRepositoryMockNextRevision{mock: m}
+	m.NextRevisionMock.callArgs = []*RelationRepositoryMockNextRevisionParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRelationRepositoryMockCreateTuples struct {
+	mock               *RelationRepositoryMock
+	defaultExpectation *RelationRepositoryMockCreateTuplesExpectation
+	expectations       []*RelationRepositoryMockCreateTuplesExpectation
+
+	callArgs []*RelationRepositoryMockCreateTuplesParams
+	mutex    sync.RWMutex
+}
+
+// RelationRepositoryMockCreateTuplesExpectation specifies expectation struct of the RelationRepository.CreateTuples
+type RelationRepositoryMockCreateTuplesExpectation struct {
+	mock      *RelationRepositoryMock
+	params    *RelationRepositoryMockCreateTuplesParams
+	paramPtrs *RelationRepositoryMockCreateTuplesParamPtrs
+	results   *RelationRepositoryMockCreateTuplesResults
+	Counter   uint64
+}
+
+// RelationRepositoryMockCreateTuplesParams contains parameters of the RelationRepository.CreateTuples
+type RelationRepositoryMockCreateTuplesParams struct {
+	ctx      context.Context
+	tuples   []model.RelationTuple
+	revision int64
+}
+
+// RelationRepositoryMockCreateTuplesParamPtrs contains pointers to parameters of the RelationRepository.CreateTuples
+type RelationRepositoryMockCreateTuplesParamPtrs struct {
+	ctx      *context.Context
+	tuples   *[]model.RelationTuple
+	revision *int64
+}
+
+// RelationRepositoryMockCreateTuplesResults contains results of the RelationRepository.CreateTuples
+type RelationRepositoryMockCreateTuplesResults struct {
+	err error
+}
+
+// Expect sets up expected params for RelationRepository.CreateTuples
+func (mmCreateTuples *mRelationRepositoryMockCreateTuples) Expect(ctx context.Context, tuples []model.RelationTuple, revision int64) *mRelationRepositoryMockCreateTuples {
+	if mmCreateTuples.mock.funcCreateTuples != nil {
+		mmCreateTuples.mock.t.Fatalf("RelationRepositoryMock.CreateTuples mock is already set by Set")
+	}
+
+	if mmCreateTuples.defaultExpectation == nil {
+		mmCreateTuples.defaultExpectation = &RelationRepositoryMockCreateTuplesExpectation{}
+	}
+
+	if mmCreateTuples.defaultExpectation.paramPtrs != nil {
+		mmCreateTuples.mock.t.Fatalf("RelationRepositoryMock.CreateTuples mock is already set by ExpectParams functions")
+	}
+
+	mmCreateTuples.defaultExpectation.params = &RelationRepositoryMockCreateTuplesParams{ctx, tuples, revision}
+	for _, e := range mmCreateTuples.expectations {
+		if minimock.Equal(e.params, mmCreateTuples.defaultExpectation.params) {
+			mmCreateTuples.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateTuples.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateTuples
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationRepository.CreateTuples
+func (mmCreateTuples *mRelationRepositoryMockCreateTuples) ExpectCtxParam1(ctx context.Context) *mRelationRepositoryMockCreateTuples {
+	if mmCreateTuples.mock.funcCreateTuples != nil {
+		mmCreateTuples.mock.t.Fatalf("RelationRepositoryMock.CreateTuples mock is already set by Set")
+	}
+
+	if mmCreateTuples.defaultExpectation == nil {
+		mmCreateTuples.defaultExpectation = &RelationRepositoryMockCreateTuplesExpectation{}
+	}
+
+	if mmCreateTuples.defaultExpectation.params != nil {
+		mmCreateTuples.mock.t.Fatalf("RelationRepositoryMock.CreateTuples mock is already set by Expect")
+	}
+
+	if mmCreateTuples.defaultExpectation.paramPtrs == nil {
+		mmCreateTuples.defaultExpectation.paramPtrs = &RelationRepositoryMockCreateTuplesParamPtrs{}
+	}
+	mmCreateTuples.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateTuples
+}
+
+// ExpectTuplesParam2 sets up expected param tuples for RelationRepository.CreateTuples
+func (mmCreateTuples *mRelationRepositoryMockCreateTuples) ExpectTuplesParam2(tuples []model.RelationTuple) *mRelationRepositoryMockCreateTuples {
+	if mmCreateTuples.mock.funcCreateTuples != nil {
+		mmCreateTuples.mock.t.Fatalf("RelationRepositoryMock.CreateTuples mock is already set by Set")
+	}
+
+	if mmCreateTuples.defaultExpectation == nil {
+		mmCreateTuples.defaultExpectation = &RelationRepositoryMockCreateTuplesExpectation{}
+	}
+
+	if mmCreateTuples.defaultExpectation.params != nil {
+		mmCreateTuples.mock.t.Fatalf("RelationRepositoryMock.CreateTuples mock is already set by Expect")
+	}
+
+	if mmCreateTuples.defaultExpectation.paramPtrs == nil {
+		mmCreateTuples.defaultExpectation.paramPtrs = &RelationRepositoryMockCreateTuplesParamPtrs{}
+	}
+	mmCreateTuples.defaultExpectation.paramPtrs.tuples = &tuples
+
+	return mmCreateTuples
+}
+
+// ExpectRevisionParam3 sets up expected param revision for RelationRepository.CreateTuples
+func (mmCreateTuples *mRelationRepositoryMockCreateTuples) ExpectRevisionParam3(revision int64) *mRelationRepositoryMockCreateTuples {
+	if mmCreateTuples.mock.funcCreateTuples != nil {
+		mmCreateTuples.mock.t.Fatalf("RelationRepositoryMock.CreateTuples mock is already set by Set")
+	}
+
+	if mmCreateTuples.defaultExpectation == nil {
+		mmCreateTuples.defaultExpectation = &RelationRepositoryMockCreateTuplesExpectation{}
+	}
+
+	if mmCreateTuples.defaultExpectation.params != nil {
+		mmCreateTuples.mock.t.Fatalf("RelationRepositoryMock.CreateTuples mock is already set by Expect")
+	}
+
+	if mmCreateTuples.defaultExpectation.paramPtrs == nil {
+		mmCreateTuples.defaultExpectation.paramPtrs = &RelationRepositoryMockCreateTuplesParamPtrs{}
+	}
+	mmCreateTuples.defaultExpectation.paramPtrs.revision = &revision
+
+	return mmCreateTuples
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationRepository.CreateTuples
+func (mmCreateTuples *mRelationRepositoryMockCreateTuples) Inspect(f func(ctx context.Context, tuples []model.RelationTuple, revision int64)) *mRelationRepositoryMockCreateTuples {
+	if mmCreateTuples.mock.inspectFuncCreateTuples != nil {
+		mmCreateTuples.mock.t.Fatalf("Inspect function is already set for RelationRepositoryMock.CreateTuples")
+	}
+
+	mmCreateTuples.mock.inspectFuncCreateTuples = f
+
+	return mmCreateTuples
+}
+
+// Return sets up results that will be returned by RelationRepository.CreateTuples
+func (mmCreateTuples *mRelationRepositoryMockCreateTuples) Return(err error) *RelationRepositoryMock {
+	if mmCreateTuples.mock.funcCreateTuples != nil {
+		mmCreateTuples.mock.t.Fatalf("RelationRepositoryMock.CreateTuples mock is already set by Set")
+	}
+
+	if mmCreateTuples.defaultExpectation == nil {
+		mmCreateTuples.defaultExpectation = &RelationRepositoryMockCreateTuplesExpectation{mock: mmCreateTuples.mock}
+	}
+	mmCreateTuples.defaultExpectation.results = &RelationRepositoryMockCreateTuplesResults{err}
+	return mmCreateTuples.mock
+}
+
+// Set uses given function f to mock the RelationRepository.CreateTuples method
+func (mmCreateTuples *mRelationRepositoryMockCreateTuples) Set(f func(ctx context.Context, tuples []model.RelationTuple, revision int64) (err error)) *RelationRepositoryMock {
+	if mmCreateTuples.defaultExpectation != nil {
+		mmCreateTuples.mock.t.Fatalf("Default expectation is already set for the RelationRepository.CreateTuples method")
+	}
+
+	if len(mmCreateTuples.expectations) > 0 {
+		mmCreateTuples.mock.t.Fatalf("Some expectations are already set for the RelationRepository.CreateTuples method")
+	}
+
+	mmCreateTuples.mock.funcCreateTuples = f
+	return mmCreateTuples.mock
+}
+
+// When sets expectation for the RelationRepository.CreateTuples which will trigger the result defined by the following
+// Then helper
+func (mmCreateTuples *mRelationRepositoryMockCreateTuples) When(ctx context.Context, tuples []model.RelationTuple, revision int64) *RelationRepositoryMockCreateTuplesExpectation {
+	if mmCreateTuples.mock.funcCreateTuples != nil {
+		mmCreateTuples.mock.t.Fatalf("RelationRepositoryMock.CreateTuples mock is already set by Set")
+	}
+
+	expectation := &RelationRepositoryMockCreateTuplesExpectation{
+		mock:   mmCreateTuples.mock,
+		params: &RelationRepositoryMockCreateTuplesParams{ctx, tuples, revision},
+	}
+	mmCreateTuples.expectations = append(mmCreateTuples.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationRepository.CreateTuples return parameters for the expectation previously defined by the When method
+func (e *RelationRepositoryMockCreateTuplesExpectation) Then(err error) *RelationRepositoryMock {
+	e.results = &RelationRepositoryMockCreateTuplesResults{err}
+	return e.mock
+}
+
+// CreateTuples implements repository.RelationRepository
+func (mmCreateTuples *RelationRepositoryMock) CreateTuples(ctx context.Context, tuples []model.RelationTuple, revision int64) (err error) {
+	mm_atomic.AddUint64(&mmCreateTuples.beforeCreateTuplesCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateTuples.afterCreateTuplesCounter, 1)
+
+	if mmCreateTuples.inspectFuncCreateTuples != nil {
+		mmCreateTuples.inspectFuncCreateTuples(ctx, tuples, revision)
+	}
+
+	mm_params := RelationRepositoryMockCreateTuplesParams{ctx, tuples, revision}
+
+	// Record call args
+	mmCreateTuples.CreateTuplesMock.mutex.Lock()
+	mmCreateTuples.CreateTuplesMock.callArgs = append(mmCreateTuples.CreateTuplesMock.callArgs, &mm_params)
+	mmCreateTuples.CreateTuplesMock.mutex.Unlock()
+
+	for _, e := range mmCreateTuples.CreateTuplesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateTuples.CreateTuplesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateTuples.CreateTuplesMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateTuples.CreateTuplesMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateTuples.CreateTuplesMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationRepositoryMockCreateTuplesParams{ctx, tuples, revision}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateTuples.t.Errorf("RelationRepositoryMock.CreateTuples got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tuples != nil && !minimock.Equal(*mm_want_ptrs.tuples, mm_got.tuples) {
+				mmCreateTuples.t.Errorf("RelationRepositoryMock.CreateTuples got unexpected parameter tuples, want: %#v, got: %#v%s\n", *mm_want_ptrs.tuples, mm_got.tuples, minimock.Diff(*mm_want_ptrs.tuples, mm_got.tuples))
+			}
+
+			if mm_want_ptrs.revision != nil && !minimock.Equal(*mm_want_ptrs.revision, mm_got.revision) {
+				mmCreateTuples.t.Errorf("RelationRepositoryMock.CreateTuples got unexpected parameter revision, want: %#v, got: %#v%s\n", *mm_want_ptrs.revision, mm_got.revision, minimock.Diff(*mm_want_ptrs.revision, mm_got.revision))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateTuples.t.Errorf("RelationRepositoryMock.CreateTuples got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateTuples.CreateTuplesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateTuples.t.Fatal("No results are set for the RelationRepositoryMock.CreateTuples")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateTuples.funcCreateTuples != nil {
+		return mmCreateTuples.funcCreateTuples(ctx, tuples, revision)
+	}
+	mmCreateTuples.t.Fatalf("Unexpected call to RelationRepositoryMock.CreateTuples. %v %v %v", ctx, tuples, revision)
+	return
+}
+
+// CreateTuplesAfterCounter returns a count of finished RelationRepositoryMock.CreateTuples invocations
+func (mmCreateTuples *RelationRepositoryMock) CreateTuplesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateTuples.afterCreateTuplesCounter)
+}
+
+// CreateTuplesBeforeCounter returns a count of RelationRepositoryMock.CreateTuples invocations
+func (mmCreateTuples *RelationRepositoryMock) CreateTuplesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateTuples.beforeCreateTuplesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationRepositoryMock.CreateTuples.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateTuples *mRelationRepositoryMockCreateTuples) Calls() []*RelationRepositoryMockCreateTuplesParams {
+	mmCreateTuples.mutex.RLock()
+
+	argCopy := make([]*RelationRepositoryMockCreateTuplesParams, len(mmCreateTuples.callArgs))
+	copy(argCopy, mmCreateTuples.callArgs)
+
+	mmCreateTuples.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateTuplesDone returns true if the count of the CreateTuples invocations corresponds
+// the number of defined expectations
+func (m *RelationRepositoryMock) MinimockCreateTuplesDone() bool {
+	for _, e := range m.CreateTuplesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateTuplesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateTuplesCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateTuples != nil && mm_atomic.LoadUint64(&m.afterCreateTuplesCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCreateTuplesInspect logs each unmet expectation
+func (m *RelationRepositoryMock) MinimockCreateTuplesInspect() {
+	for _, e := range m.CreateTuplesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationRepositoryMock.CreateTuples with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateTuplesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateTuplesCounter) < 1 {
+		if m.CreateTuplesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RelationRepositoryMock.CreateTuples")
+		} else {
+			m.t.Errorf("Expected call to RelationRepositoryMock.CreateTuples with params: %#v", *m.CreateTuplesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateTuples != nil && mm_atomic.LoadUint64(&m.afterCreateTuplesCounter) < 1 {
+		m.t.Error("Expected call to RelationRepositoryMock.CreateTuples")
+	}
+}
+
+type mRelationRepositoryMockDeleteTuples struct {
+	mock               *RelationRepositoryMock
+	defaultExpectation *RelationRepositoryMockDeleteTuplesExpectation
+	expectations       []*RelationRepositoryMockDeleteTuplesExpectation
+
+	callArgs []*RelationRepositoryMockDeleteTuplesParams
+	mutex    sync.RWMutex
+}
+
+// RelationRepositoryMockDeleteTuplesExpectation specifies expectation struct of the RelationRepository.DeleteTuples
+type RelationRepositoryMockDeleteTuplesExpectation struct {
+	mock      *RelationRepositoryMock
+	params    *RelationRepositoryMockDeleteTuplesParams
+	paramPtrs *RelationRepositoryMockDeleteTuplesParamPtrs
+	results   *RelationRepositoryMockDeleteTuplesResults
+	Counter   uint64
+}
+
+// RelationRepositoryMockDeleteTuplesParams contains parameters of the RelationRepository.DeleteTuples
+type RelationRepositoryMockDeleteTuplesParams struct {
+	ctx      context.Context
+	tuples   []model.RelationTuple
+	revision int64
+}
+
+// RelationRepositoryMockDeleteTuplesParamPtrs contains pointers to parameters of the RelationRepository.DeleteTuples
+type RelationRepositoryMockDeleteTuplesParamPtrs struct {
+	ctx      *context.Context
+	tuples   *[]model.RelationTuple
+	revision *int64
+}
+
+// RelationRepositoryMockDeleteTuplesResults contains results of the RelationRepository.DeleteTuples
+type RelationRepositoryMockDeleteTuplesResults struct {
+	err error
+}
+
+// Expect sets up expected params for RelationRepository.DeleteTuples
+func (mmDeleteTuples *mRelationRepositoryMockDeleteTuples) Expect(ctx context.Context, tuples []model.RelationTuple, revision int64) *mRelationRepositoryMockDeleteTuples {
+	if mmDeleteTuples.mock.funcDeleteTuples != nil {
+		mmDeleteTuples.mock.t.Fatalf("RelationRepositoryMock.DeleteTuples mock is already set by Set")
+	}
+
+	if mmDeleteTuples.defaultExpectation == nil {
+		mmDeleteTuples.defaultExpectation = &RelationRepositoryMockDeleteTuplesExpectation{}
+	}
+
+	if mmDeleteTuples.defaultExpectation.paramPtrs != nil {
+		mmDeleteTuples.mock.t.Fatalf("RelationRepositoryMock.DeleteTuples mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteTuples.defaultExpectation.params = &RelationRepositoryMockDeleteTuplesParams{ctx, tuples, revision}
+	for _, e := range mmDeleteTuples.expectations {
+		if minimock.Equal(e.params, mmDeleteTuples.defaultExpectation.params) {
+			mmDeleteTuples.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteTuples.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteTuples
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationRepository.DeleteTuples
+func (mmDeleteTuples *mRelationRepositoryMockDeleteTuples) ExpectCtxParam1(ctx context.Context) *mRelationRepositoryMockDeleteTuples {
+	if mmDeleteTuples.mock.funcDeleteTuples != nil {
+		mmDeleteTuples.mock.t.Fatalf("RelationRepositoryMock.DeleteTuples mock is already set by Set")
+	}
+
+	if mmDeleteTuples.defaultExpectation == nil {
+		mmDeleteTuples.defaultExpectation = &RelationRepositoryMockDeleteTuplesExpectation{}
+	}
+
+	if mmDeleteTuples.defaultExpectation.params != nil {
+		mmDeleteTuples.mock.t.Fatalf("RelationRepositoryMock.DeleteTuples mock is already set by Expect")
+	}
+
+	if mmDeleteTuples.defaultExpectation.paramPtrs == nil {
+		mmDeleteTuples.defaultExpectation.paramPtrs = &RelationRepositoryMockDeleteTuplesParamPtrs{}
+	}
+	mmDeleteTuples.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeleteTuples
+}
+
+// ExpectTuplesParam2 sets up expected param tuples for RelationRepository.DeleteTuples
+func (mmDeleteTuples *mRelationRepositoryMockDeleteTuples) ExpectTuplesParam2(tuples []model.RelationTuple) *mRelationRepositoryMockDeleteTuples {
+	if mmDeleteTuples.mock.funcDeleteTuples != nil {
+		mmDeleteTuples.mock.t.Fatalf("RelationRepositoryMock.DeleteTuples mock is already set by Set")
+	}
+
+	if mmDeleteTuples.defaultExpectation == nil {
+		mmDeleteTuples.defaultExpectation = &RelationRepositoryMockDeleteTuplesExpectation{}
+	}
+
+	if mmDeleteTuples.defaultExpectation.params != nil {
+		mmDeleteTuples.mock.t.Fatalf("RelationRepositoryMock.DeleteTuples mock is already set by Expect")
+	}
+
+	if mmDeleteTuples.defaultExpectation.paramPtrs == nil {
+		mmDeleteTuples.defaultExpectation.paramPtrs = &RelationRepositoryMockDeleteTuplesParamPtrs{}
+	}
+	mmDeleteTuples.defaultExpectation.paramPtrs.tuples = &tuples
+
+	return mmDeleteTuples
+}
+
+// ExpectRevisionParam3 sets up expected param revision for RelationRepository.DeleteTuples
+func (mmDeleteTuples *mRelationRepositoryMockDeleteTuples) ExpectRevisionParam3(revision int64) *mRelationRepositoryMockDeleteTuples {
+	if mmDeleteTuples.mock.funcDeleteTuples != nil {
+		mmDeleteTuples.mock.t.Fatalf("RelationRepositoryMock.DeleteTuples mock is already set by Set")
+	}
+
+	if mmDeleteTuples.defaultExpectation == nil {
+		mmDeleteTuples.defaultExpectation = &RelationRepositoryMockDeleteTuplesExpectation{}
+	}
+
+	if mmDeleteTuples.defaultExpectation.params != nil {
+		mmDeleteTuples.mock.t.Fatalf("RelationRepositoryMock.DeleteTuples mock is already set by Expect")
+	}
+
+	if mmDeleteTuples.defaultExpectation.paramPtrs == nil {
+		mmDeleteTuples.defaultExpectation.paramPtrs = &RelationRepositoryMockDeleteTuplesParamPtrs{}
+	}
+	mmDeleteTuples.defaultExpectation.paramPtrs.revision = &revision
+
+	return mmDeleteTuples
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationRepository.DeleteTuples
+func (mmDeleteTuples *mRelationRepositoryMockDeleteTuples) Inspect(f func(ctx context.Context, tuples []model.RelationTuple, revision int64)) *mRelationRepositoryMockDeleteTuples {
+	if mmDeleteTuples.mock.inspectFuncDeleteTuples != nil {
+		mmDeleteTuples.mock.t.Fatalf("Inspect function is already set for RelationRepositoryMock.DeleteTuples")
+	}
+
+	mmDeleteTuples.mock.inspectFuncDeleteTuples = f
+
+	return mmDeleteTuples
+}
+
+// Return sets up results that will be returned by RelationRepository.DeleteTuples
+func (mmDeleteTuples *mRelationRepositoryMockDeleteTuples) Return(err error) *RelationRepositoryMock {
+	if mmDeleteTuples.mock.funcDeleteTuples != nil {
+		mmDeleteTuples.mock.t.Fatalf("RelationRepositoryMock.DeleteTuples mock is already set by Set")
+	}
+
+	if mmDeleteTuples.defaultExpectation == nil {
+		mmDeleteTuples.defaultExpectation = &RelationRepositoryMockDeleteTuplesExpectation{mock: mmDeleteTuples.mock}
+	}
+	mmDeleteTuples.defaultExpectation.results = &RelationRepositoryMockDeleteTuplesResults{err}
+	return mmDeleteTuples.mock
+}
+
+// Set uses given function f to mock the RelationRepository.DeleteTuples method
+func (mmDeleteTuples *mRelationRepositoryMockDeleteTuples) Set(f func(ctx context.Context, tuples []model.RelationTuple, revision int64) (err error)) *RelationRepositoryMock {
+	if mmDeleteTuples.defaultExpectation != nil {
+		mmDeleteTuples.mock.t.Fatalf("Default expectation is already set for the RelationRepository.DeleteTuples method")
+	}
+
+	if len(mmDeleteTuples.expectations) > 0 {
+		mmDeleteTuples.mock.t.Fatalf("Some expectations are already set for the RelationRepository.DeleteTuples method")
+	}
+
+	mmDeleteTuples.mock.funcDeleteTuples = f
+	return mmDeleteTuples.mock
+}
+
+// When sets expectation for the RelationRepository.DeleteTuples which will trigger the result defined by the following
+// Then helper
+func (mmDeleteTuples *mRelationRepositoryMockDeleteTuples) When(ctx context.Context, tuples []model.RelationTuple, revision int64) *RelationRepositoryMockDeleteTuplesExpectation {
+	if mmDeleteTuples.mock.funcDeleteTuples != nil {
+		mmDeleteTuples.mock.t.Fatalf("RelationRepositoryMock.DeleteTuples mock is already set by Set")
+	}
+
+	expectation := &RelationRepositoryMockDeleteTuplesExpectation{
+		mock:   mmDeleteTuples.mock,
+		params: &RelationRepositoryMockDeleteTuplesParams{ctx, tuples, revision},
+	}
+	mmDeleteTuples.expectations = append(mmDeleteTuples.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationRepository.DeleteTuples return parameters for the expectation previously defined by the When method
+func (e *RelationRepositoryMockDeleteTuplesExpectation) Then(err error) *RelationRepositoryMock {
+	e.results = &RelationRepositoryMockDeleteTuplesResults{err}
+	return e.mock
+}
+
+// DeleteTuples implements repository.RelationRepository
+func (mmDeleteTuples *RelationRepositoryMock) DeleteTuples(ctx context.Context, tuples []model.RelationTuple, revision int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteTuples.beforeDeleteTuplesCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteTuples.afterDeleteTuplesCounter, 1)
+
+	if mmDeleteTuples.inspectFuncDeleteTuples != nil {
+		mmDeleteTuples.inspectFuncDeleteTuples(ctx, tuples, revision)
+	}
+
+	mm_params := RelationRepositoryMockDeleteTuplesParams{ctx, tuples, revision}
+
+	// Record call args
+	mmDeleteTuples.DeleteTuplesMock.mutex.Lock()
+	mmDeleteTuples.DeleteTuplesMock.callArgs = append(mmDeleteTuples.DeleteTuplesMock.callArgs, &mm_params)
+	mmDeleteTuples.DeleteTuplesMock.mutex.Unlock()
+
+	for _, e := range mmDeleteTuples.DeleteTuplesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteTuples.DeleteTuplesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteTuples.DeleteTuplesMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteTuples.DeleteTuplesMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteTuples.DeleteTuplesMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationRepositoryMockDeleteTuplesParams{ctx, tuples, revision}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteTuples.t.Errorf("RelationRepositoryMock.DeleteTuples got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tuples != nil && !minimock.Equal(*mm_want_ptrs.tuples, mm_got.tuples) {
+				mmDeleteTuples.t.Errorf("RelationRepositoryMock.DeleteTuples got unexpected parameter tuples, want: %#v, got: %#v%s\n", *mm_want_ptrs.tuples, mm_got.tuples, minimock.Diff(*mm_want_ptrs.tuples, mm_got.tuples))
+			}
+
+			if mm_want_ptrs.revision != nil && !minimock.Equal(*mm_want_ptrs.revision, mm_got.revision) {
+				mmDeleteTuples.t.Errorf("RelationRepositoryMock.DeleteTuples got unexpected parameter revision, want: %#v, got: %#v%s\n", *mm_want_ptrs.revision, mm_got.revision, minimock.Diff(*mm_want_ptrs.revision, mm_got.revision))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteTuples.t.Errorf("RelationRepositoryMock.DeleteTuples got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteTuples.DeleteTuplesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteTuples.t.Fatal("No results are set for the RelationRepositoryMock.DeleteTuples")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteTuples.funcDeleteTuples != nil {
+		return mmDeleteTuples.funcDeleteTuples(ctx, tuples, revision)
+	}
+	mmDeleteTuples.t.Fatalf("Unexpected call to RelationRepositoryMock.DeleteTuples. %v %v %v", ctx, tuples, revision)
+	return
+}
+
+// DeleteTuplesAfterCounter returns a count of finished RelationRepositoryMock.DeleteTuples invocations
+func (mmDeleteTuples *RelationRepositoryMock) DeleteTuplesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteTuples.afterDeleteTuplesCounter)
+}
+
+// DeleteTuplesBeforeCounter returns a count of RelationRepositoryMock.DeleteTuples invocations
+func (mmDeleteTuples *RelationRepositoryMock) DeleteTuplesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteTuples.beforeDeleteTuplesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationRepositoryMock.DeleteTuples.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteTuples *mRelationRepositoryMockDeleteTuples) Calls() []*RelationRepositoryMockDeleteTuplesParams {
+	mmDeleteTuples.mutex.RLock()
+
+	argCopy := make([]*RelationRepositoryMockDeleteTuplesParams, len(mmDeleteTuples.callArgs))
+	copy(argCopy, mmDeleteTuples.callArgs)
+
+	mmDeleteTuples.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteTuplesDone returns true if the count of the DeleteTuples invocations corresponds
+// the number of defined expectations
+func (m *RelationRepositoryMock) MinimockDeleteTuplesDone() bool {
+	for _, e := range m.DeleteTuplesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteTuplesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteTuplesCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteTuples != nil && mm_atomic.LoadUint64(&m.afterDeleteTuplesCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockDeleteTuplesInspect logs each unmet expectation
+func (m *RelationRepositoryMock) MinimockDeleteTuplesInspect() {
+	for _, e := range m.DeleteTuplesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationRepositoryMock.DeleteTuples with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteTuplesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteTuplesCounter) < 1 {
+		if m.DeleteTuplesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RelationRepositoryMock.DeleteTuples")
+		} else {
+			m.t.Errorf("Expected call to RelationRepositoryMock.DeleteTuples with params: %#v", *m.DeleteTuplesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteTuples != nil && mm_atomic.LoadUint64(&m.afterDeleteTuplesCounter) < 1 {
+		m.t.Error("Expected call to RelationRepositoryMock.DeleteTuples")
+	}
+}
+
+type mRelationRepositoryMockFindTuples struct {
+	mock               *RelationRepositoryMock
+	defaultExpectation *RelationRepositoryMockFindTuplesExpectation
+	expectations       []*RelationRepositoryMockFindTuplesExpectation
+
+	callArgs []*RelationRepositoryMockFindTuplesParams
+	mutex    sync.RWMutex
+}
+
+// RelationRepositoryMockFindTuplesExpectation specifies expectation struct of the RelationRepository.FindTuples
+type RelationRepositoryMockFindTuplesExpectation struct {
+	mock      *RelationRepositoryMock
+	params    *RelationRepositoryMockFindTuplesParams
+	paramPtrs *RelationRepositoryMockFindTuplesParamPtrs
+	results   *RelationRepositoryMockFindTuplesResults
+	Counter   uint64
+}
+
+// RelationRepositoryMockFindTuplesParams contains parameters of the RelationRepository.FindTuples
+type RelationRepositoryMockFindTuplesParams struct {
+	ctx      context.Context
+	filter   model.RelationTupleFilter
+	revision int64
+}
+
+// RelationRepositoryMockFindTuplesParamPtrs contains pointers to parameters of the RelationRepository.FindTuples
+type RelationRepositoryMockFindTuplesParamPtrs struct {
+	ctx      *context.Context
+	filter   *model.RelationTupleFilter
+	revision *int64
+}
+
+// RelationRepositoryMockFindTuplesResults contains results of the RelationRepository.FindTuples
+type RelationRepositoryMockFindTuplesResults struct {
+	ra1 []model.RelationTuple
+	err error
+}
+
+// Expect sets up expected params for RelationRepository.FindTuples
+func (mmFindTuples *mRelationRepositoryMockFindTuples) Expect(ctx context.Context, filter model.RelationTupleFilter, revision int64) *mRelationRepositoryMockFindTuples {
+	if mmFindTuples.mock.funcFindTuples != nil {
+		mmFindTuples.mock.t.Fatalf("RelationRepositoryMock.FindTuples mock is already set by Set")
+	}
+
+	if mmFindTuples.defaultExpectation == nil {
+		mmFindTuples.defaultExpectation = &RelationRepositoryMockFindTuplesExpectation{}
+	}
+
+	if mmFindTuples.defaultExpectation.paramPtrs != nil {
+		mmFindTuples.mock.t.Fatalf("RelationRepositoryMock.FindTuples mock is already set by ExpectParams functions")
+	}
+
+	mmFindTuples.defaultExpectation.params = &RelationRepositoryMockFindTuplesParams{ctx, filter, revision}
+	for _, e := range mmFindTuples.expectations {
+		if minimock.Equal(e.params, mmFindTuples.defaultExpectation.params) {
+			mmFindTuples.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindTuples.defaultExpectation.params)
+		}
+	}
+
+	return mmFindTuples
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationRepository.FindTuples
+func (mmFindTuples *mRelationRepositoryMockFindTuples) ExpectCtxParam1(ctx context.Context) *mRelationRepositoryMockFindTuples {
+	if mmFindTuples.mock.funcFindTuples != nil {
+		mmFindTuples.mock.t.Fatalf("RelationRepositoryMock.FindTuples mock is already set by Set")
+	}
+
+	if mmFindTuples.defaultExpectation == nil {
+		mmFindTuples.defaultExpectation = &RelationRepositoryMockFindTuplesExpectation{}
+	}
+
+	if mmFindTuples.defaultExpectation.params != nil {
+		mmFindTuples.mock.t.Fatalf("RelationRepositoryMock.FindTuples mock is already set by Expect")
+	}
+
+	if mmFindTuples.defaultExpectation.paramPtrs == nil {
+		mmFindTuples.defaultExpectation.paramPtrs = &RelationRepositoryMockFindTuplesParamPtrs{}
+	}
+	mmFindTuples.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmFindTuples
+}
+
+// ExpectFilterParam2 sets up expected param filter for RelationRepository.FindTuples
+func (mmFindTuples *mRelationRepositoryMockFindTuples) ExpectFilterParam2(filter model.RelationTupleFilter) *mRelationRepositoryMockFindTuples {
+	if mmFindTuples.mock.funcFindTuples != nil {
+		mmFindTuples.mock.t.Fatalf("RelationRepositoryMock.FindTuples mock is already set by Set")
+	}
+
+	if mmFindTuples.defaultExpectation == nil {
+		mmFindTuples.defaultExpectation = &RelationRepositoryMockFindTuplesExpectation{}
+	}
+
+	if mmFindTuples.defaultExpectation.params != nil {
+		mmFindTuples.mock.t.Fatalf("RelationRepositoryMock.FindTuples mock is already set by Expect")
+	}
+
+	if mmFindTuples.defaultExpectation.paramPtrs == nil {
+		mmFindTuples.defaultExpectation.paramPtrs = &RelationRepositoryMockFindTuplesParamPtrs{}
+	}
+	mmFindTuples.defaultExpectation.paramPtrs.filter = &filter
+
+	return mmFindTuples
+}
+
+// ExpectRevisionParam3 sets up expected param revision for RelationRepository.FindTuples
+func (mmFindTuples *mRelationRepositoryMockFindTuples) ExpectRevisionParam3(revision int64) *mRelationRepositoryMockFindTuples {
+	if mmFindTuples.mock.funcFindTuples != nil {
+		mmFindTuples.mock.t.Fatalf("RelationRepositoryMock.FindTuples mock is already set by Set")
+	}
+
+	if mmFindTuples.defaultExpectation == nil {
+		mmFindTuples.defaultExpectation = &RelationRepositoryMockFindTuplesExpectation{}
+	}
+
+	if mmFindTuples.defaultExpectation.params != nil {
+		mmFindTuples.mock.t.Fatalf("RelationRepositoryMock.FindTuples mock is already set by Expect")
+	}
+
+	if mmFindTuples.defaultExpectation.paramPtrs == nil {
+		mmFindTuples.defaultExpectation.paramPtrs = &RelationRepositoryMockFindTuplesParamPtrs{}
+	}
+	mmFindTuples.defaultExpectation.paramPtrs.revision = &revision
+
+	return mmFindTuples
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationRepository.FindTuples
+func (mmFindTuples *mRelationRepositoryMockFindTuples) Inspect(f func(ctx context.Context, filter model.RelationTupleFilter, revision int64)) *mRelationRepositoryMockFindTuples {
+	if mmFindTuples.mock.inspectFuncFindTuples != nil {
+		mmFindTuples.mock.t.Fatalf("Inspect function is already set for RelationRepositoryMock.FindTuples")
+	}
+
+	mmFindTuples.mock.inspectFuncFindTuples = f
+
+	return mmFindTuples
+}
+
+// Return sets up results that will be returned by RelationRepository.FindTuples
+func (mmFindTuples *mRelationRepositoryMockFindTuples) Return(ra1 []model.RelationTuple, err error) *RelationRepositoryMock {
+	if mmFindTuples.mock.funcFindTuples != nil {
+		mmFindTuples.mock.t.Fatalf("RelationRepositoryMock.FindTuples mock is already set by Set")
+	}
+
+	if mmFindTuples.defaultExpectation == nil {
+		mmFindTuples.defaultExpectation = &RelationRepositoryMockFindTuplesExpectation{mock: mmFindTuples.mock}
+	}
+	mmFindTuples.defaultExpectation.results = &RelationRepositoryMockFindTuplesResults{ra1, err}
+	return mmFindTuples.mock
+}
+
+// Set uses given function f to mock the RelationRepository.FindTuples method
+func (mmFindTuples *mRelationRepositoryMockFindTuples) Set(f func(ctx context.Context, filter model.RelationTupleFilter, revision int64) (ra1 []model.RelationTuple, err error)) *RelationRepositoryMock {
+	if mmFindTuples.defaultExpectation != nil {
+		mmFindTuples.mock.t.Fatalf("Default expectation is already set for the RelationRepository.FindTuples method")
+	}
+
+	if len(mmFindTuples.expectations) > 0 {
+		mmFindTuples.mock.t.Fatalf("Some expectations are already set for the RelationRepository.FindTuples method")
+	}
+
+	mmFindTuples.mock.funcFindTuples = f
+	return mmFindTuples.mock
+}
+
+// When sets expectation for the RelationRepository.FindTuples which will trigger the result defined by the following
+// Then helper
+func (mmFindTuples *mRelationRepositoryMockFindTuples) When(ctx context.Context, filter model.RelationTupleFilter, revision int64) *RelationRepositoryMockFindTuplesExpectation {
+	if mmFindTuples.mock.funcFindTuples != nil {
+		mmFindTuples.mock.t.Fatalf("RelationRepositoryMock.FindTuples mock is already set by Set")
+	}
+
+	expectation := &RelationRepositoryMockFindTuplesExpectation{
+		mock:   mmFindTuples.mock,
+		params: &RelationRepositoryMockFindTuplesParams{ctx, filter, revision},
+	}
+	mmFindTuples.expectations = append(mmFindTuples.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationRepository.FindTuples return parameters for the expectation previously defined by the When method
+func (e *RelationRepositoryMockFindTuplesExpectation) Then(ra1 []model.RelationTuple, err error) *RelationRepositoryMock {
+	e.results = &RelationRepositoryMockFindTuplesResults{ra1, err}
+	return e.mock
+}
+
+// FindTuples implements repository.RelationRepository
+func (mmFindTuples *RelationRepositoryMock) FindTuples(ctx context.Context, filter model.RelationTupleFilter, revision int64) (ra1 []model.RelationTuple, err error) {
+	mm_atomic.AddUint64(&mmFindTuples.beforeFindTuplesCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindTuples.afterFindTuplesCounter, 1)
+
+	if mmFindTuples.inspectFuncFindTuples != nil {
+		mmFindTuples.inspectFuncFindTuples(ctx, filter, revision)
+	}
+
+	mm_params := RelationRepositoryMockFindTuplesParams{ctx, filter, revision}
+
+	// Record call args
+	mmFindTuples.FindTuplesMock.mutex.Lock()
+	mmFindTuples.FindTuplesMock.callArgs = append(mmFindTuples.FindTuplesMock.callArgs, &mm_params)
+	mmFindTuples.FindTuplesMock.mutex.Unlock()
+
+	for _, e := range mmFindTuples.FindTuplesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ra1, e.results.err
+		}
+	}
+
+	if mmFindTuples.FindTuplesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindTuples.FindTuplesMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindTuples.FindTuplesMock.defaultExpectation.params
+		mm_want_ptrs := mmFindTuples.FindTuplesMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationRepositoryMockFindTuplesParams{ctx, filter, revision}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindTuples.t.Errorf("RelationRepositoryMock.FindTuples got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmFindTuples.t.Errorf("RelationRepositoryMock.FindTuples got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+			if mm_want_ptrs.revision != nil && !minimock.Equal(*mm_want_ptrs.revision, mm_got.revision) {
+				mmFindTuples.t.Errorf("RelationRepositoryMock.FindTuples got unexpected parameter revision, want: %#v, got: %#v%s\n", *mm_want_ptrs.revision, mm_got.revision, minimock.Diff(*mm_want_ptrs.revision, mm_got.revision))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindTuples.t.Errorf("RelationRepositoryMock.FindTuples got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindTuples.FindTuplesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindTuples.t.Fatal("No results are set for the RelationRepositoryMock.FindTuples")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmFindTuples.funcFindTuples != nil {
+		return mmFindTuples.funcFindTuples(ctx, filter, revision)
+	}
+	mmFindTuples.t.Fatalf("Unexpected call to RelationRepositoryMock.FindTuples. %v %v %v", ctx, filter, revision)
+	return
+}
+
+// FindTuplesAfterCounter returns a count of finished RelationRepositoryMock.FindTuples invocations
+func (mmFindTuples *RelationRepositoryMock) FindTuplesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindTuples.afterFindTuplesCounter)
+}
+
+// FindTuplesBeforeCounter returns a count of RelationRepositoryMock.FindTuples invocations
+func (mmFindTuples *RelationRepositoryMock) FindTuplesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindTuples.beforeFindTuplesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationRepositoryMock.FindTuples.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindTuples *mRelationRepositoryMockFindTuples) Calls() []*RelationRepositoryMockFindTuplesParams {
+	mmFindTuples.mutex.RLock()
+
+	argCopy := make([]*RelationRepositoryMockFindTuplesParams, len(mmFindTuples.callArgs))
+	copy(argCopy, mmFindTuples.callArgs)
+
+	mmFindTuples.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindTuplesDone returns true if the count of the FindTuples invocations corresponds
+// the number of defined expectations
+func (m *RelationRepositoryMock) MinimockFindTuplesDone() bool {
+	for _, e := range m.FindTuplesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindTuplesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterFindTuplesCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindTuples != nil && mm_atomic.LoadUint64(&m.afterFindTuplesCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockFindTuplesInspect logs each unmet expectation
+func (m *RelationRepositoryMock) MinimockFindTuplesInspect() {
+	for _, e := range m.FindTuplesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationRepositoryMock.FindTuples with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindTuplesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterFindTuplesCounter) < 1 {
+		if m.FindTuplesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RelationRepositoryMock.FindTuples")
+		} else {
+			m.t.Errorf("Expected call to RelationRepositoryMock.FindTuples with params: %#v", *m.FindTuplesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindTuples != nil && mm_atomic.LoadUint64(&m.afterFindTuplesCounter) < 1 {
+		m.t.Error("Expected call to RelationRepositoryMock.FindTuples")
+	}
+}
+
+type mRelationRepositoryMockGetRevision struct {
+	mock               *RelationRepositoryMock
+	defaultExpectation *RelationRepositoryMockGetRevisionExpectation
+	expectations       []*RelationRepositoryMockGetRevisionExpectation
+
+	callArgs []*RelationRepositoryMockGetRevisionParams
+	mutex    sync.RWMutex
+}
+
+// RelationRepositoryMockGetRevisionExpectation specifies expectation struct of the RelationRepository.GetRevision
+type RelationRepositoryMockGetRevisionExpectation struct {
+	mock      *RelationRepositoryMock
+	params    *RelationRepositoryMockGetRevisionParams
+	paramPtrs *RelationRepositoryMockGetRevisionParamPtrs
+	results   *RelationRepositoryMockGetRevisionResults
+	Counter   uint64
+}
+
+// RelationRepositoryMockGetRevisionParams contains parameters of the RelationRepository.GetRevision
+type RelationRepositoryMockGetRevisionParams struct {
+	ctx context.Context
+}
+
+// RelationRepositoryMockGetRevisionParamPtrs contains pointers to parameters of the RelationRepository.GetRevision
+type RelationRepositoryMockGetRevisionParamPtrs struct {
+	ctx *context.Context
+}
+
+// RelationRepositoryMockGetRevisionResults contains results of the RelationRepository.GetRevision
+type RelationRepositoryMockGetRevisionResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for RelationRepository.GetRevision
+func (mmGetRevision *mRelationRepositoryMockGetRevision) Expect(ctx context.Context) *mRelationRepositoryMockGetRevision {
+	if mmGetRevision.mock.funcGetRevision != nil {
+		mmGetRevision.mock.t.Fatalf("RelationRepositoryMock.GetRevision mock is already set by Set")
+	}
+
+	if mmGetRevision.defaultExpectation == nil {
+		mmGetRevision.defaultExpectation = &RelationRepositoryMockGetRevisionExpectation{}
+	}
+
+	if mmGetRevision.defaultExpectation.paramPtrs != nil {
+		mmGetRevision.mock.t.Fatalf("RelationRepositoryMock.GetRevision mock is already set by ExpectParams functions")
+	}
+
+	mmGetRevision.defaultExpectation.params = &RelationRepositoryMockGetRevisionParams{ctx}
+	for _, e := range mmGetRevision.expectations {
+		if minimock.Equal(e.params, mmGetRevision.defaultExpectation.params) {
+			mmGetRevision.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetRevision.defaultExpectation.params)
+		}
+	}
+
+	return mmGetRevision
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationRepository.GetRevision
+func (mmGetRevision *mRelationRepositoryMockGetRevision) ExpectCtxParam1(ctx context.Context) *mRelationRepositoryMockGetRevision {
+	if mmGetRevision.mock.funcGetRevision != nil {
+		mmGetRevision.mock.t.Fatalf("RelationRepositoryMock.GetRevision mock is already set by Set")
+	}
+
+	if mmGetRevision.defaultExpectation == nil {
+		mmGetRevision.defaultExpectation = &RelationRepositoryMockGetRevisionExpectation{}
+	}
+
+	if mmGetRevision.defaultExpectation.params != nil {
+		mmGetRevision.mock.t.Fatalf("RelationRepositoryMock.GetRevision mock is already set by Expect")
+	}
+
+	if mmGetRevision.defaultExpectation.paramPtrs == nil {
+		mmGetRevision.defaultExpectation.paramPtrs = &RelationRepositoryMockGetRevisionParamPtrs{}
+	}
+	mmGetRevision.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetRevision
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationRepository.GetRevision
+func (mmGetRevision *mRelationRepositoryMockGetRevision) Inspect(f func(ctx context.Context)) *mRelationRepositoryMockGetRevision {
+	if mmGetRevision.mock.inspectFuncGetRevision != nil {
+		mmGetRevision.mock.t.Fatalf("Inspect function is already set for RelationRepositoryMock.GetRevision")
+	}
+
+	mmGetRevision.mock.inspectFuncGetRevision = f
+
+	return mmGetRevision
+}
+
+// Return sets up results that will be returned by RelationRepository.GetRevision
+func (mmGetRevision *mRelationRepositoryMockGetRevision) Return(i1 int64, err error) *RelationRepositoryMock {
+	if mmGetRevision.mock.funcGetRevision != nil {
+		mmGetRevision.mock.t.Fatalf("RelationRepositoryMock.GetRevision mock is already set by Set")
+	}
+
+	if mmGetRevision.defaultExpectation == nil {
+		mmGetRevision.defaultExpectation = &RelationRepositoryMockGetRevisionExpectation{mock: mmGetRevision.mock}
+	}
+	mmGetRevision.defaultExpectation.results = &RelationRepositoryMockGetRevisionResults{i1, err}
+	return mmGetRevision.mock
+}
+
+// Set uses given function f to mock the RelationRepository.GetRevision method
+func (mmGetRevision *mRelationRepositoryMockGetRevision) Set(f func(ctx context.Context) (i1 int64, err error)) *RelationRepositoryMock {
+	if mmGetRevision.defaultExpectation != nil {
+		mmGetRevision.mock.t.Fatalf("Default expectation is already set for the RelationRepository.GetRevision method")
+	}
+
+	if len(mmGetRevision.expectations) > 0 {
+		mmGetRevision.mock.t.Fatalf("Some expectations are already set for the RelationRepository.GetRevision method")
+	}
+
+	mmGetRevision.mock.funcGetRevision = f
+	return mmGetRevision.mock
+}
+
+// When sets expectation for the RelationRepository.GetRevision which will trigger the result defined by the following
+// Then helper
+func (mmGetRevision *mRelationRepositoryMockGetRevision) When(ctx context.Context) *RelationRepositoryMockGetRevisionExpectation {
+	if mmGetRevision.mock.funcGetRevision != nil {
+		mmGetRevision.mock.t.Fatalf("RelationRepositoryMock.GetRevision mock is already set by Set")
+	}
+
+	expectation := &RelationRepositoryMockGetRevisionExpectation{
+		mock:   mmGetRevision.mock,
+		params: &RelationRepositoryMockGetRevisionParams{ctx},
+	}
+	mmGetRevision.expectations = append(mmGetRevision.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationRepository.GetRevision return parameters for the expectation previously defined by the When method
+func (e *RelationRepositoryMockGetRevisionExpectation) Then(i1 int64, err error) *RelationRepositoryMock {
+	e.results = &RelationRepositoryMockGetRevisionResults{i1, err}
+	return e.mock
+}
+
+// GetRevision implements repository.RelationRepository
+func (mmGetRevision *RelationRepositoryMock) GetRevision(ctx context.Context) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmGetRevision.beforeGetRevisionCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetRevision.afterGetRevisionCounter, 1)
+
+	if mmGetRevision.inspectFuncGetRevision != nil {
+		mmGetRevision.inspectFuncGetRevision(ctx)
+	}
+
+	mm_params := RelationRepositoryMockGetRevisionParams{ctx}
+
+	// Record call args
+	mmGetRevision.GetRevisionMock.mutex.Lock()
+	mmGetRevision.GetRevisionMock.callArgs = append(mmGetRevision.GetRevisionMock.callArgs, &mm_params)
+	mmGetRevision.GetRevisionMock.mutex.Unlock()
+
+	for _, e := range mmGetRevision.GetRevisionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmGetRevision.GetRevisionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetRevision.GetRevisionMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetRevision.GetRevisionMock.defaultExpectation.params
+		mm_want_ptrs := mmGetRevision.GetRevisionMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationRepositoryMockGetRevisionParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetRevision.t.Errorf("RelationRepositoryMock.GetRevision got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetRevision.t.Errorf("RelationRepositoryMock.GetRevision got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetRevision.GetRevisionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetRevision.t.Fatal("No results are set for the RelationRepositoryMock.GetRevision")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmGetRevision.funcGetRevision != nil {
+		return mmGetRevision.funcGetRevision(ctx)
+	}
+	mmGetRevision.t.Fatalf("Unexpected call to RelationRepositoryMock.GetRevision. %v", ctx)
+	return
+}
+
+// GetRevisionAfterCounter returns a count of finished RelationRepositoryMock.GetRevision invocations
+func (mmGetRevision *RelationRepositoryMock) GetRevisionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRevision.afterGetRevisionCounter)
+}
+
+// GetRevisionBeforeCounter returns a count of RelationRepositoryMock.GetRevision invocations
+func (mmGetRevision *RelationRepositoryMock) GetRevisionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRevision.beforeGetRevisionCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationRepositoryMock.GetRevision.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetRevision *mRelationRepositoryMockGetRevision) Calls() []*RelationRepositoryMockGetRevisionParams {
+	mmGetRevision.mutex.RLock()
+
+	argCopy := make([]*RelationRepositoryMockGetRevisionParams, len(mmGetRevision.callArgs))
+	copy(argCopy, mmGetRevision.callArgs)
+
+	mmGetRevision.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetRevisionDone returns true if the count of the GetRevision invocations corresponds
+// the number of defined expectations
+func (m *RelationRepositoryMock) MinimockGetRevisionDone() bool {
+	for _, e := range m.GetRevisionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRevisionMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetRevisionCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetRevision != nil && mm_atomic.LoadUint64(&m.afterGetRevisionCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetRevisionInspect logs each unmet expectation
+func (m *RelationRepositoryMock) MinimockGetRevisionInspect() {
+	for _, e := range m.GetRevisionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationRepositoryMock.GetRevision with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRevisionMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetRevisionCounter) < 1 {
+		if m.GetRevisionMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RelationRepositoryMock.GetRevision")
+		} else {
+			m.t.Errorf("Expected call to RelationRepositoryMock.GetRevision with params: %#v", *m.GetRevisionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetRevision != nil && mm_atomic.LoadUint64(&m.afterGetRevisionCounter) < 1 {
+		m.t.Error("Expected call to RelationRepositoryMock.GetRevision")
+	}
+}
+
+type mRelationRepositoryMockNextRevision struct {
+	mock               *RelationRepositoryMock
+	defaultExpectation *RelationRepositoryMockNextRevisionExpectation
+	expectations       []*RelationRepositoryMockNextRevisionExpectation
+
+	callArgs []*RelationRepositoryMockNextRevisionParams
+	mutex    sync.RWMutex
+}
+
+// RelationRepositoryMockNextRevisionExpectation specifies expectation struct of the RelationRepository.NextRevision
+type RelationRepositoryMockNextRevisionExpectation struct {
+	mock      *RelationRepositoryMock
+	params    *RelationRepositoryMockNextRevisionParams
+	paramPtrs *RelationRepositoryMockNextRevisionParamPtrs
+	results   *RelationRepositoryMockNextRevisionResults
+	Counter   uint64
+}
+
+// RelationRepositoryMockNextRevisionParams contains parameters of the RelationRepository.NextRevision
+type RelationRepositoryMockNextRevisionParams struct {
+	ctx context.Context
+}
+
+// RelationRepositoryMockNextRevisionParamPtrs contains pointers to parameters of the RelationRepository.NextRevision
+type RelationRepositoryMockNextRevisionParamPtrs struct {
+	ctx *context.Context
+}
+
+// RelationRepositoryMockNextRevisionResults contains results of the RelationRepository.NextRevision
+type RelationRepositoryMockNextRevisionResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for RelationRepository.NextRevision
+func (mmNextRevision *mRelationRepositoryMockNextRevision) Expect(ctx context.Context) *mRelationRepositoryMockNextRevision {
+	if mmNextRevision.mock.funcNextRevision != nil {
+		mmNextRevision.mock.t.Fatalf("RelationRepositoryMock.NextRevision mock is already set by Set")
+	}
+
+	if mmNextRevision.defaultExpectation == nil {
+		mmNextRevision.defaultExpectation = &RelationRepositoryMockNextRevisionExpectation{}
+	}
+
+	if mmNextRevision.defaultExpectation.paramPtrs != nil {
+		mmNextRevision.mock.t.Fatalf("RelationRepositoryMock.NextRevision mock is already set by ExpectParams functions")
+	}
+
+	mmNextRevision.defaultExpectation.params = &RelationRepositoryMockNextRevisionParams{ctx}
+	for _, e := range mmNextRevision.expectations {
+		if minimock.Equal(e.params, mmNextRevision.defaultExpectation.params) {
+			mmNextRevision.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmNextRevision.defaultExpectation.params)
+		}
+	}
+
+	return mmNextRevision
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationRepository.NextRevision
+func (mmNextRevision *mRelationRepositoryMockNextRevision) ExpectCtxParam1(ctx context.Context) *mRelationRepositoryMockNextRevision {
+	if mmNextRevision.mock.funcNextRevision != nil {
+		mmNextRevision.mock.t.Fatalf("RelationRepositoryMock.NextRevision mock is already set by Set")
+	}
+
+	if mmNextRevision.defaultExpectation == nil {
+		mmNextRevision.defaultExpectation = &RelationRepositoryMockNextRevisionExpectation{}
+	}
+
+	if mmNextRevision.defaultExpectation.params != nil {
+		mmNextRevision.mock.t.Fatalf("RelationRepositoryMock.NextRevision mock is already set by Expect")
+	}
+
+	if mmNextRevision.defaultExpectation.paramPtrs == nil {
+		mmNextRevision.defaultExpectation.paramPtrs = &RelationRepositoryMockNextRevisionParamPtrs{}
+	}
+	mmNextRevision.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmNextRevision
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationRepository.NextRevision
+func (mmNextRevision *mRelationRepositoryMockNextRevision) Inspect(f func(ctx context.Context)) *mRelationRepositoryMockNextRevision {
+	if mmNextRevision.mock.inspectFuncNextRevision != nil {
+		mmNextRevision.mock.t.Fatalf("Inspect function is already set for RelationRepositoryMock.NextRevision")
+	}
+
+	mmNextRevision.mock.inspectFuncNextRevision = f
+
+	return mmNextRevision
+}
+
+// Return sets up results that will be returned by RelationRepository.NextRevision
+func (mmNextRevision *mRelationRepositoryMockNextRevision) Return(i1 int64, err error) *RelationRepositoryMock {
+	if mmNextRevision.mock.funcNextRevision != nil {
+		mmNextRevision.mock.t.Fatalf("RelationRepositoryMock.NextRevision mock is already set by Set")
+	}
+
+	if mmNextRevision.defaultExpectation == nil {
+		mmNextRevision.defaultExpectation = &RelationRepositoryMockNextRevisionExpectation{mock: mmNextRevision.mock}
+	}
+	mmNextRevision.defaultExpectation.results = &RelationRepositoryMockNextRevisionResults{i1, err}
+	return mmNextRevision.mock
+}
+
+// Set uses given function f to mock the RelationRepository.NextRevision method
+func (mmNextRevision *mRelationRepositoryMockNextRevision) Set(f func(ctx context.Context) (i1 int64, err error)) *RelationRepositoryMock {
+	if mmNextRevision.defaultExpectation != nil {
+		mmNextRevision.mock.t.Fatalf("Default expectation is already set for the RelationRepository.NextRevision method")
+	}
+
+	if len(mmNextRevision.expectations) > 0 {
+		mmNextRevision.mock.t.Fatalf("Some expectations are already set for the RelationRepository.NextRevision method")
+	}
+
+	mmNextRevision.mock.funcNextRevision = f
+	return mmNextRevision.mock
+}
+
+// When sets expectation for the RelationRepository.NextRevision which will trigger the result defined by the following
+// Then helper
+func (mmNextRevision *mRelationRepositoryMockNextRevision) When(ctx context.Context) *RelationRepositoryMockNextRevisionExpectation {
+	if mmNextRevision.mock.funcNextRevision != nil {
+		mmNextRevision.mock.t.Fatalf("RelationRepositoryMock.NextRevision mock is already set by Set")
+	}
+
+	expectation := &RelationRepositoryMockNextRevisionExpectation{
+		mock:   mmNextRevision.mock,
+		params: &RelationRepositoryMockNextRevisionParams{ctx},
+	}
+	mmNextRevision.expectations = append(mmNextRevision.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationRepository.NextRevision return parameters for the expectation previously defined by the When method
+func (e *RelationRepositoryMockNextRevisionExpectation) Then(i1 int64, err error) *RelationRepositoryMock {
+	e.results = &RelationRepositoryMockNextRevisionResults{i1, err}
+	return e.mock
+}
+
+// NextRevision implements repository.RelationRepository
+func (mmNextRevision *RelationRepositoryMock) NextRevision(ctx context.Context) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmNextRevision.beforeNextRevisionCounter, 1)
+	defer mm_atomic.AddUint64(&mmNextRevision.afterNextRevisionCounter, 1)
+
+	if mmNextRevision.inspectFuncNextRevision != nil {
+		mmNextRevision.inspectFuncNextRevision(ctx)
+	}
+
+	mm_params := RelationRepositoryMockNextRevisionParams{ctx}
+
+	// Record call args
+	mmNextRevision.NextRevisionMock.mutex.Lock()
+	mmNextRevision.NextRevisionMock.callArgs = append(mmNextRevision.NextRevisionMock.callArgs, &mm_params)
+	mmNextRevision.NextRevisionMock.mutex.Unlock()
+
+	for _, e := range mmNextRevision.NextRevisionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmNextRevision.NextRevisionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmNextRevision.NextRevisionMock.defaultExpectation.Counter, 1)
+		mm_want := mmNextRevision.NextRevisionMock.defaultExpectation.params
+		mm_want_ptrs := mmNextRevision.NextRevisionMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationRepositoryMockNextRevisionParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmNextRevision.t.Errorf("RelationRepositoryMock.NextRevision got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmNextRevision.t.Errorf("RelationRepositoryMock.NextRevision got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmNextRevision.NextRevisionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmNextRevision.t.Fatal("No results are set for the RelationRepositoryMock.NextRevision")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmNextRevision.funcNextRevision != nil {
+		return mmNextRevision.funcNextRevision(ctx)
+	}
+	mmNextRevision.t.Fatalf("Unexpected call to RelationRepositoryMock.NextRevision. %v", ctx)
+	return
+}
+
+// NextRevisionAfterCounter returns a count of finished RelationRepositoryMock.NextRevision invocations
+func (mmNextRevision *RelationRepositoryMock) NextRevisionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmNextRevision.afterNextRevisionCounter)
+}
+
+// NextRevisionBeforeCounter returns a count of RelationRepositoryMock.NextRevision invocations
+func (mmNextRevision *RelationRepositoryMock) NextRevisionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmNextRevision.beforeNextRevisionCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationRepositoryMock.NextRevision.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmNextRevision *mRelationRepositoryMockNextRevision) Calls() []*RelationRepositoryMockNextRevisionParams {
+	mmNextRevision.mutex.RLock()
+
+	argCopy := make([]*RelationRepositoryMockNextRevisionParams, len(mmNextRevision.callArgs))
+	copy(argCopy, mmNextRevision.callArgs)
+
+	mmNextRevision.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockNextRevisionDone returns true if the count of the NextRevision invocations corresponds
+// the number of defined expectations
+func (m *RelationRepositoryMock) MinimockNextRevisionDone() bool {
+	for _, e := range m.NextRevisionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.NextRevisionMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterNextRevisionCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcNextRevision != nil && mm_atomic.LoadUint64(&m.afterNextRevisionCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockNextRevisionInspect logs each unmet expectation
+func (m *RelationRepositoryMock) MinimockNextRevisionInspect() {
+	for _, e := range m.NextRevisionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationRepositoryMock.NextRevision with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.NextRevisionMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterNextRevisionCounter) < 1 {
+		if m.NextRevisionMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RelationRepositoryMock.NextRevision")
+		} else {
+			m.t.Errorf("Expected call to RelationRepositoryMock.NextRevision with params: %#v", *m.NextRevisionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcNextRevision != nil && mm_atomic.LoadUint64(&m.afterNextRevisionCounter) < 1 {
+		m.t.Error("Expected call to RelationRepositoryMock.NextRevision")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RelationRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateTuplesInspect()
+
+			m.MinimockDeleteTuplesInspect()
+
+			m.MinimockFindTuplesInspect()
+
+			m.MinimockGetRevisionInspect()
+
+			m.MinimockNextRevisionInspect()
+			m.t.FailNow()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RelationRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RelationRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateTuplesDone() &&
+		m.MinimockDeleteTuplesDone() &&
+		m.MinimockFindTuplesDone() &&
+		m.MinimockGetRevisionDone() &&
+		m.MinimockNextRevisionDone()
+}
@@ -0,0 +1,2436 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.8). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/arifullov/auth/internal/repository.RoleRepository -o role_repository_minimock.go -n RoleRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RoleRepositoryMock implements repository.RoleRepository
+type RoleRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, role *model.RoleDefinition) (err error)
+	inspectFuncCreate   func(ctx context.Context, role *model.RoleDefinition)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mRoleRepositoryMockCreate
+
+	funcDelete          func(ctx context.Context, name model.Role) (err error)
+	inspectFuncDelete   func(ctx context.Context, name model.Role)
+	afterDeleteCounter  uint64
+	beforeDeleteCounter uint64
+	DeleteMock          mRoleRepositoryMockDelete
+
+	funcGetExisting          func(ctx context.Context, roles []model.Role) (ra1 []model.Role, err error)
+	inspectFuncGetExisting   func(ctx context.Context, roles []model.Role)
+	afterGetExistingCounter  uint64
+	beforeGetExistingCounter uint64
+	GetExistingMock          mRoleRepositoryMockGetExisting
+
+	funcGetUserAccess          func(ctx context.Context, userID int64, tenant string) (up1 *model.UserAccess, err error)
+	inspectFuncGetUserAccess   func(ctx context.Context, userID int64, tenant string)
+	afterGetUserAccessCounter  uint64
+	beforeGetUserAccessCounter uint64
+	GetUserAccessMock          mRoleRepositoryMockGetUserAccess
+
+	funcListDefinitions          func(ctx context.Context) (ra1 []model.RoleDefinition, err error)
+	inspectFuncListDefinitions   func(ctx context.Context)
+	afterListDefinitionsCounter  uint64
+	beforeListDefinitionsCounter uint64
+	ListDefinitionsMock          mRoleRepositoryMockListDefinitions
+
+	funcListEffectiveRoles          func(ctx context.Context) (m1 map[model.Role][]model.Role, err error)
+	inspectFuncListEffectiveRoles   func(ctx context.Context)
+	afterListEffectiveRolesCounter  uint64
+	beforeListEffectiveRolesCounter uint64
+	ListEffectiveRolesMock          mRoleRepositoryMockListEffectiveRoles
+
+	funcListUserRoles          func(ctx context.Context, afterID int64, limit uint64) (ua1 []model.UserRoles, err error)
+	inspectFuncListUserRoles   func(ctx context.Context, afterID int64, limit uint64)
+	afterListUserRolesCounter  uint64
+	beforeListUserRolesCounter uint64
+	ListUserRolesMock          mRoleRepositoryMockListUserRoles
+
+	funcUpdate          func(ctx context.Context, role *model.RoleDefinition) (err error)
+	inspectFuncUpdate   func(ctx context.Context, role *model.RoleDefinition)
+	afterUpdateCounter  uint64
+	beforeUpdateCounter uint64
+	UpdateMock          mRoleRepositoryMockUpdate
+}
+
+// NewRoleRepositoryMock returns a mock for repository.RoleRepository
+func NewRoleRepositoryMock(t minimock.Tester) *RoleRepositoryMock {
+	m := &RoleRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mRoleRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*RoleRepositoryMockCreateParams{}
+
+	m.DeleteMock = mRoleRepositoryMockDelete{mock: m}
+	m.DeleteMock.callArgs = []*RoleRepositoryMockDeleteParams{}
+
+	m.GetExistingMock = mRoleRepositoryMockGetExisting{mock: m}
+	m.GetExistingMock.callArgs = []*RoleRepositoryMockGetExistingParams{}
+
+	m.GetUserAccessMock = mRoleRepositoryMockGetUserAccess{mock: m}
+	m.GetUserAccessMock.callArgs = []*RoleRepositoryMockGetUserAccessParams{}
+
+	m.ListDefinitionsMock = mRoleRepositoryMockListDefinitions{mock: m}
+	m.ListDefinitionsMock.callArgs = []*RoleRepositoryMockListDefinitionsParams{}
+
+	m.ListEffectiveRolesMock = mRoleRepositoryMockListEffectiveRoles{mock: m}
+	m.ListEffectiveRolesMock.callArgs = []*RoleRepositoryMockListEffectiveRolesParams{}
+
+	m.ListUserRolesMock = mRoleRepositoryMockListUserRoles{mock: m}
+	m.ListUserRolesMock.callArgs = []*RoleRepositoryMockListUserRolesParams{}
+
+	m.UpdateMock = mRoleRepositoryMockUpdate{mock: m}
+	m.UpdateMock.callArgs = []*RoleRepositoryMockUpdateParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRoleRepositoryMockCreate struct {
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockCreateExpectation
+	expectations       []*RoleRepositoryMockCreateExpectation
+
+	callArgs []*RoleRepositoryMockCreateParams
+	mutex    sync.RWMutex
+}
+
+// RoleRepositoryMockCreateExpectation specifies expectation struct of the RoleRepository.Create
+type RoleRepositoryMockCreateExpectation struct {
+	mock      *RoleRepositoryMock
+	params    *RoleRepositoryMockCreateParams
+	paramPtrs *RoleRepositoryMockCreateParamPtrs
+	results   *RoleRepositoryMockCreateResults
+	Counter   uint64
+}
+
+// RoleRepositoryMockCreateParams contains parameters of the RoleRepository.Create
+type RoleRepositoryMockCreateParams struct {
+	ctx  context.Context
+	role *model.RoleDefinition
+}
+
+// RoleRepositoryMockCreateParamPtrs contains pointers to parameters of the RoleRepository.Create
+type RoleRepositoryMockCreateParamPtrs struct {
+	ctx  *context.Context
+	role **model.RoleDefinition
+}
+
+// RoleRepositoryMockCreateResults contains results of the RoleRepository.Create
+type RoleRepositoryMockCreateResults struct {
+	err error
+}
+
+// Expect sets up expected params for RoleRepository.Create
+func (mmCreate *mRoleRepositoryMockCreate) Expect(ctx context.Context, role *model.RoleDefinition) *mRoleRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RoleRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RoleRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("RoleRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &RoleRepositoryMockCreateParams{ctx, role}
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.Create
+func (mmCreate *mRoleRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RoleRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RoleRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("RoleRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &RoleRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreate
+}
+
+// ExpectRoleParam2 sets up expected param role for RoleRepository.Create
+func (mmCreate *mRoleRepositoryMockCreate) ExpectRoleParam2(role *model.RoleDefinition) *mRoleRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RoleRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RoleRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("RoleRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &RoleRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.role = &role
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.Create
+func (mmCreate *mRoleRepositoryMockCreate) Inspect(f func(ctx context.Context, role *model.RoleDefinition)) *mRoleRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by RoleRepository.Create
+func (mmCreate *mRoleRepositoryMockCreate) Return(err error) *RoleRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RoleRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RoleRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &RoleRepositoryMockCreateResults{err}
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the RoleRepository.Create method
+func (mmCreate *mRoleRepositoryMockCreate) Set(f func(ctx context.Context, role *model.RoleDefinition) (err error)) *RoleRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the RoleRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the RoleRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	return mmCreate.mock
+}
+
+// When sets expectation for the RoleRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mRoleRepositoryMockCreate) When(ctx context.Context, role *model.RoleDefinition) *RoleRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RoleRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockCreateExpectation{
+		mock:   mmCreate.mock,
+		params: &RoleRepositoryMockCreateParams{ctx, role},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.Create return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockCreateExpectation) Then(err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockCreateResults{err}
+	return e.mock
+}
+
+// Create implements repository.RoleRepository
+func (mmCreate *RoleRepositoryMock) Create(ctx context.Context, role *model.RoleDefinition) (err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, role)
+	}
+
+	mm_params := RoleRepositoryMockCreateParams{ctx, role}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockCreateParams{ctx, role}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("RoleRepositoryMock.Create got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmCreate.t.Errorf("RoleRepositoryMock.Create got unexpected parameter role, want: %#v, got: %#v%s\n", *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("RoleRepositoryMock.Create got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the RoleRepositoryMock.Create")
+		}
+		return (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, role)
+	}
+	mmCreate.t.Fatalf("Unexpected call to RoleRepositoryMock.Create. %v %v", ctx, role)
+	return
+}
+
+// CreateAfterCounter returns a count of finished RoleRepositoryMock.Create invocations
+func (mmCreate *RoleRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of RoleRepositoryMock.Create invocations
+func (mmCreate *RoleRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mRoleRepositoryMockCreate) Calls() []*RoleRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockCreateDone() bool {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.Create with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RoleRepositoryMock.Create")
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.Create with params: %#v", *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		m.t.Error("Expected call to RoleRepositoryMock.Create")
+	}
+}
+
+type mRoleRepositoryMockDelete struct {
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockDeleteExpectation
+	expectations       []*RoleRepositoryMockDeleteExpectation
+
+	callArgs []*RoleRepositoryMockDeleteParams
+	mutex    sync.RWMutex
+}
+
+// RoleRepositoryMockDeleteExpectation specifies expectation struct of the RoleRepository.Delete
+type RoleRepositoryMockDeleteExpectation struct {
+	mock      *RoleRepositoryMock
+	params    *RoleRepositoryMockDeleteParams
+	paramPtrs *RoleRepositoryMockDeleteParamPtrs
+	results   *RoleRepositoryMockDeleteResults
+	Counter   uint64
+}
+
+// RoleRepositoryMockDeleteParams contains parameters of the RoleRepository.Delete
+type RoleRepositoryMockDeleteParams struct {
+	ctx  context.Context
+	name model.Role
+}
+
+// RoleRepositoryMockDeleteParamPtrs contains pointers to parameters of the RoleRepository.Delete
+type RoleRepositoryMockDeleteParamPtrs struct {
+	ctx  *context.Context
+	name *model.Role
+}
+
+// RoleRepositoryMockDeleteResults contains results of the RoleRepository.Delete
+type RoleRepositoryMockDeleteResults struct {
+	err error
+}
+
+// Expect sets up expected params for RoleRepository.Delete
+func (mmDelete *mRoleRepositoryMockDelete) Expect(ctx context.Context, name model.Role) *mRoleRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RoleRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &RoleRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("RoleRepositoryMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &RoleRepositoryMockDeleteParams{ctx, name}
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.Delete
+func (mmDelete *mRoleRepositoryMockDelete) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RoleRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &RoleRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("RoleRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &RoleRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDelete
+}
+
+// ExpectNameParam2 sets up expected param name for RoleRepository.Delete
+func (mmDelete *mRoleRepositoryMockDelete) ExpectNameParam2(name model.Role) *mRoleRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RoleRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &RoleRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("RoleRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &RoleRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.name = &name
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.Delete
+func (mmDelete *mRoleRepositoryMockDelete) Inspect(f func(ctx context.Context, name model.Role)) *mRoleRepositoryMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by RoleRepository.Delete
+func (mmDelete *mRoleRepositoryMockDelete) Return(err error) *RoleRepositoryMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RoleRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &RoleRepositoryMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &RoleRepositoryMockDeleteResults{err}
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the RoleRepository.Delete method
+func (mmDelete *mRoleRepositoryMockDelete) Set(f func(ctx context.Context, name model.Role) (err error)) *RoleRepositoryMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the RoleRepository.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the RoleRepository.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	return mmDelete.mock
+}
+
+// When sets expectation for the RoleRepository.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mRoleRepositoryMockDelete) When(ctx context.Context, name model.Role) *RoleRepositoryMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RoleRepositoryMock.Delete mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockDeleteExpectation{
+		mock:   mmDelete.mock,
+		params: &RoleRepositoryMockDeleteParams{ctx, name},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.Delete return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockDeleteExpectation) Then(err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockDeleteResults{err}
+	return e.mock
+}
+
+// Delete implements repository.RoleRepository
+func (mmDelete *RoleRepositoryMock) Delete(ctx context.Context, name model.Role) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, name)
+	}
+
+	mm_params := RoleRepositoryMockDeleteParams{ctx, name}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockDeleteParams{ctx, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("RoleRepositoryMock.Delete got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmDelete.t.Errorf("RoleRepositoryMock.Delete got unexpected parameter name, want: %#v, got: %#v%s\n", *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("RoleRepositoryMock.Delete got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the RoleRepositoryMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, name)
+	}
+	mmDelete.t.Fatalf("Unexpected call to RoleRepositoryMock.Delete. %v %v", ctx, name)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished RoleRepositoryMock.Delete invocations
+func (mmDelete *RoleRepositoryMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of RoleRepositoryMock.Delete invocations
+func (mmDelete *RoleRepositoryMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mRoleRepositoryMockDelete) Calls() []*RoleRepositoryMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockDeleteDone() bool {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && mm_atomic.LoadUint64(&m.afterDeleteCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.Delete with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteCounter) < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RoleRepositoryMock.Delete")
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.Delete with params: %#v", *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && mm_atomic.LoadUint64(&m.afterDeleteCounter) < 1 {
+		m.t.Error("Expected call to RoleRepositoryMock.Delete")
+	}
+}
+
+type mRoleRepositoryMockGetExisting struct {
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockGetExistingExpectation
+	expectations       []*RoleRepositoryMockGetExistingExpectation
+
+	callArgs []*RoleRepositoryMockGetExistingParams
+	mutex    sync.RWMutex
+}
+
+// RoleRepositoryMockGetExistingExpectation specifies expectation struct of the RoleRepository.GetExisting
+type RoleRepositoryMockGetExistingExpectation struct {
+	mock      *RoleRepositoryMock
+	params    *RoleRepositoryMockGetExistingParams
+	paramPtrs *RoleRepositoryMockGetExistingParamPtrs
+	results   *RoleRepositoryMockGetExistingResults
+	Counter   uint64
+}
+
+// RoleRepositoryMockGetExistingParams contains parameters of the RoleRepository.GetExisting
+type RoleRepositoryMockGetExistingParams struct {
+	ctx   context.Context
+	roles []model.Role
+}
+
+// RoleRepositoryMockGetExistingParamPtrs contains pointers to parameters of the RoleRepository.GetExisting
+type RoleRepositoryMockGetExistingParamPtrs struct {
+	ctx   *context.Context
+	roles *[]model.Role
+}
+
+// RoleRepositoryMockGetExistingResults contains results of the RoleRepository.GetExisting
+type RoleRepositoryMockGetExistingResults struct {
+	ra1 []model.Role
+	err error
+}
+
+// Expect sets up expected params for RoleRepository.GetExisting
+func (mmGetExisting *mRoleRepositoryMockGetExisting) Expect(ctx context.Context, roles []model.Role) *mRoleRepositoryMockGetExisting {
+	if mmGetExisting.mock.funcGetExisting != nil {
+		mmGetExisting.mock.t.Fatalf("RoleRepositoryMock.GetExisting mock is already set by Set")
+	}
+
+	if mmGetExisting.defaultExpectation == nil {
+		mmGetExisting.defaultExpectation = &RoleRepositoryMockGetExistingExpectation{}
+	}
+
+	if mmGetExisting.defaultExpectation.paramPtrs != nil {
+		mmGetExisting.mock.t.Fatalf("RoleRepositoryMock.GetExisting mock is already set by ExpectParams functions")
+	}
+
+	mmGetExisting.defaultExpectation.params = &RoleRepositoryMockGetExistingParams{ctx, roles}
+	for _, e := range mmGetExisting.expectations {
+		if minimock.Equal(e.params, mmGetExisting.defaultExpectation.params) {
+			mmGetExisting.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetExisting.defaultExpectation.params)
+		}
+	}
+
+	return mmGetExisting
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.GetExisting
+func (mmGetExisting *mRoleRepositoryMockGetExisting) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockGetExisting {
+	if mmGetExisting.mock.funcGetExisting != nil {
+		mmGetExisting.mock.t.Fatalf("RoleRepositoryMock.GetExisting mock is already set by Set")
+	}
+
+	if mmGetExisting.defaultExpectation == nil {
+		mmGetExisting.defaultExpectation = &RoleRepositoryMockGetExistingExpectation{}
+	}
+
+	if mmGetExisting.defaultExpectation.params != nil {
+		mmGetExisting.mock.t.Fatalf("RoleRepositoryMock.GetExisting mock is already set by Expect")
+	}
+
+	if mmGetExisting.defaultExpectation.paramPtrs == nil {
+		mmGetExisting.defaultExpectation.paramPtrs = &RoleRepositoryMockGetExistingParamPtrs{}
+	}
+	mmGetExisting.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetExisting
+}
+
+// ExpectRolesParam2 sets up expected param roles for RoleRepository.GetExisting
+func (mmGetExisting *mRoleRepositoryMockGetExisting) ExpectRolesParam2(roles []model.Role) *mRoleRepositoryMockGetExisting {
+	if mmGetExisting.mock.funcGetExisting != nil {
+		mmGetExisting.mock.t.Fatalf("RoleRepositoryMock.GetExisting mock is already set by Set")
+	}
+
+	if mmGetExisting.defaultExpectation == nil {
+		mmGetExisting.defaultExpectation = &RoleRepositoryMockGetExistingExpectation{}
+	}
+
+	if mmGetExisting.defaultExpectation.params != nil {
+		mmGetExisting.mock.t.Fatalf("RoleRepositoryMock.GetExisting mock is already set by Expect")
+	}
+
+	if mmGetExisting.defaultExpectation.paramPtrs == nil {
+		mmGetExisting.defaultExpectation.paramPtrs = &RoleRepositoryMockGetExistingParamPtrs{}
+	}
+	mmGetExisting.defaultExpectation.paramPtrs.roles = &roles
+
+	return mmGetExisting
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.GetExisting
+func (mmGetExisting *mRoleRepositoryMockGetExisting) Inspect(f func(ctx context.Context, roles []model.Role)) *mRoleRepositoryMockGetExisting {
+	if mmGetExisting.mock.inspectFuncGetExisting != nil {
+		mmGetExisting.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.GetExisting")
+	}
+
+	mmGetExisting.mock.inspectFuncGetExisting = f
+
+	return mmGetExisting
+}
+
+// Return sets up results that will be returned by RoleRepository.GetExisting
+func (mmGetExisting *mRoleRepositoryMockGetExisting) Return(ra1 []model.Role, err error) *RoleRepositoryMock {
+	if mmGetExisting.mock.funcGetExisting != nil {
+		mmGetExisting.mock.t.Fatalf("RoleRepositoryMock.GetExisting mock is already set by Set")
+	}
+
+	if mmGetExisting.defaultExpectation == nil {
+		mmGetExisting.defaultExpectation = &RoleRepositoryMockGetExistingExpectation{mock: mmGetExisting.mock}
+	}
+	mmGetExisting.defaultExpectation.results = &RoleRepositoryMockGetExistingResults{ra1, err}
+	return mmGetExisting.mock
+}
+
+// Set uses given function f to mock the RoleRepository.GetExisting method
+func (mmGetExisting *mRoleRepositoryMockGetExisting) Set(f func(ctx context.Context, roles []model.Role) (ra1 []model.Role, err error)) *RoleRepositoryMock {
+	if mmGetExisting.defaultExpectation != nil {
+		mmGetExisting.mock.t.Fatalf("Default expectation is already set for the RoleRepository.GetExisting method")
+	}
+
+	if len(mmGetExisting.expectations) > 0 {
+		mmGetExisting.mock.t.Fatalf("Some expectations are already set for the RoleRepository.GetExisting method")
+	}
+
+	mmGetExisting.mock.funcGetExisting = f
+	return mmGetExisting.mock
+}
+
+// When sets expectation for the RoleRepository.GetExisting which will trigger the result defined by the following
+// Then helper
+func (mmGetExisting *mRoleRepositoryMockGetExisting) When(ctx context.Context, roles []model.Role) *RoleRepositoryMockGetExistingExpectation {
+	if mmGetExisting.mock.funcGetExisting != nil {
+		mmGetExisting.mock.t.Fatalf("RoleRepositoryMock.GetExisting mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockGetExistingExpectation{
+		mock:   mmGetExisting.mock,
+		params: &RoleRepositoryMockGetExistingParams{ctx, roles},
+	}
+	mmGetExisting.expectations = append(mmGetExisting.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.GetExisting return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockGetExistingExpectation) Then(ra1 []model.Role, err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockGetExistingResults{ra1, err}
+	return e.mock
+}
+
+// GetExisting implements repository.RoleRepository
+func (mmGetExisting *RoleRepositoryMock) GetExisting(ctx context.Context, roles []model.Role) (ra1 []model.Role, err error) {
+	mm_atomic.AddUint64(&mmGetExisting.beforeGetExistingCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetExisting.afterGetExistingCounter, 1)
+
+	if mmGetExisting.inspectFuncGetExisting != nil {
+		mmGetExisting.inspectFuncGetExisting(ctx, roles)
+	}
+
+	mm_params := RoleRepositoryMockGetExistingParams{ctx, roles}
+
+	// Record call args
+	mmGetExisting.GetExistingMock.mutex.Lock()
+	mmGetExisting.GetExistingMock.callArgs = append(mmGetExisting.GetExistingMock.callArgs, &mm_params)
+	mmGetExisting.GetExistingMock.mutex.Unlock()
+
+	for _, e := range mmGetExisting.GetExistingMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ra1, e.results.err
+		}
+	}
+
+	if mmGetExisting.GetExistingMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetExisting.GetExistingMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetExisting.GetExistingMock.defaultExpectation.params
+		mm_want_ptrs := mmGetExisting.GetExistingMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockGetExistingParams{ctx, roles}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetExisting.t.Errorf("RoleRepositoryMock.GetExisting got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.roles != nil && !minimock.Equal(*mm_want_ptrs.roles, mm_got.roles) {
+				mmGetExisting.t.Errorf("RoleRepositoryMock.GetExisting got unexpected parameter roles, want: %#v, got: %#v%s\n", *mm_want_ptrs.roles, mm_got.roles, minimock.Diff(*mm_want_ptrs.roles, mm_got.roles))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetExisting.t.Errorf("RoleRepositoryMock.GetExisting got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetExisting.GetExistingMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetExisting.t.Fatal("No results are set for the RoleRepositoryMock.GetExisting")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmGetExisting.funcGetExisting != nil {
+		return mmGetExisting.funcGetExisting(ctx, roles)
+	}
+	mmGetExisting.t.Fatalf("Unexpected call to RoleRepositoryMock.GetExisting. %v %v", ctx, roles)
+	return
+}
+
+// GetExistingAfterCounter returns a count of finished RoleRepositoryMock.GetExisting invocations
+func (mmGetExisting *RoleRepositoryMock) GetExistingAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetExisting.afterGetExistingCounter)
+}
+
+// GetExistingBeforeCounter returns a count of RoleRepositoryMock.GetExisting invocations
+func (mmGetExisting *RoleRepositoryMock) GetExistingBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetExisting.beforeGetExistingCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.GetExisting.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetExisting *mRoleRepositoryMockGetExisting) Calls() []*RoleRepositoryMockGetExistingParams {
+	mmGetExisting.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockGetExistingParams, len(mmGetExisting.callArgs))
+	copy(argCopy, mmGetExisting.callArgs)
+
+	mmGetExisting.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetExistingDone returns true if the count of the GetExisting invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockGetExistingDone() bool {
+	for _, e := range m.GetExistingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetExistingMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetExistingCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetExisting != nil && mm_atomic.LoadUint64(&m.afterGetExistingCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetExistingInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockGetExistingInspect() {
+	for _, e := range m.GetExistingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.GetExisting with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetExistingMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetExistingCounter) < 1 {
+		if m.GetExistingMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RoleRepositoryMock.GetExisting")
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.GetExisting with params: %#v", *m.GetExistingMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetExisting != nil && mm_atomic.LoadUint64(&m.afterGetExistingCounter) < 1 {
+		m.t.Error("Expected call to RoleRepositoryMock.GetExisting")
+	}
+}
+
+type mRoleRepositoryMockGetUserAccess struct {
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockGetUserAccessExpectation
+	expectations       []*RoleRepositoryMockGetUserAccessExpectation
+
+	callArgs []*RoleRepositoryMockGetUserAccessParams
+	mutex    sync.RWMutex
+}
+
+// RoleRepositoryMockGetUserAccessExpectation specifies expectation struct of the RoleRepository.GetUserAccess
+type RoleRepositoryMockGetUserAccessExpectation struct {
+	mock      *RoleRepositoryMock
+	params    *RoleRepositoryMockGetUserAccessParams
+	paramPtrs *RoleRepositoryMockGetUserAccessParamPtrs
+	results   *RoleRepositoryMockGetUserAccessResults
+	Counter   uint64
+}
+
+// RoleRepositoryMockGetUserAccessParams contains parameters of the RoleRepository.GetUserAccess
+type RoleRepositoryMockGetUserAccessParams struct {
+	ctx    context.Context
+	userID int64
+	tenant string
+}
+
+// RoleRepositoryMockGetUserAccessParamPtrs contains pointers to parameters of the RoleRepository.GetUserAccess
+type RoleRepositoryMockGetUserAccessParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+	tenant *string
+}
+
+// RoleRepositoryMockGetUserAccessResults contains results of the RoleRepository.GetUserAccess
+type RoleRepositoryMockGetUserAccessResults struct {
+	up1 *model.UserAccess
+	err error
+}
+
+// Expect sets up expected params for RoleRepository.GetUserAccess
+func (mmGetUserAccess *mRoleRepositoryMockGetUserAccess) Expect(ctx context.Context, userID int64, tenant string) *mRoleRepositoryMockGetUserAccess {
+	if mmGetUserAccess.mock.funcGetUserAccess != nil {
+		mmGetUserAccess.mock.t.Fatalf("RoleRepositoryMock.GetUserAccess mock is already set by Set")
+	}
+
+	if mmGetUserAccess.defaultExpectation == nil {
+		mmGetUserAccess.defaultExpectation = &RoleRepositoryMockGetUserAccessExpectation{}
+	}
+
+	if mmGetUserAccess.defaultExpectation.paramPtrs != nil {
+		mmGetUserAccess.mock.t.Fatalf("RoleRepositoryMock.GetUserAccess mock is already set by ExpectParams functions")
+	}
+
+	mmGetUserAccess.defaultExpectation.params = &RoleRepositoryMockGetUserAccessParams{ctx, userID, tenant}
+	for _, e := range mmGetUserAccess.expectations {
+		if minimock.Equal(e.params, mmGetUserAccess.defaultExpectation.params) {
+			mmGetUserAccess.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUserAccess.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUserAccess
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.GetUserAccess
+func (mmGetUserAccess *mRoleRepositoryMockGetUserAccess) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockGetUserAccess {
+	if mmGetUserAccess.mock.funcGetUserAccess != nil {
+		mmGetUserAccess.mock.t.Fatalf("RoleRepositoryMock.GetUserAccess mock is already set by Set")
+	}
+
+	if mmGetUserAccess.defaultExpectation == nil {
+		mmGetUserAccess.defaultExpectation = &RoleRepositoryMockGetUserAccessExpectation{}
+	}
+
+	if mmGetUserAccess.defaultExpectation.params != nil {
+		mmGetUserAccess.mock.t.Fatalf("RoleRepositoryMock.GetUserAccess mock is already set by Expect")
+	}
+
+	if mmGetUserAccess.defaultExpectation.paramPtrs == nil {
+		mmGetUserAccess.defaultExpectation.paramPtrs = &RoleRepositoryMockGetUserAccessParamPtrs{}
+	}
+	mmGetUserAccess.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetUserAccess
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RoleRepository.GetUserAccess
+func (mmGetUserAccess *mRoleRepositoryMockGetUserAccess) ExpectUserIDParam2(userID int64) *mRoleRepositoryMockGetUserAccess {
+	if mmGetUserAccess.mock.funcGetUserAccess != nil {
+		mmGetUserAccess.mock.t.Fatalf("RoleRepositoryMock.GetUserAccess mock is already set by Set")
+	}
+
+	if mmGetUserAccess.defaultExpectation == nil {
+		mmGetUserAccess.defaultExpectation = &RoleRepositoryMockGetUserAccessExpectation{}
+	}
+
+	if mmGetUserAccess.defaultExpectation.params != nil {
+		mmGetUserAccess.mock.t.Fatalf("RoleRepositoryMock.GetUserAccess mock is already set by Expect")
+	}
+
+	if mmGetUserAccess.defaultExpectation.paramPtrs == nil {
+		mmGetUserAccess.defaultExpectation.paramPtrs = &RoleRepositoryMockGetUserAccessParamPtrs{}
+	}
+	mmGetUserAccess.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmGetUserAccess
+}
+
+// ExpectTenantParam3 sets up expected param tenant for RoleRepository.GetUserAccess
+func (mmGetUserAccess *mRoleRepositoryMockGetUserAccess) ExpectTenantParam3(tenant string) *mRoleRepositoryMockGetUserAccess {
+	if mmGetUserAccess.mock.funcGetUserAccess != nil {
+		mmGetUserAccess.mock.t.Fatalf("RoleRepositoryMock.GetUserAccess mock is already set by Set")
+	}
+
+	if mmGetUserAccess.defaultExpectation == nil {
+		mmGetUserAccess.defaultExpectation = &RoleRepositoryMockGetUserAccessExpectation{}
+	}
+
+	if mmGetUserAccess.defaultExpectation.params != nil {
+		mmGetUserAccess.mock.t.Fatalf("RoleRepositoryMock.GetUserAccess mock is already set by Expect")
+	}
+
+	if mmGetUserAccess.defaultExpectation.paramPtrs == nil {
+		mmGetUserAccess.defaultExpectation.paramPtrs = &RoleRepositoryMockGetUserAccessParamPtrs{}
+	}
+	mmGetUserAccess.defaultExpectation.paramPtrs.tenant = &tenant
+
+	return mmGetUserAccess
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.GetUserAccess
+func (mmGetUserAccess *mRoleRepositoryMockGetUserAccess) Inspect(f func(ctx context.Context, userID int64, tenant string)) *mRoleRepositoryMockGetUserAccess {
+	if mmGetUserAccess.mock.inspectFuncGetUserAccess != nil {
+		mmGetUserAccess.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.GetUserAccess")
+	}
+
+	mmGetUserAccess.mock.inspectFuncGetUserAccess = f
+
+	return mmGetUserAccess
+}
+
+// Return sets up results that will be returned by RoleRepository.GetUserAccess
+func (mmGetUserAccess *mRoleRepositoryMockGetUserAccess) Return(up1 *model.UserAccess, err error) *RoleRepositoryMock {
+	if mmGetUserAccess.mock.funcGetUserAccess != nil {
+		mmGetUserAccess.mock.t.Fatalf("RoleRepositoryMock.GetUserAccess mock is already set by Set")
+	}
+
+	if mmGetUserAccess.defaultExpectation == nil {
+		mmGetUserAccess.defaultExpectation = &RoleRepositoryMockGetUserAccessExpectation{mock: mmGetUserAccess.mock}
+	}
+	mmGetUserAccess.defaultExpectation.results = &RoleRepositoryMockGetUserAccessResults{up1, err}
+	return mmGetUserAccess.mock
+}
+
+// Set uses given function f to mock the RoleRepository.GetUserAccess method
+func (mmGetUserAccess *mRoleRepositoryMockGetUserAccess) Set(f func(ctx context.Context, userID int64, tenant string) (up1 *model.UserAccess, err error)) *RoleRepositoryMock {
+	if mmGetUserAccess.defaultExpectation != nil {
+		mmGetUserAccess.mock.t.Fatalf("Default expectation is already set for the RoleRepository.GetUserAccess method")
+	}
+
+	if len(mmGetUserAccess.expectations) > 0 {
+		mmGetUserAccess.mock.t.Fatalf("Some expectations are already set for the RoleRepository.GetUserAccess method")
+	}
+
+	mmGetUserAccess.mock.funcGetUserAccess = f
+	return mmGetUserAccess.mock
+}
+
+// When sets expectation for the RoleRepository.GetUserAccess which will trigger the result defined by the following
+// Then helper
+func (mmGetUserAccess *mRoleRepositoryMockGetUserAccess) When(ctx context.Context, userID int64, tenant string) *RoleRepositoryMockGetUserAccessExpectation {
+	if mmGetUserAccess.mock.funcGetUserAccess != nil {
+		mmGetUserAccess.mock.t.Fatalf("RoleRepositoryMock.GetUserAccess mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockGetUserAccessExpectation{
+		mock:   mmGetUserAccess.mock,
+		params: &RoleRepositoryMockGetUserAccessParams{ctx, userID, tenant},
+	}
+	mmGetUserAccess.expectations = append(mmGetUserAccess.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.GetUserAccess return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockGetUserAccessExpectation) Then(up1 *model.UserAccess, err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockGetUserAccessResults{up1, err}
+	return e.mock
+}
+
+// GetUserAccess implements repository.RoleRepository
+func (mmGetUserAccess *RoleRepositoryMock) GetUserAccess(ctx context.Context, userID int64, tenant string) (up1 *model.UserAccess, err error) {
+	mm_atomic.AddUint64(&mmGetUserAccess.beforeGetUserAccessCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUserAccess.afterGetUserAccessCounter, 1)
+
+	if mmGetUserAccess.inspectFuncGetUserAccess != nil {
+		mmGetUserAccess.inspectFuncGetUserAccess(ctx, userID, tenant)
+	}
+
+	mm_params := RoleRepositoryMockGetUserAccessParams{ctx, userID, tenant}
+
+	// Record call args
+	mmGetUserAccess.GetUserAccessMock.mutex.Lock()
+	mmGetUserAccess.GetUserAccessMock.callArgs = append(mmGetUserAccess.GetUserAccessMock.callArgs, &mm_params)
+	mmGetUserAccess.GetUserAccessMock.mutex.Unlock()
+
+	for _, e := range mmGetUserAccess.GetUserAccessMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmGetUserAccess.GetUserAccessMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUserAccess.GetUserAccessMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUserAccess.GetUserAccessMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUserAccess.GetUserAccessMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockGetUserAccessParams{ctx, userID, tenant}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUserAccess.t.Errorf("RoleRepositoryMock.GetUserAccess got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetUserAccess.t.Errorf("RoleRepositoryMock.GetUserAccess got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.tenant != nil && !minimock.Equal(*mm_want_ptrs.tenant, mm_got.tenant) {
+				mmGetUserAccess.t.Errorf("RoleRepositoryMock.GetUserAccess got unexpected parameter tenant, want: %#v, got: %#v%s\n", *mm_want_ptrs.tenant, mm_got.tenant, minimock.Diff(*mm_want_ptrs.tenant, mm_got.tenant))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUserAccess.t.Errorf("RoleRepositoryMock.GetUserAccess got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUserAccess.GetUserAccessMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUserAccess.t.Fatal("No results are set for the RoleRepositoryMock.GetUserAccess")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmGetUserAccess.funcGetUserAccess != nil {
+		return mmGetUserAccess.funcGetUserAccess(ctx, userID, tenant)
+	}
+	mmGetUserAccess.t.Fatalf("Unexpected call to RoleRepositoryMock.GetUserAccess. %v %v %v", ctx, userID, tenant)
+	return
+}
+
+// GetUserAccessAfterCounter returns a count of finished RoleRepositoryMock.GetUserAccess invocations
+func (mmGetUserAccess *RoleRepositoryMock) GetUserAccessAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserAccess.afterGetUserAccessCounter)
+}
+
+// GetUserAccessBeforeCounter returns a count of RoleRepositoryMock.GetUserAccess invocations
+func (mmGetUserAccess *RoleRepositoryMock) GetUserAccessBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserAccess.beforeGetUserAccessCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.GetUserAccess.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUserAccess *mRoleRepositoryMockGetUserAccess) Calls() []*RoleRepositoryMockGetUserAccessParams {
+	mmGetUserAccess.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockGetUserAccessParams, len(mmGetUserAccess.callArgs))
+	copy(argCopy, mmGetUserAccess.callArgs)
+
+	mmGetUserAccess.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserAccessDone returns true if the count of the GetUserAccess invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockGetUserAccessDone() bool {
+	for _, e := range m.GetUserAccessMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserAccessMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetUserAccessCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUserAccess != nil && mm_atomic.LoadUint64(&m.afterGetUserAccessCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetUserAccessInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockGetUserAccessInspect() {
+	for _, e := range m.GetUserAccessMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.GetUserAccess with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserAccessMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetUserAccessCounter) < 1 {
+		if m.GetUserAccessMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RoleRepositoryMock.GetUserAccess")
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.GetUserAccess with params: %#v", *m.GetUserAccessMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUserAccess != nil && mm_atomic.LoadUint64(&m.afterGetUserAccessCounter) < 1 {
+		m.t.Error("Expected call to RoleRepositoryMock.GetUserAccess")
+	}
+}
+
+type mRoleRepositoryMockListDefinitions struct {
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockListDefinitionsExpectation
+	expectations       []*RoleRepositoryMockListDefinitionsExpectation
+
+	callArgs []*RoleRepositoryMockListDefinitionsParams
+	mutex    sync.RWMutex
+}
+
+// RoleRepositoryMockListDefinitionsExpectation specifies expectation struct of the RoleRepository.ListDefinitions
+type RoleRepositoryMockListDefinitionsExpectation struct {
+	mock      *RoleRepositoryMock
+	params    *RoleRepositoryMockListDefinitionsParams
+	paramPtrs *RoleRepositoryMockListDefinitionsParamPtrs
+	results   *RoleRepositoryMockListDefinitionsResults
+	Counter   uint64
+}
+
+// RoleRepositoryMockListDefinitionsParams contains parameters of the RoleRepository.ListDefinitions
+type RoleRepositoryMockListDefinitionsParams struct {
+	ctx context.Context
+}
+
+// RoleRepositoryMockListDefinitionsParamPtrs contains pointers to parameters of the RoleRepository.ListDefinitions
+type RoleRepositoryMockListDefinitionsParamPtrs struct {
+	ctx *context.Context
+}
+
+// RoleRepositoryMockListDefinitionsResults contains results of the RoleRepository.ListDefinitions
+type RoleRepositoryMockListDefinitionsResults struct {
+	ra1 []model.RoleDefinition
+	err error
+}
+
+// Expect sets up expected params for RoleRepository.ListDefinitions
+func (mmListDefinitions *mRoleRepositoryMockListDefinitions) Expect(ctx context.Context) *mRoleRepositoryMockListDefinitions {
+	if mmListDefinitions.mock.funcListDefinitions != nil {
+		mmListDefinitions.mock.t.Fatalf("RoleRepositoryMock.ListDefinitions mock is already set by Set")
+	}
+
+	if mmListDefinitions.defaultExpectation == nil {
+		mmListDefinitions.defaultExpectation = &RoleRepositoryMockListDefinitionsExpectation{}
+	}
+
+	if mmListDefinitions.defaultExpectation.paramPtrs != nil {
+		mmListDefinitions.mock.t.Fatalf("RoleRepositoryMock.ListDefinitions mock is already set by ExpectParams functions")
+	}
+
+	mmListDefinitions.defaultExpectation.params = &RoleRepositoryMockListDefinitionsParams{ctx}
+	for _, e := range mmListDefinitions.expectations {
+		if minimock.Equal(e.params, mmListDefinitions.defaultExpectation.params) {
+			mmListDefinitions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListDefinitions.defaultExpectation.params)
+		}
+	}
+
+	return mmListDefinitions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.ListDefinitions
+func (mmListDefinitions *mRoleRepositoryMockListDefinitions) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockListDefinitions {
+	if mmListDefinitions.mock.funcListDefinitions != nil {
+		mmListDefinitions.mock.t.Fatalf("RoleRepositoryMock.ListDefinitions mock is already set by Set")
+	}
+
+	if mmListDefinitions.defaultExpectation == nil {
+		mmListDefinitions.defaultExpectation = &RoleRepositoryMockListDefinitionsExpectation{}
+	}
+
+	if mmListDefinitions.defaultExpectation.params != nil {
+		mmListDefinitions.mock.t.Fatalf("RoleRepositoryMock.ListDefinitions mock is already set by Expect")
+	}
+
+	if mmListDefinitions.defaultExpectation.paramPtrs == nil {
+		mmListDefinitions.defaultExpectation.paramPtrs = &RoleRepositoryMockListDefinitionsParamPtrs{}
+	}
+	mmListDefinitions.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListDefinitions
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.ListDefinitions
+func (mmListDefinitions *mRoleRepositoryMockListDefinitions) Inspect(f func(ctx context.Context)) *mRoleRepositoryMockListDefinitions {
+	if mmListDefinitions.mock.inspectFuncListDefinitions != nil {
+		mmListDefinitions.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.ListDefinitions")
+	}
+
+	mmListDefinitions.mock.inspectFuncListDefinitions = f
+
+	return mmListDefinitions
+}
+
+// Return sets up results that will be returned by RoleRepository.ListDefinitions
+func (mmListDefinitions *mRoleRepositoryMockListDefinitions) Return(ra1 []model.RoleDefinition, err error) *RoleRepositoryMock {
+	if mmListDefinitions.mock.funcListDefinitions != nil {
+		mmListDefinitions.mock.t.Fatalf("RoleRepositoryMock.ListDefinitions mock is already set by Set")
+	}
+
+	if mmListDefinitions.defaultExpectation == nil {
+		mmListDefinitions.defaultExpectation = &RoleRepositoryMockListDefinitionsExpectation{mock: mmListDefinitions.mock}
+	}
+	mmListDefinitions.defaultExpectation.results = &RoleRepositoryMockListDefinitionsResults{ra1, err}
+	return mmListDefinitions.mock
+}
+
+// Set uses given function f to mock the RoleRepository.ListDefinitions method
+func (mmListDefinitions *mRoleRepositoryMockListDefinitions) Set(f func(ctx context.Context) (ra1 []model.RoleDefinition, err error)) *RoleRepositoryMock {
+	if mmListDefinitions.defaultExpectation != nil {
+		mmListDefinitions.mock.t.Fatalf("Default expectation is already set for the RoleRepository.ListDefinitions method")
+	}
+
+	if len(mmListDefinitions.expectations) > 0 {
+		mmListDefinitions.mock.t.Fatalf("Some expectations are already set for the RoleRepository.ListDefinitions method")
+	}
+
+	mmListDefinitions.mock.funcListDefinitions = f
+	return mmListDefinitions.mock
+}
+
+// When sets expectation for the RoleRepository.ListDefinitions which will trigger the result defined by the following
+// Then helper
+func (mmListDefinitions *mRoleRepositoryMockListDefinitions) When(ctx context.Context) *RoleRepositoryMockListDefinitionsExpectation {
+	if mmListDefinitions.mock.funcListDefinitions != nil {
+		mmListDefinitions.mock.t.Fatalf("RoleRepositoryMock.ListDefinitions mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockListDefinitionsExpectation{
+		mock:   mmListDefinitions.mock,
+		params: &RoleRepositoryMockListDefinitionsParams{ctx},
+	}
+	mmListDefinitions.expectations = append(mmListDefinitions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.ListDefinitions return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockListDefinitionsExpectation) Then(ra1 []model.RoleDefinition, err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockListDefinitionsResults{ra1, err}
+	return e.mock
+}
+
+// ListDefinitions implements repository.RoleRepository
+func (mmListDefinitions *RoleRepositoryMock) ListDefinitions(ctx context.Context) (ra1 []model.RoleDefinition, err error) {
+	mm_atomic.AddUint64(&mmListDefinitions.beforeListDefinitionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListDefinitions.afterListDefinitionsCounter, 1)
+
+	if mmListDefinitions.inspectFuncListDefinitions != nil {
+		mmListDefinitions.inspectFuncListDefinitions(ctx)
+	}
+
+	mm_params := RoleRepositoryMockListDefinitionsParams{ctx}
+
+	// Record call args
+	mmListDefinitions.ListDefinitionsMock.mutex.Lock()
+	mmListDefinitions.ListDefinitionsMock.callArgs = append(mmListDefinitions.ListDefinitionsMock.callArgs, &mm_params)
+	mmListDefinitions.ListDefinitionsMock.mutex.Unlock()
+
+	for _, e := range mmListDefinitions.ListDefinitionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ra1, e.results.err
+		}
+	}
+
+	if mmListDefinitions.ListDefinitionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListDefinitions.ListDefinitionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListDefinitions.ListDefinitionsMock.defaultExpectation.params
+		mm_want_ptrs := mmListDefinitions.ListDefinitionsMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockListDefinitionsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListDefinitions.t.Errorf("RoleRepositoryMock.ListDefinitions got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListDefinitions.t.Errorf("RoleRepositoryMock.ListDefinitions got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListDefinitions.ListDefinitionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListDefinitions.t.Fatal("No results are set for the RoleRepositoryMock.ListDefinitions")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmListDefinitions.funcListDefinitions != nil {
+		return mmListDefinitions.funcListDefinitions(ctx)
+	}
+	mmListDefinitions.t.Fatalf("Unexpected call to RoleRepositoryMock.ListDefinitions. %v", ctx)
+	return
+}
+
+// ListDefinitionsAfterCounter returns a count of finished RoleRepositoryMock.ListDefinitions invocations
+func (mmListDefinitions *RoleRepositoryMock) ListDefinitionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListDefinitions.afterListDefinitionsCounter)
+}
+
+// ListDefinitionsBeforeCounter returns a count of RoleRepositoryMock.ListDefinitions invocations
+func (mmListDefinitions *RoleRepositoryMock) ListDefinitionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListDefinitions.beforeListDefinitionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.ListDefinitions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListDefinitions *mRoleRepositoryMockListDefinitions) Calls() []*RoleRepositoryMockListDefinitionsParams {
+	mmListDefinitions.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockListDefinitionsParams, len(mmListDefinitions.callArgs))
+	copy(argCopy, mmListDefinitions.callArgs)
+
+	mmListDefinitions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDefinitionsDone returns true if the count of the ListDefinitions invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockListDefinitionsDone() bool {
+	for _, e := range m.ListDefinitionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListDefinitionsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListDefinitionsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListDefinitions != nil && mm_atomic.LoadUint64(&m.afterListDefinitionsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockListDefinitionsInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockListDefinitionsInspect() {
+	for _, e := range m.ListDefinitionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.ListDefinitions with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListDefinitionsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListDefinitionsCounter) < 1 {
+		if m.ListDefinitionsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RoleRepositoryMock.ListDefinitions")
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.ListDefinitions with params: %#v", *m.ListDefinitionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListDefinitions != nil && mm_atomic.LoadUint64(&m.afterListDefinitionsCounter) < 1 {
+		m.t.Error("Expected call to RoleRepositoryMock.ListDefinitions")
+	}
+}
+
+type mRoleRepositoryMockListEffectiveRoles struct {
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockListEffectiveRolesExpectation
+	expectations       []*RoleRepositoryMockListEffectiveRolesExpectation
+
+	callArgs []*RoleRepositoryMockListEffectiveRolesParams
+	mutex    sync.RWMutex
+}
+
+// RoleRepositoryMockListEffectiveRolesExpectation specifies expectation struct of the RoleRepository.ListEffectiveRoles
+type RoleRepositoryMockListEffectiveRolesExpectation struct {
+	mock      *RoleRepositoryMock
+	params    *RoleRepositoryMockListEffectiveRolesParams
+	paramPtrs *RoleRepositoryMockListEffectiveRolesParamPtrs
+	results   *RoleRepositoryMockListEffectiveRolesResults
+	Counter   uint64
+}
+
+// RoleRepositoryMockListEffectiveRolesParams contains parameters of the RoleRepository.ListEffectiveRoles
+type RoleRepositoryMockListEffectiveRolesParams struct {
+	ctx context.Context
+}
+
+// RoleRepositoryMockListEffectiveRolesParamPtrs contains pointers to parameters of the RoleRepository.ListEffectiveRoles
+type RoleRepositoryMockListEffectiveRolesParamPtrs struct {
+	ctx *context.Context
+}
+
+// RoleRepositoryMockListEffectiveRolesResults contains results of the RoleRepository.ListEffectiveRoles
+type RoleRepositoryMockListEffectiveRolesResults struct {
+	m1  map[model.Role][]model.Role
+	err error
+}
+
+// Expect sets up expected params for RoleRepository.ListEffectiveRoles
+func (mmListEffectiveRoles *mRoleRepositoryMockListEffectiveRoles) Expect(ctx context.Context) *mRoleRepositoryMockListEffectiveRoles {
+	if mmListEffectiveRoles.mock.funcListEffectiveRoles != nil {
+		mmListEffectiveRoles.mock.t.Fatalf("RoleRepositoryMock.ListEffectiveRoles mock is already set by Set")
+	}
+
+	if mmListEffectiveRoles.defaultExpectation == nil {
+		mmListEffectiveRoles.defaultExpectation = &RoleRepositoryMockListEffectiveRolesExpectation{}
+	}
+
+	if mmListEffectiveRoles.defaultExpectation.paramPtrs != nil {
+		mmListEffectiveRoles.mock.t.Fatalf("RoleRepositoryMock.ListEffectiveRoles mock is already set by ExpectParams functions")
+	}
+
+	mmListEffectiveRoles.defaultExpectation.params = &RoleRepositoryMockListEffectiveRolesParams{ctx}
+	for _, e := range mmListEffectiveRoles.expectations {
+		if minimock.Equal(e.params, mmListEffectiveRoles.defaultExpectation.params) {
+			mmListEffectiveRoles.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListEffectiveRoles.defaultExpectation.params)
+		}
+	}
+
+	return mmListEffectiveRoles
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.ListEffectiveRoles
+func (mmListEffectiveRoles *mRoleRepositoryMockListEffectiveRoles) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockListEffectiveRoles {
+	if mmListEffectiveRoles.mock.funcListEffectiveRoles != nil {
+		mmListEffectiveRoles.mock.t.Fatalf("RoleRepositoryMock.ListEffectiveRoles mock is already set by Set")
+	}
+
+	if mmListEffectiveRoles.defaultExpectation == nil {
+		mmListEffectiveRoles.defaultExpectation = &RoleRepositoryMockListEffectiveRolesExpectation{}
+	}
+
+	if mmListEffectiveRoles.defaultExpectation.params != nil {
+		mmListEffectiveRoles.mock.t.Fatalf("RoleRepositoryMock.ListEffectiveRoles mock is already set by Expect")
+	}
+
+	if mmListEffectiveRoles.defaultExpectation.paramPtrs == nil {
+		mmListEffectiveRoles.defaultExpectation.paramPtrs = &RoleRepositoryMockListEffectiveRolesParamPtrs{}
+	}
+	mmListEffectiveRoles.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListEffectiveRoles
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.ListEffectiveRoles
+func (mmListEffectiveRoles *mRoleRepositoryMockListEffectiveRoles) Inspect(f func(ctx context.Context)) *mRoleRepositoryMockListEffectiveRoles {
+	if mmListEffectiveRoles.mock.inspectFuncListEffectiveRoles != nil {
+		mmListEffectiveRoles.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.ListEffectiveRoles")
+	}
+
+	mmListEffectiveRoles.mock.inspectFuncListEffectiveRoles = f
+
+	return mmListEffectiveRoles
+}
+
+// Return sets up results that will be returned by RoleRepository.ListEffectiveRoles
+func (mmListEffectiveRoles *mRoleRepositoryMockListEffectiveRoles) Return(m1 map[model.Role][]model.Role, err error) *RoleRepositoryMock {
+	if mmListEffectiveRoles.mock.funcListEffectiveRoles != nil {
+		mmListEffectiveRoles.mock.t.Fatalf("RoleRepositoryMock.ListEffectiveRoles mock is already set by Set")
+	}
+
+	if mmListEffectiveRoles.defaultExpectation == nil {
+		mmListEffectiveRoles.defaultExpectation = &RoleRepositoryMockListEffectiveRolesExpectation{mock: mmListEffectiveRoles.mock}
+	}
+	mmListEffectiveRoles.defaultExpectation.results = &RoleRepositoryMockListEffectiveRolesResults{m1, err}
+	return mmListEffectiveRoles.mock
+}
+
+// Set uses given function f to mock the RoleRepository.ListEffectiveRoles method
+func (mmListEffectiveRoles *mRoleRepositoryMockListEffectiveRoles) Set(f func(ctx context.Context) (m1 map[model.Role][]model.Role, err error)) *RoleRepositoryMock {
+	if mmListEffectiveRoles.defaultExpectation != nil {
+		mmListEffectiveRoles.mock.t.Fatalf("Default expectation is already set for the RoleRepository.ListEffectiveRoles method")
+	}
+
+	if len(mmListEffectiveRoles.expectations) > 0 {
+		mmListEffectiveRoles.mock.t.Fatalf("Some expectations are already set for the RoleRepository.ListEffectiveRoles method")
+	}
+
+	mmListEffectiveRoles.mock.funcListEffectiveRoles = f
+	return mmListEffectiveRoles.mock
+}
+
+// When sets expectation for the RoleRepository.ListEffectiveRoles which will trigger the result defined by the following
+// Then helper
+func (mmListEffectiveRoles *mRoleRepositoryMockListEffectiveRoles) When(ctx context.Context) *RoleRepositoryMockListEffectiveRolesExpectation {
+	if mmListEffectiveRoles.mock.funcListEffectiveRoles != nil {
+		mmListEffectiveRoles.mock.t.Fatalf("RoleRepositoryMock.ListEffectiveRoles mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockListEffectiveRolesExpectation{
+		mock:   mmListEffectiveRoles.mock,
+		params: &RoleRepositoryMockListEffectiveRolesParams{ctx},
+	}
+	mmListEffectiveRoles.expectations = append(mmListEffectiveRoles.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.ListEffectiveRoles return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockListEffectiveRolesExpectation) Then(m1 map[model.Role][]model.Role, err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockListEffectiveRolesResults{m1, err}
+	return e.mock
+}
+
+// ListEffectiveRoles implements repository.RoleRepository
+func (mmListEffectiveRoles *RoleRepositoryMock) ListEffectiveRoles(ctx context.Context) (m1 map[model.Role][]model.Role, err error) {
+	mm_atomic.AddUint64(&mmListEffectiveRoles.beforeListEffectiveRolesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListEffectiveRoles.afterListEffectiveRolesCounter, 1)
+
+	if mmListEffectiveRoles.inspectFuncListEffectiveRoles != nil {
+		mmListEffectiveRoles.inspectFuncListEffectiveRoles(ctx)
+	}
+
+	mm_params := RoleRepositoryMockListEffectiveRolesParams{ctx}
+
+	// Record call args
+	mmListEffectiveRoles.ListEffectiveRolesMock.mutex.Lock()
+	mmListEffectiveRoles.ListEffectiveRolesMock.callArgs = append(mmListEffectiveRoles.ListEffectiveRolesMock.callArgs, &mm_params)
+	mmListEffectiveRoles.ListEffectiveRolesMock.mutex.Unlock()
+
+	for _, e := range mmListEffectiveRoles.ListEffectiveRolesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmListEffectiveRoles.ListEffectiveRolesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListEffectiveRoles.ListEffectiveRolesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListEffectiveRoles.ListEffectiveRolesMock.defaultExpectation.params
+		mm_want_ptrs := mmListEffectiveRoles.ListEffectiveRolesMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockListEffectiveRolesParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListEffectiveRoles.t.Errorf("RoleRepositoryMock.ListEffectiveRoles got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListEffectiveRoles.t.Errorf("RoleRepositoryMock.ListEffectiveRoles got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListEffectiveRoles.ListEffectiveRolesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListEffectiveRoles.t.Fatal("No results are set for the RoleRepositoryMock.ListEffectiveRoles")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmListEffectiveRoles.funcListEffectiveRoles != nil {
+		return mmListEffectiveRoles.funcListEffectiveRoles(ctx)
+	}
+	mmListEffectiveRoles.t.Fatalf("Unexpected call to RoleRepositoryMock.ListEffectiveRoles. %v", ctx)
+	return
+}
+
+// ListEffectiveRolesAfterCounter returns a count of finished RoleRepositoryMock.ListEffectiveRoles invocations
+func (mmListEffectiveRoles *RoleRepositoryMock) ListEffectiveRolesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListEffectiveRoles.afterListEffectiveRolesCounter)
+}
+
+// ListEffectiveRolesBeforeCounter returns a count of RoleRepositoryMock.ListEffectiveRoles invocations
+func (mmListEffectiveRoles *RoleRepositoryMock) ListEffectiveRolesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListEffectiveRoles.beforeListEffectiveRolesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.ListEffectiveRoles.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListEffectiveRoles *mRoleRepositoryMockListEffectiveRoles) Calls() []*RoleRepositoryMockListEffectiveRolesParams {
+	mmListEffectiveRoles.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockListEffectiveRolesParams, len(mmListEffectiveRoles.callArgs))
+	copy(argCopy, mmListEffectiveRoles.callArgs)
+
+	mmListEffectiveRoles.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListEffectiveRolesDone returns true if the count of the ListEffectiveRoles invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockListEffectiveRolesDone() bool {
+	for _, e := range m.ListEffectiveRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListEffectiveRolesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListEffectiveRolesCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListEffectiveRoles != nil && mm_atomic.LoadUint64(&m.afterListEffectiveRolesCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockListEffectiveRolesInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockListEffectiveRolesInspect() {
+	for _, e := range m.ListEffectiveRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.ListEffectiveRoles with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListEffectiveRolesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListEffectiveRolesCounter) < 1 {
+		if m.ListEffectiveRolesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RoleRepositoryMock.ListEffectiveRoles")
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.ListEffectiveRoles with params: %#v", *m.ListEffectiveRolesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListEffectiveRoles != nil && mm_atomic.LoadUint64(&m.afterListEffectiveRolesCounter) < 1 {
+		m.t.Error("Expected call to RoleRepositoryMock.ListEffectiveRoles")
+	}
+}
+
+type mRoleRepositoryMockListUserRoles struct {
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockListUserRolesExpectation
+	expectations       []*RoleRepositoryMockListUserRolesExpectation
+
+	callArgs []*RoleRepositoryMockListUserRolesParams
+	mutex    sync.RWMutex
+}
+
+// RoleRepositoryMockListUserRolesExpectation specifies expectation struct of the RoleRepository.ListUserRoles
+type RoleRepositoryMockListUserRolesExpectation struct {
+	mock      *RoleRepositoryMock
+	params    *RoleRepositoryMockListUserRolesParams
+	paramPtrs *RoleRepositoryMockListUserRolesParamPtrs
+	results   *RoleRepositoryMockListUserRolesResults
+	Counter   uint64
+}
+
+// RoleRepositoryMockListUserRolesParams contains parameters of the RoleRepository.ListUserRoles
+type RoleRepositoryMockListUserRolesParams struct {
+	ctx     context.Context
+	afterID int64
+	limit   uint64
+}
+
+// RoleRepositoryMockListUserRolesParamPtrs contains pointers to parameters of the RoleRepository.ListUserRoles
+type RoleRepositoryMockListUserRolesParamPtrs struct {
+	ctx     *context.Context
+	afterID *int64
+	limit   *uint64
+}
+
+// RoleRepositoryMockListUserRolesResults contains results of the RoleRepository.ListUserRoles
+type RoleRepositoryMockListUserRolesResults struct {
+	ua1 []model.UserRoles
+	err error
+}
+
+// Expect sets up expected params for RoleRepository.ListUserRoles
+func (mmListUserRoles *mRoleRepositoryMockListUserRoles) Expect(ctx context.Context, afterID int64, limit uint64) *mRoleRepositoryMockListUserRoles {
+	if mmListUserRoles.mock.funcListUserRoles != nil {
+		mmListUserRoles.mock.t.Fatalf("RoleRepositoryMock.ListUserRoles mock is already set by Set")
+	}
+
+	if mmListUserRoles.defaultExpectation == nil {
+		mmListUserRoles.defaultExpectation = &RoleRepositoryMockListUserRolesExpectation{}
+	}
+
+	if mmListUserRoles.defaultExpectation.paramPtrs != nil {
+		mmListUserRoles.mock.t.Fatalf("RoleRepositoryMock.ListUserRoles mock is already set by ExpectParams functions")
+	}
+
+	mmListUserRoles.defaultExpectation.params = &RoleRepositoryMockListUserRolesParams{ctx, afterID, limit}
+	for _, e := range mmListUserRoles.expectations {
+		if minimock.Equal(e.params, mmListUserRoles.defaultExpectation.params) {
+			mmListUserRoles.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListUserRoles.defaultExpectation.params)
+		}
+	}
+
+	return mmListUserRoles
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.ListUserRoles
+func (mmListUserRoles *mRoleRepositoryMockListUserRoles) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockListUserRoles {
+	if mmListUserRoles.mock.funcListUserRoles != nil {
+		mmListUserRoles.mock.t.Fatalf("RoleRepositoryMock.ListUserRoles mock is already set by Set")
+	}
+
+	if mmListUserRoles.defaultExpectation == nil {
+		mmListUserRoles.defaultExpectation = &RoleRepositoryMockListUserRolesExpectation{}
+	}
+
+	if mmListUserRoles.defaultExpectation.params != nil {
+		mmListUserRoles.mock.t.Fatalf("RoleRepositoryMock.ListUserRoles mock is already set by Expect")
+	}
+
+	if mmListUserRoles.defaultExpectation.paramPtrs == nil {
+		mmListUserRoles.defaultExpectation.paramPtrs = &RoleRepositoryMockListUserRolesParamPtrs{}
+	}
+	mmListUserRoles.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListUserRoles
+}
+
+// ExpectAfterIDParam2 sets up expected param afterID for RoleRepository.ListUserRoles
+func (mmListUserRoles *mRoleRepositoryMockListUserRoles) ExpectAfterIDParam2(afterID int64) *mRoleRepositoryMockListUserRoles {
+	if mmListUserRoles.mock.funcListUserRoles != nil {
+		mmListUserRoles.mock.t.Fatalf("RoleRepositoryMock.ListUserRoles mock is already set by Set")
+	}
+
+	if mmListUserRoles.defaultExpectation == nil {
+		mmListUserRoles.defaultExpectation = &RoleRepositoryMockListUserRolesExpectation{}
+	}
+
+	if mmListUserRoles.defaultExpectation.params != nil {
+		mmListUserRoles.mock.t.Fatalf("RoleRepositoryMock.ListUserRoles mock is already set by Expect")
+	}
+
+	if mmListUserRoles.defaultExpectation.paramPtrs == nil {
+		mmListUserRoles.defaultExpectation.paramPtrs = &RoleRepositoryMockListUserRolesParamPtrs{}
+	}
+	mmListUserRoles.defaultExpectation.paramPtrs.afterID = &afterID
+
+	return mmListUserRoles
+}
+
+// ExpectLimitParam3 sets up expected param limit for RoleRepository.ListUserRoles
+func (mmListUserRoles *mRoleRepositoryMockListUserRoles) ExpectLimitParam3(limit uint64) *mRoleRepositoryMockListUserRoles {
+	if mmListUserRoles.mock.funcListUserRoles != nil {
+		mmListUserRoles.mock.t.Fatalf("RoleRepositoryMock.ListUserRoles mock is already set by Set")
+	}
+
+	if mmListUserRoles.defaultExpectation == nil {
+		mmListUserRoles.defaultExpectation = &RoleRepositoryMockListUserRolesExpectation{}
+	}
+
+	if mmListUserRoles.defaultExpectation.params != nil {
+		mmListUserRoles.mock.t.Fatalf("RoleRepositoryMock.ListUserRoles mock is already set by Expect")
+	}
+
+	if mmListUserRoles.defaultExpectation.paramPtrs == nil {
+		mmListUserRoles.defaultExpectation.paramPtrs = &RoleRepositoryMockListUserRolesParamPtrs{}
+	}
+	mmListUserRoles.defaultExpectation.paramPtrs.limit = &limit
+
+	return mmListUserRoles
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.ListUserRoles
+func (mmListUserRoles *mRoleRepositoryMockListUserRoles) Inspect(f func(ctx context.Context, afterID int64, limit uint64)) *mRoleRepositoryMockListUserRoles {
+	if mmListUserRoles.mock.inspectFuncListUserRoles != nil {
+		mmListUserRoles.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.ListUserRoles")
+	}
+
+	mmListUserRoles.mock.inspectFuncListUserRoles = f
+
+	return mmListUserRoles
+}
+
+// Return sets up results that will be returned by RoleRepository.ListUserRoles
+func (mmListUserRoles *mRoleRepositoryMockListUserRoles) Return(ua1 []model.UserRoles, err error) *RoleRepositoryMock {
+	if mmListUserRoles.mock.funcListUserRoles != nil {
+		mmListUserRoles.mock.t.Fatalf("RoleRepositoryMock.ListUserRoles mock is already set by Set")
+	}
+
+	if mmListUserRoles.defaultExpectation == nil {
+		mmListUserRoles.defaultExpectation = &RoleRepositoryMockListUserRolesExpectation{mock: mmListUserRoles.mock}
+	}
+	mmListUserRoles.defaultExpectation.results = &RoleRepositoryMockListUserRolesResults{ua1, err}
+	return mmListUserRoles.mock
+}
+
+// Set uses given function f to mock the RoleRepository.ListUserRoles method
+func (mmListUserRoles *mRoleRepositoryMockListUserRoles) Set(f func(ctx context.Context, afterID int64, limit uint64) (ua1 []model.UserRoles, err error)) *RoleRepositoryMock {
+	if mmListUserRoles.defaultExpectation != nil {
+		mmListUserRoles.mock.t.Fatalf("Default expectation is already set for the RoleRepository.ListUserRoles method")
+	}
+
+	if len(mmListUserRoles.expectations) > 0 {
+		mmListUserRoles.mock.t.Fatalf("Some expectations are already set for the RoleRepository.ListUserRoles method")
+	}
+
+	mmListUserRoles.mock.funcListUserRoles = f
+	return mmListUserRoles.mock
+}
+
+// When sets expectation for the RoleRepository.ListUserRoles which will trigger the result defined by the following
+// Then helper
+func (mmListUserRoles *mRoleRepositoryMockListUserRoles) When(ctx context.Context, afterID int64, limit uint64) *RoleRepositoryMockListUserRolesExpectation {
+	if mmListUserRoles.mock.funcListUserRoles != nil {
+		mmListUserRoles.mock.t.Fatalf("RoleRepositoryMock.ListUserRoles mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockListUserRolesExpectation{
+		mock:   mmListUserRoles.mock,
+		params: &RoleRepositoryMockListUserRolesParams{ctx, afterID, limit},
+	}
+	mmListUserRoles.expectations = append(mmListUserRoles.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.ListUserRoles return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockListUserRolesExpectation) Then(ua1 []model.UserRoles, err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockListUserRolesResults{ua1, err}
+	return e.mock
+}
+
+// ListUserRoles implements repository.RoleRepository
+func (mmListUserRoles *RoleRepositoryMock) ListUserRoles(ctx context.Context, afterID int64, limit uint64) (ua1 []model.UserRoles, err error) {
+	mm_atomic.AddUint64(&mmListUserRoles.beforeListUserRolesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListUserRoles.afterListUserRolesCounter, 1)
+
+	if mmListUserRoles.inspectFuncListUserRoles != nil {
+		mmListUserRoles.inspectFuncListUserRoles(ctx, afterID, limit)
+	}
+
+	mm_params := RoleRepositoryMockListUserRolesParams{ctx, afterID, limit}
+
+	// Record call args
+	mmListUserRoles.ListUserRolesMock.mutex.Lock()
+	mmListUserRoles.ListUserRolesMock.callArgs = append(mmListUserRoles.ListUserRolesMock.callArgs, &mm_params)
+	mmListUserRoles.ListUserRolesMock.mutex.Unlock()
+
+	for _, e := range mmListUserRoles.ListUserRolesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ua1, e.results.err
+		}
+	}
+
+	if mmListUserRoles.ListUserRolesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListUserRoles.ListUserRolesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListUserRoles.ListUserRolesMock.defaultExpectation.params
+		mm_want_ptrs := mmListUserRoles.ListUserRolesMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockListUserRolesParams{ctx, afterID, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListUserRoles.t.Errorf("RoleRepositoryMock.ListUserRoles got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.afterID != nil && !minimock.Equal(*mm_want_ptrs.afterID, mm_got.afterID) {
+				mmListUserRoles.t.Errorf("RoleRepositoryMock.ListUserRoles got unexpected parameter afterID, want: %#v, got: %#v%s\n", *mm_want_ptrs.afterID, mm_got.afterID, minimock.Diff(*mm_want_ptrs.afterID, mm_got.afterID))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListUserRoles.t.Errorf("RoleRepositoryMock.ListUserRoles got unexpected parameter limit, want: %#v, got: %#v%s\n", *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListUserRoles.t.Errorf("RoleRepositoryMock.ListUserRoles got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListUserRoles.ListUserRolesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListUserRoles.t.Fatal("No results are set for the RoleRepositoryMock.ListUserRoles")
+		}
+		return (*mm_results).ua1, (*mm_results).err
+	}
+	if mmListUserRoles.funcListUserRoles != nil {
+		return mmListUserRoles.funcListUserRoles(ctx, afterID, limit)
+	}
+	mmListUserRoles.t.Fatalf("Unexpected call to RoleRepositoryMock.ListUserRoles. %v %v %v", ctx, afterID, limit)
+	return
+}
+
+// ListUserRolesAfterCounter returns a count of finished RoleRepositoryMock.ListUserRoles invocations
+func (mmListUserRoles *RoleRepositoryMock) ListUserRolesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUserRoles.afterListUserRolesCounter)
+}
+
+// ListUserRolesBeforeCounter returns a count of RoleRepositoryMock.ListUserRoles invocations
+func (mmListUserRoles *RoleRepositoryMock) ListUserRolesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUserRoles.beforeListUserRolesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.ListUserRoles.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListUserRoles *mRoleRepositoryMockListUserRoles) Calls() []*RoleRepositoryMockListUserRolesParams {
+	mmListUserRoles.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockListUserRolesParams, len(mmListUserRoles.callArgs))
+	copy(argCopy, mmListUserRoles.callArgs)
+
+	mmListUserRoles.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListUserRolesDone returns true if the count of the ListUserRoles invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockListUserRolesDone() bool {
+	for _, e := range m.ListUserRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListUserRolesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListUserRolesCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListUserRoles != nil && mm_atomic.LoadUint64(&m.afterListUserRolesCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockListUserRolesInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockListUserRolesInspect() {
+	for _, e := range m.ListUserRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.ListUserRoles with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListUserRolesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListUserRolesCounter) < 1 {
+		if m.ListUserRolesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RoleRepositoryMock.ListUserRoles")
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.ListUserRoles with params: %#v", *m.ListUserRolesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListUserRoles != nil && mm_atomic.LoadUint64(&m.afterListUserRolesCounter) < 1 {
+		m.t.Error("Expected call to RoleRepositoryMock.ListUserRoles")
+	}
+}
+
+type mRoleRepositoryMockUpdate struct {
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockUpdateExpectation
+	expectations       []*RoleRepositoryMockUpdateExpectation
+
+	callArgs []*RoleRepositoryMockUpdateParams
+	mutex    sync.RWMutex
+}
+
+// RoleRepositoryMockUpdateExpectation specifies expectation struct of the RoleRepository.Update
+type RoleRepositoryMockUpdateExpectation struct {
+	mock      *RoleRepositoryMock
+	params    *RoleRepositoryMockUpdateParams
+	paramPtrs *RoleRepositoryMockUpdateParamPtrs
+	results   *RoleRepositoryMockUpdateResults
+	Counter   uint64
+}
+
+// RoleRepositoryMockUpdateParams contains parameters of the RoleRepository.Update
+type RoleRepositoryMockUpdateParams struct {
+	ctx  context.Context
+	role *model.RoleDefinition
+}
+
+// RoleRepositoryMockUpdateParamPtrs contains pointers to parameters of the RoleRepository.Update
+type RoleRepositoryMockUpdateParamPtrs struct {
+	ctx  *context.Context
+	role **model.RoleDefinition
+}
+
+// RoleRepositoryMockUpdateResults contains results of the RoleRepository.Update
+type RoleRepositoryMockUpdateResults struct {
+	err error
+}
+
+// Expect sets up expected params for RoleRepository.Update
+func (mmUpdate *mRoleRepositoryMockUpdate) Expect(ctx context.Context, role *model.RoleDefinition) *mRoleRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("RoleRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &RoleRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs != nil {
+		mmUpdate.mock.t.Fatalf("RoleRepositoryMock.Update mock is already set by ExpectParams functions")
+	}
+
+	mmUpdate.defaultExpectation.params = &RoleRepositoryMockUpdateParams{ctx, role}
+	for _, e := range mmUpdate.expectations {
+		if minimock.Equal(e.params, mmUpdate.defaultExpectation.params) {
+			mmUpdate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdate.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.Update
+func (mmUpdate *mRoleRepositoryMockUpdate) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("RoleRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &RoleRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("RoleRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &RoleRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmUpdate
+}
+
+// ExpectRoleParam2 sets up expected param role for RoleRepository.Update
+func (mmUpdate *mRoleRepositoryMockUpdate) ExpectRoleParam2(role *model.RoleDefinition) *mRoleRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("RoleRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &RoleRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("RoleRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &RoleRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.role = &role
+
+	return mmUpdate
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.Update
+func (mmUpdate *mRoleRepositoryMockUpdate) Inspect(f func(ctx context.Context, role *model.RoleDefinition)) *mRoleRepositoryMockUpdate {
+	if mmUpdate.mock.inspectFuncUpdate != nil {
+		mmUpdate.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.Update")
+	}
+
+	mmUpdate.mock.inspectFuncUpdate = f
+
+	return mmUpdate
+}
+
+// Return sets up results that will be returned by RoleRepository.Update
+func (mmUpdate *mRoleRepositoryMockUpdate) Return(err error) *RoleRepositoryMock {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("RoleRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &RoleRepositoryMockUpdateExpectation{mock: mmUpdate.mock}
+	}
+	mmUpdate.defaultExpectation.results = &RoleRepositoryMockUpdateResults{err}
+	return mmUpdate.mock
+}
+
+// Set uses given function f to mock the RoleRepository.Update method
+func (mmUpdate *mRoleRepositoryMockUpdate) Set(f func(ctx context.Context, role *model.RoleDefinition) (err error)) *RoleRepositoryMock {
+	if mmUpdate.defaultExpectation != nil {
+		mmUpdate.mock.t.Fatalf("Default expectation is already set for the RoleRepository.Update method")
+	}
+
+	if len(mmUpdate.expectations) > 0 {
+		mmUpdate.mock.t.Fatalf("Some expectations are already set for the RoleRepository.Update method")
+	}
+
+	mmUpdate.mock.funcUpdate = f
+	return mmUpdate.mock
+}
+
+// When sets expectation for the RoleRepository.Update which will trigger the result defined by the following
+// Then helper
+func (mmUpdate *mRoleRepositoryMockUpdate) When(ctx context.Context, role *model.RoleDefinition) *RoleRepositoryMockUpdateExpectation {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("RoleRepositoryMock.Update mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockUpdateExpectation{
+		mock:   mmUpdate.mock,
+		params: &RoleRepositoryMockUpdateParams{ctx, role},
+	}
+	mmUpdate.expectations = append(mmUpdate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.Update return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockUpdateExpectation) Then(err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockUpdateResults{err}
+	return e.mock
+}
+
+// Update implements repository.RoleRepository
+func (mmUpdate *RoleRepositoryMock) Update(ctx context.Context, role *model.RoleDefinition) (err error) {
+	mm_atomic.AddUint64(&mmUpdate.beforeUpdateCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdate.afterUpdateCounter, 1)
+
+	if mmUpdate.inspectFuncUpdate != nil {
+		mmUpdate.inspectFuncUpdate(ctx, role)
+	}
+
+	mm_params := RoleRepositoryMockUpdateParams{ctx, role}
+
+	// Record call args
+	mmUpdate.UpdateMock.mutex.Lock()
+	mmUpdate.UpdateMock.callArgs = append(mmUpdate.UpdateMock.callArgs, &mm_params)
+	mmUpdate.UpdateMock.mutex.Unlock()
+
+	for _, e := range mmUpdate.UpdateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdate.UpdateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdate.UpdateMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdate.UpdateMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdate.UpdateMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockUpdateParams{ctx, role}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdate.t.Errorf("RoleRepositoryMock.Update got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmUpdate.t.Errorf("RoleRepositoryMock.Update got unexpected parameter role, want: %#v, got: %#v%s\n", *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdate.t.Errorf("RoleRepositoryMock.Update got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdate.UpdateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdate.t.Fatal("No results are set for the RoleRepositoryMock.Update")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdate.funcUpdate != nil {
+		return mmUpdate.funcUpdate(ctx, role)
+	}
+	mmUpdate.t.Fatalf("Unexpected call to RoleRepositoryMock.Update. %v %v", ctx, role)
+	return
+}
+
+// UpdateAfterCounter returns a count of finished RoleRepositoryMock.Update invocations
+func (mmUpdate *RoleRepositoryMock) UpdateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.afterUpdateCounter)
+}
+
+// UpdateBeforeCounter returns a count of RoleRepositoryMock.Update invocations
+func (mmUpdate *RoleRepositoryMock) UpdateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.beforeUpdateCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.Update.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdate *mRoleRepositoryMockUpdate) Calls() []*RoleRepositoryMockUpdateParams {
+	mmUpdate.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockUpdateParams, len(mmUpdate.callArgs))
+	copy(argCopy, mmUpdate.callArgs)
+
+	mmUpdate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateDone returns true if the count of the Update invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockUpdateDone() bool {
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdate != nil && mm_atomic.LoadUint64(&m.afterUpdateCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockUpdateInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockUpdateInspect() {
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.Update with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateCounter) < 1 {
+		if m.UpdateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RoleRepositoryMock.Update")
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.Update with params: %#v", *m.UpdateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdate != nil && mm_atomic.LoadUint64(&m.afterUpdateCounter) < 1 {
+		m.t.Error("Expected call to RoleRepositoryMock.Update")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RoleRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockDeleteInspect()
+
+			m.MinimockGetExistingInspect()
+
+			m.MinimockGetUserAccessInspect()
+
+			m.MinimockListDefinitionsInspect()
+
+			m.MinimockListEffectiveRolesInspect()
+
+			m.MinimockListUserRolesInspect()
+
+			m.MinimockUpdateInspect()
+			m.t.FailNow()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RoleRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RoleRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockDeleteDone() &&
+		m.MinimockGetExistingDone() &&
+		m.MinimockGetUserAccessDone() &&
+		m.MinimockListDefinitionsDone() &&
+		m.MinimockListEffectiveRolesDone() &&
+		m.MinimockListUserRolesDone() &&
+		m.MinimockUpdateDone()
+}
@@ -0,0 +1,2198 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.8). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/arifullov/auth/internal/repository.RoleRequestRepository -o role_request_repository_minimock.go -n RoleRequestRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RoleRequestRepositoryMock implements repository.RoleRequestRepository
+type RoleRequestRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, req *model.CreateRoleRequest) (i1 int64, err error)
+	inspectFuncCreate   func(ctx context.Context, req *model.CreateRoleRequest)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mRoleRequestRepositoryMockCreate
+
+	funcDecide          func(ctx context.Context, decision *model.RoleRequestDecision) (rp1 *model.RoleRequest, err error)
+	inspectFuncDecide   func(ctx context.Context, decision *model.RoleRequestDecision)
+	afterDecideCounter  uint64
+	beforeDecideCounter uint64
+	DecideMock          mRoleRequestRepositoryMockDecide
+
+	funcDeleteExpiredGrants          func(ctx context.Context, now time.Time) (ea1 []model.ExpiredRoleGrant, err error)
+	inspectFuncDeleteExpiredGrants   func(ctx context.Context, now time.Time)
+	afterDeleteExpiredGrantsCounter  uint64
+	beforeDeleteExpiredGrantsCounter uint64
+	DeleteExpiredGrantsMock          mRoleRequestRepositoryMockDeleteExpiredGrants
+
+	funcExpireRequests          func(ctx context.Context, now time.Time) (ia1 []int64, err error)
+	inspectFuncExpireRequests   func(ctx context.Context, now time.Time)
+	afterExpireRequestsCounter  uint64
+	beforeExpireRequestsCounter uint64
+	ExpireRequestsMock          mRoleRequestRepositoryMockExpireRequests
+
+	funcFind          func(ctx context.Context, filter model.RoleRequestFilter) (ra1 []model.RoleRequest, err error)
+	inspectFuncFind   func(ctx context.Context, filter model.RoleRequestFilter)
+	afterFindCounter  uint64
+	beforeFindCounter uint64
+	FindMock          mRoleRequestRepositoryMockFind
+
+	funcGet          func(ctx context.Context, id int64) (rp1 *model.RoleRequest, err error)
+	inspectFuncGet   func(ctx context.Context, id int64)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mRoleRequestRepositoryMockGet
+
+	funcGrant          func(ctx context.Context, userID int64, role model.Role, expiresAt time.Time) (err error)
+	inspectFuncGrant   func(ctx context.Context, userID int64, role model.Role, expiresAt time.Time)
+	afterGrantCounter  uint64
+	beforeGrantCounter uint64
+	GrantMock          mRoleRequestRepositoryMockGrant
+}
+
+// NewRoleRequestRepositoryMock returns a mock for repository.RoleRequestRepository
+func NewRoleRequestRepositoryMock(t minimock.Tester) *RoleRequestRepositoryMock {
+	m := &RoleRequestRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mRoleRequestRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*RoleRequestRepositoryMockCreateParams{}
+
+	m.DecideMock = mRoleRequestRepositoryMockDecide{mock: m}
+	m.DecideMock.callArgs = []*RoleRequestRepositoryMockDecideParams{}
+
+	m.DeleteExpiredGrantsMock = mRoleRequestRepositoryMockDeleteExpiredGrants{mock: m}
+	m.DeleteExpiredGrantsMock.callArgs = []*RoleRequestRepositoryMockDeleteExpiredGrantsParams{}
+
+	m.ExpireRequestsMock = mRoleRequestRepositoryMockExpireRequests{mock: m}
+	m.ExpireRequestsMock.callArgs = []*RoleRequestRepositoryMockExpireRequestsParams{}
+
+	m.FindMock = mRoleRequestRepositoryMockFind{mock: m}
+	m.FindMock.callArgs = []*RoleRequestRepositoryMockFindParams{}
+
+	m.GetMock = mRoleRequestRepositoryMockGet{mock: m}
+	m.GetMock.callArgs = []*RoleRequestRepositoryMockGetParams{}
+
+	m.GrantMock = mRoleRequestRepositoryMockGrant{mock: m}
+	m.GrantMock.callArgs = []*RoleRequestRepositoryMockGrantParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRoleRequestRepositoryMockCreate struct {
+	mock               *RoleRequestRepositoryMock
+	defaultExpectation *RoleRequestRepositoryMockCreateExpectation
+	expectations       []*RoleRequestRepositoryMockCreateExpectation
+
+	callArgs []*RoleRequestRepositoryMockCreateParams
+	mutex    sync.RWMutex
+}
+
+// RoleRequestRepositoryMockCreateExpectation specifies expectation struct of the RoleRequestRepository.Create
+type RoleRequestRepositoryMockCreateExpectation struct {
+	mock      *RoleRequestRepositoryMock
+	params    *RoleRequestRepositoryMockCreateParams
+	paramPtrs *RoleRequestRepositoryMockCreateParamPtrs
+	results   *RoleRequestRepositoryMockCreateResults
+	Counter   uint64
+}
+
+// RoleRequestRepositoryMockCreateParams contains parameters of the RoleRequestRepository.Create
+type RoleRequestRepositoryMockCreateParams struct {
+	ctx context.Context
+	req *model.CreateRoleRequest
+}
+
+// RoleRequestRepositoryMockCreateParamPtrs contains pointers to parameters of the RoleRequestRepository.Create
+type RoleRequestRepositoryMockCreateParamPtrs struct {
+	ctx *context.Context
+	req **model.CreateRoleRequest
+}
+
+// RoleRequestRepositoryMockCreateResults contains results of the RoleRequestRepository.Create
+type RoleRequestRepositoryMockCreateResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for RoleRequestRepository.Create
+func (mmCreate *mRoleRequestRepositoryMockCreate) Expect(ctx context.Context, req *model.CreateRoleRequest) *mRoleRequestRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RoleRequestRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RoleRequestRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("RoleRequestRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &RoleRequestRepositoryMockCreateParams{ctx, req}
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRequestRepository.Create
+func (mmCreate *mRoleRequestRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mRoleRequestRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RoleRequestRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RoleRequestRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("RoleRequestRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &RoleRequestRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreate
+}
+
+// ExpectReqParam2 sets up expected param req for RoleRequestRepository.Create
+func (mmCreate *mRoleRequestRepositoryMockCreate) ExpectReqParam2(req *model.CreateRoleRequest) *mRoleRequestRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RoleRequestRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RoleRequestRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("RoleRequestRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &RoleRequestRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.req = &req
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRequestRepository.Create
+func (mmCreate *mRoleRequestRepositoryMockCreate) Inspect(f func(ctx context.Context, req *model.CreateRoleRequest)) *mRoleRequestRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for RoleRequestRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by RoleRequestRepository.Create
+func (mmCreate *mRoleRequestRepositoryMockCreate) Return(i1 int64, err error) *RoleRequestRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RoleRequestRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RoleRequestRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &RoleRequestRepositoryMockCreateResults{i1, err}
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the RoleRequestRepository.Create method
+func (mmCreate *mRoleRequestRepositoryMockCreate) Set(f func(ctx context.Context, req *model.CreateRoleRequest) (i1 int64, err error)) *RoleRequestRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the RoleRequestRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the RoleRequestRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	return mmCreate.mock
+}
+
+// When sets expectation for the RoleRequestRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mRoleRequestRepositoryMockCreate) When(ctx context.Context, req *model.CreateRoleRequest) *RoleRequestRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RoleRequestRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &RoleRequestRepositoryMockCreateExpectation{
+		mock:   mmCreate.mock,
+		params: &RoleRequestRepositoryMockCreateParams{ctx, req},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRequestRepository.Create return parameters for the expectation previously defined by the When method
+func (e *RoleRequestRepositoryMockCreateExpectation) Then(i1 int64, err error) *RoleRequestRepositoryMock {
+	e.results = &RoleRequestRepositoryMockCreateResults{i1, err}
+	return e.mock
+}
+
+// Create implements repository.RoleRequestRepository
+func (mmCreate *RoleRequestRepositoryMock) Create(ctx context.Context, req *model.CreateRoleRequest) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, req)
+	}
+
+	mm_params := RoleRequestRepositoryMockCreateParams{ctx, req}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRequestRepositoryMockCreateParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("RoleRequestRepositoryMock.Create got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmCreate.t.Errorf("RoleRequestRepositoryMock.Create got unexpected parameter req, want: %#v, got: %#v%s\n", *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("RoleRequestRepositoryMock.Create got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the RoleRequestRepositoryMock.Create")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, req)
+	}
+	mmCreate.t.Fatalf("Unexpected call to RoleRequestRepositoryMock.Create. %v %v", ctx, req)
+	return
+}
+
+// CreateAfterCounter returns a count of finished RoleRequestRepositoryMock.Create invocations
+func (mmCreate *RoleRequestRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of RoleRequestRepositoryMock.Create invocations
+func (mmCreate *RoleRequestRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRequestRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mRoleRequestRepositoryMockCreate) Calls() []*RoleRequestRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*RoleRequestRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *RoleRequestRepositoryMock) MinimockCreateDone() bool {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *RoleRequestRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRequestRepositoryMock.Create with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RoleRequestRepositoryMock.Create")
+		} else {
+			m.t.Errorf("Expected call to RoleRequestRepositoryMock.Create with params: %#v", *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		m.t.Error("Expected call to RoleRequestRepositoryMock.Create")
+	}
+}
+
+type mRoleRequestRepositoryMockDecide struct {
+	mock               *RoleRequestRepositoryMock
+	defaultExpectation *RoleRequestRepositoryMockDecideExpectation
+	expectations       []*RoleRequestRepositoryMockDecideExpectation
+
+	callArgs []*RoleRequestRepositoryMockDecideParams
+	mutex    sync.RWMutex
+}
+
+// RoleRequestRepositoryMockDecideExpectation specifies expectation struct of the RoleRequestRepository.Decide
+type RoleRequestRepositoryMockDecideExpectation struct {
+	mock      *RoleRequestRepositoryMock
+	params    *RoleRequestRepositoryMockDecideParams
+	paramPtrs *RoleRequestRepositoryMockDecideParamPtrs
+	results   *RoleRequestRepositoryMockDecideResults
+	Counter   uint64
+}
+
+// RoleRequestRepositoryMockDecideParams contains parameters of the RoleRequestRepository.Decide
+type RoleRequestRepositoryMockDecideParams struct {
+	ctx      context.Context
+	decision *model.RoleRequestDecision
+}
+
+// RoleRequestRepositoryMockDecideParamPtrs contains pointers to parameters of the RoleRequestRepository.Decide
+type RoleRequestRepositoryMockDecideParamPtrs struct {
+	ctx      *context.Context
+	decision **model.RoleRequestDecision
+}
+
+// RoleRequestRepositoryMockDecideResults contains results of the RoleRequestRepository.Decide
+type RoleRequestRepositoryMockDecideResults struct {
+	rp1 *model.RoleRequest
+	err error
+}
+
+// Expect sets up expected params for RoleRequestRepository.Decide
+func (mmDecide *mRoleRequestRepositoryMockDecide) Expect(ctx context.Context, decision *model.RoleRequestDecision) *mRoleRequestRepositoryMockDecide {
+	if mmDecide.mock.funcDecide != nil {
+		mmDecide.mock.t.Fatalf("RoleRequestRepositoryMock.Decide mock is already set by Set")
+	}
+
+	if mmDecide.defaultExpectation == nil {
+		mmDecide.defaultExpectation = &RoleRequestRepositoryMockDecideExpectation{}
+	}
+
+	if mmDecide.defaultExpectation.paramPtrs != nil {
+		mmDecide.mock.t.Fatalf("RoleRequestRepositoryMock.Decide mock is already set by ExpectParams functions")
+	}
+
+	mmDecide.defaultExpectation.params = &RoleRequestRepositoryMockDecideParams{ctx, decision}
+	for _, e := range mmDecide.expectations {
+		if minimock.Equal(e.params, mmDecide.defaultExpectation.params) {
+			mmDecide.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDecide.defaultExpectation.params)
+		}
+	}
+
+	return mmDecide
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRequestRepository.Decide
+func (mmDecide *mRoleRequestRepositoryMockDecide) ExpectCtxParam1(ctx context.Context) *mRoleRequestRepositoryMockDecide {
+	if mmDecide.mock.funcDecide != nil {
+		mmDecide.mock.t.Fatalf("RoleRequestRepositoryMock.Decide mock is already set by Set")
+	}
+
+	if mmDecide.defaultExpectation == nil {
+		mmDecide.defaultExpectation = &RoleRequestRepositoryMockDecideExpectation{}
+	}
+
+	if mmDecide.defaultExpectation.params != nil {
+		mmDecide.mock.t.Fatalf("RoleRequestRepositoryMock.Decide mock is already set by Expect")
+	}
+
+	if mmDecide.defaultExpectation.paramPtrs == nil {
+		mmDecide.defaultExpectation.paramPtrs = &RoleRequestRepositoryMockDecideParamPtrs{}
+	}
+	mmDecide.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDecide
+}
+
+// ExpectDecisionParam2 sets up expected param decision for RoleRequestRepository.Decide
+func (mmDecide *mRoleRequestRepositoryMockDecide) ExpectDecisionParam2(decision *model.RoleRequestDecision) *mRoleRequestRepositoryMockDecide {
+	if mmDecide.mock.funcDecide != nil {
+		mmDecide.mock.t.Fatalf("RoleRequestRepositoryMock.Decide mock is already set by Set")
+	}
+
+	if mmDecide.defaultExpectation == nil {
+		mmDecide.defaultExpectation = &RoleRequestRepositoryMockDecideExpectation{}
+	}
+
+	if mmDecide.defaultExpectation.params != nil {
+		mmDecide.mock.t.Fatalf("RoleRequestRepositoryMock.Decide mock is already set by Expect")
+	}
+
+	if mmDecide.defaultExpectation.paramPtrs == nil {
+		mmDecide.defaultExpectation.paramPtrs = &RoleRequestRepositoryMockDecideParamPtrs{}
+	}
+	mmDecide.defaultExpectation.paramPtrs.decision = &decision
+
+	return mmDecide
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRequestRepository.Decide
+func (mmDecide *mRoleRequestRepositoryMockDecide) Inspect(f func(ctx context.Context, decision *model.RoleRequestDecision)) *mRoleRequestRepositoryMockDecide {
+	if mmDecide.mock.inspectFuncDecide != nil {
+		mmDecide.mock.t.Fatalf("Inspect function is already set for RoleRequestRepositoryMock.Decide")
+	}
+
+	mmDecide.mock.inspectFuncDecide = f
+
+	return mmDecide
+}
+
+// Return sets up results that will be returned by RoleRequestRepository.Decide
+func (mmDecide *mRoleRequestRepositoryMockDecide) Return(rp1 *model.RoleRequest, err error) *RoleRequestRepositoryMock {
+	if mmDecide.mock.funcDecide != nil {
+		mmDecide.mock.t.Fatalf("RoleRequestRepositoryMock.Decide mock is already set by Set")
+	}
+
+	if mmDecide.defaultExpectation == nil {
+		mmDecide.defaultExpectation = &RoleRequestRepositoryMockDecideExpectation{mock: mmDecide.mock}
+	}
+	mmDecide.defaultExpectation.results = &RoleRequestRepositoryMockDecideResults{rp1, err}
+	return mmDecide.mock
+}
+
+// Set uses given function f to mock the RoleRequestRepository.Decide method
+func (mmDecide *mRoleRequestRepositoryMockDecide) Set(f func(ctx context.Context, decision *model.RoleRequestDecision) (rp1 *model.RoleRequest, err error)) *RoleRequestRepositoryMock {
+	if mmDecide.defaultExpectation != nil {
+		mmDecide.mock.t.Fatalf("Default expectation is already set for the RoleRequestRepository.Decide method")
+	}
+
+	if len(mmDecide.expectations) > 0 {
+		mmDecide.mock.t.Fatalf("Some expectations are already set for the RoleRequestRepository.Decide method")
+	}
+
+	mmDecide.mock.funcDecide = f
+	return mmDecide.mock
+}
+
+// When sets expectation for the RoleRequestRepository.Decide which will trigger the result defined by the following
+// Then helper
+func (mmDecide *mRoleRequestRepositoryMockDecide) When(ctx context.Context, decision *model.RoleRequestDecision) *RoleRequestRepositoryMockDecideExpectation {
+	if mmDecide.mock.funcDecide != nil {
+		mmDecide.mock.t.Fatalf("RoleRequestRepositoryMock.Decide mock is already set by Set")
+	}
+
+	expectation := &RoleRequestRepositoryMockDecideExpectation{
+		mock:   mmDecide.mock,
+		params: &RoleRequestRepositoryMockDecideParams{ctx, decision},
+	}
+	mmDecide.expectations = append(mmDecide.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRequestRepository.Decide return parameters for the expectation previously defined by the When method
+func (e *RoleRequestRepositoryMockDecideExpectation) Then(rp1 *model.RoleRequest, err error) *RoleRequestRepositoryMock {
+	e.results = &RoleRequestRepositoryMockDecideResults{rp1, err}
+	return e.mock
+}
+
+// Decide implements repository.RoleRequestRepository
+func (mmDecide *RoleRequestRepositoryMock) Decide(ctx context.Context, decision *model.RoleRequestDecision) (rp1 *model.RoleRequest, err error) {
+	mm_atomic.AddUint64(&mmDecide.beforeDecideCounter, 1)
+	defer mm_atomic.AddUint64(&mmDecide.afterDecideCounter, 1)
+
+	if mmDecide.inspectFuncDecide != nil {
+		mmDecide.inspectFuncDecide(ctx, decision)
+	}
+
+	mm_params := RoleRequestRepositoryMockDecideParams{ctx, decision}
+
+	// Record call args
+	mmDecide.DecideMock.mutex.Lock()
+	mmDecide.DecideMock.callArgs = append(mmDecide.DecideMock.callArgs, &mm_params)
+	mmDecide.DecideMock.mutex.Unlock()
+
+	for _, e := range mmDecide.DecideMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmDecide.DecideMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDecide.DecideMock.defaultExpectation.Counter, 1)
+		mm_want := mmDecide.DecideMock.defaultExpectation.params
+		mm_want_ptrs := mmDecide.DecideMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRequestRepositoryMockDecideParams{ctx, decision}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDecide.t.Errorf("RoleRequestRepositoryMock.Decide got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.decision != nil && !minimock.Equal(*mm_want_ptrs.decision, mm_got.decision) {
+				mmDecide.t.Errorf("RoleRequestRepositoryMock.Decide got unexpected parameter decision, want: %#v, got: %#v%s\n", *mm_want_ptrs.decision, mm_got.decision, minimock.Diff(*mm_want_ptrs.decision, mm_got.decision))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDecide.t.Errorf("RoleRequestRepositoryMock.Decide got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDecide.DecideMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDecide.t.Fatal("No results are set for the RoleRequestRepositoryMock.Decide")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmDecide.funcDecide != nil {
+		return mmDecide.funcDecide(ctx, decision)
+	}
+	mmDecide.t.Fatalf("Unexpected call to RoleRequestRepositoryMock.Decide. %v %v", ctx, decision)
+	return
+}
+
+// DecideAfterCounter returns a count of finished RoleRequestRepositoryMock.Decide invocations
+func (mmDecide *RoleRequestRepositoryMock) DecideAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDecide.afterDecideCounter)
+}
+
+// DecideBeforeCounter returns a count of RoleRequestRepositoryMock.Decide invocations
+func (mmDecide *RoleRequestRepositoryMock) DecideBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDecide.beforeDecideCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRequestRepositoryMock.Decide.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDecide *mRoleRequestRepositoryMockDecide) Calls() []*RoleRequestRepositoryMockDecideParams {
+	mmDecide.mutex.RLock()
+
+	argCopy := make([]*RoleRequestRepositoryMockDecideParams, len(mmDecide.callArgs))
+	copy(argCopy, mmDecide.callArgs)
+
+	mmDecide.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDecideDone returns true if the count of the Decide invocations corresponds
+// the number of defined expectations
+func (m *RoleRequestRepositoryMock) MinimockDecideDone() bool {
+	for _, e := range m.DecideMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DecideMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDecideCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDecide != nil && mm_atomic.LoadUint64(&m.afterDecideCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockDecideInspect logs each unmet expectation
+func (m *RoleRequestRepositoryMock) MinimockDecideInspect() {
+	for _, e := range m.DecideMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRequestRepositoryMock.Decide with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DecideMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDecideCounter) < 1 {
+		if m.DecideMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RoleRequestRepositoryMock.Decide")
+		} else {
+			m.t.Errorf("Expected call to RoleRequestRepositoryMock.Decide with params: %#v", *m.DecideMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDecide != nil && mm_atomic.LoadUint64(&m.afterDecideCounter) < 1 {
+		m.t.Error("Expected call to RoleRequestRepositoryMock.Decide")
+	}
+}
+
+type mRoleRequestRepositoryMockDeleteExpiredGrants struct {
+	mock               *RoleRequestRepositoryMock
+	defaultExpectation *RoleRequestRepositoryMockDeleteExpiredGrantsExpectation
+	expectations       []*RoleRequestRepositoryMockDeleteExpiredGrantsExpectation
+
+	callArgs []*RoleRequestRepositoryMockDeleteExpiredGrantsParams
+	mutex    sync.RWMutex
+}
+
+// RoleRequestRepositoryMockDeleteExpiredGrantsExpectation specifies expectation struct of the RoleRequestRepository.DeleteExpiredGrants
+type RoleRequestRepositoryMockDeleteExpiredGrantsExpectation struct {
+	mock      *RoleRequestRepositoryMock
+	params    *RoleRequestRepositoryMockDeleteExpiredGrantsParams
+	paramPtrs *RoleRequestRepositoryMockDeleteExpiredGrantsParamPtrs
+	results   *RoleRequestRepositoryMockDeleteExpiredGrantsResults
+	Counter   uint64
+}
+
+// RoleRequestRepositoryMockDeleteExpiredGrantsParams contains parameters of the RoleRequestRepository.DeleteExpiredGrants
+type RoleRequestRepositoryMockDeleteExpiredGrantsParams struct {
+	ctx context.Context
+	now time.Time
+}
+
+// RoleRequestRepositoryMockDeleteExpiredGrantsParamPtrs contains pointers to parameters of the RoleRequestRepository.DeleteExpiredGrants
+type RoleRequestRepositoryMockDeleteExpiredGrantsParamPtrs struct {
+	ctx *context.Context
+	now *time.Time
+}
+
+// RoleRequestRepositoryMockDeleteExpiredGrantsResults contains results of the RoleRequestRepository.DeleteExpiredGrants
+type RoleRequestRepositoryMockDeleteExpiredGrantsResults struct {
+	ea1 []model.ExpiredRoleGrant
+	err error
+}
+
+// Expect sets up expected params for RoleRequestRepository.DeleteExpiredGrants
+func (mmDeleteExpiredGrants *mRoleRequestRepositoryMockDeleteExpiredGrants) Expect(ctx context.Context, now time.Time) *mRoleRequestRepositoryMockDeleteExpiredGrants {
+	if mmDeleteExpiredGrants.mock.funcDeleteExpiredGrants != nil {
+		mmDeleteExpiredGrants.mock.t.Fatalf("RoleRequestRepositoryMock.DeleteExpiredGrants mock is already set by Set")
+	}
+
+	if mmDeleteExpiredGrants.defaultExpectation == nil {
+		mmDeleteExpiredGrants.defaultExpectation = &RoleRequestRepositoryMockDeleteExpiredGrantsExpectation{}
+	}
+
+	if mmDeleteExpiredGrants.defaultExpectation.paramPtrs != nil {
+		mmDeleteExpiredGrants.mock.t.Fatalf("RoleRequestRepositoryMock.DeleteExpiredGrants mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteExpiredGrants.defaultExpectation.params = &RoleRequestRepositoryMockDeleteExpiredGrantsParams{ctx, now}
+	for _, e := range mmDeleteExpiredGrants.expectations {
+		if minimock.Equal(e.params, mmDeleteExpiredGrants.defaultExpectation.params) {
+			mmDeleteExpiredGrants.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteExpiredGrants.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteExpiredGrants
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRequestRepository.DeleteExpiredGrants
+func (mmDeleteExpiredGrants *mRoleRequestRepositoryMockDeleteExpiredGrants) ExpectCtxParam1(ctx context.Context) *mRoleRequestRepositoryMockDeleteExpiredGrants {
+	if mmDeleteExpiredGrants.mock.funcDeleteExpiredGrants != nil {
+		mmDeleteExpiredGrants.mock.t.Fatalf("RoleRequestRepositoryMock.DeleteExpiredGrants mock is already set by Set")
+	}
+
+	if mmDeleteExpiredGrants.defaultExpectation == nil {
+		mmDeleteExpiredGrants.defaultExpectation = &RoleRequestRepositoryMockDeleteExpiredGrantsExpectation{}
+	}
+
+	if mmDeleteExpiredGrants.defaultExpectation.params != nil {
+		mmDeleteExpiredGrants.mock.t.Fatalf("RoleRequestRepositoryMock.DeleteExpiredGrants mock is already set by Expect")
+	}
+
+	if mmDeleteExpiredGrants.defaultExpectation.paramPtrs == nil {
+		mmDeleteExpiredGrants.defaultExpectation.paramPtrs = &RoleRequestRepositoryMockDeleteExpiredGrantsParamPtrs{}
+	}
+	mmDeleteExpiredGrants.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeleteExpiredGrants
+}
+
+// ExpectNowParam2 sets up expected param now for RoleRequestRepository.DeleteExpiredGrants
+func (mmDeleteExpiredGrants *mRoleRequestRepositoryMockDeleteExpiredGrants) ExpectNowParam2(now time.Time) *mRoleRequestRepositoryMockDeleteExpiredGrants {
+	if mmDeleteExpiredGrants.mock.funcDeleteExpiredGrants != nil {
+		mmDeleteExpiredGrants.mock.t.Fatalf("RoleRequestRepositoryMock.DeleteExpiredGrants mock is already set by Set")
+	}
+
+	if mmDeleteExpiredGrants.defaultExpectation == nil {
+		mmDeleteExpiredGrants.defaultExpectation = &RoleRequestRepositoryMockDeleteExpiredGrantsExpectation{}
+	}
+
+	if mmDeleteExpiredGrants.defaultExpectation.params != nil {
+		mmDeleteExpiredGrants.mock.t.Fatalf("RoleRequestRepositoryMock.DeleteExpiredGrants mock is already set by Expect")
+	}
+
+	if mmDeleteExpiredGrants.defaultExpectation.paramPtrs == nil {
+		mmDeleteExpiredGrants.defaultExpectation.paramPtrs = &RoleRequestRepositoryMockDeleteExpiredGrantsParamPtrs{}
+	}
+	mmDeleteExpiredGrants.defaultExpectation.paramPtrs.now = &now
+
+	return mmDeleteExpiredGrants
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRequestRepository.DeleteExpiredGrants
+func (mmDeleteExpiredGrants *mRoleRequestRepositoryMockDeleteExpiredGrants) Inspect(f func(ctx context.Context, now time.Time)) *mRoleRequestRepositoryMockDeleteExpiredGrants {
+	if mmDeleteExpiredGrants.mock.inspectFuncDeleteExpiredGrants != nil {
+		mmDeleteExpiredGrants.mock.t.Fatalf("Inspect function is already set for RoleRequestRepositoryMock.DeleteExpiredGrants")
+	}
+
+	mmDeleteExpiredGrants.mock.inspectFuncDeleteExpiredGrants = f
+
+	return mmDeleteExpiredGrants
+}
+
+// Return sets up results that will be returned by RoleRequestRepository.DeleteExpiredGrants
+func (mmDeleteExpiredGrants *mRoleRequestRepositoryMockDeleteExpiredGrants) Return(ea1 []model.ExpiredRoleGrant, err error) *RoleRequestRepositoryMock {
+	if mmDeleteExpiredGrants.mock.funcDeleteExpiredGrants != nil {
+		mmDeleteExpiredGrants.mock.t.Fatalf("RoleRequestRepositoryMock.DeleteExpiredGrants mock is already set by Set")
+	}
+
+	if mmDeleteExpiredGrants.defaultExpectation == nil {
+		mmDeleteExpiredGrants.defaultExpectation = &RoleRequestRepositoryMockDeleteExpiredGrantsExpectation{mock: mmDeleteExpiredGrants.mock}
+	}
+	mmDeleteExpiredGrants.defaultExpectation.results = &RoleRequestRepositoryMockDeleteExpiredGrantsResults{ea1, err}
+	return mmDeleteExpiredGrants.mock
+}
+
+// Set uses given function f to mock the RoleRequestRepository.DeleteExpiredGrants method
+func (mmDeleteExpiredGrants *mRoleRequestRepositoryMockDeleteExpiredGrants) Set(f func(ctx context.Context, now time.Time) (ea1 []model.ExpiredRoleGrant, err error)) *RoleRequestRepositoryMock {
+	if mmDeleteExpiredGrants.defaultExpectation != nil {
+		mmDeleteExpiredGrants.mock.t.Fatalf("Default expectation is already set for the RoleRequestRepository.DeleteExpiredGrants method")
+	}
+
+	if len(mmDeleteExpiredGrants.expectations) > 0 {
+		mmDeleteExpiredGrants.mock.t.Fatalf("Some expectations are already set for the RoleRequestRepository.DeleteExpiredGrants method")
+	}
+
+	mmDeleteExpiredGrants.mock.funcDeleteExpiredGrants = f
+	return mmDeleteExpiredGrants.mock
+}
+
+// When sets expectation for the RoleRequestRepository.DeleteExpiredGrants which will trigger the result defined by the following
+// Then helper
+func (mmDeleteExpiredGrants *mRoleRequestRepositoryMockDeleteExpiredGrants) When(ctx context.Context, now time.Time) *RoleRequestRepositoryMockDeleteExpiredGrantsExpectation {
+	if mmDeleteExpiredGrants.mock.funcDeleteExpiredGrants != nil {
+		mmDeleteExpiredGrants.mock.t.Fatalf("RoleRequestRepositoryMock.DeleteExpiredGrants mock is already set by Set")
+	}
+
+	expectation := &RoleRequestRepositoryMockDeleteExpiredGrantsExpectation{
+		mock:   mmDeleteExpiredGrants.mock,
+		params: &RoleRequestRepositoryMockDeleteExpiredGrantsParams{ctx, now},
+	}
+	mmDeleteExpiredGrants.expectations = append(mmDeleteExpiredGrants.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRequestRepository.DeleteExpiredGrants return parameters for the expectation previously defined by the When method
+func (e *RoleRequestRepositoryMockDeleteExpiredGrantsExpectation) Then(ea1 []model.ExpiredRoleGrant, err error) *RoleRequestRepositoryMock {
+	e.results = &RoleRequestRepositoryMockDeleteExpiredGrantsResults{ea1, err}
+	return e.mock
+}
+
+// DeleteExpiredGrants implements repository.RoleRequestRepository
+func (mmDeleteExpiredGrants *RoleRequestRepositoryMock) DeleteExpiredGrants(ctx context.Context, now time.Time) (ea1 []model.ExpiredRoleGrant, err error) {
+	mm_atomic.AddUint64(&mmDeleteExpiredGrants.beforeDeleteExpiredGrantsCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteExpiredGrants.afterDeleteExpiredGrantsCounter, 1)
+
+	if mmDeleteExpiredGrants.inspectFuncDeleteExpiredGrants != nil {
+		mmDeleteExpiredGrants.inspectFuncDeleteExpiredGrants(ctx, now)
+	}
+
+	mm_params := RoleRequestRepositoryMockDeleteExpiredGrantsParams{ctx, now}
+
+	// Record call args
+	mmDeleteExpiredGrants.DeleteExpiredGrantsMock.mutex.Lock()
+	mmDeleteExpiredGrants.DeleteExpiredGrantsMock.callArgs = append(mmDeleteExpiredGrants.DeleteExpiredGrantsMock.callArgs, &mm_params)
+	mmDeleteExpiredGrants.DeleteExpiredGrantsMock.mutex.Unlock()
+
+	for _, e := range mmDeleteExpiredGrants.DeleteExpiredGrantsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ea1, e.results.err
+		}
+	}
+
+	if mmDeleteExpiredGrants.DeleteExpiredGrantsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteExpiredGrants.DeleteExpiredGrantsMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteExpiredGrants.DeleteExpiredGrantsMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteExpiredGrants.DeleteExpiredGrantsMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRequestRepositoryMockDeleteExpiredGrantsParams{ctx, now}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteExpiredGrants.t.Errorf("RoleRequestRepositoryMock.DeleteExpiredGrants got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.now != nil && !minimock.Equal(*mm_want_ptrs.now, mm_got.now) {
+				mmDeleteExpiredGrants.t.Errorf("RoleRequestRepositoryMock.DeleteExpiredGrants got unexpected parameter now, want: %#v, got: %#v%s\n", *mm_want_ptrs.now, mm_got.now, minimock.Diff(*mm_want_ptrs.now, mm_got.now))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteExpiredGrants.t.Errorf("RoleRequestRepositoryMock.DeleteExpiredGrants got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteExpiredGrants.DeleteExpiredGrantsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteExpiredGrants.t.Fatal("No results are set for the RoleRequestRepositoryMock.DeleteExpiredGrants")
+		}
+		return (*mm_results).ea1, (*mm_results).err
+	}
+	if mmDeleteExpiredGrants.funcDeleteExpiredGrants != nil {
+		return mmDeleteExpiredGrants.funcDeleteExpiredGrants(ctx, now)
+	}
+	mmDeleteExpiredGrants.t.Fatalf("Unexpected call to RoleRequestRepositoryMock.DeleteExpiredGrants. %v %v", ctx, now)
+	return
+}
+
+// DeleteExpiredGrantsAfterCounter returns a count of finished RoleRequestRepositoryMock.DeleteExpiredGrants invocations
+func (mmDeleteExpiredGrants *RoleRequestRepositoryMock) DeleteExpiredGrantsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteExpiredGrants.afterDeleteExpiredGrantsCounter)
+}
+
+// DeleteExpiredGrantsBeforeCounter returns a count of RoleRequestRepositoryMock.DeleteExpiredGrants invocations
+func (mmDeleteExpiredGrants *RoleRequestRepositoryMock) DeleteExpiredGrantsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteExpiredGrants.beforeDeleteExpiredGrantsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRequestRepositoryMock.DeleteExpiredGrants.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteExpiredGrants *mRoleRequestRepositoryMockDeleteExpiredGrants) Calls() []*RoleRequestRepositoryMockDeleteExpiredGrantsParams {
+	mmDeleteExpiredGrants.mutex.RLock()
+
+	argCopy := make([]*RoleRequestRepositoryMockDeleteExpiredGrantsParams, len(mmDeleteExpiredGrants.callArgs))
+	copy(argCopy, mmDeleteExpiredGrants.callArgs)
+
+	mmDeleteExpiredGrants.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteExpiredGrantsDone returns true if the count of the DeleteExpiredGrants invocations corresponds
+// the number of defined expectations
+func (m *RoleRequestRepositoryMock) MinimockDeleteExpiredGrantsDone() bool {
+	for _, e := range m.DeleteExpiredGrantsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteExpiredGrantsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteExpiredGrantsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteExpiredGrants != nil && mm_atomic.LoadUint64(&m.afterDeleteExpiredGrantsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockDeleteExpiredGrantsInspect logs each unmet expectation
+func (m *RoleRequestRepositoryMock) MinimockDeleteExpiredGrantsInspect() {
+	for _, e := range m.DeleteExpiredGrantsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRequestRepositoryMock.DeleteExpiredGrants with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteExpiredGrantsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteExpiredGrantsCounter) < 1 {
+		if m.DeleteExpiredGrantsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RoleRequestRepositoryMock.DeleteExpiredGrants")
+		} else {
+			m.t.Errorf("Expected call to RoleRequestRepositoryMock.DeleteExpiredGrants with params: %#v", *m.DeleteExpiredGrantsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteExpiredGrants != nil && mm_atomic.LoadUint64(&m.afterDeleteExpiredGrantsCounter) < 1 {
+		m.t.Error("Expected call to RoleRequestRepositoryMock.DeleteExpiredGrants")
+	}
+}
+
+type mRoleRequestRepositoryMockExpireRequests struct {
+	mock               *RoleRequestRepositoryMock
+	defaultExpectation *RoleRequestRepositoryMockExpireRequestsExpectation
+	expectations       []*RoleRequestRepositoryMockExpireRequestsExpectation
+
+	callArgs []*RoleRequestRepositoryMockExpireRequestsParams
+	mutex    sync.RWMutex
+}
+
+// RoleRequestRepositoryMockExpireRequestsExpectation specifies expectation struct of the RoleRequestRepository.ExpireRequests
+type RoleRequestRepositoryMockExpireRequestsExpectation struct {
+	mock      *RoleRequestRepositoryMock
+	params    *RoleRequestRepositoryMockExpireRequestsParams
+	paramPtrs *RoleRequestRepositoryMockExpireRequestsParamPtrs
+	results   *RoleRequestRepositoryMockExpireRequestsResults
+	Counter   uint64
+}
+
+// RoleRequestRepositoryMockExpireRequestsParams contains parameters of the RoleRequestRepository.ExpireRequests
+type RoleRequestRepositoryMockExpireRequestsParams struct {
+	ctx context.Context
+	now time.Time
+}
+
+// RoleRequestRepositoryMockExpireRequestsParamPtrs contains pointers to parameters of the RoleRequestRepository.ExpireRequests
+type RoleRequestRepositoryMockExpireRequestsParamPtrs struct {
+	ctx *context.Context
+	now *time.Time
+}
+
+// RoleRequestRepositoryMockExpireRequestsResults contains results of the RoleRequestRepository.ExpireRequests
+type RoleRequestRepositoryMockExpireRequestsResults struct {
+	ia1 []int64
+	err error
+}
+
+// Expect sets up expected params for RoleRequestRepository.ExpireRequests
+func (mmExpireRequests *mRoleRequestRepositoryMockExpireRequests) Expect(ctx context.Context, now time.Time) *mRoleRequestRepositoryMockExpireRequests {
+	if mmExpireRequests.mock.funcExpireRequests != nil {
+		mmExpireRequests.mock.t.Fatalf("RoleRequestRepositoryMock.ExpireRequests mock is already set by Set")
+	}
+
+	if mmExpireRequests.defaultExpectation == nil {
+		mmExpireRequests.defaultExpectation = &RoleRequestRepositoryMockExpireRequestsExpectation{}
+	}
+
+	if mmExpireRequests.defaultExpectation.paramPtrs != nil {
+		mmExpireRequests.mock.t.Fatalf("RoleRequestRepositoryMock.ExpireRequests mock is already set by ExpectParams functions")
+	}
+
+	mmExpireRequests.defaultExpectation.params = &RoleRequestRepositoryMockExpireRequestsParams{ctx, now}
+	for _, e := range mmExpireRequests.expectations {
+		if minimock.Equal(e.params, mmExpireRequests.defaultExpectation.params) {
+			mmExpireRequests.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExpireRequests.defaultExpectation.params)
+		}
+	}
+
+	return mmExpireRequests
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRequestRepository.ExpireRequests
+func (mmExpireRequests *mRoleRequestRepositoryMockExpireRequests) ExpectCtxParam1(ctx context.Context) *mRoleRequestRepositoryMockExpireRequests {
+	if mmExpireRequests.mock.funcExpireRequests != nil {
+		mmExpireRequests.mock.t.Fatalf("RoleRequestRepositoryMock.ExpireRequests mock is already set by Set")
+	}
+
+	if mmExpireRequests.defaultExpectation == nil {
+		mmExpireRequests.defaultExpectation = &RoleRequestRepositoryMockExpireRequestsExpectation{}
+	}
+
+	if mmExpireRequests.defaultExpectation.params != nil {
+		mmExpireRequests.mock.t.Fatalf("RoleRequestRepositoryMock.ExpireRequests mock is already set by Expect")
+	}
+
+	if mmExpireRequests.defaultExpectation.paramPtrs == nil {
+		mmExpireRequests.defaultExpectation.paramPtrs = &RoleRequestRepositoryMockExpireRequestsParamPtrs{}
+	}
+	mmExpireRequests.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmExpireRequests
+}
+
+// ExpectNowParam2 sets up expected param now for RoleRequestRepository.ExpireRequests
+func (mmExpireRequests *mRoleRequestRepositoryMockExpireRequests) ExpectNowParam2(now time.Time) *mRoleRequestRepositoryMockExpireRequests {
+	if mmExpireRequests.mock.funcExpireRequests != nil {
+		mmExpireRequests.mock.t.Fatalf("RoleRequestRepositoryMock.ExpireRequests mock is already set by Set")
+	}
+
+	if mmExpireRequests.defaultExpectation == nil {
+		mmExpireRequests.defaultExpectation = &RoleRequestRepositoryMockExpireRequestsExpectation{}
+	}
+
+	if mmExpireRequests.defaultExpectation.params != nil {
+		mmExpireRequests.mock.t.Fatalf("RoleRequestRepositoryMock.ExpireRequests mock is already set by Expect")
+	}
+
+	if mmExpireRequests.defaultExpectation.paramPtrs == nil {
+		mmExpireRequests.defaultExpectation.paramPtrs = &RoleRequestRepositoryMockExpireRequestsParamPtrs{}
+	}
+	mmExpireRequests.defaultExpectation.paramPtrs.now = &now
+
+	return mmExpireRequests
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRequestRepository.ExpireRequests
+func (mmExpireRequests *mRoleRequestRepositoryMockExpireRequests) Inspect(f func(ctx context.Context, now time.Time)) *mRoleRequestRepositoryMockExpireRequests {
+	if mmExpireRequests.mock.inspectFuncExpireRequests != nil {
+		mmExpireRequests.mock.t.Fatalf("Inspect function is already set for RoleRequestRepositoryMock.ExpireRequests")
+	}
+
+	mmExpireRequests.mock.inspectFuncExpireRequests = f
+
+	return mmExpireRequests
+}
+
+// Return sets up results that will be returned by RoleRequestRepository.ExpireRequests
+func (mmExpireRequests *mRoleRequestRepositoryMockExpireRequests) Return(ia1 []int64, err error) *RoleRequestRepositoryMock {
+	if mmExpireRequests.mock.funcExpireRequests != nil {
+		mmExpireRequests.mock.t.Fatalf("RoleRequestRepositoryMock.ExpireRequests mock is already set by Set")
+	}
+
+	if mmExpireRequests.defaultExpectation == nil {
+		mmExpireRequests.defaultExpectation = &RoleRequestRepositoryMockExpireRequestsExpectation{mock: mmExpireRequests.mock}
+	}
+	mmExpireRequests.defaultExpectation.results = &RoleRequestRepositoryMockExpireRequestsResults{ia1, err}
+	return mmExpireRequests.mock
+}
+
+// Set uses given function f to mock the RoleRequestRepository.ExpireRequests method
+func (mmExpireRequests *mRoleRequestRepositoryMockExpireRequests) Set(f func(ctx context.Context, now time.Time) (ia1 []int64, err error)) *RoleRequestRepositoryMock {
+	if mmExpireRequests.defaultExpectation != nil {
+		mmExpireRequests.mock.t.Fatalf("Default expectation is already set for the RoleRequestRepository.ExpireRequests method")
+	}
+
+	if len(mmExpireRequests.expectations) > 0 {
+		mmExpireRequests.mock.t.Fatalf("Some expectations are already set for the RoleRequestRepository.ExpireRequests method")
+	}
+
+	mmExpireRequests.mock.funcExpireRequests = f
+	return mmExpireRequests.mock
+}
+
+// When sets expectation for the RoleRequestRepository.ExpireRequests which will trigger the result defined by the following
+// Then helper
+func (mmExpireRequests *mRoleRequestRepositoryMockExpireRequests) When(ctx context.Context, now time.Time) *RoleRequestRepositoryMockExpireRequestsExpectation {
+	if mmExpireRequests.mock.funcExpireRequests != nil {
+		mmExpireRequests.mock.t.Fatalf("RoleRequestRepositoryMock.ExpireRequests mock is already set by Set")
+	}
+
+	expectation := &RoleRequestRepositoryMockExpireRequestsExpectation{
+		mock:   mmExpireRequests.mock,
+		params: &RoleRequestRepositoryMockExpireRequestsParams{ctx, now},
+	}
+	mmExpireRequests.expectations = append(mmExpireRequests.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRequestRepository.ExpireRequests return parameters for the expectation previously defined by the When method
+func (e *RoleRequestRepositoryMockExpireRequestsExpectation) Then(ia1 []int64, err error) *RoleRequestRepositoryMock {
+	e.results = &RoleRequestRepositoryMockExpireRequestsResults{ia1, err}
+	return e.mock
+}
+
+// ExpireRequests implements repository.RoleRequestRepository
+func (mmExpireRequests *RoleRequestRepositoryMock) ExpireRequests(ctx context.Context, now time.Time) (ia1 []int64, err error) {
+	mm_atomic.AddUint64(&mmExpireRequests.beforeExpireRequestsCounter, 1)
+	defer mm_atomic.AddUint64(&mmExpireRequests.afterExpireRequestsCounter, 1)
+
+	if mmExpireRequests.inspectFuncExpireRequests != nil {
+		mmExpireRequests.inspectFuncExpireRequests(ctx, now)
+	}
+
+	mm_params := RoleRequestRepositoryMockExpireRequestsParams{ctx, now}
+
+	// Record call args
+	mmExpireRequests.ExpireRequestsMock.mutex.Lock()
+	mmExpireRequests.ExpireRequestsMock.callArgs = append(mmExpireRequests.ExpireRequestsMock.callArgs, &mm_params)
+	mmExpireRequests.ExpireRequestsMock.mutex.Unlock()
+
+	for _, e := range mmExpireRequests.ExpireRequestsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ia1, e.results.err
+		}
+	}
+
+	if mmExpireRequests.ExpireRequestsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExpireRequests.ExpireRequestsMock.defaultExpectation.Counter, 1)
+		mm_want := mmExpireRequests.ExpireRequestsMock.defaultExpectation.params
+		mm_want_ptrs := mmExpireRequests.ExpireRequestsMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRequestRepositoryMockExpireRequestsParams{ctx, now}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExpireRequests.t.Errorf("RoleRequestRepositoryMock.ExpireRequests got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.now != nil && !minimock.Equal(*mm_want_ptrs.now, mm_got.now) {
+				mmExpireRequests.t.Errorf("RoleRequestRepositoryMock.ExpireRequests got unexpected parameter now, want: %#v, got: %#v%s\n", *mm_want_ptrs.now, mm_got.now, minimock.Diff(*mm_want_ptrs.now, mm_got.now))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExpireRequests.t.Errorf("RoleRequestRepositoryMock.ExpireRequests got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExpireRequests.ExpireRequestsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExpireRequests.t.Fatal("No results are set for the RoleRequestRepositoryMock.ExpireRequests")
+		}
+		return (*mm_results).ia1, (*mm_results).err
+	}
+	if mmExpireRequests.funcExpireRequests != nil {
+		return mmExpireRequests.funcExpireRequests(ctx, now)
+	}
+	mmExpireRequests.t.Fatalf("Unexpected call to RoleRequestRepositoryMock.ExpireRequests. %v %v", ctx, now)
+	return
+}
+
+// ExpireRequestsAfterCounter returns a count of finished RoleRequestRepositoryMock.ExpireRequests invocations
+func (mmExpireRequests *RoleRequestRepositoryMock) ExpireRequestsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExpireRequests.afterExpireRequestsCounter)
+}
+
+// ExpireRequestsBeforeCounter returns a count of RoleRequestRepositoryMock.ExpireRequests invocations
+func (mmExpireRequests *RoleRequestRepositoryMock) ExpireRequestsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExpireRequests.beforeExpireRequestsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRequestRepositoryMock.ExpireRequests.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExpireRequests *mRoleRequestRepositoryMockExpireRequests) Calls() []*RoleRequestRepositoryMockExpireRequestsParams {
+	mmExpireRequests.mutex.RLock()
+
+	argCopy := make([]*RoleRequestRepositoryMockExpireRequestsParams, len(mmExpireRequests.callArgs))
+	copy(argCopy, mmExpireRequests.callArgs)
+
+	mmExpireRequests.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExpireRequestsDone returns true if the count of the ExpireRequests invocations corresponds
+// the number of defined expectations
+func (m *RoleRequestRepositoryMock) MinimockExpireRequestsDone() bool {
+	for _, e := range m.ExpireRequestsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExpireRequestsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterExpireRequestsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExpireRequests != nil && mm_atomic.LoadUint64(&m.afterExpireRequestsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockExpireRequestsInspect logs each unmet expectation
+func (m *RoleRequestRepositoryMock) MinimockExpireRequestsInspect() {
+	for _, e := range m.ExpireRequestsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRequestRepositoryMock.ExpireRequests with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExpireRequestsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterExpireRequestsCounter) < 1 {
+		if m.ExpireRequestsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RoleRequestRepositoryMock.ExpireRequests")
+		} else {
+			m.t.Errorf("Expected call to RoleRequestRepositoryMock.ExpireRequests with params: %#v", *m.ExpireRequestsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExpireRequests != nil && mm_atomic.LoadUint64(&m.afterExpireRequestsCounter) < 1 {
+		m.t.Error("Expected call to RoleRequestRepositoryMock.ExpireRequests")
+	}
+}
+
+type mRoleRequestRepositoryMockFind struct {
+	mock               *RoleRequestRepositoryMock
+	defaultExpectation *RoleRequestRepositoryMockFindExpectation
+	expectations       []*RoleRequestRepositoryMockFindExpectation
+
+	callArgs []*RoleRequestRepositoryMockFindParams
+	mutex    sync.RWMutex
+}
+
+// RoleRequestRepositoryMockFindExpectation specifies expectation struct of the RoleRequestRepository.Find
+type RoleRequestRepositoryMockFindExpectation struct {
+	mock      *RoleRequestRepositoryMock
+	params    *RoleRequestRepositoryMockFindParams
+	paramPtrs *RoleRequestRepositoryMockFindParamPtrs
+	results   *RoleRequestRepositoryMockFindResults
+	Counter   uint64
+}
+
+// RoleRequestRepositoryMockFindParams contains parameters of the RoleRequestRepository.Find
+type RoleRequestRepositoryMockFindParams struct {
+	ctx    context.Context
+	filter model.RoleRequestFilter
+}
+
+// RoleRequestRepositoryMockFindParamPtrs contains pointers to parameters of the RoleRequestRepository.Find
+type RoleRequestRepositoryMockFindParamPtrs struct {
+	ctx    *context.Context
+	filter *model.RoleRequestFilter
+}
+
+// RoleRequestRepositoryMockFindResults contains results of the RoleRequestRepository.Find
+type RoleRequestRepositoryMockFindResults struct {
+	ra1 []model.RoleRequest
+	err error
+}
+
+// Expect sets up expected params for RoleRequestRepository.Find
+func (mmFind *mRoleRequestRepositoryMockFind) Expect(ctx context.Context, filter model.RoleRequestFilter) *mRoleRequestRepositoryMockFind {
+	if mmFind.mock.funcFind != nil {
+		mmFind.mock.t.Fatalf("RoleRequestRepositoryMock.Find mock is already set by Set")
+	}
+
+	if mmFind.defaultExpectation == nil {
+		mmFind.defaultExpectation = &RoleRequestRepositoryMockFindExpectation{}
+	}
+
+	if mmFind.defaultExpectation.paramPtrs != nil {
+		mmFind.mock.t.Fatalf("RoleRequestRepositoryMock.Find mock is already set by ExpectParams functions")
+	}
+
+	mmFind.defaultExpectation.params = &RoleRequestRepositoryMockFindParams{ctx, filter}
+	for _, e := range mmFind.expectations {
+		if minimock.Equal(e.params, mmFind.defaultExpectation.params) {
+			mmFind.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFind.defaultExpectation.params)
+		}
+	}
+
+	return mmFind
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRequestRepository.Find
+func (mmFind *mRoleRequestRepositoryMockFind) ExpectCtxParam1(ctx context.Context) *mRoleRequestRepositoryMockFind {
+	if mmFind.mock.funcFind != nil {
+		mmFind.mock.t.Fatalf("RoleRequestRepositoryMock.Find mock is already set by Set")
+	}
+
+	if mmFind.defaultExpectation == nil {
+		mmFind.defaultExpectation = &RoleRequestRepositoryMockFindExpectation{}
+	}
+
+	if mmFind.defaultExpectation.params != nil {
+		mmFind.mock.t.Fatalf("RoleRequestRepositoryMock.Find mock is already set by Expect")
+	}
+
+	if mmFind.defaultExpectation.paramPtrs == nil {
+		mmFind.defaultExpectation.paramPtrs = &RoleRequestRepositoryMockFindParamPtrs{}
+	}
+	mmFind.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmFind
+}
+
+// ExpectFilterParam2 sets up expected param filter for RoleRequestRepository.Find
+func (mmFind *mRoleRequestRepositoryMockFind) ExpectFilterParam2(filter model.RoleRequestFilter) *mRoleRequestRepositoryMockFind {
+	if mmFind.mock.funcFind != nil {
+		mmFind.mock.t.Fatalf("RoleRequestRepositoryMock.Find mock is already set by Set")
+	}
+
+	if mmFind.defaultExpectation == nil {
+		mmFind.defaultExpectation = &RoleRequestRepositoryMockFindExpectation{}
+	}
+
+	if mmFind.defaultExpectation.params != nil {
+		mmFind.mock.t.Fatalf("RoleRequestRepositoryMock.Find mock is already set by Expect")
+	}
+
+	if mmFind.defaultExpectation.paramPtrs == nil {
+		mmFind.defaultExpectation.paramPtrs = &RoleRequestRepositoryMockFindParamPtrs{}
+	}
+	mmFind.defaultExpectation.paramPtrs.filter = &filter
+
+	return mmFind
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRequestRepository.Find
+func (mmFind *mRoleRequestRepositoryMockFind) Inspect(f func(ctx context.Context, filter model.RoleRequestFilter)) *mRoleRequestRepositoryMockFind {
+	if mmFind.mock.inspectFuncFind != nil {
+		mmFind.mock.t.Fatalf("Inspect function is already set for RoleRequestRepositoryMock.Find")
+	}
+
+	mmFind.mock.inspectFuncFind = f
+
+	return mmFind
+}
+
+// Return sets up results that will be returned by RoleRequestRepository.Find
+func (mmFind *mRoleRequestRepositoryMockFind) Return(ra1 []model.RoleRequest, err error) *RoleRequestRepositoryMock {
+	if mmFind.mock.funcFind != nil {
+		mmFind.mock.t.Fatalf("RoleRequestRepositoryMock.Find mock is already set by Set")
+	}
+
+	if mmFind.defaultExpectation == nil {
+		mmFind.defaultExpectation = &RoleRequestRepositoryMockFindExpectation{mock: mmFind.mock}
+	}
+	mmFind.defaultExpectation.results = &RoleRequestRepositoryMockFindResults{ra1, err}
+	return mmFind.mock
+}
+
+// Set uses given function f to mock the RoleRequestRepository.Find method
+func (mmFind *mRoleRequestRepositoryMockFind) Set(f func(ctx context.Context, filter model.RoleRequestFilter) (ra1 []model.RoleRequest, err error)) *RoleRequestRepositoryMock {
+	if mmFind.defaultExpectation != nil {
+		mmFind.mock.t.Fatalf("Default expectation is already set for the RoleRequestRepository.Find method")
+	}
+
+	if len(mmFind.expectations) > 0 {
+		mmFind.mock.t.Fatalf("Some expectations are already set for the RoleRequestRepository.Find method")
+	}
+
+	mmFind.mock.funcFind = f
+	return mmFind.mock
+}
+
+// When sets expectation for the RoleRequestRepository.Find which will trigger the result defined by the following
+// Then helper
+func (mmFind *mRoleRequestRepositoryMockFind) When(ctx context.Context, filter model.RoleRequestFilter) *RoleRequestRepositoryMockFindExpectation {
+	if mmFind.mock.funcFind != nil {
+		mmFind.mock.t.Fatalf("RoleRequestRepositoryMock.Find mock is already set by Set")
+	}
+
+	expectation := &RoleRequestRepositoryMockFindExpectation{
+		mock:   mmFind.mock,
+		params: &RoleRequestRepositoryMockFindParams{ctx, filter},
+	}
+	mmFind.expectations = append(mmFind.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRequestRepository.Find return parameters for the expectation previously defined by the When method
+func (e *RoleRequestRepositoryMockFindExpectation) Then(ra1 []model.RoleRequest, err error) *RoleRequestRepositoryMock {
+	e.results = &RoleRequestRepositoryMockFindResults{ra1, err}
+	return e.mock
+}
+
+// Find implements repository.RoleRequestRepository
+func (mmFind *RoleRequestRepositoryMock) Find(ctx context.Context, filter model.RoleRequestFilter) (ra1 []model.RoleRequest, err error) {
+	mm_atomic.AddUint64(&mmFind.beforeFindCounter, 1)
+	defer mm_atomic.AddUint64(&mmFind.afterFindCounter, 1)
+
+	if mmFind.inspectFuncFind != nil {
+		mmFind.inspectFuncFind(ctx, filter)
+	}
+
+	mm_params := RoleRequestRepositoryMockFindParams{ctx, filter}
+
+	// Record call args
+	mmFind.FindMock.mutex.Lock()
+	mmFind.FindMock.callArgs = append(mmFind.FindMock.callArgs, &mm_params)
+	mmFind.FindMock.mutex.Unlock()
+
+	for _, e := range mmFind.FindMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ra1, e.results.err
+		}
+	}
+
+	if mmFind.FindMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFind.FindMock.defaultExpectation.Counter, 1)
+		mm_want := mmFind.FindMock.defaultExpectation.params
+		mm_want_ptrs := mmFind.FindMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRequestRepositoryMockFindParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFind.t.Errorf("RoleRequestRepositoryMock.Find got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmFind.t.Errorf("RoleRequestRepositoryMock.Find got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFind.t.Errorf("RoleRequestRepositoryMock.Find got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFind.FindMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFind.t.Fatal("No results are set for the RoleRequestRepositoryMock.Find")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmFind.funcFind != nil {
+		return mmFind.funcFind(ctx, filter)
+	}
+	mmFind.t.Fatalf("Unexpected call to RoleRequestRepositoryMock.Find. %v %v", ctx, filter)
+	return
+}
+
+// FindAfterCounter returns a count of finished RoleRequestRepositoryMock.Find invocations
+func (mmFind *RoleRequestRepositoryMock) FindAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFind.afterFindCounter)
+}
+
+// FindBeforeCounter returns a count of RoleRequestRepositoryMock.Find invocations
+func (mmFind *RoleRequestRepositoryMock) FindBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFind.beforeFindCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRequestRepositoryMock.Find.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFind *mRoleRequestRepositoryMockFind) Calls() []*RoleRequestRepositoryMockFindParams {
+	mmFind.mutex.RLock()
+
+	argCopy := make([]*RoleRequestRepositoryMockFindParams, len(mmFind.callArgs))
+	copy(argCopy, mmFind.callArgs)
+
+	mmFind.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindDone returns true if the count of the Find invocations corresponds
+// the number of defined expectations
+func (m *RoleRequestRepositoryMock) MinimockFindDone() bool {
+	for _, e := range m.FindMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterFindCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFind != nil && mm_atomic.LoadUint64(&m.afterFindCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockFindInspect logs each unmet expectation
+func (m *RoleRequestRepositoryMock) MinimockFindInspect() {
+	for _, e := range m.FindMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRequestRepositoryMock.Find with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterFindCounter) < 1 {
+		if m.FindMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RoleRequestRepositoryMock.Find")
+		} else {
+			m.t.Errorf("Expected call to RoleRequestRepositoryMock.Find with params: %#v", *m.FindMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFind != nil && mm_atomic.LoadUint64(&m.afterFindCounter) < 1 {
+		m.t.Error("Expected call to RoleRequestRepositoryMock.Find")
+	}
+}
+
+type mRoleRequestRepositoryMockGet struct {
+	mock               *RoleRequestRepositoryMock
+	defaultExpectation *RoleRequestRepositoryMockGetExpectation
+	expectations       []*RoleRequestRepositoryMockGetExpectation
+
+	callArgs []*RoleRequestRepositoryMockGetParams
+	mutex    sync.RWMutex
+}
+
+// RoleRequestRepositoryMockGetExpectation specifies expectation struct of the RoleRequestRepository.Get
+type RoleRequestRepositoryMockGetExpectation struct {
+	mock      *RoleRequestRepositoryMock
+	params    *RoleRequestRepositoryMockGetParams
+	paramPtrs *RoleRequestRepositoryMockGetParamPtrs
+	results   *RoleRequestRepositoryMockGetResults
+	Counter   uint64
+}
+
+// RoleRequestRepositoryMockGetParams contains parameters of the RoleRequestRepository.Get
+type RoleRequestRepositoryMockGetParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// RoleRequestRepositoryMockGetParamPtrs contains pointers to parameters of the RoleRequestRepository.Get
+type RoleRequestRepositoryMockGetParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// RoleRequestRepositoryMockGetResults contains results of the RoleRequestRepository.Get
+type RoleRequestRepositoryMockGetResults struct {
+	rp1 *model.RoleRequest
+	err error
+}
+
+// Expect sets up expected params for RoleRequestRepository.Get
+func (mmGet *mRoleRequestRepositoryMockGet) Expect(ctx context.Context, id int64) *mRoleRequestRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RoleRequestRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RoleRequestRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("RoleRequestRepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &RoleRequestRepositoryMockGetParams{ctx, id}
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRequestRepository.Get
+func (mmGet *mRoleRequestRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mRoleRequestRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RoleRequestRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RoleRequestRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("RoleRequestRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &RoleRequestRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGet
+}
+
+// ExpectIdParam2 sets up expected param id for RoleRequestRepository.Get
+func (mmGet *mRoleRequestRepositoryMockGet) ExpectIdParam2(id int64) *mRoleRequestRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RoleRequestRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RoleRequestRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("RoleRequestRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &RoleRequestRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.id = &id
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRequestRepository.Get
+func (mmGet *mRoleRequestRepositoryMockGet) Inspect(f func(ctx context.Context, id int64)) *mRoleRequestRepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for RoleRequestRepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by RoleRequestRepository.Get
+func (mmGet *mRoleRequestRepositoryMockGet) Return(rp1 *model.RoleRequest, err error) *RoleRequestRepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RoleRequestRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RoleRequestRepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &RoleRequestRepositoryMockGetResults{rp1, err}
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the RoleRequestRepository.Get method
+func (mmGet *mRoleRequestRepositoryMockGet) Set(f func(ctx context.Context, id int64) (rp1 *model.RoleRequest, err error)) *RoleRequestRepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the RoleRequestRepository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the RoleRequestRepository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	return mmGet.mock
+}
+
+// When sets expectation for the RoleRequestRepository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mRoleRequestRepositoryMockGet) When(ctx context.Context, id int64) *RoleRequestRepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RoleRequestRepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &RoleRequestRepositoryMockGetExpectation{
+		mock:   mmGet.mock,
+		params: &RoleRequestRepositoryMockGetParams{ctx, id},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRequestRepository.Get return parameters for the expectation previously defined by the When method
+func (e *RoleRequestRepositoryMockGetExpectation) Then(rp1 *model.RoleRequest, err error) *RoleRequestRepositoryMock {
+	e.results = &RoleRequestRepositoryMockGetResults{rp1, err}
+	return e.mock
+}
+
+// Get implements repository.RoleRequestRepository
+func (mmGet *RoleRequestRepositoryMock) Get(ctx context.Context, id int64) (rp1 *model.RoleRequest, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, id)
+	}
+
+	mm_params := RoleRequestRepositoryMockGetParams{ctx, id}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRequestRepositoryMockGetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("RoleRequestRepositoryMock.Get got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGet.t.Errorf("RoleRequestRepositoryMock.Get got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("RoleRequestRepositoryMock.Get got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the RoleRequestRepositoryMock.Get")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, id)
+	}
+	mmGet.t.Fatalf("Unexpected call to RoleRequestRepositoryMock.Get. %v %v", ctx, id)
+	return
+}
+
+// GetAfterCounter returns a count of finished RoleRequestRepositoryMock.Get invocations
+func (mmGet *RoleRequestRepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of RoleRequestRepositoryMock.Get invocations
+func (mmGet *RoleRequestRepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRequestRepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mRoleRequestRepositoryMockGet) Calls() []*RoleRequestRepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*RoleRequestRepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *RoleRequestRepositoryMock) MinimockGetDone() bool {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && mm_atomic.LoadUint64(&m.afterGetCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *RoleRequestRepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRequestRepositoryMock.Get with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetCounter) < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RoleRequestRepositoryMock.Get")
+		} else {
+			m.t.Errorf("Expected call to RoleRequestRepositoryMock.Get with params: %#v", *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && mm_atomic.LoadUint64(&m.afterGetCounter) < 1 {
+		m.t.Error("Expected call to RoleRequestRepositoryMock.Get")
+	}
+}
+
+type mRoleRequestRepositoryMockGrant struct {
+	mock               *RoleRequestRepositoryMock
+	defaultExpectation *RoleRequestRepositoryMockGrantExpectation
+	expectations       []*RoleRequestRepositoryMockGrantExpectation
+
+	callArgs []*RoleRequestRepositoryMockGrantParams
+	mutex    sync.RWMutex
+}
+
+// RoleRequestRepositoryMockGrantExpectation specifies expectation struct of the RoleRequestRepository.Grant
+type RoleRequestRepositoryMockGrantExpectation struct {
+	mock      *RoleRequestRepositoryMock
+	params    *RoleRequestRepositoryMockGrantParams
+	paramPtrs *RoleRequestRepositoryMockGrantParamPtrs
+	results   *RoleRequestRepositoryMockGrantResults
+	Counter   uint64
+}
+
+// RoleRequestRepositoryMockGrantParams contains parameters of the RoleRequestRepository.Grant
+type RoleRequestRepositoryMockGrantParams struct {
+	ctx       context.Context
+	userID    int64
+	role      model.Role
+	expiresAt time.Time
+}
+
+// RoleRequestRepositoryMockGrantParamPtrs contains pointers to parameters of the RoleRequestRepository.Grant
+type RoleRequestRepositoryMockGrantParamPtrs struct {
+	ctx       *context.Context
+	userID    *int64
+	role      *model.Role
+	expiresAt *time.Time
+}
+
+// RoleRequestRepositoryMockGrantResults contains results of the RoleRequestRepository.Grant
+type RoleRequestRepositoryMockGrantResults struct {
+	err error
+}
+
+// Expect sets up expected params for RoleRequestRepository.Grant
+func (mmGrant *mRoleRequestRepositoryMockGrant) Expect(ctx context.Context, userID int64, role model.Role, expiresAt time.Time) *mRoleRequestRepositoryMockGrant {
+	if mmGrant.mock.funcGrant != nil {
+		mmGrant.mock.t.Fatalf("RoleRequestRepositoryMock.Grant mock is already set by Set")
+	}
+
+	if mmGrant.defaultExpectation == nil {
+		mmGrant.defaultExpectation = &RoleRequestRepositoryMockGrantExpectation{}
+	}
+
+	if mmGrant.defaultExpectation.paramPtrs != nil {
+		mmGrant.mock.t.Fatalf("RoleRequestRepositoryMock.Grant mock is already set by ExpectParams functions")
+	}
+
+	mmGrant.defaultExpectation.params = &RoleRequestRepositoryMockGrantParams{ctx, userID, role, expiresAt}
+	for _, e := range mmGrant.expectations {
+		if minimock.Equal(e.params, mmGrant.defaultExpectation.params) {
+			mmGrant.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGrant.defaultExpectation.params)
+		}
+	}
+
+	return mmGrant
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRequestRepository.Grant
+func (mmGrant *mRoleRequestRepositoryMockGrant) ExpectCtxParam1(ctx context.Context) *mRoleRequestRepositoryMockGrant {
+	if mmGrant.mock.funcGrant != nil {
+		mmGrant.mock.t.Fatalf("RoleRequestRepositoryMock.Grant mock is already set by Set")
+	}
+
+	if mmGrant.defaultExpectation == nil {
+		mmGrant.defaultExpectation = &RoleRequestRepositoryMockGrantExpectation{}
+	}
+
+	if mmGrant.defaultExpectation.params != nil {
+		mmGrant.mock.t.Fatalf("RoleRequestRepositoryMock.Grant mock is already set by Expect")
+	}
+
+	if mmGrant.defaultExpectation.paramPtrs == nil {
+		mmGrant.defaultExpectation.paramPtrs = &RoleRequestRepositoryMockGrantParamPtrs{}
+	}
+	mmGrant.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGrant
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RoleRequestRepository.Grant
+func (mmGrant *mRoleRequestRepositoryMockGrant) ExpectUserIDParam2(userID int64) *mRoleRequestRepositoryMockGrant {
+	if mmGrant.mock.funcGrant != nil {
+		mmGrant.mock.t.Fatalf("RoleRequestRepositoryMock.Grant mock is already set by Set")
+	}
+
+	if mmGrant.defaultExpectation == nil {
+		mmGrant.defaultExpectation = &RoleRequestRepositoryMockGrantExpectation{}
+	}
+
+	if mmGrant.defaultExpectation.params != nil {
+		mmGrant.mock.t.Fatalf("RoleRequestRepositoryMock.Grant mock is already set by Expect")
+	}
+
+	if mmGrant.defaultExpectation.paramPtrs == nil {
+		mmGrant.defaultExpectation.paramPtrs = &RoleRequestRepositoryMockGrantParamPtrs{}
+	}
+	mmGrant.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmGrant
+}
+
+// ExpectRoleParam3 sets up expected param role for RoleRequestRepository.Grant
+func (mmGrant *mRoleRequestRepositoryMockGrant) ExpectRoleParam3(role model.Role) *mRoleRequestRepositoryMockGrant {
+	if mmGrant.mock.funcGrant != nil {
+		mmGrant.mock.t.Fatalf("RoleRequestRepositoryMock.Grant mock is already set by Set")
+	}
+
+	if mmGrant.defaultExpectation == nil {
+		mmGrant.defaultExpectation = &RoleRequestRepositoryMockGrantExpectation{}
+	}
+
+	if mmGrant.defaultExpectation.params != nil {
+		mmGrant.mock.t.Fatalf("RoleRequestRepositoryMock.Grant mock is already set by Expect")
+	}
+
+	if mmGrant.defaultExpectation.paramPtrs == nil {
+		mmGrant.defaultExpectation.paramPtrs = &RoleRequestRepositoryMockGrantParamPtrs{}
+	}
+	mmGrant.defaultExpectation.paramPtrs.role = &role
+
+	return mmGrant
+}
+
+// ExpectExpiresAtParam4 sets up expected param expiresAt for RoleRequestRepository.Grant
+func (mmGrant *mRoleRequestRepositoryMockGrant) ExpectExpiresAtParam4(expiresAt time.Time) *mRoleRequestRepositoryMockGrant {
+	if mmGrant.mock.funcGrant != nil {
+		mmGrant.mock.t.Fatalf("RoleRequestRepositoryMock.Grant mock is already set by Set")
+	}
+
+	if mmGrant.defaultExpectation == nil {
+		mmGrant.defaultExpectation = &RoleRequestRepositoryMockGrantExpectation{}
+	}
+
+	if mmGrant.defaultExpectation.params != nil {
+		mmGrant.mock.t.Fatalf("RoleRequestRepositoryMock.Grant mock is already set by Expect")
+	}
+
+	if mmGrant.defaultExpectation.paramPtrs == nil {
+		mmGrant.defaultExpectation.paramPtrs = &RoleRequestRepositoryMockGrantParamPtrs{}
+	}
+	mmGrant.defaultExpectation.paramPtrs.expiresAt = &expiresAt
+
+	return mmGrant
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRequestRepository.Grant
+func (mmGrant *mRoleRequestRepositoryMockGrant) Inspect(f func(ctx context.Context, userID int64, role model.Role, expiresAt time.Time)) *mRoleRequestRepositoryMockGrant {
+	if mmGrant.mock.inspectFuncGrant != nil {
+		mmGrant.mock.t.Fatalf("Inspect function is already set for RoleRequestRepositoryMock.Grant")
+	}
+
+	mmGrant.mock.inspectFuncGrant = f
+
+	return mmGrant
+}
+
+// Return sets up results that will be returned by RoleRequestRepository.Grant
+func (mmGrant *mRoleRequestRepositoryMockGrant) Return(err error) *RoleRequestRepositoryMock {
+	if mmGrant.mock.funcGrant != nil {
+		mmGrant.mock.t.Fatalf("RoleRequestRepositoryMock.Grant mock is already set by Set")
+	}
+
+	if mmGrant.defaultExpectation == nil {
+		mmGrant.defaultExpectation = &RoleRequestRepositoryMockGrantExpectation{mock: mmGrant.mock}
+	}
+	mmGrant.defaultExpectation.results = &RoleRequestRepositoryMockGrantResults{err}
+	return mmGrant.mock
+}
+
+// Set uses given function f to mock the RoleRequestRepository.Grant method
+func (mmGrant *mRoleRequestRepositoryMockGrant) Set(f func(ctx context.Context, userID int64, role model.Role, expiresAt time.Time) (err error)) *RoleRequestRepositoryMock {
+	if mmGrant.defaultExpectation != nil {
+		mmGrant.mock.t.Fatalf("Default expectation is already set for the RoleRequestRepository.Grant method")
+	}
+
+	if len(mmGrant.expectations) > 0 {
+		mmGrant.mock.t.Fatalf("Some expectations are already set for the RoleRequestRepository.Grant method")
+	}
+
+	mmGrant.mock.funcGrant = f
+	return mmGrant.mock
+}
+
+// When sets expectation for the RoleRequestRepository.Grant which will trigger the result defined by the following
+// Then helper
+func (mmGrant *mRoleRequestRepositoryMockGrant) When(ctx context.Context, userID int64, role model.Role, expiresAt time.Time) *RoleRequestRepositoryMockGrantExpectation {
+	if mmGrant.mock.funcGrant != nil {
+		mmGrant.mock.t.Fatalf("RoleRequestRepositoryMock.Grant mock is already set by Set")
+	}
+
+	expectation := &RoleRequestRepositoryMockGrantExpectation{
+		mock:   mmGrant.mock,
+		params: &RoleRequestRepositoryMockGrantParams{ctx, userID, role, expiresAt},
+	}
+	mmGrant.expectations = append(mmGrant.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRequestRepository.Grant return parameters for the expectation previously defined by the When method
+func (e *RoleRequestRepositoryMockGrantExpectation) Then(err error) *RoleRequestRepositoryMock {
+	e.results = &RoleRequestRepositoryMockGrantResults{err}
+	return e.mock
+}
+
+// Grant implements repository.RoleRequestRepository
+func (mmGrant *RoleRequestRepositoryMock) Grant(ctx context.Context, userID int64, role model.Role, expiresAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmGrant.beforeGrantCounter, 1)
+	defer mm_atomic.AddUint64(&mmGrant.afterGrantCounter, 1)
+
+	if mmGrant.inspectFuncGrant != nil {
+		mmGrant.inspectFuncGrant(ctx, userID, role, expiresAt)
+	}
+
+	mm_params := RoleRequestRepositoryMockGrantParams{ctx, userID, role, expiresAt}
+
+	// Record call args
+	mmGrant.GrantMock.mutex.Lock()
+	mmGrant.GrantMock.callArgs = append(mmGrant.GrantMock.callArgs, &mm_params)
+	mmGrant.GrantMock.mutex.Unlock()
+
+	for _, e := range mmGrant.GrantMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmGrant.GrantMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGrant.GrantMock.defaultExpectation.Counter, 1)
+		mm_want := mmGrant.GrantMock.defaultExpectation.params
+		mm_want_ptrs := mmGrant.GrantMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRequestRepositoryMockGrantParams{ctx, userID, role, expiresAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGrant.t.Errorf("RoleRequestRepositoryMock.Grant got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGrant.t.Errorf("RoleRequestRepositoryMock.Grant got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmGrant.t.Errorf("RoleRequestRepositoryMock.Grant got unexpected parameter role, want: %#v, got: %#v%s\n", *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+			if mm_want_ptrs.expiresAt != nil && !minimock.Equal(*mm_want_ptrs.expiresAt, mm_got.expiresAt) {
+				mmGrant.t.Errorf("RoleRequestRepositoryMock.Grant got unexpected parameter expiresAt, want: %#v, got: %#v%s\n", *mm_want_ptrs.expiresAt, mm_got.expiresAt, minimock.Diff(*mm_want_ptrs.expiresAt, mm_got.expiresAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGrant.t.Errorf("RoleRequestRepositoryMock.Grant got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGrant.GrantMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGrant.t.Fatal("No results are set for the RoleRequestRepositoryMock.Grant")
+		}
+		return (*mm_results).err
+	}
+	if mmGrant.funcGrant != nil {
+		return mmGrant.funcGrant(ctx, userID, role, expiresAt)
+	}
+	mmGrant.t.Fatalf("Unexpected call to RoleRequestRepositoryMock.Grant. %v %v %v %v", ctx, userID, role, expiresAt)
+	return
+}
+
+// GrantAfterCounter returns a count of finished RoleRequestRepositoryMock.Grant invocations
+func (mmGrant *RoleRequestRepositoryMock) GrantAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGrant.afterGrantCounter)
+}
+
+// GrantBeforeCounter returns a count of RoleRequestRepositoryMock.Grant invocations
+func (mmGrant *RoleRequestRepositoryMock) GrantBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGrant.beforeGrantCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRequestRepositoryMock.Grant.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGrant *mRoleRequestRepositoryMockGrant) Calls() []*RoleRequestRepositoryMockGrantParams {
+	mmGrant.mutex.RLock()
+
+	argCopy := make([]*RoleRequestRepositoryMockGrantParams, len(mmGrant.callArgs))
+	copy(argCopy, mmGrant.callArgs)
+
+	mmGrant.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGrantDone returns true if the count of the Grant invocations corresponds
+// the number of defined expectations
+func (m *RoleRequestRepositoryMock) MinimockGrantDone() bool {
+	for _, e := range m.GrantMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GrantMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGrantCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGrant != nil && mm_atomic.LoadUint64(&m.afterGrantCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGrantInspect logs each unmet expectation
+func (m *RoleRequestRepositoryMock) MinimockGrantInspect() {
+	for _, e := range m.GrantMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRequestRepositoryMock.Grant with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GrantMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGrantCounter) < 1 {
+		if m.GrantMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RoleRequestRepositoryMock.Grant")
+		} else {
+			m.t.Errorf("Expected call to RoleRequestRepositoryMock.Grant with params: %#v", *m.GrantMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGrant != nil && mm_atomic.LoadUint64(&m.afterGrantCounter) < 1 {
+		m.t.Error("Expected call to RoleRequestRepositoryMock.Grant")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RoleRequestRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockDecideInspect()
+
+			m.MinimockDeleteExpiredGrantsInspect()
+
+			m.MinimockExpireRequestsInspect()
+
+			m.MinimockFindInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockGrantInspect()
+			m.t.FailNow()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RoleRequestRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RoleRequestRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockDecideDone() &&
+		m.MinimockDeleteExpiredGrantsDone() &&
+		m.MinimockExpireRequestsDone() &&
+		m.MinimockFindDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockGrantDone()
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/arifullov/auth/internal/model"
@@ -19,26 +20,50 @@ type UserRepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcCreate          func(ctx context.Context, user *model.CreateUser) (i1 int64, err error)
-	inspectFuncCreate   func(ctx context.Context, user *model.CreateUser)
+	funcCreate          func(ctx context.Context, user *model.CreateUser, tenant string) (i1 int64, err error)
+	inspectFuncCreate   func(ctx context.Context, user *model.CreateUser, tenant string)
 	afterCreateCounter  uint64
 	beforeCreateCounter uint64
 	CreateMock          mUserRepositoryMockCreate
 
-	funcDelete          func(ctx context.Context, id int64) (err error)
-	inspectFuncDelete   func(ctx context.Context, id int64)
+	funcDelete          func(ctx context.Context, id int64, tenant string) (err error)
+	inspectFuncDelete   func(ctx context.Context, id int64, tenant string)
 	afterDeleteCounter  uint64
 	beforeDeleteCounter uint64
 	DeleteMock          mUserRepositoryMockDelete
 
-	funcGet          func(ctx context.Context, id int64) (up1 *model.User, err error)
-	inspectFuncGet   func(ctx context.Context, id int64)
+	funcGet          func(ctx context.Context, id int64, tenant string) (up1 *model.User, err error)
+	inspectFuncGet   func(ctx context.Context, id int64, tenant string)
 	afterGetCounter  uint64
 	beforeGetCounter uint64
 	GetMock          mUserRepositoryMockGet
 
-	funcUpdate          func(ctx context.Context, user *model.UpdateUser) (err error)
-	inspectFuncUpdate   func(ctx context.Context, user *model.UpdateUser)
+	funcGetByEmail          func(ctx context.Context, email string) (up1 *model.User, err error)
+	inspectFuncGetByEmail   func(ctx context.Context, email string)
+	afterGetByEmailCounter  uint64
+	beforeGetByEmailCounter uint64
+	GetByEmailMock          mUserRepositoryMockGetByEmail
+
+	funcListTokenRevocations          func(ctx context.Context, since time.Time) (ta1 []model.TokenRevocation, err error)
+	inspectFuncListTokenRevocations   func(ctx context.Context, since time.Time)
+	afterListTokenRevocationsCounter  uint64
+	beforeListTokenRevocationsCounter uint64
+	ListTokenRevocationsMock          mUserRepositoryMockListTokenRevocations
+
+	funcRevokeTokens          func(ctx context.Context, id int64, revokedAt time.Time) (err error)
+	inspectFuncRevokeTokens   func(ctx context.Context, id int64, revokedAt time.Time)
+	afterRevokeTokensCounter  uint64
+	beforeRevokeTokensCounter uint64
+	RevokeTokensMock          mUserRepositoryMockRevokeTokens
+
+	funcSetRoles          func(ctx context.Context, id int64, roles []model.Role, tenant string) (err error)
+	inspectFuncSetRoles   func(ctx context.Context, id int64, roles []model.Role, tenant string)
+	afterSetRolesCounter  uint64
+	beforeSetRolesCounter uint64
+	SetRolesMock          mUserRepositoryMockSetRoles
+
+	funcUpdate          func(ctx context.Context, user *model.UpdateUser, tenant string) (err error)
+	inspectFuncUpdate   func(ctx context.Context, user *model.UpdateUser, tenant string)
 	afterUpdateCounter  uint64
 	beforeUpdateCounter uint64
 	UpdateMock          mUserRepositoryMockUpdate
@@ -61,6 +86,18 @@ func NewUserRepositoryMock(t minimock.Tester) *UserRepositoryMock {
 	m.GetMock = mUserRepositoryMockGet{mock: m}
 	m.GetMock.callArgs = []*UserRepositoryMockGetParams{}
 
+	m.GetByEmailMock = mUserRepositoryMockGetByEmail{mock: m}
+	m.GetByEmailMock.callArgs = []*UserRepositoryMockGetByEmailParams{}
+
+	m.ListTokenRevocationsMock = mUserRepositoryMockListTokenRevocations{mock: m}
+	m.ListTokenRevocationsMock.callArgs = []*UserRepositoryMockListTokenRevocationsParams{}
+
+	m.RevokeTokensMock = mUserRepositoryMockRevokeTokens{mock: m}
+	m.RevokeTokensMock.callArgs = []*UserRepositoryMockRevokeTokensParams{}
+
+	m.SetRolesMock = mUserRepositoryMockSetRoles{mock: m}
+	m.SetRolesMock.callArgs = []*UserRepositoryMockSetRolesParams{}
+
 	m.UpdateMock = mUserRepositoryMockUpdate{mock: m}
 	m.UpdateMock.callArgs = []*UserRepositoryMockUpdateParams{}
 
@@ -89,14 +126,16 @@ type UserRepositoryMockCreateExpectation struct {
 
 // UserRepositoryMockCreateParams contains parameters of the UserRepository.Create
 type UserRepositoryMockCreateParams struct {
-	ctx  context.Context
-	user *model.CreateUser
+	ctx    context.Context
+	user   *model.CreateUser
+	tenant string
 }
 
 // UserRepositoryMockCreateParamPtrs contains pointers to parameters of the UserRepository.Create
 type UserRepositoryMockCreateParamPtrs struct {
-	ctx  *context.Context
-	user **model.CreateUser
+	ctx    *context.Context
+	user   **model.CreateUser
+	tenant *string
 }
 
 // UserRepositoryMockCreateResults contains results of the UserRepository.Create
@@ -106,7 +145,7 @@ type UserRepositoryMockCreateResults struct {
 }
 
 // Expect sets up expected params for UserRepository.Create
-func (mmCreate *mUserRepositoryMockCreate) Expect(ctx context.Context, user *model.CreateUser) *mUserRepositoryMockCreate {
+func (mmCreate *mUserRepositoryMockCreate) Expect(ctx context.Context, user *model.CreateUser, tenant string) *mUserRepositoryMockCreate {
 	if mmCreate.mock.funcCreate != nil {
 		mmCreate.mock.t.Fatalf("UserRepositoryMock.Create mock is already set by Set")
 	}
@@ -119,7 +158,7 @@ func (mmCreate *mUserRepositoryMockCreate) Expect(ctx context.Context, user *mod
 		mmCreate.mock.t.Fatalf("UserRepositoryMock.Create mock is already set by ExpectParams functions")
 	}
 
-	mmCreate.defaultExpectation.params = &UserRepositoryMockCreateParams{ctx, user}
+	mmCreate.defaultExpectation.params = &UserRepositoryMockCreateParams{ctx, user, tenant}
 	for _, e := range mmCreate.expectations {
 		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
 			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
@@ -173,8 +212,30 @@ func (mmCreate *mUserRepositoryMockCreate) ExpectUserParam2(user *model.CreateUs
 	return mmCreate
 }
 
+// ExpectTenantParam3 sets up expected param tenant for UserRepository.Create
+func (mmCreate *mUserRepositoryMockCreate) ExpectTenantParam3(tenant string) *mUserRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("UserRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &UserRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("UserRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &UserRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.tenant = &tenant
+
+	return mmCreate
+}
+
 // Inspect accepts an inspector function that has same arguments as the UserRepository.Create
-func (mmCreate *mUserRepositoryMockCreate) Inspect(f func(ctx context.Context, user *model.CreateUser)) *mUserRepositoryMockCreate {
+func (mmCreate *mUserRepositoryMockCreate) Inspect(f func(ctx context.Context, user *model.CreateUser, tenant string)) *mUserRepositoryMockCreate {
 	if mmCreate.mock.inspectFuncCreate != nil {
 		mmCreate.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.Create")
 	}
@@ -198,7 +259,7 @@ func (mmCreate *mUserRepositoryMockCreate) Return(i1 int64, err error) *UserRepo
 }
 
 // Set uses given function f to mock the UserRepository.Create method
-func (mmCreate *mUserRepositoryMockCreate) Set(f func(ctx context.Context, user *model.CreateUser) (i1 int64, err error)) *UserRepositoryMock {
+func (mmCreate *mUserRepositoryMockCreate) Set(f func(ctx context.Context, user *model.CreateUser, tenant string) (i1 int64, err error)) *UserRepositoryMock {
 	if mmCreate.defaultExpectation != nil {
 		mmCreate.mock.t.Fatalf("Default expectation is already set for the UserRepository.Create method")
 	}
@@ -213,14 +274,14 @@ func (mmCreate *mUserRepositoryMockCreate) Set(f func(ctx context.Context, user
 
 // When sets expectation for the UserRepository.Create which will trigger the result defined by the following
 // Then helper
-func (mmCreate *mUserRepositoryMockCreate) When(ctx context.Context, user *model.CreateUser) *UserRepositoryMockCreateExpectation {
+func (mmCreate *mUserRepositoryMockCreate) When(ctx context.Context, user *model.CreateUser, tenant string) *UserRepositoryMockCreateExpectation {
 	if mmCreate.mock.funcCreate != nil {
 		mmCreate.mock.t.Fatalf("UserRepositoryMock.Create mock is already set by Set")
 	}
 
 	expectation := &UserRepositoryMockCreateExpectation{
 		mock:   mmCreate.mock,
-		params: &UserRepositoryMockCreateParams{ctx, user},
+		params: &UserRepositoryMockCreateParams{ctx, user, tenant},
 	}
 	mmCreate.expectations = append(mmCreate.expectations, expectation)
 	return expectation
@@ -233,15 +294,15 @@ func (e *UserRepositoryMockCreateExpectation) Then(i1 int64, err error) *UserRep
 }
 
 // Create implements repository.UserRepository
-func (mmCreate *UserRepositoryMock) Create(ctx context.Context, user *model.CreateUser) (i1 int64, err error) {
+func (mmCreate *UserRepositoryMock) Create(ctx context.Context, user *model.CreateUser, tenant string) (i1 int64, err error) {
 	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
 	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
 
 	if mmCreate.inspectFuncCreate != nil {
-		mmCreate.inspectFuncCreate(ctx, user)
+		mmCreate.inspectFuncCreate(ctx, user, tenant)
 	}
 
-	mm_params := UserRepositoryMockCreateParams{ctx, user}
+	mm_params := UserRepositoryMockCreateParams{ctx, user, tenant}
 
 	// Record call args
 	mmCreate.CreateMock.mutex.Lock()
@@ -260,7 +321,7 @@ func (mmCreate *UserRepositoryMock) Create(ctx context.Context, user *model.Crea
 		mm_want := mmCreate.CreateMock.defaultExpectation.params
 		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
 
-		mm_got := UserRepositoryMockCreateParams{ctx, user}
+		mm_got := UserRepositoryMockCreateParams{ctx, user, tenant}
 
 		if mm_want_ptrs != nil {
 
@@ -272,6 +333,10 @@ func (mmCreate *UserRepositoryMock) Create(ctx context.Context, user *model.Crea
 				mmCreate.t.Errorf("UserRepositoryMock.Create got unexpected parameter user, want: %#v, got: %#v%s\n", *mm_want_ptrs.user, mm_got.user, minimock.Diff(*mm_want_ptrs.user, mm_got.user))
 			}
 
+			if mm_want_ptrs.tenant != nil && !minimock.Equal(*mm_want_ptrs.tenant, mm_got.tenant) {
+				mmCreate.t.Errorf("UserRepositoryMock.Create got unexpected parameter tenant, want: %#v, got: %#v%s\n", *mm_want_ptrs.tenant, mm_got.tenant, minimock.Diff(*mm_want_ptrs.tenant, mm_got.tenant))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmCreate.t.Errorf("UserRepositoryMock.Create got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
@@ -283,9 +348,9 @@ func (mmCreate *UserRepositoryMock) Create(ctx context.Context, user *model.Crea
 		return (*mm_results).i1, (*mm_results).err
 	}
 	if mmCreate.funcCreate != nil {
-		return mmCreate.funcCreate(ctx, user)
+		return mmCreate.funcCreate(ctx, user, tenant)
 	}
-	mmCreate.t.Fatalf("Unexpected call to UserRepositoryMock.Create. %v %v", ctx, user)
+	mmCreate.t.Fatalf("Unexpected call to UserRepositoryMock.Create. %v %v %v", ctx, user, tenant)
 	return
 }
 
@@ -374,14 +439,16 @@ type UserRepositoryMockDeleteExpectation struct {
 
 // UserRepositoryMockDeleteParams contains parameters of the UserRepository.Delete
 type UserRepositoryMockDeleteParams struct {
-	ctx context.Context
-	id  int64
+	ctx    context.Context
+	id     int64
+	tenant string
 }
 
 // UserRepositoryMockDeleteParamPtrs contains pointers to parameters of the UserRepository.Delete
 type UserRepositoryMockDeleteParamPtrs struct {
-	ctx *context.Context
-	id  *int64
+	ctx    *context.Context
+	id     *int64
+	tenant *string
 }
 
 // UserRepositoryMockDeleteResults contains results of the UserRepository.Delete
@@ -390,7 +457,7 @@ type UserRepositoryMockDeleteResults struct {
 }
 
 // Expect sets up expected params for UserRepository.Delete
-func (mmDelete *mUserRepositoryMockDelete) Expect(ctx context.Context, id int64) *mUserRepositoryMockDelete {
+func (mmDelete *mUserRepositoryMockDelete) Expect(ctx context.Context, id int64, tenant string) *mUserRepositoryMockDelete {
 	if mmDelete.mock.funcDelete != nil {
 		mmDelete.mock.t.Fatalf("UserRepositoryMock.Delete mock is already set by Set")
 	}
@@ -403,7 +470,7 @@ func (mmDelete *mUserRepositoryMockDelete) Expect(ctx context.Context, id int64)
 		mmDelete.mock.t.Fatalf("UserRepositoryMock.Delete mock is already set by ExpectParams functions")
 	}
 
-	mmDelete.defaultExpectation.params = &UserRepositoryMockDeleteParams{ctx, id}
+	mmDelete.defaultExpectation.params = &UserRepositoryMockDeleteParams{ctx, id, tenant}
 	for _, e := range mmDelete.expectations {
 		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
 			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
@@ -457,8 +524,30 @@ func (mmDelete *mUserRepositoryMockDelete) ExpectIdParam2(id int64) *mUserReposi
 	return mmDelete
 }
 
+// ExpectTenantParam3 sets up expected param tenant for UserRepository.Delete
+func (mmDelete *mUserRepositoryMockDelete) ExpectTenantParam3(tenant string) *mUserRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("UserRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &UserRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("UserRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &UserRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.tenant = &tenant
+
+	return mmDelete
+}
+
 // Inspect accepts an inspector function that has same arguments as the UserRepository.Delete
-func (mmDelete *mUserRepositoryMockDelete) Inspect(f func(ctx context.Context, id int64)) *mUserRepositoryMockDelete {
+func (mmDelete *mUserRepositoryMockDelete) Inspect(f func(ctx context.Context, id int64, tenant string)) *mUserRepositoryMockDelete {
 	if mmDelete.mock.inspectFuncDelete != nil {
 		mmDelete.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.Delete")
 	}
@@ -482,7 +571,7 @@ func (mmDelete *mUserRepositoryMockDelete) Return(err error) *UserRepositoryMock
 }
 
 // Set uses given function f to mock the UserRepository.Delete method
-func (mmDelete *mUserRepositoryMockDelete) Set(f func(ctx context.Context, id int64) (err error)) *UserRepositoryMock {
+func (mmDelete *mUserRepositoryMockDelete) Set(f func(ctx context.Context, id int64, tenant string) (err error)) *UserRepositoryMock {
 	if mmDelete.defaultExpectation != nil {
 		mmDelete.mock.t.Fatalf("Default expectation is already set for the UserRepository.Delete method")
 	}
@@ -497,14 +586,14 @@ func (mmDelete *mUserRepositoryMockDelete) Set(f func(ctx context.Context, id in
 
 // When sets expectation for the UserRepository.Delete which will trigger the result defined by the following
 // Then helper
-func (mmDelete *mUserRepositoryMockDelete) When(ctx context.Context, id int64) *UserRepositoryMockDeleteExpectation {
+func (mmDelete *mUserRepositoryMockDelete) When(ctx context.Context, id int64, tenant string) *UserRepositoryMockDeleteExpectation {
 	if mmDelete.mock.funcDelete != nil {
 		mmDelete.mock.t.Fatalf("UserRepositoryMock.Delete mock is already set by Set")
 	}
 
 	expectation := &UserRepositoryMockDeleteExpectation{
 		mock:   mmDelete.mock,
-		params: &UserRepositoryMockDeleteParams{ctx, id},
+		params: &UserRepositoryMockDeleteParams{ctx, id, tenant},
 	}
 	mmDelete.expectations = append(mmDelete.expectations, expectation)
 	return expectation
@@ -517,15 +606,15 @@ func (e *UserRepositoryMockDeleteExpectation) Then(err error) *UserRepositoryMoc
 }
 
 // Delete implements repository.UserRepository
-func (mmDelete *UserRepositoryMock) Delete(ctx context.Context, id int64) (err error) {
+func (mmDelete *UserRepositoryMock) Delete(ctx context.Context, id int64, tenant string) (err error) {
 	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
 	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
 
 	if mmDelete.inspectFuncDelete != nil {
-		mmDelete.inspectFuncDelete(ctx, id)
+		mmDelete.inspectFuncDelete(ctx, id, tenant)
 	}
 
-	mm_params := UserRepositoryMockDeleteParams{ctx, id}
+	mm_params := UserRepositoryMockDeleteParams{ctx, id, tenant}
 
 	// Record call args
 	mmDelete.DeleteMock.mutex.Lock()
@@ -544,7 +633,7 @@ func (mmDelete *UserRepositoryMock) Delete(ctx context.Context, id int64) (err e
 		mm_want := mmDelete.DeleteMock.defaultExpectation.params
 		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
 
-		mm_got := UserRepositoryMockDeleteParams{ctx, id}
+		mm_got := UserRepositoryMockDeleteParams{ctx, id, tenant}
 
 		if mm_want_ptrs != nil {
 
@@ -556,6 +645,10 @@ func (mmDelete *UserRepositoryMock) Delete(ctx context.Context, id int64) (err e
 				mmDelete.t.Errorf("UserRepositoryMock.Delete got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
 			}
 
+			if mm_want_ptrs.tenant != nil && !minimock.Equal(*mm_want_ptrs.tenant, mm_got.tenant) {
+				mmDelete.t.Errorf("UserRepositoryMock.Delete got unexpected parameter tenant, want: %#v, got: %#v%s\n", *mm_want_ptrs.tenant, mm_got.tenant, minimock.Diff(*mm_want_ptrs.tenant, mm_got.tenant))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmDelete.t.Errorf("UserRepositoryMock.Delete got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
@@ -567,9 +660,9 @@ func (mmDelete *UserRepositoryMock) Delete(ctx context.Context, id int64) (err e
 		return (*mm_results).err
 	}
 	if mmDelete.funcDelete != nil {
-		return mmDelete.funcDelete(ctx, id)
+		return mmDelete.funcDelete(ctx, id, tenant)
 	}
-	mmDelete.t.Fatalf("Unexpected call to UserRepositoryMock.Delete. %v %v", ctx, id)
+	mmDelete.t.Fatalf("Unexpected call to UserRepositoryMock.Delete. %v %v %v", ctx, id, tenant)
 	return
 }
 
@@ -658,14 +751,16 @@ type UserRepositoryMockGetExpectation struct {
 
 // UserRepositoryMockGetParams contains parameters of the UserRepository.Get
 type UserRepositoryMockGetParams struct {
-	ctx context.Context
-	id  int64
+	ctx    context.Context
+	id     int64
+	tenant string
 }
 
 // UserRepositoryMockGetParamPtrs contains pointers to parameters of the UserRepository.Get
 type UserRepositoryMockGetParamPtrs struct {
-	ctx *context.Context
-	id  *int64
+	ctx    *context.Context
+	id     *int64
+	tenant *string
 }
 
 // UserRepositoryMockGetResults contains results of the UserRepository.Get
@@ -675,7 +770,7 @@ type UserRepositoryMockGetResults struct {
 }
 
 // Expect sets up expected params for UserRepository.Get
-func (mmGet *mUserRepositoryMockGet) Expect(ctx context.Context, id int64) *mUserRepositoryMockGet {
+func (mmGet *mUserRepositoryMockGet) Expect(ctx context.Context, id int64, tenant string) *mUserRepositoryMockGet {
 	if mmGet.mock.funcGet != nil {
 		mmGet.mock.t.Fatalf("UserRepositoryMock.Get mock is already set by Set")
 	}
@@ -688,7 +783,7 @@ func (mmGet *mUserRepositoryMockGet) Expect(ctx context.Context, id int64) *mUse
 		mmGet.mock.t.Fatalf("UserRepositoryMock.Get mock is already set by ExpectParams functions")
 	}
 
-	mmGet.defaultExpectation.params = &UserRepositoryMockGetParams{ctx, id}
+	mmGet.defaultExpectation.params = &UserRepositoryMockGetParams{ctx, id, tenant}
 	for _, e := range mmGet.expectations {
 		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
 			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
@@ -742,8 +837,30 @@ func (mmGet *mUserRepositoryMockGet) ExpectIdParam2(id int64) *mUserRepositoryMo
 	return mmGet
 }
 
+// ExpectTenantParam3 sets up expected param tenant for UserRepository.Get
+func (mmGet *mUserRepositoryMockGet) ExpectTenantParam3(tenant string) *mUserRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("UserRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &UserRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("UserRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &UserRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.tenant = &tenant
+
+	return mmGet
+}
+
 // Inspect accepts an inspector function that has same arguments as the UserRepository.Get
-func (mmGet *mUserRepositoryMockGet) Inspect(f func(ctx context.Context, id int64)) *mUserRepositoryMockGet {
+func (mmGet *mUserRepositoryMockGet) Inspect(f func(ctx context.Context, id int64, tenant string)) *mUserRepositoryMockGet {
 	if mmGet.mock.inspectFuncGet != nil {
 		mmGet.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.Get")
 	}
@@ -767,7 +884,7 @@ func (mmGet *mUserRepositoryMockGet) Return(up1 *model.User, err error) *UserRep
 }
 
 // Set uses given function f to mock the UserRepository.Get method
-func (mmGet *mUserRepositoryMockGet) Set(f func(ctx context.Context, id int64) (up1 *model.User, err error)) *UserRepositoryMock {
+func (mmGet *mUserRepositoryMockGet) Set(f func(ctx context.Context, id int64, tenant string) (up1 *model.User, err error)) *UserRepositoryMock {
 	if mmGet.defaultExpectation != nil {
 		mmGet.mock.t.Fatalf("Default expectation is already set for the UserRepository.Get method")
 	}
@@ -782,14 +899,14 @@ func (mmGet *mUserRepositoryMockGet) Set(f func(ctx context.Context, id int64) (
 
 // When sets expectation for the UserRepository.Get which will trigger the result defined by the following
 // Then helper
-func (mmGet *mUserRepositoryMockGet) When(ctx context.Context, id int64) *UserRepositoryMockGetExpectation {
+func (mmGet *mUserRepositoryMockGet) When(ctx context.Context, id int64, tenant string) *UserRepositoryMockGetExpectation {
 	if mmGet.mock.funcGet != nil {
 		mmGet.mock.t.Fatalf("UserRepositoryMock.Get mock is already set by Set")
 	}
 
 	expectation := &UserRepositoryMockGetExpectation{
 		mock:   mmGet.mock,
-		params: &UserRepositoryMockGetParams{ctx, id},
+		params: &UserRepositoryMockGetParams{ctx, id, tenant},
 	}
 	mmGet.expectations = append(mmGet.expectations, expectation)
 	return expectation
@@ -802,15 +919,15 @@ func (e *UserRepositoryMockGetExpectation) Then(up1 *model.User, err error) *Use
 }
 
 // Get implements repository.UserRepository
-func (mmGet *UserRepositoryMock) Get(ctx context.Context, id int64) (up1 *model.User, err error) {
+func (mmGet *UserRepositoryMock) Get(ctx context.Context, id int64, tenant string) (up1 *model.User, err error) {
 	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
 	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
 
 	if mmGet.inspectFuncGet != nil {
-		mmGet.inspectFuncGet(ctx, id)
+		mmGet.inspectFuncGet(ctx, id, tenant)
 	}
 
-	mm_params := UserRepositoryMockGetParams{ctx, id}
+	mm_params := UserRepositoryMockGetParams{ctx, id, tenant}
 
 	// Record call args
 	mmGet.GetMock.mutex.Lock()
@@ -829,7 +946,7 @@ func (mmGet *UserRepositoryMock) Get(ctx context.Context, id int64) (up1 *model.
 		mm_want := mmGet.GetMock.defaultExpectation.params
 		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
 
-		mm_got := UserRepositoryMockGetParams{ctx, id}
+		mm_got := UserRepositoryMockGetParams{ctx, id, tenant}
 
 		if mm_want_ptrs != nil {
 
@@ -841,6 +958,10 @@ func (mmGet *UserRepositoryMock) Get(ctx context.Context, id int64) (up1 *model.
 				mmGet.t.Errorf("UserRepositoryMock.Get got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
 			}
 
+			if mm_want_ptrs.tenant != nil && !minimock.Equal(*mm_want_ptrs.tenant, mm_got.tenant) {
+				mmGet.t.Errorf("UserRepositoryMock.Get got unexpected parameter tenant, want: %#v, got: %#v%s\n", *mm_want_ptrs.tenant, mm_got.tenant, minimock.Diff(*mm_want_ptrs.tenant, mm_got.tenant))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmGet.t.Errorf("UserRepositoryMock.Get got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
@@ -852,9 +973,9 @@ func (mmGet *UserRepositoryMock) Get(ctx context.Context, id int64) (up1 *model.
 		return (*mm_results).up1, (*mm_results).err
 	}
 	if mmGet.funcGet != nil {
-		return mmGet.funcGet(ctx, id)
+		return mmGet.funcGet(ctx, id, tenant)
 	}
-	mmGet.t.Fatalf("Unexpected call to UserRepositoryMock.Get. %v %v", ctx, id)
+	mmGet.t.Fatalf("Unexpected call to UserRepositoryMock.Get. %v %v %v", ctx, id, tenant)
 	return
 }
 
@@ -923,299 +1044,1557 @@ func (m *UserRepositoryMock) MinimockGetInspect() {
 	}
 }
 
-type mUserRepositoryMockUpdate struct {
+type mUserRepositoryMockGetByEmail struct {
 	mock               *UserRepositoryMock
-	defaultExpectation *UserRepositoryMockUpdateExpectation
-	expectations       []*UserRepositoryMockUpdateExpectation
+	defaultExpectation *UserRepositoryMockGetByEmailExpectation
+	expectations       []*UserRepositoryMockGetByEmailExpectation
 
-	callArgs []*UserRepositoryMockUpdateParams
+	callArgs []*UserRepositoryMockGetByEmailParams
 	mutex    sync.RWMutex
 }
 
-// UserRepositoryMockUpdateExpectation specifies expectation struct of the UserRepository.Update
-type UserRepositoryMockUpdateExpectation struct {
+// UserRepositoryMockGetByEmailExpectation specifies expectation struct of the UserRepository.GetByEmail
+type UserRepositoryMockGetByEmailExpectation struct {
 	mock      *UserRepositoryMock
-	params    *UserRepositoryMockUpdateParams
-	paramPtrs *UserRepositoryMockUpdateParamPtrs
-	results   *UserRepositoryMockUpdateResults
+	params    *UserRepositoryMockGetByEmailParams
+	paramPtrs *UserRepositoryMockGetByEmailParamPtrs
+	results   *UserRepositoryMockGetByEmailResults
 	Counter   uint64
 }
 
-// UserRepositoryMockUpdateParams contains parameters of the UserRepository.Update
-type UserRepositoryMockUpdateParams struct {
-	ctx  context.Context
-	user *model.UpdateUser
+// UserRepositoryMockGetByEmailParams contains parameters of the UserRepository.GetByEmail
+type UserRepositoryMockGetByEmailParams struct {
+	ctx   context.Context
+	email string
 }
 
-// UserRepositoryMockUpdateParamPtrs contains pointers to parameters of the UserRepository.Update
-type UserRepositoryMockUpdateParamPtrs struct {
-	ctx  *context.Context
-	user **model.UpdateUser
+// UserRepositoryMockGetByEmailParamPtrs contains pointers to parameters of the UserRepository.GetByEmail
+type UserRepositoryMockGetByEmailParamPtrs struct {
+	ctx   *context.Context
+	email *string
 }
 
-// UserRepositoryMockUpdateResults contains results of the UserRepository.Update
-type UserRepositoryMockUpdateResults struct {
+// UserRepositoryMockGetByEmailResults contains results of the UserRepository.GetByEmail
+type UserRepositoryMockGetByEmailResults struct {
+	up1 *model.User
 	err error
 }
 
-// Expect sets up expected params for UserRepository.Update
-func (mmUpdate *mUserRepositoryMockUpdate) Expect(ctx context.Context, user *model.UpdateUser) *mUserRepositoryMockUpdate {
-	if mmUpdate.mock.funcUpdate != nil {
-		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Set")
+// Expect sets up expected params for UserRepository.GetByEmail
+func (mmGetByEmail *mUserRepositoryMockGetByEmail) Expect(ctx context.Context, email string) *mUserRepositoryMockGetByEmail {
+	if mmGetByEmail.mock.funcGetByEmail != nil {
+		mmGetByEmail.mock.t.Fatalf("UserRepositoryMock.GetByEmail mock is already set by Set")
 	}
 
-	if mmUpdate.defaultExpectation == nil {
-		mmUpdate.defaultExpectation = &UserRepositoryMockUpdateExpectation{}
+	if mmGetByEmail.defaultExpectation == nil {
+		mmGetByEmail.defaultExpectation = &UserRepositoryMockGetByEmailExpectation{}
 	}
 
-	if mmUpdate.defaultExpectation.paramPtrs != nil {
-		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by ExpectParams functions")
+	if mmGetByEmail.defaultExpectation.paramPtrs != nil {
+		mmGetByEmail.mock.t.Fatalf("UserRepositoryMock.GetByEmail mock is already set by ExpectParams functions")
 	}
 
-	mmUpdate.defaultExpectation.params = &UserRepositoryMockUpdateParams{ctx, user}
-	for _, e := range mmUpdate.expectations {
-		if minimock.Equal(e.params, mmUpdate.defaultExpectation.params) {
-			mmUpdate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdate.defaultExpectation.params)
+	mmGetByEmail.defaultExpectation.params = &UserRepositoryMockGetByEmailParams{ctx, email}
+	for _, e := range mmGetByEmail.expectations {
+		if minimock.Equal(e.params, mmGetByEmail.defaultExpectation.params) {
+			mmGetByEmail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetByEmail.defaultExpectation.params)
 		}
 	}
 
-	return mmUpdate
+	return mmGetByEmail
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UserRepository.Update
-func (mmUpdate *mUserRepositoryMockUpdate) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockUpdate {
-	if mmUpdate.mock.funcUpdate != nil {
-		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.GetByEmail
+func (mmGetByEmail *mUserRepositoryMockGetByEmail) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockGetByEmail {
+	if mmGetByEmail.mock.funcGetByEmail != nil {
+		mmGetByEmail.mock.t.Fatalf("UserRepositoryMock.GetByEmail mock is already set by Set")
 	}
 
-	if mmUpdate.defaultExpectation == nil {
-		mmUpdate.defaultExpectation = &UserRepositoryMockUpdateExpectation{}
+	if mmGetByEmail.defaultExpectation == nil {
+		mmGetByEmail.defaultExpectation = &UserRepositoryMockGetByEmailExpectation{}
 	}
 
-	if mmUpdate.defaultExpectation.params != nil {
-		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Expect")
+	if mmGetByEmail.defaultExpectation.params != nil {
+		mmGetByEmail.mock.t.Fatalf("UserRepositoryMock.GetByEmail mock is already set by Expect")
 	}
 
-	if mmUpdate.defaultExpectation.paramPtrs == nil {
-		mmUpdate.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateParamPtrs{}
+	if mmGetByEmail.defaultExpectation.paramPtrs == nil {
+		mmGetByEmail.defaultExpectation.paramPtrs = &UserRepositoryMockGetByEmailParamPtrs{}
 	}
-	mmUpdate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetByEmail.defaultExpectation.paramPtrs.ctx = &ctx
 
-	return mmUpdate
+	return mmGetByEmail
 }
 
-// ExpectUserParam2 sets up expected param user for UserRepository.Update
-func (mmUpdate *mUserRepositoryMockUpdate) ExpectUserParam2(user *model.UpdateUser) *mUserRepositoryMockUpdate {
-	if mmUpdate.mock.funcUpdate != nil {
-		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Set")
+// ExpectEmailParam2 sets up expected param email for UserRepository.GetByEmail
+func (mmGetByEmail *mUserRepositoryMockGetByEmail) ExpectEmailParam2(email string) *mUserRepositoryMockGetByEmail {
+	if mmGetByEmail.mock.funcGetByEmail != nil {
+		mmGetByEmail.mock.t.Fatalf("UserRepositoryMock.GetByEmail mock is already set by Set")
 	}
 
-	if mmUpdate.defaultExpectation == nil {
-		mmUpdate.defaultExpectation = &UserRepositoryMockUpdateExpectation{}
+	if mmGetByEmail.defaultExpectation == nil {
+		mmGetByEmail.defaultExpectation = &UserRepositoryMockGetByEmailExpectation{}
 	}
 
-	if mmUpdate.defaultExpectation.params != nil {
-		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Expect")
+	if mmGetByEmail.defaultExpectation.params != nil {
+		mmGetByEmail.mock.t.Fatalf("UserRepositoryMock.GetByEmail mock is already set by Expect")
 	}
 
-	if mmUpdate.defaultExpectation.paramPtrs == nil {
-		mmUpdate.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateParamPtrs{}
+	if mmGetByEmail.defaultExpectation.paramPtrs == nil {
+		mmGetByEmail.defaultExpectation.paramPtrs = &UserRepositoryMockGetByEmailParamPtrs{}
 	}
-	mmUpdate.defaultExpectation.paramPtrs.user = &user
+	mmGetByEmail.defaultExpectation.paramPtrs.email = &email
 
-	return mmUpdate
+	return mmGetByEmail
 }
 
-// Inspect accepts an inspector function that has same arguments as the UserRepository.Update
-func (mmUpdate *mUserRepositoryMockUpdate) Inspect(f func(ctx context.Context, user *model.UpdateUser)) *mUserRepositoryMockUpdate {
-	if mmUpdate.mock.inspectFuncUpdate != nil {
-		mmUpdate.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.Update")
+// Inspect accepts an inspector function that has same arguments as the UserRepository.GetByEmail
+func (mmGetByEmail *mUserRepositoryMockGetByEmail) Inspect(f func(ctx context.Context, email string)) *mUserRepositoryMockGetByEmail {
+	if mmGetByEmail.mock.inspectFuncGetByEmail != nil {
+		mmGetByEmail.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.GetByEmail")
 	}
 
-	mmUpdate.mock.inspectFuncUpdate = f
+	mmGetByEmail.mock.inspectFuncGetByEmail = f
 
-	return mmUpdate
+	return mmGetByEmail
 }
 
-// Return sets up results that will be returned by UserRepository.Update
-func (mmUpdate *mUserRepositoryMockUpdate) Return(err error) *UserRepositoryMock {
-	if mmUpdate.mock.funcUpdate != nil {
-		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Set")
+// Return sets up results that will be returned by UserRepository.GetByEmail
+func (mmGetByEmail *mUserRepositoryMockGetByEmail) Return(up1 *model.User, err error) *UserRepositoryMock {
+	if mmGetByEmail.mock.funcGetByEmail != nil {
+		mmGetByEmail.mock.t.Fatalf("UserRepositoryMock.GetByEmail mock is already set by Set")
 	}
 
-	if mmUpdate.defaultExpectation == nil {
-		mmUpdate.defaultExpectation = &UserRepositoryMockUpdateExpectation{mock: mmUpdate.mock}
+	if mmGetByEmail.defaultExpectation == nil {
+		mmGetByEmail.defaultExpectation = &UserRepositoryMockGetByEmailExpectation{mock: mmGetByEmail.mock}
 	}
-	mmUpdate.defaultExpectation.results = &UserRepositoryMockUpdateResults{err}
-	return mmUpdate.mock
+	mmGetByEmail.defaultExpectation.results = &UserRepositoryMockGetByEmailResults{up1, err}
+	return mmGetByEmail.mock
 }
 
-// Set uses given function f to mock the UserRepository.Update method
-func (mmUpdate *mUserRepositoryMockUpdate) Set(f func(ctx context.Context, user *model.UpdateUser) (err error)) *UserRepositoryMock {
-	if mmUpdate.defaultExpectation != nil {
-		mmUpdate.mock.t.Fatalf("Default expectation is already set for the UserRepository.Update method")
+// Set uses given function f to mock the UserRepository.GetByEmail method
+func (mmGetByEmail *mUserRepositoryMockGetByEmail) Set(f func(ctx context.Context, email string) (up1 *model.User, err error)) *UserRepositoryMock {
+	if mmGetByEmail.defaultExpectation != nil {
+		mmGetByEmail.mock.t.Fatalf("Default expectation is already set for the UserRepository.GetByEmail method")
 	}
 
-	if len(mmUpdate.expectations) > 0 {
-		mmUpdate.mock.t.Fatalf("Some expectations are already set for the UserRepository.Update method")
+	if len(mmGetByEmail.expectations) > 0 {
+		mmGetByEmail.mock.t.Fatalf("Some expectations are already set for the UserRepository.GetByEmail method")
 	}
 
-	mmUpdate.mock.funcUpdate = f
-	return mmUpdate.mock
+	mmGetByEmail.mock.funcGetByEmail = f
+	return mmGetByEmail.mock
 }
 
-// When sets expectation for the UserRepository.Update which will trigger the result defined by the following
+// When sets expectation for the UserRepository.GetByEmail which will trigger the result defined by the following
 // Then helper
-func (mmUpdate *mUserRepositoryMockUpdate) When(ctx context.Context, user *model.UpdateUser) *UserRepositoryMockUpdateExpectation {
-	if mmUpdate.mock.funcUpdate != nil {
-		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Set")
+func (mmGetByEmail *mUserRepositoryMockGetByEmail) When(ctx context.Context, email string) *UserRepositoryMockGetByEmailExpectation {
+	if mmGetByEmail.mock.funcGetByEmail != nil {
+		mmGetByEmail.mock.t.Fatalf("UserRepositoryMock.GetByEmail mock is already set by Set")
 	}
 
-	expectation := &UserRepositoryMockUpdateExpectation{
-		mock:   mmUpdate.mock,
-		params: &UserRepositoryMockUpdateParams{ctx, user},
+	expectation := &UserRepositoryMockGetByEmailExpectation{
+		mock:   mmGetByEmail.mock,
+		params: &UserRepositoryMockGetByEmailParams{ctx, email},
 	}
-	mmUpdate.expectations = append(mmUpdate.expectations, expectation)
+	mmGetByEmail.expectations = append(mmGetByEmail.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UserRepository.Update return parameters for the expectation previously defined by the When method
-func (e *UserRepositoryMockUpdateExpectation) Then(err error) *UserRepositoryMock {
-	e.results = &UserRepositoryMockUpdateResults{err}
+// Then sets up UserRepository.GetByEmail return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockGetByEmailExpectation) Then(up1 *model.User, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockGetByEmailResults{up1, err}
 	return e.mock
 }
 
-// Update implements repository.UserRepository
-func (mmUpdate *UserRepositoryMock) Update(ctx context.Context, user *model.UpdateUser) (err error) {
-	mm_atomic.AddUint64(&mmUpdate.beforeUpdateCounter, 1)
-	defer mm_atomic.AddUint64(&mmUpdate.afterUpdateCounter, 1)
+// GetByEmail implements repository.UserRepository
+func (mmGetByEmail *UserRepositoryMock) GetByEmail(ctx context.Context, email string) (up1 *model.User, err error) {
+	mm_atomic.AddUint64(&mmGetByEmail.beforeGetByEmailCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetByEmail.afterGetByEmailCounter, 1)
 
-	if mmUpdate.inspectFuncUpdate != nil {
-		mmUpdate.inspectFuncUpdate(ctx, user)
+	if mmGetByEmail.inspectFuncGetByEmail != nil {
+		mmGetByEmail.inspectFuncGetByEmail(ctx, email)
 	}
 
-	mm_params := UserRepositoryMockUpdateParams{ctx, user}
+	mm_params := UserRepositoryMockGetByEmailParams{ctx, email}
 
 	// Record call args
-	mmUpdate.UpdateMock.mutex.Lock()
-	mmUpdate.UpdateMock.callArgs = append(mmUpdate.UpdateMock.callArgs, &mm_params)
-	mmUpdate.UpdateMock.mutex.Unlock()
+	mmGetByEmail.GetByEmailMock.mutex.Lock()
+	mmGetByEmail.GetByEmailMock.callArgs = append(mmGetByEmail.GetByEmailMock.callArgs, &mm_params)
+	mmGetByEmail.GetByEmailMock.mutex.Unlock()
 
-	for _, e := range mmUpdate.UpdateMock.expectations {
+	for _, e := range mmGetByEmail.GetByEmailMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.up1, e.results.err
 		}
 	}
 
-	if mmUpdate.UpdateMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmUpdate.UpdateMock.defaultExpectation.Counter, 1)
-		mm_want := mmUpdate.UpdateMock.defaultExpectation.params
-		mm_want_ptrs := mmUpdate.UpdateMock.defaultExpectation.paramPtrs
+	if mmGetByEmail.GetByEmailMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetByEmail.GetByEmailMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetByEmail.GetByEmailMock.defaultExpectation.params
+		mm_want_ptrs := mmGetByEmail.GetByEmailMock.defaultExpectation.paramPtrs
 
-		mm_got := UserRepositoryMockUpdateParams{ctx, user}
+		mm_got := UserRepositoryMockGetByEmailParams{ctx, email}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmUpdate.t.Errorf("UserRepositoryMock.Update got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmGetByEmail.t.Errorf("UserRepositoryMock.GetByEmail got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.user != nil && !minimock.Equal(*mm_want_ptrs.user, mm_got.user) {
-				mmUpdate.t.Errorf("UserRepositoryMock.Update got unexpected parameter user, want: %#v, got: %#v%s\n", *mm_want_ptrs.user, mm_got.user, minimock.Diff(*mm_want_ptrs.user, mm_got.user))
+			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
+				mmGetByEmail.t.Errorf("UserRepositoryMock.GetByEmail got unexpected parameter email, want: %#v, got: %#v%s\n", *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmUpdate.t.Errorf("UserRepositoryMock.Update got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmGetByEmail.t.Errorf("UserRepositoryMock.GetByEmail got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmUpdate.UpdateMock.defaultExpectation.results
+		mm_results := mmGetByEmail.GetByEmailMock.defaultExpectation.results
 		if mm_results == nil {
-			mmUpdate.t.Fatal("No results are set for the UserRepositoryMock.Update")
+			mmGetByEmail.t.Fatal("No results are set for the UserRepositoryMock.GetByEmail")
 		}
-		return (*mm_results).err
+		return (*mm_results).up1, (*mm_results).err
 	}
-	if mmUpdate.funcUpdate != nil {
-		return mmUpdate.funcUpdate(ctx, user)
+	if mmGetByEmail.funcGetByEmail != nil {
+		return mmGetByEmail.funcGetByEmail(ctx, email)
 	}
-	mmUpdate.t.Fatalf("Unexpected call to UserRepositoryMock.Update. %v %v", ctx, user)
+	mmGetByEmail.t.Fatalf("Unexpected call to UserRepositoryMock.GetByEmail. %v %v", ctx, email)
 	return
 }
 
-// UpdateAfterCounter returns a count of finished UserRepositoryMock.Update invocations
-func (mmUpdate *UserRepositoryMock) UpdateAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdate.afterUpdateCounter)
+// GetByEmailAfterCounter returns a count of finished UserRepositoryMock.GetByEmail invocations
+func (mmGetByEmail *UserRepositoryMock) GetByEmailAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetByEmail.afterGetByEmailCounter)
 }
 
-// UpdateBeforeCounter returns a count of UserRepositoryMock.Update invocations
-func (mmUpdate *UserRepositoryMock) UpdateBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdate.beforeUpdateCounter)
+// GetByEmailBeforeCounter returns a count of UserRepositoryMock.GetByEmail invocations
+func (mmGetByEmail *UserRepositoryMock) GetByEmailBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetByEmail.beforeGetByEmailCounter)
 }
 
-// Calls returns a list of arguments used in each call to UserRepositoryMock.Update.
+// Calls returns a list of arguments used in each call to UserRepositoryMock.GetByEmail.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmUpdate *mUserRepositoryMockUpdate) Calls() []*UserRepositoryMockUpdateParams {
-	mmUpdate.mutex.RLock()
+func (mmGetByEmail *mUserRepositoryMockGetByEmail) Calls() []*UserRepositoryMockGetByEmailParams {
+	mmGetByEmail.mutex.RLock()
 
-	argCopy := make([]*UserRepositoryMockUpdateParams, len(mmUpdate.callArgs))
-	copy(argCopy, mmUpdate.callArgs)
+	argCopy := make([]*UserRepositoryMockGetByEmailParams, len(mmGetByEmail.callArgs))
+	copy(argCopy, mmGetByEmail.callArgs)
 
-	mmUpdate.mutex.RUnlock()
+	mmGetByEmail.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockUpdateDone returns true if the count of the Update invocations corresponds
+// MinimockGetByEmailDone returns true if the count of the GetByEmail invocations corresponds
 // the number of defined expectations
-func (m *UserRepositoryMock) MinimockUpdateDone() bool {
-	for _, e := range m.UpdateMock.expectations {
+func (m *UserRepositoryMock) MinimockGetByEmailDone() bool {
+	for _, e := range m.GetByEmailMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.UpdateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateCounter) < 1 {
+	if m.GetByEmailMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetByEmailCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcUpdate != nil && mm_atomic.LoadUint64(&m.afterUpdateCounter) < 1 {
+	if m.funcGetByEmail != nil && mm_atomic.LoadUint64(&m.afterGetByEmailCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockUpdateInspect logs each unmet expectation
-func (m *UserRepositoryMock) MinimockUpdateInspect() {
-	for _, e := range m.UpdateMock.expectations {
+// MinimockGetByEmailInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockGetByEmailInspect() {
+	for _, e := range m.GetByEmailMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UserRepositoryMock.Update with params: %#v", *e.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.GetByEmail with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.UpdateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateCounter) < 1 {
-		if m.UpdateMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to UserRepositoryMock.Update")
+	if m.GetByEmailMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetByEmailCounter) < 1 {
+		if m.GetByEmailMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserRepositoryMock.GetByEmail")
 		} else {
-			m.t.Errorf("Expected call to UserRepositoryMock.Update with params: %#v", *m.UpdateMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.GetByEmail with params: %#v", *m.GetByEmailMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcUpdate != nil && mm_atomic.LoadUint64(&m.afterUpdateCounter) < 1 {
-		m.t.Error("Expected call to UserRepositoryMock.Update")
+	if m.funcGetByEmail != nil && mm_atomic.LoadUint64(&m.afterGetByEmailCounter) < 1 {
+		m.t.Error("Expected call to UserRepositoryMock.GetByEmail")
 	}
 }
 
-// MinimockFinish checks that all mocked methods have been called the expected number of times
-func (m *UserRepositoryMock) MinimockFinish() {
-	m.finishOnce.Do(func() {
-		if !m.minimockDone() {
-			m.MinimockCreateInspect()
+type mUserRepositoryMockListTokenRevocations struct {
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockListTokenRevocationsExpectation
+	expectations       []*UserRepositoryMockListTokenRevocationsExpectation
 
-			m.MinimockDeleteInspect()
+	callArgs []*UserRepositoryMockListTokenRevocationsParams
+	mutex    sync.RWMutex
+}
 
-			m.MinimockGetInspect()
+// UserRepositoryMockListTokenRevocationsExpectation specifies expectation struct of the UserRepository.ListTokenRevocations
+type UserRepositoryMockListTokenRevocationsExpectation struct {
+	mock      *UserRepositoryMock
+	params    *UserRepositoryMockListTokenRevocationsParams
+	paramPtrs *UserRepositoryMockListTokenRevocationsParamPtrs
+	results   *UserRepositoryMockListTokenRevocationsResults
+	Counter   uint64
+}
+
+// UserRepositoryMockListTokenRevocationsParams contains parameters of the UserRepository.ListTokenRevocations
+type UserRepositoryMockListTokenRevocationsParams struct {
+	ctx   context.Context
+	since time.Time
+}
+
+// UserRepositoryMockListTokenRevocationsParamPtrs contains pointers to parameters of the UserRepository.ListTokenRevocations
+type UserRepositoryMockListTokenRevocationsParamPtrs struct {
+	ctx   *context.Context
+	since *time.Time
+}
+
+// UserRepositoryMockListTokenRevocationsResults contains results of the UserRepository.ListTokenRevocations
+type UserRepositoryMockListTokenRevocationsResults struct {
+	ta1 []model.TokenRevocation
+	err error
+}
+
+// Expect sets up expected params for UserRepository.ListTokenRevocations
+func (mmListTokenRevocations *mUserRepositoryMockListTokenRevocations) Expect(ctx context.Context, since time.Time) *mUserRepositoryMockListTokenRevocations {
+	if mmListTokenRevocations.mock.funcListTokenRevocations != nil {
+		mmListTokenRevocations.mock.t.Fatalf("UserRepositoryMock.ListTokenRevocations mock is already set by Set")
+	}
+
+	if mmListTokenRevocations.defaultExpectation == nil {
+		mmListTokenRevocations.defaultExpectation = &UserRepositoryMockListTokenRevocationsExpectation{}
+	}
+
+	if mmListTokenRevocations.defaultExpectation.paramPtrs != nil {
+		mmListTokenRevocations.mock.t.Fatalf("UserRepositoryMock.ListTokenRevocations mock is already set by ExpectParams functions")
+	}
+
+	mmListTokenRevocations.defaultExpectation.params = &UserRepositoryMockListTokenRevocationsParams{ctx, since}
+	for _, e := range mmListTokenRevocations.expectations {
+		if minimock.Equal(e.params, mmListTokenRevocations.defaultExpectation.params) {
+			mmListTokenRevocations.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListTokenRevocations.defaultExpectation.params)
+		}
+	}
+
+	return mmListTokenRevocations
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.ListTokenRevocations
+func (mmListTokenRevocations *mUserRepositoryMockListTokenRevocations) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockListTokenRevocations {
+	if mmListTokenRevocations.mock.funcListTokenRevocations != nil {
+		mmListTokenRevocations.mock.t.Fatalf("UserRepositoryMock.ListTokenRevocations mock is already set by Set")
+	}
+
+	if mmListTokenRevocations.defaultExpectation == nil {
+		mmListTokenRevocations.defaultExpectation = &UserRepositoryMockListTokenRevocationsExpectation{}
+	}
+
+	if mmListTokenRevocations.defaultExpectation.params != nil {
+		mmListTokenRevocations.mock.t.Fatalf("UserRepositoryMock.ListTokenRevocations mock is already set by Expect")
+	}
+
+	if mmListTokenRevocations.defaultExpectation.paramPtrs == nil {
+		mmListTokenRevocations.defaultExpectation.paramPtrs = &UserRepositoryMockListTokenRevocationsParamPtrs{}
+	}
+	mmListTokenRevocations.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListTokenRevocations
+}
+
+// ExpectSinceParam2 sets up expected param since for UserRepository.ListTokenRevocations
+func (mmListTokenRevocations *mUserRepositoryMockListTokenRevocations) ExpectSinceParam2(since time.Time) *mUserRepositoryMockListTokenRevocations {
+	if mmListTokenRevocations.mock.funcListTokenRevocations != nil {
+		mmListTokenRevocations.mock.t.Fatalf("UserRepositoryMock.ListTokenRevocations mock is already set by Set")
+	}
+
+	if mmListTokenRevocations.defaultExpectation == nil {
+		mmListTokenRevocations.defaultExpectation = &UserRepositoryMockListTokenRevocationsExpectation{}
+	}
+
+	if mmListTokenRevocations.defaultExpectation.params != nil {
+		mmListTokenRevocations.mock.t.Fatalf("UserRepositoryMock.ListTokenRevocations mock is already set by Expect")
+	}
+
+	if mmListTokenRevocations.defaultExpectation.paramPtrs == nil {
+		mmListTokenRevocations.defaultExpectation.paramPtrs = &UserRepositoryMockListTokenRevocationsParamPtrs{}
+	}
+	mmListTokenRevocations.defaultExpectation.paramPtrs.since = &since
+
+	return mmListTokenRevocations
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.ListTokenRevocations
+func (mmListTokenRevocations *mUserRepositoryMockListTokenRevocations) Inspect(f func(ctx context.Context, since time.Time)) *mUserRepositoryMockListTokenRevocations {
+	if mmListTokenRevocations.mock.inspectFuncListTokenRevocations != nil {
+		mmListTokenRevocations.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.ListTokenRevocations")
+	}
+
+	mmListTokenRevocations.mock.inspectFuncListTokenRevocations = f
+
+	return mmListTokenRevocations
+}
+
+// Return sets up results that will be returned by UserRepository.ListTokenRevocations
+func (mmListTokenRevocations *mUserRepositoryMockListTokenRevocations) Return(ta1 []model.TokenRevocation, err error) *UserRepositoryMock {
+	if mmListTokenRevocations.mock.funcListTokenRevocations != nil {
+		mmListTokenRevocations.mock.t.Fatalf("UserRepositoryMock.ListTokenRevocations mock is already set by Set")
+	}
+
+	if mmListTokenRevocations.defaultExpectation == nil {
+		mmListTokenRevocations.defaultExpectation = &UserRepositoryMockListTokenRevocationsExpectation{mock: mmListTokenRevocations.mock}
+	}
+	mmListTokenRevocations.defaultExpectation.results = &UserRepositoryMockListTokenRevocationsResults{ta1, err}
+	return mmListTokenRevocations.mock
+}
+
+// Set uses given function f to mock the UserRepository.ListTokenRevocations method
+func (mmListTokenRevocations *mUserRepositoryMockListTokenRevocations) Set(f func(ctx context.Context, since time.Time) (ta1 []model.TokenRevocation, err error)) *UserRepositoryMock {
+	if mmListTokenRevocations.defaultExpectation != nil {
+		mmListTokenRevocations.mock.t.Fatalf("Default expectation is already set for the UserRepository.ListTokenRevocations method")
+	}
+
+	if len(mmListTokenRevocations.expectations) > 0 {
+		mmListTokenRevocations.mock.t.Fatalf("Some expectations are already set for the UserRepository.ListTokenRevocations method")
+	}
+
+	mmListTokenRevocations.mock.funcListTokenRevocations = f
+	return mmListTokenRevocations.mock
+}
+
+// When sets expectation for the UserRepository.ListTokenRevocations which will trigger the result defined by the following
+// Then helper
+func (mmListTokenRevocations *mUserRepositoryMockListTokenRevocations) When(ctx context.Context, since time.Time) *UserRepositoryMockListTokenRevocationsExpectation {
+	if mmListTokenRevocations.mock.funcListTokenRevocations != nil {
+		mmListTokenRevocations.mock.t.Fatalf("UserRepositoryMock.ListTokenRevocations mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockListTokenRevocationsExpectation{
+		mock:   mmListTokenRevocations.mock,
+		params: &UserRepositoryMockListTokenRevocationsParams{ctx, since},
+	}
+	mmListTokenRevocations.expectations = append(mmListTokenRevocations.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.ListTokenRevocations return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockListTokenRevocationsExpectation) Then(ta1 []model.TokenRevocation, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockListTokenRevocationsResults{ta1, err}
+	return e.mock
+}
+
+// ListTokenRevocations implements repository.UserRepository
+func (mmListTokenRevocations *UserRepositoryMock) ListTokenRevocations(ctx context.Context, since time.Time) (ta1 []model.TokenRevocation, err error) {
+	mm_atomic.AddUint64(&mmListTokenRevocations.beforeListTokenRevocationsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListTokenRevocations.afterListTokenRevocationsCounter, 1)
+
+	if mmListTokenRevocations.inspectFuncListTokenRevocations != nil {
+		mmListTokenRevocations.inspectFuncListTokenRevocations(ctx, since)
+	}
+
+	mm_params := UserRepositoryMockListTokenRevocationsParams{ctx, since}
+
+	// Record call args
+	mmListTokenRevocations.ListTokenRevocationsMock.mutex.Lock()
+	mmListTokenRevocations.ListTokenRevocationsMock.callArgs = append(mmListTokenRevocations.ListTokenRevocationsMock.callArgs, &mm_params)
+	mmListTokenRevocations.ListTokenRevocationsMock.mutex.Unlock()
+
+	for _, e := range mmListTokenRevocations.ListTokenRevocationsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ta1, e.results.err
+		}
+	}
+
+	if mmListTokenRevocations.ListTokenRevocationsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListTokenRevocations.ListTokenRevocationsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListTokenRevocations.ListTokenRevocationsMock.defaultExpectation.params
+		mm_want_ptrs := mmListTokenRevocations.ListTokenRevocationsMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockListTokenRevocationsParams{ctx, since}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListTokenRevocations.t.Errorf("UserRepositoryMock.ListTokenRevocations got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.since != nil && !minimock.Equal(*mm_want_ptrs.since, mm_got.since) {
+				mmListTokenRevocations.t.Errorf("UserRepositoryMock.ListTokenRevocations got unexpected parameter since, want: %#v, got: %#v%s\n", *mm_want_ptrs.since, mm_got.since, minimock.Diff(*mm_want_ptrs.since, mm_got.since))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListTokenRevocations.t.Errorf("UserRepositoryMock.ListTokenRevocations got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListTokenRevocations.ListTokenRevocationsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListTokenRevocations.t.Fatal("No results are set for the UserRepositoryMock.ListTokenRevocations")
+		}
+		return (*mm_results).ta1, (*mm_results).err
+	}
+	if mmListTokenRevocations.funcListTokenRevocations != nil {
+		return mmListTokenRevocations.funcListTokenRevocations(ctx, since)
+	}
+	mmListTokenRevocations.t.Fatalf("Unexpected call to UserRepositoryMock.ListTokenRevocations. %v %v", ctx, since)
+	return
+}
+
+// ListTokenRevocationsAfterCounter returns a count of finished UserRepositoryMock.ListTokenRevocations invocations
+func (mmListTokenRevocations *UserRepositoryMock) ListTokenRevocationsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListTokenRevocations.afterListTokenRevocationsCounter)
+}
+
+// ListTokenRevocationsBeforeCounter returns a count of UserRepositoryMock.ListTokenRevocations invocations
+func (mmListTokenRevocations *UserRepositoryMock) ListTokenRevocationsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListTokenRevocations.beforeListTokenRevocationsCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.ListTokenRevocations.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListTokenRevocations *mUserRepositoryMockListTokenRevocations) Calls() []*UserRepositoryMockListTokenRevocationsParams {
+	mmListTokenRevocations.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockListTokenRevocationsParams, len(mmListTokenRevocations.callArgs))
+	copy(argCopy, mmListTokenRevocations.callArgs)
+
+	mmListTokenRevocations.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListTokenRevocationsDone returns true if the count of the ListTokenRevocations invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockListTokenRevocationsDone() bool {
+	for _, e := range m.ListTokenRevocationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListTokenRevocationsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListTokenRevocationsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListTokenRevocations != nil && mm_atomic.LoadUint64(&m.afterListTokenRevocationsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockListTokenRevocationsInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockListTokenRevocationsInspect() {
+	for _, e := range m.ListTokenRevocationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.ListTokenRevocations with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListTokenRevocationsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListTokenRevocationsCounter) < 1 {
+		if m.ListTokenRevocationsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserRepositoryMock.ListTokenRevocations")
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.ListTokenRevocations with params: %#v", *m.ListTokenRevocationsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListTokenRevocations != nil && mm_atomic.LoadUint64(&m.afterListTokenRevocationsCounter) < 1 {
+		m.t.Error("Expected call to UserRepositoryMock.ListTokenRevocations")
+	}
+}
+
+type mUserRepositoryMockRevokeTokens struct {
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockRevokeTokensExpectation
+	expectations       []*UserRepositoryMockRevokeTokensExpectation
+
+	callArgs []*UserRepositoryMockRevokeTokensParams
+	mutex    sync.RWMutex
+}
+
+// UserRepositoryMockRevokeTokensExpectation specifies expectation struct of the UserRepository.RevokeTokens
+type UserRepositoryMockRevokeTokensExpectation struct {
+	mock      *UserRepositoryMock
+	params    *UserRepositoryMockRevokeTokensParams
+	paramPtrs *UserRepositoryMockRevokeTokensParamPtrs
+	results   *UserRepositoryMockRevokeTokensResults
+	Counter   uint64
+}
+
+// UserRepositoryMockRevokeTokensParams contains parameters of the UserRepository.RevokeTokens
+type UserRepositoryMockRevokeTokensParams struct {
+	ctx       context.Context
+	id        int64
+	revokedAt time.Time
+}
+
+// UserRepositoryMockRevokeTokensParamPtrs contains pointers to parameters of the UserRepository.RevokeTokens
+type UserRepositoryMockRevokeTokensParamPtrs struct {
+	ctx       *context.Context
+	id        *int64
+	revokedAt *time.Time
+}
+
+// UserRepositoryMockRevokeTokensResults contains results of the UserRepository.RevokeTokens
+type UserRepositoryMockRevokeTokensResults struct {
+	err error
+}
+
+// Expect sets up expected params for UserRepository.RevokeTokens
+func (mmRevokeTokens *mUserRepositoryMockRevokeTokens) Expect(ctx context.Context, id int64, revokedAt time.Time) *mUserRepositoryMockRevokeTokens {
+	if mmRevokeTokens.mock.funcRevokeTokens != nil {
+		mmRevokeTokens.mock.t.Fatalf("UserRepositoryMock.RevokeTokens mock is already set by Set")
+	}
+
+	if mmRevokeTokens.defaultExpectation == nil {
+		mmRevokeTokens.defaultExpectation = &UserRepositoryMockRevokeTokensExpectation{}
+	}
+
+	if mmRevokeTokens.defaultExpectation.paramPtrs != nil {
+		mmRevokeTokens.mock.t.Fatalf("UserRepositoryMock.RevokeTokens mock is already set by ExpectParams functions")
+	}
+
+	mmRevokeTokens.defaultExpectation.params = &UserRepositoryMockRevokeTokensParams{ctx, id, revokedAt}
+	for _, e := range mmRevokeTokens.expectations {
+		if minimock.Equal(e.params, mmRevokeTokens.defaultExpectation.params) {
+			mmRevokeTokens.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeTokens.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokeTokens
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.RevokeTokens
+func (mmRevokeTokens *mUserRepositoryMockRevokeTokens) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockRevokeTokens {
+	if mmRevokeTokens.mock.funcRevokeTokens != nil {
+		mmRevokeTokens.mock.t.Fatalf("UserRepositoryMock.RevokeTokens mock is already set by Set")
+	}
+
+	if mmRevokeTokens.defaultExpectation == nil {
+		mmRevokeTokens.defaultExpectation = &UserRepositoryMockRevokeTokensExpectation{}
+	}
+
+	if mmRevokeTokens.defaultExpectation.params != nil {
+		mmRevokeTokens.mock.t.Fatalf("UserRepositoryMock.RevokeTokens mock is already set by Expect")
+	}
+
+	if mmRevokeTokens.defaultExpectation.paramPtrs == nil {
+		mmRevokeTokens.defaultExpectation.paramPtrs = &UserRepositoryMockRevokeTokensParamPtrs{}
+	}
+	mmRevokeTokens.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRevokeTokens
+}
+
+// ExpectIdParam2 sets up expected param id for UserRepository.RevokeTokens
+func (mmRevokeTokens *mUserRepositoryMockRevokeTokens) ExpectIdParam2(id int64) *mUserRepositoryMockRevokeTokens {
+	if mmRevokeTokens.mock.funcRevokeTokens != nil {
+		mmRevokeTokens.mock.t.Fatalf("UserRepositoryMock.RevokeTokens mock is already set by Set")
+	}
+
+	if mmRevokeTokens.defaultExpectation == nil {
+		mmRevokeTokens.defaultExpectation = &UserRepositoryMockRevokeTokensExpectation{}
+	}
+
+	if mmRevokeTokens.defaultExpectation.params != nil {
+		mmRevokeTokens.mock.t.Fatalf("UserRepositoryMock.RevokeTokens mock is already set by Expect")
+	}
+
+	if mmRevokeTokens.defaultExpectation.paramPtrs == nil {
+		mmRevokeTokens.defaultExpectation.paramPtrs = &UserRepositoryMockRevokeTokensParamPtrs{}
+	}
+	mmRevokeTokens.defaultExpectation.paramPtrs.id = &id
+
+	return mmRevokeTokens
+}
+
+// ExpectRevokedAtParam3 sets up expected param revokedAt for UserRepository.RevokeTokens
+func (mmRevokeTokens *mUserRepositoryMockRevokeTokens) ExpectRevokedAtParam3(revokedAt time.Time) *mUserRepositoryMockRevokeTokens {
+	if mmRevokeTokens.mock.funcRevokeTokens != nil {
+		mmRevokeTokens.mock.t.Fatalf("UserRepositoryMock.RevokeTokens mock is already set by Set")
+	}
+
+	if mmRevokeTokens.defaultExpectation == nil {
+		mmRevokeTokens.defaultExpectation = &UserRepositoryMockRevokeTokensExpectation{}
+	}
+
+	if mmRevokeTokens.defaultExpectation.params != nil {
+		mmRevokeTokens.mock.t.Fatalf("UserRepositoryMock.RevokeTokens mock is already set by Expect")
+	}
+
+	if mmRevokeTokens.defaultExpectation.paramPtrs == nil {
+		mmRevokeTokens.defaultExpectation.paramPtrs = &UserRepositoryMockRevokeTokensParamPtrs{}
+	}
+	mmRevokeTokens.defaultExpectation.paramPtrs.revokedAt = &revokedAt
+
+	return mmRevokeTokens
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.RevokeTokens
+func (mmRevokeTokens *mUserRepositoryMockRevokeTokens) Inspect(f func(ctx context.Context, id int64, revokedAt time.Time)) *mUserRepositoryMockRevokeTokens {
+	if mmRevokeTokens.mock.inspectFuncRevokeTokens != nil {
+		mmRevokeTokens.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.RevokeTokens")
+	}
+
+	mmRevokeTokens.mock.inspectFuncRevokeTokens = f
+
+	return mmRevokeTokens
+}
+
+// Return sets up results that will be returned by UserRepository.RevokeTokens
+func (mmRevokeTokens *mUserRepositoryMockRevokeTokens) Return(err error) *UserRepositoryMock {
+	if mmRevokeTokens.mock.funcRevokeTokens != nil {
+		mmRevokeTokens.mock.t.Fatalf("UserRepositoryMock.RevokeTokens mock is already set by Set")
+	}
+
+	if mmRevokeTokens.defaultExpectation == nil {
+		mmRevokeTokens.defaultExpectation = &UserRepositoryMockRevokeTokensExpectation{mock: mmRevokeTokens.mock}
+	}
+	mmRevokeTokens.defaultExpectation.results = &UserRepositoryMockRevokeTokensResults{err}
+	return mmRevokeTokens.mock
+}
+
+// Set uses given function f to mock the UserRepository.RevokeTokens method
+func (mmRevokeTokens *mUserRepositoryMockRevokeTokens) Set(f func(ctx context.Context, id int64, revokedAt time.Time) (err error)) *UserRepositoryMock {
+	if mmRevokeTokens.defaultExpectation != nil {
+		mmRevokeTokens.mock.t.Fatalf("Default expectation is already set for the UserRepository.RevokeTokens method")
+	}
+
+	if len(mmRevokeTokens.expectations) > 0 {
+		mmRevokeTokens.mock.t.Fatalf("Some expectations are already set for the UserRepository.RevokeTokens method")
+	}
+
+	mmRevokeTokens.mock.funcRevokeTokens = f
+	return mmRevokeTokens.mock
+}
+
+// When sets expectation for the UserRepository.RevokeTokens which will trigger the result defined by the following
+// Then helper
+func (mmRevokeTokens *mUserRepositoryMockRevokeTokens) When(ctx context.Context, id int64, revokedAt time.Time) *UserRepositoryMockRevokeTokensExpectation {
+	if mmRevokeTokens.mock.funcRevokeTokens != nil {
+		mmRevokeTokens.mock.t.Fatalf("UserRepositoryMock.RevokeTokens mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockRevokeTokensExpectation{
+		mock:   mmRevokeTokens.mock,
+		params: &UserRepositoryMockRevokeTokensParams{ctx, id, revokedAt},
+	}
+	mmRevokeTokens.expectations = append(mmRevokeTokens.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.RevokeTokens return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockRevokeTokensExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockRevokeTokensResults{err}
+	return e.mock
+}
+
+// RevokeTokens implements repository.UserRepository
+func (mmRevokeTokens *UserRepositoryMock) RevokeTokens(ctx context.Context, id int64, revokedAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmRevokeTokens.beforeRevokeTokensCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeTokens.afterRevokeTokensCounter, 1)
+
+	if mmRevokeTokens.inspectFuncRevokeTokens != nil {
+		mmRevokeTokens.inspectFuncRevokeTokens(ctx, id, revokedAt)
+	}
+
+	mm_params := UserRepositoryMockRevokeTokensParams{ctx, id, revokedAt}
+
+	// Record call args
+	mmRevokeTokens.RevokeTokensMock.mutex.Lock()
+	mmRevokeTokens.RevokeTokensMock.callArgs = append(mmRevokeTokens.RevokeTokensMock.callArgs, &mm_params)
+	mmRevokeTokens.RevokeTokensMock.mutex.Unlock()
+
+	for _, e := range mmRevokeTokens.RevokeTokensMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevokeTokens.RevokeTokensMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeTokens.RevokeTokensMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeTokens.RevokeTokensMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokeTokens.RevokeTokensMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockRevokeTokensParams{ctx, id, revokedAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevokeTokens.t.Errorf("UserRepositoryMock.RevokeTokens got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmRevokeTokens.t.Errorf("UserRepositoryMock.RevokeTokens got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.revokedAt != nil && !minimock.Equal(*mm_want_ptrs.revokedAt, mm_got.revokedAt) {
+				mmRevokeTokens.t.Errorf("UserRepositoryMock.RevokeTokens got unexpected parameter revokedAt, want: %#v, got: %#v%s\n", *mm_want_ptrs.revokedAt, mm_got.revokedAt, minimock.Diff(*mm_want_ptrs.revokedAt, mm_got.revokedAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevokeTokens.t.Errorf("UserRepositoryMock.RevokeTokens got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevokeTokens.RevokeTokensMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevokeTokens.t.Fatal("No results are set for the UserRepositoryMock.RevokeTokens")
+		}
+		return (*mm_results).err
+	}
+	if mmRevokeTokens.funcRevokeTokens != nil {
+		return mmRevokeTokens.funcRevokeTokens(ctx, id, revokedAt)
+	}
+	mmRevokeTokens.t.Fatalf("Unexpected call to UserRepositoryMock.RevokeTokens. %v %v %v", ctx, id, revokedAt)
+	return
+}
+
+// RevokeTokensAfterCounter returns a count of finished UserRepositoryMock.RevokeTokens invocations
+func (mmRevokeTokens *UserRepositoryMock) RevokeTokensAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeTokens.afterRevokeTokensCounter)
+}
+
+// RevokeTokensBeforeCounter returns a count of UserRepositoryMock.RevokeTokens invocations
+func (mmRevokeTokens *UserRepositoryMock) RevokeTokensBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeTokens.beforeRevokeTokensCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.RevokeTokens.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevokeTokens *mUserRepositoryMockRevokeTokens) Calls() []*UserRepositoryMockRevokeTokensParams {
+	mmRevokeTokens.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockRevokeTokensParams, len(mmRevokeTokens.callArgs))
+	copy(argCopy, mmRevokeTokens.callArgs)
+
+	mmRevokeTokens.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeTokensDone returns true if the count of the RevokeTokens invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockRevokeTokensDone() bool {
+	for _, e := range m.RevokeTokensMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeTokensMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRevokeTokensCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokeTokens != nil && mm_atomic.LoadUint64(&m.afterRevokeTokensCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockRevokeTokensInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockRevokeTokensInspect() {
+	for _, e := range m.RevokeTokensMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.RevokeTokens with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeTokensMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRevokeTokensCounter) < 1 {
+		if m.RevokeTokensMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserRepositoryMock.RevokeTokens")
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.RevokeTokens with params: %#v", *m.RevokeTokensMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokeTokens != nil && mm_atomic.LoadUint64(&m.afterRevokeTokensCounter) < 1 {
+		m.t.Error("Expected call to UserRepositoryMock.RevokeTokens")
+	}
+}
+
+type mUserRepositoryMockSetRoles struct {
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockSetRolesExpectation
+	expectations       []*UserRepositoryMockSetRolesExpectation
+
+	callArgs []*UserRepositoryMockSetRolesParams
+	mutex    sync.RWMutex
+}
+
+// UserRepositoryMockSetRolesExpectation specifies expectation struct of the UserRepository.SetRoles
+type UserRepositoryMockSetRolesExpectation struct {
+	mock      *UserRepositoryMock
+	params    *UserRepositoryMockSetRolesParams
+	paramPtrs *UserRepositoryMockSetRolesParamPtrs
+	results   *UserRepositoryMockSetRolesResults
+	Counter   uint64
+}
+
+// UserRepositoryMockSetRolesParams contains parameters of the UserRepository.SetRoles
+type UserRepositoryMockSetRolesParams struct {
+	ctx    context.Context
+	id     int64
+	roles  []model.Role
+	tenant string
+}
+
+// UserRepositoryMockSetRolesParamPtrs contains pointers to parameters of the UserRepository.SetRoles
+type UserRepositoryMockSetRolesParamPtrs struct {
+	ctx    *context.Context
+	id     *int64
+	roles  *[]model.Role
+	tenant *string
+}
+
+// UserRepositoryMockSetRolesResults contains results of the UserRepository.SetRoles
+type UserRepositoryMockSetRolesResults struct {
+	err error
+}
+
+// Expect sets up expected params for UserRepository.SetRoles
+func (mmSetRoles *mUserRepositoryMockSetRoles) Expect(ctx context.Context, id int64, roles []model.Role, tenant string) *mUserRepositoryMockSetRoles {
+	if mmSetRoles.mock.funcSetRoles != nil {
+		mmSetRoles.mock.t.Fatalf("UserRepositoryMock.SetRoles mock is already set by Set")
+	}
+
+	if mmSetRoles.defaultExpectation == nil {
+		mmSetRoles.defaultExpectation = &UserRepositoryMockSetRolesExpectation{}
+	}
+
+	if mmSetRoles.defaultExpectation.paramPtrs != nil {
+		mmSetRoles.mock.t.Fatalf("UserRepositoryMock.SetRoles mock is already set by ExpectParams functions")
+	}
+
+	mmSetRoles.defaultExpectation.params = &UserRepositoryMockSetRolesParams{ctx, id, roles, tenant}
+	for _, e := range mmSetRoles.expectations {
+		if minimock.Equal(e.params, mmSetRoles.defaultExpectation.params) {
+			mmSetRoles.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetRoles.defaultExpectation.params)
+		}
+	}
+
+	return mmSetRoles
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.SetRoles
+func (mmSetRoles *mUserRepositoryMockSetRoles) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockSetRoles {
+	if mmSetRoles.mock.funcSetRoles != nil {
+		mmSetRoles.mock.t.Fatalf("UserRepositoryMock.SetRoles mock is already set by Set")
+	}
+
+	if mmSetRoles.defaultExpectation == nil {
+		mmSetRoles.defaultExpectation = &UserRepositoryMockSetRolesExpectation{}
+	}
+
+	if mmSetRoles.defaultExpectation.params != nil {
+		mmSetRoles.mock.t.Fatalf("UserRepositoryMock.SetRoles mock is already set by Expect")
+	}
+
+	if mmSetRoles.defaultExpectation.paramPtrs == nil {
+		mmSetRoles.defaultExpectation.paramPtrs = &UserRepositoryMockSetRolesParamPtrs{}
+	}
+	mmSetRoles.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmSetRoles
+}
+
+// ExpectIdParam2 sets up expected param id for UserRepository.SetRoles
+func (mmSetRoles *mUserRepositoryMockSetRoles) ExpectIdParam2(id int64) *mUserRepositoryMockSetRoles {
+	if mmSetRoles.mock.funcSetRoles != nil {
+		mmSetRoles.mock.t.Fatalf("UserRepositoryMock.SetRoles mock is already set by Set")
+	}
+
+	if mmSetRoles.defaultExpectation == nil {
+		mmSetRoles.defaultExpectation = &UserRepositoryMockSetRolesExpectation{}
+	}
+
+	if mmSetRoles.defaultExpectation.params != nil {
+		mmSetRoles.mock.t.Fatalf("UserRepositoryMock.SetRoles mock is already set by Expect")
+	}
+
+	if mmSetRoles.defaultExpectation.paramPtrs == nil {
+		mmSetRoles.defaultExpectation.paramPtrs = &UserRepositoryMockSetRolesParamPtrs{}
+	}
+	mmSetRoles.defaultExpectation.paramPtrs.id = &id
+
+	return mmSetRoles
+}
+
+// ExpectRolesParam3 sets up expected param roles for UserRepository.SetRoles
+func (mmSetRoles *mUserRepositoryMockSetRoles) ExpectRolesParam3(roles []model.Role) *mUserRepositoryMockSetRoles {
+	if mmSetRoles.mock.funcSetRoles != nil {
+		mmSetRoles.mock.t.Fatalf("UserRepositoryMock.SetRoles mock is already set by Set")
+	}
+
+	if mmSetRoles.defaultExpectation == nil {
+		mmSetRoles.defaultExpectation = &UserRepositoryMockSetRolesExpectation{}
+	}
+
+	if mmSetRoles.defaultExpectation.params != nil {
+		mmSetRoles.mock.t.Fatalf("UserRepositoryMock.SetRoles mock is already set by Expect")
+	}
+
+	if mmSetRoles.defaultExpectation.paramPtrs == nil {
+		mmSetRoles.defaultExpectation.paramPtrs = &UserRepositoryMockSetRolesParamPtrs{}
+	}
+	mmSetRoles.defaultExpectation.paramPtrs.roles = &roles
+
+	return mmSetRoles
+}
+
+// ExpectTenantParam4 sets up expected param tenant for UserRepository.SetRoles
+func (mmSetRoles *mUserRepositoryMockSetRoles) ExpectTenantParam4(tenant string) *mUserRepositoryMockSetRoles {
+	if mmSetRoles.mock.funcSetRoles != nil {
+		mmSetRoles.mock.t.Fatalf("UserRepositoryMock.SetRoles mock is already set by Set")
+	}
+
+	if mmSetRoles.defaultExpectation == nil {
+		mmSetRoles.defaultExpectation = &UserRepositoryMockSetRolesExpectation{}
+	}
+
+	if mmSetRoles.defaultExpectation.params != nil {
+		mmSetRoles.mock.t.Fatalf("UserRepositoryMock.SetRoles mock is already set by Expect")
+	}
+
+	if mmSetRoles.defaultExpectation.paramPtrs == nil {
+		mmSetRoles.defaultExpectation.paramPtrs = &UserRepositoryMockSetRolesParamPtrs{}
+	}
+	mmSetRoles.defaultExpectation.paramPtrs.tenant = &tenant
+
+	return mmSetRoles
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.SetRoles
+func (mmSetRoles *mUserRepositoryMockSetRoles) Inspect(f func(ctx context.Context, id int64, roles []model.Role, tenant string)) *mUserRepositoryMockSetRoles {
+	if mmSetRoles.mock.inspectFuncSetRoles != nil {
+		mmSetRoles.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.SetRoles")
+	}
+
+	mmSetRoles.mock.inspectFuncSetRoles = f
+
+	return mmSetRoles
+}
+
+// Return sets up results that will be returned by UserRepository.SetRoles
+func (mmSetRoles *mUserRepositoryMockSetRoles) Return(err error) *UserRepositoryMock {
+	if mmSetRoles.mock.funcSetRoles != nil {
+		mmSetRoles.mock.t.Fatalf("UserRepositoryMock.SetRoles mock is already set by Set")
+	}
+
+	if mmSetRoles.defaultExpectation == nil {
+		mmSetRoles.defaultExpectation = &UserRepositoryMockSetRolesExpectation{mock: mmSetRoles.mock}
+	}
+	mmSetRoles.defaultExpectation.results = &UserRepositoryMockSetRolesResults{err}
+	return mmSetRoles.mock
+}
+
+// Set uses given function f to mock the UserRepository.SetRoles method
+func (mmSetRoles *mUserRepositoryMockSetRoles) Set(f func(ctx context.Context, id int64, roles []model.Role, tenant string) (err error)) *UserRepositoryMock {
+	if mmSetRoles.defaultExpectation != nil {
+		mmSetRoles.mock.t.Fatalf("Default expectation is already set for the UserRepository.SetRoles method")
+	}
+
+	if len(mmSetRoles.expectations) > 0 {
+		mmSetRoles.mock.t.Fatalf("Some expectations are already set for the UserRepository.SetRoles method")
+	}
+
+	mmSetRoles.mock.funcSetRoles = f
+	return mmSetRoles.mock
+}
+
+// When sets expectation for the UserRepository.SetRoles which will trigger the result defined by the following
+// Then helper
+func (mmSetRoles *mUserRepositoryMockSetRoles) When(ctx context.Context, id int64, roles []model.Role, tenant string) *UserRepositoryMockSetRolesExpectation {
+	if mmSetRoles.mock.funcSetRoles != nil {
+		mmSetRoles.mock.t.Fatalf("UserRepositoryMock.SetRoles mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockSetRolesExpectation{
+		mock:   mmSetRoles.mock,
+		params: &UserRepositoryMockSetRolesParams{ctx, id, roles, tenant},
+	}
+	mmSetRoles.expectations = append(mmSetRoles.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.SetRoles return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockSetRolesExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockSetRolesResults{err}
+	return e.mock
+}
+
+// SetRoles implements repository.UserRepository
+func (mmSetRoles *UserRepositoryMock) SetRoles(ctx context.Context, id int64, roles []model.Role, tenant string) (err error) {
+	mm_atomic.AddUint64(&mmSetRoles.beforeSetRolesCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetRoles.afterSetRolesCounter, 1)
+
+	if mmSetRoles.inspectFuncSetRoles != nil {
+		mmSetRoles.inspectFuncSetRoles(ctx, id, roles, tenant)
+	}
+
+	mm_params := UserRepositoryMockSetRolesParams{ctx, id, roles, tenant}
+
+	// Record call args
+	mmSetRoles.SetRolesMock.mutex.Lock()
+	mmSetRoles.SetRolesMock.callArgs = append(mmSetRoles.SetRolesMock.callArgs, &mm_params)
+	mmSetRoles.SetRolesMock.mutex.Unlock()
+
+	for _, e := range mmSetRoles.SetRolesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetRoles.SetRolesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetRoles.SetRolesMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetRoles.SetRolesMock.defaultExpectation.params
+		mm_want_ptrs := mmSetRoles.SetRolesMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockSetRolesParams{ctx, id, roles, tenant}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetRoles.t.Errorf("UserRepositoryMock.SetRoles got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmSetRoles.t.Errorf("UserRepositoryMock.SetRoles got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.roles != nil && !minimock.Equal(*mm_want_ptrs.roles, mm_got.roles) {
+				mmSetRoles.t.Errorf("UserRepositoryMock.SetRoles got unexpected parameter roles, want: %#v, got: %#v%s\n", *mm_want_ptrs.roles, mm_got.roles, minimock.Diff(*mm_want_ptrs.roles, mm_got.roles))
+			}
+
+			if mm_want_ptrs.tenant != nil && !minimock.Equal(*mm_want_ptrs.tenant, mm_got.tenant) {
+				mmSetRoles.t.Errorf("UserRepositoryMock.SetRoles got unexpected parameter tenant, want: %#v, got: %#v%s\n", *mm_want_ptrs.tenant, mm_got.tenant, minimock.Diff(*mm_want_ptrs.tenant, mm_got.tenant))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetRoles.t.Errorf("UserRepositoryMock.SetRoles got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetRoles.SetRolesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetRoles.t.Fatal("No results are set for the UserRepositoryMock.SetRoles")
+		}
+		return (*mm_results).err
+	}
+	if mmSetRoles.funcSetRoles != nil {
+		return mmSetRoles.funcSetRoles(ctx, id, roles, tenant)
+	}
+	mmSetRoles.t.Fatalf("Unexpected call to UserRepositoryMock.SetRoles. %v %v %v %v", ctx, id, roles, tenant)
+	return
+}
+
+// SetRolesAfterCounter returns a count of finished UserRepositoryMock.SetRoles invocations
+func (mmSetRoles *UserRepositoryMock) SetRolesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetRoles.afterSetRolesCounter)
+}
+
+// SetRolesBeforeCounter returns a count of UserRepositoryMock.SetRoles invocations
+func (mmSetRoles *UserRepositoryMock) SetRolesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetRoles.beforeSetRolesCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.SetRoles.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetRoles *mUserRepositoryMockSetRoles) Calls() []*UserRepositoryMockSetRolesParams {
+	mmSetRoles.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockSetRolesParams, len(mmSetRoles.callArgs))
+	copy(argCopy, mmSetRoles.callArgs)
+
+	mmSetRoles.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetRolesDone returns true if the count of the SetRoles invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockSetRolesDone() bool {
+	for _, e := range m.SetRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetRolesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetRolesCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetRoles != nil && mm_atomic.LoadUint64(&m.afterSetRolesCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSetRolesInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockSetRolesInspect() {
+	for _, e := range m.SetRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.SetRoles with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetRolesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetRolesCounter) < 1 {
+		if m.SetRolesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserRepositoryMock.SetRoles")
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.SetRoles with params: %#v", *m.SetRolesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetRoles != nil && mm_atomic.LoadUint64(&m.afterSetRolesCounter) < 1 {
+		m.t.Error("Expected call to UserRepositoryMock.SetRoles")
+	}
+}
+
+type mUserRepositoryMockUpdate struct {
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockUpdateExpectation
+	expectations       []*UserRepositoryMockUpdateExpectation
+
+	callArgs []*UserRepositoryMockUpdateParams
+	mutex    sync.RWMutex
+}
+
+// UserRepositoryMockUpdateExpectation specifies expectation struct of the UserRepository.Update
+type UserRepositoryMockUpdateExpectation struct {
+	mock      *UserRepositoryMock
+	params    *UserRepositoryMockUpdateParams
+	paramPtrs *UserRepositoryMockUpdateParamPtrs
+	results   *UserRepositoryMockUpdateResults
+	Counter   uint64
+}
+
+// UserRepositoryMockUpdateParams contains parameters of the UserRepository.Update
+type UserRepositoryMockUpdateParams struct {
+	ctx    context.Context
+	user   *model.UpdateUser
+	tenant string
+}
+
+// UserRepositoryMockUpdateParamPtrs contains pointers to parameters of the UserRepository.Update
+type UserRepositoryMockUpdateParamPtrs struct {
+	ctx    *context.Context
+	user   **model.UpdateUser
+	tenant *string
+}
+
+// UserRepositoryMockUpdateResults contains results of the UserRepository.Update
+type UserRepositoryMockUpdateResults struct {
+	err error
+}
+
+// Expect sets up expected params for UserRepository.Update
+func (mmUpdate *mUserRepositoryMockUpdate) Expect(ctx context.Context, user *model.UpdateUser, tenant string) *mUserRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &UserRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs != nil {
+		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by ExpectParams functions")
+	}
+
+	mmUpdate.defaultExpectation.params = &UserRepositoryMockUpdateParams{ctx, user, tenant}
+	for _, e := range mmUpdate.expectations {
+		if minimock.Equal(e.params, mmUpdate.defaultExpectation.params) {
+			mmUpdate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdate.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.Update
+func (mmUpdate *mUserRepositoryMockUpdate) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &UserRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmUpdate
+}
+
+// ExpectUserParam2 sets up expected param user for UserRepository.Update
+func (mmUpdate *mUserRepositoryMockUpdate) ExpectUserParam2(user *model.UpdateUser) *mUserRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &UserRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.user = &user
+
+	return mmUpdate
+}
+
+// ExpectTenantParam3 sets up expected param tenant for UserRepository.Update
+func (mmUpdate *mUserRepositoryMockUpdate) ExpectTenantParam3(tenant string) *mUserRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &UserRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.tenant = &tenant
+
+	return mmUpdate
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.Update
+func (mmUpdate *mUserRepositoryMockUpdate) Inspect(f func(ctx context.Context, user *model.UpdateUser, tenant string)) *mUserRepositoryMockUpdate {
+	if mmUpdate.mock.inspectFuncUpdate != nil {
+		mmUpdate.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.Update")
+	}
+
+	mmUpdate.mock.inspectFuncUpdate = f
+
+	return mmUpdate
+}
+
+// Return sets up results that will be returned by UserRepository.Update
+func (mmUpdate *mUserRepositoryMockUpdate) Return(err error) *UserRepositoryMock {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &UserRepositoryMockUpdateExpectation{mock: mmUpdate.mock}
+	}
+	mmUpdate.defaultExpectation.results = &UserRepositoryMockUpdateResults{err}
+	return mmUpdate.mock
+}
+
+// Set uses given function f to mock the UserRepository.Update method
+func (mmUpdate *mUserRepositoryMockUpdate) Set(f func(ctx context.Context, user *model.UpdateUser, tenant string) (err error)) *UserRepositoryMock {
+	if mmUpdate.defaultExpectation != nil {
+		mmUpdate.mock.t.Fatalf("Default expectation is already set for the UserRepository.Update method")
+	}
+
+	if len(mmUpdate.expectations) > 0 {
+		mmUpdate.mock.t.Fatalf("Some expectations are already set for the UserRepository.Update method")
+	}
+
+	mmUpdate.mock.funcUpdate = f
+	return mmUpdate.mock
+}
+
+// When sets expectation for the UserRepository.Update which will trigger the result defined by the following
+// Then helper
+func (mmUpdate *mUserRepositoryMockUpdate) When(ctx context.Context, user *model.UpdateUser, tenant string) *UserRepositoryMockUpdateExpectation {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockUpdateExpectation{
+		mock:   mmUpdate.mock,
+		params: &UserRepositoryMockUpdateParams{ctx, user, tenant},
+	}
+	mmUpdate.expectations = append(mmUpdate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.Update return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockUpdateExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockUpdateResults{err}
+	return e.mock
+}
+
+// Update implements repository.UserRepository
+func (mmUpdate *UserRepositoryMock) Update(ctx context.Context, user *model.UpdateUser, tenant string) (err error) {
+	mm_atomic.AddUint64(&mmUpdate.beforeUpdateCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdate.afterUpdateCounter, 1)
+
+	if mmUpdate.inspectFuncUpdate != nil {
+		mmUpdate.inspectFuncUpdate(ctx, user, tenant)
+	}
+
+	mm_params := UserRepositoryMockUpdateParams{ctx, user, tenant}
+
+	// Record call args
+	mmUpdate.UpdateMock.mutex.Lock()
+	mmUpdate.UpdateMock.callArgs = append(mmUpdate.UpdateMock.callArgs, &mm_params)
+	mmUpdate.UpdateMock.mutex.Unlock()
+
+	for _, e := range mmUpdate.UpdateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdate.UpdateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdate.UpdateMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdate.UpdateMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdate.UpdateMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockUpdateParams{ctx, user, tenant}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdate.t.Errorf("UserRepositoryMock.Update got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.user != nil && !minimock.Equal(*mm_want_ptrs.user, mm_got.user) {
+				mmUpdate.t.Errorf("UserRepositoryMock.Update got unexpected parameter user, want: %#v, got: %#v%s\n", *mm_want_ptrs.user, mm_got.user, minimock.Diff(*mm_want_ptrs.user, mm_got.user))
+			}
+
+			if mm_want_ptrs.tenant != nil && !minimock.Equal(*mm_want_ptrs.tenant, mm_got.tenant) {
+				mmUpdate.t.Errorf("UserRepositoryMock.Update got unexpected parameter tenant, want: %#v, got: %#v%s\n", *mm_want_ptrs.tenant, mm_got.tenant, minimock.Diff(*mm_want_ptrs.tenant, mm_got.tenant))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdate.t.Errorf("UserRepositoryMock.Update got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdate.UpdateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdate.t.Fatal("No results are set for the UserRepositoryMock.Update")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdate.funcUpdate != nil {
+		return mmUpdate.funcUpdate(ctx, user, tenant)
+	}
+	mmUpdate.t.Fatalf("Unexpected call to UserRepositoryMock.Update. %v %v %v", ctx, user, tenant)
+	return
+}
+
+// UpdateAfterCounter returns a count of finished UserRepositoryMock.Update invocations
+func (mmUpdate *UserRepositoryMock) UpdateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.afterUpdateCounter)
+}
+
+// UpdateBeforeCounter returns a count of UserRepositoryMock.Update invocations
+func (mmUpdate *UserRepositoryMock) UpdateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.beforeUpdateCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.Update.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdate *mUserRepositoryMockUpdate) Calls() []*UserRepositoryMockUpdateParams {
+	mmUpdate.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockUpdateParams, len(mmUpdate.callArgs))
+	copy(argCopy, mmUpdate.callArgs)
+
+	mmUpdate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateDone returns true if the count of the Update invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockUpdateDone() bool {
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdate != nil && mm_atomic.LoadUint64(&m.afterUpdateCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockUpdateInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockUpdateInspect() {
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.Update with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateCounter) < 1 {
+		if m.UpdateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserRepositoryMock.Update")
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.Update with params: %#v", *m.UpdateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdate != nil && mm_atomic.LoadUint64(&m.afterUpdateCounter) < 1 {
+		m.t.Error("Expected call to UserRepositoryMock.Update")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *UserRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockDeleteInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockGetByEmailInspect()
+
+			m.MinimockListTokenRevocationsInspect()
+
+			m.MinimockRevokeTokensInspect()
+
+			m.MinimockSetRolesInspect()
 
 			m.MinimockUpdateInspect()
 			m.t.FailNow()
@@ -1245,5 +2624,9 @@ func (m *UserRepositoryMock) minimockDone() bool {
 		m.MinimockCreateDone() &&
 		m.MinimockDeleteDone() &&
 		m.MinimockGetDone() &&
+		m.MinimockGetByEmailDone() &&
+		m.MinimockListTokenRevocationsDone() &&
+		m.MinimockRevokeTokensDone() &&
+		m.MinimockSetRolesDone() &&
 		m.MinimockUpdateDone()
 }
@@ -0,0 +1,23 @@
+package converter
+
+import (
+	"github.com/arifullov/auth/internal/model"
+	modelRepo "github.com/arifullov/auth/internal/repository/organization/model"
+)
+
+func ToOrganizationFromRepo(org modelRepo.Organization) *model.Organization {
+	return &model.Organization{
+		ID:        org.ID,
+		Slug:      org.Slug,
+		Name:      org.Name,
+		CreatedAt: org.CreatedAt,
+	}
+}
+
+func ToOrganizationsFromRepo(orgs []modelRepo.Organization) []model.Organization {
+	res := make([]model.Organization, 0, len(orgs))
+	for _, org := range orgs {
+		res = append(res, *ToOrganizationFromRepo(org))
+	}
+	return res
+}
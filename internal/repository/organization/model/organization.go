@@ -0,0 +1,12 @@
+package model
+
+import (
+	"time"
+)
+
+type Organization struct {
+	ID        int64     `db:"id"`
+	Slug      string    `db:"slug"`
+	Name      string    `db:"name"`
+	CreatedAt time.Time `db:"created_at"`
+}
@@ -0,0 +1,210 @@
+package organization
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/arifullov/auth/internal/client/db"
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/repository"
+	"github.com/arifullov/auth/internal/repository/organization/converter"
+	modelRepo "github.com/arifullov/auth/internal/repository/organization/model"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+)
+
+const (
+	tableName   = "organizations"
+	membersName = "organization_members"
+
+	idColumn             = "id"
+	slugColumn           = "slug"
+	nameColumn           = "name"
+	createdAtColumn      = "created_at"
+	organizationIDColumn = "organization_id"
+	userIDColumn         = "user_id"
+)
+
+// addMemberQuery adds the member and assigns the roles in one statement, names missing
+// in the roles table are ignored.
+const addMemberQuery = `
+with new_member as (
+	insert into organization_members (organization_id, user_id)
+	values ($1, $2)
+	returning organization_id, user_id
+), new_member_roles as (
+	insert into organization_member_roles (organization_id, user_id, role_id)
+	select new_member.organization_id, new_member.user_id, roles.id
+	from new_member, roles
+	where roles.name = any($3)
+)
+select user_id from new_member`
+
+// listByUserQuery returns the organizations of a member ordered by slug.
+const listByUserQuery = `
+select o.id, o.slug, o.name, o.created_at
+from organizations o
+join organization_members m on m.organization_id = o.id
+where m.user_id = $1
+order by o.slug`
+
+type repo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) repository.OrganizationRepository {
+	return &repo{db: db}
+}
+
+func (r *repo) Create(ctx context.Context, org *model.Organization) (int64, error) {
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(slugColumn, nameColumn).
+		Values(org.Slug, org.Name).
+		Suffix("RETURNING id")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "organization_repository.Create",
+		QueryRaw: query,
+	}
+
+	var id int64
+	var pgErr *pgconn.PgError
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&id)
+	if err != nil && errors.As(err, &pgErr) {
+		if pgErr.Code == "23505" {
+			return 0, sys.NewCommonError(codes.AlreadyExists, "organization already exists")
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *repo) GetBySlug(ctx context.Context, slug string) (*model.Organization, error) {
+	builderSelect := sq.Select(idColumn, slugColumn, nameColumn, createdAtColumn).
+		PlaceholderFormat(sq.Dollar).
+		From(tableName).
+		Where(sq.Eq{slugColumn: slug})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "organization_repository.GetBySlug",
+		QueryRaw: query,
+	}
+
+	var org modelRepo.Organization
+	err = r.db.DB().ScanOneContext(ctx, &org, q, args...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, sys.NewCommonError(codes.NotFound, "organization not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return converter.ToOrganizationFromRepo(org), nil
+}
+
+func (r *repo) ListByUser(ctx context.Context, userID int64) ([]model.Organization, error) {
+	q := db.Query{
+		Name:     "organization_repository.ListByUser",
+		QueryRaw: listByUserQuery,
+	}
+
+	var orgs []modelRepo.Organization
+	err := r.db.DB().ScanAllContext(ctx, &orgs, q, userID)
+	if err != nil {
+		return nil, err
+	}
+	return converter.ToOrganizationsFromRepo(orgs), nil
+}
+
+func (r *repo) IsMember(ctx context.Context, slug string, userID int64) (bool, error) {
+	builderSelect := sq.Select("1").
+		PlaceholderFormat(sq.Dollar).
+		From(membersName + " m").
+		Join(tableName + " o on o.id = m.organization_id").
+		Where(sq.Eq{"o." + slugColumn: slug, "m." + userIDColumn: userID}).
+		Prefix("select exists (").
+		Suffix(")")
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	q := db.Query{
+		Name:     "organization_repository.IsMember",
+		QueryRaw: query,
+	}
+
+	var member bool
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&member)
+	if err != nil {
+		return false, err
+	}
+	return member, nil
+}
+
+func (r *repo) AddMember(ctx context.Context, member *model.OrganizationMember) error {
+	roles := make([]string, 0, len(member.Roles))
+	for _, role := range member.Roles {
+		roles = append(roles, string(role))
+	}
+
+	q := db.Query{
+		Name:     "organization_repository.AddMember",
+		QueryRaw: addMemberQuery,
+	}
+
+	var userID int64
+	var pgErr *pgconn.PgError
+	err := r.db.DB().QueryRowContext(ctx, q, member.OrganizationID, member.UserID, roles).Scan(&userID)
+	if err != nil && errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23505":
+			return sys.NewCommonError(codes.AlreadyExists, "user is already a member of the organization")
+		case "23503":
+			return sys.NewCommonError(codes.NotFound, "user not found")
+		}
+	}
+	return err
+}
+
+func (r *repo) RemoveMember(ctx context.Context, organizationID int64, userID int64) error {
+	builderDelete := sq.Delete(membersName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{organizationIDColumn: organizationID, userIDColumn: userID})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "organization_repository.RemoveMember",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return sys.NewCommonError(codes.NotFound, "user is not a member of the organization")
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package converter
+
+import (
+	"github.com/arifullov/auth/internal/model"
+	modelRepo "github.com/arifullov/auth/internal/repository/relation/model"
+)
+
+func ToRelationTuplesFromRepo(tuples []modelRepo.RelationTuple) []model.RelationTuple {
+	res := make([]model.RelationTuple, 0, len(tuples))
+	for _, tuple := range tuples {
+		res = append(res, model.RelationTuple{
+			ID: tuple.ID,
+			Object: model.RelationObject{
+				Namespace: tuple.Namespace,
+				ID:        tuple.ObjectID,
+			},
+			Relation: tuple.Relation,
+			Subject: model.RelationSubject{
+				UserID: tuple.SubjectUserID,
+				Object: model.RelationObject{
+					Namespace: tuple.SubjectNamespace,
+					ID:        tuple.SubjectObjectID,
+				},
+				Relation: tuple.SubjectRelation,
+			},
+		})
+	}
+	return res
+}
+
+func ToRelationTupleFromService(tuple model.RelationTuple) modelRepo.RelationTuple {
+	return modelRepo.RelationTuple{
+		ID:               tuple.ID,
+		Namespace:        tuple.Object.Namespace,
+		ObjectID:         tuple.Object.ID,
+		Relation:         tuple.Relation,
+		SubjectUserID:    tuple.Subject.UserID,
+		SubjectNamespace: tuple.Subject.Object.Namespace,
+		SubjectObjectID:  tuple.Subject.Object.ID,
+		SubjectRelation:  tuple.Subject.Relation,
+	}
+}
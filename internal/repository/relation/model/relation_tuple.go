@@ -0,0 +1,12 @@
+package model
+
+type RelationTuple struct {
+	ID               int64  `db:"id"`
+	Namespace        string `db:"namespace"`
+	ObjectID         string `db:"object_id"`
+	Relation         string `db:"relation"`
+	SubjectUserID    string `db:"subject_user_id"`
+	SubjectNamespace string `db:"subject_namespace"`
+	SubjectObjectID  string `db:"subject_object_id"`
+	SubjectRelation  string `db:"subject_relation"`
+}
@@ -0,0 +1,207 @@
+package relation
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/arifullov/auth/internal/client/db"
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/repository"
+	"github.com/arifullov/auth/internal/repository/relation/converter"
+	modelRepo "github.com/arifullov/auth/internal/repository/relation/model"
+)
+
+const (
+	tuplesTable   = "relation_tuples"
+	revisionTable = "relation_revision"
+
+	idColumn               = "id"
+	namespaceColumn        = "namespace"
+	objectIDColumn         = "object_id"
+	relationColumn         = "relation"
+	subjectUserIDColumn    = "subject_user_id"
+	subjectNamespaceColumn = "subject_namespace"
+	subjectObjectIDColumn  = "subject_object_id"
+	subjectRelationColumn  = "subject_relation"
+	createdRevisionColumn  = "created_revision"
+	deletedRevisionColumn  = "deleted_revision"
+	revisionColumn         = "revision"
+)
+
+type repo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) repository.RelationRepository {
+	return &repo{
+		db: db,
+	}
+}
+
+func (r *repo) GetRevision(ctx context.Context) (int64, error) {
+	builderSelect := sq.Select(revisionColumn).
+		PlaceholderFormat(sq.Dollar).
+		From(revisionTable)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "relation_repository.GetRevision",
+		QueryRaw: query,
+	}
+
+	var revision int64
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&revision)
+	if err != nil {
+		return 0, err
+	}
+	return revision, nil
+}
+
+func (r *repo) NextRevision(ctx context.Context) (int64, error) {
+	builderUpdate := sq.Update(revisionTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(revisionColumn, sq.Expr(revisionColumn+" + 1")).
+		Suffix("RETURNING " + revisionColumn)
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "relation_repository.NextRevision",
+		QueryRaw: query,
+	}
+
+	var revision int64
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&revision)
+	if err != nil {
+		return 0, err
+	}
+	return revision, nil
+}
+
+func (r *repo) CreateTuples(ctx context.Context, tuples []model.RelationTuple, revision int64) error {
+	if len(tuples) == 0 {
+		return nil
+	}
+
+	builderInsert := sq.Insert(tuplesTable).
+		PlaceholderFormat(sq.Dollar).
+		Columns(namespaceColumn, objectIDColumn, relationColumn,
+			subjectUserIDColumn, subjectNamespaceColumn, subjectObjectIDColumn, subjectRelationColumn,
+			createdRevisionColumn).
+		Suffix("ON CONFLICT DO NOTHING")
+	for _, tuple := range tuples {
+		t := converter.ToRelationTupleFromService(tuple)
+		builderInsert = builderInsert.Values(t.Namespace, t.ObjectID, t.Relation,
+			t.SubjectUserID, t.SubjectNamespace, t.SubjectObjectID, t.SubjectRelation,
+			revision)
+	}
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "relation_repository.CreateTuples",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
+
+func (r *repo) DeleteTuples(ctx context.Context, tuples []model.RelationTuple, revision int64) error {
+	if len(tuples) == 0 {
+		return nil
+	}
+
+	matches := make(sq.Or, 0, len(tuples))
+	for _, tuple := range tuples {
+		t := converter.ToRelationTupleFromService(tuple)
+		matches = append(matches, sq.Eq{
+			namespaceColumn:        t.Namespace,
+			objectIDColumn:         t.ObjectID,
+			relationColumn:         t.Relation,
+			subjectUserIDColumn:    t.SubjectUserID,
+			subjectNamespaceColumn: t.SubjectNamespace,
+			subjectObjectIDColumn:  t.SubjectObjectID,
+			subjectRelationColumn:  t.SubjectRelation,
+		})
+	}
+
+	builderUpdate := sq.Update(tuplesTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(deletedRevisionColumn, revision).
+		Where(sq.Eq{deletedRevisionColumn: nil}).
+		Where(matches)
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "relation_repository.DeleteTuples",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
+
+func (r *repo) FindTuples(ctx context.Context, filter model.RelationTupleFilter, revision int64) ([]model.RelationTuple, error) {
+	builderSelect := sq.Select(idColumn, namespaceColumn, objectIDColumn, relationColumn,
+		subjectUserIDColumn, subjectNamespaceColumn, subjectObjectIDColumn, subjectRelationColumn).
+		PlaceholderFormat(sq.Dollar).
+		From(tuplesTable).
+		Where(sq.LtOrEq{createdRevisionColumn: revision}).
+		Where(sq.Or{sq.Eq{deletedRevisionColumn: nil}, sq.Gt{deletedRevisionColumn: revision}}).
+		Where(sq.Gt{idColumn: filter.AfterID}).
+		OrderBy(idColumn)
+	if filter.Limit > 0 {
+		builderSelect = builderSelect.Limit(filter.Limit)
+	}
+	if filter.Namespace != "" {
+		builderSelect = builderSelect.Where(sq.Eq{namespaceColumn: filter.Namespace})
+	}
+	if filter.ObjectID != "" {
+		builderSelect = builderSelect.Where(sq.Eq{objectIDColumn: filter.ObjectID})
+	}
+	if filter.Relation != "" {
+		builderSelect = builderSelect.Where(sq.Eq{relationColumn: filter.Relation})
+	}
+	if filter.Subject != nil {
+		s := converter.ToRelationTupleFromService(model.RelationTuple{Subject: *filter.Subject})
+		builderSelect = builderSelect.Where(sq.Eq{
+			subjectUserIDColumn:    s.SubjectUserID,
+			subjectNamespaceColumn: s.SubjectNamespace,
+			subjectObjectIDColumn:  s.SubjectObjectID,
+			subjectRelationColumn:  s.SubjectRelation,
+		})
+	}
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "relation_repository.FindTuples",
+		QueryRaw: query,
+	}
+
+	var tuples []modelRepo.RelationTuple
+	err = r.db.DB().ScanAllContext(ctx, &tuples, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	return converter.ToRelationTuplesFromRepo(tuples), nil
+}
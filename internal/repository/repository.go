@@ -2,20 +2,162 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/arifullov/auth/internal/model"
 )
 
 //go:generate sh -c "rm -rf mocks && mkdir -p mocks"
 //go:generate minimock -i UserRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i AccessRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i RoleRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i AuditRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i RelationRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i OrganizationRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i GroupRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i RoleRequestRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i DeviceCodeRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i IdentityRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i FederatedStateRepository -o ./mocks/ -s "_minimock.go"
+
+// UserRepository isolates tenants: methods taking a tenant work on the members of that
+// organization and the roles assigned there, tenant is empty outside of organizations.
 type UserRepository interface {
-	Create(ctx context.Context, user *model.CreateUser) (int64, error)
-	Get(ctx context.Context, id int64) (*model.User, error)
+	// Create adds the user, in a tenant as a member of the organization.
+	Create(ctx context.Context, user *model.CreateUser, tenant string) (int64, error)
+	Get(ctx context.Context, id int64, tenant string) (*model.User, error)
 	GetByEmail(ctx context.Context, email string) (*model.User, error)
-	Update(ctx context.Context, user *model.UpdateUser) error
-	Delete(ctx context.Context, id int64) error
+	Update(ctx context.Context, user *model.UpdateUser, tenant string) error
+	// Delete removes the user, in a tenant only the membership in the organization.
+	Delete(ctx context.Context, id int64, tenant string) error
+	// SetRoles replaces the roles assigned to the user.
+	SetRoles(ctx context.Context, id int64, roles []model.Role, tenant string) error
+	// RevokeTokens invalidates the tokens issued to the user before revokedAt, in every organization.
+	RevokeTokens(ctx context.Context, id int64, revokedAt time.Time) error
+	// ListTokenRevocations returns the revocations made after since.
+	ListTokenRevocations(ctx context.Context, since time.Time) ([]model.TokenRevocation, error)
+}
+
+type RoleRepository interface {
+	// GetExisting returns the given roles that exist in the roles table.
+	GetExisting(ctx context.Context, roles []model.Role) ([]model.Role, error)
+	// GetUserAccess returns the roles assigned to the user in every organization, in the tenant
+	// and through groups, with all inherited roles and their permissions. The tenant may be empty.
+	GetUserAccess(ctx context.Context, userID int64, tenant string) (*model.UserAccess, error)
+	// ListEffectiveRoles maps every role to itself and the roles it inherits.
+	ListEffectiveRoles(ctx context.Context) (map[model.Role][]model.Role, error)
+	// ListUserRoles returns a page of users ordered by id with their assigned, group and inherited roles.
+	ListUserRoles(ctx context.Context, afterID int64, limit uint64) ([]model.UserRoles, error)
+	// ListDefinitions returns every role with its parents and permissions ordered by name.
+	ListDefinitions(ctx context.Context) ([]model.RoleDefinition, error)
+	// Create adds the role with its description only, parents and permissions are set by Update
+	// once every role they refer to exists.
+	Create(ctx context.Context, role *model.RoleDefinition) error
+	// Update replaces the description, parents and permissions of the role, missing permissions are created.
+	Update(ctx context.Context, role *model.RoleDefinition) error
+	// Delete removes the role along with its assignments and route rules.
+	Delete(ctx context.Context, name model.Role) error
 }
 
 type AccessRepository interface {
-	GetRouteRoles(ctx context.Context, route string) ([]model.Role, error)
+	ListRouteRules(ctx context.Context) ([]model.RouteRule, error)
+	GetPolicyVersion(ctx context.Context) (int64, error)
+	FindRouteRules(ctx context.Context, filter model.RouteRuleFilter) ([]model.RouteRule, error)
+	GetRouteRule(ctx context.Context, id int64) (*model.RouteRule, error)
+	CreateRouteRule(ctx context.Context, rule *model.RouteRule) (int64, error)
+	UpdateRouteRule(ctx context.Context, rule *model.RouteRule) error
+	DeleteRouteRule(ctx context.Context, id int64) error
+}
+
+type AuditRepository interface {
+	Create(ctx context.Context, record *model.AuditRecord) (int64, error)
+}
+
+type DeviceCodeRepository interface {
+	Create(ctx context.Context, code *model.DeviceCode) (int64, error)
+	GetByDeviceCodeHash(ctx context.Context, deviceCodeHash string) (*model.DeviceCode, error)
+	Resolve(ctx context.Context, userCode string, status model.DeviceCodeStatus, userID int64) error
+	UpdatePoll(ctx context.Context, id int64, polledAt time.Time, pollInterval time.Duration) error
+	Delete(ctx context.Context, id int64) error
+	// DeleteExpired deletes the codes expired before now, so that their user codes can be reused.
+	DeleteExpired(ctx context.Context, now time.Time) error
+}
+
+type IdentityRepository interface {
+	Create(ctx context.Context, identity *model.UserIdentity) (int64, error)
+	GetUserID(ctx context.Context, provider string, subject string) (int64, error)
+}
+
+type FederatedStateRepository interface {
+	Create(ctx context.Context, state *model.FederatedState) error
+	// Consume deletes the state, NotFound when it was consumed before or is expired.
+	Consume(ctx context.Context, id string, now time.Time) error
+	DeleteExpired(ctx context.Context, now time.Time) error
+}
+
+// RelationRepository stores relation tuples at revisions, reads see the tuples
+// created at or before the revision and not deleted by then.
+type RelationRepository interface {
+	// GetRevision returns the revision of the last committed write.
+	GetRevision(ctx context.Context) (int64, error)
+	// NextRevision returns the revision of a new write, it must be called in the transaction
+	// of the write and serializes concurrent writes until the transaction ends.
+	NextRevision(ctx context.Context) (int64, error)
+	// CreateTuples stores the tuples at the revision, tuples already stored are skipped.
+	CreateTuples(ctx context.Context, tuples []model.RelationTuple, revision int64) error
+	// DeleteTuples deletes the tuples at the revision, tuples not stored are skipped.
+	DeleteTuples(ctx context.Context, tuples []model.RelationTuple, revision int64) error
+	FindTuples(ctx context.Context, filter model.RelationTupleFilter, revision int64) ([]model.RelationTuple, error)
+}
+
+// OrganizationRepository stores organizations and their members.
+type OrganizationRepository interface {
+	Create(ctx context.Context, org *model.Organization) (int64, error)
+	GetBySlug(ctx context.Context, slug string) (*model.Organization, error)
+	// ListByUser returns the organizations the user is a member of.
+	ListByUser(ctx context.Context, userID int64) ([]model.Organization, error)
+	IsMember(ctx context.Context, slug string, userID int64) (bool, error)
+	// AddMember adds the user to the organization with the roles assigned there.
+	AddMember(ctx context.Context, member *model.OrganizationMember) error
+	// RemoveMember removes the user with the roles assigned in the organization.
+	RemoveMember(ctx context.Context, organizationID int64, userID int64) error
+}
+
+// GroupRepository stores groups, their members, nesting and role bindings.
+type GroupRepository interface {
+	Create(ctx context.Context, group *model.CreateGroup) (int64, error)
+	Get(ctx context.Context, id int64) (*model.Group, error)
+	Delete(ctx context.Context, id int64) error
+	// SetRoles replaces the roles bound to the group.
+	SetRoles(ctx context.Context, id int64, roles []model.Role) error
+	AddMember(ctx context.Context, groupID int64, userID int64) error
+	RemoveMember(ctx context.Context, groupID int64, userID int64) error
+	// LockParents serializes changes to the nesting of groups until the transaction ends.
+	LockParents(ctx context.Context) error
+	// AddParent nests the group in the parent group, FailedPrecondition when it would create a cycle.
+	// It must run after LockParents in the same transaction, or concurrent nestings may close a cycle.
+	AddParent(ctx context.Context, groupID int64, parentID int64) error
+	RemoveParent(ctx context.Context, groupID int64, parentID int64) error
+	// RevokeMemberTokens revokes the tokens of the members of the group and of the groups nested in it.
+	RevokeMemberTokens(ctx context.Context, id int64, revokedAt time.Time) error
+	// GetRoleGraph returns the roles of the user, in the tenant if not empty, and the groups granting them.
+	GetRoleGraph(ctx context.Context, userID int64, tenant string) (*model.RoleGraph, error)
+	// IsMember reports whether the user is a member of the named group or of a group nested in it.
+	IsMember(ctx context.Context, name string, userID int64) (bool, error)
+}
+
+// RoleRequestRepository stores requests for time-bound roles and the roles they grant.
+type RoleRequestRepository interface {
+	Create(ctx context.Context, req *model.CreateRoleRequest) (int64, error)
+	Get(ctx context.Context, id int64) (*model.RoleRequest, error)
+	Find(ctx context.Context, filter model.RoleRequestFilter) ([]model.RoleRequest, error)
+	// Decide resolves a pending request, FailedPrecondition when it is not pending anymore.
+	Decide(ctx context.Context, decision *model.RoleRequestDecision) (*model.RoleRequest, error)
+	// Grant assigns the role to the user until expiresAt, permanent grants are kept.
+	// NotFound when the role does not exist anymore.
+	Grant(ctx context.Context, userID int64, role model.Role, expiresAt time.Time) error
+	// DeleteExpiredGrants removes the time-bound roles expired by now.
+	DeleteExpiredGrants(ctx context.Context, now time.Time) ([]model.ExpiredRoleGrant, error)
+	// ExpireRequests marks the approved requests expired by now and returns their ids.
+	ExpireRequests(ctx context.Context, now time.Time) ([]int64, error)
 }
@@ -0,0 +1,63 @@
+package converter
+
+import (
+	"github.com/arifullov/auth/internal/model"
+	modelRepo "github.com/arifullov/auth/internal/repository/role/model"
+)
+
+func ToRolesFromRepo(names []string) []model.Role {
+	roles := make([]model.Role, 0, len(names))
+	for _, name := range names {
+		roles = append(roles, model.Role(name))
+	}
+	return roles
+}
+
+func ToRoleNamesFromService(roles []model.Role) []string {
+	names := make([]string, 0, len(roles))
+	for _, role := range roles {
+		names = append(names, string(role))
+	}
+	return names
+}
+
+func ToUserAccessFromRepo(access modelRepo.UserAccess, tenant string) *model.UserAccess {
+	return &model.UserAccess{
+		Tenant:      tenant,
+		Roles:       ToRolesFromRepo(access.Roles),
+		Permissions: access.Permissions,
+	}
+}
+
+func ToEffectiveRolesFromRepo(closures []modelRepo.RoleClosure) map[model.Role][]model.Role {
+	roles := make(map[model.Role][]model.Role, len(closures))
+	for _, closure := range closures {
+		roles[model.Role(closure.Role)] = ToRolesFromRepo(closure.Roles)
+	}
+	return roles
+}
+
+func ToUserRolesFromRepo(users []modelRepo.UserRoles) []model.UserRoles {
+	res := make([]model.UserRoles, 0, len(users))
+	for _, user := range users {
+		res = append(res, model.UserRoles{
+			UserID: user.ID,
+			Name:   user.Name,
+			Roles:  ToRolesFromRepo(user.Roles),
+		})
+	}
+	return res
+}
+
+func ToRoleDefinitionsFromRepo(roles []modelRepo.RoleDefinition) []model.RoleDefinition {
+	res := make([]model.RoleDefinition, 0, len(roles))
+	for _, role := range roles {
+		res = append(res, model.RoleDefinition{
+			Name:        model.Role(role.Name),
+			Description: role.Description,
+			Parents:     ToRolesFromRepo(role.Parents),
+			Permissions: role.Permissions,
+		})
+	}
+	return res
+}
@@ -0,0 +1,24 @@
+package model
+
+type UserAccess struct {
+	Roles       []string `db:"roles"`
+	Permissions []string `db:"permissions"`
+}
+
+type RoleClosure struct {
+	Role  string   `db:"role"`
+	Roles []string `db:"roles"`
+}
+
+type UserRoles struct {
+	ID    int64    `db:"id"`
+	Name  string   `db:"name"`
+	Roles []string `db:"roles"`
+}
+
+type RoleDefinition struct {
+	Name        string   `db:"name"`
+	Description string   `db:"description"`
+	Parents     []string `db:"parents"`
+	Permissions []string `db:"permissions"`
+}
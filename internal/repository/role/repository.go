@@ -0,0 +1,358 @@
+package role
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/arifullov/auth/internal/client/db"
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/repository"
+	"github.com/arifullov/auth/internal/repository/role/converter"
+	modelRepo "github.com/arifullov/auth/internal/repository/role/model"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+)
+
+const (
+	rolesTable = "roles"
+
+	nameColumn        = "name"
+	descriptionColumn = "description"
+	parentsColumn     = "coalesce((select array_agg(p.name order by p.name) from role_parents rp join roles p on p.id = rp.parent_id where rp.role_id = roles.id), '{}') as parents"
+	permissionsColumn = "coalesce((select array_agg(p.name order by p.name) from role_permissions rp join permissions p on p.id = rp.permission_id where rp.role_id = roles.id), '{}') as permissions"
+)
+
+// createPermissionsQuery adds the permissions $1 that do not exist yet.
+const createPermissionsQuery = `
+insert into permissions (name)
+select unnest($1::text[])
+on conflict (name) do nothing`
+
+// setParentsQuery makes the roles $2 the only parents of the role $1.
+const setParentsQuery = `
+with role as (
+	select id from roles where name = $1
+), removed as (
+	delete from role_parents rp
+	using role, roles p
+	where rp.role_id = role.id and p.id = rp.parent_id and p.name <> all($2)
+)
+insert into role_parents (role_id, parent_id)
+select role.id, p.id
+from role, roles p
+where p.name = any($2)
+on conflict do nothing`
+
+// setPermissionsQuery makes the permissions $2 the only permissions of the role $1.
+const setPermissionsQuery = `
+with role as (
+	select id from roles where name = $1
+), removed as (
+	delete from role_permissions rp
+	using role, permissions p
+	where rp.role_id = role.id and p.id = rp.permission_id and p.name <> all($2)
+)
+insert into role_permissions (role_id, permission_id)
+select role.id, p.id
+from role, permissions p
+where p.name = any($2)
+on conflict do nothing`
+
+// userAccessQuery walks role_parents from the roles assigned to the user, in every
+// organization, in the tenant $2 and through the groups of the user, up to all inherited
+// roles. Groups are walked up to the groups they are nested in, union makes both walks
+// stop on cycles. Expired time-bound grants are ignored until they are removed.
+const userAccessQuery = `
+with recursive user_groups (group_id) as (
+	select group_id from group_members where user_id = $1
+	union
+	select gp.parent_id
+	from group_parents gp
+	join user_groups ug on ug.group_id = gp.group_id
+), effective_roles (role_id) as (
+	select role_id from user_roles where user_id = $1 and (expires_at is null or expires_at > now())
+	union
+	select mr.role_id
+	from organization_member_roles mr
+	join organizations o on o.id = mr.organization_id
+	where mr.user_id = $1 and o.slug = $2
+	union
+	select gr.role_id
+	from group_roles gr
+	join user_groups ug on ug.group_id = gr.group_id
+	union
+	select rp.parent_id
+	from role_parents rp
+	join effective_roles er on er.role_id = rp.role_id
+)
+select
+	coalesce((
+		select array_agg(r.name order by r.name)
+		from roles r
+		join effective_roles er on er.role_id = r.id
+	), '{}') as roles,
+	coalesce((
+		select array_agg(distinct p.name order by p.name)
+		from permissions p
+		join role_permissions rp on rp.permission_id = p.id
+		join effective_roles er on er.role_id = rp.role_id
+	), '{}') as permissions`
+
+// effectiveRolesQuery pairs every role with itself and all the roles it inherits.
+const effectiveRolesQuery = `
+with recursive closure (role_id, inherited_id) as (
+	select id, id from roles
+	union
+	select c.role_id, rp.parent_id
+	from role_parents rp
+	join closure c on c.inherited_id = rp.role_id
+)
+select r.name as role, array_agg(i.name order by i.name) as roles
+from closure c
+join roles r on r.id = c.role_id
+join roles i on i.id = c.inherited_id
+group by r.name
+order by r.name`
+
+// userRolesQuery is userAccessQuery for a page of users.
+const userRolesQuery = `
+with recursive page as (
+	select id, name from users where id > $1 order by id limit $2
+), user_groups (user_id, group_id) as (
+	select gm.user_id, gm.group_id from group_members gm join page p on p.id = gm.user_id
+	union
+	select ug.user_id, gp.parent_id
+	from group_parents gp
+	join user_groups ug on ug.group_id = gp.group_id
+), effective_roles (user_id, role_id) as (
+	select ur.user_id, ur.role_id
+	from user_roles ur
+	join page p on p.id = ur.user_id
+	where ur.expires_at is null or ur.expires_at > now()
+	union
+	select ug.user_id, gr.role_id from group_roles gr join user_groups ug on ug.group_id = gr.group_id
+	union
+	select er.user_id, rp.parent_id
+	from role_parents rp
+	join effective_roles er on er.role_id = rp.role_id
+)
+select
+	p.id,
+	p.name,
+	coalesce((
+		select array_agg(r.name order by r.name)
+		from roles r
+		join effective_roles er on er.role_id = r.id
+		where er.user_id = p.id
+	), '{}') as roles
+from page p
+order by p.id`
+
+type repo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) repository.RoleRepository {
+	return &repo{
+		db: db,
+	}
+}
+
+func (r *repo) GetExisting(ctx context.Context, roles []model.Role) ([]model.Role, error) {
+	builderSelect := sq.Select(nameColumn).
+		PlaceholderFormat(sq.Dollar).
+		From(rolesTable).
+		Where(sq.Eq{nameColumn: converter.ToRoleNamesFromService(roles)})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "role_repository.GetExisting",
+		QueryRaw: query,
+	}
+
+	var names []string
+	err = r.db.DB().ScanAllContext(ctx, &names, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToRolesFromRepo(names), nil
+}
+
+func (r *repo) GetUserAccess(ctx context.Context, userID int64, tenant string) (*model.UserAccess, error) {
+	q := db.Query{
+		Name:     "role_repository.GetUserAccess",
+		QueryRaw: userAccessQuery,
+	}
+
+	var access modelRepo.UserAccess
+	err := r.db.DB().ScanOneContext(ctx, &access, q, userID, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToUserAccessFromRepo(access, tenant), nil
+}
+
+func (r *repo) ListEffectiveRoles(ctx context.Context) (map[model.Role][]model.Role, error) {
+	q := db.Query{
+		Name:     "role_repository.ListEffectiveRoles",
+		QueryRaw: effectiveRolesQuery,
+	}
+
+	var closures []modelRepo.RoleClosure
+	err := r.db.DB().ScanAllContext(ctx, &closures, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToEffectiveRolesFromRepo(closures), nil
+}
+
+func (r *repo) ListUserRoles(ctx context.Context, afterID int64, limit uint64) ([]model.UserRoles, error) {
+	q := db.Query{
+		Name:     "role_repository.ListUserRoles",
+		QueryRaw: userRolesQuery,
+	}
+
+	var users []modelRepo.UserRoles
+	err := r.db.DB().ScanAllContext(ctx, &users, q, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToUserRolesFromRepo(users), nil
+}
+
+func (r *repo) ListDefinitions(ctx context.Context) ([]model.RoleDefinition, error) {
+	builderSelect := sq.Select(nameColumn, descriptionColumn, parentsColumn, permissionsColumn).
+		PlaceholderFormat(sq.Dollar).
+		From(rolesTable).
+		OrderBy(nameColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "role_repository.ListDefinitions",
+		QueryRaw: query,
+	}
+
+	var roles []modelRepo.RoleDefinition
+	err = r.db.DB().ScanAllContext(ctx, &roles, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToRoleDefinitionsFromRepo(roles), nil
+}
+
+func (r *repo) Create(ctx context.Context, role *model.RoleDefinition) error {
+	builderInsert := sq.Insert(rolesTable).
+		PlaceholderFormat(sq.Dollar).
+		Columns(nameColumn, descriptionColumn).
+		Values(role.Name, role.Description)
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "role_repository.Create",
+		QueryRaw: query,
+	}
+
+	var pgErr *pgconn.PgError
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return sys.NewCommonError(codes.AlreadyExists, "role already exists")
+	}
+	return err
+}
+
+func (r *repo) Update(ctx context.Context, role *model.RoleDefinition) error {
+	builderUpdate := sq.Update(rolesTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(descriptionColumn, role.Description).
+		Where(sq.Eq{nameColumn: role.Name})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "role_repository.Update",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return sys.NewCommonError(codes.NotFound, "role not found")
+	}
+
+	q = db.Query{
+		Name:     "role_repository.SetParents",
+		QueryRaw: setParentsQuery,
+	}
+	if _, err = r.db.DB().ExecContext(ctx, q, role.Name, converter.ToRoleNamesFromService(role.Parents)); err != nil {
+		return err
+	}
+
+	permissions := role.Permissions
+	if permissions == nil {
+		permissions = []string{}
+	}
+	q = db.Query{
+		Name:     "role_repository.CreatePermissions",
+		QueryRaw: createPermissionsQuery,
+	}
+	if _, err = r.db.DB().ExecContext(ctx, q, permissions); err != nil {
+		return err
+	}
+
+	q = db.Query{
+		Name:     "role_repository.SetPermissions",
+		QueryRaw: setPermissionsQuery,
+	}
+	_, err = r.db.DB().ExecContext(ctx, q, role.Name, permissions)
+	return err
+}
+
+func (r *repo) Delete(ctx context.Context, name model.Role) error {
+	builderDelete := sq.Delete(rolesTable).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{nameColumn: name})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "role_repository.Delete",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return sys.NewCommonError(codes.NotFound, "role not found")
+	}
+	return nil
+}
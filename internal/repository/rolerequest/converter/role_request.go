@@ -0,0 +1,51 @@
+package converter
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/arifullov/auth/internal/model"
+	modelRepo "github.com/arifullov/auth/internal/repository/rolerequest/model"
+)
+
+func ToRoleRequestFromRepo(req modelRepo.RoleRequest) *model.RoleRequest {
+	return &model.RoleRequest{
+		ID:              req.ID,
+		UserID:          req.UserID,
+		Role:            model.Role(req.Role),
+		Justification:   req.Justification,
+		Duration:        time.Duration(req.DurationSeconds) * time.Second,
+		Status:          model.RoleRequestStatus(req.Status),
+		ApproverID:      req.ApproverID.Int64,
+		DecisionComment: req.DecisionComment,
+		CreatedAt:       req.CreatedAt,
+		DecidedAt:       toTimePtr(req.DecidedAt),
+		ExpiresAt:       toTimePtr(req.ExpiresAt),
+	}
+}
+
+func ToRoleRequestsFromRepo(reqs []modelRepo.RoleRequest) []model.RoleRequest {
+	res := make([]model.RoleRequest, 0, len(reqs))
+	for _, req := range reqs {
+		res = append(res, *ToRoleRequestFromRepo(req))
+	}
+	return res
+}
+
+func ToExpiredRoleGrantsFromRepo(grants []modelRepo.ExpiredRoleGrant) []model.ExpiredRoleGrant {
+	res := make([]model.ExpiredRoleGrant, 0, len(grants))
+	for _, grant := range grants {
+		res = append(res, model.ExpiredRoleGrant{
+			UserID: grant.UserID,
+			Role:   model.Role(grant.Role),
+		})
+	}
+	return res
+}
+
+func toTimePtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
@@ -0,0 +1,25 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+type RoleRequest struct {
+	ID              int64         `db:"id"`
+	UserID          int64         `db:"user_id"`
+	Role            string        `db:"role"`
+	Justification   string        `db:"justification"`
+	DurationSeconds int64         `db:"duration_seconds"`
+	Status          string        `db:"status"`
+	ApproverID      sql.NullInt64 `db:"approver_id"`
+	DecisionComment string        `db:"decision_comment"`
+	CreatedAt       time.Time     `db:"created_at"`
+	DecidedAt       sql.NullTime  `db:"decided_at"`
+	ExpiresAt       sql.NullTime  `db:"expires_at"`
+}
+
+type ExpiredRoleGrant struct {
+	UserID int64  `db:"user_id"`
+	Role   string `db:"role"`
+}
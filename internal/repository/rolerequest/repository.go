@@ -0,0 +1,238 @@
+package rolerequest
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/arifullov/auth/internal/client/db"
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/repository"
+	"github.com/arifullov/auth/internal/repository/rolerequest/converter"
+	modelRepo "github.com/arifullov/auth/internal/repository/rolerequest/model"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+)
+
+const (
+	tableName = "role_requests rq"
+
+	idColumn              = "rq.id"
+	userIDColumn          = "rq.user_id"
+	roleColumn            = "(select r.name from roles r where r.id = rq.role_id) as role"
+	justificationColumn   = "rq.justification"
+	durationColumn        = "rq.duration_seconds"
+	statusColumn          = "rq.status"
+	approverIDColumn      = "rq.approver_id"
+	decisionCommentColumn = "rq.decision_comment"
+	createdAtColumn       = "rq.created_at"
+	decidedAtColumn       = "rq.decided_at"
+	expiresAtColumn       = "rq.expires_at"
+)
+
+var columns = []string{
+	idColumn, userIDColumn, roleColumn, justificationColumn, durationColumn, statusColumn,
+	approverIDColumn, decisionCommentColumn, createdAtColumn, decidedAtColumn, expiresAtColumn,
+}
+
+// createQuery inserts a pending request for the role named $2.
+const createQuery = `
+insert into role_requests (user_id, role_id, justification, duration_seconds)
+select $1, r.id, $3, $4
+from roles r
+where r.name = $2
+returning id`
+
+// decideQuery resolves the pending request, an approved request expires its duration after the decision.
+const decideQuery = `
+update role_requests rq
+set status = $2,
+	approver_id = $3,
+	decision_comment = $4,
+	decided_at = $5,
+	expires_at = case when $2::text = 'approved' then $5::timestamptz + rq.duration_seconds * interval '1 second' end
+where rq.id = $1 and rq.status = 'pending'
+returning rq.id, rq.user_id, (select r.name from roles r where r.id = rq.role_id) as role,
+	rq.justification, rq.duration_seconds, rq.status, rq.approver_id, rq.decision_comment,
+	rq.created_at, rq.decided_at, rq.expires_at`
+
+// grantQuery grants the role until $3. A permanent grant stays permanent and a longer
+// time-bound grant is kept.
+const grantQuery = `
+insert into user_roles (user_id, role_id, expires_at)
+select $1, r.id, $3
+from roles r
+where r.name = $2
+on conflict (user_id, role_id) do update
+set expires_at = case
+	when user_roles.expires_at is null then null
+	else greatest(user_roles.expires_at, excluded.expires_at)
+end`
+
+const deleteExpiredGrantsQuery = `
+delete from user_roles ur
+using roles r
+where r.id = ur.role_id and ur.expires_at <= $1
+returning ur.user_id, r.name as role`
+
+const expireRequestsQuery = `
+update role_requests
+set status = 'expired'
+where status = 'approved' and expires_at <= $1
+returning id`
+
+type repo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) repository.RoleRequestRepository {
+	return &repo{db: db}
+}
+
+func (r *repo) Create(ctx context.Context, req *model.CreateRoleRequest) (int64, error) {
+	q := db.Query{
+		Name:     "role_request_repository.Create",
+		QueryRaw: createQuery,
+	}
+
+	var id int64
+	var pgErr *pgconn.PgError
+	err := r.db.DB().QueryRowContext(ctx, q, req.UserID, string(req.Role), req.Justification, int64(req.Duration/time.Second)).Scan(&id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, sys.NewCommonError(codes.NotFound, "role not found")
+	}
+	if err != nil && errors.As(err, &pgErr) {
+		if pgErr.Code == "23505" {
+			return 0, sys.NewCommonError(codes.AlreadyExists, "role is already requested")
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *repo) Get(ctx context.Context, id int64) (*model.RoleRequest, error) {
+	builderSelect := sq.Select(columns...).
+		PlaceholderFormat(sq.Dollar).
+		From(tableName).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "role_request_repository.Get",
+		QueryRaw: query,
+	}
+
+	var req modelRepo.RoleRequest
+	err = r.db.DB().ScanOneContext(ctx, &req, q, args...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, sys.NewCommonError(codes.NotFound, "role request not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return converter.ToRoleRequestFromRepo(req), nil
+}
+
+func (r *repo) Find(ctx context.Context, filter model.RoleRequestFilter) ([]model.RoleRequest, error) {
+	builderSelect := sq.Select(columns...).
+		PlaceholderFormat(sq.Dollar).
+		From(tableName).
+		Where(sq.Gt{idColumn: filter.AfterID}).
+		OrderBy(idColumn).
+		Limit(filter.Limit)
+	if filter.UserID != 0 {
+		builderSelect = builderSelect.Where(sq.Eq{userIDColumn: filter.UserID})
+	}
+	if filter.Status != "" {
+		builderSelect = builderSelect.Where(sq.Eq{statusColumn: string(filter.Status)})
+	}
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "role_request_repository.Find",
+		QueryRaw: query,
+	}
+
+	var reqs []modelRepo.RoleRequest
+	err = r.db.DB().ScanAllContext(ctx, &reqs, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	return converter.ToRoleRequestsFromRepo(reqs), nil
+}
+
+func (r *repo) Decide(ctx context.Context, decision *model.RoleRequestDecision) (*model.RoleRequest, error) {
+	q := db.Query{
+		Name:     "role_request_repository.Decide",
+		QueryRaw: decideQuery,
+	}
+
+	var req modelRepo.RoleRequest
+	err := r.db.DB().ScanOneContext(ctx, &req, q,
+		decision.ID, string(decision.Status), decision.ApproverID, decision.Comment, decision.DecidedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, sys.NewCommonError(codes.FailedPrecondition, "role request is not pending")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return converter.ToRoleRequestFromRepo(req), nil
+}
+
+func (r *repo) Grant(ctx context.Context, userID int64, role model.Role, expiresAt time.Time) error {
+	q := db.Query{
+		Name:     "role_request_repository.Grant",
+		QueryRaw: grantQuery,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, userID, string(role), expiresAt)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return sys.NewCommonError(codes.NotFound, "role not found")
+	}
+	return nil
+}
+
+func (r *repo) DeleteExpiredGrants(ctx context.Context, now time.Time) ([]model.ExpiredRoleGrant, error) {
+	q := db.Query{
+		Name:     "role_request_repository.DeleteExpiredGrants",
+		QueryRaw: deleteExpiredGrantsQuery,
+	}
+
+	var grants []modelRepo.ExpiredRoleGrant
+	err := r.db.DB().ScanAllContext(ctx, &grants, q, now)
+	if err != nil {
+		return nil, err
+	}
+	return converter.ToExpiredRoleGrantsFromRepo(grants), nil
+}
+
+func (r *repo) ExpireRequests(ctx context.Context, now time.Time) ([]int64, error) {
+	q := db.Query{
+		Name:     "role_request_repository.ExpireRequests",
+		QueryRaw: expireRequestsQuery,
+	}
+
+	var ids []int64
+	err := r.db.DB().ScanAllContext(ctx, &ids, q, now)
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
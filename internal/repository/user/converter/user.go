@@ -6,13 +6,29 @@ import (
 )
 
 func ToUserFromRepo(user modelRepo.User) *model.User {
+	roles := make([]model.Role, 0, len(user.Roles))
+	for _, role := range user.Roles {
+		roles = append(roles, model.Role(role))
+	}
 	return &model.User{
-		ID:           user.ID,
-		Name:         user.Name,
-		Email:        user.Email,
-		Role:         model.Role(user.Role),
-		PasswordHash: user.PasswordHash,
-		CreatedAt:    user.CreatedAt,
-		UpdatedAt:    user.UpdatedAt,
+		ID:              user.ID,
+		Name:            user.Name,
+		Email:           user.Email,
+		Roles:           roles,
+		PasswordHash:    user.PasswordHash,
+		TokensRevokedAt: user.TokensRevokedAt.Time,
+		CreatedAt:       user.CreatedAt,
+		UpdatedAt:       user.UpdatedAt,
+	}
+}
+
+func ToTokenRevocationsFromRepo(revocations []modelRepo.TokenRevocation) []model.TokenRevocation {
+	res := make([]model.TokenRevocation, 0, len(revocations))
+	for _, revocation := range revocations {
+		res = append(res, model.TokenRevocation{
+			UserID:    revocation.UserID,
+			RevokedAt: revocation.RevokedAt,
+		})
 	}
+	return res
 }
@@ -1,15 +1,23 @@
 package model
 
 import (
+	"database/sql"
 	"time"
 )
 
 type User struct {
-	ID           int64     `db:"id"`
-	Name         string    `db:"name"`
-	Email        string    `db:"email"`
-	Role         string    `db:"role"`
-	PasswordHash string    `db:"password_hash"`
-	CreatedAt    time.Time `db:"created_at"`
-	UpdatedAt    time.Time `db:"updated_at"`
+	ID           int64    `db:"id"`
+	Name         string   `db:"name"`
+	Email        string   `db:"email"`
+	Roles        []string `db:"roles"`
+	PasswordHash string   `db:"password_hash"`
+	// TokensRevokedAt is null until the tokens of the user are revoked for the first time.
+	TokensRevokedAt sql.NullTime `db:"tokens_revoked_at"`
+	CreatedAt       time.Time    `db:"created_at"`
+	UpdatedAt       time.Time    `db:"updated_at"`
+}
+
+type TokenRevocation struct {
+	UserID    int64     `db:"id"`
+	RevokedAt time.Time `db:"tokens_revoked_at"`
 }
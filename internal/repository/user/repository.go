@@ -21,43 +21,104 @@ import (
 const (
 	tableName = "users"
 
-	idColumn           = "id"
-	nameColumn         = "name"
-	emailColumn        = "email"
-	roleColumn         = "role"
-	passwordHashColumn = "password_hash"
-	createdAtColumn    = "created_at"
-	updatedAtColumn    = "updated_at"
+	idColumn              = "id"
+	nameColumn            = "name"
+	emailColumn           = "email"
+	rolesColumn           = "coalesce((select array_agg(r.name order by r.name) from user_roles ur join roles r on r.id = ur.role_id where ur.user_id = users.id and (ur.expires_at is null or ur.expires_at > now())), '{}') as roles"
+	tenantRolesColumn     = "coalesce((select array_agg(r.name order by r.name) from organization_member_roles mr join organizations o on o.id = mr.organization_id join roles r on r.id = mr.role_id where mr.user_id = users.id and o.slug = ?), '{}') as roles"
+	passwordHashColumn    = "password_hash"
+	tokensRevokedAtColumn = "tokens_revoked_at"
+	createdAtColumn       = "created_at"
+	updatedAtColumn       = "updated_at"
 )
 
 type repo struct {
 	db db.Client
 }
 
+// NewRepository returns a repository isolating tenants: in an organization, users outside of
+// it are not found and roles are assigned in it.
 func NewRepository(db db.Client) repository.UserRepository {
 	return &repo{db: db}
 }
 
-func (r *repo) Create(ctx context.Context, user *model.CreateUser) (int64, error) {
-	builderInsert := sq.Insert(tableName).
-		PlaceholderFormat(sq.Dollar).
-		Columns(nameColumn, emailColumn, passwordHashColumn, roleColumn, createdAtColumn, updatedAtColumn).
-		Values(user.Name, user.Email, user.PasswordHash, user.Role, user.CreatedAt, user.UpdatedAt).
-		Suffix("RETURNING id")
+// memberOf limits a query of users to the members of the tenant.
+func memberOf(tenant string) sq.Sqlizer {
+	return sq.Expr("exists (select 1 from organization_members m join organizations o on o.id = m.organization_id where m.user_id = users.id and o.slug = ?)", tenant)
+}
 
-	query, args, err := builderInsert.ToSql()
-	if err != nil {
-		return 0, err
+// scoped adds the isolation of the tenant to a condition on users.
+func scoped(tenant string, cond sq.Sqlizer) sq.Sqlizer {
+	if tenant == "" {
+		return cond
+	}
+	return sq.And{cond, memberOf(tenant)}
+}
+
+func rolesOf(tenant string) sq.Sqlizer {
+	if tenant == "" {
+		return sq.Expr(rolesColumn)
+	}
+	return sq.Expr(tenantRolesColumn, tenant)
+}
+
+// createQuery inserts the user and assigns the roles in one statement, names missing in the roles table are ignored.
+const createQuery = `
+with new_user as (
+	insert into users (name, email, password_hash, created_at, updated_at)
+	values ($1, $2, $3, $4, $5)
+	returning id
+), new_user_roles as (
+	insert into user_roles (user_id, role_id)
+	select new_user.id, roles.id
+	from new_user, roles
+	where roles.name = any($6)
+)
+select id from new_user`
+
+// createMemberQuery inserts the user as a member of the organization $6 and assigns the roles
+// there, names missing in the roles table are ignored.
+const createMemberQuery = `
+with new_user as (
+	insert into users (name, email, password_hash, created_at, updated_at)
+	values ($1, $2, $3, $4, $5)
+	returning id
+), new_member as (
+	insert into organization_members (organization_id, user_id)
+	select o.id, new_user.id
+	from new_user, organizations o
+	where o.slug = $6
+	returning organization_id, user_id
+), new_member_roles as (
+	insert into organization_member_roles (organization_id, user_id, role_id)
+	select new_member.organization_id, new_member.user_id, roles.id
+	from new_member, roles
+	where roles.name = any($7)
+)
+select id from new_user`
+
+func (r *repo) Create(ctx context.Context, user *model.CreateUser, tenant string) (int64, error) {
+	roles := make([]string, 0, len(user.Roles))
+	for _, role := range user.Roles {
+		roles = append(roles, string(role))
 	}
+	args := []any{user.Name, user.Email, user.PasswordHash, user.CreatedAt, user.UpdatedAt, roles}
 
 	q := db.Query{
 		Name:     "user_repository.Create",
-		QueryRaw: query,
+		QueryRaw: createQuery,
+	}
+	if tenant != "" {
+		args = []any{user.Name, user.Email, user.PasswordHash, user.CreatedAt, user.UpdatedAt, tenant, roles}
+		q = db.Query{
+			Name:     "user_repository.CreateMember",
+			QueryRaw: createMemberQuery,
+		}
 	}
 
 	var userID int64
 	var pgErr *pgconn.PgError
-	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&userID)
+	err := r.db.DB().QueryRowContext(ctx, q, args...).Scan(&userID)
 	if err != nil && errors.As(err, &pgErr) {
 		if pgErr.Code == "23505" {
 			return 0, sys.NewCommonError(codes.AlreadyExists, "user already exists")
@@ -69,11 +130,13 @@ func (r *repo) Create(ctx context.Context, user *model.CreateUser) (int64, error
 	return userID, nil
 }
 
-func (r *repo) Get(ctx context.Context, id int64) (*model.User, error) {
-	builderSelect := sq.Select(idColumn, nameColumn, emailColumn, roleColumn, createdAtColumn, updatedAtColumn).
+func (r *repo) Get(ctx context.Context, id int64, tenant string) (*model.User, error) {
+	builderSelect := sq.Select(idColumn, nameColumn, emailColumn).
+		Column(rolesOf(tenant)).
+		Columns(tokensRevokedAtColumn, createdAtColumn, updatedAtColumn).
 		PlaceholderFormat(sq.Dollar).
 		From(tableName).
-		Where(sq.Eq{idColumn: id})
+		Where(scoped(tenant, sq.Eq{idColumn: id}))
 
 	query, args, err := builderSelect.ToSql()
 	if err != nil {
@@ -98,7 +161,7 @@ func (r *repo) Get(ctx context.Context, id int64) (*model.User, error) {
 }
 
 func (r *repo) GetByEmail(ctx context.Context, email string) (*model.User, error) {
-	builderSelect := sq.Select(idColumn, nameColumn, emailColumn, roleColumn, passwordHashColumn, createdAtColumn, updatedAtColumn).
+	builderSelect := sq.Select(idColumn, nameColumn, emailColumn, rolesColumn, passwordHashColumn, tokensRevokedAtColumn, createdAtColumn, updatedAtColumn).
 		PlaceholderFormat(sq.Dollar).
 		From(tableName).
 		Where(sq.Eq{emailColumn: email})
@@ -125,11 +188,11 @@ func (r *repo) GetByEmail(ctx context.Context, email string) (*model.User, error
 	return converter.ToUserFromRepo(user), nil
 }
 
-func (r *repo) Update(ctx context.Context, user *model.UpdateUser) error {
+func (r *repo) Update(ctx context.Context, user *model.UpdateUser, tenant string) error {
 	builderUpdate := sq.Update(tableName).
 		PlaceholderFormat(sq.Dollar).
 		Set(updatedAtColumn, time.Now()).
-		Where(sq.Eq{idColumn: user.ID})
+		Where(scoped(tenant, sq.Eq{idColumn: user.ID}))
 	if user.Name.Valid {
 		builderUpdate = builderUpdate.Set(nameColumn, user.Name.String)
 	}
@@ -163,7 +226,13 @@ func (r *repo) Update(ctx context.Context, user *model.UpdateUser) error {
 	return nil
 }
 
-func (r *repo) Delete(ctx context.Context, id int64) error {
+// Delete removes the user, or only the membership in a tenant: the user may still belong
+// to other organizations.
+func (r *repo) Delete(ctx context.Context, id int64, tenant string) error {
+	if tenant != "" {
+		return r.deleteMember(ctx, tenant, id)
+	}
+
 	builderDelete := sq.Delete(tableName).
 		PlaceholderFormat(sq.Dollar).
 		Where(sq.Eq{idColumn: id})
@@ -187,3 +256,138 @@ func (r *repo) Delete(ctx context.Context, id int64) error {
 	}
 	return nil
 }
+
+// setRolesQuery makes the roles of the user equal to the given names, names missing in the roles table are ignored.
+// Time-bound grants of the given roles become permanent.
+const setRolesQuery = `
+with removed as (
+	delete from user_roles ur
+	using roles r
+	where ur.role_id = r.id and ur.user_id = $1 and r.name <> all($2)
+)
+insert into user_roles (user_id, role_id)
+select $1, r.id
+from roles r
+where r.name = any($2)
+on conflict (user_id, role_id) do update set expires_at = null`
+
+// setMemberRolesQuery makes the roles of the member of the organization $3 equal to the given
+// names, names missing in the roles table are ignored.
+const setMemberRolesQuery = `
+with member as (
+	select m.organization_id, m.user_id
+	from organization_members m
+	join organizations o on o.id = m.organization_id
+	where m.user_id = $1 and o.slug = $3
+), removed as (
+	delete from organization_member_roles mr
+	using roles r, member
+	where mr.role_id = r.id and mr.organization_id = member.organization_id
+		and mr.user_id = member.user_id and r.name <> all($2)
+)
+insert into organization_member_roles (organization_id, user_id, role_id)
+select member.organization_id, member.user_id, r.id
+from member, roles r
+where r.name = any($2)
+on conflict do nothing`
+
+// SetRoles replaces the roles assigned to the user, in a tenant only the roles assigned
+// in the organization are replaced.
+func (r *repo) SetRoles(ctx context.Context, id int64, roles []model.Role, tenant string) error {
+	names := make([]string, 0, len(roles))
+	for _, role := range roles {
+		names = append(names, string(role))
+	}
+
+	q := db.Query{
+		Name:     "user_repository.SetRoles",
+		QueryRaw: setRolesQuery,
+	}
+	args := []any{id, names}
+	if tenant != "" {
+		q = db.Query{
+			Name:     "user_repository.SetMemberRoles",
+			QueryRaw: setMemberRolesQuery,
+		}
+		args = append(args, tenant)
+	}
+
+	_, err := r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
+
+func (r *repo) deleteMember(ctx context.Context, tenant string, id int64) error {
+	builderDelete := sq.Delete("organization_members m").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"m.user_id": id}).
+		Where(sq.Expr("m.organization_id = (select o.id from organizations o where o.slug = ?)", tenant))
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "user_repository.DeleteMember",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return sys.NewCommonError(codes.NotFound, "user not found")
+	}
+	return nil
+}
+
+func (r *repo) RevokeTokens(ctx context.Context, id int64, revokedAt time.Time) error {
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(tokensRevokedAtColumn, revokedAt).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "user_repository.RevokeTokens",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return sys.NewCommonError(codes.NotFound, "user not found")
+	}
+	return nil
+}
+
+func (r *repo) ListTokenRevocations(ctx context.Context, since time.Time) ([]model.TokenRevocation, error) {
+	builderSelect := sq.Select(idColumn, tokensRevokedAtColumn).
+		PlaceholderFormat(sq.Dollar).
+		From(tableName).
+		Where(sq.Gt{tokensRevokedAtColumn: since})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "user_repository.ListTokenRevocations",
+		QueryRaw: query,
+	}
+
+	var revocations []modelRepo.TokenRevocation
+	err = r.db.DB().ScanAllContext(ctx, &revocations, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	return converter.ToTokenRevocationsFromRepo(revocations), nil
+}
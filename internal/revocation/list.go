@@ -0,0 +1,120 @@
+package revocation
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/arifullov/auth/internal/client/db"
+	"github.com/arifullov/auth/internal/logger"
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/repository"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+	"github.com/arifullov/auth/internal/utils"
+)
+
+// NotifyChannel receives the id of a user whenever the tokens of the user are revoked.
+const NotifyChannel = "tokens_revoked"
+
+// List keeps the recent token revocations in memory, so access tokens can be checked
+// against them without querying the database on every call. Revocations older than the
+// access token lifetime are left out, tokens issued before them have expired anyway.
+type List struct {
+	userRepository repository.UserRepository
+	window         time.Duration
+
+	// revokedAt maps the subject of tokens to the moment they were revoked.
+	revokedAt atomic.Pointer[map[string]time.Time]
+	// reloadMu serializes reloads, so an older list never replaces a newer one.
+	reloadMu sync.Mutex
+}
+
+func NewList(userRepository repository.UserRepository, window time.Duration) *List {
+	return &List{
+		userRepository: userRepository,
+		window:         window,
+	}
+}
+
+// Revoked reports whether the token was revoked, loading the list on first use.
+func (l *List) Revoked(ctx context.Context, claims *model.UserClaims) (bool, error) {
+	revokedAt := l.revokedAt.Load()
+	if revokedAt == nil {
+		if err := l.Reload(ctx); err != nil {
+			return false, err
+		}
+		revokedAt = l.revokedAt.Load()
+	}
+	return claims.IssuedBefore((*revokedAt)[claims.Subject]), nil
+}
+
+// Reload loads the revocations made within the access token lifetime.
+func (l *List) Reload(ctx context.Context) error {
+	l.reloadMu.Lock()
+	defer l.reloadMu.Unlock()
+
+	revocations, err := l.userRepository.ListTokenRevocations(ctx, time.Now().Add(-l.window))
+	if err != nil {
+		return err
+	}
+
+	revokedAt := make(map[string]time.Time, len(revocations))
+	for _, revocation := range revocations {
+		revokedAt[strconv.FormatInt(revocation.UserID, 10)] = revocation.RevokedAt
+	}
+	l.revokedAt.Store(&revokedAt)
+	return nil
+}
+
+// Watch keeps the list fresh until the context is canceled: it reloads on notifications
+// about new revocations and fully every reloadInterval in case a notification was lost.
+func (l *List) Watch(ctx context.Context, listener db.Listener, reloadInterval time.Duration) {
+	notifications := make(chan struct{}, 1)
+	go func() {
+		err := listener.Listen(ctx, NotifyChannel, func(string) {
+			// Coalesce bursts of revocations into a single pending reload.
+			select {
+			case notifications <- struct{}{}:
+			default:
+			}
+		})
+		if err != nil {
+			logger.Errorf("failed to listen for token revocations: %s", err.Error())
+		}
+	}()
+
+	ticker := time.NewTicker(reloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-notifications:
+		case <-ticker.C:
+		}
+
+		if err := l.Reload(ctx); err != nil && ctx.Err() == nil {
+			logger.Errorf("failed to reload token revocations: %s", err.Error())
+		}
+	}
+}
+
+// VerifyToken returns the claims of an access token signed with the secret key that is not revoked.
+func (l *List) VerifyToken(ctx context.Context, accessToken string, secretKey []byte) (*model.UserClaims, error) {
+	claims, err := utils.VerifyToken(accessToken, secretKey)
+	if err != nil {
+		return nil, sys.NewCommonError(codes.Unauthenticated, err.Error())
+	}
+	revoked, err := l.Revoked(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, sys.NewCommonError(codes.Unauthenticated, "token revoked")
+	}
+	return claims, nil
+}
@@ -0,0 +1,74 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arifullov/auth/internal/model"
+	repositoryMocks "github.com/arifullov/auth/internal/repository/mocks"
+	"github.com/arifullov/auth/internal/revocation"
+)
+
+func TestRevoked(t *testing.T) {
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		second    = time.Now().Truncate(time.Second)
+		revokedAt = second.Add(300 * time.Millisecond)
+	)
+
+	userRepositoryMock := repositoryMocks.NewUserRepositoryMock(mc)
+	userRepositoryMock.ListTokenRevocationsMock.Return([]model.TokenRevocation{{UserID: 1, RevokedAt: revokedAt}}, nil)
+	list := revocation.NewList(userRepositoryMock, time.Hour)
+
+	tests := []struct {
+		name     string
+		subject  string
+		issuedAt *jwt.NumericDate
+		want     bool
+	}{
+		{
+			name:     "issued before the revocation",
+			subject:  "1",
+			issuedAt: jwt.NewNumericDate(second.Add(-time.Second)),
+			want:     true,
+		},
+		{
+			name:     "issued within the second of the revocation",
+			subject:  "1",
+			issuedAt: jwt.NewNumericDate(second),
+			want:     true,
+		},
+		{
+			name:     "issued after the second of the revocation",
+			subject:  "1",
+			issuedAt: jwt.NewNumericDate(second.Add(time.Second)),
+		},
+		{
+			name:    "issued without iat",
+			subject: "1",
+			want:    true,
+		},
+		{
+			name:     "tokens of another user",
+			subject:  "2",
+			issuedAt: jwt.NewNumericDate(second.Add(-time.Second)),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			claims := &model.UserClaims{RegisteredClaims: jwt.RegisteredClaims{Subject: tt.subject, IssuedAt: tt.issuedAt}}
+			revoked, err := list.Revoked(ctx, claims)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, revoked)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package access
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/arifullov/auth/internal/logger"
+	"github.com/arifullov/auth/internal/metric"
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/policy"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+	"github.com/arifullov/auth/internal/utils"
+)
+
+// globalRoutes manage the whole service, they are denied to tokens issued for an organization:
+// the roles of such tokens may be assigned in the organization only.
+var globalRoutes = []string{
+	"/access_admin_v1.AccessAdminV1/",
+	"/group_v1.GroupV1/",
+	"/relation_v1.RelationV1/",
+	"/role_request_v1.RoleRequestV1/",
+}
+
+func (s *serv) Check(ctx context.Context, accessToken string, req *model.AccessRequest) error {
+	_, err := s.Authorize(ctx, accessToken, req)
+	return err
+}
+
+func (s *serv) Authorize(ctx context.Context, accessToken string, req *model.AccessRequest) (*model.UserClaims, error) {
+	claims, err := s.verifyToken(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := s.policyCache.Snapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	decision := s.decide(snapshot.Index, claims, req)
+	switch decision.Reason {
+	case "":
+		return claims, nil
+	case model.DenyReasonInvalidAudience:
+		return nil, sys.NewCommonError(codes.PermissionDenied, "invalid token audience")
+	case model.DenyReasonTenantMismatch:
+		return nil, sys.NewCommonError(codes.PermissionDenied, "token is not valid for the tenant")
+	case model.DenyReasonInsufficientScope:
+		return nil, sys.NewCommonError(codes.PermissionDenied, model.InsufficientScopeChallenge(decision.MissingScopes))
+	case model.DenyReasonNoMatchingRule:
+		logger.Warnf("no access rule matches route %s %s", req.Method, req.EndpointAddress)
+		return nil, sys.NewCommonError(codes.PermissionDenied, "no access rule matches route")
+	default:
+		return nil, sys.NewCommonError(codes.PermissionDenied, "permission denied")
+	}
+}
+
+func (s *serv) BatchCheck(ctx context.Context, accessToken string, reqs []model.AccessRequest) ([]model.AccessDecision, error) {
+	claims, err := s.verifyToken(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every request is decided against the same policy snapshot.
+	snapshot, err := s.policyCache.Snapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	decisions := make([]model.AccessDecision, 0, len(reqs))
+	for i := range reqs {
+		decisions = append(decisions, s.decide(snapshot.Index, claims, &reqs[i]))
+	}
+	return decisions, nil
+}
+
+// verifyToken returns the claims of a valid access token that is not revoked.
+func (s *serv) verifyToken(ctx context.Context, accessToken string) (*model.UserClaims, error) {
+	return s.revocationList.VerifyToken(ctx, accessToken, utils.S2B(s.accessTokenSecretKey))
+}
+
+func (s *serv) decide(index *policy.Index, claims *model.UserClaims, req *model.AccessRequest) model.AccessDecision {
+	// Tokens without audience are issued by Login and are valid everywhere,
+	// exchanged tokens may only be used by the services they were issued for.
+	if len(claims.Audience) > 0 && !slices.Contains(claims.Audience, req.Audience) {
+		return model.AccessDecision{
+			Roles:  claims.Roles,
+			Reason: model.DenyReasonInvalidAudience,
+		}
+	}
+	if (req.Tenant != "" && req.Tenant != claims.Tenant) || (claims.Tenant != "" && isGlobalRoute(req.EndpointAddress)) {
+		return model.AccessDecision{
+			Roles:  claims.Roles,
+			Reason: model.DenyReasonTenantMismatch,
+		}
+	}
+
+	start := time.Now()
+	decision := index.Decide(policy.Request{
+		Route:      req.EndpointAddress,
+		Method:     req.Method,
+		Claims:     claims,
+		Fields:     req.Fields,
+		PathParams: req.PathParams,
+		IP:         req.IP,
+		Headers:    req.Headers,
+		Time:       start,
+	})
+	decisionLabel := decisionDeny
+	if decision.Allowed {
+		decisionLabel = decisionAllow
+	}
+	metric.HistogramPolicyEvaluationObserve(decisionLabel, time.Since(start).Seconds())
+
+	return model.AccessDecision{
+		Allowed:       decision.Allowed,
+		Rule:          decision.Rule,
+		Roles:         claims.Roles,
+		Reason:        decision.Reason,
+		MissingScopes: decision.MissingScopes,
+	}
+}
+
+func isGlobalRoute(route string) bool {
+	for _, prefix := range globalRoutes {
+		if strings.HasPrefix(route, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,168 @@
+package access
+
+import (
+	"context"
+	"encoding/base64"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+)
+
+const (
+	defaultPageSize = 50
+	// userScanBatch is how many users are fetched at once while looking for the users
+	// that may call a route.
+	userScanBatch = 200
+)
+
+func (s *serv) ListAccessibleRoutes(ctx context.Context, accessToken string, userID int64, pageToken string, filter model.RouteGrantFilter) (*model.RouteGrantPage, error) {
+	claims, err := s.verifyToken(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	after, err := decodeRoutePageToken(pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := claims.Roles
+	if userID != 0 && strconv.FormatInt(userID, 10) != claims.Subject {
+		if !claims.HasGlobalRole(model.AdminRole) {
+			return nil, sys.NewCommonError(codes.PermissionDenied, "admin role required")
+		}
+		access, errAccess := s.roleRepository.GetUserAccess(ctx, userID, claims.Tenant)
+		if errAccess != nil {
+			return nil, errAccess
+		}
+		roles = access.Roles
+	}
+
+	snapshot, err := s.policyCache.Snapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := filter.Limit
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	page := &model.RouteGrantPage{}
+	for _, grant := range snapshot.Index.AccessibleRoutes(roles) {
+		if !strings.HasPrefix(grant.Route, filter.RoutePrefix) || routeKey(grant) <= after {
+			continue
+		}
+		if uint64(len(page.Grants)) == pageSize {
+			page.NextPageToken = encodeRoutePageToken(page.Grants[pageSize-1])
+			break
+		}
+		page.Grants = append(page.Grants, grant)
+	}
+	return page, nil
+}
+
+func (s *serv) ListPrincipalsForRoute(ctx context.Context, accessToken string, route string, method string, pageToken string, limit uint64) (*model.RoutePrincipals, error) {
+	claims, err := s.verifyToken(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	if !claims.HasGlobalRole(model.AdminRole) {
+		return nil, sys.NewCommonError(codes.PermissionDenied, "admin role required")
+	}
+	afterID, err := decodeUserPageToken(pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := s.policyCache.Snapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// The route is validated once here, later grants of the same route cannot fail.
+	if _, _, err = snapshot.Index.Grant(route, method, nil); err != nil {
+		return nil, sys.NewCommonError(codes.InvalidArgument, err.Error())
+	}
+
+	principals := &model.RoutePrincipals{}
+	if pageToken == "" {
+		effective, errRoles := s.roleRepository.ListEffectiveRoles(ctx)
+		if errRoles != nil {
+			return nil, errRoles
+		}
+		roles := make([]model.Role, 0, len(effective))
+		for role := range effective {
+			roles = append(roles, role)
+		}
+		slices.Sort(roles)
+		for _, role := range roles {
+			if grant, ok, _ := snapshot.Index.Grant(route, method, effective[role]); ok {
+				principals.Roles = append(principals.Roles, model.RoleGrant{Role: role, Grant: grant})
+			}
+		}
+	}
+
+	pageSize := limit
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	for {
+		users, errUsers := s.roleRepository.ListUserRoles(ctx, afterID, userScanBatch)
+		if errUsers != nil {
+			return nil, errUsers
+		}
+		for _, user := range users {
+			grant, ok, _ := snapshot.Index.Grant(route, method, user.Roles)
+			if !ok {
+				continue
+			}
+			if uint64(len(principals.Users)) == pageSize {
+				principals.NextPageToken = encodeUserPageToken(principals.Users[pageSize-1].UserID)
+				return principals, nil
+			}
+			principals.Users = append(principals.Users, model.UserGrant{UserID: user.UserID, Name: user.Name, Grant: grant})
+		}
+		if len(users) < userScanBatch {
+			return principals, nil
+		}
+		afterID = users[len(users)-1].UserID
+	}
+}
+
+// routeKey orders grants like AccessibleRoutes returns them.
+func routeKey(grant model.RouteGrant) string {
+	return grant.Route + "\x00" + grant.Method
+}
+
+func encodeRoutePageToken(last model.RouteGrant) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(routeKey(last)))
+}
+
+func decodeRoutePageToken(pageToken string) (string, error) {
+	b, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return "", sys.NewCommonError(codes.InvalidArgument, "invalid page token")
+	}
+	return string(b), nil
+}
+
+func encodeUserPageToken(lastID int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(lastID, 10)))
+}
+
+func decodeUserPageToken(pageToken string) (int64, error) {
+	if pageToken == "" {
+		return 0, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return 0, sys.NewCommonError(codes.InvalidArgument, "invalid page token")
+	}
+	lastID, err := strconv.ParseInt(string(b), 10, 64)
+	if err != nil || lastID < 0 {
+		return 0, sys.NewCommonError(codes.InvalidArgument, "invalid page token")
+	}
+	return lastID, nil
+}
@@ -0,0 +1,106 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/policy"
+	repositoryMocks "github.com/arifullov/auth/internal/repository/mocks"
+	"github.com/arifullov/auth/internal/revocation"
+	"github.com/arifullov/auth/internal/service/access"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+	"github.com/arifullov/auth/internal/utils"
+)
+
+const secretKey = "access-secret"
+
+func signToken(t *testing.T, tenant string) string {
+	now := time.Now()
+	token, err := utils.SignClaims(model.UserClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "1",
+			IssuedAt:  jwt.NewNumericDate(now.Add(-time.Minute)),
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+		},
+		Username: "admin@example.com",
+		Roles:    []model.Role{model.AdminRole},
+		Tenant:   tenant,
+	}, []byte(secretKey))
+	require.NoError(t, err)
+	return token
+}
+
+func TestAuthorizeTenant(t *testing.T) {
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		tenantMismatch = sys.NewCommonError(codes.PermissionDenied, "token is not valid for the tenant")
+	)
+
+	accessRepositoryMock := repositoryMocks.NewAccessRepositoryMock(mc)
+	accessRepositoryMock.GetPolicyVersionMock.Return(1, nil)
+	accessRepositoryMock.ListRouteRulesMock.Return([]model.RouteRule{
+		{ID: 1, Route: "/**", Method: model.AnyMethod, Role: model.AdminRole, Effect: model.AllowEffect},
+	}, nil)
+	userRepositoryMock := repositoryMocks.NewUserRepositoryMock(mc)
+	userRepositoryMock.ListTokenRevocationsMock.Return(nil, nil)
+
+	service := access.NewAccessService(
+		policy.NewCache(accessRepositoryMock),
+		revocation.NewList(userRepositoryMock, time.Hour),
+		repositoryMocks.NewRoleRepositoryMock(mc),
+		secretKey,
+	)
+
+	tests := []struct {
+		name string
+		// tokenTenant is the organization the token was issued for.
+		tokenTenant string
+		req         model.AccessRequest
+		err         error
+	}{
+		{
+			name:        "organization admin in the organization",
+			tokenTenant: "acme",
+			req:         model.AccessRequest{EndpointAddress: "/user_v1.UserV1/Delete", Tenant: "acme"},
+		},
+		{
+			name:        "organization admin in another organization",
+			tokenTenant: "acme",
+			req:         model.AccessRequest{EndpointAddress: "/user_v1.UserV1/Delete", Tenant: "globex"},
+			err:         tenantMismatch,
+		},
+		{
+			name:        "organization admin on route rules",
+			tokenTenant: "acme",
+			req:         model.AccessRequest{EndpointAddress: "/access_admin_v1.AccessAdminV1/ReplaceRouteRules"},
+			err:         tenantMismatch,
+		},
+		{
+			name:        "organization admin on groups",
+			tokenTenant: "acme",
+			req:         model.AccessRequest{EndpointAddress: "/group_v1.GroupV1/SetGroupRoles", Tenant: "acme"},
+			err:         tenantMismatch,
+		},
+		{
+			name: "admin on route rules",
+			req:  model.AccessRequest{EndpointAddress: "/access_admin_v1.AccessAdminV1/ReplaceRouteRules"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.Authorize(ctx, signToken(t, tt.tokenTenant), &tt.req)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
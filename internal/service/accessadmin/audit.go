@@ -0,0 +1,28 @@
+package accessadmin
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/arifullov/auth/internal/model"
+)
+
+func (s *serv) audit(ctx context.Context, actor string, action string, id int64, before *model.RouteRule, after *model.RouteRule) error {
+	record := &model.AuditRecord{
+		Actor:        actor,
+		Action:       action,
+		ResourceType: model.AuditResourceRouteRule,
+		ResourceID:   strconv.FormatInt(id, 10),
+		CreatedAt:    time.Now(),
+	}
+	// Typed nil pointers would be stored as JSON null instead of SQL NULL.
+	if before != nil {
+		record.Before = before
+	}
+	if after != nil {
+		record.After = after
+	}
+	_, err := s.auditRepository.Create(ctx, record)
+	return err
+}
@@ -0,0 +1,28 @@
+package accessadmin
+
+import (
+	"context"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+	"github.com/arifullov/auth/internal/utils"
+)
+
+// authorizeAdmin verifies the access token like AccessService.Authorize and requires the admin
+// role outside of organizations. Calls also pass the route rules of the auth interceptor, this
+// check keeps rule management to admins even when a rule grants the API to other roles.
+func (s *serv) authorizeAdmin(ctx context.Context, accessToken string) (*model.UserClaims, error) {
+	claims, err := s.revocationList.VerifyToken(ctx, accessToken, utils.S2B(s.tokenConfig.AccessTokenSecretKey()))
+	if err != nil {
+		return nil, err
+	}
+	// Tokens exchanged for other services are not valid for this one.
+	if len(claims.Audience) > 0 {
+		return nil, sys.NewCommonError(codes.PermissionDenied, "invalid token audience")
+	}
+	if !claims.HasGlobalRole(model.AdminRole) {
+		return nil, sys.NewCommonError(codes.PermissionDenied, "admin role required")
+	}
+	return claims, nil
+}
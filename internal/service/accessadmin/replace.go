@@ -0,0 +1,98 @@
+package accessadmin
+
+import (
+	"context"
+	"slices"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+)
+
+type ruleKey struct {
+	route  string
+	method string
+	role   model.Role
+}
+
+func keyOf(rule model.RouteRule) ruleKey {
+	return ruleKey{route: rule.Route, method: rule.Method, role: rule.Role}
+}
+
+// ReplaceRouteRules makes the stored rules equal to the given set. Rules are matched by
+// route, method and role, so unchanged rules keep their ids and only the differences are
+// written and audited.
+func (s *serv) ReplaceRouteRules(ctx context.Context, accessToken string, rules []model.RouteRule) (*model.ReplaceResult, error) {
+	claims, err := s.authorizeAdmin(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := make(map[ruleKey]model.RouteRule, len(rules))
+	for i := range rules {
+		normalizeRule(&rules[i])
+		key := keyOf(rules[i])
+		if _, ok := wanted[key]; ok {
+			return nil, sys.NewCommonError(codes.InvalidArgument, "duplicate rule for route "+rules[i].Route)
+		}
+		wanted[key] = rules[i]
+	}
+	if err = s.validateRules(ctx, rules); err != nil {
+		return nil, err
+	}
+
+	result := &model.ReplaceResult{}
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		current, errTx := s.accessRepository.ListRouteRules(ctx)
+		if errTx != nil {
+			return errTx
+		}
+
+		for _, before := range current {
+			want, ok := wanted[keyOf(before)]
+			delete(wanted, keyOf(before))
+			switch {
+			case !ok:
+				if errTx = s.accessRepository.DeleteRouteRule(ctx, before.ID); errTx != nil {
+					return errTx
+				}
+				if errTx = s.audit(ctx, claims.Username, model.AuditActionDelete, before.ID, &before, nil); errTx != nil {
+					return errTx
+				}
+				result.Deleted++
+			case want.Effect != before.Effect || want.OwnerField != before.OwnerField || want.Condition != before.Condition ||
+				!slices.Equal(want.Scopes, before.Scopes):
+				want.ID = before.ID
+				if errTx = s.accessRepository.UpdateRouteRule(ctx, &want); errTx != nil {
+					return errTx
+				}
+				if errTx = s.audit(ctx, claims.Username, model.AuditActionUpdate, want.ID, &before, &want); errTx != nil {
+					return errTx
+				}
+				result.Updated++
+			default:
+			}
+		}
+
+		// Rules still left in wanted are not stored yet.
+		for _, rule := range rules {
+			if _, ok := wanted[keyOf(rule)]; !ok {
+				continue
+			}
+			id, errTx := s.accessRepository.CreateRouteRule(ctx, &rule)
+			if errTx != nil {
+				return errTx
+			}
+			rule.ID = id
+			if errTx = s.audit(ctx, claims.Username, model.AuditActionCreate, id, nil, &rule); errTx != nil {
+				return errTx
+			}
+			result.Created++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
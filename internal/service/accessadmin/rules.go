@@ -0,0 +1,143 @@
+package accessadmin
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+)
+
+const (
+	defaultPageSize = 50
+)
+
+func (s *serv) ListRouteRules(ctx context.Context, accessToken string, pageToken string, filter model.RouteRuleFilter) (*model.RouteRulePage, error) {
+	if _, err := s.authorizeAdmin(ctx, accessToken); err != nil {
+		return nil, err
+	}
+
+	afterID, err := decodePageToken(pageToken)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := filter.Limit
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	// One extra rule tells whether there is a next page.
+	filter.AfterID = afterID
+	filter.Limit = pageSize + 1
+	rules, err := s.accessRepository.FindRouteRules(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &model.RouteRulePage{
+		Rules: rules,
+	}
+	if uint64(len(rules)) > pageSize {
+		page.Rules = rules[:pageSize]
+		page.NextPageToken = encodePageToken(page.Rules[pageSize-1].ID)
+	}
+	return page, nil
+}
+
+func (s *serv) GetRouteRule(ctx context.Context, accessToken string, id int64) (*model.RouteRule, error) {
+	if _, err := s.authorizeAdmin(ctx, accessToken); err != nil {
+		return nil, err
+	}
+	return s.accessRepository.GetRouteRule(ctx, id)
+}
+
+func (s *serv) GrantRouteRule(ctx context.Context, accessToken string, rule *model.RouteRule) (*model.RouteRule, error) {
+	claims, err := s.authorizeAdmin(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	normalizeRule(rule)
+	if err = s.validateRules(ctx, []model.RouteRule{*rule}); err != nil {
+		return nil, err
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		id, errTx := s.accessRepository.CreateRouteRule(ctx, rule)
+		if errTx != nil {
+			return errTx
+		}
+		rule.ID = id
+		return s.audit(ctx, claims.Username, model.AuditActionCreate, id, nil, rule)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (s *serv) UpdateRouteRule(ctx context.Context, accessToken string, rule *model.RouteRule) (*model.RouteRule, error) {
+	claims, err := s.authorizeAdmin(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	normalizeRule(rule)
+	if err = s.validateRules(ctx, []model.RouteRule{*rule}); err != nil {
+		return nil, err
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		before, errTx := s.accessRepository.GetRouteRule(ctx, rule.ID)
+		if errTx != nil {
+			return errTx
+		}
+		if errTx = s.accessRepository.UpdateRouteRule(ctx, rule); errTx != nil {
+			return errTx
+		}
+		return s.audit(ctx, claims.Username, model.AuditActionUpdate, rule.ID, before, rule)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (s *serv) RevokeRouteRule(ctx context.Context, accessToken string, id int64) error {
+	claims, err := s.authorizeAdmin(ctx, accessToken)
+	if err != nil {
+		return err
+	}
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		before, errTx := s.accessRepository.GetRouteRule(ctx, id)
+		if errTx != nil {
+			return errTx
+		}
+		if errTx = s.accessRepository.DeleteRouteRule(ctx, id); errTx != nil {
+			return errTx
+		}
+		return s.audit(ctx, claims.Username, model.AuditActionDelete, id, before, nil)
+	})
+}
+
+func encodePageToken(lastID int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(lastID, 10)))
+}
+
+func decodePageToken(pageToken string) (int64, error) {
+	if pageToken == "" {
+		return 0, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return 0, sys.NewCommonError(codes.InvalidArgument, "invalid page token")
+	}
+	lastID, err := strconv.ParseInt(string(b), 10, 64)
+	if err != nil || lastID < 0 {
+		return 0, sys.NewCommonError(codes.InvalidArgument, "invalid page token")
+	}
+	return lastID, nil
+}
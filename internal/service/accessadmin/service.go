@@ -0,0 +1,36 @@
+package accessadmin
+
+import (
+	"github.com/arifullov/auth/internal/client/db"
+	"github.com/arifullov/auth/internal/config"
+	"github.com/arifullov/auth/internal/repository"
+	"github.com/arifullov/auth/internal/revocation"
+	"github.com/arifullov/auth/internal/service"
+)
+
+type serv struct {
+	accessRepository repository.AccessRepository
+	roleRepository   repository.RoleRepository
+	auditRepository  repository.AuditRepository
+	txManager        db.TxManager
+	tokenConfig      config.TokenConfig
+	revocationList   *revocation.List
+}
+
+func NewAccessAdminService(
+	accessRepository repository.AccessRepository,
+	roleRepository repository.RoleRepository,
+	auditRepository repository.AuditRepository,
+	txManager db.TxManager,
+	tokenConfig config.TokenConfig,
+	revocationList *revocation.List,
+) service.AccessAdminService {
+	return &serv{
+		accessRepository: accessRepository,
+		roleRepository:   roleRepository,
+		auditRepository:  auditRepository,
+		txManager:        txManager,
+		tokenConfig:      tokenConfig,
+		revocationList:   revocationList,
+	}
+}
@@ -0,0 +1,161 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arifullov/auth/internal/client/db"
+	txManagerMocks "github.com/arifullov/auth/internal/client/db/mocks"
+	"github.com/arifullov/auth/internal/model"
+	repositoryMocks "github.com/arifullov/auth/internal/repository/mocks"
+	"github.com/arifullov/auth/internal/revocation"
+	"github.com/arifullov/auth/internal/service/accessadmin"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+	"github.com/arifullov/auth/internal/utils"
+)
+
+const (
+	secretKey = "secret"
+	adminID   = "1"
+)
+
+type tokenConfig struct{}
+
+func (tokenConfig) RefreshTokenSecretKey() string         { return secretKey }
+func (tokenConfig) AccessTokenSecretKey() string          { return secretKey }
+func (tokenConfig) RefreshTokenExpiration() time.Duration { return time.Hour }
+func (tokenConfig) AccessTokenExpiration() time.Duration  { return time.Hour }
+
+func signToken(t *testing.T, roles ...model.Role) string {
+	return signClaims(t, model.UserClaims{Roles: roles})
+}
+
+func signClaims(t *testing.T, claims model.UserClaims) string {
+	now := time.Now()
+	claims.Subject = adminID
+	claims.Username = "admin@example.com"
+	claims.IssuedAt = jwt.NewNumericDate(now.Add(-time.Minute))
+	claims.ExpiresAt = jwt.NewNumericDate(now.Add(time.Hour))
+	token, err := utils.SignClaims(claims, []byte(secretKey))
+	require.NoError(t, err)
+	return token
+}
+
+// revocationList returns a list with the tokens of the admin revoked when revoked is set.
+func revocationList(mc *minimock.Controller, revoked bool) *revocation.List {
+	var revocations []model.TokenRevocation
+	if revoked {
+		revocations = append(revocations, model.TokenRevocation{UserID: 1, RevokedAt: time.Now()})
+	}
+	userRepositoryMock := repositoryMocks.NewUserRepositoryMock(mc)
+	userRepositoryMock.ListTokenRevocationsMock.Return(revocations, nil)
+	return revocation.NewList(userRepositoryMock, time.Hour)
+}
+
+func TestReplaceRouteRules(t *testing.T) {
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		kept    = model.RouteRule{ID: 1, Route: "/user_v1.UserV1/*", Method: model.AnyMethod, Role: model.UserRole, Effect: model.AllowEffect}
+		flipped = model.RouteRule{ID: 2, Route: "/user_v1.UserV1/Delete", Method: model.AnyMethod, Role: model.UserRole, Effect: model.AllowEffect}
+		removed = model.RouteRule{ID: 3, Route: "/orders/**", Method: "GET", Role: model.UserRole, Effect: model.AllowEffect}
+	)
+
+	accessRepositoryMock := repositoryMocks.NewAccessRepositoryMock(mc)
+	accessRepositoryMock.ListRouteRulesMock.Return([]model.RouteRule{kept, flipped, removed}, nil)
+	accessRepositoryMock.UpdateRouteRuleMock.Expect(ctx, &model.RouteRule{
+		ID: 2, Route: "/user_v1.UserV1/Delete", Method: model.AnyMethod, Role: model.UserRole, Effect: model.DenyEffect,
+	}).Return(nil)
+	accessRepositoryMock.DeleteRouteRuleMock.Expect(ctx, removed.ID).Return(nil)
+	accessRepositoryMock.CreateRouteRuleMock.Expect(ctx, &model.RouteRule{
+		Route: "/access_admin_v1.AccessAdminV1/**", Method: model.AnyMethod, Role: model.AdminRole, Effect: model.AllowEffect,
+	}).Return(4, nil)
+
+	roleRepositoryMock := repositoryMocks.NewRoleRepositoryMock(mc)
+	roleRepositoryMock.GetExistingMock.Return([]model.Role{model.UserRole, model.AdminRole}, nil)
+
+	auditRepositoryMock := repositoryMocks.NewAuditRepositoryMock(mc)
+	auditRepositoryMock.CreateMock.Return(1, nil)
+
+	txManagerMock := txManagerMocks.NewTxManagerMock(mc)
+	txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) error {
+		return f(ctx)
+	})
+
+	service := accessadmin.NewAccessAdminService(accessRepositoryMock, roleRepositoryMock, auditRepositoryMock, txManagerMock, tokenConfig{}, revocationList(mc, false))
+
+	result, err := service.ReplaceRouteRules(ctx, signToken(t, model.AdminRole), []model.RouteRule{
+		{Route: "/user_v1.UserV1/*", Role: model.UserRole},
+		{Route: "/user_v1.UserV1/Delete", Method: "", Role: model.UserRole, Effect: model.DenyEffect},
+		{Route: "/access_admin_v1.AccessAdminV1/**", Role: model.AdminRole},
+	})
+	require.NoError(t, err)
+	require.Equal(t, &model.ReplaceResult{Created: 1, Updated: 1, Deleted: 1}, result)
+	require.Equal(t, uint64(3), auditRepositoryMock.CreateAfterCounter())
+}
+
+func TestReplaceRouteRulesRequiresAdmin(t *testing.T) {
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+	)
+
+	tests := []struct {
+		name    string
+		token   string
+		revoked bool
+		err     error
+	}{
+		{
+			name:  "user role",
+			token: signToken(t, model.UserRole),
+			err:   sys.NewCommonError(codes.PermissionDenied, "admin role required"),
+		},
+		{
+			name:    "revoked admin token",
+			token:   signToken(t, model.AdminRole),
+			revoked: true,
+			err:     sys.NewCommonError(codes.Unauthenticated, "token revoked"),
+		},
+		{
+			name: "admin token exchanged for another service",
+			token: signClaims(t, model.UserClaims{
+				RegisteredClaims: jwt.RegisteredClaims{Audience: []string{"orders"}},
+				Roles:            []model.Role{model.AdminRole},
+			}),
+			err: sys.NewCommonError(codes.PermissionDenied, "invalid token audience"),
+		},
+		{
+			name: "admin of an organization",
+			token: signClaims(t, model.UserClaims{
+				Roles:  []model.Role{model.AdminRole},
+				Tenant: "acme",
+			}),
+			err: sys.NewCommonError(codes.PermissionDenied, "admin role required"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			service := accessadmin.NewAccessAdminService(
+				repositoryMocks.NewAccessRepositoryMock(mc),
+				repositoryMocks.NewRoleRepositoryMock(mc),
+				repositoryMocks.NewAuditRepositoryMock(mc),
+				txManagerMocks.NewTxManagerMock(mc),
+				tokenConfig{},
+				revocationList(mc, tt.revoked),
+			)
+
+			_, err := service.ReplaceRouteRules(ctx, tt.token, nil)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
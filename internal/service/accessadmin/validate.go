@@ -0,0 +1,67 @@
+package accessadmin
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/policy"
+	"github.com/arifullov/auth/internal/sys/validate"
+)
+
+// normalizeRule fills the defaults of a rule coming from the API.
+func normalizeRule(rule *model.RouteRule) {
+	rule.Method = strings.ToUpper(strings.TrimSpace(rule.Method))
+	if rule.Method == "" {
+		rule.Method = model.AnyMethod
+	}
+	if rule.Effect == "" {
+		rule.Effect = model.AllowEffect
+	}
+	slices.Sort(rule.Scopes)
+	rule.Scopes = slices.Compact(rule.Scopes)
+}
+
+func (s *serv) validateRules(ctx context.Context, rules []model.RouteRule) error {
+	roles := make([]model.Role, 0, len(rules))
+	for _, rule := range rules {
+		// Rules without a role are for any role.
+		if rule.Role != "" && !slices.Contains(roles, rule.Role) {
+			roles = append(roles, rule.Role)
+		}
+	}
+
+	return validate.Validate(
+		ctx,
+		rulesAreValid(rules),
+		s.rolesExist(roles),
+	)
+}
+
+func rulesAreValid(rules []model.RouteRule) validate.Condition {
+	return func(ctx context.Context) error {
+		for _, rule := range rules {
+			if err := policy.ValidateRule(rule); err != nil {
+				return validate.NewValidationErrors(err.Error())
+			}
+		}
+		return nil
+	}
+}
+
+func (s *serv) rolesExist(roles []model.Role) validate.Condition {
+	return func(ctx context.Context) error {
+		existing, err := s.roleRepository.GetExisting(ctx, roles)
+		if err != nil {
+			return err
+		}
+		for _, role := range roles {
+			if !slices.Contains(existing, role) {
+				return validate.NewValidationErrors(fmt.Sprintf("unknown role %s", role))
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,252 @@
+package auth
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+	"github.com/arifullov/auth/internal/sys/validate"
+	"github.com/arifullov/auth/internal/utils"
+)
+
+// ExchangeToken implements RFC 8693 token exchange: the actor trades the subject token
+// for a new access token bound to the requested audience and a subset of the subject scopes
+// and roles. Actors are service principals or registered clients granted tokens:exchange.
+// Audience-bound subject tokens can only be exchanged by a party of their audience,
+// so a token leaked by one service cannot be replayed for another one.
+func (s *serv) ExchangeToken(ctx context.Context, exchange *model.TokenExchange) (*model.ExchangedToken, error) {
+	err := validate.Validate(
+		ctx,
+		grantTypeIsTokenExchange(exchange.GrantType),
+		tokenTypeIsAccessToken("subject_token_type", exchange.SubjectTokenType, false),
+		tokenTypeIsAccessToken("actor_token_type", exchange.ActorTokenType, false),
+		tokenTypeIsAccessToken("requested_token_type", exchange.RequestedTokenType, true),
+		audienceIsNotEmpty(exchange.Audience),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	secretKey := utils.S2B(s.tokenConfig.AccessTokenSecretKey())
+	subject, err := utils.VerifyToken(exchange.SubjectToken, secretKey)
+	if err != nil {
+		return nil, sys.NewCommonError(codes.Unauthenticated, "invalid subject token")
+	}
+	actor, err := utils.VerifyToken(exchange.ActorToken, secretKey)
+	if err != nil {
+		return nil, sys.NewCommonError(codes.Unauthenticated, "invalid actor token")
+	}
+	if err = s.checkActor(ctx, subject, actor); err != nil {
+		return nil, err
+	}
+
+	roles, permissions, tenant := subject.Roles, subject.Permissions, subject.Tenant
+	if exchange.Tenant != "" && exchange.Tenant != subject.Tenant {
+		// The access in the tenant replaces the one of the subject token, a token narrowed by
+		// an exchange, a scope or a client would be widened by it.
+		if subject.Act != nil || subject.ClientID != "" || subject.Scope != "" {
+			return nil, sys.NewCommonError(codes.PermissionDenied, "restricted token cannot switch tenant")
+		}
+		access, err := s.tenantAccess(ctx, subject, exchange.Tenant)
+		if err != nil {
+			return nil, err
+		}
+		roles, permissions, tenant = access.Roles, access.Permissions, access.Tenant
+	}
+	roles, err = narrowRoles(roles, exchange.Roles)
+	if err != nil {
+		return nil, err
+	}
+	scopes, err := narrowScopes(subject.Scopes(), permissions, exchange.Scopes)
+	if err != nil {
+		return nil, err
+	}
+	permissions = narrowPermissions(permissions, scopes)
+
+	now := time.Now()
+	expiresAt := now.Add(s.tokenConfig.AccessTokenExpiration())
+	if subject.ExpiresAt != nil && subject.ExpiresAt.Before(expiresAt) {
+		expiresAt = subject.ExpiresAt.Time
+	}
+
+	// The exchanged token keeps the issue time of the subject token,
+	// so revoking the tokens of the subject revokes it as well.
+	accessToken, err := utils.SignClaims(model.UserClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   subject.Subject,
+			Audience:  exchange.Audience,
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  subject.IssuedAt,
+		},
+		Username:    subject.Username,
+		Roles:       roles,
+		Permissions: permissions,
+		Scope:       strings.Join(scopes, " "),
+		ClientID:    subject.ClientID,
+		Tenant:      tenant,
+		Act: &model.Actor{
+			Username: actor.Username,
+			Act:      subject.Act,
+		},
+	}, secretKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.ExchangedToken{
+		AccessToken:     accessToken,
+		IssuedTokenType: model.AccessTokenType,
+		ExpiresIn:       expiresAt.Sub(now),
+		Scopes:          scopes,
+	}, nil
+}
+
+// tenantAccess computes the access of the subject in the tenant it switches to,
+// the subject must be a member of the tenant.
+func (s *serv) tenantAccess(ctx context.Context, subject *model.UserClaims, tenant string) (*model.UserAccess, error) {
+	userID, err := strconv.ParseInt(subject.Subject, 10, 64)
+	if err != nil {
+		return nil, sys.NewCommonError(codes.InvalidArgument, "subject token does not identify a user")
+	}
+	if err = s.checkMember(ctx, tenant, userID); err != nil {
+		return nil, err
+	}
+	return s.roleRepository.GetUserAccess(ctx, userID, tenant)
+}
+
+// checkActor requires both tokens to be valid still and the actor to be allowed to exchange
+// the subject token. The actor is a service principal, a user whose roles grant tokens:exchange,
+// or a registered client holding the tokens:exchange scope.
+func (s *serv) checkActor(ctx context.Context, subject *model.UserClaims, actor *model.UserClaims) error {
+	if err := s.checkNotRevoked(ctx, subject, "subject token revoked"); err != nil {
+		return err
+	}
+	if err := s.checkNotRevoked(ctx, actor, "actor token revoked"); err != nil {
+		return err
+	}
+	if _, err := utils.ResolveClient(s.clientsConfig, actor.ClientID); err != nil {
+		return err
+	}
+	if !actor.HasScope(model.TokenExchangePermission) {
+		return sys.NewCommonError(codes.PermissionDenied, "actor may not exchange tokens")
+	}
+	if !actorMayExchange(subject, actor) {
+		return sys.NewCommonError(codes.PermissionDenied, "actor is not in the audience of the subject token")
+	}
+	return nil
+}
+
+func (s *serv) checkNotRevoked(ctx context.Context, claims *model.UserClaims, msg string) error {
+	revoked, err := s.revocationList.Revoked(ctx, claims)
+	if err != nil {
+		return err
+	}
+	if revoked {
+		return sys.NewCommonError(codes.Unauthenticated, msg)
+	}
+	return nil
+}
+
+// actorMayExchange reports whether the actor may exchange the subject token: tokens without an
+// audience are exchanged by any actor, audience-bound ones only by a party of their audience
+// or by the client they were issued to.
+func actorMayExchange(subject *model.UserClaims, actor *model.UserClaims) bool {
+	if len(subject.Audience) == 0 {
+		return true
+	}
+	for _, identity := range []string{actor.Username, actor.Subject, actor.ClientID} {
+		if identity == "" {
+			continue
+		}
+		if slices.Contains(subject.Audience, identity) || identity == subject.ClientID {
+			return true
+		}
+	}
+	return false
+}
+
+// narrowRoles keeps the subject roles when nothing is requested and otherwise
+// requires every requested role to be granted to the subject.
+func narrowRoles(subjectRoles []model.Role, requestedRoles []model.Role) ([]model.Role, error) {
+	if len(requestedRoles) == 0 {
+		return subjectRoles, nil
+	}
+	for _, role := range requestedRoles {
+		if !slices.Contains(subjectRoles, role) {
+			return nil, validate.NewValidationErrors("invalid_role: " + string(role))
+		}
+	}
+	return requestedRoles, nil
+}
+
+// narrowPermissions drops the permissions outside of the scopes of a scope restricted token.
+// Scopes are named after the permissions they grant, see model.GrantScopes, so a scope
+// restricted token keeps only the permissions its scopes name.
+func narrowPermissions(permissions []string, scopes []string) []string {
+	if scopes == nil {
+		return permissions
+	}
+	narrowed := make([]string, 0, len(permissions))
+	for _, permission := range permissions {
+		if slices.Contains(scopes, permission) {
+			narrowed = append(narrowed, permission)
+		}
+	}
+	return narrowed
+}
+
+// narrowScopes keeps the subject scopes when nothing is requested and otherwise
+// requires every requested scope to be granted to the subject token. A token without
+// scope restriction grants the permissions of the subject, nothing beyond them.
+func narrowScopes(subjectScopes []string, permissions []string, requestedScopes []string) ([]string, error) {
+	if len(requestedScopes) == 0 {
+		return subjectScopes, nil
+	}
+	granted := subjectScopes
+	if granted == nil {
+		granted = permissions
+	}
+	for _, scope := range requestedScopes {
+		if !slices.Contains(granted, scope) {
+			return nil, validate.NewValidationErrors("invalid_scope: " + scope)
+		}
+	}
+	return requestedScopes, nil
+}
+
+func grantTypeIsTokenExchange(grantType string) validate.Condition {
+	return func(ctx context.Context) error {
+		if grantType != model.TokenExchangeGrantType {
+			return validate.NewValidationErrors("unsupported_grant_type")
+		}
+		return nil
+	}
+}
+
+func tokenTypeIsAccessToken(field string, tokenType string, optional bool) validate.Condition {
+	return func(ctx context.Context) error {
+		if optional && tokenType == "" {
+			return nil
+		}
+		if tokenType != model.AccessTokenType {
+			return validate.NewValidationErrors("unsupported " + field)
+		}
+		return nil
+	}
+}
+
+func audienceIsNotEmpty(audience []string) validate.Condition {
+	return func(ctx context.Context) error {
+		if len(audience) == 0 {
+			return validate.NewValidationErrors("audience is required")
+		}
+		return nil
+	}
+}
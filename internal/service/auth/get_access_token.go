@@ -3,6 +3,7 @@ package auth
 import (
 	"context"
 
+	"github.com/arifullov/auth/internal/model"
 	"github.com/arifullov/auth/internal/sys"
 	"github.com/arifullov/auth/internal/sys/codes"
 	"github.com/arifullov/auth/internal/utils"
@@ -14,12 +15,30 @@ func (s *serv) GetAccessToken(ctx context.Context, refreshToken string) (string,
 		return "", sys.NewCommonError(codes.Unauthenticated, err.Error())
 	}
 
-	user, err := s.userRepository.GetByEmail(ctx, claims.Username)
+	// Removing the user from the organization stops the renewal of its tokens.
+	user, err := s.userFromClaims(ctx, claims)
 	if err != nil {
 		return "", err
 	}
+	if claims.IssuedBefore(user.TokensRevokedAt) {
+		return "", sys.NewCommonError(codes.Unauthenticated, "token revoked")
+	}
+
+	access, err := s.roleRepository.GetUserAccess(ctx, user.ID, claims.Tenant)
+	if err != nil {
+		return "", err
+	}
+
+	// Scopes are narrowed to what the client and the user may still have.
+	grant := model.TokenGrant{ClientID: claims.ClientID}
+	if scopes := claims.Scopes(); len(scopes) > 0 {
+		grant, err = utils.GrantScopes(s.clientsConfig, model.ScopeRequest{ClientID: claims.ClientID, Scopes: scopes}, access.Permissions)
+		if err != nil {
+			return "", err
+		}
+	}
 
-	accessToken, err := generateAccessToken(user, utils.S2B(s.tokenConfig.AccessTokenSecretKey()), s.tokenConfig.AccessTokenExpiration())
+	accessToken, err := generateAccessToken(user, access, grant, utils.S2B(s.tokenConfig.AccessTokenSecretKey()), s.tokenConfig.AccessTokenExpiration())
 	if err != nil {
 		return "", err
 	}
@@ -3,6 +3,7 @@ package auth
 import (
 	"context"
 
+	"github.com/arifullov/auth/internal/model"
 	"github.com/arifullov/auth/internal/sys"
 	"github.com/arifullov/auth/internal/sys/codes"
 	"github.com/arifullov/auth/internal/utils"
@@ -14,12 +15,17 @@ func (s *serv) GetRefreshToken(ctx context.Context, oldRefreshToken string) (str
 		return "", sys.NewCommonError(codes.Unauthenticated, err.Error())
 	}
 
-	user, err := s.userRepository.GetByEmail(ctx, claims.Username)
+	// Removing the user from the organization stops the renewal of its tokens.
+	user, err := s.userFromClaims(ctx, claims)
 	if err != nil {
 		return "", err
 	}
+	if claims.IssuedBefore(user.TokensRevokedAt) {
+		return "", sys.NewCommonError(codes.Unauthenticated, "token revoked")
+	}
 
-	refreshToken, err := generateRefreshToken(user, utils.S2B(s.tokenConfig.RefreshTokenSecretKey()), s.tokenConfig.RefreshTokenExpiration())
+	grant := model.TokenGrant{ClientID: claims.ClientID, Scopes: claims.Scopes()}
+	refreshToken, err := generateRefreshToken(user, claims.Tenant, grant, utils.S2B(s.tokenConfig.RefreshTokenSecretKey()), s.tokenConfig.RefreshTokenExpiration())
 	if err != nil {
 		return "", err
 	}
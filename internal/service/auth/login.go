@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"github.com/arifullov/auth/internal/model"
@@ -10,30 +11,71 @@ import (
 	"github.com/arifullov/auth/internal/utils"
 )
 
-func (s *serv) Login(ctx context.Context, username string, password string) (string, error) {
-	user, err := s.userRepository.GetByEmail(ctx, username)
+// Login issues a refresh token for the tenant the user picked, the token is issued
+// outside of organizations when the tenant is empty. Tokens issued to a client or for
+// requested scopes carry the scopes both the client and the user may have.
+func (s *serv) Login(ctx context.Context, username string, password string, tenant string, scope model.ScopeRequest) (string, error) {
+	user, err := s.authenticator.Authenticate(ctx, username, password)
 	if err != nil {
 		return "", err
 	}
-	isPasswordEqual, err := utils.CheckPbkdf2SHA256(password, user.PasswordHash)
-	if err != nil {
+	if err = s.checkMember(ctx, tenant, user.ID); err != nil {
 		return "", err
 	}
-	if !isPasswordEqual {
-		return "", sys.NewCommonError(codes.Unauthenticated, "wrong credentials")
+
+	grant, err := s.grantScopes(ctx, user.ID, tenant, scope)
+	if err != nil {
+		return "", err
 	}
 
-	refreshToken, err := generateRefreshToken(user, utils.S2B(s.tokenConfig.RefreshTokenSecretKey()), s.tokenConfig.RefreshTokenExpiration())
+	refreshToken, err := generateRefreshToken(user, tenant, grant, utils.S2B(s.tokenConfig.RefreshTokenSecretKey()), s.tokenConfig.RefreshTokenExpiration())
 	if err != nil {
 		return "", err
 	}
 	return refreshToken, nil
 }
 
-func generateRefreshToken(user *model.User, secretKey []byte, duration time.Duration) (string, error) {
-	return utils.GenerateToken(user, secretKey, duration)
+// checkMember requires the user to be a member of the tenant, if any.
+func (s *serv) checkMember(ctx context.Context, tenant string, userID int64) error {
+	if tenant == "" {
+		return nil
+	}
+	member, err := s.orgRepository.IsMember(ctx, tenant, userID)
+	if err != nil {
+		return err
+	}
+	if !member {
+		return sys.NewCommonError(codes.PermissionDenied, "not a member of the organization")
+	}
+	return nil
+}
+
+// userFromClaims loads the user the token was issued to, users are found only while they
+// are members of the tenant of the token.
+func (s *serv) userFromClaims(ctx context.Context, claims *model.UserClaims) (*model.User, error) {
+	id, err := strconv.ParseInt(claims.Subject, 10, 64)
+	if err != nil {
+		return nil, sys.NewCommonError(codes.Unauthenticated, "token does not identify a user")
+	}
+	return s.userRepository.Get(ctx, id, claims.Tenant)
+}
+
+// grantScopes checks the scopes requested at login against the permissions of the user in the tenant.
+func (s *serv) grantScopes(ctx context.Context, userID int64, tenant string, scope model.ScopeRequest) (model.TokenGrant, error) {
+	if scope.ClientID == "" && len(scope.Scopes) == 0 {
+		return model.TokenGrant{}, nil
+	}
+	access, err := s.roleRepository.GetUserAccess(ctx, userID, tenant)
+	if err != nil {
+		return model.TokenGrant{}, err
+	}
+	return utils.GrantScopes(s.clientsConfig, scope, access.Permissions)
+}
+
+func generateRefreshToken(user *model.User, tenant string, grant model.TokenGrant, secretKey []byte, duration time.Duration) (string, error) {
+	return utils.GenerateToken(user, tenant, grant, secretKey, duration)
 }
 
-func generateAccessToken(user *model.User, secretKey []byte, duration time.Duration) (string, error) {
-	return utils.GenerateToken(user, secretKey, duration)
+func generateAccessToken(user *model.User, access *model.UserAccess, grant model.TokenGrant, secretKey []byte, duration time.Duration) (string, error) {
+	return utils.GenerateAccessToken(user, access, grant, secretKey, duration)
 }
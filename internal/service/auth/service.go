@@ -1,26 +1,43 @@
 package auth
 
 import (
+	"github.com/arifullov/auth/internal/authenticator"
 	"github.com/arifullov/auth/internal/client/db"
 	"github.com/arifullov/auth/internal/config"
 	"github.com/arifullov/auth/internal/repository"
+	"github.com/arifullov/auth/internal/revocation"
 	"github.com/arifullov/auth/internal/service"
 )
 
 type serv struct {
 	userRepository repository.UserRepository
+	roleRepository repository.RoleRepository
+	orgRepository  repository.OrganizationRepository
 	txManager      db.TxManager
 	tokenConfig    config.TokenConfig
+	clientsConfig  config.OAuthClientsConfig
+	authenticator  authenticator.Authenticator
+	revocationList *revocation.List
 }
 
 func NewAuthService(
 	userRepository repository.UserRepository,
+	roleRepository repository.RoleRepository,
+	orgRepository repository.OrganizationRepository,
 	txManager db.TxManager,
 	tokenConfig config.TokenConfig,
+	clientsConfig config.OAuthClientsConfig,
+	authenticator authenticator.Authenticator,
+	revocationList *revocation.List,
 ) service.AuthService {
 	return &serv{
 		userRepository: userRepository,
+		roleRepository: roleRepository,
+		orgRepository:  orgRepository,
 		txManager:      txManager,
 		tokenConfig:    tokenConfig,
+		clientsConfig:  clientsConfig,
+		authenticator:  authenticator,
+		revocationList: revocationList,
 	}
 }
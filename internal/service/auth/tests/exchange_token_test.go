@@ -0,0 +1,423 @@
+package tests
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	txManagerMocks "github.com/arifullov/auth/internal/client/db/mocks"
+	"github.com/arifullov/auth/internal/config"
+	"github.com/arifullov/auth/internal/model"
+	repositoryMocks "github.com/arifullov/auth/internal/repository/mocks"
+	"github.com/arifullov/auth/internal/revocation"
+	"github.com/arifullov/auth/internal/service/auth"
+	"github.com/arifullov/auth/internal/utils"
+)
+
+const (
+	secretKey = "secret"
+	clientID  = "orders-app"
+)
+
+type tokenConfig struct{}
+
+func (tokenConfig) RefreshTokenSecretKey() string         { return secretKey }
+func (tokenConfig) AccessTokenSecretKey() string          { return secretKey }
+func (tokenConfig) RefreshTokenExpiration() time.Duration { return time.Hour }
+func (tokenConfig) AccessTokenExpiration() time.Duration  { return time.Hour }
+
+type clientsConfig struct{}
+
+func (clientsConfig) Clients() []config.OAuthClientConfig {
+	return []config.OAuthClientConfig{{ClientID: clientID, Scopes: []string{model.TokenExchangePermission}}}
+}
+
+func signToken(t *testing.T, claims model.UserClaims) string {
+	claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(time.Hour))
+	token, err := utils.SignClaims(claims, []byte(secretKey))
+	require.NoError(t, err)
+	return token
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestExchangeToken(t *testing.T) {
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		userEmail    = gofakeit.Email()
+		serviceEmail = gofakeit.Email()
+
+		userID       = gofakeit.Int64()
+		tenant       = gofakeit.Word()
+		subjectToken = signToken(t, model.UserClaims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: strconv.FormatInt(userID, 10)},
+			Username:         userEmail,
+			Roles:            []model.Role{model.AdminRole, model.UserRole},
+			Permissions:      []string{"users:read", "users:write", "orders:read"},
+			Scope:            "users:read users:write",
+		})
+		// unrestrictedToken has no scope restriction.
+		unrestrictedToken = signToken(t, model.UserClaims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: strconv.FormatInt(userID, 10)},
+			Username:         userEmail,
+			Roles:            []model.Role{model.UserRole},
+			Permissions:      []string{"users:read"},
+		})
+		actorToken = signToken(t, model.UserClaims{
+			Username:    serviceEmail,
+			Roles:       []model.Role{model.UserRole},
+			Permissions: []string{model.TokenExchangePermission},
+		})
+		// revokedActorID is the service principal whose tokens were revoked.
+		revokedActorID    = gofakeit.Int64()
+		revokedActorToken = signToken(t, model.UserClaims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: strconv.FormatInt(revokedActorID, 10)},
+			Username:         serviceEmail,
+			Permissions:      []string{model.TokenExchangePermission},
+		})
+		userActorToken   = signToken(t, model.UserClaims{Username: serviceEmail, Roles: []model.Role{model.UserRole}})
+		clientActorToken = signToken(t, model.UserClaims{
+			Username:    serviceEmail,
+			Permissions: []string{model.TokenExchangePermission},
+			Scope:       model.TokenExchangePermission,
+			ClientID:    clientID,
+		})
+		unknownClientActorToken = signToken(t, model.UserClaims{
+			Username:    serviceEmail,
+			Permissions: []string{model.TokenExchangePermission},
+			Scope:       model.TokenExchangePermission,
+			ClientID:    "unknown-app",
+		})
+
+		// boundToken was exchanged for the orders service before.
+		boundToken = signToken(t, model.UserClaims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: strconv.FormatInt(userID, 10), Audience: []string{"orders"}},
+			Username:         userEmail,
+			Roles:            []model.Role{model.UserRole},
+			Scope:            "users:read",
+		})
+		ordersToken = signToken(t, model.UserClaims{
+			Username:    "orders",
+			Roles:       []model.Role{model.UserRole},
+			Permissions: []string{model.TokenExchangePermission},
+		})
+	)
+
+	userRepository := repositoryMocks.NewUserRepositoryMock(mc)
+	userRepository.ListTokenRevocationsMock.Return([]model.TokenRevocation{
+		{UserID: revokedActorID, RevokedAt: time.Now()},
+	}, nil)
+	revocationList := revocation.NewList(userRepository, time.Hour)
+
+	tests := []struct {
+		name            string
+		exchange        *model.TokenExchange
+		member          *bool
+		wantScopes      []string
+		wantRoles       []model.Role
+		wantPermissions []string
+		wantTenant      string
+		wantActor       string
+		wantErr         bool
+	}{
+		{
+			name: "success exchange",
+			exchange: &model.TokenExchange{
+				GrantType:        model.TokenExchangeGrantType,
+				SubjectToken:     subjectToken,
+				SubjectTokenType: model.AccessTokenType,
+				ActorToken:       actorToken,
+				ActorTokenType:   model.AccessTokenType,
+				Audience:         []string{"orders"},
+				Scopes:           []string{"users:read"},
+			},
+			wantScopes:      []string{"users:read"},
+			wantRoles:       []model.Role{model.AdminRole, model.UserRole},
+			wantPermissions: []string{"users:read"},
+		},
+		{
+			name: "success roles narrowed",
+			exchange: &model.TokenExchange{
+				GrantType:        model.TokenExchangeGrantType,
+				SubjectToken:     subjectToken,
+				SubjectTokenType: model.AccessTokenType,
+				ActorToken:       actorToken,
+				ActorTokenType:   model.AccessTokenType,
+				Audience:         []string{"orders"},
+				Roles:            []model.Role{model.UserRole},
+			},
+			wantScopes:      []string{"users:read", "users:write"},
+			wantRoles:       []model.Role{model.UserRole},
+			wantPermissions: []string{"users:read", "users:write"},
+		},
+		{
+			name: "role is not granted to subject",
+			exchange: &model.TokenExchange{
+				GrantType:        model.TokenExchangeGrantType,
+				SubjectToken:     boundToken,
+				SubjectTokenType: model.AccessTokenType,
+				ActorToken:       ordersToken,
+				ActorTokenType:   model.AccessTokenType,
+				Audience:         []string{"billing"},
+				Roles:            []model.Role{model.AdminRole},
+			},
+			wantErr: true,
+		},
+		{
+			name: "success audience-bound token exchanged by its audience",
+			exchange: &model.TokenExchange{
+				GrantType:        model.TokenExchangeGrantType,
+				SubjectToken:     boundToken,
+				SubjectTokenType: model.AccessTokenType,
+				ActorToken:       ordersToken,
+				ActorTokenType:   model.AccessTokenType,
+				Audience:         []string{"billing"},
+			},
+			wantScopes:      []string{"users:read"},
+			wantRoles:       []model.Role{model.UserRole},
+			wantPermissions: []string{},
+			wantActor:       "orders",
+		},
+		{
+			name: "audience-bound token replayed by another actor",
+			exchange: &model.TokenExchange{
+				GrantType:        model.TokenExchangeGrantType,
+				SubjectToken:     boundToken,
+				SubjectTokenType: model.AccessTokenType,
+				ActorToken:       actorToken,
+				ActorTokenType:   model.AccessTokenType,
+				Audience:         []string{"billing"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "success tenant switch",
+			exchange: &model.TokenExchange{
+				GrantType:        model.TokenExchangeGrantType,
+				SubjectToken:     unrestrictedToken,
+				SubjectTokenType: model.AccessTokenType,
+				ActorToken:       actorToken,
+				ActorTokenType:   model.AccessTokenType,
+				Audience:         []string{"orders"},
+				Tenant:           tenant,
+			},
+			member:          ptr(true),
+			wantRoles:       []model.Role{model.AdminRole, model.UserRole},
+			wantPermissions: []string{"users:read", "orders:read"},
+			wantTenant:      tenant,
+		},
+		{
+			name: "narrowed token switches tenant",
+			exchange: &model.TokenExchange{
+				GrantType:        model.TokenExchangeGrantType,
+				SubjectToken:     boundToken,
+				SubjectTokenType: model.AccessTokenType,
+				ActorToken:       ordersToken,
+				ActorTokenType:   model.AccessTokenType,
+				Audience:         []string{"billing"},
+				Tenant:           tenant,
+			},
+			wantErr: true,
+		},
+		{
+			name: "scoped token switches tenant",
+			exchange: &model.TokenExchange{
+				GrantType:        model.TokenExchangeGrantType,
+				SubjectToken:     subjectToken,
+				SubjectTokenType: model.AccessTokenType,
+				ActorToken:       actorToken,
+				ActorTokenType:   model.AccessTokenType,
+				Audience:         []string{"orders"},
+				Tenant:           tenant,
+			},
+			wantErr: true,
+		},
+		{
+			name: "subject is not a member of tenant",
+			exchange: &model.TokenExchange{
+				GrantType:        model.TokenExchangeGrantType,
+				SubjectToken:     unrestrictedToken,
+				SubjectTokenType: model.AccessTokenType,
+				ActorToken:       actorToken,
+				ActorTokenType:   model.AccessTokenType,
+				Audience:         []string{"orders"},
+				Tenant:           tenant,
+			},
+			member:  ptr(false),
+			wantErr: true,
+		},
+		{
+			name: "scope is not granted to subject",
+			exchange: &model.TokenExchange{
+				GrantType:        model.TokenExchangeGrantType,
+				SubjectToken:     subjectToken,
+				SubjectTokenType: model.AccessTokenType,
+				ActorToken:       actorToken,
+				ActorTokenType:   model.AccessTokenType,
+				Audience:         []string{"orders"},
+				Scopes:           []string{"users:delete"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "success unrestricted token narrowed to a permission",
+			exchange: &model.TokenExchange{
+				GrantType:        model.TokenExchangeGrantType,
+				SubjectToken:     unrestrictedToken,
+				SubjectTokenType: model.AccessTokenType,
+				ActorToken:       actorToken,
+				ActorTokenType:   model.AccessTokenType,
+				Audience:         []string{"orders"},
+				Scopes:           []string{"users:read"},
+			},
+			wantScopes:      []string{"users:read"},
+			wantRoles:       []model.Role{model.UserRole},
+			wantPermissions: []string{"users:read"},
+		},
+		{
+			name: "scope is not a permission of unrestricted token",
+			exchange: &model.TokenExchange{
+				GrantType:        model.TokenExchangeGrantType,
+				SubjectToken:     unrestrictedToken,
+				SubjectTokenType: model.AccessTokenType,
+				ActorToken:       actorToken,
+				ActorTokenType:   model.AccessTokenType,
+				Audience:         []string{"orders"},
+				Scopes:           []string{"users:write"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "success actor is a registered client",
+			exchange: &model.TokenExchange{
+				GrantType:        model.TokenExchangeGrantType,
+				SubjectToken:     unrestrictedToken,
+				SubjectTokenType: model.AccessTokenType,
+				ActorToken:       clientActorToken,
+				ActorTokenType:   model.AccessTokenType,
+				Audience:         []string{"orders"},
+			},
+			wantRoles:       []model.Role{model.UserRole},
+			wantPermissions: []string{"users:read"},
+		},
+		{
+			name: "actor is not a service principal",
+			exchange: &model.TokenExchange{
+				GrantType:        model.TokenExchangeGrantType,
+				SubjectToken:     unrestrictedToken,
+				SubjectTokenType: model.AccessTokenType,
+				ActorToken:       userActorToken,
+				ActorTokenType:   model.AccessTokenType,
+				Audience:         []string{"orders"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "actor is an unregistered client",
+			exchange: &model.TokenExchange{
+				GrantType:        model.TokenExchangeGrantType,
+				SubjectToken:     unrestrictedToken,
+				SubjectTokenType: model.AccessTokenType,
+				ActorToken:       unknownClientActorToken,
+				ActorTokenType:   model.AccessTokenType,
+				Audience:         []string{"orders"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "actor token revoked",
+			exchange: &model.TokenExchange{
+				GrantType:        model.TokenExchangeGrantType,
+				SubjectToken:     unrestrictedToken,
+				SubjectTokenType: model.AccessTokenType,
+				ActorToken:       revokedActorToken,
+				ActorTokenType:   model.AccessTokenType,
+				Audience:         []string{"orders"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing audience",
+			exchange: &model.TokenExchange{
+				GrantType:        model.TokenExchangeGrantType,
+				SubjectToken:     subjectToken,
+				SubjectTokenType: model.AccessTokenType,
+				ActorToken:       actorToken,
+				ActorTokenType:   model.AccessTokenType,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid actor token",
+			exchange: &model.TokenExchange{
+				GrantType:        model.TokenExchangeGrantType,
+				SubjectToken:     subjectToken,
+				SubjectTokenType: model.AccessTokenType,
+				ActorToken:       "invalid",
+				ActorTokenType:   model.AccessTokenType,
+				Audience:         []string{"orders"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			roleRepository := repositoryMocks.NewRoleRepositoryMock(mc)
+			orgRepository := repositoryMocks.NewOrganizationRepositoryMock(mc)
+			if tt.member != nil {
+				orgRepository.IsMemberMock.Expect(ctx, tenant, userID).Return(*tt.member, nil)
+			}
+			if tt.member != nil && *tt.member {
+				roleRepository.GetUserAccessMock.Expect(ctx, userID, tenant).Return(&model.UserAccess{
+					Tenant:      tenant,
+					Roles:       []model.Role{model.AdminRole, model.UserRole},
+					Permissions: []string{"users:read", "orders:read"},
+				}, nil)
+			}
+
+			service := auth.NewAuthService(
+				repositoryMocks.NewUserRepositoryMock(mc),
+				roleRepository,
+				orgRepository,
+				txManagerMocks.NewTxManagerMock(mc),
+				tokenConfig{},
+				clientsConfig{},
+				nil,
+				revocationList,
+			)
+
+			token, err := service.ExchangeToken(ctx, tt.exchange)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantScopes, token.Scopes)
+
+			claims, err := utils.VerifyToken(token.AccessToken, []byte(secretKey))
+			require.NoError(t, err)
+			require.Equal(t, userEmail, claims.Username)
+			require.Equal(t, jwt.ClaimStrings(tt.exchange.Audience), claims.Audience)
+			wantActor := tt.wantActor
+			if wantActor == "" {
+				wantActor = serviceEmail
+			}
+			require.Equal(t, wantActor, claims.Act.Username)
+			require.Equal(t, tt.wantRoles, claims.Roles)
+			require.ElementsMatch(t, tt.wantPermissions, claims.Permissions)
+			require.Equal(t, tt.wantTenant, claims.Tenant)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package device
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+	"github.com/arifullov/auth/internal/utils"
+)
+
+func (s *serv) Approve(ctx context.Context, accessToken string, userCode string, approved bool) error {
+	claims, err := utils.VerifyToken(accessToken, utils.S2B(s.tokenConfig.AccessTokenSecretKey()))
+	if err != nil {
+		return sys.NewCommonError(codes.Unauthenticated, err.Error())
+	}
+	// The approved device gets the full access of the user, a restricted token may not grant it.
+	if !claims.Unrestricted() {
+		return sys.NewCommonError(codes.PermissionDenied, "device codes are approved with first-party tokens only")
+	}
+
+	userID, err := strconv.ParseInt(claims.Subject, 10, 64)
+	if err != nil {
+		return sys.NewCommonError(codes.Unauthenticated, "token does not identify a user")
+	}
+	user, err := s.userRepository.Get(ctx, userID, claims.Tenant)
+	if err != nil {
+		return err
+	}
+
+	status := model.DeviceCodeDenied
+	if approved {
+		status = model.DeviceCodeApproved
+	}
+	return s.deviceCodeRepository.Resolve(ctx, strings.ToUpper(strings.TrimSpace(userCode)), status, user.ID)
+}
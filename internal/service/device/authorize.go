@@ -0,0 +1,106 @@
+package device
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/utils"
+)
+
+const (
+	deviceCodeSize = 32
+	// userCodeChars excludes vowels and ambiguous characters as recommended by RFC 8628.
+	userCodeChars  = "BCDFGHJKLMNPQRSTVWXZ"
+	userCodeLength = 8
+)
+
+func (s *serv) Authorize(ctx context.Context, scope model.ScopeRequest) (*model.DeviceAuthorization, error) {
+	// Unknown clients are rejected before the user is asked to approve the device.
+	if _, err := utils.ResolveClient(s.clientsConfig, scope.ClientID); err != nil {
+		return nil, err
+	}
+
+	deviceCode, err := newDeviceCode()
+	if err != nil {
+		return nil, err
+	}
+	userCode, err := newUserCode()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	// Expired codes are not polled anymore, they are purged here so that their user codes
+	// do not stay reserved.
+	if err = s.deviceCodeRepository.DeleteExpired(ctx, now); err != nil {
+		return nil, err
+	}
+	_, err = s.deviceCodeRepository.Create(ctx, &model.DeviceCode{
+		DeviceCodeHash: hashDeviceCode(deviceCode),
+		UserCode:       userCode,
+		ClientID:       scope.ClientID,
+		Scope:          strings.Join(scope.Scopes, " "),
+		PollInterval:   s.deviceConfig.PollInterval(),
+		ExpiresAt:      now.Add(s.deviceConfig.CodeExpiration()),
+		CreatedAt:      now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	verificationURIComplete, err := url.Parse(s.deviceConfig.VerificationURI())
+	if err != nil {
+		return nil, err
+	}
+	query := verificationURIComplete.Query()
+	query.Set("user_code", userCode)
+	verificationURIComplete.RawQuery = query.Encode()
+
+	return &model.DeviceAuthorization{
+		DeviceCode:              deviceCode,
+		UserCode:                userCode,
+		VerificationURI:         s.deviceConfig.VerificationURI(),
+		VerificationURIComplete: verificationURIComplete.String(),
+		ExpiresIn:               s.deviceConfig.CodeExpiration(),
+		Interval:                s.deviceConfig.PollInterval(),
+	}, nil
+}
+
+func newDeviceCode() (string, error) {
+	b := make([]byte, deviceCodeSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// newUserCode returns a code in the XXXX-XXXX form that is easy to type on another device.
+func newUserCode() (string, error) {
+	code := make([]byte, 0, userCodeLength+1)
+	max := big.NewInt(int64(len(userCodeChars)))
+	for i := 0; i < userCodeLength; i++ {
+		if i == userCodeLength/2 {
+			code = append(code, '-')
+		}
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		code = append(code, userCodeChars[n.Int64()])
+	}
+	return string(code), nil
+}
+
+// hashDeviceCode is used so that device codes are never stored in plain text.
+func hashDeviceCode(deviceCode string) string {
+	sum := sha256.Sum256([]byte(deviceCode))
+	return hex.EncodeToString(sum[:])
+}
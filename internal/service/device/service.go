@@ -0,0 +1,38 @@
+package device
+
+import (
+	"github.com/arifullov/auth/internal/client/db"
+	"github.com/arifullov/auth/internal/config"
+	"github.com/arifullov/auth/internal/repository"
+	"github.com/arifullov/auth/internal/service"
+)
+
+type serv struct {
+	deviceCodeRepository repository.DeviceCodeRepository
+	userRepository       repository.UserRepository
+	roleRepository       repository.RoleRepository
+	txManager            db.TxManager
+	tokenConfig          config.TokenConfig
+	deviceConfig         config.DeviceConfig
+	clientsConfig        config.OAuthClientsConfig
+}
+
+func NewDeviceService(
+	deviceCodeRepository repository.DeviceCodeRepository,
+	userRepository repository.UserRepository,
+	roleRepository repository.RoleRepository,
+	txManager db.TxManager,
+	tokenConfig config.TokenConfig,
+	deviceConfig config.DeviceConfig,
+	clientsConfig config.OAuthClientsConfig,
+) service.DeviceService {
+	return &serv{
+		deviceCodeRepository: deviceCodeRepository,
+		userRepository:       userRepository,
+		roleRepository:       roleRepository,
+		txManager:            txManager,
+		tokenConfig:          tokenConfig,
+		deviceConfig:         deviceConfig,
+		clientsConfig:        clientsConfig,
+	}
+}
@@ -0,0 +1,99 @@
+package tests
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	txManagerMocks "github.com/arifullov/auth/internal/client/db/mocks"
+	"github.com/arifullov/auth/internal/model"
+	repositoryMocks "github.com/arifullov/auth/internal/repository/mocks"
+	"github.com/arifullov/auth/internal/service/device"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+	"github.com/arifullov/auth/internal/utils"
+)
+
+const (
+	secretKey = "secret"
+	userCode  = "ABCD-EFGH"
+)
+
+type tokenConfig struct{}
+
+func (tokenConfig) RefreshTokenSecretKey() string         { return secretKey }
+func (tokenConfig) AccessTokenSecretKey() string          { return secretKey }
+func (tokenConfig) RefreshTokenExpiration() time.Duration { return time.Hour }
+func (tokenConfig) AccessTokenExpiration() time.Duration  { return time.Hour }
+
+func TestApprove(t *testing.T) {
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		userID int64 = 7
+		denied       = sys.NewCommonError(codes.PermissionDenied, "device codes are approved with first-party tokens only")
+	)
+
+	tests := []struct {
+		name   string
+		claims model.UserClaims
+		err    error
+	}{
+		{
+			name:   "first-party token",
+			claims: model.UserClaims{Permissions: []string{"users:read"}},
+		},
+		{
+			name:   "scope restricted token",
+			claims: model.UserClaims{Permissions: []string{"users:read"}, Scope: "users:read"},
+			err:    denied,
+		},
+		{
+			name:   "token of a client",
+			claims: model.UserClaims{ClientID: clientID},
+			err:    denied,
+		},
+		{
+			name:   "exchanged token",
+			claims: model.UserClaims{Act: &model.Actor{Username: "orders"}},
+			err:    denied,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			claims := tt.claims
+			claims.Subject = strconv.FormatInt(userID, 10)
+			claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(time.Hour))
+			token, err := utils.SignClaims(claims, []byte(secretKey))
+			require.NoError(t, err)
+
+			repo := repositoryMocks.NewDeviceCodeRepositoryMock(mc)
+			userRepository := repositoryMocks.NewUserRepositoryMock(mc)
+			if tt.err == nil {
+				userRepository.GetMock.Expect(ctx, userID, "").Return(&model.User{ID: userID}, nil)
+				repo.ResolveMock.Expect(ctx, userCode, model.DeviceCodeApproved, userID).Return(nil)
+			}
+
+			service := device.NewDeviceService(
+				repo,
+				userRepository,
+				repositoryMocks.NewRoleRepositoryMock(mc),
+				txManagerMocks.NewTxManagerMock(mc),
+				tokenConfig{},
+				nil,
+				nil,
+			)
+
+			err = service.Approve(ctx, token, userCode, true)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
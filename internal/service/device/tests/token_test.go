@@ -0,0 +1,125 @@
+package tests
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	txManagerMocks "github.com/arifullov/auth/internal/client/db/mocks"
+	"github.com/arifullov/auth/internal/model"
+	repositoryMocks "github.com/arifullov/auth/internal/repository/mocks"
+	"github.com/arifullov/auth/internal/service/device"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+)
+
+const (
+	deviceCode = "device-code"
+	clientID   = "tv-app"
+)
+
+func TestToken(t *testing.T) {
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		id       int64 = 5
+		hash           = sha256.Sum256([]byte(deviceCode))
+		interval       = 5 * time.Second
+	)
+
+	tests := []struct {
+		name     string
+		clientID string
+		code     model.DeviceCode
+		// deleted is whether the code is consumed.
+		deleted bool
+		// polledInterval is the poll interval stored by the poll, zero when the poll is not stored.
+		polledInterval time.Duration
+		err            error
+	}{
+		{
+			name:           "authorization pending",
+			clientID:       clientID,
+			code:           model.DeviceCode{Status: model.DeviceCodePending},
+			polledInterval: interval,
+			err:            sys.NewCommonError(codes.FailedPrecondition, "authorization_pending"),
+		},
+		{
+			name:     "polled too often",
+			clientID: clientID,
+			code: model.DeviceCode{
+				Status:       model.DeviceCodePending,
+				LastPolledAt: sql.NullTime{Time: time.Now().Add(-time.Second), Valid: true},
+			},
+			polledInterval: interval + 5*time.Second,
+			err:            sys.NewCommonError(codes.ResourceExhausted, "slow_down"),
+		},
+		{
+			name:     "code expired",
+			clientID: clientID,
+			code:     model.DeviceCode{Status: model.DeviceCodePending, ExpiresAt: time.Now().Add(-time.Second)},
+			deleted:  true,
+			err:      sys.NewCommonError(codes.FailedPrecondition, "expired_token"),
+		},
+		{
+			name:     "user denied the device",
+			clientID: clientID,
+			code:     model.DeviceCode{Status: model.DeviceCodeDenied},
+			deleted:  true,
+			err:      sys.NewCommonError(codes.PermissionDenied, "access_denied"),
+		},
+		{
+			name:     "code of another client",
+			clientID: "other-app",
+			code:     model.DeviceCode{Status: model.DeviceCodeApproved},
+			err:      sys.NewCommonError(codes.InvalidArgument, "invalid_grant"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			code := tt.code
+			code.ID = id
+			code.ClientID = clientID
+			code.PollInterval = interval
+			if code.ExpiresAt.IsZero() {
+				code.ExpiresAt = time.Now().Add(time.Minute)
+			}
+
+			repo := repositoryMocks.NewDeviceCodeRepositoryMock(mc)
+			repo.GetByDeviceCodeHashMock.Expect(ctx, hex.EncodeToString(hash[:])).Return(&code, nil)
+			if tt.deleted {
+				repo.DeleteMock.Expect(ctx, id).Return(nil)
+			}
+			if tt.polledInterval != 0 {
+				repo.UpdatePollMock.Set(func(_ context.Context, gotID int64, _ time.Time, pollInterval time.Duration) error {
+					require.Equal(t, id, gotID)
+					require.Equal(t, tt.polledInterval, pollInterval)
+					return nil
+				})
+			}
+
+			service := device.NewDeviceService(
+				repo,
+				repositoryMocks.NewUserRepositoryMock(mc),
+				repositoryMocks.NewRoleRepositoryMock(mc),
+				txManagerMocks.NewTxManagerMock(mc),
+				nil,
+				nil,
+				nil,
+			)
+
+			tokens, err := service.Token(ctx, model.DeviceCodeGrantType, deviceCode, tt.clientID)
+			require.Nil(t, tokens)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package device
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+	"github.com/arifullov/auth/internal/utils"
+)
+
+const (
+	// slowDownIncrease is added to the polling interval each time a client polls too often (RFC 8628, section 3.5).
+	slowDownIncrease = 5 * time.Second
+)
+
+func (s *serv) Token(ctx context.Context, grantType string, deviceCode string, clientID string) (*model.TokenPair, error) {
+	if grantType != model.DeviceCodeGrantType {
+		return nil, sys.NewCommonError(codes.InvalidArgument, "unsupported_grant_type")
+	}
+
+	code, err := s.deviceCodeRepository.GetByDeviceCodeHash(ctx, hashDeviceCode(deviceCode))
+	if err != nil {
+		if sys.IsCommonError(err) && sys.GetCommonError(err).Code() == codes.NotFound {
+			return nil, sys.NewCommonError(codes.InvalidArgument, "invalid_grant")
+		}
+		return nil, err
+	}
+	// A device code can only be redeemed by the client it was issued to.
+	if code.ClientID != clientID {
+		return nil, sys.NewCommonError(codes.InvalidArgument, "invalid_grant")
+	}
+
+	now := time.Now()
+	if now.After(code.ExpiresAt) {
+		if err = s.deviceCodeRepository.Delete(ctx, code.ID); err != nil {
+			return nil, err
+		}
+		return nil, sys.NewCommonError(codes.FailedPrecondition, "expired_token")
+	}
+
+	switch code.Status {
+	case model.DeviceCodeApproved:
+		return s.issueTokens(ctx, code)
+	case model.DeviceCodeDenied:
+		if err = s.deviceCodeRepository.Delete(ctx, code.ID); err != nil {
+			return nil, err
+		}
+		return nil, sys.NewCommonError(codes.PermissionDenied, "access_denied")
+	default:
+	}
+
+	if code.LastPolledAt.Valid && now.Sub(code.LastPolledAt.Time) < code.PollInterval {
+		if err = s.deviceCodeRepository.UpdatePoll(ctx, code.ID, now, code.PollInterval+slowDownIncrease); err != nil {
+			return nil, err
+		}
+		return nil, sys.NewCommonError(codes.ResourceExhausted, "slow_down")
+	}
+
+	if err = s.deviceCodeRepository.UpdatePoll(ctx, code.ID, now, code.PollInterval); err != nil {
+		return nil, err
+	}
+	return nil, sys.NewCommonError(codes.FailedPrecondition, "authorization_pending")
+}
+
+// issueTokens consumes the approved device code and issues the same token pair as Login.
+func (s *serv) issueTokens(ctx context.Context, code *model.DeviceCode) (*model.TokenPair, error) {
+	var tokens *model.TokenPair
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		if err := s.deviceCodeRepository.Delete(ctx, code.ID); err != nil {
+			return err
+		}
+
+		user, err := s.userRepository.Get(ctx, code.UserID.Int64, "")
+		if err != nil {
+			return err
+		}
+
+		access, err := s.roleRepository.GetUserAccess(ctx, user.ID, "")
+		if err != nil {
+			return err
+		}
+		grant := model.TokenGrant{}
+		if code.ClientID != "" || code.Scope != "" {
+			scope := model.ScopeRequest{ClientID: code.ClientID, Scopes: strings.Fields(code.Scope)}
+			grant, err = utils.GrantScopes(s.clientsConfig, scope, access.Permissions)
+			if err != nil {
+				return err
+			}
+		}
+
+		refreshToken, err := utils.GenerateToken(user, "", grant, utils.S2B(s.tokenConfig.RefreshTokenSecretKey()), s.tokenConfig.RefreshTokenExpiration())
+		if err != nil {
+			return err
+		}
+		accessToken, err := utils.GenerateAccessToken(user, access, grant, utils.S2B(s.tokenConfig.AccessTokenSecretKey()), s.tokenConfig.AccessTokenExpiration())
+		if err != nil {
+			return err
+		}
+
+		tokens = &model.TokenPair{
+			RefreshToken: refreshToken,
+			AccessToken:  accessToken,
+			ExpiresIn:    s.tokenConfig.AccessTokenExpiration(),
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
@@ -0,0 +1,149 @@
+package federated
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"github.com/arifullov/auth/internal/idp"
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+	"github.com/arifullov/auth/internal/utils"
+)
+
+const (
+	randomPasswordSize = 32
+)
+
+func (s *serv) Start(ctx context.Context, providerName string) (*model.FederatedLogin, error) {
+	provider, ok := s.registry.Get(providerName)
+	if !ok {
+		return nil, sys.NewCommonError(codes.NotFound, "identity provider not found")
+	}
+
+	state, err := s.newState(providerName)
+	if err != nil {
+		return nil, err
+	}
+	// Abandoned logins are never consumed, they are purged here.
+	if err = s.stateRepository.DeleteExpired(ctx, time.Now()); err != nil {
+		return nil, err
+	}
+	err = s.stateRepository.Create(ctx, &model.FederatedState{ID: state.id, ExpiresAt: state.expiresAt})
+	if err != nil {
+		return nil, err
+	}
+
+	authorizationURL, err := provider.AuthCodeURL(ctx, state.state, state.nonce)
+	if err != nil {
+		return nil, err
+	}
+	return &model.FederatedLogin{AuthorizationURL: authorizationURL, Binding: state.binding}, nil
+}
+
+// Finish completes the login only in the browser that started it and only once, so that
+// a login of an attacker cannot be completed in the browser of a victim.
+func (s *serv) Finish(ctx context.Context, providerName string, code string, state string, binding string) (string, error) {
+	provider, ok := s.registry.Get(providerName)
+	if !ok {
+		return "", sys.NewCommonError(codes.NotFound, "identity provider not found")
+	}
+
+	claims, err := s.verifyState(state, providerName, binding)
+	if err != nil {
+		return "", sys.NewCommonError(codes.Unauthenticated, "invalid state")
+	}
+	if err = s.stateRepository.Consume(ctx, claims.ID, time.Now()); err != nil {
+		if isNotFound(err) {
+			return "", sys.NewCommonError(codes.Unauthenticated, "invalid state")
+		}
+		return "", err
+	}
+
+	identity, err := provider.Exchange(ctx, code, claims.Nonce)
+	if err != nil {
+		return "", err
+	}
+
+	var user *model.User
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		user, errTx = s.resolveUser(ctx, provider, identity)
+		return errTx
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return utils.GenerateToken(user, "", model.TokenGrant{}, utils.S2B(s.tokenConfig.RefreshTokenSecretKey()), s.tokenConfig.RefreshTokenExpiration())
+}
+
+// resolveUser finds the user linked to the external identity, links it to an existing
+// account with the same verified email or provisions a new user.
+func (s *serv) resolveUser(ctx context.Context, provider idp.Provider, identity *model.ExternalIdentity) (*model.User, error) {
+	userID, err := s.identityRepository.GetUserID(ctx, identity.Provider, identity.Subject)
+	if err == nil {
+		return s.userRepository.Get(ctx, userID, "")
+	}
+	if !isNotFound(err) {
+		return nil, err
+	}
+
+	if identity.Email == "" || !identity.EmailVerified {
+		return nil, sys.NewCommonError(codes.FailedPrecondition, "identity provider did not return a verified email")
+	}
+
+	user, err := s.userRepository.GetByEmail(ctx, identity.Email)
+	if isNotFound(err) {
+		user, err = s.provision(ctx, provider, identity)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.identityRepository.Create(ctx, &model.UserIdentity{
+		UserID:    user.ID,
+		Provider:  identity.Provider,
+		Subject:   identity.Subject,
+		Email:     identity.Email,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// provision creates a user for the external identity. The password is random,
+// such users can only sign in through the identity provider until they reset it.
+func (s *serv) provision(ctx context.Context, provider idp.Provider, identity *model.ExternalIdentity) (*model.User, error) {
+	b := make([]byte, randomPasswordSize)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+
+	name := identity.Name
+	if name == "" {
+		name = identity.Email
+	}
+
+	now := time.Now()
+	id, err := s.userRepository.Create(ctx, &model.CreateUser{
+		Name:         name,
+		Email:        identity.Email,
+		PasswordHash: utils.MakePbkdf2SHA256(base64.RawURLEncoding.EncodeToString(b)),
+		Roles:        []model.Role{provider.Role(identity)},
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}, "")
+	if err != nil {
+		return nil, err
+	}
+	return s.userRepository.Get(ctx, id, "")
+}
+
+func isNotFound(err error) bool {
+	return sys.IsCommonError(err) && sys.GetCommonError(err).Code() == codes.NotFound
+}
@@ -0,0 +1,39 @@
+package federated
+
+import (
+	"github.com/arifullov/auth/internal/client/db"
+	"github.com/arifullov/auth/internal/config"
+	"github.com/arifullov/auth/internal/idp"
+	"github.com/arifullov/auth/internal/repository"
+	"github.com/arifullov/auth/internal/service"
+)
+
+type serv struct {
+	registry           *idp.Registry
+	userRepository     repository.UserRepository
+	identityRepository repository.IdentityRepository
+	stateRepository    repository.FederatedStateRepository
+	txManager          db.TxManager
+	tokenConfig        config.TokenConfig
+	idpConfig          config.IdentityProvidersConfig
+}
+
+func NewFederatedService(
+	registry *idp.Registry,
+	userRepository repository.UserRepository,
+	identityRepository repository.IdentityRepository,
+	stateRepository repository.FederatedStateRepository,
+	txManager db.TxManager,
+	tokenConfig config.TokenConfig,
+	idpConfig config.IdentityProvidersConfig,
+) service.FederatedService {
+	return &serv{
+		registry:           registry,
+		userRepository:     userRepository,
+		identityRepository: identityRepository,
+		stateRepository:    stateRepository,
+		txManager:          txManager,
+		tokenConfig:        tokenConfig,
+		idpConfig:          idpConfig,
+	}
+}
@@ -0,0 +1,111 @@
+package federated
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/arifullov/auth/internal/utils"
+)
+
+const (
+	stateExpiration = 10 * time.Minute
+	nonceSize       = 16
+	bindingSize     = 32
+)
+
+// stateClaims binds the login attempt to the provider and to the browser that started it
+// and carries the OIDC nonce. The state is single-use, its ID is consumed by the callback.
+type stateClaims struct {
+	jwt.RegisteredClaims
+	Provider string `json:"provider"`
+	Nonce    string `json:"nonce"`
+	// BindingHash is the hash of the binding kept by the browser, the binding itself never
+	// leaves it through the identity provider.
+	BindingHash string `json:"bh"`
+}
+
+// loginState is a signed state with the values it was issued for.
+type loginState struct {
+	state     string
+	id        string
+	nonce     string
+	binding   string
+	expiresAt time.Time
+}
+
+func (s *serv) newState(provider string) (*loginState, error) {
+	id, err := randomString(nonceSize)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := randomString(nonceSize)
+	if err != nil {
+		return nil, err
+	}
+	binding, err := randomString(bindingSize)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := time.Now().Add(stateExpiration)
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, stateClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        id,
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+		Provider:    provider,
+		Nonce:       nonce,
+		BindingHash: hashBinding(binding),
+	})
+	state, err := token.SignedString(utils.S2B(s.idpConfig.StateSecretKey()))
+	if err != nil {
+		return nil, err
+	}
+	return &loginState{
+		state:     state,
+		id:        id,
+		nonce:     nonce,
+		binding:   binding,
+		expiresAt: expiresAt,
+	}, nil
+}
+
+func (s *serv) verifyState(state string, provider string, binding string) (*stateClaims, error) {
+	claims := &stateClaims{}
+	_, err := jwt.ParseWithClaims(state, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("Unexpected signing method: %v", token.Header["alg"])
+		}
+		return utils.S2B(s.idpConfig.StateSecretKey()), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if claims.Provider != provider {
+		return nil, fmt.Errorf("state was issued for provider %q", claims.Provider)
+	}
+	if subtle.ConstantTimeCompare([]byte(hashBinding(binding)), []byte(claims.BindingHash)) != 1 {
+		return nil, fmt.Errorf("state was issued to another browser")
+	}
+	return claims, nil
+}
+
+func hashBinding(binding string) string {
+	sum := sha256.Sum256([]byte(binding))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomString(size int) (string, error) {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,305 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arifullov/auth/internal/client/db"
+	txManagerMocks "github.com/arifullov/auth/internal/client/db/mocks"
+	"github.com/arifullov/auth/internal/config"
+	"github.com/arifullov/auth/internal/idp"
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/repository"
+	repositoryMocks "github.com/arifullov/auth/internal/repository/mocks"
+	"github.com/arifullov/auth/internal/service"
+	"github.com/arifullov/auth/internal/service/federated"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+)
+
+const (
+	providerName = "corp"
+	authCode     = "code"
+)
+
+type tokenConfig struct{}
+
+func (tokenConfig) RefreshTokenSecretKey() string         { return "refresh-secret" }
+func (tokenConfig) AccessTokenSecretKey() string          { return "access-secret" }
+func (tokenConfig) RefreshTokenExpiration() time.Duration { return time.Hour }
+func (tokenConfig) AccessTokenExpiration() time.Duration  { return time.Minute }
+
+type idpConfig struct{}
+
+func (idpConfig) Providers() []config.IdentityProviderConfig { return nil }
+func (idpConfig) StateSecretKey() string                     { return "state-secret" }
+
+// fakeProvider returns the identity for the code and keeps the state of the last login.
+type fakeProvider struct {
+	name     string
+	identity *model.ExternalIdentity
+	state    string
+}
+
+func (p *fakeProvider) Name() string { return p.name }
+
+func (p *fakeProvider) AuthCodeURL(_ context.Context, state string, _ string) (string, error) {
+	p.state = state
+	return "https://idp.example.com/authorize", nil
+}
+
+func (p *fakeProvider) Exchange(_ context.Context, code string, _ string) (*model.ExternalIdentity, error) {
+	if code != authCode {
+		return nil, sys.NewCommonError(codes.Unauthenticated, "invalid code")
+	}
+	return p.identity, nil
+}
+
+func (p *fakeProvider) Role(*model.ExternalIdentity) model.Role {
+	return model.UserRole
+}
+
+// stateRepository keeps the states in memory, consumed is whether the callback consumes a state.
+func stateRepository(mc *minimock.Controller, consumed bool) repository.FederatedStateRepository {
+	states := map[string]bool{}
+	repo := repositoryMocks.NewFederatedStateRepositoryMock(mc)
+	repo.DeleteExpiredMock.Return(nil)
+	repo.CreateMock.Set(func(_ context.Context, state *model.FederatedState) error {
+		states[state.ID] = true
+		return nil
+	})
+	if consumed {
+		repo.ConsumeMock.Set(func(_ context.Context, id string, _ time.Time) error {
+			if !states[id] {
+				return sys.NewCommonError(codes.NotFound, "federated state not found or expired")
+			}
+			delete(states, id)
+			return nil
+		})
+	}
+	return repo
+}
+
+func newService(
+	mc *minimock.Controller,
+	provider idp.Provider,
+	userRepository repository.UserRepository,
+	identityRepository repository.IdentityRepository,
+	resolved bool,
+) service.FederatedService {
+	txManager := txManagerMocks.NewTxManagerMock(mc)
+	if resolved {
+		txManager.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) error {
+			return f(ctx)
+		})
+	}
+	return federated.NewFederatedService(
+		idp.NewStaticRegistry(provider),
+		userRepository,
+		identityRepository,
+		stateRepository(mc, resolved),
+		txManager,
+		tokenConfig{},
+		idpConfig{},
+	)
+}
+
+func TestFinishResolvesUser(t *testing.T) {
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		userID   int64 = 10
+		email          = "jane@example.com"
+		user           = &model.User{ID: userID, Email: email, Roles: []model.Role{model.UserRole}}
+		notFound       = sys.NewCommonError(codes.NotFound, "not found")
+	)
+
+	tests := []struct {
+		name     string
+		identity *model.ExternalIdentity
+		// linkedTo is the user the identity is linked to, zero when it is not linked.
+		linkedTo int64
+		// existing is the account with the email of the identity, nil when there is none.
+		existing *model.User
+		// linked is whether the identity is linked to the user.
+		linked    bool
+		linkErr   error
+		provision bool
+		err       error
+	}{
+		{
+			name:     "linked identity signs in",
+			identity: &model.ExternalIdentity{Provider: providerName, Subject: "1", Email: email, EmailVerified: true},
+			linkedTo: userID,
+		},
+		{
+			name:     "unverified email is not linked",
+			identity: &model.ExternalIdentity{Provider: providerName, Subject: "1", Email: email},
+			err:      sys.NewCommonError(codes.FailedPrecondition, "identity provider did not return a verified email"),
+		},
+		{
+			name:     "account with the email is linked",
+			identity: &model.ExternalIdentity{Provider: providerName, Subject: "1", Email: email, EmailVerified: true},
+			existing: user,
+			linked:   true,
+		},
+		{
+			name:      "new user is provisioned",
+			identity:  &model.ExternalIdentity{Provider: providerName, Subject: "1", Email: email, EmailVerified: true, Name: "Jane"},
+			provision: true,
+			linked:    true,
+		},
+		{
+			name:     "identity linked concurrently",
+			identity: &model.ExternalIdentity{Provider: providerName, Subject: "1", Email: email, EmailVerified: true},
+			existing: user,
+			linked:   true,
+			linkErr:  sys.NewCommonError(codes.AlreadyExists, "identity already linked"),
+			err:      sys.NewCommonError(codes.AlreadyExists, "identity already linked"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			userRepository := repositoryMocks.NewUserRepositoryMock(mc)
+			identityRepository := repositoryMocks.NewIdentityRepositoryMock(mc)
+
+			if tt.linkedTo != 0 {
+				identityRepository.GetUserIDMock.Expect(ctx, providerName, tt.identity.Subject).Return(tt.linkedTo, nil)
+				userRepository.GetMock.Expect(ctx, tt.linkedTo, "").Return(user, nil)
+			} else {
+				identityRepository.GetUserIDMock.Expect(ctx, providerName, tt.identity.Subject).Return(0, notFound)
+			}
+			if tt.identity.EmailVerified && tt.linkedTo == 0 {
+				if tt.existing != nil {
+					userRepository.GetByEmailMock.Expect(ctx, email).Return(tt.existing, nil)
+				} else {
+					userRepository.GetByEmailMock.Expect(ctx, email).Return(nil, notFound)
+				}
+			}
+			if tt.provision {
+				userRepository.CreateMock.Set(func(_ context.Context, create *model.CreateUser, _ string) (int64, error) {
+					require.Equal(t, tt.identity.Name, create.Name)
+					require.Equal(t, email, create.Email)
+					require.Equal(t, []model.Role{model.UserRole}, create.Roles)
+					require.NotEmpty(t, create.PasswordHash)
+					return userID, nil
+				})
+				userRepository.GetMock.Expect(ctx, userID, "").Return(user, nil)
+			}
+			if tt.linked {
+				identityRepository.CreateMock.Set(func(_ context.Context, identity *model.UserIdentity) (int64, error) {
+					require.Equal(t, userID, identity.UserID)
+					require.Equal(t, providerName, identity.Provider)
+					require.Equal(t, tt.identity.Subject, identity.Subject)
+					return 1, tt.linkErr
+				})
+			}
+
+			provider := &fakeProvider{name: providerName, identity: tt.identity}
+			service := newService(mc, provider, userRepository, identityRepository, true)
+
+			login, err := service.Start(ctx, providerName)
+			require.NoError(t, err)
+
+			refreshToken, err := service.Finish(ctx, providerName, authCode, provider.state, login.Binding)
+			if tt.err != nil {
+				require.Equal(t, tt.err, err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotEmpty(t, refreshToken)
+		})
+	}
+}
+
+func TestFinishState(t *testing.T) {
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		userID       int64 = 10
+		identity           = &model.ExternalIdentity{Provider: providerName, Subject: "1"}
+		invalidState       = sys.NewCommonError(codes.Unauthenticated, "invalid state")
+	)
+
+	tests := []struct {
+		name string
+		// finish completes the login started with the state and binding.
+		finish func(s service.FederatedService, state string, binding string) error
+		// resolved is whether the state is accepted once and the user resolved.
+		resolved bool
+		err      error
+	}{
+		{
+			name: "browser that started the login",
+			finish: func(s service.FederatedService, state string, binding string) error {
+				_, err := s.Finish(ctx, providerName, authCode, state, binding)
+				return err
+			},
+			resolved: true,
+		},
+		{
+			name: "another browser",
+			finish: func(s service.FederatedService, state string, _ string) error {
+				_, err := s.Finish(ctx, providerName, authCode, state, "attacker-binding")
+				return err
+			},
+			err: invalidState,
+		},
+		{
+			name: "without binding",
+			finish: func(s service.FederatedService, state string, _ string) error {
+				_, err := s.Finish(ctx, providerName, authCode, state, "")
+				return err
+			},
+			err: invalidState,
+		},
+		{
+			name: "state of another provider",
+			finish: func(s service.FederatedService, state string, binding string) error {
+				_, err := s.Finish(ctx, "other", authCode, state, binding)
+				return err
+			},
+			err: sys.NewCommonError(codes.NotFound, "identity provider not found"),
+		},
+		{
+			name: "state replayed",
+			finish: func(s service.FederatedService, state string, binding string) error {
+				if _, err := s.Finish(ctx, providerName, authCode, state, binding); err != nil {
+					return err
+				}
+				_, err := s.Finish(ctx, providerName, authCode, state, binding)
+				return err
+			},
+			resolved: true,
+			err:      invalidState,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			userRepository := repositoryMocks.NewUserRepositoryMock(mc)
+			identityRepository := repositoryMocks.NewIdentityRepositoryMock(mc)
+			if tt.resolved {
+				userRepository.GetMock.Expect(ctx, userID, "").Return(&model.User{ID: userID}, nil)
+				identityRepository.GetUserIDMock.Expect(ctx, providerName, identity.Subject).Return(userID, nil)
+			}
+
+			provider := &fakeProvider{name: providerName, identity: identity}
+			service := newService(mc, provider, userRepository, identityRepository, tt.resolved)
+
+			login, err := service.Start(ctx, providerName)
+			require.NoError(t, err)
+
+			require.Equal(t, tt.err, tt.finish(service, provider.state, login.Binding))
+		})
+	}
+}
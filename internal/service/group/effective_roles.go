@@ -0,0 +1,105 @@
+package group
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/arifullov/auth/internal/authctx"
+	"github.com/arifullov/auth/internal/model"
+)
+
+// ListEffectiveRoles returns the roles of the user in the tenant of the caller, each with
+// the shortest path granting it.
+func (s *serv) ListEffectiveRoles(ctx context.Context, userID int64) ([]model.EffectiveRole, error) {
+	// Get finds only the users of the tenant of the caller.
+	tenant := authctx.Tenant(ctx)
+	if _, err := s.userRepository.Get(ctx, userID, tenant); err != nil {
+		return nil, err
+	}
+
+	graph, err := s.groupRepository.GetRoleGraph(ctx, userID, tenant)
+	if err != nil {
+		return nil, err
+	}
+	return effectiveRoles(graph), nil
+}
+
+type node struct {
+	step model.RolePathStep
+	path []model.RolePathStep
+}
+
+// effectiveRoles walks the graph breadth first from what is assigned to the user: roles,
+// the tenant and groups. Groups lead to the groups they are nested in and to their roles,
+// roles to the roles they inherit. Nodes are visited once, so cycles end the walk.
+func effectiveRoles(graph *model.RoleGraph) []model.EffectiveRole {
+	var queue []node
+	push := func(parent []model.RolePathStep, step model.RolePathStep) {
+		path := make([]model.RolePathStep, len(parent), len(parent)+1)
+		copy(path, parent)
+		queue = append(queue, node{step: step, path: append(path, step)})
+	}
+	pushRoles := func(parent []model.RolePathStep, roles []model.Role) {
+		sorted := slices.Clone(roles)
+		slices.Sort(sorted)
+		for _, role := range sorted {
+			push(parent, model.RolePathStep{Kind: model.RoleSourceRole, Name: string(role)})
+		}
+	}
+	pushGroups := func(parent []model.RolePathStep, ids []int64) {
+		sorted := slices.Clone(ids)
+		slices.Sort(sorted)
+		for _, id := range sorted {
+			if group, ok := graph.Groups[id]; ok {
+				push(parent, model.RolePathStep{Kind: model.RoleSourceGroup, ID: id, Name: group.Name})
+			}
+		}
+	}
+
+	pushRoles(nil, graph.Roles)
+	if graph.Tenant != "" && len(graph.TenantRoles) > 0 {
+		push(nil, model.RolePathStep{Kind: model.RoleSourceOrganization, Name: graph.Tenant})
+	}
+	pushGroups(nil, graph.GroupIDs)
+
+	visitedGroups := make(map[int64]struct{})
+	roles := make(map[model.Role][]model.RolePathStep)
+	tenantVisited := false
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		switch n.step.Kind {
+		case model.RoleSourceRole:
+			role := model.Role(n.step.Name)
+			if _, ok := roles[role]; ok {
+				continue
+			}
+			roles[role] = n.path
+			pushRoles(n.path, graph.RoleParents[role])
+		case model.RoleSourceOrganization:
+			if tenantVisited {
+				continue
+			}
+			tenantVisited = true
+			pushRoles(n.path, graph.TenantRoles)
+		case model.RoleSourceGroup:
+			if _, ok := visitedGroups[n.step.ID]; ok {
+				continue
+			}
+			visitedGroups[n.step.ID] = struct{}{}
+			group := graph.Groups[n.step.ID]
+			pushRoles(n.path, group.Roles)
+			pushGroups(n.path, group.ParentIDs)
+		}
+	}
+
+	res := make([]model.EffectiveRole, 0, len(roles))
+	for role, path := range roles {
+		res = append(res, model.EffectiveRole{Role: role, Path: path})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Role < res[j].Role
+	})
+	return res
+}
@@ -0,0 +1,113 @@
+package group
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/arifullov/auth/internal/authctx"
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+	"github.com/arifullov/auth/internal/sys/validate"
+)
+
+func (s *serv) Create(ctx context.Context, group *model.CreateGroup) (int64, error) {
+	err := validate.Validate(
+		ctx,
+		callerIsGlobal(),
+		rolesAreGrantable(group.Roles),
+		s.rolesExist(group.Roles),
+	)
+	if err != nil {
+		return 0, err
+	}
+	return s.groupRepository.Create(ctx, group)
+}
+
+func (s *serv) Get(ctx context.Context, id int64) (*model.Group, error) {
+	return s.groupRepository.Get(ctx, id)
+}
+
+func (s *serv) Delete(ctx context.Context, id int64) error {
+	if err := validate.Validate(ctx, callerIsGlobal()); err != nil {
+		return err
+	}
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		// Members lose the roles of the group, tokens carrying them must not be used anymore.
+		if errTx := s.groupRepository.RevokeMemberTokens(ctx, id, time.Now()); errTx != nil {
+			return errTx
+		}
+		return s.groupRepository.Delete(ctx, id)
+	})
+}
+
+func (s *serv) SetRoles(ctx context.Context, id int64, roles []model.Role) error {
+	err := validate.Validate(
+		ctx,
+		callerIsGlobal(),
+		rolesAreGrantable(roles),
+		s.rolesExist(roles),
+	)
+	if err != nil {
+		return err
+	}
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		group, errTx := s.groupRepository.Get(ctx, id)
+		if errTx != nil {
+			return errTx
+		}
+		// Unbinding a role ranked higher than the caller is as much an escalation as binding it.
+		if errTx = rolesAreGrantable(group.Roles)(ctx); errTx != nil {
+			return errTx
+		}
+		if errTx = s.groupRepository.SetRoles(ctx, id, roles); errTx != nil {
+			return errTx
+		}
+		return s.groupRepository.RevokeMemberTokens(ctx, id, time.Now())
+	})
+}
+
+// callerIsGlobal requires a token issued outside of organizations, groups are shared by all of them.
+func callerIsGlobal() validate.Condition {
+	return func(ctx context.Context) error {
+		if authctx.Tenant(ctx) != "" {
+			return sys.NewCommonError(codes.PermissionDenied, "groups cannot be managed within an organization")
+		}
+		return nil
+	}
+}
+
+// rolesAreGrantable requires the caller to hold each of the roles, so nobody can bind
+// a role higher than their own.
+func rolesAreGrantable(roles []model.Role) validate.Condition {
+	return func(ctx context.Context) error {
+		claims, ok := authctx.Claims(ctx)
+		if !ok {
+			return sys.NewCommonError(codes.Unauthenticated, "caller is not authenticated")
+		}
+		for _, role := range roles {
+			if !claims.HasRole(role) {
+				return sys.NewCommonError(codes.PermissionDenied, fmt.Sprintf("role %s cannot be granted by the caller", role))
+			}
+		}
+		return nil
+	}
+}
+
+func (s *serv) rolesExist(roles []model.Role) validate.Condition {
+	return func(ctx context.Context) error {
+		existing, err := s.roleRepository.GetExisting(ctx, roles)
+		if err != nil {
+			return err
+		}
+		for _, role := range roles {
+			if !slices.Contains(existing, role) {
+				return validate.NewValidationErrors(fmt.Sprintf("unknown role %s", role))
+			}
+		}
+		return nil
+	}
+}
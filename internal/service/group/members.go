@@ -0,0 +1,52 @@
+package group
+
+import (
+	"context"
+	"time"
+
+	"github.com/arifullov/auth/internal/sys/validate"
+)
+
+func (s *serv) AddMember(ctx context.Context, groupID int64, userID int64) error {
+	if err := validate.Validate(ctx, callerIsGlobal()); err != nil {
+		return err
+	}
+	return s.groupRepository.AddMember(ctx, groupID, userID)
+}
+
+func (s *serv) RemoveMember(ctx context.Context, groupID int64, userID int64) error {
+	if err := validate.Validate(ctx, callerIsGlobal()); err != nil {
+		return err
+	}
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		if errTx := s.groupRepository.RemoveMember(ctx, groupID, userID); errTx != nil {
+			return errTx
+		}
+		return s.userRepository.RevokeTokens(ctx, userID, time.Now())
+	})
+}
+
+func (s *serv) AddParent(ctx context.Context, groupID int64, parentID int64) error {
+	if err := validate.Validate(ctx, callerIsGlobal()); err != nil {
+		return err
+	}
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		if errTx := s.groupRepository.LockParents(ctx); errTx != nil {
+			return errTx
+		}
+		return s.groupRepository.AddParent(ctx, groupID, parentID)
+	})
+}
+
+func (s *serv) RemoveParent(ctx context.Context, groupID int64, parentID int64) error {
+	if err := validate.Validate(ctx, callerIsGlobal()); err != nil {
+		return err
+	}
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		if errTx := s.groupRepository.RemoveParent(ctx, groupID, parentID); errTx != nil {
+			return errTx
+		}
+		// Members of the group and of the groups nested in it lose the roles of the parent.
+		return s.groupRepository.RevokeMemberTokens(ctx, groupID, time.Now())
+	})
+}
@@ -0,0 +1,28 @@
+package group
+
+import (
+	"github.com/arifullov/auth/internal/client/db"
+	"github.com/arifullov/auth/internal/repository"
+	"github.com/arifullov/auth/internal/service"
+)
+
+type serv struct {
+	groupRepository repository.GroupRepository
+	userRepository  repository.UserRepository
+	roleRepository  repository.RoleRepository
+	txManager       db.TxManager
+}
+
+func NewGroupService(
+	groupRepository repository.GroupRepository,
+	userRepository repository.UserRepository,
+	roleRepository repository.RoleRepository,
+	txManager db.TxManager,
+) service.GroupService {
+	return &serv{
+		groupRepository: groupRepository,
+		userRepository:  userRepository,
+		roleRepository:  roleRepository,
+		txManager:       txManager,
+	}
+}
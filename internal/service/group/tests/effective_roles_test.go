@@ -0,0 +1,101 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/repository"
+	repositoryMocks "github.com/arifullov/auth/internal/repository/mocks"
+	"github.com/arifullov/auth/internal/service/group"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+)
+
+func TestListEffectiveRoles(t *testing.T) {
+	type userRepositoryMockFunc func(mc *minimock.Controller) repository.UserRepository
+	type groupRepositoryMockFunc func(mc *minimock.Controller) repository.GroupRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		userID = gofakeit.Int64()
+
+		notFoundErr = sys.NewCommonError(codes.NotFound, "user not found")
+
+		role = func(name string) model.RolePathStep {
+			return model.RolePathStep{Kind: model.RoleSourceRole, Name: name}
+		}
+		eng   = model.RolePathStep{Kind: model.RoleSourceGroup, ID: 1, Name: "eng"}
+		staff = model.RolePathStep{Kind: model.RoleSourceGroup, ID: 2, Name: "staff"}
+
+		// eng and staff are nested in each other, the walk must stop on the cycle.
+		graph = &model.RoleGraph{
+			Roles:    []model.Role{"auditor"},
+			GroupIDs: []int64{1},
+			Groups: map[int64]model.GroupNode{
+				1: {Name: "eng", ParentIDs: []int64{2}, Roles: []model.Role{"developer"}},
+				2: {Name: "staff", ParentIDs: []int64{1}, Roles: []model.Role{"user", "viewer"}},
+			},
+			RoleParents: map[model.Role][]model.Role{
+				"developer": {"user"},
+			},
+		}
+	)
+
+	tests := []struct {
+		name                string
+		want                []model.EffectiveRole
+		err                 error
+		userRepositoryMock  userRepositoryMockFunc
+		groupRepositoryMock groupRepositoryMockFunc
+	}{
+		{
+			name: "success list with shortest paths",
+			want: []model.EffectiveRole{
+				{Role: "auditor", Path: []model.RolePathStep{role("auditor")}},
+				{Role: "developer", Path: []model.RolePathStep{eng, role("developer")}},
+				{Role: "user", Path: []model.RolePathStep{eng, role("developer"), role("user")}},
+				{Role: "viewer", Path: []model.RolePathStep{eng, staff, role("viewer")}},
+			},
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, userID, "").Return(&model.User{ID: userID}, nil)
+				return mock
+			},
+			groupRepositoryMock: func(mc *minimock.Controller) repository.GroupRepository {
+				mock := repositoryMocks.NewGroupRepositoryMock(mc)
+				mock.GetRoleGraphMock.Expect(ctx, userID, "").Return(graph, nil)
+				return mock
+			},
+		},
+		{
+			name: "user not found",
+			err:  notFoundErr,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, userID, "").Return(nil, notFoundErr)
+				return mock
+			},
+			groupRepositoryMock: func(mc *minimock.Controller) repository.GroupRepository {
+				return repositoryMocks.NewGroupRepositoryMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			service := group.NewGroupService(tt.groupRepositoryMock(mc), tt.userRepositoryMock(mc), nil, nil)
+
+			roles, err := service.ListEffectiveRoles(ctx, userID)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, roles)
+		})
+	}
+}
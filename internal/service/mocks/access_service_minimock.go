@@ -0,0 +1,1827 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.8). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/arifullov/auth/internal/service.AccessService -o access_service_minimock.go -n AccessServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// AccessServiceMock implements service.AccessService
+type AccessServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAuthorize          func(ctx context.Context, accessToken string, req *model.AccessRequest) (up1 *model.UserClaims, err error)
+	inspectFuncAuthorize   func(ctx context.Context, accessToken string, req *model.AccessRequest)
+	afterAuthorizeCounter  uint64
+	beforeAuthorizeCounter uint64
+	AuthorizeMock          mAccessServiceMockAuthorize
+
+	funcBatchCheck          func(ctx context.Context, accessToken string, reqs []model.AccessRequest) (aa1 []model.AccessDecision, err error)
+	inspectFuncBatchCheck   func(ctx context.Context, accessToken string, reqs []model.AccessRequest)
+	afterBatchCheckCounter  uint64
+	beforeBatchCheckCounter uint64
+	BatchCheckMock          mAccessServiceMockBatchCheck
+
+	funcCheck          func(ctx context.Context, accessToken string, req *model.AccessRequest) (err error)
+	inspectFuncCheck   func(ctx context.Context, accessToken string, req *model.AccessRequest)
+	afterCheckCounter  uint64
+	beforeCheckCounter uint64
+	CheckMock          mAccessServiceMockCheck
+
+	funcListAccessibleRoutes          func(ctx context.Context, accessToken string, userID int64, pageToken string, filter model.RouteGrantFilter) (rp1 *model.RouteGrantPage, err error)
+	inspectFuncListAccessibleRoutes   func(ctx context.Context, accessToken string, userID int64, pageToken string, filter model.RouteGrantFilter)
+	afterListAccessibleRoutesCounter  uint64
+	beforeListAccessibleRoutesCounter uint64
+	ListAccessibleRoutesMock          mAccessServiceMockListAccessibleRoutes
+
+	funcListPrincipalsForRoute          func(ctx context.Context, accessToken string, route string, method string, pageToken string, limit uint64) (rp1 *model.RoutePrincipals, err error)
+	inspectFuncListPrincipalsForRoute   func(ctx context.Context, accessToken string, route string, method string, pageToken string, limit uint64)
+	afterListPrincipalsForRouteCounter  uint64
+	beforeListPrincipalsForRouteCounter uint64
+	ListPrincipalsForRouteMock          mAccessServiceMockListPrincipalsForRoute
+}
+
+// NewAccessServiceMock returns a mock for service.AccessService
+func NewAccessServiceMock(t minimock.Tester) *AccessServiceMock {
+	m := &AccessServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AuthorizeMock = mAccessServiceMockAuthorize{mock: m}
+	m.AuthorizeMock.callArgs = []*AccessServiceMockAuthorizeParams{}
+
+	m.BatchCheckMock = mAccessServiceMockBatchCheck{mock: m}
+	m.BatchCheckMock.callArgs = []*AccessServiceMockBatchCheckParams{}
+
+	m.CheckMock = mAccessServiceMockCheck{mock: m}
+	m.CheckMock.callArgs = []*AccessServiceMockCheckParams{}
+
+	m.ListAccessibleRoutesMock = mAccessServiceMockListAccessibleRoutes{mock: m}
+	m.ListAccessibleRoutesMock.callArgs = []*AccessServiceMockListAccessibleRoutesParams{}
+
+	m.ListPrincipalsForRouteMock = mAccessServiceMockListPrincipalsForRoute{mock: m}
+	m.ListPrincipalsForRouteMock.callArgs = []*AccessServiceMockListPrincipalsForRouteParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAccessServiceMockAuthorize struct {
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockAuthorizeExpectation
+	expectations       []*AccessServiceMockAuthorizeExpectation
+
+	callArgs []*AccessServiceMockAuthorizeParams
+	mutex    sync.RWMutex
+}
+
+// AccessServiceMockAuthorizeExpectation specifies expectation struct of the AccessService.Authorize
+type AccessServiceMockAuthorizeExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockAuthorizeParams
+	paramPtrs *AccessServiceMockAuthorizeParamPtrs
+	results   *AccessServiceMockAuthorizeResults
+	Counter   uint64
+}
+
+// AccessServiceMockAuthorizeParams contains parameters of the AccessService.Authorize
+type AccessServiceMockAuthorizeParams struct {
+	ctx         context.Context
+	accessToken string
+	req         *model.AccessRequest
+}
+
+// AccessServiceMockAuthorizeParamPtrs contains pointers to parameters of the AccessService.Authorize
+type AccessServiceMockAuthorizeParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	req         **model.AccessRequest
+}
+
+// AccessServiceMockAuthorizeResults contains results of the AccessService.Authorize
+type AccessServiceMockAuthorizeResults struct {
+	up1 *model.UserClaims
+	err error
+}
+
+// Expect sets up expected params for AccessService.Authorize
+func (mmAuthorize *mAccessServiceMockAuthorize) Expect(ctx context.Context, accessToken string, req *model.AccessRequest) *mAccessServiceMockAuthorize {
+	if mmAuthorize.mock.funcAuthorize != nil {
+		mmAuthorize.mock.t.Fatalf("AccessServiceMock.Authorize mock is already set by Set")
+	}
+
+	if mmAuthorize.defaultExpectation == nil {
+		mmAuthorize.defaultExpectation = &AccessServiceMockAuthorizeExpectation{}
+	}
+
+	if mmAuthorize.defaultExpectation.paramPtrs != nil {
+		mmAuthorize.mock.t.Fatalf("AccessServiceMock.Authorize mock is already set by ExpectParams functions")
+	}
+
+	mmAuthorize.defaultExpectation.params = &AccessServiceMockAuthorizeParams{ctx, accessToken, req}
+	for _, e := range mmAuthorize.expectations {
+		if minimock.Equal(e.params, mmAuthorize.defaultExpectation.params) {
+			mmAuthorize.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAuthorize.defaultExpectation.params)
+		}
+	}
+
+	return mmAuthorize
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.Authorize
+func (mmAuthorize *mAccessServiceMockAuthorize) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockAuthorize {
+	if mmAuthorize.mock.funcAuthorize != nil {
+		mmAuthorize.mock.t.Fatalf("AccessServiceMock.Authorize mock is already set by Set")
+	}
+
+	if mmAuthorize.defaultExpectation == nil {
+		mmAuthorize.defaultExpectation = &AccessServiceMockAuthorizeExpectation{}
+	}
+
+	if mmAuthorize.defaultExpectation.params != nil {
+		mmAuthorize.mock.t.Fatalf("AccessServiceMock.Authorize mock is already set by Expect")
+	}
+
+	if mmAuthorize.defaultExpectation.paramPtrs == nil {
+		mmAuthorize.defaultExpectation.paramPtrs = &AccessServiceMockAuthorizeParamPtrs{}
+	}
+	mmAuthorize.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmAuthorize
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for AccessService.Authorize
+func (mmAuthorize *mAccessServiceMockAuthorize) ExpectAccessTokenParam2(accessToken string) *mAccessServiceMockAuthorize {
+	if mmAuthorize.mock.funcAuthorize != nil {
+		mmAuthorize.mock.t.Fatalf("AccessServiceMock.Authorize mock is already set by Set")
+	}
+
+	if mmAuthorize.defaultExpectation == nil {
+		mmAuthorize.defaultExpectation = &AccessServiceMockAuthorizeExpectation{}
+	}
+
+	if mmAuthorize.defaultExpectation.params != nil {
+		mmAuthorize.mock.t.Fatalf("AccessServiceMock.Authorize mock is already set by Expect")
+	}
+
+	if mmAuthorize.defaultExpectation.paramPtrs == nil {
+		mmAuthorize.defaultExpectation.paramPtrs = &AccessServiceMockAuthorizeParamPtrs{}
+	}
+	mmAuthorize.defaultExpectation.paramPtrs.accessToken = &accessToken
+
+	return mmAuthorize
+}
+
+// ExpectReqParam3 sets up expected param req for AccessService.Authorize
+func (mmAuthorize *mAccessServiceMockAuthorize) ExpectReqParam3(req *model.AccessRequest) *mAccessServiceMockAuthorize {
+	if mmAuthorize.mock.funcAuthorize != nil {
+		mmAuthorize.mock.t.Fatalf("AccessServiceMock.Authorize mock is already set by Set")
+	}
+
+	if mmAuthorize.defaultExpectation == nil {
+		mmAuthorize.defaultExpectation = &AccessServiceMockAuthorizeExpectation{}
+	}
+
+	if mmAuthorize.defaultExpectation.params != nil {
+		mmAuthorize.mock.t.Fatalf("AccessServiceMock.Authorize mock is already set by Expect")
+	}
+
+	if mmAuthorize.defaultExpectation.paramPtrs == nil {
+		mmAuthorize.defaultExpectation.paramPtrs = &AccessServiceMockAuthorizeParamPtrs{}
+	}
+	mmAuthorize.defaultExpectation.paramPtrs.req = &req
+
+	return mmAuthorize
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.Authorize
+func (mmAuthorize *mAccessServiceMockAuthorize) Inspect(f func(ctx context.Context, accessToken string, req *model.AccessRequest)) *mAccessServiceMockAuthorize {
+	if mmAuthorize.mock.inspectFuncAuthorize != nil {
+		mmAuthorize.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.Authorize")
+	}
+
+	mmAuthorize.mock.inspectFuncAuthorize = f
+
+	return mmAuthorize
+}
+
+// Return sets up results that will be returned by AccessService.Authorize
+func (mmAuthorize *mAccessServiceMockAuthorize) Return(up1 *model.UserClaims, err error) *AccessServiceMock {
+	if mmAuthorize.mock.funcAuthorize != nil {
+		mmAuthorize.mock.t.Fatalf("AccessServiceMock.Authorize mock is already set by Set")
+	}
+
+	if mmAuthorize.defaultExpectation == nil {
+		mmAuthorize.defaultExpectation = &AccessServiceMockAuthorizeExpectation{mock: mmAuthorize.mock}
+	}
+	mmAuthorize.defaultExpectation.results = &AccessServiceMockAuthorizeResults{up1, err}
+	return mmAuthorize.mock
+}
+
+// Set uses given function f to mock the AccessService.Authorize method
+func (mmAuthorize *mAccessServiceMockAuthorize) Set(f func(ctx context.Context, accessToken string, req *model.AccessRequest) (up1 *model.UserClaims, err error)) *AccessServiceMock {
+	if mmAuthorize.defaultExpectation != nil {
+		mmAuthorize.mock.t.Fatalf("Default expectation is already set for the AccessService.Authorize method")
+	}
+
+	if len(mmAuthorize.expectations) > 0 {
+		mmAuthorize.mock.t.Fatalf("Some expectations are already set for the AccessService.Authorize method")
+	}
+
+	mmAuthorize.mock.funcAuthorize = f
+	return mmAuthorize.mock
+}
+
+// When sets expectation for the AccessService.Authorize which will trigger the result defined by the following
+// Then helper
+func (mmAuthorize *mAccessServiceMockAuthorize) When(ctx context.Context, accessToken string, req *model.AccessRequest) *AccessServiceMockAuthorizeExpectation {
+	if mmAuthorize.mock.funcAuthorize != nil {
+		mmAuthorize.mock.t.Fatalf("AccessServiceMock.Authorize mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockAuthorizeExpectation{
+		mock:   mmAuthorize.mock,
+		params: &AccessServiceMockAuthorizeParams{ctx, accessToken, req},
+	}
+	mmAuthorize.expectations = append(mmAuthorize.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.Authorize return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockAuthorizeExpectation) Then(up1 *model.UserClaims, err error) *AccessServiceMock {
+	e.results = &AccessServiceMockAuthorizeResults{up1, err}
+	return e.mock
+}
+
+// Authorize implements service.AccessService
+func (mmAuthorize *AccessServiceMock) Authorize(ctx context.Context, accessToken string, req *model.AccessRequest) (up1 *model.UserClaims, err error) {
+	mm_atomic.AddUint64(&mmAuthorize.beforeAuthorizeCounter, 1)
+	defer mm_atomic.AddUint64(&mmAuthorize.afterAuthorizeCounter, 1)
+
+	if mmAuthorize.inspectFuncAuthorize != nil {
+		mmAuthorize.inspectFuncAuthorize(ctx, accessToken, req)
+	}
+
+	mm_params := AccessServiceMockAuthorizeParams{ctx, accessToken, req}
+
+	// Record call args
+	mmAuthorize.AuthorizeMock.mutex.Lock()
+	mmAuthorize.AuthorizeMock.callArgs = append(mmAuthorize.AuthorizeMock.callArgs, &mm_params)
+	mmAuthorize.AuthorizeMock.mutex.Unlock()
+
+	for _, e := range mmAuthorize.AuthorizeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmAuthorize.AuthorizeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAuthorize.AuthorizeMock.defaultExpectation.Counter, 1)
+		mm_want := mmAuthorize.AuthorizeMock.defaultExpectation.params
+		mm_want_ptrs := mmAuthorize.AuthorizeMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockAuthorizeParams{ctx, accessToken, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAuthorize.t.Errorf("AccessServiceMock.Authorize got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmAuthorize.t.Errorf("AccessServiceMock.Authorize got unexpected parameter accessToken, want: %#v, got: %#v%s\n", *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmAuthorize.t.Errorf("AccessServiceMock.Authorize got unexpected parameter req, want: %#v, got: %#v%s\n", *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAuthorize.t.Errorf("AccessServiceMock.Authorize got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAuthorize.AuthorizeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAuthorize.t.Fatal("No results are set for the AccessServiceMock.Authorize")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmAuthorize.funcAuthorize != nil {
+		return mmAuthorize.funcAuthorize(ctx, accessToken, req)
+	}
+	mmAuthorize.t.Fatalf("Unexpected call to AccessServiceMock.Authorize. %v %v %v", ctx, accessToken, req)
+	return
+}
+
+// AuthorizeAfterCounter returns a count of finished AccessServiceMock.Authorize invocations
+func (mmAuthorize *AccessServiceMock) AuthorizeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAuthorize.afterAuthorizeCounter)
+}
+
+// AuthorizeBeforeCounter returns a count of AccessServiceMock.Authorize invocations
+func (mmAuthorize *AccessServiceMock) AuthorizeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAuthorize.beforeAuthorizeCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.Authorize.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAuthorize *mAccessServiceMockAuthorize) Calls() []*AccessServiceMockAuthorizeParams {
+	mmAuthorize.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockAuthorizeParams, len(mmAuthorize.callArgs))
+	copy(argCopy, mmAuthorize.callArgs)
+
+	mmAuthorize.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAuthorizeDone returns true if the count of the Authorize invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockAuthorizeDone() bool {
+	for _, e := range m.AuthorizeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AuthorizeMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterAuthorizeCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAuthorize != nil && mm_atomic.LoadUint64(&m.afterAuthorizeCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockAuthorizeInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockAuthorizeInspect() {
+	for _, e := range m.AuthorizeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.Authorize with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AuthorizeMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterAuthorizeCounter) < 1 {
+		if m.AuthorizeMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.Authorize")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.Authorize with params: %#v", *m.AuthorizeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAuthorize != nil && mm_atomic.LoadUint64(&m.afterAuthorizeCounter) < 1 {
+		m.t.Error("Expected call to AccessServiceMock.Authorize")
+	}
+}
+
+type mAccessServiceMockBatchCheck struct {
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockBatchCheckExpectation
+	expectations       []*AccessServiceMockBatchCheckExpectation
+
+	callArgs []*AccessServiceMockBatchCheckParams
+	mutex    sync.RWMutex
+}
+
+// AccessServiceMockBatchCheckExpectation specifies expectation struct of the AccessService.BatchCheck
+type AccessServiceMockBatchCheckExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockBatchCheckParams
+	paramPtrs *AccessServiceMockBatchCheckParamPtrs
+	results   *AccessServiceMockBatchCheckResults
+	Counter   uint64
+}
+
+// AccessServiceMockBatchCheckParams contains parameters of the AccessService.BatchCheck
+type AccessServiceMockBatchCheckParams struct {
+	ctx         context.Context
+	accessToken string
+	reqs        []model.AccessRequest
+}
+
+// AccessServiceMockBatchCheckParamPtrs contains pointers to parameters of the AccessService.BatchCheck
+type AccessServiceMockBatchCheckParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	reqs        *[]model.AccessRequest
+}
+
+// AccessServiceMockBatchCheckResults contains results of the AccessService.BatchCheck
+type AccessServiceMockBatchCheckResults struct {
+	aa1 []model.AccessDecision
+	err error
+}
+
+// Expect sets up expected params for AccessService.BatchCheck
+func (mmBatchCheck *mAccessServiceMockBatchCheck) Expect(ctx context.Context, accessToken string, reqs []model.AccessRequest) *mAccessServiceMockBatchCheck {
+	if mmBatchCheck.mock.funcBatchCheck != nil {
+		mmBatchCheck.mock.t.Fatalf("AccessServiceMock.BatchCheck mock is already set by Set")
+	}
+
+	if mmBatchCheck.defaultExpectation == nil {
+		mmBatchCheck.defaultExpectation = &AccessServiceMockBatchCheckExpectation{}
+	}
+
+	if mmBatchCheck.defaultExpectation.paramPtrs != nil {
+		mmBatchCheck.mock.t.Fatalf("AccessServiceMock.BatchCheck mock is already set by ExpectParams functions")
+	}
+
+	mmBatchCheck.defaultExpectation.params = &AccessServiceMockBatchCheckParams{ctx, accessToken, reqs}
+	for _, e := range mmBatchCheck.expectations {
+		if minimock.Equal(e.params, mmBatchCheck.defaultExpectation.params) {
+			mmBatchCheck.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmBatchCheck.defaultExpectation.params)
+		}
+	}
+
+	return mmBatchCheck
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.BatchCheck
+func (mmBatchCheck *mAccessServiceMockBatchCheck) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockBatchCheck {
+	if mmBatchCheck.mock.funcBatchCheck != nil {
+		mmBatchCheck.mock.t.Fatalf("AccessServiceMock.BatchCheck mock is already set by Set")
+	}
+
+	if mmBatchCheck.defaultExpectation == nil {
+		mmBatchCheck.defaultExpectation = &AccessServiceMockBatchCheckExpectation{}
+	}
+
+	if mmBatchCheck.defaultExpectation.params != nil {
+		mmBatchCheck.mock.t.Fatalf("AccessServiceMock.BatchCheck mock is already set by Expect")
+	}
+
+	if mmBatchCheck.defaultExpectation.paramPtrs == nil {
+		mmBatchCheck.defaultExpectation.paramPtrs = &AccessServiceMockBatchCheckParamPtrs{}
+	}
+	mmBatchCheck.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmBatchCheck
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for AccessService.BatchCheck
+func (mmBatchCheck *mAccessServiceMockBatchCheck) ExpectAccessTokenParam2(accessToken string) *mAccessServiceMockBatchCheck {
+	if mmBatchCheck.mock.funcBatchCheck != nil {
+		mmBatchCheck.mock.t.Fatalf("AccessServiceMock.BatchCheck mock is already set by Set")
+	}
+
+	if mmBatchCheck.defaultExpectation == nil {
+		mmBatchCheck.defaultExpectation = &AccessServiceMockBatchCheckExpectation{}
+	}
+
+	if mmBatchCheck.defaultExpectation.params != nil {
+		mmBatchCheck.mock.t.Fatalf("AccessServiceMock.BatchCheck mock is already set by Expect")
+	}
+
+	if mmBatchCheck.defaultExpectation.paramPtrs == nil {
+		mmBatchCheck.defaultExpectation.paramPtrs = &AccessServiceMockBatchCheckParamPtrs{}
+	}
+	mmBatchCheck.defaultExpectation.paramPtrs.accessToken = &accessToken
+
+	return mmBatchCheck
+}
+
+// ExpectReqsParam3 sets up expected param reqs for AccessService.BatchCheck
+func (mmBatchCheck *mAccessServiceMockBatchCheck) ExpectReqsParam3(reqs []model.AccessRequest) *mAccessServiceMockBatchCheck {
+	if mmBatchCheck.mock.funcBatchCheck != nil {
+		mmBatchCheck.mock.t.Fatalf("AccessServiceMock.BatchCheck mock is already set by Set")
+	}
+
+	if mmBatchCheck.defaultExpectation == nil {
+		mmBatchCheck.defaultExpectation = &AccessServiceMockBatchCheckExpectation{}
+	}
+
+	if mmBatchCheck.defaultExpectation.params != nil {
+		mmBatchCheck.mock.t.Fatalf("AccessServiceMock.BatchCheck mock is already set by Expect")
+	}
+
+	if mmBatchCheck.defaultExpectation.paramPtrs == nil {
+		mmBatchCheck.defaultExpectation.paramPtrs = &AccessServiceMockBatchCheckParamPtrs{}
+	}
+	mmBatchCheck.defaultExpectation.paramPtrs.reqs = &reqs
+
+	return mmBatchCheck
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.BatchCheck
+func (mmBatchCheck *mAccessServiceMockBatchCheck) Inspect(f func(ctx context.Context, accessToken string, reqs []model.AccessRequest)) *mAccessServiceMockBatchCheck {
+	if mmBatchCheck.mock.inspectFuncBatchCheck != nil {
+		mmBatchCheck.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.BatchCheck")
+	}
+
+	mmBatchCheck.mock.inspectFuncBatchCheck = f
+
+	return mmBatchCheck
+}
+
+// Return sets up results that will be returned by AccessService.BatchCheck
+func (mmBatchCheck *mAccessServiceMockBatchCheck) Return(aa1 []model.AccessDecision, err error) *AccessServiceMock {
+	if mmBatchCheck.mock.funcBatchCheck != nil {
+		mmBatchCheck.mock.t.Fatalf("AccessServiceMock.BatchCheck mock is already set by Set")
+	}
+
+	if mmBatchCheck.defaultExpectation == nil {
+		mmBatchCheck.defaultExpectation = &AccessServiceMockBatchCheckExpectation{mock: mmBatchCheck.mock}
+	}
+	mmBatchCheck.defaultExpectation.results = &AccessServiceMockBatchCheckResults{aa1, err}
+	return mmBatchCheck.mock
+}
+
+// Set uses given function f to mock the AccessService.BatchCheck method
+func (mmBatchCheck *mAccessServiceMockBatchCheck) Set(f func(ctx context.Context, accessToken string, reqs []model.AccessRequest) (aa1 []model.AccessDecision, err error)) *AccessServiceMock {
+	if mmBatchCheck.defaultExpectation != nil {
+		mmBatchCheck.mock.t.Fatalf("Default expectation is already set for the AccessService.BatchCheck method")
+	}
+
+	if len(mmBatchCheck.expectations) > 0 {
+		mmBatchCheck.mock.t.Fatalf("Some expectations are already set for the AccessService.BatchCheck method")
+	}
+
+	mmBatchCheck.mock.funcBatchCheck = f
+	return mmBatchCheck.mock
+}
+
+// When sets expectation for the AccessService.BatchCheck which will trigger the result defined by the following
+// Then helper
+func (mmBatchCheck *mAccessServiceMockBatchCheck) When(ctx context.Context, accessToken string, reqs []model.AccessRequest) *AccessServiceMockBatchCheckExpectation {
+	if mmBatchCheck.mock.funcBatchCheck != nil {
+		mmBatchCheck.mock.t.Fatalf("AccessServiceMock.BatchCheck mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockBatchCheckExpectation{
+		mock:   mmBatchCheck.mock,
+		params: &AccessServiceMockBatchCheckParams{ctx, accessToken, reqs},
+	}
+	mmBatchCheck.expectations = append(mmBatchCheck.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.BatchCheck return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockBatchCheckExpectation) Then(aa1 []model.AccessDecision, err error) *AccessServiceMock {
+	e.results = &AccessServiceMockBatchCheckResults{aa1, err}
+	return e.mock
+}
+
+// BatchCheck implements service.AccessService
+func (mmBatchCheck *AccessServiceMock) BatchCheck(ctx context.Context, accessToken string, reqs []model.AccessRequest) (aa1 []model.AccessDecision, err error) {
+	mm_atomic.AddUint64(&mmBatchCheck.beforeBatchCheckCounter, 1)
+	defer mm_atomic.AddUint64(&mmBatchCheck.afterBatchCheckCounter, 1)
+
+	if mmBatchCheck.inspectFuncBatchCheck != nil {
+		mmBatchCheck.inspectFuncBatchCheck(ctx, accessToken, reqs)
+	}
+
+	mm_params := AccessServiceMockBatchCheckParams{ctx, accessToken, reqs}
+
+	// Record call args
+	mmBatchCheck.BatchCheckMock.mutex.Lock()
+	mmBatchCheck.BatchCheckMock.callArgs = append(mmBatchCheck.BatchCheckMock.callArgs, &mm_params)
+	mmBatchCheck.BatchCheckMock.mutex.Unlock()
+
+	for _, e := range mmBatchCheck.BatchCheckMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.aa1, e.results.err
+		}
+	}
+
+	if mmBatchCheck.BatchCheckMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmBatchCheck.BatchCheckMock.defaultExpectation.Counter, 1)
+		mm_want := mmBatchCheck.BatchCheckMock.defaultExpectation.params
+		mm_want_ptrs := mmBatchCheck.BatchCheckMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockBatchCheckParams{ctx, accessToken, reqs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmBatchCheck.t.Errorf("AccessServiceMock.BatchCheck got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmBatchCheck.t.Errorf("AccessServiceMock.BatchCheck got unexpected parameter accessToken, want: %#v, got: %#v%s\n", *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.reqs != nil && !minimock.Equal(*mm_want_ptrs.reqs, mm_got.reqs) {
+				mmBatchCheck.t.Errorf("AccessServiceMock.BatchCheck got unexpected parameter reqs, want: %#v, got: %#v%s\n", *mm_want_ptrs.reqs, mm_got.reqs, minimock.Diff(*mm_want_ptrs.reqs, mm_got.reqs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmBatchCheck.t.Errorf("AccessServiceMock.BatchCheck got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmBatchCheck.BatchCheckMock.defaultExpectation.results
+		if mm_results == nil {
+			mmBatchCheck.t.Fatal("No results are set for the AccessServiceMock.BatchCheck")
+		}
+		return (*mm_results).aa1, (*mm_results).err
+	}
+	if mmBatchCheck.funcBatchCheck != nil {
+		return mmBatchCheck.funcBatchCheck(ctx, accessToken, reqs)
+	}
+	mmBatchCheck.t.Fatalf("Unexpected call to AccessServiceMock.BatchCheck. %v %v %v", ctx, accessToken, reqs)
+	return
+}
+
+// BatchCheckAfterCounter returns a count of finished AccessServiceMock.BatchCheck invocations
+func (mmBatchCheck *AccessServiceMock) BatchCheckAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBatchCheck.afterBatchCheckCounter)
+}
+
+// BatchCheckBeforeCounter returns a count of AccessServiceMock.BatchCheck invocations
+func (mmBatchCheck *AccessServiceMock) BatchCheckBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBatchCheck.beforeBatchCheckCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.BatchCheck.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmBatchCheck *mAccessServiceMockBatchCheck) Calls() []*AccessServiceMockBatchCheckParams {
+	mmBatchCheck.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockBatchCheckParams, len(mmBatchCheck.callArgs))
+	copy(argCopy, mmBatchCheck.callArgs)
+
+	mmBatchCheck.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockBatchCheckDone returns true if the count of the BatchCheck invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockBatchCheckDone() bool {
+	for _, e := range m.BatchCheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.BatchCheckMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterBatchCheckCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcBatchCheck != nil && mm_atomic.LoadUint64(&m.afterBatchCheckCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockBatchCheckInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockBatchCheckInspect() {
+	for _, e := range m.BatchCheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.BatchCheck with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.BatchCheckMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterBatchCheckCounter) < 1 {
+		if m.BatchCheckMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.BatchCheck")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.BatchCheck with params: %#v", *m.BatchCheckMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcBatchCheck != nil && mm_atomic.LoadUint64(&m.afterBatchCheckCounter) < 1 {
+		m.t.Error("Expected call to AccessServiceMock.BatchCheck")
+	}
+}
+
+type mAccessServiceMockCheck struct {
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockCheckExpectation
+	expectations       []*AccessServiceMockCheckExpectation
+
+	callArgs []*AccessServiceMockCheckParams
+	mutex    sync.RWMutex
+}
+
+// AccessServiceMockCheckExpectation specifies expectation struct of the AccessService.Check
+type AccessServiceMockCheckExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockCheckParams
+	paramPtrs *AccessServiceMockCheckParamPtrs
+	results   *AccessServiceMockCheckResults
+	Counter   uint64
+}
+
+// AccessServiceMockCheckParams contains parameters of the AccessService.Check
+type AccessServiceMockCheckParams struct {
+	ctx         context.Context
+	accessToken string
+	req         *model.AccessRequest
+}
+
+// AccessServiceMockCheckParamPtrs contains pointers to parameters of the AccessService.Check
+type AccessServiceMockCheckParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	req         **model.AccessRequest
+}
+
+// AccessServiceMockCheckResults contains results of the AccessService.Check
+type AccessServiceMockCheckResults struct {
+	err error
+}
+
+// Expect sets up expected params for AccessService.Check
+func (mmCheck *mAccessServiceMockCheck) Expect(ctx context.Context, accessToken string, req *model.AccessRequest) *mAccessServiceMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &AccessServiceMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceMock.Check mock is already set by ExpectParams functions")
+	}
+
+	mmCheck.defaultExpectation.params = &AccessServiceMockCheckParams{ctx, accessToken, req}
+	for _, e := range mmCheck.expectations {
+		if minimock.Equal(e.params, mmCheck.defaultExpectation.params) {
+			mmCheck.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheck.defaultExpectation.params)
+		}
+	}
+
+	return mmCheck
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.Check
+func (mmCheck *mAccessServiceMockCheck) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &AccessServiceMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.params != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceMock.Check mock is already set by Expect")
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs == nil {
+		mmCheck.defaultExpectation.paramPtrs = &AccessServiceMockCheckParamPtrs{}
+	}
+	mmCheck.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCheck
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for AccessService.Check
+func (mmCheck *mAccessServiceMockCheck) ExpectAccessTokenParam2(accessToken string) *mAccessServiceMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &AccessServiceMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.params != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceMock.Check mock is already set by Expect")
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs == nil {
+		mmCheck.defaultExpectation.paramPtrs = &AccessServiceMockCheckParamPtrs{}
+	}
+	mmCheck.defaultExpectation.paramPtrs.accessToken = &accessToken
+
+	return mmCheck
+}
+
+// ExpectReqParam3 sets up expected param req for AccessService.Check
+func (mmCheck *mAccessServiceMockCheck) ExpectReqParam3(req *model.AccessRequest) *mAccessServiceMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &AccessServiceMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.params != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceMock.Check mock is already set by Expect")
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs == nil {
+		mmCheck.defaultExpectation.paramPtrs = &AccessServiceMockCheckParamPtrs{}
+	}
+	mmCheck.defaultExpectation.paramPtrs.req = &req
+
+	return mmCheck
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.Check
+func (mmCheck *mAccessServiceMockCheck) Inspect(f func(ctx context.Context, accessToken string, req *model.AccessRequest)) *mAccessServiceMockCheck {
+	if mmCheck.mock.inspectFuncCheck != nil {
+		mmCheck.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.Check")
+	}
+
+	mmCheck.mock.inspectFuncCheck = f
+
+	return mmCheck
+}
+
+// Return sets up results that will be returned by AccessService.Check
+func (mmCheck *mAccessServiceMockCheck) Return(err error) *AccessServiceMock {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &AccessServiceMockCheckExpectation{mock: mmCheck.mock}
+	}
+	mmCheck.defaultExpectation.results = &AccessServiceMockCheckResults{err}
+	return mmCheck.mock
+}
+
+// Set uses given function f to mock the AccessService.Check method
+func (mmCheck *mAccessServiceMockCheck) Set(f func(ctx context.Context, accessToken string, req *model.AccessRequest) (err error)) *AccessServiceMock {
+	if mmCheck.defaultExpectation != nil {
+		mmCheck.mock.t.Fatalf("Default expectation is already set for the AccessService.Check method")
+	}
+
+	if len(mmCheck.expectations) > 0 {
+		mmCheck.mock.t.Fatalf("Some expectations are already set for the AccessService.Check method")
+	}
+
+	mmCheck.mock.funcCheck = f
+	return mmCheck.mock
+}
+
+// When sets expectation for the AccessService.Check which will trigger the result defined by the following
+// Then helper
+func (mmCheck *mAccessServiceMockCheck) When(ctx context.Context, accessToken string, req *model.AccessRequest) *AccessServiceMockCheckExpectation {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceMock.Check mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockCheckExpectation{
+		mock:   mmCheck.mock,
+		params: &AccessServiceMockCheckParams{ctx, accessToken, req},
+	}
+	mmCheck.expectations = append(mmCheck.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.Check return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockCheckExpectation) Then(err error) *AccessServiceMock {
+	e.results = &AccessServiceMockCheckResults{err}
+	return e.mock
+}
+
+// Check implements service.AccessService
+func (mmCheck *AccessServiceMock) Check(ctx context.Context, accessToken string, req *model.AccessRequest) (err error) {
+	mm_atomic.AddUint64(&mmCheck.beforeCheckCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheck.afterCheckCounter, 1)
+
+	if mmCheck.inspectFuncCheck != nil {
+		mmCheck.inspectFuncCheck(ctx, accessToken, req)
+	}
+
+	mm_params := AccessServiceMockCheckParams{ctx, accessToken, req}
+
+	// Record call args
+	mmCheck.CheckMock.mutex.Lock()
+	mmCheck.CheckMock.callArgs = append(mmCheck.CheckMock.callArgs, &mm_params)
+	mmCheck.CheckMock.mutex.Unlock()
+
+	for _, e := range mmCheck.CheckMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCheck.CheckMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheck.CheckMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheck.CheckMock.defaultExpectation.params
+		mm_want_ptrs := mmCheck.CheckMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockCheckParams{ctx, accessToken, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheck.t.Errorf("AccessServiceMock.Check got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmCheck.t.Errorf("AccessServiceMock.Check got unexpected parameter accessToken, want: %#v, got: %#v%s\n", *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmCheck.t.Errorf("AccessServiceMock.Check got unexpected parameter req, want: %#v, got: %#v%s\n", *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheck.t.Errorf("AccessServiceMock.Check got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheck.CheckMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheck.t.Fatal("No results are set for the AccessServiceMock.Check")
+		}
+		return (*mm_results).err
+	}
+	if mmCheck.funcCheck != nil {
+		return mmCheck.funcCheck(ctx, accessToken, req)
+	}
+	mmCheck.t.Fatalf("Unexpected call to AccessServiceMock.Check. %v %v %v", ctx, accessToken, req)
+	return
+}
+
+// CheckAfterCounter returns a count of finished AccessServiceMock.Check invocations
+func (mmCheck *AccessServiceMock) CheckAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheck.afterCheckCounter)
+}
+
+// CheckBeforeCounter returns a count of AccessServiceMock.Check invocations
+func (mmCheck *AccessServiceMock) CheckBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheck.beforeCheckCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.Check.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheck *mAccessServiceMockCheck) Calls() []*AccessServiceMockCheckParams {
+	mmCheck.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockCheckParams, len(mmCheck.callArgs))
+	copy(argCopy, mmCheck.callArgs)
+
+	mmCheck.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckDone returns true if the count of the Check invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockCheckDone() bool {
+	for _, e := range m.CheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCheckCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheck != nil && mm_atomic.LoadUint64(&m.afterCheckCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCheckInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockCheckInspect() {
+	for _, e := range m.CheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.Check with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCheckCounter) < 1 {
+		if m.CheckMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.Check")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.Check with params: %#v", *m.CheckMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheck != nil && mm_atomic.LoadUint64(&m.afterCheckCounter) < 1 {
+		m.t.Error("Expected call to AccessServiceMock.Check")
+	}
+}
+
+type mAccessServiceMockListAccessibleRoutes struct {
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockListAccessibleRoutesExpectation
+	expectations       []*AccessServiceMockListAccessibleRoutesExpectation
+
+	callArgs []*AccessServiceMockListAccessibleRoutesParams
+	mutex    sync.RWMutex
+}
+
+// AccessServiceMockListAccessibleRoutesExpectation specifies expectation struct of the AccessService.ListAccessibleRoutes
+type AccessServiceMockListAccessibleRoutesExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockListAccessibleRoutesParams
+	paramPtrs *AccessServiceMockListAccessibleRoutesParamPtrs
+	results   *AccessServiceMockListAccessibleRoutesResults
+	Counter   uint64
+}
+
+// AccessServiceMockListAccessibleRoutesParams contains parameters of the AccessService.ListAccessibleRoutes
+type AccessServiceMockListAccessibleRoutesParams struct {
+	ctx         context.Context
+	accessToken string
+	userID      int64
+	pageToken   string
+	filter      model.RouteGrantFilter
+}
+
+// AccessServiceMockListAccessibleRoutesParamPtrs contains pointers to parameters of the AccessService.ListAccessibleRoutes
+type AccessServiceMockListAccessibleRoutesParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	userID      *int64
+	pageToken   *string
+	filter      *model.RouteGrantFilter
+}
+
+// AccessServiceMockListAccessibleRoutesResults contains results of the AccessService.ListAccessibleRoutes
+type AccessServiceMockListAccessibleRoutesResults struct {
+	rp1 *model.RouteGrantPage
+	err error
+}
+
+// Expect sets up expected params for AccessService.ListAccessibleRoutes
+func (mmListAccessibleRoutes *mAccessServiceMockListAccessibleRoutes) Expect(ctx context.Context, accessToken string, userID int64, pageToken string, filter model.RouteGrantFilter) *mAccessServiceMockListAccessibleRoutes {
+	if mmListAccessibleRoutes.mock.funcListAccessibleRoutes != nil {
+		mmListAccessibleRoutes.mock.t.Fatalf("AccessServiceMock.ListAccessibleRoutes mock is already set by Set")
+	}
+
+	if mmListAccessibleRoutes.defaultExpectation == nil {
+		mmListAccessibleRoutes.defaultExpectation = &AccessServiceMockListAccessibleRoutesExpectation{}
+	}
+
+	if mmListAccessibleRoutes.defaultExpectation.paramPtrs != nil {
+		mmListAccessibleRoutes.mock.t.Fatalf("AccessServiceMock.ListAccessibleRoutes mock is already set by ExpectParams functions")
+	}
+
+	mmListAccessibleRoutes.defaultExpectation.params = &AccessServiceMockListAccessibleRoutesParams{ctx, accessToken, userID, pageToken, filter}
+	for _, e := range mmListAccessibleRoutes.expectations {
+		if minimock.Equal(e.params, mmListAccessibleRoutes.defaultExpectation.params) {
+			mmListAccessibleRoutes.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListAccessibleRoutes.defaultExpectation.params)
+		}
+	}
+
+	return mmListAccessibleRoutes
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.ListAccessibleRoutes
+func (mmListAccessibleRoutes *mAccessServiceMockListAccessibleRoutes) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockListAccessibleRoutes {
+	if mmListAccessibleRoutes.mock.funcListAccessibleRoutes != nil {
+		mmListAccessibleRoutes.mock.t.Fatalf("AccessServiceMock.ListAccessibleRoutes mock is already set by Set")
+	}
+
+	if mmListAccessibleRoutes.defaultExpectation == nil {
+		mmListAccessibleRoutes.defaultExpectation = &AccessServiceMockListAccessibleRoutesExpectation{}
+	}
+
+	if mmListAccessibleRoutes.defaultExpectation.params != nil {
+		mmListAccessibleRoutes.mock.t.Fatalf("AccessServiceMock.ListAccessibleRoutes mock is already set by Expect")
+	}
+
+	if mmListAccessibleRoutes.defaultExpectation.paramPtrs == nil {
+		mmListAccessibleRoutes.defaultExpectation.paramPtrs = &AccessServiceMockListAccessibleRoutesParamPtrs{}
+	}
+	mmListAccessibleRoutes.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListAccessibleRoutes
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for AccessService.ListAccessibleRoutes
+func (mmListAccessibleRoutes *mAccessServiceMockListAccessibleRoutes) ExpectAccessTokenParam2(accessToken string) *mAccessServiceMockListAccessibleRoutes {
+	if mmListAccessibleRoutes.mock.funcListAccessibleRoutes != nil {
+		mmListAccessibleRoutes.mock.t.Fatalf("AccessServiceMock.ListAccessibleRoutes mock is already set by Set")
+	}
+
+	if mmListAccessibleRoutes.defaultExpectation == nil {
+		mmListAccessibleRoutes.defaultExpectation = &AccessServiceMockListAccessibleRoutesExpectation{}
+	}
+
+	if mmListAccessibleRoutes.defaultExpectation.params != nil {
+		mmListAccessibleRoutes.mock.t.Fatalf("AccessServiceMock.ListAccessibleRoutes mock is already set by Expect")
+	}
+
+	if mmListAccessibleRoutes.defaultExpectation.paramPtrs == nil {
+		mmListAccessibleRoutes.defaultExpectation.paramPtrs = &AccessServiceMockListAccessibleRoutesParamPtrs{}
+	}
+	mmListAccessibleRoutes.defaultExpectation.paramPtrs.accessToken = &accessToken
+
+	return mmListAccessibleRoutes
+}
+
+// ExpectUserIDParam3 sets up expected param userID for AccessService.ListAccessibleRoutes
+func (mmListAccessibleRoutes *mAccessServiceMockListAccessibleRoutes) ExpectUserIDParam3(userID int64) *mAccessServiceMockListAccessibleRoutes {
+	if mmListAccessibleRoutes.mock.funcListAccessibleRoutes != nil {
+		mmListAccessibleRoutes.mock.t.Fatalf("AccessServiceMock.ListAccessibleRoutes mock is already set by Set")
+	}
+
+	if mmListAccessibleRoutes.defaultExpectation == nil {
+		mmListAccessibleRoutes.defaultExpectation = &AccessServiceMockListAccessibleRoutesExpectation{}
+	}
+
+	if mmListAccessibleRoutes.defaultExpectation.params != nil {
+		mmListAccessibleRoutes.mock.t.Fatalf("AccessServiceMock.ListAccessibleRoutes mock is already set by Expect")
+	}
+
+	if mmListAccessibleRoutes.defaultExpectation.paramPtrs == nil {
+		mmListAccessibleRoutes.defaultExpectation.paramPtrs = &AccessServiceMockListAccessibleRoutesParamPtrs{}
+	}
+	mmListAccessibleRoutes.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmListAccessibleRoutes
+}
+
+// ExpectPageTokenParam4 sets up expected param pageToken for AccessService.ListAccessibleRoutes
+func (mmListAccessibleRoutes *mAccessServiceMockListAccessibleRoutes) ExpectPageTokenParam4(pageToken string) *mAccessServiceMockListAccessibleRoutes {
+	if mmListAccessibleRoutes.mock.funcListAccessibleRoutes != nil {
+		mmListAccessibleRoutes.mock.t.Fatalf("AccessServiceMock.ListAccessibleRoutes mock is already set by Set")
+	}
+
+	if mmListAccessibleRoutes.defaultExpectation == nil {
+		mmListAccessibleRoutes.defaultExpectation = &AccessServiceMockListAccessibleRoutesExpectation{}
+	}
+
+	if mmListAccessibleRoutes.defaultExpectation.params != nil {
+		mmListAccessibleRoutes.mock.t.Fatalf("AccessServiceMock.ListAccessibleRoutes mock is already set by Expect")
+	}
+
+	if mmListAccessibleRoutes.defaultExpectation.paramPtrs == nil {
+		mmListAccessibleRoutes.defaultExpectation.paramPtrs = &AccessServiceMockListAccessibleRoutesParamPtrs{}
+	}
+	mmListAccessibleRoutes.defaultExpectation.paramPtrs.pageToken = &pageToken
+
+	return mmListAccessibleRoutes
+}
+
+// ExpectFilterParam5 sets up expected param filter for AccessService.ListAccessibleRoutes
+func (mmListAccessibleRoutes *mAccessServiceMockListAccessibleRoutes) ExpectFilterParam5(filter model.RouteGrantFilter) *mAccessServiceMockListAccessibleRoutes {
+	if mmListAccessibleRoutes.mock.funcListAccessibleRoutes != nil {
+		mmListAccessibleRoutes.mock.t.Fatalf("AccessServiceMock.ListAccessibleRoutes mock is already set by Set")
+	}
+
+	if mmListAccessibleRoutes.defaultExpectation == nil {
+		mmListAccessibleRoutes.defaultExpectation = &AccessServiceMockListAccessibleRoutesExpectation{}
+	}
+
+	if mmListAccessibleRoutes.defaultExpectation.params != nil {
+		mmListAccessibleRoutes.mock.t.Fatalf("AccessServiceMock.ListAccessibleRoutes mock is already set by Expect")
+	}
+
+	if mmListAccessibleRoutes.defaultExpectation.paramPtrs == nil {
+		mmListAccessibleRoutes.defaultExpectation.paramPtrs = &AccessServiceMockListAccessibleRoutesParamPtrs{}
+	}
+	mmListAccessibleRoutes.defaultExpectation.paramPtrs.filter = &filter
+
+	return mmListAccessibleRoutes
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.ListAccessibleRoutes
+func (mmListAccessibleRoutes *mAccessServiceMockListAccessibleRoutes) Inspect(f func(ctx context.Context, accessToken string, userID int64, pageToken string, filter model.RouteGrantFilter)) *mAccessServiceMockListAccessibleRoutes {
+	if mmListAccessibleRoutes.mock.inspectFuncListAccessibleRoutes != nil {
+		mmListAccessibleRoutes.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.ListAccessibleRoutes")
+	}
+
+	mmListAccessibleRoutes.mock.inspectFuncListAccessibleRoutes = f
+
+	return mmListAccessibleRoutes
+}
+
+// Return sets up results that will be returned by AccessService.ListAccessibleRoutes
+func (mmListAccessibleRoutes *mAccessServiceMockListAccessibleRoutes) Return(rp1 *model.RouteGrantPage, err error) *AccessServiceMock {
+	if mmListAccessibleRoutes.mock.funcListAccessibleRoutes != nil {
+		mmListAccessibleRoutes.mock.t.Fatalf("AccessServiceMock.ListAccessibleRoutes mock is already set by Set")
+	}
+
+	if mmListAccessibleRoutes.defaultExpectation == nil {
+		mmListAccessibleRoutes.defaultExpectation = &AccessServiceMockListAccessibleRoutesExpectation{mock: mmListAccessibleRoutes.mock}
+	}
+	mmListAccessibleRoutes.defaultExpectation.results = &AccessServiceMockListAccessibleRoutesResults{rp1, err}
+	return mmListAccessibleRoutes.mock
+}
+
+// Set uses given function f to mock the AccessService.ListAccessibleRoutes method
+func (mmListAccessibleRoutes *mAccessServiceMockListAccessibleRoutes) Set(f func(ctx context.Context, accessToken string, userID int64, pageToken string, filter model.RouteGrantFilter) (rp1 *model.RouteGrantPage, err error)) *AccessServiceMock {
+	if mmListAccessibleRoutes.defaultExpectation != nil {
+		mmListAccessibleRoutes.mock.t.Fatalf("Default expectation is already set for the AccessService.ListAccessibleRoutes method")
+	}
+
+	if len(mmListAccessibleRoutes.expectations) > 0 {
+		mmListAccessibleRoutes.mock.t.Fatalf("Some expectations are already set for the AccessService.ListAccessibleRoutes method")
+	}
+
+	mmListAccessibleRoutes.mock.funcListAccessibleRoutes = f
+	return mmListAccessibleRoutes.mock
+}
+
+// When sets expectation for the AccessService.ListAccessibleRoutes which will trigger the result defined by the following
+// Then helper
+func (mmListAccessibleRoutes *mAccessServiceMockListAccessibleRoutes) When(ctx context.Context, accessToken string, userID int64, pageToken string, filter model.RouteGrantFilter) *AccessServiceMockListAccessibleRoutesExpectation {
+	if mmListAccessibleRoutes.mock.funcListAccessibleRoutes != nil {
+		mmListAccessibleRoutes.mock.t.Fatalf("AccessServiceMock.ListAccessibleRoutes mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockListAccessibleRoutesExpectation{
+		mock:   mmListAccessibleRoutes.mock,
+		params: &AccessServiceMockListAccessibleRoutesParams{ctx, accessToken, userID, pageToken, filter},
+	}
+	mmListAccessibleRoutes.expectations = append(mmListAccessibleRoutes.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.ListAccessibleRoutes return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockListAccessibleRoutesExpectation) Then(rp1 *model.RouteGrantPage, err error) *AccessServiceMock {
+	e.results = &AccessServiceMockListAccessibleRoutesResults{rp1, err}
+	return e.mock
+}
+
+// ListAccessibleRoutes implements service.AccessService
+func (mmListAccessibleRoutes *AccessServiceMock) ListAccessibleRoutes(ctx context.Context, accessToken string, userID int64, pageToken string, filter model.RouteGrantFilter) (rp1 *model.RouteGrantPage, err error) {
+	mm_atomic.AddUint64(&mmListAccessibleRoutes.beforeListAccessibleRoutesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListAccessibleRoutes.afterListAccessibleRoutesCounter, 1)
+
+	if mmListAccessibleRoutes.inspectFuncListAccessibleRoutes != nil {
+		mmListAccessibleRoutes.inspectFuncListAccessibleRoutes(ctx, accessToken, userID, pageToken, filter)
+	}
+
+	mm_params := AccessServiceMockListAccessibleRoutesParams{ctx, accessToken, userID, pageToken, filter}
+
+	// Record call args
+	mmListAccessibleRoutes.ListAccessibleRoutesMock.mutex.Lock()
+	mmListAccessibleRoutes.ListAccessibleRoutesMock.callArgs = append(mmListAccessibleRoutes.ListAccessibleRoutesMock.callArgs, &mm_params)
+	mmListAccessibleRoutes.ListAccessibleRoutesMock.mutex.Unlock()
+
+	for _, e := range mmListAccessibleRoutes.ListAccessibleRoutesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmListAccessibleRoutes.ListAccessibleRoutesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListAccessibleRoutes.ListAccessibleRoutesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListAccessibleRoutes.ListAccessibleRoutesMock.defaultExpectation.params
+		mm_want_ptrs := mmListAccessibleRoutes.ListAccessibleRoutesMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockListAccessibleRoutesParams{ctx, accessToken, userID, pageToken, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListAccessibleRoutes.t.Errorf("AccessServiceMock.ListAccessibleRoutes got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmListAccessibleRoutes.t.Errorf("AccessServiceMock.ListAccessibleRoutes got unexpected parameter accessToken, want: %#v, got: %#v%s\n", *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListAccessibleRoutes.t.Errorf("AccessServiceMock.ListAccessibleRoutes got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.pageToken != nil && !minimock.Equal(*mm_want_ptrs.pageToken, mm_got.pageToken) {
+				mmListAccessibleRoutes.t.Errorf("AccessServiceMock.ListAccessibleRoutes got unexpected parameter pageToken, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageToken, mm_got.pageToken, minimock.Diff(*mm_want_ptrs.pageToken, mm_got.pageToken))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListAccessibleRoutes.t.Errorf("AccessServiceMock.ListAccessibleRoutes got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListAccessibleRoutes.t.Errorf("AccessServiceMock.ListAccessibleRoutes got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListAccessibleRoutes.ListAccessibleRoutesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListAccessibleRoutes.t.Fatal("No results are set for the AccessServiceMock.ListAccessibleRoutes")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmListAccessibleRoutes.funcListAccessibleRoutes != nil {
+		return mmListAccessibleRoutes.funcListAccessibleRoutes(ctx, accessToken, userID, pageToken, filter)
+	}
+	mmListAccessibleRoutes.t.Fatalf("Unexpected call to AccessServiceMock.ListAccessibleRoutes. %v %v %v %v %v", ctx, accessToken, userID, pageToken, filter)
+	return
+}
+
+// ListAccessibleRoutesAfterCounter returns a count of finished AccessServiceMock.ListAccessibleRoutes invocations
+func (mmListAccessibleRoutes *AccessServiceMock) ListAccessibleRoutesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAccessibleRoutes.afterListAccessibleRoutesCounter)
+}
+
+// ListAccessibleRoutesBeforeCounter returns a count of AccessServiceMock.ListAccessibleRoutes invocations
+func (mmListAccessibleRoutes *AccessServiceMock) ListAccessibleRoutesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAccessibleRoutes.beforeListAccessibleRoutesCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.ListAccessibleRoutes.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListAccessibleRoutes *mAccessServiceMockListAccessibleRoutes) Calls() []*AccessServiceMockListAccessibleRoutesParams {
+	mmListAccessibleRoutes.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockListAccessibleRoutesParams, len(mmListAccessibleRoutes.callArgs))
+	copy(argCopy, mmListAccessibleRoutes.callArgs)
+
+	mmListAccessibleRoutes.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListAccessibleRoutesDone returns true if the count of the ListAccessibleRoutes invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockListAccessibleRoutesDone() bool {
+	for _, e := range m.ListAccessibleRoutesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListAccessibleRoutesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListAccessibleRoutesCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListAccessibleRoutes != nil && mm_atomic.LoadUint64(&m.afterListAccessibleRoutesCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockListAccessibleRoutesInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockListAccessibleRoutesInspect() {
+	for _, e := range m.ListAccessibleRoutesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.ListAccessibleRoutes with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListAccessibleRoutesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListAccessibleRoutesCounter) < 1 {
+		if m.ListAccessibleRoutesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.ListAccessibleRoutes")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.ListAccessibleRoutes with params: %#v", *m.ListAccessibleRoutesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListAccessibleRoutes != nil && mm_atomic.LoadUint64(&m.afterListAccessibleRoutesCounter) < 1 {
+		m.t.Error("Expected call to AccessServiceMock.ListAccessibleRoutes")
+	}
+}
+
+type mAccessServiceMockListPrincipalsForRoute struct {
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockListPrincipalsForRouteExpectation
+	expectations       []*AccessServiceMockListPrincipalsForRouteExpectation
+
+	callArgs []*AccessServiceMockListPrincipalsForRouteParams
+	mutex    sync.RWMutex
+}
+
+// AccessServiceMockListPrincipalsForRouteExpectation specifies expectation struct of the AccessService.ListPrincipalsForRoute
+type AccessServiceMockListPrincipalsForRouteExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockListPrincipalsForRouteParams
+	paramPtrs *AccessServiceMockListPrincipalsForRouteParamPtrs
+	results   *AccessServiceMockListPrincipalsForRouteResults
+	Counter   uint64
+}
+
+// AccessServiceMockListPrincipalsForRouteParams contains parameters of the AccessService.ListPrincipalsForRoute
+type AccessServiceMockListPrincipalsForRouteParams struct {
+	ctx         context.Context
+	accessToken string
+	route       string
+	method      string
+	pageToken   string
+	limit       uint64
+}
+
+// AccessServiceMockListPrincipalsForRouteParamPtrs contains pointers to parameters of the AccessService.ListPrincipalsForRoute
+type AccessServiceMockListPrincipalsForRouteParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	route       *string
+	method      *string
+	pageToken   *string
+	limit       *uint64
+}
+
+// AccessServiceMockListPrincipalsForRouteResults contains results of the AccessService.ListPrincipalsForRoute
+type AccessServiceMockListPrincipalsForRouteResults struct {
+	rp1 *model.RoutePrincipals
+	err error
+}
+
+// Expect sets up expected params for AccessService.ListPrincipalsForRoute
+func (mmListPrincipalsForRoute *mAccessServiceMockListPrincipalsForRoute) Expect(ctx context.Context, accessToken string, route string, method string, pageToken string, limit uint64) *mAccessServiceMockListPrincipalsForRoute {
+	if mmListPrincipalsForRoute.mock.funcListPrincipalsForRoute != nil {
+		mmListPrincipalsForRoute.mock.t.Fatalf("AccessServiceMock.ListPrincipalsForRoute mock is already set by Set")
+	}
+
+	if mmListPrincipalsForRoute.defaultExpectation == nil {
+		mmListPrincipalsForRoute.defaultExpectation = &AccessServiceMockListPrincipalsForRouteExpectation{}
+	}
+
+	if mmListPrincipalsForRoute.defaultExpectation.paramPtrs != nil {
+		mmListPrincipalsForRoute.mock.t.Fatalf("AccessServiceMock.ListPrincipalsForRoute mock is already set by ExpectParams functions")
+	}
+
+	mmListPrincipalsForRoute.defaultExpectation.params = &AccessServiceMockListPrincipalsForRouteParams{ctx, accessToken, route, method, pageToken, limit}
+	for _, e := range mmListPrincipalsForRoute.expectations {
+		if minimock.Equal(e.params, mmListPrincipalsForRoute.defaultExpectation.params) {
+			mmListPrincipalsForRoute.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPrincipalsForRoute.defaultExpectation.params)
+		}
+	}
+
+	return mmListPrincipalsForRoute
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.ListPrincipalsForRoute
+func (mmListPrincipalsForRoute *mAccessServiceMockListPrincipalsForRoute) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockListPrincipalsForRoute {
+	if mmListPrincipalsForRoute.mock.funcListPrincipalsForRoute != nil {
+		mmListPrincipalsForRoute.mock.t.Fatalf("AccessServiceMock.ListPrincipalsForRoute mock is already set by Set")
+	}
+
+	if mmListPrincipalsForRoute.defaultExpectation == nil {
+		mmListPrincipalsForRoute.defaultExpectation = &AccessServiceMockListPrincipalsForRouteExpectation{}
+	}
+
+	if mmListPrincipalsForRoute.defaultExpectation.params != nil {
+		mmListPrincipalsForRoute.mock.t.Fatalf("AccessServiceMock.ListPrincipalsForRoute mock is already set by Expect")
+	}
+
+	if mmListPrincipalsForRoute.defaultExpectation.paramPtrs == nil {
+		mmListPrincipalsForRoute.defaultExpectation.paramPtrs = &AccessServiceMockListPrincipalsForRouteParamPtrs{}
+	}
+	mmListPrincipalsForRoute.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListPrincipalsForRoute
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for AccessService.ListPrincipalsForRoute
+func (mmListPrincipalsForRoute *mAccessServiceMockListPrincipalsForRoute) ExpectAccessTokenParam2(accessToken string) *mAccessServiceMockListPrincipalsForRoute {
+	if mmListPrincipalsForRoute.mock.funcListPrincipalsForRoute != nil {
+		mmListPrincipalsForRoute.mock.t.Fatalf("AccessServiceMock.ListPrincipalsForRoute mock is already set by Set")
+	}
+
+	if mmListPrincipalsForRoute.defaultExpectation == nil {
+		mmListPrincipalsForRoute.defaultExpectation = &AccessServiceMockListPrincipalsForRouteExpectation{}
+	}
+
+	if mmListPrincipalsForRoute.defaultExpectation.params != nil {
+		mmListPrincipalsForRoute.mock.t.Fatalf("AccessServiceMock.ListPrincipalsForRoute mock is already set by Expect")
+	}
+
+	if mmListPrincipalsForRoute.defaultExpectation.paramPtrs == nil {
+		mmListPrincipalsForRoute.defaultExpectation.paramPtrs = &AccessServiceMockListPrincipalsForRouteParamPtrs{}
+	}
+	mmListPrincipalsForRoute.defaultExpectation.paramPtrs.accessToken = &accessToken
+
+	return mmListPrincipalsForRoute
+}
+
+// ExpectRouteParam3 sets up expected param route for AccessService.ListPrincipalsForRoute
+func (mmListPrincipalsForRoute *mAccessServiceMockListPrincipalsForRoute) ExpectRouteParam3(route string) *mAccessServiceMockListPrincipalsForRoute {
+	if mmListPrincipalsForRoute.mock.funcListPrincipalsForRoute != nil {
+		mmListPrincipalsForRoute.mock.t.Fatalf("AccessServiceMock.ListPrincipalsForRoute mock is already set by Set")
+	}
+
+	if mmListPrincipalsForRoute.defaultExpectation == nil {
+		mmListPrincipalsForRoute.defaultExpectation = &AccessServiceMockListPrincipalsForRouteExpectation{}
+	}
+
+	if mmListPrincipalsForRoute.defaultExpectation.params != nil {
+		mmListPrincipalsForRoute.mock.t.Fatalf("AccessServiceMock.ListPrincipalsForRoute mock is already set by Expect")
+	}
+
+	if mmListPrincipalsForRoute.defaultExpectation.paramPtrs == nil {
+		mmListPrincipalsForRoute.defaultExpectation.paramPtrs = &AccessServiceMockListPrincipalsForRouteParamPtrs{}
+	}
+	mmListPrincipalsForRoute.defaultExpectation.paramPtrs.route = &route
+
+	return mmListPrincipalsForRoute
+}
+
+// ExpectMethodParam4 sets up expected param method for AccessService.ListPrincipalsForRoute
+func (mmListPrincipalsForRoute *mAccessServiceMockListPrincipalsForRoute) ExpectMethodParam4(method string) *mAccessServiceMockListPrincipalsForRoute {
+	if mmListPrincipalsForRoute.mock.funcListPrincipalsForRoute != nil {
+		mmListPrincipalsForRoute.mock.t.Fatalf("AccessServiceMock.ListPrincipalsForRoute mock is already set by Set")
+	}
+
+	if mmListPrincipalsForRoute.defaultExpectation == nil {
+		mmListPrincipalsForRoute.defaultExpectation = &AccessServiceMockListPrincipalsForRouteExpectation{}
+	}
+
+	if mmListPrincipalsForRoute.defaultExpectation.params != nil {
+		mmListPrincipalsForRoute.mock.t.Fatalf("AccessServiceMock.ListPrincipalsForRoute mock is already set by Expect")
+	}
+
+	if mmListPrincipalsForRoute.defaultExpectation.paramPtrs == nil {
+		mmListPrincipalsForRoute.defaultExpectation.paramPtrs = &AccessServiceMockListPrincipalsForRouteParamPtrs{}
+	}
+	mmListPrincipalsForRoute.defaultExpectation.paramPtrs.method = &method
+
+	return mmListPrincipalsForRoute
+}
+
+// ExpectPageTokenParam5 sets up expected param pageToken for AccessService.ListPrincipalsForRoute
+func (mmListPrincipalsForRoute *mAccessServiceMockListPrincipalsForRoute) ExpectPageTokenParam5(pageToken string) *mAccessServiceMockListPrincipalsForRoute {
+	if mmListPrincipalsForRoute.mock.funcListPrincipalsForRoute != nil {
+		mmListPrincipalsForRoute.mock.t.Fatalf("AccessServiceMock.ListPrincipalsForRoute mock is already set by Set")
+	}
+
+	if mmListPrincipalsForRoute.defaultExpectation == nil {
+		mmListPrincipalsForRoute.defaultExpectation = &AccessServiceMockListPrincipalsForRouteExpectation{}
+	}
+
+	if mmListPrincipalsForRoute.defaultExpectation.params != nil {
+		mmListPrincipalsForRoute.mock.t.Fatalf("AccessServiceMock.ListPrincipalsForRoute mock is already set by Expect")
+	}
+
+	if mmListPrincipalsForRoute.defaultExpectation.paramPtrs == nil {
+		mmListPrincipalsForRoute.defaultExpectation.paramPtrs = &AccessServiceMockListPrincipalsForRouteParamPtrs{}
+	}
+	mmListPrincipalsForRoute.defaultExpectation.paramPtrs.pageToken = &pageToken
+
+	return mmListPrincipalsForRoute
+}
+
+// ExpectLimitParam6 sets up expected param limit for AccessService.ListPrincipalsForRoute
+func (mmListPrincipalsForRoute *mAccessServiceMockListPrincipalsForRoute) ExpectLimitParam6(limit uint64) *mAccessServiceMockListPrincipalsForRoute {
+	if mmListPrincipalsForRoute.mock.funcListPrincipalsForRoute != nil {
+		mmListPrincipalsForRoute.mock.t.Fatalf("AccessServiceMock.ListPrincipalsForRoute mock is already set by Set")
+	}
+
+	if mmListPrincipalsForRoute.defaultExpectation == nil {
+		mmListPrincipalsForRoute.defaultExpectation = &AccessServiceMockListPrincipalsForRouteExpectation{}
+	}
+
+	if mmListPrincipalsForRoute.defaultExpectation.params != nil {
+		mmListPrincipalsForRoute.mock.t.Fatalf("AccessServiceMock.ListPrincipalsForRoute mock is already set by Expect")
+	}
+
+	if mmListPrincipalsForRoute.defaultExpectation.paramPtrs == nil {
+		mmListPrincipalsForRoute.defaultExpectation.paramPtrs = &AccessServiceMockListPrincipalsForRouteParamPtrs{}
+	}
+	mmListPrincipalsForRoute.defaultExpectation.paramPtrs.limit = &limit
+
+	return mmListPrincipalsForRoute
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.ListPrincipalsForRoute
+func (mmListPrincipalsForRoute *mAccessServiceMockListPrincipalsForRoute) Inspect(f func(ctx context.Context, accessToken string, route string, method string, pageToken string, limit uint64)) *mAccessServiceMockListPrincipalsForRoute {
+	if mmListPrincipalsForRoute.mock.inspectFuncListPrincipalsForRoute != nil {
+		mmListPrincipalsForRoute.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.ListPrincipalsForRoute")
+	}
+
+	mmListPrincipalsForRoute.mock.inspectFuncListPrincipalsForRoute = f
+
+	return mmListPrincipalsForRoute
+}
+
+// Return sets up results that will be returned by AccessService.ListPrincipalsForRoute
+func (mmListPrincipalsForRoute *mAccessServiceMockListPrincipalsForRoute) Return(rp1 *model.RoutePrincipals, err error) *AccessServiceMock {
+	if mmListPrincipalsForRoute.mock.funcListPrincipalsForRoute != nil {
+		mmListPrincipalsForRoute.mock.t.Fatalf("AccessServiceMock.ListPrincipalsForRoute mock is already set by Set")
+	}
+
+	if mmListPrincipalsForRoute.defaultExpectation == nil {
+		mmListPrincipalsForRoute.defaultExpectation = &AccessServiceMockListPrincipalsForRouteExpectation{mock: mmListPrincipalsForRoute.mock}
+	}
+	mmListPrincipalsForRoute.defaultExpectation.results = &AccessServiceMockListPrincipalsForRouteResults{rp1, err}
+	return mmListPrincipalsForRoute.mock
+}
+
+// Set uses given function f to mock the AccessService.ListPrincipalsForRoute method
+func (mmListPrincipalsForRoute *mAccessServiceMockListPrincipalsForRoute) Set(f func(ctx context.Context, accessToken string, route string, method string, pageToken string, limit uint64) (rp1 *model.RoutePrincipals, err error)) *AccessServiceMock {
+	if mmListPrincipalsForRoute.defaultExpectation != nil {
+		mmListPrincipalsForRoute.mock.t.Fatalf("Default expectation is already set for the AccessService.ListPrincipalsForRoute method")
+	}
+
+	if len(mmListPrincipalsForRoute.expectations) > 0 {
+		mmListPrincipalsForRoute.mock.t.Fatalf("Some expectations are already set for the AccessService.ListPrincipalsForRoute method")
+	}
+
+	mmListPrincipalsForRoute.mock.funcListPrincipalsForRoute = f
+	return mmListPrincipalsForRoute.mock
+}
+
+// When sets expectation for the AccessService.ListPrincipalsForRoute which will trigger the result defined by the following
+// Then helper
+func (mmListPrincipalsForRoute *mAccessServiceMockListPrincipalsForRoute) When(ctx context.Context, accessToken string, route string, method string, pageToken string, limit uint64) *AccessServiceMockListPrincipalsForRouteExpectation {
+	if mmListPrincipalsForRoute.mock.funcListPrincipalsForRoute != nil {
+		mmListPrincipalsForRoute.mock.t.Fatalf("AccessServiceMock.ListPrincipalsForRoute mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockListPrincipalsForRouteExpectation{
+		mock:   mmListPrincipalsForRoute.mock,
+		params: &AccessServiceMockListPrincipalsForRouteParams{ctx, accessToken, route, method, pageToken, limit},
+	}
+	mmListPrincipalsForRoute.expectations = append(mmListPrincipalsForRoute.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.ListPrincipalsForRoute return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockListPrincipalsForRouteExpectation) Then(rp1 *model.RoutePrincipals, err error) *AccessServiceMock {
+	e.results = &AccessServiceMockListPrincipalsForRouteResults{rp1, err}
+	return e.mock
+}
+
+// ListPrincipalsForRoute implements service.AccessService
+func (mmListPrincipalsForRoute *AccessServiceMock) ListPrincipalsForRoute(ctx context.Context, accessToken string, route string, method string, pageToken string, limit uint64) (rp1 *model.RoutePrincipals, err error) {
+	mm_atomic.AddUint64(&mmListPrincipalsForRoute.beforeListPrincipalsForRouteCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPrincipalsForRoute.afterListPrincipalsForRouteCounter, 1)
+
+	if mmListPrincipalsForRoute.inspectFuncListPrincipalsForRoute != nil {
+		mmListPrincipalsForRoute.inspectFuncListPrincipalsForRoute(ctx, accessToken, route, method, pageToken, limit)
+	}
+
+	mm_params := AccessServiceMockListPrincipalsForRouteParams{ctx, accessToken, route, method, pageToken, limit}
+
+	// Record call args
+	mmListPrincipalsForRoute.ListPrincipalsForRouteMock.mutex.Lock()
+	mmListPrincipalsForRoute.ListPrincipalsForRouteMock.callArgs = append(mmListPrincipalsForRoute.ListPrincipalsForRouteMock.callArgs, &mm_params)
+	mmListPrincipalsForRoute.ListPrincipalsForRouteMock.mutex.Unlock()
+
+	for _, e := range mmListPrincipalsForRoute.ListPrincipalsForRouteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmListPrincipalsForRoute.ListPrincipalsForRouteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPrincipalsForRoute.ListPrincipalsForRouteMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPrincipalsForRoute.ListPrincipalsForRouteMock.defaultExpectation.params
+		mm_want_ptrs := mmListPrincipalsForRoute.ListPrincipalsForRouteMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockListPrincipalsForRouteParams{ctx, accessToken, route, method, pageToken, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPrincipalsForRoute.t.Errorf("AccessServiceMock.ListPrincipalsForRoute got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmListPrincipalsForRoute.t.Errorf("AccessServiceMock.ListPrincipalsForRoute got unexpected parameter accessToken, want: %#v, got: %#v%s\n", *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.route != nil && !minimock.Equal(*mm_want_ptrs.route, mm_got.route) {
+				mmListPrincipalsForRoute.t.Errorf("AccessServiceMock.ListPrincipalsForRoute got unexpected parameter route, want: %#v, got: %#v%s\n", *mm_want_ptrs.route, mm_got.route, minimock.Diff(*mm_want_ptrs.route, mm_got.route))
+			}
+
+			if mm_want_ptrs.method != nil && !minimock.Equal(*mm_want_ptrs.method, mm_got.method) {
+				mmListPrincipalsForRoute.t.Errorf("AccessServiceMock.ListPrincipalsForRoute got unexpected parameter method, want: %#v, got: %#v%s\n", *mm_want_ptrs.method, mm_got.method, minimock.Diff(*mm_want_ptrs.method, mm_got.method))
+			}
+
+			if mm_want_ptrs.pageToken != nil && !minimock.Equal(*mm_want_ptrs.pageToken, mm_got.pageToken) {
+				mmListPrincipalsForRoute.t.Errorf("AccessServiceMock.ListPrincipalsForRoute got unexpected parameter pageToken, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageToken, mm_got.pageToken, minimock.Diff(*mm_want_ptrs.pageToken, mm_got.pageToken))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListPrincipalsForRoute.t.Errorf("AccessServiceMock.ListPrincipalsForRoute got unexpected parameter limit, want: %#v, got: %#v%s\n", *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPrincipalsForRoute.t.Errorf("AccessServiceMock.ListPrincipalsForRoute got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPrincipalsForRoute.ListPrincipalsForRouteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPrincipalsForRoute.t.Fatal("No results are set for the AccessServiceMock.ListPrincipalsForRoute")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmListPrincipalsForRoute.funcListPrincipalsForRoute != nil {
+		return mmListPrincipalsForRoute.funcListPrincipalsForRoute(ctx, accessToken, route, method, pageToken, limit)
+	}
+	mmListPrincipalsForRoute.t.Fatalf("Unexpected call to AccessServiceMock.ListPrincipalsForRoute. %v %v %v %v %v %v", ctx, accessToken, route, method, pageToken, limit)
+	return
+}
+
+// ListPrincipalsForRouteAfterCounter returns a count of finished AccessServiceMock.ListPrincipalsForRoute invocations
+func (mmListPrincipalsForRoute *AccessServiceMock) ListPrincipalsForRouteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPrincipalsForRoute.afterListPrincipalsForRouteCounter)
+}
+
+// ListPrincipalsForRouteBeforeCounter returns a count of AccessServiceMock.ListPrincipalsForRoute invocations
+func (mmListPrincipalsForRoute *AccessServiceMock) ListPrincipalsForRouteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPrincipalsForRoute.beforeListPrincipalsForRouteCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.ListPrincipalsForRoute.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPrincipalsForRoute *mAccessServiceMockListPrincipalsForRoute) Calls() []*AccessServiceMockListPrincipalsForRouteParams {
+	mmListPrincipalsForRoute.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockListPrincipalsForRouteParams, len(mmListPrincipalsForRoute.callArgs))
+	copy(argCopy, mmListPrincipalsForRoute.callArgs)
+
+	mmListPrincipalsForRoute.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPrincipalsForRouteDone returns true if the count of the ListPrincipalsForRoute invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockListPrincipalsForRouteDone() bool {
+	for _, e := range m.ListPrincipalsForRouteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPrincipalsForRouteMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListPrincipalsForRouteCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPrincipalsForRoute != nil && mm_atomic.LoadUint64(&m.afterListPrincipalsForRouteCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockListPrincipalsForRouteInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockListPrincipalsForRouteInspect() {
+	for _, e := range m.ListPrincipalsForRouteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.ListPrincipalsForRoute with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPrincipalsForRouteMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListPrincipalsForRouteCounter) < 1 {
+		if m.ListPrincipalsForRouteMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.ListPrincipalsForRoute")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.ListPrincipalsForRoute with params: %#v", *m.ListPrincipalsForRouteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPrincipalsForRoute != nil && mm_atomic.LoadUint64(&m.afterListPrincipalsForRouteCounter) < 1 {
+		m.t.Error("Expected call to AccessServiceMock.ListPrincipalsForRoute")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AccessServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAuthorizeInspect()
+
+			m.MinimockBatchCheckInspect()
+
+			m.MinimockCheckInspect()
+
+			m.MinimockListAccessibleRoutesInspect()
+
+			m.MinimockListPrincipalsForRouteInspect()
+			m.t.FailNow()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AccessServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AccessServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAuthorizeDone() &&
+		m.MinimockBatchCheckDone() &&
+		m.MinimockCheckDone() &&
+		m.MinimockListAccessibleRoutesDone() &&
+		m.MinimockListPrincipalsForRouteDone()
+}
@@ -0,0 +1,2870 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.8). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/arifullov/auth/internal/service.GroupService -o group_service_minimock.go -n GroupServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// GroupServiceMock implements service.GroupService
+type GroupServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAddMember          func(ctx context.Context, groupID int64, userID int64) (err error)
+	inspectFuncAddMember   func(ctx context.Context, groupID int64, userID int64)
+	afterAddMemberCounter  uint64
+	beforeAddMemberCounter uint64
+	AddMemberMock          mGroupServiceMockAddMember
+
+	funcAddParent          func(ctx context.Context, groupID int64, parentID int64) (err error)
+	inspectFuncAddParent   func(ctx context.Context, groupID int64, parentID int64)
+	afterAddParentCounter  uint64
+	beforeAddParentCounter uint64
+	AddParentMock          mGroupServiceMockAddParent
+
+	funcCreate          func(ctx context.Context, group *model.CreateGroup) (i1 int64, err error)
+	inspectFuncCreate   func(ctx context.Context, group *model.CreateGroup)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mGroupServiceMockCreate
+
+	funcDelete          func(ctx context.Context, id int64) (err error)
+	inspectFuncDelete   func(ctx context.Context, id int64)
+	afterDeleteCounter  uint64
+	beforeDeleteCounter uint64
+	DeleteMock          mGroupServiceMockDelete
+
+	funcGet          func(ctx context.Context, id int64) (gp1 *model.Group, err error)
+	inspectFuncGet   func(ctx context.Context, id int64)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mGroupServiceMockGet
+
+	funcListEffectiveRoles          func(ctx context.Context, userID int64) (ea1 []model.EffectiveRole, err error)
+	inspectFuncListEffectiveRoles   func(ctx context.Context, userID int64)
+	afterListEffectiveRolesCounter  uint64
+	beforeListEffectiveRolesCounter uint64
+	ListEffectiveRolesMock          mGroupServiceMockListEffectiveRoles
+
+	funcRemoveMember          func(ctx context.Context, groupID int64, userID int64) (err error)
+	inspectFuncRemoveMember   func(ctx context.Context, groupID int64, userID int64)
+	afterRemoveMemberCounter  uint64
+	beforeRemoveMemberCounter uint64
+	RemoveMemberMock          mGroupServiceMockRemoveMember
+
+	funcRemoveParent          func(ctx context.Context, groupID int64, parentID int64) (err error)
+	inspectFuncRemoveParent   func(ctx context.Context, groupID int64, parentID int64)
+	afterRemoveParentCounter  uint64
+	beforeRemoveParentCounter uint64
+	RemoveParentMock          mGroupServiceMockRemoveParent
+
+	funcSetRoles          func(ctx context.Context, id int64, roles []model.Role) (err error)
+	inspectFuncSetRoles   func(ctx context.Context, id int64, roles []model.Role)
+	afterSetRolesCounter  uint64
+	beforeSetRolesCounter uint64
+	SetRolesMock          mGroupServiceMockSetRoles
+}
+
+// NewGroupServiceMock returns a mock for service.GroupService
+func NewGroupServiceMock(t minimock.Tester) *GroupServiceMock {
+	m := &GroupServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddMemberMock = mGroupServiceMockAddMember{mock: m}
+	m.AddMemberMock.callArgs = []*GroupServiceMockAddMemberParams{}
+
+	m.AddParentMock = mGroupServiceMockAddParent{mock: m}
+	m.AddParentMock.callArgs = []*GroupServiceMockAddParentParams{}
+
+	m.CreateMock = mGroupServiceMockCreate{mock: m}
+	m.CreateMock.callArgs = []*GroupServiceMockCreateParams{}
+
+	m.DeleteMock = mGroupServiceMockDelete{mock: m}
+	m.DeleteMock.callArgs = []*GroupServiceMockDeleteParams{}
+
+	m.GetMock = mGroupServiceMockGet{mock: m}
+	m.GetMock.callArgs = []*GroupServiceMockGetParams{}
+
+	m.ListEffectiveRolesMock = mGroupServiceMockListEffectiveRoles{mock: m}
+	m.ListEffectiveRolesMock.callArgs = []*GroupServiceMockListEffectiveRolesParams{}
+
+	m.RemoveMemberMock = mGroupServiceMockRemoveMember{mock: m}
+	m.RemoveMemberMock.callArgs = []*GroupServiceMockRemoveMemberParams{}
+
+	m.RemoveParentMock = mGroupServiceMockRemoveParent{mock: m}
+	m.RemoveParentMock.callArgs = []*GroupServiceMockRemoveParentParams{}
+
+	m.SetRolesMock = mGroupServiceMockSetRoles{mock: m}
+	m.SetRolesMock.callArgs = []*GroupServiceMockSetRolesParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mGroupServiceMockAddMember struct {
+	mock               *GroupServiceMock
+	defaultExpectation *GroupServiceMockAddMemberExpectation
+	expectations       []*GroupServiceMockAddMemberExpectation
+
+	callArgs []*GroupServiceMockAddMemberParams
+	mutex    sync.RWMutex
+}
+
+// GroupServiceMockAddMemberExpectation specifies expectation struct of the GroupService.AddMember
+type GroupServiceMockAddMemberExpectation struct {
+	mock      *GroupServiceMock
+	params    *GroupServiceMockAddMemberParams
+	paramPtrs *GroupServiceMockAddMemberParamPtrs
+	results   *GroupServiceMockAddMemberResults
+	Counter   uint64
+}
+
+// GroupServiceMockAddMemberParams contains parameters of the GroupService.AddMember
+type GroupServiceMockAddMemberParams struct {
+	ctx     context.Context
+	groupID int64
+	userID  int64
+}
+
+// GroupServiceMockAddMemberParamPtrs contains pointers to parameters of the GroupService.AddMember
+type GroupServiceMockAddMemberParamPtrs struct {
+	ctx     *context.Context
+	groupID *int64
+	userID  *int64
+}
+
+// GroupServiceMockAddMemberResults contains results of the GroupService.AddMember
+type GroupServiceMockAddMemberResults struct {
+	err error
+}
+
+// Expect sets up expected params for GroupService.AddMember
+func (mmAddMember *mGroupServiceMockAddMember) Expect(ctx context.Context, groupID int64, userID int64) *mGroupServiceMockAddMember {
+	if mmAddMember.mock.funcAddMember != nil {
+		mmAddMember.mock.t.Fatalf("GroupServiceMock.AddMember mock is already set by Set")
+	}
+
+	if mmAddMember.defaultExpectation == nil {
+		mmAddMember.defaultExpectation = &GroupServiceMockAddMemberExpectation{}
+	}
+
+	if mmAddMember.defaultExpectation.paramPtrs != nil {
+		mmAddMember.mock.t.Fatalf("GroupServiceMock.AddMember mock is already set by ExpectParams functions")
+	}
+
+	mmAddMember.defaultExpectation.params = &GroupServiceMockAddMemberParams{ctx, groupID, userID}
+	for _, e := range mmAddMember.expectations {
+		if minimock.Equal(e.params, mmAddMember.defaultExpectation.params) {
+			mmAddMember.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddMember.defaultExpectation.params)
+		}
+	}
+
+	return mmAddMember
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GroupService.AddMember
+func (mmAddMember *mGroupServiceMockAddMember) ExpectCtxParam1(ctx context.Context) *mGroupServiceMockAddMember {
+	if mmAddMember.mock.funcAddMember != nil {
+		mmAddMember.mock.t.Fatalf("GroupServiceMock.AddMember mock is already set by Set")
+	}
+
+	if mmAddMember.defaultExpectation == nil {
+		mmAddMember.defaultExpectation = &GroupServiceMockAddMemberExpectation{}
+	}
+
+	if mmAddMember.defaultExpectation.params != nil {
+		mmAddMember.mock.t.Fatalf("GroupServiceMock.AddMember mock is already set by Expect")
+	}
+
+	if mmAddMember.defaultExpectation.paramPtrs == nil {
+		mmAddMember.defaultExpectation.paramPtrs = &GroupServiceMockAddMemberParamPtrs{}
+	}
+	mmAddMember.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmAddMember
+}
+
+// ExpectGroupIDParam2 sets up expected param groupID for GroupService.AddMember
+func (mmAddMember *mGroupServiceMockAddMember) ExpectGroupIDParam2(groupID int64) *mGroupServiceMockAddMember {
+	if mmAddMember.mock.funcAddMember != nil {
+		mmAddMember.mock.t.Fatalf("GroupServiceMock.AddMember mock is already set by Set")
+	}
+
+	if mmAddMember.defaultExpectation == nil {
+		mmAddMember.defaultExpectation = &GroupServiceMockAddMemberExpectation{}
+	}
+
+	if mmAddMember.defaultExpectation.params != nil {
+		mmAddMember.mock.t.Fatalf("GroupServiceMock.AddMember mock is already set by Expect")
+	}
+
+	if mmAddMember.defaultExpectation.paramPtrs == nil {
+		mmAddMember.defaultExpectation.paramPtrs = &GroupServiceMockAddMemberParamPtrs{}
+	}
+	mmAddMember.defaultExpectation.paramPtrs.groupID = &groupID
+
+	return mmAddMember
+}
+
+// ExpectUserIDParam3 sets up expected param userID for GroupService.AddMember
+func (mmAddMember *mGroupServiceMockAddMember) ExpectUserIDParam3(userID int64) *mGroupServiceMockAddMember {
+	if mmAddMember.mock.funcAddMember != nil {
+		mmAddMember.mock.t.Fatalf("GroupServiceMock.AddMember mock is already set by Set")
+	}
+
+	if mmAddMember.defaultExpectation == nil {
+		mmAddMember.defaultExpectation = &GroupServiceMockAddMemberExpectation{}
+	}
+
+	if mmAddMember.defaultExpectation.params != nil {
+		mmAddMember.mock.t.Fatalf("GroupServiceMock.AddMember mock is already set by Expect")
+	}
+
+	if mmAddMember.defaultExpectation.paramPtrs == nil {
+		mmAddMember.defaultExpectation.paramPtrs = &GroupServiceMockAddMemberParamPtrs{}
+	}
+	mmAddMember.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmAddMember
+}
+
+// Inspect accepts an inspector function that has same arguments as the GroupService.AddMember
+func (mmAddMember *mGroupServiceMockAddMember) Inspect(f func(ctx context.Context, groupID int64, userID int64)) *mGroupServiceMockAddMember {
+	if mmAddMember.mock.inspectFuncAddMember != nil {
+		mmAddMember.mock.t.Fatalf("Inspect function is already set for GroupServiceMock.AddMember")
+	}
+
+	mmAddMember.mock.inspectFuncAddMember = f
+
+	return mmAddMember
+}
+
+// Return sets up results that will be returned by GroupService.AddMember
+func (mmAddMember *mGroupServiceMockAddMember) Return(err error) *GroupServiceMock {
+	if mmAddMember.mock.funcAddMember != nil {
+		mmAddMember.mock.t.Fatalf("GroupServiceMock.AddMember mock is already set by Set")
+	}
+
+	if mmAddMember.defaultExpectation == nil {
+		mmAddMember.defaultExpectation = &GroupServiceMockAddMemberExpectation{mock: mmAddMember.mock}
+	}
+	mmAddMember.defaultExpectation.results = &GroupServiceMockAddMemberResults{err}
+	return mmAddMember.mock
+}
+
+// Set uses given function f to mock the GroupService.AddMember method
+func (mmAddMember *mGroupServiceMockAddMember) Set(f func(ctx context.Context, groupID int64, userID int64) (err error)) *GroupServiceMock {
+	if mmAddMember.defaultExpectation != nil {
+		mmAddMember.mock.t.Fatalf("Default expectation is already set for the GroupService.AddMember method")
+	}
+
+	if len(mmAddMember.expectations) > 0 {
+		mmAddMember.mock.t.Fatalf("Some expectations are already set for the GroupService.AddMember method")
+	}
+
+	mmAddMember.mock.funcAddMember = f
+	return mmAddMember.mock
+}
+
+// When sets expectation for the GroupService.AddMember which will trigger the result defined by the following
+// Then helper
+func (mmAddMember *mGroupServiceMockAddMember) When(ctx context.Context, groupID int64, userID int64) *GroupServiceMockAddMemberExpectation {
+	if mmAddMember.mock.funcAddMember != nil {
+		mmAddMember.mock.t.Fatalf("GroupServiceMock.AddMember mock is already set by Set")
+	}
+
+	expectation := &GroupServiceMockAddMemberExpectation{
+		mock:   mmAddMember.mock,
+		params: &GroupServiceMockAddMemberParams{ctx, groupID, userID},
+	}
+	mmAddMember.expectations = append(mmAddMember.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GroupService.AddMember return parameters for the expectation previously defined by the When method
+func (e *GroupServiceMockAddMemberExpectation) Then(err error) *GroupServiceMock {
+	e.results = &GroupServiceMockAddMemberResults{err}
+	return e.mock
+}
+
+// AddMember implements service.GroupService
+func (mmAddMember *GroupServiceMock) AddMember(ctx context.Context, groupID int64, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmAddMember.beforeAddMemberCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddMember.afterAddMemberCounter, 1)
+
+	if mmAddMember.inspectFuncAddMember != nil {
+		mmAddMember.inspectFuncAddMember(ctx, groupID, userID)
+	}
+
+	mm_params := GroupServiceMockAddMemberParams{ctx, groupID, userID}
+
+	// Record call args
+	mmAddMember.AddMemberMock.mutex.Lock()
+	mmAddMember.AddMemberMock.callArgs = append(mmAddMember.AddMemberMock.callArgs, &mm_params)
+	mmAddMember.AddMemberMock.mutex.Unlock()
+
+	for _, e := range mmAddMember.AddMemberMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddMember.AddMemberMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddMember.AddMemberMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddMember.AddMemberMock.defaultExpectation.params
+		mm_want_ptrs := mmAddMember.AddMemberMock.defaultExpectation.paramPtrs
+
+		mm_got := GroupServiceMockAddMemberParams{ctx, groupID, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddMember.t.Errorf("GroupServiceMock.AddMember got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.groupID != nil && !minimock.Equal(*mm_want_ptrs.groupID, mm_got.groupID) {
+				mmAddMember.t.Errorf("GroupServiceMock.AddMember got unexpected parameter groupID, want: %#v, got: %#v%s\n", *mm_want_ptrs.groupID, mm_got.groupID, minimock.Diff(*mm_want_ptrs.groupID, mm_got.groupID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmAddMember.t.Errorf("GroupServiceMock.AddMember got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddMember.t.Errorf("GroupServiceMock.AddMember got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddMember.AddMemberMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddMember.t.Fatal("No results are set for the GroupServiceMock.AddMember")
+		}
+		return (*mm_results).err
+	}
+	if mmAddMember.funcAddMember != nil {
+		return mmAddMember.funcAddMember(ctx, groupID, userID)
+	}
+	mmAddMember.t.Fatalf("Unexpected call to GroupServiceMock.AddMember. %v %v %v", ctx, groupID, userID)
+	return
+}
+
+// AddMemberAfterCounter returns a count of finished GroupServiceMock.AddMember invocations
+func (mmAddMember *GroupServiceMock) AddMemberAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddMember.afterAddMemberCounter)
+}
+
+// AddMemberBeforeCounter returns a count of GroupServiceMock.AddMember invocations
+func (mmAddMember *GroupServiceMock) AddMemberBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddMember.beforeAddMemberCounter)
+}
+
+// Calls returns a list of arguments used in each call to GroupServiceMock.AddMember.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddMember *mGroupServiceMockAddMember) Calls() []*GroupServiceMockAddMemberParams {
+	mmAddMember.mutex.RLock()
+
+	argCopy := make([]*GroupServiceMockAddMemberParams, len(mmAddMember.callArgs))
+	copy(argCopy, mmAddMember.callArgs)
+
+	mmAddMember.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddMemberDone returns true if the count of the AddMember invocations corresponds
+// the number of defined expectations
+func (m *GroupServiceMock) MinimockAddMemberDone() bool {
+	for _, e := range m.AddMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddMemberMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterAddMemberCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddMember != nil && mm_atomic.LoadUint64(&m.afterAddMemberCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockAddMemberInspect logs each unmet expectation
+func (m *GroupServiceMock) MinimockAddMemberInspect() {
+	for _, e := range m.AddMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GroupServiceMock.AddMember with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddMemberMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterAddMemberCounter) < 1 {
+		if m.AddMemberMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GroupServiceMock.AddMember")
+		} else {
+			m.t.Errorf("Expected call to GroupServiceMock.AddMember with params: %#v", *m.AddMemberMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddMember != nil && mm_atomic.LoadUint64(&m.afterAddMemberCounter) < 1 {
+		m.t.Error("Expected call to GroupServiceMock.AddMember")
+	}
+}
+
+type mGroupServiceMockAddParent struct {
+	mock               *GroupServiceMock
+	defaultExpectation *GroupServiceMockAddParentExpectation
+	expectations       []*GroupServiceMockAddParentExpectation
+
+	callArgs []*GroupServiceMockAddParentParams
+	mutex    sync.RWMutex
+}
+
+// GroupServiceMockAddParentExpectation specifies expectation struct of the GroupService.AddParent
+type GroupServiceMockAddParentExpectation struct {
+	mock      *GroupServiceMock
+	params    *GroupServiceMockAddParentParams
+	paramPtrs *GroupServiceMockAddParentParamPtrs
+	results   *GroupServiceMockAddParentResults
+	Counter   uint64
+}
+
+// GroupServiceMockAddParentParams contains parameters of the GroupService.AddParent
+type GroupServiceMockAddParentParams struct {
+	ctx      context.Context
+	groupID  int64
+	parentID int64
+}
+
+// GroupServiceMockAddParentParamPtrs contains pointers to parameters of the GroupService.AddParent
+type GroupServiceMockAddParentParamPtrs struct {
+	ctx      *context.Context
+	groupID  *int64
+	parentID *int64
+}
+
+// GroupServiceMockAddParentResults contains results of the GroupService.AddParent
+type GroupServiceMockAddParentResults struct {
+	err error
+}
+
+// Expect sets up expected params for GroupService.AddParent
+func (mmAddParent *mGroupServiceMockAddParent) Expect(ctx context.Context, groupID int64, parentID int64) *mGroupServiceMockAddParent {
+	if mmAddParent.mock.funcAddParent != nil {
+		mmAddParent.mock.t.Fatalf("GroupServiceMock.AddParent mock is already set by Set")
+	}
+
+	if mmAddParent.defaultExpectation == nil {
+		mmAddParent.defaultExpectation = &GroupServiceMockAddParentExpectation{}
+	}
+
+	if mmAddParent.defaultExpectation.paramPtrs != nil {
+		mmAddParent.mock.t.Fatalf("GroupServiceMock.AddParent mock is already set by ExpectParams functions")
+	}
+
+	mmAddParent.defaultExpectation.params = &GroupServiceMockAddParentParams{ctx, groupID, parentID}
+	for _, e := range mmAddParent.expectations {
+		if minimock.Equal(e.params, mmAddParent.defaultExpectation.params) {
+			mmAddParent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddParent.defaultExpectation.params)
+		}
+	}
+
+	return mmAddParent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GroupService.AddParent
+func (mmAddParent *mGroupServiceMockAddParent) ExpectCtxParam1(ctx context.Context) *mGroupServiceMockAddParent {
+	if mmAddParent.mock.funcAddParent != nil {
+		mmAddParent.mock.t.Fatalf("GroupServiceMock.AddParent mock is already set by Set")
+	}
+
+	if mmAddParent.defaultExpectation == nil {
+		mmAddParent.defaultExpectation = &GroupServiceMockAddParentExpectation{}
+	}
+
+	if mmAddParent.defaultExpectation.params != nil {
+		mmAddParent.mock.t.Fatalf("GroupServiceMock.AddParent mock is already set by Expect")
+	}
+
+	if mmAddParent.defaultExpectation.paramPtrs == nil {
+		mmAddParent.defaultExpectation.paramPtrs = &GroupServiceMockAddParentParamPtrs{}
+	}
+	mmAddParent.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmAddParent
+}
+
+// ExpectGroupIDParam2 sets up expected param groupID for GroupService.AddParent
+func (mmAddParent *mGroupServiceMockAddParent) ExpectGroupIDParam2(groupID int64) *mGroupServiceMockAddParent {
+	if mmAddParent.mock.funcAddParent != nil {
+		mmAddParent.mock.t.Fatalf("GroupServiceMock.AddParent mock is already set by Set")
+	}
+
+	if mmAddParent.defaultExpectation == nil {
+		mmAddParent.defaultExpectation = &GroupServiceMockAddParentExpectation{}
+	}
+
+	if mmAddParent.defaultExpectation.params != nil {
+		mmAddParent.mock.t.Fatalf("GroupServiceMock.AddParent mock is already set by Expect")
+	}
+
+	if mmAddParent.defaultExpectation.paramPtrs == nil {
+		mmAddParent.defaultExpectation.paramPtrs = &GroupServiceMockAddParentParamPtrs{}
+	}
+	mmAddParent.defaultExpectation.paramPtrs.groupID = &groupID
+
+	return mmAddParent
+}
+
+// ExpectParentIDParam3 sets up expected param parentID for GroupService.AddParent
+func (mmAddParent *mGroupServiceMockAddParent) ExpectParentIDParam3(parentID int64) *mGroupServiceMockAddParent {
+	if mmAddParent.mock.funcAddParent != nil {
+		mmAddParent.mock.t.Fatalf("GroupServiceMock.AddParent mock is already set by Set")
+	}
+
+	if mmAddParent.defaultExpectation == nil {
+		mmAddParent.defaultExpectation = &GroupServiceMockAddParentExpectation{}
+	}
+
+	if mmAddParent.defaultExpectation.params != nil {
+		mmAddParent.mock.t.Fatalf("GroupServiceMock.AddParent mock is already set by Expect")
+	}
+
+	if mmAddParent.defaultExpectation.paramPtrs == nil {
+		mmAddParent.defaultExpectation.paramPtrs = &GroupServiceMockAddParentParamPtrs{}
+	}
+	mmAddParent.defaultExpectation.paramPtrs.parentID = &parentID
+
+	return mmAddParent
+}
+
+// Inspect accepts an inspector function that has same arguments as the GroupService.AddParent
+func (mmAddParent *mGroupServiceMockAddParent) Inspect(f func(ctx context.Context, groupID int64, parentID int64)) *mGroupServiceMockAddParent {
+	if mmAddParent.mock.inspectFuncAddParent != nil {
+		mmAddParent.mock.t.Fatalf("Inspect function is already set for GroupServiceMock.AddParent")
+	}
+
+	mmAddParent.mock.inspectFuncAddParent = f
+
+	return mmAddParent
+}
+
+// Return sets up results that will be returned by GroupService.AddParent
+func (mmAddParent *mGroupServiceMockAddParent) Return(err error) *GroupServiceMock {
+	if mmAddParent.mock.funcAddParent != nil {
+		mmAddParent.mock.t.Fatalf("GroupServiceMock.AddParent mock is already set by Set")
+	}
+
+	if mmAddParent.defaultExpectation == nil {
+		mmAddParent.defaultExpectation = &GroupServiceMockAddParentExpectation{mock: mmAddParent.mock}
+	}
+	mmAddParent.defaultExpectation.results = &GroupServiceMockAddParentResults{err}
+	return mmAddParent.mock
+}
+
+// Set uses given function f to mock the GroupService.AddParent method
+func (mmAddParent *mGroupServiceMockAddParent) Set(f func(ctx context.Context, groupID int64, parentID int64) (err error)) *GroupServiceMock {
+	if mmAddParent.defaultExpectation != nil {
+		mmAddParent.mock.t.Fatalf("Default expectation is already set for the GroupService.AddParent method")
+	}
+
+	if len(mmAddParent.expectations) > 0 {
+		mmAddParent.mock.t.Fatalf("Some expectations are already set for the GroupService.AddParent method")
+	}
+
+	mmAddParent.mock.funcAddParent = f
+	return mmAddParent.mock
+}
+
+// When sets expectation for the GroupService.AddParent which will trigger the result defined by the following
+// Then helper
+func (mmAddParent *mGroupServiceMockAddParent) When(ctx context.Context, groupID int64, parentID int64) *GroupServiceMockAddParentExpectation {
+	if mmAddParent.mock.funcAddParent != nil {
+		mmAddParent.mock.t.Fatalf("GroupServiceMock.AddParent mock is already set by Set")
+	}
+
+	expectation := &GroupServiceMockAddParentExpectation{
+		mock:   mmAddParent.mock,
+		params: &GroupServiceMockAddParentParams{ctx, groupID, parentID},
+	}
+	mmAddParent.expectations = append(mmAddParent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GroupService.AddParent return parameters for the expectation previously defined by the When method
+func (e *GroupServiceMockAddParentExpectation) Then(err error) *GroupServiceMock {
+	e.results = &GroupServiceMockAddParentResults{err}
+	return e.mock
+}
+
+// AddParent implements service.GroupService
+func (mmAddParent *GroupServiceMock) AddParent(ctx context.Context, groupID int64, parentID int64) (err error) {
+	mm_atomic.AddUint64(&mmAddParent.beforeAddParentCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddParent.afterAddParentCounter, 1)
+
+	if mmAddParent.inspectFuncAddParent != nil {
+		mmAddParent.inspectFuncAddParent(ctx, groupID, parentID)
+	}
+
+	mm_params := GroupServiceMockAddParentParams{ctx, groupID, parentID}
+
+	// Record call args
+	mmAddParent.AddParentMock.mutex.Lock()
+	mmAddParent.AddParentMock.callArgs = append(mmAddParent.AddParentMock.callArgs, &mm_params)
+	mmAddParent.AddParentMock.mutex.Unlock()
+
+	for _, e := range mmAddParent.AddParentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddParent.AddParentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddParent.AddParentMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddParent.AddParentMock.defaultExpectation.params
+		mm_want_ptrs := mmAddParent.AddParentMock.defaultExpectation.paramPtrs
+
+		mm_got := GroupServiceMockAddParentParams{ctx, groupID, parentID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddParent.t.Errorf("GroupServiceMock.AddParent got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.groupID != nil && !minimock.Equal(*mm_want_ptrs.groupID, mm_got.groupID) {
+				mmAddParent.t.Errorf("GroupServiceMock.AddParent got unexpected parameter groupID, want: %#v, got: %#v%s\n", *mm_want_ptrs.groupID, mm_got.groupID, minimock.Diff(*mm_want_ptrs.groupID, mm_got.groupID))
+			}
+
+			if mm_want_ptrs.parentID != nil && !minimock.Equal(*mm_want_ptrs.parentID, mm_got.parentID) {
+				mmAddParent.t.Errorf("GroupServiceMock.AddParent got unexpected parameter parentID, want: %#v, got: %#v%s\n", *mm_want_ptrs.parentID, mm_got.parentID, minimock.Diff(*mm_want_ptrs.parentID, mm_got.parentID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddParent.t.Errorf("GroupServiceMock.AddParent got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddParent.AddParentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddParent.t.Fatal("No results are set for the GroupServiceMock.AddParent")
+		}
+		return (*mm_results).err
+	}
+	if mmAddParent.funcAddParent != nil {
+		return mmAddParent.funcAddParent(ctx, groupID, parentID)
+	}
+	mmAddParent.t.Fatalf("Unexpected call to GroupServiceMock.AddParent. %v %v %v", ctx, groupID, parentID)
+	return
+}
+
+// AddParentAfterCounter returns a count of finished GroupServiceMock.AddParent invocations
+func (mmAddParent *GroupServiceMock) AddParentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddParent.afterAddParentCounter)
+}
+
+// AddParentBeforeCounter returns a count of GroupServiceMock.AddParent invocations
+func (mmAddParent *GroupServiceMock) AddParentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddParent.beforeAddParentCounter)
+}
+
+// Calls returns a list of arguments used in each call to GroupServiceMock.AddParent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddParent *mGroupServiceMockAddParent) Calls() []*GroupServiceMockAddParentParams {
+	mmAddParent.mutex.RLock()
+
+	argCopy := make([]*GroupServiceMockAddParentParams, len(mmAddParent.callArgs))
+	copy(argCopy, mmAddParent.callArgs)
+
+	mmAddParent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddParentDone returns true if the count of the AddParent invocations corresponds
+// the number of defined expectations
+func (m *GroupServiceMock) MinimockAddParentDone() bool {
+	for _, e := range m.AddParentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddParentMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterAddParentCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddParent != nil && mm_atomic.LoadUint64(&m.afterAddParentCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockAddParentInspect logs each unmet expectation
+func (m *GroupServiceMock) MinimockAddParentInspect() {
+	for _, e := range m.AddParentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GroupServiceMock.AddParent with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddParentMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterAddParentCounter) < 1 {
+		if m.AddParentMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GroupServiceMock.AddParent")
+		} else {
+			m.t.Errorf("Expected call to GroupServiceMock.AddParent with params: %#v", *m.AddParentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddParent != nil && mm_atomic.LoadUint64(&m.afterAddParentCounter) < 1 {
+		m.t.Error("Expected call to GroupServiceMock.AddParent")
+	}
+}
+
+type mGroupServiceMockCreate struct {
+	mock               *GroupServiceMock
+	defaultExpectation *GroupServiceMockCreateExpectation
+	expectations       []*GroupServiceMockCreateExpectation
+
+	callArgs []*GroupServiceMockCreateParams
+	mutex    sync.RWMutex
+}
+
+// GroupServiceMockCreateExpectation specifies expectation struct of the GroupService.Create
+type GroupServiceMockCreateExpectation struct {
+	mock      *GroupServiceMock
+	params    *GroupServiceMockCreateParams
+	paramPtrs *GroupServiceMockCreateParamPtrs
+	results   *GroupServiceMockCreateResults
+	Counter   uint64
+}
+
+// GroupServiceMockCreateParams contains parameters of the GroupService.Create
+type GroupServiceMockCreateParams struct {
+	ctx   context.Context
+	group *model.CreateGroup
+}
+
+// GroupServiceMockCreateParamPtrs contains pointers to parameters of the GroupService.Create
+type GroupServiceMockCreateParamPtrs struct {
+	ctx   *context.Context
+	group **model.CreateGroup
+}
+
+// GroupServiceMockCreateResults contains results of the GroupService.Create
+type GroupServiceMockCreateResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for GroupService.Create
+func (mmCreate *mGroupServiceMockCreate) Expect(ctx context.Context, group *model.CreateGroup) *mGroupServiceMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("GroupServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &GroupServiceMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("GroupServiceMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &GroupServiceMockCreateParams{ctx, group}
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GroupService.Create
+func (mmCreate *mGroupServiceMockCreate) ExpectCtxParam1(ctx context.Context) *mGroupServiceMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("GroupServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &GroupServiceMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("GroupServiceMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &GroupServiceMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreate
+}
+
+// ExpectGroupParam2 sets up expected param group for GroupService.Create
+func (mmCreate *mGroupServiceMockCreate) ExpectGroupParam2(group *model.CreateGroup) *mGroupServiceMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("GroupServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &GroupServiceMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("GroupServiceMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &GroupServiceMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.group = &group
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the GroupService.Create
+func (mmCreate *mGroupServiceMockCreate) Inspect(f func(ctx context.Context, group *model.CreateGroup)) *mGroupServiceMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for GroupServiceMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by GroupService.Create
+func (mmCreate *mGroupServiceMockCreate) Return(i1 int64, err error) *GroupServiceMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("GroupServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &GroupServiceMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &GroupServiceMockCreateResults{i1, err}
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the GroupService.Create method
+func (mmCreate *mGroupServiceMockCreate) Set(f func(ctx context.Context, group *model.CreateGroup) (i1 int64, err error)) *GroupServiceMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the GroupService.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the GroupService.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	return mmCreate.mock
+}
+
+// When sets expectation for the GroupService.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mGroupServiceMockCreate) When(ctx context.Context, group *model.CreateGroup) *GroupServiceMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("GroupServiceMock.Create mock is already set by Set")
+	}
+
+	expectation := &GroupServiceMockCreateExpectation{
+		mock:   mmCreate.mock,
+		params: &GroupServiceMockCreateParams{ctx, group},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GroupService.Create return parameters for the expectation previously defined by the When method
+func (e *GroupServiceMockCreateExpectation) Then(i1 int64, err error) *GroupServiceMock {
+	e.results = &GroupServiceMockCreateResults{i1, err}
+	return e.mock
+}
+
+// Create implements service.GroupService
+func (mmCreate *GroupServiceMock) Create(ctx context.Context, group *model.CreateGroup) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, group)
+	}
+
+	mm_params := GroupServiceMockCreateParams{ctx, group}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := GroupServiceMockCreateParams{ctx, group}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("GroupServiceMock.Create got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.group != nil && !minimock.Equal(*mm_want_ptrs.group, mm_got.group) {
+				mmCreate.t.Errorf("GroupServiceMock.Create got unexpected parameter group, want: %#v, got: %#v%s\n", *mm_want_ptrs.group, mm_got.group, minimock.Diff(*mm_want_ptrs.group, mm_got.group))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("GroupServiceMock.Create got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the GroupServiceMock.Create")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, group)
+	}
+	mmCreate.t.Fatalf("Unexpected call to GroupServiceMock.Create. %v %v", ctx, group)
+	return
+}
+
+// CreateAfterCounter returns a count of finished GroupServiceMock.Create invocations
+func (mmCreate *GroupServiceMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of GroupServiceMock.Create invocations
+func (mmCreate *GroupServiceMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to GroupServiceMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mGroupServiceMockCreate) Calls() []*GroupServiceMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*GroupServiceMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *GroupServiceMock) MinimockCreateDone() bool {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *GroupServiceMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GroupServiceMock.Create with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GroupServiceMock.Create")
+		} else {
+			m.t.Errorf("Expected call to GroupServiceMock.Create with params: %#v", *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		m.t.Error("Expected call to GroupServiceMock.Create")
+	}
+}
+
+type mGroupServiceMockDelete struct {
+	mock               *GroupServiceMock
+	defaultExpectation *GroupServiceMockDeleteExpectation
+	expectations       []*GroupServiceMockDeleteExpectation
+
+	callArgs []*GroupServiceMockDeleteParams
+	mutex    sync.RWMutex
+}
+
+// GroupServiceMockDeleteExpectation specifies expectation struct of the GroupService.Delete
+type GroupServiceMockDeleteExpectation struct {
+	mock      *GroupServiceMock
+	params    *GroupServiceMockDeleteParams
+	paramPtrs *GroupServiceMockDeleteParamPtrs
+	results   *GroupServiceMockDeleteResults
+	Counter   uint64
+}
+
+// GroupServiceMockDeleteParams contains parameters of the GroupService.Delete
+type GroupServiceMockDeleteParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// GroupServiceMockDeleteParamPtrs contains pointers to parameters of the GroupService.Delete
+type GroupServiceMockDeleteParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// GroupServiceMockDeleteResults contains results of the GroupService.Delete
+type GroupServiceMockDeleteResults struct {
+	err error
+}
+
+// Expect sets up expected params for GroupService.Delete
+func (mmDelete *mGroupServiceMockDelete) Expect(ctx context.Context, id int64) *mGroupServiceMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("GroupServiceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &GroupServiceMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("GroupServiceMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &GroupServiceMockDeleteParams{ctx, id}
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GroupService.Delete
+func (mmDelete *mGroupServiceMockDelete) ExpectCtxParam1(ctx context.Context) *mGroupServiceMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("GroupServiceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &GroupServiceMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("GroupServiceMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &GroupServiceMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDelete
+}
+
+// ExpectIdParam2 sets up expected param id for GroupService.Delete
+func (mmDelete *mGroupServiceMockDelete) ExpectIdParam2(id int64) *mGroupServiceMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("GroupServiceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &GroupServiceMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("GroupServiceMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &GroupServiceMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.id = &id
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the GroupService.Delete
+func (mmDelete *mGroupServiceMockDelete) Inspect(f func(ctx context.Context, id int64)) *mGroupServiceMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for GroupServiceMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by GroupService.Delete
+func (mmDelete *mGroupServiceMockDelete) Return(err error) *GroupServiceMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("GroupServiceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &GroupServiceMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &GroupServiceMockDeleteResults{err}
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the GroupService.Delete method
+func (mmDelete *mGroupServiceMockDelete) Set(f func(ctx context.Context, id int64) (err error)) *GroupServiceMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the GroupService.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the GroupService.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	return mmDelete.mock
+}
+
+// When sets expectation for the GroupService.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mGroupServiceMockDelete) When(ctx context.Context, id int64) *GroupServiceMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("GroupServiceMock.Delete mock is already set by Set")
+	}
+
+	expectation := &GroupServiceMockDeleteExpectation{
+		mock:   mmDelete.mock,
+		params: &GroupServiceMockDeleteParams{ctx, id},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GroupService.Delete return parameters for the expectation previously defined by the When method
+func (e *GroupServiceMockDeleteExpectation) Then(err error) *GroupServiceMock {
+	e.results = &GroupServiceMockDeleteResults{err}
+	return e.mock
+}
+
+// Delete implements service.GroupService
+func (mmDelete *GroupServiceMock) Delete(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, id)
+	}
+
+	mm_params := GroupServiceMockDeleteParams{ctx, id}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := GroupServiceMockDeleteParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("GroupServiceMock.Delete got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDelete.t.Errorf("GroupServiceMock.Delete got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("GroupServiceMock.Delete got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the GroupServiceMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, id)
+	}
+	mmDelete.t.Fatalf("Unexpected call to GroupServiceMock.Delete. %v %v", ctx, id)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished GroupServiceMock.Delete invocations
+func (mmDelete *GroupServiceMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of GroupServiceMock.Delete invocations
+func (mmDelete *GroupServiceMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to GroupServiceMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mGroupServiceMockDelete) Calls() []*GroupServiceMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*GroupServiceMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *GroupServiceMock) MinimockDeleteDone() bool {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && mm_atomic.LoadUint64(&m.afterDeleteCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *GroupServiceMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GroupServiceMock.Delete with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteCounter) < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GroupServiceMock.Delete")
+		} else {
+			m.t.Errorf("Expected call to GroupServiceMock.Delete with params: %#v", *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && mm_atomic.LoadUint64(&m.afterDeleteCounter) < 1 {
+		m.t.Error("Expected call to GroupServiceMock.Delete")
+	}
+}
+
+type mGroupServiceMockGet struct {
+	mock               *GroupServiceMock
+	defaultExpectation *GroupServiceMockGetExpectation
+	expectations       []*GroupServiceMockGetExpectation
+
+	callArgs []*GroupServiceMockGetParams
+	mutex    sync.RWMutex
+}
+
+// GroupServiceMockGetExpectation specifies expectation struct of the GroupService.Get
+type GroupServiceMockGetExpectation struct {
+	mock      *GroupServiceMock
+	params    *GroupServiceMockGetParams
+	paramPtrs *GroupServiceMockGetParamPtrs
+	results   *GroupServiceMockGetResults
+	Counter   uint64
+}
+
+// GroupServiceMockGetParams contains parameters of the GroupService.Get
+type GroupServiceMockGetParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// GroupServiceMockGetParamPtrs contains pointers to parameters of the GroupService.Get
+type GroupServiceMockGetParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// GroupServiceMockGetResults contains results of the GroupService.Get
+type GroupServiceMockGetResults struct {
+	gp1 *model.Group
+	err error
+}
+
+// Expect sets up expected params for GroupService.Get
+func (mmGet *mGroupServiceMockGet) Expect(ctx context.Context, id int64) *mGroupServiceMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("GroupServiceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &GroupServiceMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("GroupServiceMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &GroupServiceMockGetParams{ctx, id}
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GroupService.Get
+func (mmGet *mGroupServiceMockGet) ExpectCtxParam1(ctx context.Context) *mGroupServiceMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("GroupServiceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &GroupServiceMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("GroupServiceMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &GroupServiceMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGet
+}
+
+// ExpectIdParam2 sets up expected param id for GroupService.Get
+func (mmGet *mGroupServiceMockGet) ExpectIdParam2(id int64) *mGroupServiceMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("GroupServiceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &GroupServiceMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("GroupServiceMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &GroupServiceMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.id = &id
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the GroupService.Get
+func (mmGet *mGroupServiceMockGet) Inspect(f func(ctx context.Context, id int64)) *mGroupServiceMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for GroupServiceMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by GroupService.Get
+func (mmGet *mGroupServiceMockGet) Return(gp1 *model.Group, err error) *GroupServiceMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("GroupServiceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &GroupServiceMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &GroupServiceMockGetResults{gp1, err}
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the GroupService.Get method
+func (mmGet *mGroupServiceMockGet) Set(f func(ctx context.Context, id int64) (gp1 *model.Group, err error)) *GroupServiceMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the GroupService.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the GroupService.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	return mmGet.mock
+}
+
+// When sets expectation for the GroupService.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mGroupServiceMockGet) When(ctx context.Context, id int64) *GroupServiceMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("GroupServiceMock.Get mock is already set by Set")
+	}
+
+	expectation := &GroupServiceMockGetExpectation{
+		mock:   mmGet.mock,
+		params: &GroupServiceMockGetParams{ctx, id},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GroupService.Get return parameters for the expectation previously defined by the When method
+func (e *GroupServiceMockGetExpectation) Then(gp1 *model.Group, err error) *GroupServiceMock {
+	e.results = &GroupServiceMockGetResults{gp1, err}
+	return e.mock
+}
+
+// Get implements service.GroupService
+func (mmGet *GroupServiceMock) Get(ctx context.Context, id int64) (gp1 *model.Group, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, id)
+	}
+
+	mm_params := GroupServiceMockGetParams{ctx, id}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.gp1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := GroupServiceMockGetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("GroupServiceMock.Get got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGet.t.Errorf("GroupServiceMock.Get got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("GroupServiceMock.Get got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the GroupServiceMock.Get")
+		}
+		return (*mm_results).gp1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, id)
+	}
+	mmGet.t.Fatalf("Unexpected call to GroupServiceMock.Get. %v %v", ctx, id)
+	return
+}
+
+// GetAfterCounter returns a count of finished GroupServiceMock.Get invocations
+func (mmGet *GroupServiceMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of GroupServiceMock.Get invocations
+func (mmGet *GroupServiceMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to GroupServiceMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mGroupServiceMockGet) Calls() []*GroupServiceMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*GroupServiceMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *GroupServiceMock) MinimockGetDone() bool {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && mm_atomic.LoadUint64(&m.afterGetCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *GroupServiceMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GroupServiceMock.Get with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetCounter) < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GroupServiceMock.Get")
+		} else {
+			m.t.Errorf("Expected call to GroupServiceMock.Get with params: %#v", *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && mm_atomic.LoadUint64(&m.afterGetCounter) < 1 {
+		m.t.Error("Expected call to GroupServiceMock.Get")
+	}
+}
+
+type mGroupServiceMockListEffectiveRoles struct {
+	mock               *GroupServiceMock
+	defaultExpectation *GroupServiceMockListEffectiveRolesExpectation
+	expectations       []*GroupServiceMockListEffectiveRolesExpectation
+
+	callArgs []*GroupServiceMockListEffectiveRolesParams
+	mutex    sync.RWMutex
+}
+
+// GroupServiceMockListEffectiveRolesExpectation specifies expectation struct of the GroupService.ListEffectiveRoles
+type GroupServiceMockListEffectiveRolesExpectation struct {
+	mock      *GroupServiceMock
+	params    *GroupServiceMockListEffectiveRolesParams
+	paramPtrs *GroupServiceMockListEffectiveRolesParamPtrs
+	results   *GroupServiceMockListEffectiveRolesResults
+	Counter   uint64
+}
+
+// GroupServiceMockListEffectiveRolesParams contains parameters of the GroupService.ListEffectiveRoles
+type GroupServiceMockListEffectiveRolesParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// GroupServiceMockListEffectiveRolesParamPtrs contains pointers to parameters of the GroupService.ListEffectiveRoles
+type GroupServiceMockListEffectiveRolesParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// GroupServiceMockListEffectiveRolesResults contains results of the GroupService.ListEffectiveRoles
+type GroupServiceMockListEffectiveRolesResults struct {
+	ea1 []model.EffectiveRole
+	err error
+}
+
+// Expect sets up expected params for GroupService.ListEffectiveRoles
+func (mmListEffectiveRoles *mGroupServiceMockListEffectiveRoles) Expect(ctx context.Context, userID int64) *mGroupServiceMockListEffectiveRoles {
+	if mmListEffectiveRoles.mock.funcListEffectiveRoles != nil {
+		mmListEffectiveRoles.mock.t.Fatalf("GroupServiceMock.ListEffectiveRoles mock is already set by Set")
+	}
+
+	if mmListEffectiveRoles.defaultExpectation == nil {
+		mmListEffectiveRoles.defaultExpectation = &GroupServiceMockListEffectiveRolesExpectation{}
+	}
+
+	if mmListEffectiveRoles.defaultExpectation.paramPtrs != nil {
+		mmListEffectiveRoles.mock.t.Fatalf("GroupServiceMock.ListEffectiveRoles mock is already set by ExpectParams functions")
+	}
+
+	mmListEffectiveRoles.defaultExpectation.params = &GroupServiceMockListEffectiveRolesParams{ctx, userID}
+	for _, e := range mmListEffectiveRoles.expectations {
+		if minimock.Equal(e.params, mmListEffectiveRoles.defaultExpectation.params) {
+			mmListEffectiveRoles.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListEffectiveRoles.defaultExpectation.params)
+		}
+	}
+
+	return mmListEffectiveRoles
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GroupService.ListEffectiveRoles
+func (mmListEffectiveRoles *mGroupServiceMockListEffectiveRoles) ExpectCtxParam1(ctx context.Context) *mGroupServiceMockListEffectiveRoles {
+	if mmListEffectiveRoles.mock.funcListEffectiveRoles != nil {
+		mmListEffectiveRoles.mock.t.Fatalf("GroupServiceMock.ListEffectiveRoles mock is already set by Set")
+	}
+
+	if mmListEffectiveRoles.defaultExpectation == nil {
+		mmListEffectiveRoles.defaultExpectation = &GroupServiceMockListEffectiveRolesExpectation{}
+	}
+
+	if mmListEffectiveRoles.defaultExpectation.params != nil {
+		mmListEffectiveRoles.mock.t.Fatalf("GroupServiceMock.ListEffectiveRoles mock is already set by Expect")
+	}
+
+	if mmListEffectiveRoles.defaultExpectation.paramPtrs == nil {
+		mmListEffectiveRoles.defaultExpectation.paramPtrs = &GroupServiceMockListEffectiveRolesParamPtrs{}
+	}
+	mmListEffectiveRoles.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListEffectiveRoles
+}
+
+// ExpectUserIDParam2 sets up expected param userID for GroupService.ListEffectiveRoles
+func (mmListEffectiveRoles *mGroupServiceMockListEffectiveRoles) ExpectUserIDParam2(userID int64) *mGroupServiceMockListEffectiveRoles {
+	if mmListEffectiveRoles.mock.funcListEffectiveRoles != nil {
+		mmListEffectiveRoles.mock.t.Fatalf("GroupServiceMock.ListEffectiveRoles mock is already set by Set")
+	}
+
+	if mmListEffectiveRoles.defaultExpectation == nil {
+		mmListEffectiveRoles.defaultExpectation = &GroupServiceMockListEffectiveRolesExpectation{}
+	}
+
+	if mmListEffectiveRoles.defaultExpectation.params != nil {
+		mmListEffectiveRoles.mock.t.Fatalf("GroupServiceMock.ListEffectiveRoles mock is already set by Expect")
+	}
+
+	if mmListEffectiveRoles.defaultExpectation.paramPtrs == nil {
+		mmListEffectiveRoles.defaultExpectation.paramPtrs = &GroupServiceMockListEffectiveRolesParamPtrs{}
+	}
+	mmListEffectiveRoles.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmListEffectiveRoles
+}
+
+// Inspect accepts an inspector function that has same arguments as the GroupService.ListEffectiveRoles
+func (mmListEffectiveRoles *mGroupServiceMockListEffectiveRoles) Inspect(f func(ctx context.Context, userID int64)) *mGroupServiceMockListEffectiveRoles {
+	if mmListEffectiveRoles.mock.inspectFuncListEffectiveRoles != nil {
+		mmListEffectiveRoles.mock.t.Fatalf("Inspect function is already set for GroupServiceMock.ListEffectiveRoles")
+	}
+
+	mmListEffectiveRoles.mock.inspectFuncListEffectiveRoles = f
+
+	return mmListEffectiveRoles
+}
+
+// Return sets up results that will be returned by GroupService.ListEffectiveRoles
+func (mmListEffectiveRoles *mGroupServiceMockListEffectiveRoles) Return(ea1 []model.EffectiveRole, err error) *GroupServiceMock {
+	if mmListEffectiveRoles.mock.funcListEffectiveRoles != nil {
+		mmListEffectiveRoles.mock.t.Fatalf("GroupServiceMock.ListEffectiveRoles mock is already set by Set")
+	}
+
+	if mmListEffectiveRoles.defaultExpectation == nil {
+		mmListEffectiveRoles.defaultExpectation = &GroupServiceMockListEffectiveRolesExpectation{mock: mmListEffectiveRoles.mock}
+	}
+	mmListEffectiveRoles.defaultExpectation.results = &GroupServiceMockListEffectiveRolesResults{ea1, err}
+	return mmListEffectiveRoles.mock
+}
+
+// Set uses given function f to mock the GroupService.ListEffectiveRoles method
+func (mmListEffectiveRoles *mGroupServiceMockListEffectiveRoles) Set(f func(ctx context.Context, userID int64) (ea1 []model.EffectiveRole, err error)) *GroupServiceMock {
+	if mmListEffectiveRoles.defaultExpectation != nil {
+		mmListEffectiveRoles.mock.t.Fatalf("Default expectation is already set for the GroupService.ListEffectiveRoles method")
+	}
+
+	if len(mmListEffectiveRoles.expectations) > 0 {
+		mmListEffectiveRoles.mock.t.Fatalf("Some expectations are already set for the GroupService.ListEffectiveRoles method")
+	}
+
+	mmListEffectiveRoles.mock.funcListEffectiveRoles = f
+	return mmListEffectiveRoles.mock
+}
+
+// When sets expectation for the GroupService.ListEffectiveRoles which will trigger the result defined by the following
+// Then helper
+func (mmListEffectiveRoles *mGroupServiceMockListEffectiveRoles) When(ctx context.Context, userID int64) *GroupServiceMockListEffectiveRolesExpectation {
+	if mmListEffectiveRoles.mock.funcListEffectiveRoles != nil {
+		mmListEffectiveRoles.mock.t.Fatalf("GroupServiceMock.ListEffectiveRoles mock is already set by Set")
+	}
+
+	expectation := &GroupServiceMockListEffectiveRolesExpectation{
+		mock:   mmListEffectiveRoles.mock,
+		params: &GroupServiceMockListEffectiveRolesParams{ctx, userID},
+	}
+	mmListEffectiveRoles.expectations = append(mmListEffectiveRoles.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GroupService.ListEffectiveRoles return parameters for the expectation previously defined by the When method
+func (e *GroupServiceMockListEffectiveRolesExpectation) Then(ea1 []model.EffectiveRole, err error) *GroupServiceMock {
+	e.results = &GroupServiceMockListEffectiveRolesResults{ea1, err}
+	return e.mock
+}
+
+// ListEffectiveRoles implements service.GroupService
+func (mmListEffectiveRoles *GroupServiceMock) ListEffectiveRoles(ctx context.Context, userID int64) (ea1 []model.EffectiveRole, err error) {
+	mm_atomic.AddUint64(&mmListEffectiveRoles.beforeListEffectiveRolesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListEffectiveRoles.afterListEffectiveRolesCounter, 1)
+
+	if mmListEffectiveRoles.inspectFuncListEffectiveRoles != nil {
+		mmListEffectiveRoles.inspectFuncListEffectiveRoles(ctx, userID)
+	}
+
+	mm_params := GroupServiceMockListEffectiveRolesParams{ctx, userID}
+
+	// Record call args
+	mmListEffectiveRoles.ListEffectiveRolesMock.mutex.Lock()
+	mmListEffectiveRoles.ListEffectiveRolesMock.callArgs = append(mmListEffectiveRoles.ListEffectiveRolesMock.callArgs, &mm_params)
+	mmListEffectiveRoles.ListEffectiveRolesMock.mutex.Unlock()
+
+	for _, e := range mmListEffectiveRoles.ListEffectiveRolesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ea1, e.results.err
+		}
+	}
+
+	if mmListEffectiveRoles.ListEffectiveRolesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListEffectiveRoles.ListEffectiveRolesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListEffectiveRoles.ListEffectiveRolesMock.defaultExpectation.params
+		mm_want_ptrs := mmListEffectiveRoles.ListEffectiveRolesMock.defaultExpectation.paramPtrs
+
+		mm_got := GroupServiceMockListEffectiveRolesParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListEffectiveRoles.t.Errorf("GroupServiceMock.ListEffectiveRoles got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListEffectiveRoles.t.Errorf("GroupServiceMock.ListEffectiveRoles got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListEffectiveRoles.t.Errorf("GroupServiceMock.ListEffectiveRoles got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListEffectiveRoles.ListEffectiveRolesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListEffectiveRoles.t.Fatal("No results are set for the GroupServiceMock.ListEffectiveRoles")
+		}
+		return (*mm_results).ea1, (*mm_results).err
+	}
+	if mmListEffectiveRoles.funcListEffectiveRoles != nil {
+		return mmListEffectiveRoles.funcListEffectiveRoles(ctx, userID)
+	}
+	mmListEffectiveRoles.t.Fatalf("Unexpected call to GroupServiceMock.ListEffectiveRoles. %v %v", ctx, userID)
+	return
+}
+
+// ListEffectiveRolesAfterCounter returns a count of finished GroupServiceMock.ListEffectiveRoles invocations
+func (mmListEffectiveRoles *GroupServiceMock) ListEffectiveRolesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListEffectiveRoles.afterListEffectiveRolesCounter)
+}
+
+// ListEffectiveRolesBeforeCounter returns a count of GroupServiceMock.ListEffectiveRoles invocations
+func (mmListEffectiveRoles *GroupServiceMock) ListEffectiveRolesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListEffectiveRoles.beforeListEffectiveRolesCounter)
+}
+
+// Calls returns a list of arguments used in each call to GroupServiceMock.ListEffectiveRoles.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListEffectiveRoles *mGroupServiceMockListEffectiveRoles) Calls() []*GroupServiceMockListEffectiveRolesParams {
+	mmListEffectiveRoles.mutex.RLock()
+
+	argCopy := make([]*GroupServiceMockListEffectiveRolesParams, len(mmListEffectiveRoles.callArgs))
+	copy(argCopy, mmListEffectiveRoles.callArgs)
+
+	mmListEffectiveRoles.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListEffectiveRolesDone returns true if the count of the ListEffectiveRoles invocations corresponds
+// the number of defined expectations
+func (m *GroupServiceMock) MinimockListEffectiveRolesDone() bool {
+	for _, e := range m.ListEffectiveRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListEffectiveRolesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListEffectiveRolesCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListEffectiveRoles != nil && mm_atomic.LoadUint64(&m.afterListEffectiveRolesCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockListEffectiveRolesInspect logs each unmet expectation
+func (m *GroupServiceMock) MinimockListEffectiveRolesInspect() {
+	for _, e := range m.ListEffectiveRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GroupServiceMock.ListEffectiveRoles with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListEffectiveRolesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListEffectiveRolesCounter) < 1 {
+		if m.ListEffectiveRolesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GroupServiceMock.ListEffectiveRoles")
+		} else {
+			m.t.Errorf("Expected call to GroupServiceMock.ListEffectiveRoles with params: %#v", *m.ListEffectiveRolesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListEffectiveRoles != nil && mm_atomic.LoadUint64(&m.afterListEffectiveRolesCounter) < 1 {
+		m.t.Error("Expected call to GroupServiceMock.ListEffectiveRoles")
+	}
+}
+
+type mGroupServiceMockRemoveMember struct {
+	mock               *GroupServiceMock
+	defaultExpectation *GroupServiceMockRemoveMemberExpectation
+	expectations       []*GroupServiceMockRemoveMemberExpectation
+
+	callArgs []*GroupServiceMockRemoveMemberParams
+	mutex    sync.RWMutex
+}
+
+// GroupServiceMockRemoveMemberExpectation specifies expectation struct of the GroupService.RemoveMember
+type GroupServiceMockRemoveMemberExpectation struct {
+	mock      *GroupServiceMock
+	params    *GroupServiceMockRemoveMemberParams
+	paramPtrs *GroupServiceMockRemoveMemberParamPtrs
+	results   *GroupServiceMockRemoveMemberResults
+	Counter   uint64
+}
+
+// GroupServiceMockRemoveMemberParams contains parameters of the GroupService.RemoveMember
+type GroupServiceMockRemoveMemberParams struct {
+	ctx     context.Context
+	groupID int64
+	userID  int64
+}
+
+// GroupServiceMockRemoveMemberParamPtrs contains pointers to parameters of the GroupService.RemoveMember
+type GroupServiceMockRemoveMemberParamPtrs struct {
+	ctx     *context.Context
+	groupID *int64
+	userID  *int64
+}
+
+// GroupServiceMockRemoveMemberResults contains results of the GroupService.RemoveMember
+type GroupServiceMockRemoveMemberResults struct {
+	err error
+}
+
+// Expect sets up expected params for GroupService.RemoveMember
+func (mmRemoveMember *mGroupServiceMockRemoveMember) Expect(ctx context.Context, groupID int64, userID int64) *mGroupServiceMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("GroupServiceMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &GroupServiceMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs != nil {
+		mmRemoveMember.mock.t.Fatalf("GroupServiceMock.RemoveMember mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveMember.defaultExpectation.params = &GroupServiceMockRemoveMemberParams{ctx, groupID, userID}
+	for _, e := range mmRemoveMember.expectations {
+		if minimock.Equal(e.params, mmRemoveMember.defaultExpectation.params) {
+			mmRemoveMember.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveMember.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveMember
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GroupService.RemoveMember
+func (mmRemoveMember *mGroupServiceMockRemoveMember) ExpectCtxParam1(ctx context.Context) *mGroupServiceMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("GroupServiceMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &GroupServiceMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.params != nil {
+		mmRemoveMember.mock.t.Fatalf("GroupServiceMock.RemoveMember mock is already set by Expect")
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveMember.defaultExpectation.paramPtrs = &GroupServiceMockRemoveMemberParamPtrs{}
+	}
+	mmRemoveMember.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRemoveMember
+}
+
+// ExpectGroupIDParam2 sets up expected param groupID for GroupService.RemoveMember
+func (mmRemoveMember *mGroupServiceMockRemoveMember) ExpectGroupIDParam2(groupID int64) *mGroupServiceMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("GroupServiceMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &GroupServiceMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.params != nil {
+		mmRemoveMember.mock.t.Fatalf("GroupServiceMock.RemoveMember mock is already set by Expect")
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveMember.defaultExpectation.paramPtrs = &GroupServiceMockRemoveMemberParamPtrs{}
+	}
+	mmRemoveMember.defaultExpectation.paramPtrs.groupID = &groupID
+
+	return mmRemoveMember
+}
+
+// ExpectUserIDParam3 sets up expected param userID for GroupService.RemoveMember
+func (mmRemoveMember *mGroupServiceMockRemoveMember) ExpectUserIDParam3(userID int64) *mGroupServiceMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("GroupServiceMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &GroupServiceMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.params != nil {
+		mmRemoveMember.mock.t.Fatalf("GroupServiceMock.RemoveMember mock is already set by Expect")
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveMember.defaultExpectation.paramPtrs = &GroupServiceMockRemoveMemberParamPtrs{}
+	}
+	mmRemoveMember.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmRemoveMember
+}
+
+// Inspect accepts an inspector function that has same arguments as the GroupService.RemoveMember
+func (mmRemoveMember *mGroupServiceMockRemoveMember) Inspect(f func(ctx context.Context, groupID int64, userID int64)) *mGroupServiceMockRemoveMember {
+	if mmRemoveMember.mock.inspectFuncRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("Inspect function is already set for GroupServiceMock.RemoveMember")
+	}
+
+	mmRemoveMember.mock.inspectFuncRemoveMember = f
+
+	return mmRemoveMember
+}
+
+// Return sets up results that will be returned by GroupService.RemoveMember
+func (mmRemoveMember *mGroupServiceMockRemoveMember) Return(err error) *GroupServiceMock {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("GroupServiceMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &GroupServiceMockRemoveMemberExpectation{mock: mmRemoveMember.mock}
+	}
+	mmRemoveMember.defaultExpectation.results = &GroupServiceMockRemoveMemberResults{err}
+	return mmRemoveMember.mock
+}
+
+// Set uses given function f to mock the GroupService.RemoveMember method
+func (mmRemoveMember *mGroupServiceMockRemoveMember) Set(f func(ctx context.Context, groupID int64, userID int64) (err error)) *GroupServiceMock {
+	if mmRemoveMember.defaultExpectation != nil {
+		mmRemoveMember.mock.t.Fatalf("Default expectation is already set for the GroupService.RemoveMember method")
+	}
+
+	if len(mmRemoveMember.expectations) > 0 {
+		mmRemoveMember.mock.t.Fatalf("Some expectations are already set for the GroupService.RemoveMember method")
+	}
+
+	mmRemoveMember.mock.funcRemoveMember = f
+	return mmRemoveMember.mock
+}
+
+// When sets expectation for the GroupService.RemoveMember which will trigger the result defined by the following
+// Then helper
+func (mmRemoveMember *mGroupServiceMockRemoveMember) When(ctx context.Context, groupID int64, userID int64) *GroupServiceMockRemoveMemberExpectation {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("GroupServiceMock.RemoveMember mock is already set by Set")
+	}
+
+	expectation := &GroupServiceMockRemoveMemberExpectation{
+		mock:   mmRemoveMember.mock,
+		params: &GroupServiceMockRemoveMemberParams{ctx, groupID, userID},
+	}
+	mmRemoveMember.expectations = append(mmRemoveMember.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GroupService.RemoveMember return parameters for the expectation previously defined by the When method
+func (e *GroupServiceMockRemoveMemberExpectation) Then(err error) *GroupServiceMock {
+	e.results = &GroupServiceMockRemoveMemberResults{err}
+	return e.mock
+}
+
+// RemoveMember implements service.GroupService
+func (mmRemoveMember *GroupServiceMock) RemoveMember(ctx context.Context, groupID int64, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmRemoveMember.beforeRemoveMemberCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveMember.afterRemoveMemberCounter, 1)
+
+	if mmRemoveMember.inspectFuncRemoveMember != nil {
+		mmRemoveMember.inspectFuncRemoveMember(ctx, groupID, userID)
+	}
+
+	mm_params := GroupServiceMockRemoveMemberParams{ctx, groupID, userID}
+
+	// Record call args
+	mmRemoveMember.RemoveMemberMock.mutex.Lock()
+	mmRemoveMember.RemoveMemberMock.callArgs = append(mmRemoveMember.RemoveMemberMock.callArgs, &mm_params)
+	mmRemoveMember.RemoveMemberMock.mutex.Unlock()
+
+	for _, e := range mmRemoveMember.RemoveMemberMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRemoveMember.RemoveMemberMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveMember.RemoveMemberMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveMember.RemoveMemberMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveMember.RemoveMemberMock.defaultExpectation.paramPtrs
+
+		mm_got := GroupServiceMockRemoveMemberParams{ctx, groupID, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveMember.t.Errorf("GroupServiceMock.RemoveMember got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.groupID != nil && !minimock.Equal(*mm_want_ptrs.groupID, mm_got.groupID) {
+				mmRemoveMember.t.Errorf("GroupServiceMock.RemoveMember got unexpected parameter groupID, want: %#v, got: %#v%s\n", *mm_want_ptrs.groupID, mm_got.groupID, minimock.Diff(*mm_want_ptrs.groupID, mm_got.groupID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRemoveMember.t.Errorf("GroupServiceMock.RemoveMember got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveMember.t.Errorf("GroupServiceMock.RemoveMember got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveMember.RemoveMemberMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveMember.t.Fatal("No results are set for the GroupServiceMock.RemoveMember")
+		}
+		return (*mm_results).err
+	}
+	if mmRemoveMember.funcRemoveMember != nil {
+		return mmRemoveMember.funcRemoveMember(ctx, groupID, userID)
+	}
+	mmRemoveMember.t.Fatalf("Unexpected call to GroupServiceMock.RemoveMember. %v %v %v", ctx, groupID, userID)
+	return
+}
+
+// RemoveMemberAfterCounter returns a count of finished GroupServiceMock.RemoveMember invocations
+func (mmRemoveMember *GroupServiceMock) RemoveMemberAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveMember.afterRemoveMemberCounter)
+}
+
+// RemoveMemberBeforeCounter returns a count of GroupServiceMock.RemoveMember invocations
+func (mmRemoveMember *GroupServiceMock) RemoveMemberBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveMember.beforeRemoveMemberCounter)
+}
+
+// Calls returns a list of arguments used in each call to GroupServiceMock.RemoveMember.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveMember *mGroupServiceMockRemoveMember) Calls() []*GroupServiceMockRemoveMemberParams {
+	mmRemoveMember.mutex.RLock()
+
+	argCopy := make([]*GroupServiceMockRemoveMemberParams, len(mmRemoveMember.callArgs))
+	copy(argCopy, mmRemoveMember.callArgs)
+
+	mmRemoveMember.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveMemberDone returns true if the count of the RemoveMember invocations corresponds
+// the number of defined expectations
+func (m *GroupServiceMock) MinimockRemoveMemberDone() bool {
+	for _, e := range m.RemoveMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveMemberMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRemoveMemberCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveMember != nil && mm_atomic.LoadUint64(&m.afterRemoveMemberCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockRemoveMemberInspect logs each unmet expectation
+func (m *GroupServiceMock) MinimockRemoveMemberInspect() {
+	for _, e := range m.RemoveMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GroupServiceMock.RemoveMember with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveMemberMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRemoveMemberCounter) < 1 {
+		if m.RemoveMemberMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GroupServiceMock.RemoveMember")
+		} else {
+			m.t.Errorf("Expected call to GroupServiceMock.RemoveMember with params: %#v", *m.RemoveMemberMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveMember != nil && mm_atomic.LoadUint64(&m.afterRemoveMemberCounter) < 1 {
+		m.t.Error("Expected call to GroupServiceMock.RemoveMember")
+	}
+}
+
+type mGroupServiceMockRemoveParent struct {
+	mock               *GroupServiceMock
+	defaultExpectation *GroupServiceMockRemoveParentExpectation
+	expectations       []*GroupServiceMockRemoveParentExpectation
+
+	callArgs []*GroupServiceMockRemoveParentParams
+	mutex    sync.RWMutex
+}
+
+// GroupServiceMockRemoveParentExpectation specifies expectation struct of the GroupService.RemoveParent
+type GroupServiceMockRemoveParentExpectation struct {
+	mock      *GroupServiceMock
+	params    *GroupServiceMockRemoveParentParams
+	paramPtrs *GroupServiceMockRemoveParentParamPtrs
+	results   *GroupServiceMockRemoveParentResults
+	Counter   uint64
+}
+
+// GroupServiceMockRemoveParentParams contains parameters of the GroupService.RemoveParent
+type GroupServiceMockRemoveParentParams struct {
+	ctx      context.Context
+	groupID  int64
+	parentID int64
+}
+
+// GroupServiceMockRemoveParentParamPtrs contains pointers to parameters of the GroupService.RemoveParent
+type GroupServiceMockRemoveParentParamPtrs struct {
+	ctx      *context.Context
+	groupID  *int64
+	parentID *int64
+}
+
+// GroupServiceMockRemoveParentResults contains results of the GroupService.RemoveParent
+type GroupServiceMockRemoveParentResults struct {
+	err error
+}
+
+// Expect sets up expected params for GroupService.RemoveParent
+func (mmRemoveParent *mGroupServiceMockRemoveParent) Expect(ctx context.Context, groupID int64, parentID int64) *mGroupServiceMockRemoveParent {
+	if mmRemoveParent.mock.funcRemoveParent != nil {
+		mmRemoveParent.mock.t.Fatalf("GroupServiceMock.RemoveParent mock is already set by Set")
+	}
+
+	if mmRemoveParent.defaultExpectation == nil {
+		mmRemoveParent.defaultExpectation = &GroupServiceMockRemoveParentExpectation{}
+	}
+
+	if mmRemoveParent.defaultExpectation.paramPtrs != nil {
+		mmRemoveParent.mock.t.Fatalf("GroupServiceMock.RemoveParent mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveParent.defaultExpectation.params = &GroupServiceMockRemoveParentParams{ctx, groupID, parentID}
+	for _, e := range mmRemoveParent.expectations {
+		if minimock.Equal(e.params, mmRemoveParent.defaultExpectation.params) {
+			mmRemoveParent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveParent.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveParent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GroupService.RemoveParent
+func (mmRemoveParent *mGroupServiceMockRemoveParent) ExpectCtxParam1(ctx context.Context) *mGroupServiceMockRemoveParent {
+	if mmRemoveParent.mock.funcRemoveParent != nil {
+		mmRemoveParent.mock.t.Fatalf("GroupServiceMock.RemoveParent mock is already set by Set")
+	}
+
+	if mmRemoveParent.defaultExpectation == nil {
+		mmRemoveParent.defaultExpectation = &GroupServiceMockRemoveParentExpectation{}
+	}
+
+	if mmRemoveParent.defaultExpectation.params != nil {
+		mmRemoveParent.mock.t.Fatalf("GroupServiceMock.RemoveParent mock is already set by Expect")
+	}
+
+	if mmRemoveParent.defaultExpectation.paramPtrs == nil {
+		mmRemoveParent.defaultExpectation.paramPtrs = &GroupServiceMockRemoveParentParamPtrs{}
+	}
+	mmRemoveParent.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRemoveParent
+}
+
+// ExpectGroupIDParam2 sets up expected param groupID for GroupService.RemoveParent
+func (mmRemoveParent *mGroupServiceMockRemoveParent) ExpectGroupIDParam2(groupID int64) *mGroupServiceMockRemoveParent {
+	if mmRemoveParent.mock.funcRemoveParent != nil {
+		mmRemoveParent.mock.t.Fatalf("GroupServiceMock.RemoveParent mock is already set by Set")
+	}
+
+	if mmRemoveParent.defaultExpectation == nil {
+		mmRemoveParent.defaultExpectation = &GroupServiceMockRemoveParentExpectation{}
+	}
+
+	if mmRemoveParent.defaultExpectation.params != nil {
+		mmRemoveParent.mock.t.Fatalf("GroupServiceMock.RemoveParent mock is already set by Expect")
+	}
+
+	if mmRemoveParent.defaultExpectation.paramPtrs == nil {
+		mmRemoveParent.defaultExpectation.paramPtrs = &GroupServiceMockRemoveParentParamPtrs{}
+	}
+	mmRemoveParent.defaultExpectation.paramPtrs.groupID = &groupID
+
+	return mmRemoveParent
+}
+
+// ExpectParentIDParam3 sets up expected param parentID for GroupService.RemoveParent
+func (mmRemoveParent *mGroupServiceMockRemoveParent) ExpectParentIDParam3(parentID int64) *mGroupServiceMockRemoveParent {
+	if mmRemoveParent.mock.funcRemoveParent != nil {
+		mmRemoveParent.mock.t.Fatalf("GroupServiceMock.RemoveParent mock is already set by Set")
+	}
+
+	if mmRemoveParent.defaultExpectation == nil {
+		mmRemoveParent.defaultExpectation = &GroupServiceMockRemoveParentExpectation{}
+	}
+
+	if mmRemoveParent.defaultExpectation.params != nil {
+		mmRemoveParent.mock.t.Fatalf("GroupServiceMock.RemoveParent mock is already set by Expect")
+	}
+
+	if mmRemoveParent.defaultExpectation.paramPtrs == nil {
+		mmRemoveParent.defaultExpectation.paramPtrs = &GroupServiceMockRemoveParentParamPtrs{}
+	}
+	mmRemoveParent.defaultExpectation.paramPtrs.parentID = &parentID
+
+	return mmRemoveParent
+}
+
+// Inspect accepts an inspector function that has same arguments as the GroupService.RemoveParent
+func (mmRemoveParent *mGroupServiceMockRemoveParent) Inspect(f func(ctx context.Context, groupID int64, parentID int64)) *mGroupServiceMockRemoveParent {
+	if mmRemoveParent.mock.inspectFuncRemoveParent != nil {
+		mmRemoveParent.mock.t.Fatalf("Inspect function is already set for GroupServiceMock.RemoveParent")
+	}
+
+	mmRemoveParent.mock.inspectFuncRemoveParent = f
+
+	return mmRemoveParent
+}
+
+// Return sets up results that will be returned by GroupService.RemoveParent
+func (mmRemoveParent *mGroupServiceMockRemoveParent) Return(err error) *GroupServiceMock {
+	if mmRemoveParent.mock.funcRemoveParent != nil {
+		mmRemoveParent.mock.t.Fatalf("GroupServiceMock.RemoveParent mock is already set by Set")
+	}
+
+	if mmRemoveParent.defaultExpectation == nil {
+		mmRemoveParent.defaultExpectation = &GroupServiceMockRemoveParentExpectation{mock: mmRemoveParent.mock}
+	}
+	mmRemoveParent.defaultExpectation.results = &GroupServiceMockRemoveParentResults{err}
+	return mmRemoveParent.mock
+}
+
+// Set uses given function f to mock the GroupService.RemoveParent method
+func (mmRemoveParent *mGroupServiceMockRemoveParent) Set(f func(ctx context.Context, groupID int64, parentID int64) (err error)) *GroupServiceMock {
+	if mmRemoveParent.defaultExpectation != nil {
+		mmRemoveParent.mock.t.Fatalf("Default expectation is already set for the GroupService.RemoveParent method")
+	}
+
+	if len(mmRemoveParent.expectations) > 0 {
+		mmRemoveParent.mock.t.Fatalf("Some expectations are already set for the GroupService.RemoveParent method")
+	}
+
+	mmRemoveParent.mock.funcRemoveParent = f
+	return mmRemoveParent.mock
+}
+
+// When sets expectation for the GroupService.RemoveParent which will trigger the result defined by the following
+// Then helper
+func (mmRemoveParent *mGroupServiceMockRemoveParent) When(ctx context.Context, groupID int64, parentID int64) *GroupServiceMockRemoveParentExpectation {
+	if mmRemoveParent.mock.funcRemoveParent != nil {
+		mmRemoveParent.mock.t.Fatalf("GroupServiceMock.RemoveParent mock is already set by Set")
+	}
+
+	expectation := &GroupServiceMockRemoveParentExpectation{
+		mock:   mmRemoveParent.mock,
+		params: &GroupServiceMockRemoveParentParams{ctx, groupID, parentID},
+	}
+	mmRemoveParent.expectations = append(mmRemoveParent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GroupService.RemoveParent return parameters for the expectation previously defined by the When method
+func (e *GroupServiceMockRemoveParentExpectation) Then(err error) *GroupServiceMock {
+	e.results = &GroupServiceMockRemoveParentResults{err}
+	return e.mock
+}
+
+// RemoveParent implements service.GroupService
+func (mmRemoveParent *GroupServiceMock) RemoveParent(ctx context.Context, groupID int64, parentID int64) (err error) {
+	mm_atomic.AddUint64(&mmRemoveParent.beforeRemoveParentCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveParent.afterRemoveParentCounter, 1)
+
+	if mmRemoveParent.inspectFuncRemoveParent != nil {
+		mmRemoveParent.inspectFuncRemoveParent(ctx, groupID, parentID)
+	}
+
+	mm_params := GroupServiceMockRemoveParentParams{ctx, groupID, parentID}
+
+	// Record call args
+	mmRemoveParent.RemoveParentMock.mutex.Lock()
+	mmRemoveParent.RemoveParentMock.callArgs = append(mmRemoveParent.RemoveParentMock.callArgs, &mm_params)
+	mmRemoveParent.RemoveParentMock.mutex.Unlock()
+
+	for _, e := range mmRemoveParent.RemoveParentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRemoveParent.RemoveParentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveParent.RemoveParentMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveParent.RemoveParentMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveParent.RemoveParentMock.defaultExpectation.paramPtrs
+
+		mm_got := GroupServiceMockRemoveParentParams{ctx, groupID, parentID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveParent.t.Errorf("GroupServiceMock.RemoveParent got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.groupID != nil && !minimock.Equal(*mm_want_ptrs.groupID, mm_got.groupID) {
+				mmRemoveParent.t.Errorf("GroupServiceMock.RemoveParent got unexpected parameter groupID, want: %#v, got: %#v%s\n", *mm_want_ptrs.groupID, mm_got.groupID, minimock.Diff(*mm_want_ptrs.groupID, mm_got.groupID))
+			}
+
+			if mm_want_ptrs.parentID != nil && !minimock.Equal(*mm_want_ptrs.parentID, mm_got.parentID) {
+				mmRemoveParent.t.Errorf("GroupServiceMock.RemoveParent got unexpected parameter parentID, want: %#v, got: %#v%s\n", *mm_want_ptrs.parentID, mm_got.parentID, minimock.Diff(*mm_want_ptrs.parentID, mm_got.parentID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveParent.t.Errorf("GroupServiceMock.RemoveParent got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveParent.RemoveParentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveParent.t.Fatal("No results are set for the GroupServiceMock.RemoveParent")
+		}
+		return (*mm_results).err
+	}
+	if mmRemoveParent.funcRemoveParent != nil {
+		return mmRemoveParent.funcRemoveParent(ctx, groupID, parentID)
+	}
+	mmRemoveParent.t.Fatalf("Unexpected call to GroupServiceMock.RemoveParent. %v %v %v", ctx, groupID, parentID)
+	return
+}
+
+// RemoveParentAfterCounter returns a count of finished GroupServiceMock.RemoveParent invocations
+func (mmRemoveParent *GroupServiceMock) RemoveParentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveParent.afterRemoveParentCounter)
+}
+
+// RemoveParentBeforeCounter returns a count of GroupServiceMock.RemoveParent invocations
+func (mmRemoveParent *GroupServiceMock) RemoveParentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveParent.beforeRemoveParentCounter)
+}
+
+// Calls returns a list of arguments used in each call to GroupServiceMock.RemoveParent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveParent *mGroupServiceMockRemoveParent) Calls() []*GroupServiceMockRemoveParentParams {
+	mmRemoveParent.mutex.RLock()
+
+	argCopy := make([]*GroupServiceMockRemoveParentParams, len(mmRemoveParent.callArgs))
+	copy(argCopy, mmRemoveParent.callArgs)
+
+	mmRemoveParent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveParentDone returns true if the count of the RemoveParent invocations corresponds
+// the number of defined expectations
+func (m *GroupServiceMock) MinimockRemoveParentDone() bool {
+	for _, e := range m.RemoveParentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveParentMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRemoveParentCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveParent != nil && mm_atomic.LoadUint64(&m.afterRemoveParentCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockRemoveParentInspect logs each unmet expectation
+func (m *GroupServiceMock) MinimockRemoveParentInspect() {
+	for _, e := range m.RemoveParentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GroupServiceMock.RemoveParent with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveParentMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRemoveParentCounter) < 1 {
+		if m.RemoveParentMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GroupServiceMock.RemoveParent")
+		} else {
+			m.t.Errorf("Expected call to GroupServiceMock.RemoveParent with params: %#v", *m.RemoveParentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveParent != nil && mm_atomic.LoadUint64(&m.afterRemoveParentCounter) < 1 {
+		m.t.Error("Expected call to GroupServiceMock.RemoveParent")
+	}
+}
+
+type mGroupServiceMockSetRoles struct {
+	mock               *GroupServiceMock
+	defaultExpectation *GroupServiceMockSetRolesExpectation
+	expectations       []*GroupServiceMockSetRolesExpectation
+
+	callArgs []*GroupServiceMockSetRolesParams
+	mutex    sync.RWMutex
+}
+
+// GroupServiceMockSetRolesExpectation specifies expectation struct of the GroupService.SetRoles
+type GroupServiceMockSetRolesExpectation struct {
+	mock      *GroupServiceMock
+	params    *GroupServiceMockSetRolesParams
+	paramPtrs *GroupServiceMockSetRolesParamPtrs
+	results   *GroupServiceMockSetRolesResults
+	Counter   uint64
+}
+
+// GroupServiceMockSetRolesParams contains parameters of the GroupService.SetRoles
+type GroupServiceMockSetRolesParams struct {
+	ctx   context.Context
+	id    int64
+	roles []model.Role
+}
+
+// GroupServiceMockSetRolesParamPtrs contains pointers to parameters of the GroupService.SetRoles
+type GroupServiceMockSetRolesParamPtrs struct {
+	ctx   *context.Context
+	id    *int64
+	roles *[]model.Role
+}
+
+// GroupServiceMockSetRolesResults contains results of the GroupService.SetRoles
+type GroupServiceMockSetRolesResults struct {
+	err error
+}
+
+// Expect sets up expected params for GroupService.SetRoles
+func (mmSetRoles *mGroupServiceMockSetRoles) Expect(ctx context.Context, id int64, roles []model.Role) *mGroupServiceMockSetRoles {
+	if mmSetRoles.mock.funcSetRoles != nil {
+		mmSetRoles.mock.t.Fatalf("GroupServiceMock.SetRoles mock is already set by Set")
+	}
+
+	if mmSetRoles.defaultExpectation == nil {
+		mmSetRoles.defaultExpectation = &GroupServiceMockSetRolesExpectation{}
+	}
+
+	if mmSetRoles.defaultExpectation.paramPtrs != nil {
+		mmSetRoles.mock.t.Fatalf("GroupServiceMock.SetRoles mock is already set by ExpectParams functions")
+	}
+
+	mmSetRoles.defaultExpectation.params = &GroupServiceMockSetRolesParams{ctx, id, roles}
+	for _, e := range mmSetRoles.expectations {
+		if minimock.Equal(e.params, mmSetRoles.defaultExpectation.params) {
+			mmSetRoles.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetRoles.defaultExpectation.params)
+		}
+	}
+
+	return mmSetRoles
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GroupService.SetRoles
+func (mmSetRoles *mGroupServiceMockSetRoles) ExpectCtxParam1(ctx context.Context) *mGroupServiceMockSetRoles {
+	if mmSetRoles.mock.funcSetRoles != nil {
+		mmSetRoles.mock.t.Fatalf("GroupServiceMock.SetRoles mock is already set by Set")
+	}
+
+	if mmSetRoles.defaultExpectation == nil {
+		mmSetRoles.defaultExpectation = &GroupServiceMockSetRolesExpectation{}
+	}
+
+	if mmSetRoles.defaultExpectation.params != nil {
+		mmSetRoles.mock.t.Fatalf("GroupServiceMock.SetRoles mock is already set by Expect")
+	}
+
+	if mmSetRoles.defaultExpectation.paramPtrs == nil {
+		mmSetRoles.defaultExpectation.paramPtrs = &GroupServiceMockSetRolesParamPtrs{}
+	}
+	mmSetRoles.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmSetRoles
+}
+
+// ExpectIdParam2 sets up expected param id for GroupService.SetRoles
+func (mmSetRoles *mGroupServiceMockSetRoles) ExpectIdParam2(id int64) *mGroupServiceMockSetRoles {
+	if mmSetRoles.mock.funcSetRoles != nil {
+		mmSetRoles.mock.t.Fatalf("GroupServiceMock.SetRoles mock is already set by Set")
+	}
+
+	if mmSetRoles.defaultExpectation == nil {
+		mmSetRoles.defaultExpectation = &GroupServiceMockSetRolesExpectation{}
+	}
+
+	if mmSetRoles.defaultExpectation.params != nil {
+		mmSetRoles.mock.t.Fatalf("GroupServiceMock.SetRoles mock is already set by Expect")
+	}
+
+	if mmSetRoles.defaultExpectation.paramPtrs == nil {
+		mmSetRoles.defaultExpectation.paramPtrs = &GroupServiceMockSetRolesParamPtrs{}
+	}
+	mmSetRoles.defaultExpectation.paramPtrs.id = &id
+
+	return mmSetRoles
+}
+
+// ExpectRolesParam3 sets up expected param roles for GroupService.SetRoles
+func (mmSetRoles *mGroupServiceMockSetRoles) ExpectRolesParam3(roles []model.Role) *mGroupServiceMockSetRoles {
+	if mmSetRoles.mock.funcSetRoles != nil {
+		mmSetRoles.mock.t.Fatalf("GroupServiceMock.SetRoles mock is already set by Set")
+	}
+
+	if mmSetRoles.defaultExpectation == nil {
+		mmSetRoles.defaultExpectation = &GroupServiceMockSetRolesExpectation{}
+	}
+
+	if mmSetRoles.defaultExpectation.params != nil {
+		mmSetRoles.mock.t.Fatalf("GroupServiceMock.SetRoles mock is already set by Expect")
+	}
+
+	if mmSetRoles.defaultExpectation.paramPtrs == nil {
+		mmSetRoles.defaultExpectation.paramPtrs = &GroupServiceMockSetRolesParamPtrs{}
+	}
+	mmSetRoles.defaultExpectation.paramPtrs.roles = &roles
+
+	return mmSetRoles
+}
+
+// Inspect accepts an inspector function that has same arguments as the GroupService.SetRoles
+func (mmSetRoles *mGroupServiceMockSetRoles) Inspect(f func(ctx context.Context, id int64, roles []model.Role)) *mGroupServiceMockSetRoles {
+	if mmSetRoles.mock.inspectFuncSetRoles != nil {
+		mmSetRoles.mock.t.Fatalf("Inspect function is already set for GroupServiceMock.SetRoles")
+	}
+
+	mmSetRoles.mock.inspectFuncSetRoles = f
+
+	return mmSetRoles
+}
+
+// Return sets up results that will be returned by GroupService.SetRoles
+func (mmSetRoles *mGroupServiceMockSetRoles) Return(err error) *GroupServiceMock {
+	if mmSetRoles.mock.funcSetRoles != nil {
+		mmSetRoles.mock.t.Fatalf("GroupServiceMock.SetRoles mock is already set by Set")
+	}
+
+	if mmSetRoles.defaultExpectation == nil {
+		mmSetRoles.defaultExpectation = &GroupServiceMockSetRolesExpectation{mock: mmSetRoles.mock}
+	}
+	mmSetRoles.defaultExpectation.results = &GroupServiceMockSetRolesResults{err}
+	return mmSetRoles.mock
+}
+
+// Set uses given function f to mock the GroupService.SetRoles method
+func (mmSetRoles *mGroupServiceMockSetRoles) Set(f func(ctx context.Context, id int64, roles []model.Role) (err error)) *GroupServiceMock {
+	if mmSetRoles.defaultExpectation != nil {
+		mmSetRoles.mock.t.Fatalf("Default expectation is already set for the GroupService.SetRoles method")
+	}
+
+	if len(mmSetRoles.expectations) > 0 {
+		mmSetRoles.mock.t.Fatalf("Some expectations are already set for the GroupService.SetRoles method")
+	}
+
+	mmSetRoles.mock.funcSetRoles = f
+	return mmSetRoles.mock
+}
+
+// When sets expectation for the GroupService.SetRoles which will trigger the result defined by the following
+// Then helper
+func (mmSetRoles *mGroupServiceMockSetRoles) When(ctx context.Context, id int64, roles []model.Role) *GroupServiceMockSetRolesExpectation {
+	if mmSetRoles.mock.funcSetRoles != nil {
+		mmSetRoles.mock.t.Fatalf("GroupServiceMock.SetRoles mock is already set by Set")
+	}
+
+	expectation := &GroupServiceMockSetRolesExpectation{
+		mock:   mmSetRoles.mock,
+		params: &GroupServiceMockSetRolesParams{ctx, id, roles},
+	}
+	mmSetRoles.expectations = append(mmSetRoles.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GroupService.SetRoles return parameters for the expectation previously defined by the When method
+func (e *GroupServiceMockSetRolesExpectation) Then(err error) *GroupServiceMock {
+	e.results = &GroupServiceMockSetRolesResults{err}
+	return e.mock
+}
+
+// SetRoles implements service.GroupService
+func (mmSetRoles *GroupServiceMock) SetRoles(ctx context.Context, id int64, roles []model.Role) (err error) {
+	mm_atomic.AddUint64(&mmSetRoles.beforeSetRolesCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetRoles.afterSetRolesCounter, 1)
+
+	if mmSetRoles.inspectFuncSetRoles != nil {
+		mmSetRoles.inspectFuncSetRoles(ctx, id, roles)
+	}
+
+	mm_params := GroupServiceMockSetRolesParams{ctx, id, roles}
+
+	// Record call args
+	mmSetRoles.SetRolesMock.mutex.Lock()
+	mmSetRoles.SetRolesMock.callArgs = append(mmSetRoles.SetRolesMock.callArgs, &mm_params)
+	mmSetRoles.SetRolesMock.mutex.Unlock()
+
+	for _, e := range mmSetRoles.SetRolesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetRoles.SetRolesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetRoles.SetRolesMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetRoles.SetRolesMock.defaultExpectation.params
+		mm_want_ptrs := mmSetRoles.SetRolesMock.defaultExpectation.paramPtrs
+
+		mm_got := GroupServiceMockSetRolesParams{ctx, id, roles}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetRoles.t.Errorf("GroupServiceMock.SetRoles got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmSetRoles.t.Errorf("GroupServiceMock.SetRoles got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.roles != nil && !minimock.Equal(*mm_want_ptrs.roles, mm_got.roles) {
+				mmSetRoles.t.Errorf("GroupServiceMock.SetRoles got unexpected parameter roles, want: %#v, got: %#v%s\n", *mm_want_ptrs.roles, mm_got.roles, minimock.Diff(*mm_want_ptrs.roles, mm_got.roles))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetRoles.t.Errorf("GroupServiceMock.SetRoles got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetRoles.SetRolesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetRoles.t.Fatal("No results are set for the GroupServiceMock.SetRoles")
+		}
+		return (*mm_results).err
+	}
+	if mmSetRoles.funcSetRoles != nil {
+		return mmSetRoles.funcSetRoles(ctx, id, roles)
+	}
+	mmSetRoles.t.Fatalf("Unexpected call to GroupServiceMock.SetRoles. %v %v %v", ctx, id, roles)
+	return
+}
+
+// SetRolesAfterCounter returns a count of finished GroupServiceMock.SetRoles invocations
+func (mmSetRoles *GroupServiceMock) SetRolesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetRoles.afterSetRolesCounter)
+}
+
+// SetRolesBeforeCounter returns a count of GroupServiceMock.SetRoles invocations
+func (mmSetRoles *GroupServiceMock) SetRolesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetRoles.beforeSetRolesCounter)
+}
+
+// Calls returns a list of arguments used in each call to GroupServiceMock.SetRoles.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetRoles *mGroupServiceMockSetRoles) Calls() []*GroupServiceMockSetRolesParams {
+	mmSetRoles.mutex.RLock()
+
+	argCopy := make([]*GroupServiceMockSetRolesParams, len(mmSetRoles.callArgs))
+	copy(argCopy, mmSetRoles.callArgs)
+
+	mmSetRoles.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetRolesDone returns true if the count of the SetRoles invocations corresponds
+// the number of defined expectations
+func (m *GroupServiceMock) MinimockSetRolesDone() bool {
+	for _, e := range m.SetRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetRolesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetRolesCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetRoles != nil && mm_atomic.LoadUint64(&m.afterSetRolesCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSetRolesInspect logs each unmet expectation
+func (m *GroupServiceMock) MinimockSetRolesInspect() {
+	for _, e := range m.SetRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GroupServiceMock.SetRoles with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetRolesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetRolesCounter) < 1 {
+		if m.SetRolesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GroupServiceMock.SetRoles")
+		} else {
+			m.t.Errorf("Expected call to GroupServiceMock.SetRoles with params: %#v", *m.SetRolesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetRoles != nil && mm_atomic.LoadUint64(&m.afterSetRolesCounter) < 1 {
+		m.t.Error("Expected call to GroupServiceMock.SetRoles")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *GroupServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddMemberInspect()
+
+			m.MinimockAddParentInspect()
+
+			m.MinimockCreateInspect()
+
+			m.MinimockDeleteInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockListEffectiveRolesInspect()
+
+			m.MinimockRemoveMemberInspect()
+
+			m.MinimockRemoveParentInspect()
+
+			m.MinimockSetRolesInspect()
+			m.t.FailNow()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *GroupServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *GroupServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddMemberDone() &&
+		m.MinimockAddParentDone() &&
+		m.MinimockCreateDone() &&
+		m.MinimockDeleteDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockListEffectiveRolesDone() &&
+		m.MinimockRemoveMemberDone() &&
+		m.MinimockRemoveParentDone() &&
+		m.MinimockSetRolesDone()
+}
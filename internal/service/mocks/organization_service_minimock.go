@@ -0,0 +1,1305 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.8). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/arifullov/auth/internal/service.OrganizationService -o organization_service_minimock.go -n OrganizationServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// OrganizationServiceMock implements service.OrganizationService
+type OrganizationServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAddMember          func(ctx context.Context, slug string, userID int64, roles []model.Role) (err error)
+	inspectFuncAddMember   func(ctx context.Context, slug string, userID int64, roles []model.Role)
+	afterAddMemberCounter  uint64
+	beforeAddMemberCounter uint64
+	AddMemberMock          mOrganizationServiceMockAddMember
+
+	funcCreate          func(ctx context.Context, org *model.Organization) (i1 int64, err error)
+	inspectFuncCreate   func(ctx context.Context, org *model.Organization)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mOrganizationServiceMockCreate
+
+	funcList          func(ctx context.Context) (oa1 []model.Organization, err error)
+	inspectFuncList   func(ctx context.Context)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mOrganizationServiceMockList
+
+	funcRemoveMember          func(ctx context.Context, slug string, userID int64) (err error)
+	inspectFuncRemoveMember   func(ctx context.Context, slug string, userID int64)
+	afterRemoveMemberCounter  uint64
+	beforeRemoveMemberCounter uint64
+	RemoveMemberMock          mOrganizationServiceMockRemoveMember
+}
+
+// NewOrganizationServiceMock returns a mock for service.OrganizationService
+func NewOrganizationServiceMock(t minimock.Tester) *OrganizationServiceMock {
+	m := &OrganizationServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddMemberMock = mOrganizationServiceMockAddMember{mock: m}
+	m.AddMemberMock.callArgs = []*OrganizationServiceMockAddMemberParams{}
+
+	m.CreateMock = mOrganizationServiceMockCreate{mock: m}
+	m.CreateMock.callArgs = []*OrganizationServiceMockCreateParams{}
+
+	m.ListMock = mOrganizationServiceMockList{mock: m}
+	m.ListMock.callArgs = []*OrganizationServiceMockListParams{}
+
+	m.RemoveMemberMock = mOrganizationServiceMockRemoveMember{mock: m}
+	m.RemoveMemberMock.callArgs = []*OrganizationServiceMockRemoveMemberParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mOrganizationServiceMockAddMember struct {
+	mock               *OrganizationServiceMock
+	defaultExpectation *OrganizationServiceMockAddMemberExpectation
+	expectations       []*OrganizationServiceMockAddMemberExpectation
+
+	callArgs []*OrganizationServiceMockAddMemberParams
+	mutex    sync.RWMutex
+}
+
+// OrganizationServiceMockAddMemberExpectation specifies expectation struct of the OrganizationService.AddMember
+type OrganizationServiceMockAddMemberExpectation struct {
+	mock      *OrganizationServiceMock
+	params    *OrganizationServiceMockAddMemberParams
+	paramPtrs *OrganizationServiceMockAddMemberParamPtrs
+	results   *OrganizationServiceMockAddMemberResults
+	Counter   uint64
+}
+
+// OrganizationServiceMockAddMemberParams contains parameters of the OrganizationService.AddMember
+type OrganizationServiceMockAddMemberParams struct {
+	ctx    context.Context
+	slug   string
+	userID int64
+	roles  []model.Role
+}
+
+// OrganizationServiceMockAddMemberParamPtrs contains pointers to parameters of the OrganizationService.AddMember
+type OrganizationServiceMockAddMemberParamPtrs struct {
+	ctx    *context.Context
+	slug   *string
+	userID *int64
+	roles  *[]model.Role
+}
+
+// OrganizationServiceMockAddMemberResults contains results of the OrganizationService.AddMember
+type OrganizationServiceMockAddMemberResults struct {
+	err error
+}
+
+// Expect sets up expected params for OrganizationService.AddMember
+func (mmAddMember *mOrganizationServiceMockAddMember) Expect(ctx context.Context, slug string, userID int64, roles []model.Role) *mOrganizationServiceMockAddMember {
+	if mmAddMember.mock.funcAddMember != nil {
+		mmAddMember.mock.t.Fatalf("OrganizationServiceMock.AddMember mock is already set by Set")
+	}
+
+	if mmAddMember.defaultExpectation == nil {
+		mmAddMember.defaultExpectation = &OrganizationServiceMockAddMemberExpectation{}
+	}
+
+	if mmAddMember.defaultExpectation.paramPtrs != nil {
+		mmAddMember.mock.t.Fatalf("OrganizationServiceMock.AddMember mock is already set by ExpectParams functions")
+	}
+
+	mmAddMember.defaultExpectation.params = &OrganizationServiceMockAddMemberParams{ctx, slug, userID, roles}
+	for _, e := range mmAddMember.expectations {
+		if minimock.Equal(e.params, mmAddMember.defaultExpectation.params) {
+			mmAddMember.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddMember.defaultExpectation.params)
+		}
+	}
+
+	return mmAddMember
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrganizationService.AddMember
+func (mmAddMember *mOrganizationServiceMockAddMember) ExpectCtxParam1(ctx context.Context) *mOrganizationServiceMockAddMember {
+	if mmAddMember.mock.funcAddMember != nil {
+		mmAddMember.mock.t.Fatalf("OrganizationServiceMock.AddMember mock is already set by Set")
+	}
+
+	if mmAddMember.defaultExpectation == nil {
+		mmAddMember.defaultExpectation = &OrganizationServiceMockAddMemberExpectation{}
+	}
+
+	if mmAddMember.defaultExpectation.params != nil {
+		mmAddMember.mock.t.Fatalf("OrganizationServiceMock.AddMember mock is already set by Expect")
+	}
+
+	if mmAddMember.defaultExpectation.paramPtrs == nil {
+		mmAddMember.defaultExpectation.paramPtrs = &OrganizationServiceMockAddMemberParamPtrs{}
+	}
+	mmAddMember.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmAddMember
+}
+
+// ExpectSlugParam2 sets up expected param slug for OrganizationService.AddMember
+func (mmAddMember *mOrganizationServiceMockAddMember) ExpectSlugParam2(slug string) *mOrganizationServiceMockAddMember {
+	if mmAddMember.mock.funcAddMember != nil {
+		mmAddMember.mock.t.Fatalf("OrganizationServiceMock.AddMember mock is already set by Set")
+	}
+
+	if mmAddMember.defaultExpectation == nil {
+		mmAddMember.defaultExpectation = &OrganizationServiceMockAddMemberExpectation{}
+	}
+
+	if mmAddMember.defaultExpectation.params != nil {
+		mmAddMember.mock.t.Fatalf("OrganizationServiceMock.AddMember mock is already set by Expect")
+	}
+
+	if mmAddMember.defaultExpectation.paramPtrs == nil {
+		mmAddMember.defaultExpectation.paramPtrs = &OrganizationServiceMockAddMemberParamPtrs{}
+	}
+	mmAddMember.defaultExpectation.paramPtrs.slug = &slug
+
+	return mmAddMember
+}
+
+// ExpectUserIDParam3 sets up expected param userID for OrganizationService.AddMember
+func (mmAddMember *mOrganizationServiceMockAddMember) ExpectUserIDParam3(userID int64) *mOrganizationServiceMockAddMember {
+	if mmAddMember.mock.funcAddMember != nil {
+		mmAddMember.mock.t.Fatalf("OrganizationServiceMock.AddMember mock is already set by Set")
+	}
+
+	if mmAddMember.defaultExpectation == nil {
+		mmAddMember.defaultExpectation = &OrganizationServiceMockAddMemberExpectation{}
+	}
+
+	if mmAddMember.defaultExpectation.params != nil {
+		mmAddMember.mock.t.Fatalf("OrganizationServiceMock.AddMember mock is already set by Expect")
+	}
+
+	if mmAddMember.defaultExpectation.paramPtrs == nil {
+		mmAddMember.defaultExpectation.paramPtrs = &OrganizationServiceMockAddMemberParamPtrs{}
+	}
+	mmAddMember.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmAddMember
+}
+
+// ExpectRolesParam4 sets up expected param roles for OrganizationService.AddMember
+func (mmAddMember *mOrganizationServiceMockAddMember) ExpectRolesParam4(roles []model.Role) *mOrganizationServiceMockAddMember {
+	if mmAddMember.mock.funcAddMember != nil {
+		mmAddMember.mock.t.Fatalf("OrganizationServiceMock.AddMember mock is already set by Set")
+	}
+
+	if mmAddMember.defaultExpectation == nil {
+		mmAddMember.defaultExpectation = &OrganizationServiceMockAddMemberExpectation{}
+	}
+
+	if mmAddMember.defaultExpectation.params != nil {
+		mmAddMember.mock.t.Fatalf("OrganizationServiceMock.AddMember mock is already set by Expect")
+	}
+
+	if mmAddMember.defaultExpectation.paramPtrs == nil {
+		mmAddMember.defaultExpectation.paramPtrs = &OrganizationServiceMockAddMemberParamPtrs{}
+	}
+	mmAddMember.defaultExpectation.paramPtrs.roles = &roles
+
+	return mmAddMember
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrganizationService.AddMember
+func (mmAddMember *mOrganizationServiceMockAddMember) Inspect(f func(ctx context.Context, slug string, userID int64, roles []model.Role)) *mOrganizationServiceMockAddMember {
+	if mmAddMember.mock.inspectFuncAddMember != nil {
+		mmAddMember.mock.t.Fatalf("Inspect function is already set for OrganizationServiceMock.AddMember")
+	}
+
+	mmAddMember.mock.inspectFuncAddMember = f
+
+	return mmAddMember
+}
+
+// Return sets up results that will be returned by OrganizationService.AddMember
+func (mmAddMember *mOrganizationServiceMockAddMember) Return(err error) *OrganizationServiceMock {
+	if mmAddMember.mock.funcAddMember != nil {
+		mmAddMember.mock.t.Fatalf("OrganizationServiceMock.AddMember mock is already set by Set")
+	}
+
+	if mmAddMember.defaultExpectation == nil {
+		mmAddMember.defaultExpectation = &OrganizationServiceMockAddMemberExpectation{mock: mmAddMember.mock}
+	}
+	mmAddMember.defaultExpectation.results = &OrganizationServiceMockAddMemberResults{err}
+	return mmAddMember.mock
+}
+
+// Set uses given function f to mock the OrganizationService.AddMember method
+func (mmAddMember *mOrganizationServiceMockAddMember) Set(f func(ctx context.Context, slug string, userID int64, roles []model.Role) (err error)) *OrganizationServiceMock {
+	if mmAddMember.defaultExpectation != nil {
+		mmAddMember.mock.t.Fatalf("Default expectation is already set for the OrganizationService.AddMember method")
+	}
+
+	if len(mmAddMember.expectations) > 0 {
+		mmAddMember.mock.t.Fatalf("Some expectations are already set for the OrganizationService.AddMember method")
+	}
+
+	mmAddMember.mock.funcAddMember = f
+	return mmAddMember.mock
+}
+
+// When sets expectation for the OrganizationService.AddMember which will trigger the result defined by the following
+// Then helper
+func (mmAddMember *mOrganizationServiceMockAddMember) When(ctx context.Context, slug string, userID int64, roles []model.Role) *OrganizationServiceMockAddMemberExpectation {
+	if mmAddMember.mock.funcAddMember != nil {
+		mmAddMember.mock.t.Fatalf("OrganizationServiceMock.AddMember mock is already set by Set")
+	}
+
+	expectation := &OrganizationServiceMockAddMemberExpectation{
+		mock:   mmAddMember.mock,
+		params: &OrganizationServiceMockAddMemberParams{ctx, slug, userID, roles},
+	}
+	mmAddMember.expectations = append(mmAddMember.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrganizationService.AddMember return parameters for the expectation previously defined by the When method
+func (e *OrganizationServiceMockAddMemberExpectation) Then(err error) *OrganizationServiceMock {
+	e.results = &OrganizationServiceMockAddMemberResults{err}
+	return e.mock
+}
+
+// AddMember implements service.OrganizationService
+func (mmAddMember *OrganizationServiceMock) AddMember(ctx context.Context, slug string, userID int64, roles []model.Role) (err error) {
+	mm_atomic.AddUint64(&mmAddMember.beforeAddMemberCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddMember.afterAddMemberCounter, 1)
+
+	if mmAddMember.inspectFuncAddMember != nil {
+		mmAddMember.inspectFuncAddMember(ctx, slug, userID, roles)
+	}
+
+	mm_params := OrganizationServiceMockAddMemberParams{ctx, slug, userID, roles}
+
+	// Record call args
+	mmAddMember.AddMemberMock.mutex.Lock()
+	mmAddMember.AddMemberMock.callArgs = append(mmAddMember.AddMemberMock.callArgs, &mm_params)
+	mmAddMember.AddMemberMock.mutex.Unlock()
+
+	for _, e := range mmAddMember.AddMemberMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddMember.AddMemberMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddMember.AddMemberMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddMember.AddMemberMock.defaultExpectation.params
+		mm_want_ptrs := mmAddMember.AddMemberMock.defaultExpectation.paramPtrs
+
+		mm_got := OrganizationServiceMockAddMemberParams{ctx, slug, userID, roles}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddMember.t.Errorf("OrganizationServiceMock.AddMember got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.slug != nil && !minimock.Equal(*mm_want_ptrs.slug, mm_got.slug) {
+				mmAddMember.t.Errorf("OrganizationServiceMock.AddMember got unexpected parameter slug, want: %#v, got: %#v%s\n", *mm_want_ptrs.slug, mm_got.slug, minimock.Diff(*mm_want_ptrs.slug, mm_got.slug))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmAddMember.t.Errorf("OrganizationServiceMock.AddMember got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.roles != nil && !minimock.Equal(*mm_want_ptrs.roles, mm_got.roles) {
+				mmAddMember.t.Errorf("OrganizationServiceMock.AddMember got unexpected parameter roles, want: %#v, got: %#v%s\n", *mm_want_ptrs.roles, mm_got.roles, minimock.Diff(*mm_want_ptrs.roles, mm_got.roles))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddMember.t.Errorf("OrganizationServiceMock.AddMember got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddMember.AddMemberMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddMember.t.Fatal("No results are set for the OrganizationServiceMock.AddMember")
+		}
+		return (*mm_results).err
+	}
+	if mmAddMember.funcAddMember != nil {
+		return mmAddMember.funcAddMember(ctx, slug, userID, roles)
+	}
+	mmAddMember.t.Fatalf("Unexpected call to OrganizationServiceMock.AddMember. %v %v %v %v", ctx, slug, userID, roles)
+	return
+}
+
+// AddMemberAfterCounter returns a count of finished OrganizationServiceMock.AddMember invocations
+func (mmAddMember *OrganizationServiceMock) AddMemberAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddMember.afterAddMemberCounter)
+}
+
+// AddMemberBeforeCounter returns a count of OrganizationServiceMock.AddMember invocations
+func (mmAddMember *OrganizationServiceMock) AddMemberBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddMember.beforeAddMemberCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrganizationServiceMock.AddMember.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddMember *mOrganizationServiceMockAddMember) Calls() []*OrganizationServiceMockAddMemberParams {
+	mmAddMember.mutex.RLock()
+
+	argCopy := make([]*OrganizationServiceMockAddMemberParams, len(mmAddMember.callArgs))
+	copy(argCopy, mmAddMember.callArgs)
+
+	mmAddMember.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddMemberDone returns true if the count of the AddMember invocations corresponds
+// the number of defined expectations
+func (m *OrganizationServiceMock) MinimockAddMemberDone() bool {
+	for _, e := range m.AddMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddMemberMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterAddMemberCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddMember != nil && mm_atomic.LoadUint64(&m.afterAddMemberCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockAddMemberInspect logs each unmet expectation
+func (m *OrganizationServiceMock) MinimockAddMemberInspect() {
+	for _, e := range m.AddMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrganizationServiceMock.AddMember with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddMemberMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterAddMemberCounter) < 1 {
+		if m.AddMemberMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrganizationServiceMock.AddMember")
+		} else {
+			m.t.Errorf("Expected call to OrganizationServiceMock.AddMember with params: %#v", *m.AddMemberMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddMember != nil && mm_atomic.LoadUint64(&m.afterAddMemberCounter) < 1 {
+		m.t.Error("Expected call to OrganizationServiceMock.AddMember")
+	}
+}
+
+type mOrganizationServiceMockCreate struct {
+	mock               *OrganizationServiceMock
+	defaultExpectation *OrganizationServiceMockCreateExpectation
+	expectations       []*OrganizationServiceMockCreateExpectation
+
+	callArgs []*OrganizationServiceMockCreateParams
+	mutex    sync.RWMutex
+}
+
+// OrganizationServiceMockCreateExpectation specifies expectation struct of the OrganizationService.Create
+type OrganizationServiceMockCreateExpectation struct {
+	mock      *OrganizationServiceMock
+	params    *OrganizationServiceMockCreateParams
+	paramPtrs *OrganizationServiceMockCreateParamPtrs
+	results   *OrganizationServiceMockCreateResults
+	Counter   uint64
+}
+
+// OrganizationServiceMockCreateParams contains parameters of the OrganizationService.Create
+type OrganizationServiceMockCreateParams struct {
+	ctx context.Context
+	org *model.Organization
+}
+
+// OrganizationServiceMockCreateParamPtrs contains pointers to parameters of the OrganizationService.Create
+type OrganizationServiceMockCreateParamPtrs struct {
+	ctx *context.Context
+	org **model.Organization
+}
+
+// OrganizationServiceMockCreateResults contains results of the OrganizationService.Create
+type OrganizationServiceMockCreateResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for OrganizationService.Create
+func (mmCreate *mOrganizationServiceMockCreate) Expect(ctx context.Context, org *model.Organization) *mOrganizationServiceMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("OrganizationServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &OrganizationServiceMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("OrganizationServiceMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &OrganizationServiceMockCreateParams{ctx, org}
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrganizationService.Create
+func (mmCreate *mOrganizationServiceMockCreate) ExpectCtxParam1(ctx context.Context) *mOrganizationServiceMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("OrganizationServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &OrganizationServiceMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("OrganizationServiceMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &OrganizationServiceMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreate
+}
+
+// ExpectOrgParam2 sets up expected param org for OrganizationService.Create
+func (mmCreate *mOrganizationServiceMockCreate) ExpectOrgParam2(org *model.Organization) *mOrganizationServiceMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("OrganizationServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &OrganizationServiceMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("OrganizationServiceMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &OrganizationServiceMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.org = &org
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrganizationService.Create
+func (mmCreate *mOrganizationServiceMockCreate) Inspect(f func(ctx context.Context, org *model.Organization)) *mOrganizationServiceMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for OrganizationServiceMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by OrganizationService.Create
+func (mmCreate *mOrganizationServiceMockCreate) Return(i1 int64, err error) *OrganizationServiceMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("OrganizationServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &OrganizationServiceMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &OrganizationServiceMockCreateResults{i1, err}
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the OrganizationService.Create method
+func (mmCreate *mOrganizationServiceMockCreate) Set(f func(ctx context.Context, org *model.Organization) (i1 int64, err error)) *OrganizationServiceMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the OrganizationService.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the OrganizationService.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	return mmCreate.mock
+}
+
+// When sets expectation for the OrganizationService.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mOrganizationServiceMockCreate) When(ctx context.Context, org *model.Organization) *OrganizationServiceMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("OrganizationServiceMock.Create mock is already set by Set")
+	}
+
+	expectation := &OrganizationServiceMockCreateExpectation{
+		mock:   mmCreate.mock,
+		params: &OrganizationServiceMockCreateParams{ctx, org},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrganizationService.Create return parameters for the expectation previously defined by the When method
+func (e *OrganizationServiceMockCreateExpectation) Then(i1 int64, err error) *OrganizationServiceMock {
+	e.results = &OrganizationServiceMockCreateResults{i1, err}
+	return e.mock
+}
+
+// Create implements service.OrganizationService
+func (mmCreate *OrganizationServiceMock) Create(ctx context.Context, org *model.Organization) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, org)
+	}
+
+	mm_params := OrganizationServiceMockCreateParams{ctx, org}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := OrganizationServiceMockCreateParams{ctx, org}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("OrganizationServiceMock.Create got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.org != nil && !minimock.Equal(*mm_want_ptrs.org, mm_got.org) {
+				mmCreate.t.Errorf("OrganizationServiceMock.Create got unexpected parameter org, want: %#v, got: %#v%s\n", *mm_want_ptrs.org, mm_got.org, minimock.Diff(*mm_want_ptrs.org, mm_got.org))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("OrganizationServiceMock.Create got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the OrganizationServiceMock.Create")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, org)
+	}
+	mmCreate.t.Fatalf("Unexpected call to OrganizationServiceMock.Create. %v %v", ctx, org)
+	return
+}
+
+// CreateAfterCounter returns a count of finished OrganizationServiceMock.Create invocations
+func (mmCreate *OrganizationServiceMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of OrganizationServiceMock.Create invocations
+func (mmCreate *OrganizationServiceMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrganizationServiceMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mOrganizationServiceMockCreate) Calls() []*OrganizationServiceMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*OrganizationServiceMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *OrganizationServiceMock) MinimockCreateDone() bool {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *OrganizationServiceMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrganizationServiceMock.Create with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrganizationServiceMock.Create")
+		} else {
+			m.t.Errorf("Expected call to OrganizationServiceMock.Create with params: %#v", *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		m.t.Error("Expected call to OrganizationServiceMock.Create")
+	}
+}
+
+type mOrganizationServiceMockList struct {
+	mock               *OrganizationServiceMock
+	defaultExpectation *OrganizationServiceMockListExpectation
+	expectations       []*OrganizationServiceMockListExpectation
+
+	callArgs []*OrganizationServiceMockListParams
+	mutex    sync.RWMutex
+}
+
+// OrganizationServiceMockListExpectation specifies expectation struct of the OrganizationService.List
+type OrganizationServiceMockListExpectation struct {
+	mock      *OrganizationServiceMock
+	params    *OrganizationServiceMockListParams
+	paramPtrs *OrganizationServiceMockListParamPtrs
+	results   *OrganizationServiceMockListResults
+	Counter   uint64
+}
+
+// OrganizationServiceMockListParams contains parameters of the OrganizationService.List
+type OrganizationServiceMockListParams struct {
+	ctx context.Context
+}
+
+// OrganizationServiceMockListParamPtrs contains pointers to parameters of the OrganizationService.List
+type OrganizationServiceMockListParamPtrs struct {
+	ctx *context.Context
+}
+
+// OrganizationServiceMockListResults contains results of the OrganizationService.List
+type OrganizationServiceMockListResults struct {
+	oa1 []model.Organization
+	err error
+}
+
+// Expect sets up expected params for OrganizationService.List
+func (mmList *mOrganizationServiceMockList) Expect(ctx context.Context) *mOrganizationServiceMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("OrganizationServiceMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &OrganizationServiceMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("OrganizationServiceMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &OrganizationServiceMockListParams{ctx}
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrganizationService.List
+func (mmList *mOrganizationServiceMockList) ExpectCtxParam1(ctx context.Context) *mOrganizationServiceMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("OrganizationServiceMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &OrganizationServiceMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("OrganizationServiceMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &OrganizationServiceMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrganizationService.List
+func (mmList *mOrganizationServiceMockList) Inspect(f func(ctx context.Context)) *mOrganizationServiceMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for OrganizationServiceMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by OrganizationService.List
+func (mmList *mOrganizationServiceMockList) Return(oa1 []model.Organization, err error) *OrganizationServiceMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("OrganizationServiceMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &OrganizationServiceMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &OrganizationServiceMockListResults{oa1, err}
+	return mmList.mock
+}
+
+// Set uses given function f to mock the OrganizationService.List method
+func (mmList *mOrganizationServiceMockList) Set(f func(ctx context.Context) (oa1 []model.Organization, err error)) *OrganizationServiceMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the OrganizationService.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the OrganizationService.List method")
+	}
+
+	mmList.mock.funcList = f
+	return mmList.mock
+}
+
+// When sets expectation for the OrganizationService.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mOrganizationServiceMockList) When(ctx context.Context) *OrganizationServiceMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("OrganizationServiceMock.List mock is already set by Set")
+	}
+
+	expectation := &OrganizationServiceMockListExpectation{
+		mock:   mmList.mock,
+		params: &OrganizationServiceMockListParams{ctx},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrganizationService.List return parameters for the expectation previously defined by the When method
+func (e *OrganizationServiceMockListExpectation) Then(oa1 []model.Organization, err error) *OrganizationServiceMock {
+	e.results = &OrganizationServiceMockListResults{oa1, err}
+	return e.mock
+}
+
+// List implements service.OrganizationService
+func (mmList *OrganizationServiceMock) List(ctx context.Context) (oa1 []model.Organization, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx)
+	}
+
+	mm_params := OrganizationServiceMockListParams{ctx}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.oa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := OrganizationServiceMockListParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("OrganizationServiceMock.List got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("OrganizationServiceMock.List got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the OrganizationServiceMock.List")
+		}
+		return (*mm_results).oa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx)
+	}
+	mmList.t.Fatalf("Unexpected call to OrganizationServiceMock.List. %v", ctx)
+	return
+}
+
+// ListAfterCounter returns a count of finished OrganizationServiceMock.List invocations
+func (mmList *OrganizationServiceMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of OrganizationServiceMock.List invocations
+func (mmList *OrganizationServiceMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrganizationServiceMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mOrganizationServiceMockList) Calls() []*OrganizationServiceMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*OrganizationServiceMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *OrganizationServiceMock) MinimockListDone() bool {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && mm_atomic.LoadUint64(&m.afterListCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *OrganizationServiceMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrganizationServiceMock.List with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListCounter) < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrganizationServiceMock.List")
+		} else {
+			m.t.Errorf("Expected call to OrganizationServiceMock.List with params: %#v", *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && mm_atomic.LoadUint64(&m.afterListCounter) < 1 {
+		m.t.Error("Expected call to OrganizationServiceMock.List")
+	}
+}
+
+type mOrganizationServiceMockRemoveMember struct {
+	mock               *OrganizationServiceMock
+	defaultExpectation *OrganizationServiceMockRemoveMemberExpectation
+	expectations       []*OrganizationServiceMockRemoveMemberExpectation
+
+	callArgs []*OrganizationServiceMockRemoveMemberParams
+	mutex    sync.RWMutex
+}
+
+// OrganizationServiceMockRemoveMemberExpectation specifies expectation struct of the OrganizationService.RemoveMember
+type OrganizationServiceMockRemoveMemberExpectation struct {
+	mock      *OrganizationServiceMock
+	params    *OrganizationServiceMockRemoveMemberParams
+	paramPtrs *OrganizationServiceMockRemoveMemberParamPtrs
+	results   *OrganizationServiceMockRemoveMemberResults
+	Counter   uint64
+}
+
+// OrganizationServiceMockRemoveMemberParams contains parameters of the OrganizationService.RemoveMember
+type OrganizationServiceMockRemoveMemberParams struct {
+	ctx    context.Context
+	slug   string
+	userID int64
+}
+
+// OrganizationServiceMockRemoveMemberParamPtrs contains pointers to parameters of the OrganizationService.RemoveMember
+type OrganizationServiceMockRemoveMemberParamPtrs struct {
+	ctx    *context.Context
+	slug   *string
+	userID *int64
+}
+
+// OrganizationServiceMockRemoveMemberResults contains results of the OrganizationService.RemoveMember
+type OrganizationServiceMockRemoveMemberResults struct {
+	err error
+}
+
+// Expect sets up expected params for OrganizationService.RemoveMember
+func (mmRemoveMember *mOrganizationServiceMockRemoveMember) Expect(ctx context.Context, slug string, userID int64) *mOrganizationServiceMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("OrganizationServiceMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &OrganizationServiceMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs != nil {
+		mmRemoveMember.mock.t.Fatalf("OrganizationServiceMock.RemoveMember mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveMember.defaultExpectation.params = &OrganizationServiceMockRemoveMemberParams{ctx, slug, userID}
+	for _, e := range mmRemoveMember.expectations {
+		if minimock.Equal(e.params, mmRemoveMember.defaultExpectation.params) {
+			mmRemoveMember.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveMember.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveMember
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrganizationService.RemoveMember
+func (mmRemoveMember *mOrganizationServiceMockRemoveMember) ExpectCtxParam1(ctx context.Context) *mOrganizationServiceMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("OrganizationServiceMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &OrganizationServiceMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.params != nil {
+		mmRemoveMember.mock.t.Fatalf("OrganizationServiceMock.RemoveMember mock is already set by Expect")
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveMember.defaultExpectation.paramPtrs = &OrganizationServiceMockRemoveMemberParamPtrs{}
+	}
+	mmRemoveMember.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRemoveMember
+}
+
+// ExpectSlugParam2 sets up expected param slug for OrganizationService.RemoveMember
+func (mmRemoveMember *mOrganizationServiceMockRemoveMember) ExpectSlugParam2(slug string) *mOrganizationServiceMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("OrganizationServiceMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &OrganizationServiceMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.params != nil {
+		mmRemoveMember.mock.t.Fatalf("OrganizationServiceMock.RemoveMember mock is already set by Expect")
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveMember.defaultExpectation.paramPtrs = &OrganizationServiceMockRemoveMemberParamPtrs{}
+	}
+	mmRemoveMember.defaultExpectation.paramPtrs.slug = &slug
+
+	return mmRemoveMember
+}
+
+// ExpectUserIDParam3 sets up expected param userID for OrganizationService.RemoveMember
+func (mmRemoveMember *mOrganizationServiceMockRemoveMember) ExpectUserIDParam3(userID int64) *mOrganizationServiceMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("OrganizationServiceMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &OrganizationServiceMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.params != nil {
+		mmRemoveMember.mock.t.Fatalf("OrganizationServiceMock.RemoveMember mock is already set by Expect")
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveMember.defaultExpectation.paramPtrs = &OrganizationServiceMockRemoveMemberParamPtrs{}
+	}
+	mmRemoveMember.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmRemoveMember
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrganizationService.RemoveMember
+func (mmRemoveMember *mOrganizationServiceMockRemoveMember) Inspect(f func(ctx context.Context, slug string, userID int64)) *mOrganizationServiceMockRemoveMember {
+	if mmRemoveMember.mock.inspectFuncRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("Inspect function is already set for OrganizationServiceMock.RemoveMember")
+	}
+
+	mmRemoveMember.mock.inspectFuncRemoveMember = f
+
+	return mmRemoveMember
+}
+
+// Return sets up results that will be returned by OrganizationService.RemoveMember
+func (mmRemoveMember *mOrganizationServiceMockRemoveMember) Return(err error) *OrganizationServiceMock {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("OrganizationServiceMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &OrganizationServiceMockRemoveMemberExpectation{mock: mmRemoveMember.mock}
+	}
+	mmRemoveMember.defaultExpectation.results = &OrganizationServiceMockRemoveMemberResults{err}
+	return mmRemoveMember.mock
+}
+
+// Set uses given function f to mock the OrganizationService.RemoveMember method
+func (mmRemoveMember *mOrganizationServiceMockRemoveMember) Set(f func(ctx context.Context, slug string, userID int64) (err error)) *OrganizationServiceMock {
+	if mmRemoveMember.defaultExpectation != nil {
+		mmRemoveMember.mock.t.Fatalf("Default expectation is already set for the OrganizationService.RemoveMember method")
+	}
+
+	if len(mmRemoveMember.expectations) > 0 {
+		mmRemoveMember.mock.t.Fatalf("Some expectations are already set for the OrganizationService.RemoveMember method")
+	}
+
+	mmRemoveMember.mock.funcRemoveMember = f
+	return mmRemoveMember.mock
+}
+
+// When sets expectation for the OrganizationService.RemoveMember which will trigger the result defined by the following
+// Then helper
+func (mmRemoveMember *mOrganizationServiceMockRemoveMember) When(ctx context.Context, slug string, userID int64) *OrganizationServiceMockRemoveMemberExpectation {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("OrganizationServiceMock.RemoveMember mock is already set by Set")
+	}
+
+	expectation := &OrganizationServiceMockRemoveMemberExpectation{
+		mock:   mmRemoveMember.mock,
+		params: &OrganizationServiceMockRemoveMemberParams{ctx, slug, userID},
+	}
+	mmRemoveMember.expectations = append(mmRemoveMember.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrganizationService.RemoveMember return parameters for the expectation previously defined by the When method
+func (e *OrganizationServiceMockRemoveMemberExpectation) Then(err error) *OrganizationServiceMock {
+	e.results = &OrganizationServiceMockRemoveMemberResults{err}
+	return e.mock
+}
+
+// RemoveMember implements service.OrganizationService
+func (mmRemoveMember *OrganizationServiceMock) RemoveMember(ctx context.Context, slug string, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmRemoveMember.beforeRemoveMemberCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveMember.afterRemoveMemberCounter, 1)
+
+	if mmRemoveMember.inspectFuncRemoveMember != nil {
+		mmRemoveMember.inspectFuncRemoveMember(ctx, slug, userID)
+	}
+
+	mm_params := OrganizationServiceMockRemoveMemberParams{ctx, slug, userID}
+
+	// Record call args
+	mmRemoveMember.RemoveMemberMock.mutex.Lock()
+	mmRemoveMember.RemoveMemberMock.callArgs = append(mmRemoveMember.RemoveMemberMock.callArgs, &mm_params)
+	mmRemoveMember.RemoveMemberMock.mutex.Unlock()
+
+	for _, e := range mmRemoveMember.RemoveMemberMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRemoveMember.RemoveMemberMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveMember.RemoveMemberMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveMember.RemoveMemberMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveMember.RemoveMemberMock.defaultExpectation.paramPtrs
+
+		mm_got := OrganizationServiceMockRemoveMemberParams{ctx, slug, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveMember.t.Errorf("OrganizationServiceMock.RemoveMember got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.slug != nil && !minimock.Equal(*mm_want_ptrs.slug, mm_got.slug) {
+				mmRemoveMember.t.Errorf("OrganizationServiceMock.RemoveMember got unexpected parameter slug, want: %#v, got: %#v%s\n", *mm_want_ptrs.slug, mm_got.slug, minimock.Diff(*mm_want_ptrs.slug, mm_got.slug))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRemoveMember.t.Errorf("OrganizationServiceMock.RemoveMember got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveMember.t.Errorf("OrganizationServiceMock.RemoveMember got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveMember.RemoveMemberMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveMember.t.Fatal("No results are set for the OrganizationServiceMock.RemoveMember")
+		}
+		return (*mm_results).err
+	}
+	if mmRemoveMember.funcRemoveMember != nil {
+		return mmRemoveMember.funcRemoveMember(ctx, slug, userID)
+	}
+	mmRemoveMember.t.Fatalf("Unexpected call to OrganizationServiceMock.RemoveMember. %v %v %v", ctx, slug, userID)
+	return
+}
+
+// RemoveMemberAfterCounter returns a count of finished OrganizationServiceMock.RemoveMember invocations
+func (mmRemoveMember *OrganizationServiceMock) RemoveMemberAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveMember.afterRemoveMemberCounter)
+}
+
+// RemoveMemberBeforeCounter returns a count of OrganizationServiceMock.RemoveMember invocations
+func (mmRemoveMember *OrganizationServiceMock) RemoveMemberBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveMember.beforeRemoveMemberCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrganizationServiceMock.RemoveMember.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveMember *mOrganizationServiceMockRemoveMember) Calls() []*OrganizationServiceMockRemoveMemberParams {
+	mmRemoveMember.mutex.RLock()
+
+	argCopy := make([]*OrganizationServiceMockRemoveMemberParams, len(mmRemoveMember.callArgs))
+	copy(argCopy, mmRemoveMember.callArgs)
+
+	mmRemoveMember.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveMemberDone returns true if the count of the RemoveMember invocations corresponds
+// the number of defined expectations
+func (m *OrganizationServiceMock) MinimockRemoveMemberDone() bool {
+	for _, e := range m.RemoveMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveMemberMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRemoveMemberCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveMember != nil && mm_atomic.LoadUint64(&m.afterRemoveMemberCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockRemoveMemberInspect logs each unmet expectation
+func (m *OrganizationServiceMock) MinimockRemoveMemberInspect() {
+	for _, e := range m.RemoveMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrganizationServiceMock.RemoveMember with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveMemberMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRemoveMemberCounter) < 1 {
+		if m.RemoveMemberMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrganizationServiceMock.RemoveMember")
+		} else {
+			m.t.Errorf("Expected call to OrganizationServiceMock.RemoveMember with params: %#v", *m.RemoveMemberMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveMember != nil && mm_atomic.LoadUint64(&m.afterRemoveMemberCounter) < 1 {
+		m.t.Error("Expected call to OrganizationServiceMock.RemoveMember")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *OrganizationServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddMemberInspect()
+
+			m.MinimockCreateInspect()
+
+			m.MinimockListInspect()
+
+			m.MinimockRemoveMemberInspect()
+			m.t.FailNow()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *OrganizationServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *OrganizationServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddMemberDone() &&
+		m.MinimockCreateDone() &&
+		m.MinimockListDone() &&
+		m.MinimockRemoveMemberDone()
+}
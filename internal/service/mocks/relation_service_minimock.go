@@ -0,0 +1,1477 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.8). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/arifullov/auth/internal/service.RelationService -o relation_service_minimock.go -n RelationServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RelationServiceMock implements service.RelationService
+type RelationServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCheck          func(ctx context.Context, object model.RelationObject, relation string, userID string, consistency model.RelationConsistency) (b1 bool, s1 string, err error)
+	inspectFuncCheck   func(ctx context.Context, object model.RelationObject, relation string, userID string, consistency model.RelationConsistency)
+	afterCheckCounter  uint64
+	beforeCheckCounter uint64
+	CheckMock          mRelationServiceMockCheck
+
+	funcExpand          func(ctx context.Context, object model.RelationObject, relation string, consistency model.RelationConsistency) (rp1 *model.RelationExpandNode, s1 string, err error)
+	inspectFuncExpand   func(ctx context.Context, object model.RelationObject, relation string, consistency model.RelationConsistency)
+	afterExpandCounter  uint64
+	beforeExpandCounter uint64
+	ExpandMock          mRelationServiceMockExpand
+
+	funcReadTuples          func(ctx context.Context, filter model.RelationTupleFilter, pageToken string, consistency model.RelationConsistency) (rp1 *model.RelationTuplePage, err error)
+	inspectFuncReadTuples   func(ctx context.Context, filter model.RelationTupleFilter, pageToken string, consistency model.RelationConsistency)
+	afterReadTuplesCounter  uint64
+	beforeReadTuplesCounter uint64
+	ReadTuplesMock          mRelationServiceMockReadTuples
+
+	funcWriteTuples          func(ctx context.Context, writes []model.RelationTuple, deletes []model.RelationTuple) (s1 string, err error)
+	inspectFuncWriteTuples   func(ctx context.Context, writes []model.RelationTuple, deletes []model.RelationTuple)
+	afterWriteTuplesCounter  uint64
+	beforeWriteTuplesCounter uint64
+	WriteTuplesMock          mRelationServiceMockWriteTuples
+}
+
+// NewRelationServiceMock returns a mock for service.RelationService
+func NewRelationServiceMock(t minimock.Tester) *RelationServiceMock {
+	m := &RelationServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CheckMock = mRelationServiceMockCheck{mock: m}
+	m.CheckMock.callArgs = []*RelationServiceMockCheckParams{}
+
+	m.ExpandMock = mRelationServiceMockExpand{mock: m}
+	m.ExpandMock.callArgs = []*RelationServiceMockExpandParams{}
+
+	m.ReadTuplesMock = mRelationServiceMockReadTuples{mock: m}
+	m.ReadTuplesMock.callArgs = []*RelationServiceMockReadTuplesParams{}
+
+	m.WriteTuplesMock = mRelationServiceMockWriteTuples{mock: m}
+	m.WriteTuplesMock.callArgs = []*RelationServiceMockWriteTuplesParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRelationServiceMockCheck struct {
+	mock               *RelationServiceMock
+	defaultExpectation *RelationServiceMockCheckExpectation
+	expectations       []*RelationServiceMockCheckExpectation
+
+	callArgs []*RelationServiceMockCheckParams
+	mutex    sync.RWMutex
+}
+
+// RelationServiceMockCheckExpectation specifies expectation struct of the RelationService.Check
+type RelationServiceMockCheckExpectation struct {
+	mock      *RelationServiceMock
+	params    *RelationServiceMockCheckParams
+	paramPtrs *RelationServiceMockCheckParamPtrs
+	results   *RelationServiceMockCheckResults
+	Counter   uint64
+}
+
+// RelationServiceMockCheckParams contains parameters of the RelationService.Check
+type RelationServiceMockCheckParams struct {
+	ctx         context.Context
+	object      model.RelationObject
+	relation    string
+	userID      string
+	consistency model.RelationConsistency
+}
+
+// RelationServiceMockCheckParamPtrs contains pointers to parameters of the RelationService.Check
+type RelationServiceMockCheckParamPtrs struct {
+	ctx         *context.Context
+	object      *model.RelationObject
+	relation    *string
+	userID      *string
+	consistency *model.RelationConsistency
+}
+
+// RelationServiceMockCheckResults contains results of the RelationService.Check
+type RelationServiceMockCheckResults struct {
+	b1  bool
+	s1  string
+	err error
+}
+
+// Expect sets up expected params for RelationService.Check
+func (mmCheck *mRelationServiceMockCheck) Expect(ctx context.Context, object model.RelationObject, relation string, userID string, consistency model.RelationConsistency) *mRelationServiceMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("RelationServiceMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &RelationServiceMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs != nil {
+		mmCheck.mock.t.Fatalf("RelationServiceMock.Check mock is already set by ExpectParams functions")
+	}
+
+	mmCheck.defaultExpectation.params = &RelationServiceMockCheckParams{ctx, object, relation, userID, consistency}
+	for _, e := range mmCheck.expectations {
+		if minimock.Equal(e.params, mmCheck.defaultExpectation.params) {
+			mmCheck.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheck.defaultExpectation.params)
+		}
+	}
+
+	return mmCheck
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationService.Check
+func (mmCheck *mRelationServiceMockCheck) ExpectCtxParam1(ctx context.Context) *mRelationServiceMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("RelationServiceMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &RelationServiceMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.params != nil {
+		mmCheck.mock.t.Fatalf("RelationServiceMock.Check mock is already set by Expect")
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs == nil {
+		mmCheck.defaultExpectation.paramPtrs = &RelationServiceMockCheckParamPtrs{}
+	}
+	mmCheck.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCheck
+}
+
+// ExpectObjectParam2 sets up expected param object for RelationService.Check
+func (mmCheck *mRelationServiceMockCheck) ExpectObjectParam2(object model.RelationObject) *mRelationServiceMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("RelationServiceMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &RelationServiceMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.params != nil {
+		mmCheck.mock.t.Fatalf("RelationServiceMock.Check mock is already set by Expect")
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs == nil {
+		mmCheck.defaultExpectation.paramPtrs = &RelationServiceMockCheckParamPtrs{}
+	}
+	mmCheck.defaultExpectation.paramPtrs.object = &object
+
+	return mmCheck
+}
+
+// ExpectRelationParam3 sets up expected param relation for RelationService.Check
+func (mmCheck *mRelationServiceMockCheck) ExpectRelationParam3(relation string) *mRelationServiceMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("RelationServiceMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &RelationServiceMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.params != nil {
+		mmCheck.mock.t.Fatalf("RelationServiceMock.Check mock is already set by Expect")
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs == nil {
+		mmCheck.defaultExpectation.paramPtrs = &RelationServiceMockCheckParamPtrs{}
+	}
+	mmCheck.defaultExpectation.paramPtrs.relation = &relation
+
+	return mmCheck
+}
+
+// ExpectUserIDParam4 sets up expected param userID for RelationService.Check
+func (mmCheck *mRelationServiceMockCheck) ExpectUserIDParam4(userID string) *mRelationServiceMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("RelationServiceMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &RelationServiceMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.params != nil {
+		mmCheck.mock.t.Fatalf("RelationServiceMock.Check mock is already set by Expect")
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs == nil {
+		mmCheck.defaultExpectation.paramPtrs = &RelationServiceMockCheckParamPtrs{}
+	}
+	mmCheck.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmCheck
+}
+
+// ExpectConsistencyParam5 sets up expected param consistency for RelationService.Check
+func (mmCheck *mRelationServiceMockCheck) ExpectConsistencyParam5(consistency model.RelationConsistency) *mRelationServiceMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("RelationServiceMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &RelationServiceMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.params != nil {
+		mmCheck.mock.t.Fatalf("RelationServiceMock.Check mock is already set by Expect")
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs == nil {
+		mmCheck.defaultExpectation.paramPtrs = &RelationServiceMockCheckParamPtrs{}
+	}
+	mmCheck.defaultExpectation.paramPtrs.consistency = &consistency
+
+	return mmCheck
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationService.Check
+func (mmCheck *mRelationServiceMockCheck) Inspect(f func(ctx context.Context, object model.RelationObject, relation string, userID string, consistency model.RelationConsistency)) *mRelationServiceMockCheck {
+	if mmCheck.mock.inspectFuncCheck != nil {
+		mmCheck.mock.t.Fatalf("Inspect function is already set for RelationServiceMock.Check")
+	}
+
+	mmCheck.mock.inspectFuncCheck = f
+
+	return mmCheck
+}
+
+// Return sets up results that will be returned by RelationService.Check
+func (mmCheck *mRelationServiceMockCheck) Return(b1 bool, s1 string, err error) *RelationServiceMock {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("RelationServiceMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &RelationServiceMockCheckExpectation{mock: mmCheck.mock}
+	}
+	mmCheck.defaultExpectation.results = &RelationServiceMockCheckResults{b1, s1, err}
+	return mmCheck.mock
+}
+
+// Set uses given function f to mock the RelationService.Check method
+func (mmCheck *mRelationServiceMockCheck) Set(f func(ctx context.Context, object model.RelationObject, relation string, userID string, consistency model.RelationConsistency) (b1 bool, s1 string, err error)) *RelationServiceMock {
+	if mmCheck.defaultExpectation != nil {
+		mmCheck.mock.t.Fatalf("Default expectation is already set for the RelationService.Check method")
+	}
+
+	if len(mmCheck.expectations) > 0 {
+		mmCheck.mock.t.Fatalf("Some expectations are already set for the RelationService.Check method")
+	}
+
+	mmCheck.mock.funcCheck = f
+	return mmCheck.mock
+}
+
+// When sets expectation for the RelationService.Check which will trigger the result defined by the following
+// Then helper
+func (mmCheck *mRelationServiceMockCheck) When(ctx context.Context, object model.RelationObject, relation string, userID string, consistency model.RelationConsistency) *RelationServiceMockCheckExpectation {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("RelationServiceMock.Check mock is already set by Set")
+	}
+
+	expectation := &RelationServiceMockCheckExpectation{
+		mock:   mmCheck.mock,
+		params: &RelationServiceMockCheckParams{ctx, object, relation, userID, consistency},
+	}
+	mmCheck.expectations = append(mmCheck.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationService.Check return parameters for the expectation previously defined by the When method
+func (e *RelationServiceMockCheckExpectation) Then(b1 bool, s1 string, err error) *RelationServiceMock {
+	e.results = &RelationServiceMockCheckResults{b1, s1, err}
+	return e.mock
+}
+
+// Check implements service.RelationService
+func (mmCheck *RelationServiceMock) Check(ctx context.Context, object model.RelationObject, relation string, userID string, consistency model.RelationConsistency) (b1 bool, s1 string, err error) {
+	mm_atomic.AddUint64(&mmCheck.beforeCheckCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheck.afterCheckCounter, 1)
+
+	if mmCheck.inspectFuncCheck != nil {
+		mmCheck.inspectFuncCheck(ctx, object, relation, userID, consistency)
+	}
+
+	mm_params := RelationServiceMockCheckParams{ctx, object, relation, userID, consistency}
+
+	// Record call args
+	mmCheck.CheckMock.mutex.Lock()
+	mmCheck.CheckMock.callArgs = append(mmCheck.CheckMock.callArgs, &mm_params)
+	mmCheck.CheckMock.mutex.Unlock()
+
+	for _, e := range mmCheck.CheckMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.s1, e.results.err
+		}
+	}
+
+	if mmCheck.CheckMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheck.CheckMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheck.CheckMock.defaultExpectation.params
+		mm_want_ptrs := mmCheck.CheckMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationServiceMockCheckParams{ctx, object, relation, userID, consistency}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheck.t.Errorf("RelationServiceMock.Check got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.object != nil && !minimock.Equal(*mm_want_ptrs.object, mm_got.object) {
+				mmCheck.t.Errorf("RelationServiceMock.Check got unexpected parameter object, want: %#v, got: %#v%s\n", *mm_want_ptrs.object, mm_got.object, minimock.Diff(*mm_want_ptrs.object, mm_got.object))
+			}
+
+			if mm_want_ptrs.relation != nil && !minimock.Equal(*mm_want_ptrs.relation, mm_got.relation) {
+				mmCheck.t.Errorf("RelationServiceMock.Check got unexpected parameter relation, want: %#v, got: %#v%s\n", *mm_want_ptrs.relation, mm_got.relation, minimock.Diff(*mm_want_ptrs.relation, mm_got.relation))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmCheck.t.Errorf("RelationServiceMock.Check got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.consistency != nil && !minimock.Equal(*mm_want_ptrs.consistency, mm_got.consistency) {
+				mmCheck.t.Errorf("RelationServiceMock.Check got unexpected parameter consistency, want: %#v, got: %#v%s\n", *mm_want_ptrs.consistency, mm_got.consistency, minimock.Diff(*mm_want_ptrs.consistency, mm_got.consistency))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheck.t.Errorf("RelationServiceMock.Check got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheck.CheckMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheck.t.Fatal("No results are set for the RelationServiceMock.Check")
+		}
+		return (*mm_results).b1, (*mm_results).s1, (*mm_results).err
+	}
+	if mmCheck.funcCheck != nil {
+		return mmCheck.funcCheck(ctx, object, relation, userID, consistency)
+	}
+	mmCheck.t.Fatalf("Unexpected call to RelationServiceMock.Check. %v %v %v %v %v", ctx, object, relation, userID, consistency)
+	return
+}
+
+// CheckAfterCounter returns a count of finished RelationServiceMock.Check invocations
+func (mmCheck *RelationServiceMock) CheckAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheck.afterCheckCounter)
+}
+
+// CheckBeforeCounter returns a count of RelationServiceMock.Check invocations
+func (mmCheck *RelationServiceMock) CheckBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheck.beforeCheckCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationServiceMock.Check.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheck *mRelationServiceMockCheck) Calls() []*RelationServiceMockCheckParams {
+	mmCheck.mutex.RLock()
+
+	argCopy := make([]*RelationServiceMockCheckParams, len(mmCheck.callArgs))
+	copy(argCopy, mmCheck.callArgs)
+
+	mmCheck.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckDone returns true if the count of the Check invocations corresponds
+// the number of defined expectations
+func (m *RelationServiceMock) MinimockCheckDone() bool {
+	for _, e := range m.CheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCheckCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheck != nil && mm_atomic.LoadUint64(&m.afterCheckCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCheckInspect logs each unmet expectation
+func (m *RelationServiceMock) MinimockCheckInspect() {
+	for _, e := range m.CheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationServiceMock.Check with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCheckCounter) < 1 {
+		if m.CheckMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RelationServiceMock.Check")
+		} else {
+			m.t.Errorf("Expected call to RelationServiceMock.Check with params: %#v", *m.CheckMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheck != nil && mm_atomic.LoadUint64(&m.afterCheckCounter) < 1 {
+		m.t.Error("Expected call to RelationServiceMock.Check")
+	}
+}
+
+type mRelationServiceMockExpand struct {
+	mock               *RelationServiceMock
+	defaultExpectation *RelationServiceMockExpandExpectation
+	expectations       []*RelationServiceMockExpandExpectation
+
+	callArgs []*RelationServiceMockExpandParams
+	mutex    sync.RWMutex
+}
+
+// RelationServiceMockExpandExpectation specifies expectation struct of the RelationService.Expand
+type RelationServiceMockExpandExpectation struct {
+	mock      *RelationServiceMock
+	params    *RelationServiceMockExpandParams
+	paramPtrs *RelationServiceMockExpandParamPtrs
+	results   *RelationServiceMockExpandResults
+	Counter   uint64
+}
+
+// RelationServiceMockExpandParams contains parameters of the RelationService.Expand
+type RelationServiceMockExpandParams struct {
+	ctx         context.Context
+	object      model.RelationObject
+	relation    string
+	consistency model.RelationConsistency
+}
+
+// RelationServiceMockExpandParamPtrs contains pointers to parameters of the RelationService.Expand
+type RelationServiceMockExpandParamPtrs struct {
+	ctx         *context.Context
+	object      *model.RelationObject
+	relation    *string
+	consistency *model.RelationConsistency
+}
+
+// RelationServiceMockExpandResults contains results of the RelationService.Expand
+type RelationServiceMockExpandResults struct {
+	rp1 *model.RelationExpandNode
+	s1  string
+	err error
+}
+
+// Expect sets up expected params for RelationService.Expand
+func (mmExpand *mRelationServiceMockExpand) Expect(ctx context.Context, object model.RelationObject, relation string, consistency model.RelationConsistency) *mRelationServiceMockExpand {
+	if mmExpand.mock.funcExpand != nil {
+		mmExpand.mock.t.Fatalf("RelationServiceMock.Expand mock is already set by Set")
+	}
+
+	if mmExpand.defaultExpectation == nil {
+		mmExpand.defaultExpectation = &RelationServiceMockExpandExpectation{}
+	}
+
+	if mmExpand.defaultExpectation.paramPtrs != nil {
+		mmExpand.mock.t.Fatalf("RelationServiceMock.Expand mock is already set by ExpectParams functions")
+	}
+
+	mmExpand.defaultExpectation.params = &RelationServiceMockExpandParams{ctx, object, relation, consistency}
+	for _, e := range mmExpand.expectations {
+		if minimock.Equal(e.params, mmExpand.defaultExpectation.params) {
+			mmExpand.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExpand.defaultExpectation.params)
+		}
+	}
+
+	return mmExpand
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationService.Expand
+func (mmExpand *mRelationServiceMockExpand) ExpectCtxParam1(ctx context.Context) *mRelationServiceMockExpand {
+	if mmExpand.mock.funcExpand != nil {
+		mmExpand.mock.t.Fatalf("RelationServiceMock.Expand mock is already set by Set")
+	}
+
+	if mmExpand.defaultExpectation == nil {
+		mmExpand.defaultExpectation = &RelationServiceMockExpandExpectation{}
+	}
+
+	if mmExpand.defaultExpectation.params != nil {
+		mmExpand.mock.t.Fatalf("RelationServiceMock.Expand mock is already set by Expect")
+	}
+
+	if mmExpand.defaultExpectation.paramPtrs == nil {
+		mmExpand.defaultExpectation.paramPtrs = &RelationServiceMockExpandParamPtrs{}
+	}
+	mmExpand.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmExpand
+}
+
+// ExpectObjectParam2 sets up expected param object for RelationService.Expand
+func (mmExpand *mRelationServiceMockExpand) ExpectObjectParam2(object model.RelationObject) *mRelationServiceMockExpand {
+	if mmExpand.mock.funcExpand != nil {
+		mmExpand.mock.t.Fatalf("RelationServiceMock.Expand mock is already set by Set")
+	}
+
+	if mmExpand.defaultExpectation == nil {
+		mmExpand.defaultExpectation = &RelationServiceMockExpandExpectation{}
+	}
+
+	if mmExpand.defaultExpectation.params != nil {
+		mmExpand.mock.t.Fatalf("RelationServiceMock.Expand mock is already set by Expect")
+	}
+
+	if mmExpand.defaultExpectation.paramPtrs == nil {
+		mmExpand.defaultExpectation.paramPtrs = &RelationServiceMockExpandParamPtrs{}
+	}
+	mmExpand.defaultExpectation.paramPtrs.object = &object
+
+	return mmExpand
+}
+
+// ExpectRelationParam3 sets up expected param relation for RelationService.Expand
+func (mmExpand *mRelationServiceMockExpand) ExpectRelationParam3(relation string) *mRelationServiceMockExpand {
+	if mmExpand.mock.funcExpand != nil {
+		mmExpand.mock.t.Fatalf("RelationServiceMock.Expand mock is already set by Set")
+	}
+
+	if mmExpand.defaultExpectation == nil {
+		mmExpand.defaultExpectation = &RelationServiceMockExpandExpectation{}
+	}
+
+	if mmExpand.defaultExpectation.params != nil {
+		mmExpand.mock.t.Fatalf("RelationServiceMock.Expand mock is already set by Expect")
+	}
+
+	if mmExpand.defaultExpectation.paramPtrs == nil {
+		mmExpand.defaultExpectation.paramPtrs = &RelationServiceMockExpandParamPtrs{}
+	}
+	mmExpand.defaultExpectation.paramPtrs.relation = &relation
+
+	return mmExpand
+}
+
+// ExpectConsistencyParam4 sets up expected param consistency for RelationService.Expand
+func (mmExpand *mRelationServiceMockExpand) ExpectConsistencyParam4(consistency model.RelationConsistency) *mRelationServiceMockExpand {
+	if mmExpand.mock.funcExpand != nil {
+		mmExpand.mock.t.Fatalf("RelationServiceMock.Expand mock is already set by Set")
+	}
+
+	if mmExpand.defaultExpectation == nil {
+		mmExpand.defaultExpectation = &RelationServiceMockExpandExpectation{}
+	}
+
+	if mmExpand.defaultExpectation.params != nil {
+		mmExpand.mock.t.Fatalf("RelationServiceMock.Expand mock is already set by Expect")
+	}
+
+	if mmExpand.defaultExpectation.paramPtrs == nil {
+		mmExpand.defaultExpectation.paramPtrs = &RelationServiceMockExpandParamPtrs{}
+	}
+	mmExpand.defaultExpectation.paramPtrs.consistency = &consistency
+
+	return mmExpand
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationService.Expand
+func (mmExpand *mRelationServiceMockExpand) Inspect(f func(ctx context.Context, object model.RelationObject, relation string, consistency model.RelationConsistency)) *mRelationServiceMockExpand {
+	if mmExpand.mock.inspectFuncExpand != nil {
+		mmExpand.mock.t.Fatalf("Inspect function is already set for RelationServiceMock.Expand")
+	}
+
+	mmExpand.mock.inspectFuncExpand = f
+
+	return mmExpand
+}
+
+// Return sets up results that will be returned by RelationService.Expand
+func (mmExpand *mRelationServiceMockExpand) Return(rp1 *model.RelationExpandNode, s1 string, err error) *RelationServiceMock {
+	if mmExpand.mock.funcExpand != nil {
+		mmExpand.mock.t.Fatalf("RelationServiceMock.Expand mock is already set by Set")
+	}
+
+	if mmExpand.defaultExpectation == nil {
+		mmExpand.defaultExpectation = &RelationServiceMockExpandExpectation{mock: mmExpand.mock}
+	}
+	mmExpand.defaultExpectation.results = &RelationServiceMockExpandResults{rp1, s1, err}
+	return mmExpand.mock
+}
+
+// Set uses given function f to mock the RelationService.Expand method
+func (mmExpand *mRelationServiceMockExpand) Set(f func(ctx context.Context, object model.RelationObject, relation string, consistency model.RelationConsistency) (rp1 *model.RelationExpandNode, s1 string, err error)) *RelationServiceMock {
+	if mmExpand.defaultExpectation != nil {
+		mmExpand.mock.t.Fatalf("Default expectation is already set for the RelationService.Expand method")
+	}
+
+	if len(mmExpand.expectations) > 0 {
+		mmExpand.mock.t.Fatalf("Some expectations are already set for the RelationService.Expand method")
+	}
+
+	mmExpand.mock.funcExpand = f
+	return mmExpand.mock
+}
+
+// When sets expectation for the RelationService.Expand which will trigger the result defined by the following
+// Then helper
+func (mmExpand *mRelationServiceMockExpand) When(ctx context.Context, object model.RelationObject, relation string, consistency model.RelationConsistency) *RelationServiceMockExpandExpectation {
+	if mmExpand.mock.funcExpand != nil {
+		mmExpand.mock.t.Fatalf("RelationServiceMock.Expand mock is already set by Set")
+	}
+
+	expectation := &RelationServiceMockExpandExpectation{
+		mock:   mmExpand.mock,
+		params: &RelationServiceMockExpandParams{ctx, object, relation, consistency},
+	}
+	mmExpand.expectations = append(mmExpand.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationService.Expand return parameters for the expectation previously defined by the When method
+func (e *RelationServiceMockExpandExpectation) Then(rp1 *model.RelationExpandNode, s1 string, err error) *RelationServiceMock {
+	e.results = &RelationServiceMockExpandResults{rp1, s1, err}
+	return e.mock
+}
+
+// Expand implements service.RelationService
+func (mmExpand *RelationServiceMock) Expand(ctx context.Context, object model.RelationObject, relation string, consistency model.RelationConsistency) (rp1 *model.RelationExpandNode, s1 string, err error) {
+	mm_atomic.AddUint64(&mmExpand.beforeExpandCounter, 1)
+	defer mm_atomic.AddUint64(&mmExpand.afterExpandCounter, 1)
+
+	if mmExpand.inspectFuncExpand != nil {
+		mmExpand.inspectFuncExpand(ctx, object, relation, consistency)
+	}
+
+	mm_params := RelationServiceMockExpandParams{ctx, object, relation, consistency}
+
+	// Record call args
+	mmExpand.ExpandMock.mutex.Lock()
+	mmExpand.ExpandMock.callArgs = append(mmExpand.ExpandMock.callArgs, &mm_params)
+	mmExpand.ExpandMock.mutex.Unlock()
+
+	for _, e := range mmExpand.ExpandMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.s1, e.results.err
+		}
+	}
+
+	if mmExpand.ExpandMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExpand.ExpandMock.defaultExpectation.Counter, 1)
+		mm_want := mmExpand.ExpandMock.defaultExpectation.params
+		mm_want_ptrs := mmExpand.ExpandMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationServiceMockExpandParams{ctx, object, relation, consistency}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExpand.t.Errorf("RelationServiceMock.Expand got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.object != nil && !minimock.Equal(*mm_want_ptrs.object, mm_got.object) {
+				mmExpand.t.Errorf("RelationServiceMock.Expand got unexpected parameter object, want: %#v, got: %#v%s\n", *mm_want_ptrs.object, mm_got.object, minimock.Diff(*mm_want_ptrs.object, mm_got.object))
+			}
+
+			if mm_want_ptrs.relation != nil && !minimock.Equal(*mm_want_ptrs.relation, mm_got.relation) {
+				mmExpand.t.Errorf("RelationServiceMock.Expand got unexpected parameter relation, want: %#v, got: %#v%s\n", *mm_want_ptrs.relation, mm_got.relation, minimock.Diff(*mm_want_ptrs.relation, mm_got.relation))
+			}
+
+			if mm_want_ptrs.consistency != nil && !minimock.Equal(*mm_want_ptrs.consistency, mm_got.consistency) {
+				mmExpand.t.Errorf("RelationServiceMock.Expand got unexpected parameter consistency, want: %#v, got: %#v%s\n", *mm_want_ptrs.consistency, mm_got.consistency, minimock.Diff(*mm_want_ptrs.consistency, mm_got.consistency))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExpand.t.Errorf("RelationServiceMock.Expand got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExpand.ExpandMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExpand.t.Fatal("No results are set for the RelationServiceMock.Expand")
+		}
+		return (*mm_results).rp1, (*mm_results).s1, (*mm_results).err
+	}
+	if mmExpand.funcExpand != nil {
+		return mmExpand.funcExpand(ctx, object, relation, consistency)
+	}
+	mmExpand.t.Fatalf("Unexpected call to RelationServiceMock.Expand. %v %v %v %v", ctx, object, relation, consistency)
+	return
+}
+
+// ExpandAfterCounter returns a count of finished RelationServiceMock.Expand invocations
+func (mmExpand *RelationServiceMock) ExpandAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExpand.afterExpandCounter)
+}
+
+// ExpandBeforeCounter returns a count of RelationServiceMock.Expand invocations
+func (mmExpand *RelationServiceMock) ExpandBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExpand.beforeExpandCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationServiceMock.Expand.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExpand *mRelationServiceMockExpand) Calls() []*RelationServiceMockExpandParams {
+	mmExpand.mutex.RLock()
+
+	argCopy := make([]*RelationServiceMockExpandParams, len(mmExpand.callArgs))
+	copy(argCopy, mmExpand.callArgs)
+
+	mmExpand.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExpandDone returns true if the count of the Expand invocations corresponds
+// the number of defined expectations
+func (m *RelationServiceMock) MinimockExpandDone() bool {
+	for _, e := range m.ExpandMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExpandMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterExpandCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExpand != nil && mm_atomic.LoadUint64(&m.afterExpandCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockExpandInspect logs each unmet expectation
+func (m *RelationServiceMock) MinimockExpandInspect() {
+	for _, e := range m.ExpandMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationServiceMock.Expand with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExpandMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterExpandCounter) < 1 {
+		if m.ExpandMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RelationServiceMock.Expand")
+		} else {
+			m.t.Errorf("Expected call to RelationServiceMock.Expand with params: %#v", *m.ExpandMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExpand != nil && mm_atomic.LoadUint64(&m.afterExpandCounter) < 1 {
+		m.t.Error("Expected call to RelationServiceMock.Expand")
+	}
+}
+
+type mRelationServiceMockReadTuples struct {
+	mock               *RelationServiceMock
+	defaultExpectation *RelationServiceMockReadTuplesExpectation
+	expectations       []*RelationServiceMockReadTuplesExpectation
+
+	callArgs []*RelationServiceMockReadTuplesParams
+	mutex    sync.RWMutex
+}
+
+// RelationServiceMockReadTuplesExpectation specifies expectation struct of the RelationService.ReadTuples
+type RelationServiceMockReadTuplesExpectation struct {
+	mock      *RelationServiceMock
+	params    *RelationServiceMockReadTuplesParams
+	paramPtrs *RelationServiceMockReadTuplesParamPtrs
+	results   *RelationServiceMockReadTuplesResults
+	Counter   uint64
+}
+
+// RelationServiceMockReadTuplesParams contains parameters of the RelationService.ReadTuples
+type RelationServiceMockReadTuplesParams struct {
+	ctx         context.Context
+	filter      model.RelationTupleFilter
+	pageToken   string
+	consistency model.RelationConsistency
+}
+
+// RelationServiceMockReadTuplesParamPtrs contains pointers to parameters of the RelationService.ReadTuples
+type RelationServiceMockReadTuplesParamPtrs struct {
+	ctx         *context.Context
+	filter      *model.RelationTupleFilter
+	pageToken   *string
+	consistency *model.RelationConsistency
+}
+
+// RelationServiceMockReadTuplesResults contains results of the RelationService.ReadTuples
+type RelationServiceMockReadTuplesResults struct {
+	rp1 *model.RelationTuplePage
+	err error
+}
+
+// Expect sets up expected params for RelationService.ReadTuples
+func (mmReadTuples *mRelationServiceMockReadTuples) Expect(ctx context.Context, filter model.RelationTupleFilter, pageToken string, consistency model.RelationConsistency) *mRelationServiceMockReadTuples {
+	if mmReadTuples.mock.funcReadTuples != nil {
+		mmReadTuples.mock.t.Fatalf("RelationServiceMock.ReadTuples mock is already set by Set")
+	}
+
+	if mmReadTuples.defaultExpectation == nil {
+		mmReadTuples.defaultExpectation = &RelationServiceMockReadTuplesExpectation{}
+	}
+
+	if mmReadTuples.defaultExpectation.paramPtrs != nil {
+		mmReadTuples.mock.t.Fatalf("RelationServiceMock.ReadTuples mock is already set by ExpectParams functions")
+	}
+
+	mmReadTuples.defaultExpectation.params = &RelationServiceMockReadTuplesParams{ctx, filter, pageToken, consistency}
+	for _, e := range mmReadTuples.expectations {
+		if minimock.Equal(e.params, mmReadTuples.defaultExpectation.params) {
+			mmReadTuples.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReadTuples.defaultExpectation.params)
+		}
+	}
+
+	return mmReadTuples
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationService.ReadTuples
+func (mmReadTuples *mRelationServiceMockReadTuples) ExpectCtxParam1(ctx context.Context) *mRelationServiceMockReadTuples {
+	if mmReadTuples.mock.funcReadTuples != nil {
+		mmReadTuples.mock.t.Fatalf("RelationServiceMock.ReadTuples mock is already set by Set")
+	}
+
+	if mmReadTuples.defaultExpectation == nil {
+		mmReadTuples.defaultExpectation = &RelationServiceMockReadTuplesExpectation{}
+	}
+
+	if mmReadTuples.defaultExpectation.params != nil {
+		mmReadTuples.mock.t.Fatalf("RelationServiceMock.ReadTuples mock is already set by Expect")
+	}
+
+	if mmReadTuples.defaultExpectation.paramPtrs == nil {
+		mmReadTuples.defaultExpectation.paramPtrs = &RelationServiceMockReadTuplesParamPtrs{}
+	}
+	mmReadTuples.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmReadTuples
+}
+
+// ExpectFilterParam2 sets up expected param filter for RelationService.ReadTuples
+func (mmReadTuples *mRelationServiceMockReadTuples) ExpectFilterParam2(filter model.RelationTupleFilter) *mRelationServiceMockReadTuples {
+	if mmReadTuples.mock.funcReadTuples != nil {
+		mmReadTuples.mock.t.Fatalf("RelationServiceMock.ReadTuples mock is already set by Set")
+	}
+
+	if mmReadTuples.defaultExpectation == nil {
+		mmReadTuples.defaultExpectation = &RelationServiceMockReadTuplesExpectation{}
+	}
+
+	if mmReadTuples.defaultExpectation.params != nil {
+		mmReadTuples.mock.t.Fatalf("RelationServiceMock.ReadTuples mock is already set by Expect")
+	}
+
+	if mmReadTuples.defaultExpectation.paramPtrs == nil {
+		mmReadTuples.defaultExpectation.paramPtrs = &RelationServiceMockReadTuplesParamPtrs{}
+	}
+	mmReadTuples.defaultExpectation.paramPtrs.filter = &filter
+
+	return mmReadTuples
+}
+
+// ExpectPageTokenParam3 sets up expected param pageToken for RelationService.ReadTuples
+func (mmReadTuples *mRelationServiceMockReadTuples) ExpectPageTokenParam3(pageToken string) *mRelationServiceMockReadTuples {
+	if mmReadTuples.mock.funcReadTuples != nil {
+		mmReadTuples.mock.t.Fatalf("RelationServiceMock.ReadTuples mock is already set by Set")
+	}
+
+	if mmReadTuples.defaultExpectation == nil {
+		mmReadTuples.defaultExpectation = &RelationServiceMockReadTuplesExpectation{}
+	}
+
+	if mmReadTuples.defaultExpectation.params != nil {
+		mmReadTuples.mock.t.Fatalf("RelationServiceMock.ReadTuples mock is already set by Expect")
+	}
+
+	if mmReadTuples.defaultExpectation.paramPtrs == nil {
+		mmReadTuples.defaultExpectation.paramPtrs = &RelationServiceMockReadTuplesParamPtrs{}
+	}
+	mmReadTuples.defaultExpectation.paramPtrs.pageToken = &pageToken
+
+	return mmReadTuples
+}
+
+// ExpectConsistencyParam4 sets up expected param consistency for RelationService.ReadTuples
+func (mmReadTuples *mRelationServiceMockReadTuples) ExpectConsistencyParam4(consistency model.RelationConsistency) *mRelationServiceMockReadTuples {
+	if mmReadTuples.mock.funcReadTuples != nil {
+		mmReadTuples.mock.t.Fatalf("RelationServiceMock.ReadTuples mock is already set by Set")
+	}
+
+	if mmReadTuples.defaultExpectation == nil {
+		mmReadTuples.defaultExpectation = &RelationServiceMockReadTuplesExpectation{}
+	}
+
+	if mmReadTuples.defaultExpectation.params != nil {
+		mmReadTuples.mock.t.Fatalf("RelationServiceMock.ReadTuples mock is already set by Expect")
+	}
+
+	if mmReadTuples.defaultExpectation.paramPtrs == nil {
+		mmReadTuples.defaultExpectation.paramPtrs = &RelationServiceMockReadTuplesParamPtrs{}
+	}
+	mmReadTuples.defaultExpectation.paramPtrs.consistency = &consistency
+
+	return mmReadTuples
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationService.ReadTuples
+func (mmReadTuples *mRelationServiceMockReadTuples) Inspect(f func(ctx context.Context, filter model.RelationTupleFilter, pageToken string, consistency model.RelationConsistency)) *mRelationServiceMockReadTuples {
+	if mmReadTuples.mock.inspectFuncReadTuples != nil {
+		mmReadTuples.mock.t.Fatalf("Inspect function is already set for RelationServiceMock.ReadTuples")
+	}
+
+	mmReadTuples.mock.inspectFuncReadTuples = f
+
+	return mmReadTuples
+}
+
+// Return sets up results that will be returned by RelationService.ReadTuples
+func (mmReadTuples *mRelationServiceMockReadTuples) Return(rp1 *model.RelationTuplePage, err error) *RelationServiceMock {
+	if mmReadTuples.mock.funcReadTuples != nil {
+		mmReadTuples.mock.t.Fatalf("RelationServiceMock.ReadTuples mock is already set by Set")
+	}
+
+	if mmReadTuples.defaultExpectation == nil {
+		mmReadTuples.defaultExpectation = &RelationServiceMockReadTuplesExpectation{mock: mmReadTuples.mock}
+	}
+	mmReadTuples.defaultExpectation.results = &RelationServiceMockReadTuplesResults{rp1, err}
+	return mmReadTuples.mock
+}
+
+// Set uses given function f to mock the RelationService.ReadTuples method
+func (mmReadTuples *mRelationServiceMockReadTuples) Set(f func(ctx context.Context, filter model.RelationTupleFilter, pageToken string, consistency model.RelationConsistency) (rp1 *model.RelationTuplePage, err error)) *RelationServiceMock {
+	if mmReadTuples.defaultExpectation != nil {
+		mmReadTuples.mock.t.Fatalf("Default expectation is already set for the RelationService.ReadTuples method")
+	}
+
+	if len(mmReadTuples.expectations) > 0 {
+		mmReadTuples.mock.t.Fatalf("Some expectations are already set for the RelationService.ReadTuples method")
+	}
+
+	mmReadTuples.mock.funcReadTuples = f
+	return mmReadTuples.mock
+}
+
+// When sets expectation for the RelationService.ReadTuples which will trigger the result defined by the following
+// Then helper
+func (mmReadTuples *mRelationServiceMockReadTuples) When(ctx context.Context, filter model.RelationTupleFilter, pageToken string, consistency model.RelationConsistency) *RelationServiceMockReadTuplesExpectation {
+	if mmReadTuples.mock.funcReadTuples != nil {
+		mmReadTuples.mock.t.Fatalf("RelationServiceMock.ReadTuples mock is already set by Set")
+	}
+
+	expectation := &RelationServiceMockReadTuplesExpectation{
+		mock:   mmReadTuples.mock,
+		params: &RelationServiceMockReadTuplesParams{ctx, filter, pageToken, consistency},
+	}
+	mmReadTuples.expectations = append(mmReadTuples.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationService.ReadTuples return parameters for the expectation previously defined by the When method
+func (e *RelationServiceMockReadTuplesExpectation) Then(rp1 *model.RelationTuplePage, err error) *RelationServiceMock {
+	e.results = &RelationServiceMockReadTuplesResults{rp1, err}
+	return e.mock
+}
+
+// ReadTuples implements service.RelationService
+func (mmReadTuples *RelationServiceMock) ReadTuples(ctx context.Context, filter model.RelationTupleFilter, pageToken string, consistency model.RelationConsistency) (rp1 *model.RelationTuplePage, err error) {
+	mm_atomic.AddUint64(&mmReadTuples.beforeReadTuplesCounter, 1)
+	defer mm_atomic.AddUint64(&mmReadTuples.afterReadTuplesCounter, 1)
+
+	if mmReadTuples.inspectFuncReadTuples != nil {
+		mmReadTuples.inspectFuncReadTuples(ctx, filter, pageToken, consistency)
+	}
+
+	mm_params := RelationServiceMockReadTuplesParams{ctx, filter, pageToken, consistency}
+
+	// Record call args
+	mmReadTuples.ReadTuplesMock.mutex.Lock()
+	mmReadTuples.ReadTuplesMock.callArgs = append(mmReadTuples.ReadTuplesMock.callArgs, &mm_params)
+	mmReadTuples.ReadTuplesMock.mutex.Unlock()
+
+	for _, e := range mmReadTuples.ReadTuplesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmReadTuples.ReadTuplesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReadTuples.ReadTuplesMock.defaultExpectation.Counter, 1)
+		mm_want := mmReadTuples.ReadTuplesMock.defaultExpectation.params
+		mm_want_ptrs := mmReadTuples.ReadTuplesMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationServiceMockReadTuplesParams{ctx, filter, pageToken, consistency}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReadTuples.t.Errorf("RelationServiceMock.ReadTuples got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmReadTuples.t.Errorf("RelationServiceMock.ReadTuples got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+			if mm_want_ptrs.pageToken != nil && !minimock.Equal(*mm_want_ptrs.pageToken, mm_got.pageToken) {
+				mmReadTuples.t.Errorf("RelationServiceMock.ReadTuples got unexpected parameter pageToken, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageToken, mm_got.pageToken, minimock.Diff(*mm_want_ptrs.pageToken, mm_got.pageToken))
+			}
+
+			if mm_want_ptrs.consistency != nil && !minimock.Equal(*mm_want_ptrs.consistency, mm_got.consistency) {
+				mmReadTuples.t.Errorf("RelationServiceMock.ReadTuples got unexpected parameter consistency, want: %#v, got: %#v%s\n", *mm_want_ptrs.consistency, mm_got.consistency, minimock.Diff(*mm_want_ptrs.consistency, mm_got.consistency))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReadTuples.t.Errorf("RelationServiceMock.ReadTuples got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReadTuples.ReadTuplesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReadTuples.t.Fatal("No results are set for the RelationServiceMock.ReadTuples")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmReadTuples.funcReadTuples != nil {
+		return mmReadTuples.funcReadTuples(ctx, filter, pageToken, consistency)
+	}
+	mmReadTuples.t.Fatalf("Unexpected call to RelationServiceMock.ReadTuples. %v %v %v %v", ctx, filter, pageToken, consistency)
+	return
+}
+
+// ReadTuplesAfterCounter returns a count of finished RelationServiceMock.ReadTuples invocations
+func (mmReadTuples *RelationServiceMock) ReadTuplesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReadTuples.afterReadTuplesCounter)
+}
+
+// ReadTuplesBeforeCounter returns a count of RelationServiceMock.ReadTuples invocations
+func (mmReadTuples *RelationServiceMock) ReadTuplesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReadTuples.beforeReadTuplesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationServiceMock.ReadTuples.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReadTuples *mRelationServiceMockReadTuples) Calls() []*RelationServiceMockReadTuplesParams {
+	mmReadTuples.mutex.RLock()
+
+	argCopy := make([]*RelationServiceMockReadTuplesParams, len(mmReadTuples.callArgs))
+	copy(argCopy, mmReadTuples.callArgs)
+
+	mmReadTuples.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReadTuplesDone returns true if the count of the ReadTuples invocations corresponds
+// the number of defined expectations
+func (m *RelationServiceMock) MinimockReadTuplesDone() bool {
+	for _, e := range m.ReadTuplesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReadTuplesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterReadTuplesCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReadTuples != nil && mm_atomic.LoadUint64(&m.afterReadTuplesCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockReadTuplesInspect logs each unmet expectation
+func (m *RelationServiceMock) MinimockReadTuplesInspect() {
+	for _, e := range m.ReadTuplesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationServiceMock.ReadTuples with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReadTuplesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterReadTuplesCounter) < 1 {
+		if m.ReadTuplesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RelationServiceMock.ReadTuples")
+		} else {
+			m.t.Errorf("Expected call to RelationServiceMock.ReadTuples with params: %#v", *m.ReadTuplesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReadTuples != nil && mm_atomic.LoadUint64(&m.afterReadTuplesCounter) < 1 {
+		m.t.Error("Expected call to RelationServiceMock.ReadTuples")
+	}
+}
+
+type mRelationServiceMockWriteTuples struct {
+	mock               *RelationServiceMock
+	defaultExpectation *RelationServiceMockWriteTuplesExpectation
+	expectations       []*RelationServiceMockWriteTuplesExpectation
+
+	callArgs []*RelationServiceMockWriteTuplesParams
+	mutex    sync.RWMutex
+}
+
+// RelationServiceMockWriteTuplesExpectation specifies expectation struct of the RelationService.WriteTuples
+type RelationServiceMockWriteTuplesExpectation struct {
+	mock      *RelationServiceMock
+	params    *RelationServiceMockWriteTuplesParams
+	paramPtrs *RelationServiceMockWriteTuplesParamPtrs
+	results   *RelationServiceMockWriteTuplesResults
+	Counter   uint64
+}
+
+// RelationServiceMockWriteTuplesParams contains parameters of the RelationService.WriteTuples
+type RelationServiceMockWriteTuplesParams struct {
+	ctx     context.Context
+	writes  []model.RelationTuple
+	deletes []model.RelationTuple
+}
+
+// RelationServiceMockWriteTuplesParamPtrs contains pointers to parameters of the RelationService.WriteTuples
+type RelationServiceMockWriteTuplesParamPtrs struct {
+	ctx     *context.Context
+	writes  *[]model.RelationTuple
+	deletes *[]model.RelationTuple
+}
+
+// RelationServiceMockWriteTuplesResults contains results of the RelationService.WriteTuples
+type RelationServiceMockWriteTuplesResults struct {
+	s1  string
+	err error
+}
+
+// Expect sets up expected params for RelationService.WriteTuples
+func (mmWriteTuples *mRelationServiceMockWriteTuples) Expect(ctx context.Context, writes []model.RelationTuple, deletes []model.RelationTuple) *mRelationServiceMockWriteTuples {
+	if mmWriteTuples.mock.funcWriteTuples != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationServiceMock.WriteTuples mock is already set by Set")
+	}
+
+	if mmWriteTuples.defaultExpectation == nil {
+		mmWriteTuples.defaultExpectation = &RelationServiceMockWriteTuplesExpectation{}
+	}
+
+	if mmWriteTuples.defaultExpectation.paramPtrs != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationServiceMock.WriteTuples mock is already set by ExpectParams functions")
+	}
+
+	mmWriteTuples.defaultExpectation.params = &RelationServiceMockWriteTuplesParams{ctx, writes, deletes}
+	for _, e := range mmWriteTuples.expectations {
+		if minimock.Equal(e.params, mmWriteTuples.defaultExpectation.params) {
+			mmWriteTuples.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmWriteTuples.defaultExpectation.params)
+		}
+	}
+
+	return mmWriteTuples
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationService.WriteTuples
+func (mmWriteTuples *mRelationServiceMockWriteTuples) ExpectCtxParam1(ctx context.Context) *mRelationServiceMockWriteTuples {
+	if mmWriteTuples.mock.funcWriteTuples != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationServiceMock.WriteTuples mock is already set by Set")
+	}
+
+	if mmWriteTuples.defaultExpectation == nil {
+		mmWriteTuples.defaultExpectation = &RelationServiceMockWriteTuplesExpectation{}
+	}
+
+	if mmWriteTuples.defaultExpectation.params != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationServiceMock.WriteTuples mock is already set by Expect")
+	}
+
+	if mmWriteTuples.defaultExpectation.paramPtrs == nil {
+		mmWriteTuples.defaultExpectation.paramPtrs = &RelationServiceMockWriteTuplesParamPtrs{}
+	}
+	mmWriteTuples.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmWriteTuples
+}
+
+// ExpectWritesParam2 sets up expected param writes for RelationService.WriteTuples
+func (mmWriteTuples *mRelationServiceMockWriteTuples) ExpectWritesParam2(writes []model.RelationTuple) *mRelationServiceMockWriteTuples {
+	if mmWriteTuples.mock.funcWriteTuples != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationServiceMock.WriteTuples mock is already set by Set")
+	}
+
+	if mmWriteTuples.defaultExpectation == nil {
+		mmWriteTuples.defaultExpectation = &RelationServiceMockWriteTuplesExpectation{}
+	}
+
+	if mmWriteTuples.defaultExpectation.params != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationServiceMock.WriteTuples mock is already set by Expect")
+	}
+
+	if mmWriteTuples.defaultExpectation.paramPtrs == nil {
+		mmWriteTuples.defaultExpectation.paramPtrs = &RelationServiceMockWriteTuplesParamPtrs{}
+	}
+	mmWriteTuples.defaultExpectation.paramPtrs.writes = &writes
+
+	return mmWriteTuples
+}
+
+// ExpectDeletesParam3 sets up expected param deletes for RelationService.WriteTuples
+func (mmWriteTuples *mRelationServiceMockWriteTuples) ExpectDeletesParam3(deletes []model.RelationTuple) *mRelationServiceMockWriteTuples {
+	if mmWriteTuples.mock.funcWriteTuples != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationServiceMock.WriteTuples mock is already set by Set")
+	}
+
+	if mmWriteTuples.defaultExpectation == nil {
+		mmWriteTuples.defaultExpectation = &RelationServiceMockWriteTuplesExpectation{}
+	}
+
+	if mmWriteTuples.defaultExpectation.params != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationServiceMock.WriteTuples mock is already set by Expect")
+	}
+
+	if mmWriteTuples.defaultExpectation.paramPtrs == nil {
+		mmWriteTuples.defaultExpectation.paramPtrs = &RelationServiceMockWriteTuplesParamPtrs{}
+	}
+	mmWriteTuples.defaultExpectation.paramPtrs.deletes = &deletes
+
+	return mmWriteTuples
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationService.WriteTuples
+func (mmWriteTuples *mRelationServiceMockWriteTuples) Inspect(f func(ctx context.Context, writes []model.RelationTuple, deletes []model.RelationTuple)) *mRelationServiceMockWriteTuples {
+	if mmWriteTuples.mock.inspectFuncWriteTuples != nil {
+		mmWriteTuples.mock.t.Fatalf("Inspect function is already set for RelationServiceMock.WriteTuples")
+	}
+
+	mmWriteTuples.mock.inspectFuncWriteTuples = f
+
+	return mmWriteTuples
+}
+
+// Return sets up results that will be returned by RelationService.WriteTuples
+func (mmWriteTuples *mRelationServiceMockWriteTuples) Return(s1 string, err error) *RelationServiceMock {
+	if mmWriteTuples.mock.funcWriteTuples != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationServiceMock.WriteTuples mock is already set by Set")
+	}
+
+	if mmWriteTuples.defaultExpectation == nil {
+		mmWriteTuples.defaultExpectation = &RelationServiceMockWriteTuplesExpectation{mock: mmWriteTuples.mock}
+	}
+	mmWriteTuples.defaultExpectation.results = &RelationServiceMockWriteTuplesResults{s1, err}
+	return mmWriteTuples.mock
+}
+
+// Set uses given function f to mock the RelationService.WriteTuples method
+func (mmWriteTuples *mRelationServiceMockWriteTuples) Set(f func(ctx context.Context, writes []model.RelationTuple, deletes []model.RelationTuple) (s1 string, err error)) *RelationServiceMock {
+	if mmWriteTuples.defaultExpectation != nil {
+		mmWriteTuples.mock.t.Fatalf("Default expectation is already set for the RelationService.WriteTuples method")
+	}
+
+	if len(mmWriteTuples.expectations) > 0 {
+		mmWriteTuples.mock.t.Fatalf("Some expectations are already set for the RelationService.WriteTuples method")
+	}
+
+	mmWriteTuples.mock.funcWriteTuples = f
+	return mmWriteTuples.mock
+}
+
+// When sets expectation for the RelationService.WriteTuples which will trigger the result defined by the following
+// Then helper
+func (mmWriteTuples *mRelationServiceMockWriteTuples) When(ctx context.Context, writes []model.RelationTuple, deletes []model.RelationTuple) *RelationServiceMockWriteTuplesExpectation {
+	if mmWriteTuples.mock.funcWriteTuples != nil {
+		mmWriteTuples.mock.t.Fatalf("RelationServiceMock.WriteTuples mock is already set by Set")
+	}
+
+	expectation := &RelationServiceMockWriteTuplesExpectation{
+		mock:   mmWriteTuples.mock,
+		params: &RelationServiceMockWriteTuplesParams{ctx, writes, deletes},
+	}
+	mmWriteTuples.expectations = append(mmWriteTuples.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationService.WriteTuples return parameters for the expectation previously defined by the When method
+func (e *RelationServiceMockWriteTuplesExpectation) Then(s1 string, err error) *RelationServiceMock {
+	e.results = &RelationServiceMockWriteTuplesResults{s1, err}
+	return e.mock
+}
+
+// WriteTuples implements service.RelationService
+func (mmWriteTuples *RelationServiceMock) WriteTuples(ctx context.Context, writes []model.RelationTuple, deletes []model.RelationTuple) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmWriteTuples.beforeWriteTuplesCounter, 1)
+	defer mm_atomic.AddUint64(&mmWriteTuples.afterWriteTuplesCounter, 1)
+
+	if mmWriteTuples.inspectFuncWriteTuples != nil {
+		mmWriteTuples.inspectFuncWriteTuples(ctx, writes, deletes)
+	}
+
+	mm_params := RelationServiceMockWriteTuplesParams{ctx, writes, deletes}
+
+	// Record call args
+	mmWriteTuples.WriteTuplesMock.mutex.Lock()
+	mmWriteTuples.WriteTuplesMock.callArgs = append(mmWriteTuples.WriteTuplesMock.callArgs, &mm_params)
+	mmWriteTuples.WriteTuplesMock.mutex.Unlock()
+
+	for _, e := range mmWriteTuples.WriteTuplesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmWriteTuples.WriteTuplesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmWriteTuples.WriteTuplesMock.defaultExpectation.Counter, 1)
+		mm_want := mmWriteTuples.WriteTuplesMock.defaultExpectation.params
+		mm_want_ptrs := mmWriteTuples.WriteTuplesMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationServiceMockWriteTuplesParams{ctx, writes, deletes}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmWriteTuples.t.Errorf("RelationServiceMock.WriteTuples got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.writes != nil && !minimock.Equal(*mm_want_ptrs.writes, mm_got.writes) {
+				mmWriteTuples.t.Errorf("RelationServiceMock.WriteTuples got unexpected parameter writes, want: %#v, got: %#v%s\n", *mm_want_ptrs.writes, mm_got.writes, minimock.Diff(*mm_want_ptrs.writes, mm_got.writes))
+			}
+
+			if mm_want_ptrs.deletes != nil && !minimock.Equal(*mm_want_ptrs.deletes, mm_got.deletes) {
+				mmWriteTuples.t.Errorf("RelationServiceMock.WriteTuples got unexpected parameter deletes, want: %#v, got: %#v%s\n", *mm_want_ptrs.deletes, mm_got.deletes, minimock.Diff(*mm_want_ptrs.deletes, mm_got.deletes))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmWriteTuples.t.Errorf("RelationServiceMock.WriteTuples got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmWriteTuples.WriteTuplesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmWriteTuples.t.Fatal("No results are set for the RelationServiceMock.WriteTuples")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmWriteTuples.funcWriteTuples != nil {
+		return mmWriteTuples.funcWriteTuples(ctx, writes, deletes)
+	}
+	mmWriteTuples.t.Fatalf("Unexpected call to RelationServiceMock.WriteTuples. %v %v %v", ctx, writes, deletes)
+	return
+}
+
+// WriteTuplesAfterCounter returns a count of finished RelationServiceMock.WriteTuples invocations
+func (mmWriteTuples *RelationServiceMock) WriteTuplesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWriteTuples.afterWriteTuplesCounter)
+}
+
+// WriteTuplesBeforeCounter returns a count of RelationServiceMock.WriteTuples invocations
+func (mmWriteTuples *RelationServiceMock) WriteTuplesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWriteTuples.beforeWriteTuplesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationServiceMock.WriteTuples.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmWriteTuples *mRelationServiceMockWriteTuples) Calls() []*RelationServiceMockWriteTuplesParams {
+	mmWriteTuples.mutex.RLock()
+
+	argCopy := make([]*RelationServiceMockWriteTuplesParams, len(mmWriteTuples.callArgs))
+	copy(argCopy, mmWriteTuples.callArgs)
+
+	mmWriteTuples.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockWriteTuplesDone returns true if the count of the WriteTuples invocations corresponds
+// the number of defined expectations
+func (m *RelationServiceMock) MinimockWriteTuplesDone() bool {
+	for _, e := range m.WriteTuplesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.WriteTuplesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterWriteTuplesCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcWriteTuples != nil && mm_atomic.LoadUint64(&m.afterWriteTuplesCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockWriteTuplesInspect logs each unmet expectation
+func (m *RelationServiceMock) MinimockWriteTuplesInspect() {
+	for _, e := range m.WriteTuplesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationServiceMock.WriteTuples with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.WriteTuplesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterWriteTuplesCounter) < 1 {
+		if m.WriteTuplesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RelationServiceMock.WriteTuples")
+		} else {
+			m.t.Errorf("Expected call to RelationServiceMock.WriteTuples with params: %#v", *m.WriteTuplesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcWriteTuples != nil && mm_atomic.LoadUint64(&m.afterWriteTuplesCounter) < 1 {
+		m.t.Error("Expected call to RelationServiceMock.WriteTuples")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RelationServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCheckInspect()
+
+			m.MinimockExpandInspect()
+
+			m.MinimockReadTuplesInspect()
+
+			m.MinimockWriteTuplesInspect()
+			m.t.FailNow()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RelationServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RelationServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCheckDone() &&
+		m.MinimockExpandDone() &&
+		m.MinimockReadTuplesDone() &&
+		m.MinimockWriteTuplesDone()
+}
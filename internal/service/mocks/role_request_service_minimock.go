@@ -0,0 +1,1901 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.8). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/arifullov/auth/internal/service.RoleRequestService -o role_request_service_minimock.go -n RoleRequestServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RoleRequestServiceMock implements service.RoleRequestService
+type RoleRequestServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcApprove          func(ctx context.Context, id int64, comment string) (rp1 *model.RoleRequest, err error)
+	inspectFuncApprove   func(ctx context.Context, id int64, comment string)
+	afterApproveCounter  uint64
+	beforeApproveCounter uint64
+	ApproveMock          mRoleRequestServiceMockApprove
+
+	funcDeny          func(ctx context.Context, id int64, comment string) (rp1 *model.RoleRequest, err error)
+	inspectFuncDeny   func(ctx context.Context, id int64, comment string)
+	afterDenyCounter  uint64
+	beforeDenyCounter uint64
+	DenyMock          mRoleRequestServiceMockDeny
+
+	funcExpireGrants          func(ctx context.Context) (i1 int, err error)
+	inspectFuncExpireGrants   func(ctx context.Context)
+	afterExpireGrantsCounter  uint64
+	beforeExpireGrantsCounter uint64
+	ExpireGrantsMock          mRoleRequestServiceMockExpireGrants
+
+	funcGet          func(ctx context.Context, id int64) (rp1 *model.RoleRequest, err error)
+	inspectFuncGet   func(ctx context.Context, id int64)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mRoleRequestServiceMockGet
+
+	funcList          func(ctx context.Context, pageToken string, filter model.RoleRequestFilter) (rp1 *model.RoleRequestPage, err error)
+	inspectFuncList   func(ctx context.Context, pageToken string, filter model.RoleRequestFilter)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mRoleRequestServiceMockList
+
+	funcRequest          func(ctx context.Context, req *model.CreateRoleRequest) (i1 int64, err error)
+	inspectFuncRequest   func(ctx context.Context, req *model.CreateRoleRequest)
+	afterRequestCounter  uint64
+	beforeRequestCounter uint64
+	RequestMock          mRoleRequestServiceMockRequest
+}
+
+// NewRoleRequestServiceMock returns a mock for service.RoleRequestService
+func NewRoleRequestServiceMock(t minimock.Tester) *RoleRequestServiceMock {
+	m := &RoleRequestServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ApproveMock = mRoleRequestServiceMockApprove{mock: m}
+	m.ApproveMock.callArgs = []*RoleRequestServiceMockApproveParams{}
+
+	m.DenyMock = mRoleRequestServiceMockDeny{mock: m}
+	m.DenyMock.callArgs = []*RoleRequestServiceMockDenyParams{}
+
+	m.ExpireGrantsMock = mRoleRequestServiceMockExpireGrants{mock: m}
+	m.ExpireGrantsMock.callArgs = []*RoleRequestServiceMockExpireGrantsParams{}
+
+	m.GetMock = mRoleRequestServiceMockGet{mock: m}
+	m.GetMock.callArgs = []*RoleRequestServiceMockGetParams{}
+
+	m.ListMock = mRoleRequestServiceMockList{mock: m}
+	m.ListMock.callArgs = []*RoleRequestServiceMockListParams{}
+
+	m.RequestMock = mRoleRequestServiceMockRequest{mock: m}
+	m.RequestMock.callArgs = []*RoleRequestServiceMockRequestParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRoleRequestServiceMockApprove struct {
+	mock               *RoleRequestServiceMock
+	defaultExpectation *RoleRequestServiceMockApproveExpectation
+	expectations       []*RoleRequestServiceMockApproveExpectation
+
+	callArgs []*RoleRequestServiceMockApproveParams
+	mutex    sync.RWMutex
+}
+
+// RoleRequestServiceMockApproveExpectation specifies expectation struct of the RoleRequestService.Approve
+type RoleRequestServiceMockApproveExpectation struct {
+	mock      *RoleRequestServiceMock
+	params    *RoleRequestServiceMockApproveParams
+	paramPtrs *RoleRequestServiceMockApproveParamPtrs
+	results   *RoleRequestServiceMockApproveResults
+	Counter   uint64
+}
+
+// RoleRequestServiceMockApproveParams contains parameters of the RoleRequestService.Approve
+type RoleRequestServiceMockApproveParams struct {
+	ctx     context.Context
+	id      int64
+	comment string
+}
+
+// RoleRequestServiceMockApproveParamPtrs contains pointers to parameters of the RoleRequestService.Approve
+type RoleRequestServiceMockApproveParamPtrs struct {
+	ctx     *context.Context
+	id      *int64
+	comment *string
+}
+
+// RoleRequestServiceMockApproveResults contains results of the RoleRequestService.Approve
+type RoleRequestServiceMockApproveResults struct {
+	rp1 *model.RoleRequest
+	err error
+}
+
+// Expect sets up expected params for RoleRequestService.Approve
+func (mmApprove *mRoleRequestServiceMockApprove) Expect(ctx context.Context, id int64, comment string) *mRoleRequestServiceMockApprove {
+	if mmApprove.mock.funcApprove != nil {
+		mmApprove.mock.t.Fatalf("RoleRequestServiceMock.Approve mock is already set by Set")
+	}
+
+	if mmApprove.defaultExpectation == nil {
+		mmApprove.defaultExpectation = &RoleRequestServiceMockApproveExpectation{}
+	}
+
+	if mmApprove.defaultExpectation.paramPtrs != nil {
+		mmApprove.mock.t.Fatalf("RoleRequestServiceMock.Approve mock is already set by ExpectParams functions")
+	}
+
+	mmApprove.defaultExpectation.params = &RoleRequestServiceMockApproveParams{ctx, id, comment}
+	for _, e := range mmApprove.expectations {
+		if minimock.Equal(e.params, mmApprove.defaultExpectation.params) {
+			mmApprove.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmApprove.defaultExpectation.params)
+		}
+	}
+
+	return mmApprove
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRequestService.Approve
+func (mmApprove *mRoleRequestServiceMockApprove) ExpectCtxParam1(ctx context.Context) *mRoleRequestServiceMockApprove {
+	if mmApprove.mock.funcApprove != nil {
+		mmApprove.mock.t.Fatalf("RoleRequestServiceMock.Approve mock is already set by Set")
+	}
+
+	if mmApprove.defaultExpectation == nil {
+		mmApprove.defaultExpectation = &RoleRequestServiceMockApproveExpectation{}
+	}
+
+	if mmApprove.defaultExpectation.params != nil {
+		mmApprove.mock.t.Fatalf("RoleRequestServiceMock.Approve mock is already set by Expect")
+	}
+
+	if mmApprove.defaultExpectation.paramPtrs == nil {
+		mmApprove.defaultExpectation.paramPtrs = &RoleRequestServiceMockApproveParamPtrs{}
+	}
+	mmApprove.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmApprove
+}
+
+// ExpectIdParam2 sets up expected param id for RoleRequestService.Approve
+func (mmApprove *mRoleRequestServiceMockApprove) ExpectIdParam2(id int64) *mRoleRequestServiceMockApprove {
+	if mmApprove.mock.funcApprove != nil {
+		mmApprove.mock.t.Fatalf("RoleRequestServiceMock.Approve mock is already set by Set")
+	}
+
+	if mmApprove.defaultExpectation == nil {
+		mmApprove.defaultExpectation = &RoleRequestServiceMockApproveExpectation{}
+	}
+
+	if mmApprove.defaultExpectation.params != nil {
+		mmApprove.mock.t.Fatalf("RoleRequestServiceMock.Approve mock is already set by Expect")
+	}
+
+	if mmApprove.defaultExpectation.paramPtrs == nil {
+		mmApprove.defaultExpectation.paramPtrs = &RoleRequestServiceMockApproveParamPtrs{}
+	}
+	mmApprove.defaultExpectation.paramPtrs.id = &id
+
+	return mmApprove
+}
+
+// ExpectCommentParam3 sets up expected param comment for RoleRequestService.Approve
+func (mmApprove *mRoleRequestServiceMockApprove) ExpectCommentParam3(comment string) *mRoleRequestServiceMockApprove {
+	if mmApprove.mock.funcApprove != nil {
+		mmApprove.mock.t.Fatalf("RoleRequestServiceMock.Approve mock is already set by Set")
+	}
+
+	if mmApprove.defaultExpectation == nil {
+		mmApprove.defaultExpectation = &RoleRequestServiceMockApproveExpectation{}
+	}
+
+	if mmApprove.defaultExpectation.params != nil {
+		mmApprove.mock.t.Fatalf("RoleRequestServiceMock.Approve mock is already set by Expect")
+	}
+
+	if mmApprove.defaultExpectation.paramPtrs == nil {
+		mmApprove.defaultExpectation.paramPtrs = &RoleRequestServiceMockApproveParamPtrs{}
+	}
+	mmApprove.defaultExpectation.paramPtrs.comment = &comment
+
+	return mmApprove
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRequestService.Approve
+func (mmApprove *mRoleRequestServiceMockApprove) Inspect(f func(ctx context.Context, id int64, comment string)) *mRoleRequestServiceMockApprove {
+	if mmApprove.mock.inspectFuncApprove != nil {
+		mmApprove.mock.t.Fatalf("Inspect function is already set for RoleRequestServiceMock.Approve")
+	}
+
+	mmApprove.mock.inspectFuncApprove = f
+
+	return mmApprove
+}
+
+// Return sets up results that will be returned by RoleRequestService.Approve
+func (mmApprove *mRoleRequestServiceMockApprove) Return(rp1 *model.RoleRequest, err error) *RoleRequestServiceMock {
+	if mmApprove.mock.funcApprove != nil {
+		mmApprove.mock.t.Fatalf("RoleRequestServiceMock.Approve mock is already set by Set")
+	}
+
+	if mmApprove.defaultExpectation == nil {
+		mmApprove.defaultExpectation = &RoleRequestServiceMockApproveExpectation{mock: mmApprove.mock}
+	}
+	mmApprove.defaultExpectation.results = &RoleRequestServiceMockApproveResults{rp1, err}
+	return mmApprove.mock
+}
+
+// Set uses given function f to mock the RoleRequestService.Approve method
+func (mmApprove *mRoleRequestServiceMockApprove) Set(f func(ctx context.Context, id int64, comment string) (rp1 *model.RoleRequest, err error)) *RoleRequestServiceMock {
+	if mmApprove.defaultExpectation != nil {
+		mmApprove.mock.t.Fatalf("Default expectation is already set for the RoleRequestService.Approve method")
+	}
+
+	if len(mmApprove.expectations) > 0 {
+		mmApprove.mock.t.Fatalf("Some expectations are already set for the RoleRequestService.Approve method")
+	}
+
+	mmApprove.mock.funcApprove = f
+	return mmApprove.mock
+}
+
+// When sets expectation for the RoleRequestService.Approve which will trigger the result defined by the following
+// Then helper
+func (mmApprove *mRoleRequestServiceMockApprove) When(ctx context.Context, id int64, comment string) *RoleRequestServiceMockApproveExpectation {
+	if mmApprove.mock.funcApprove != nil {
+		mmApprove.mock.t.Fatalf("RoleRequestServiceMock.Approve mock is already set by Set")
+	}
+
+	expectation := &RoleRequestServiceMockApproveExpectation{
+		mock:   mmApprove.mock,
+		params: &RoleRequestServiceMockApproveParams{ctx, id, comment},
+	}
+	mmApprove.expectations = append(mmApprove.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRequestService.Approve return parameters for the expectation previously defined by the When method
+func (e *RoleRequestServiceMockApproveExpectation) Then(rp1 *model.RoleRequest, err error) *RoleRequestServiceMock {
+	e.results = &RoleRequestServiceMockApproveResults{rp1, err}
+	return e.mock
+}
+
+// Approve implements service.RoleRequestService
+func (mmApprove *RoleRequestServiceMock) Approve(ctx context.Context, id int64, comment string) (rp1 *model.RoleRequest, err error) {
+	mm_atomic.AddUint64(&mmApprove.beforeApproveCounter, 1)
+	defer mm_atomic.AddUint64(&mmApprove.afterApproveCounter, 1)
+
+	if mmApprove.inspectFuncApprove != nil {
+		mmApprove.inspectFuncApprove(ctx, id, comment)
+	}
+
+	mm_params := RoleRequestServiceMockApproveParams{ctx, id, comment}
+
+	// Record call args
+	mmApprove.ApproveMock.mutex.Lock()
+	mmApprove.ApproveMock.callArgs = append(mmApprove.ApproveMock.callArgs, &mm_params)
+	mmApprove.ApproveMock.mutex.Unlock()
+
+	for _, e := range mmApprove.ApproveMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmApprove.ApproveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmApprove.ApproveMock.defaultExpectation.Counter, 1)
+		mm_want := mmApprove.ApproveMock.defaultExpectation.params
+		mm_want_ptrs := mmApprove.ApproveMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRequestServiceMockApproveParams{ctx, id, comment}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmApprove.t.Errorf("RoleRequestServiceMock.Approve got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmApprove.t.Errorf("RoleRequestServiceMock.Approve got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.comment != nil && !minimock.Equal(*mm_want_ptrs.comment, mm_got.comment) {
+				mmApprove.t.Errorf("RoleRequestServiceMock.Approve got unexpected parameter comment, want: %#v, got: %#v%s\n", *mm_want_ptrs.comment, mm_got.comment, minimock.Diff(*mm_want_ptrs.comment, mm_got.comment))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmApprove.t.Errorf("RoleRequestServiceMock.Approve got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmApprove.ApproveMock.defaultExpectation.results
+		if mm_results == nil {
+			mmApprove.t.Fatal("No results are set for the RoleRequestServiceMock.Approve")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmApprove.funcApprove != nil {
+		return mmApprove.funcApprove(ctx, id, comment)
+	}
+	mmApprove.t.Fatalf("Unexpected call to RoleRequestServiceMock.Approve. %v %v %v", ctx, id, comment)
+	return
+}
+
+// ApproveAfterCounter returns a count of finished RoleRequestServiceMock.Approve invocations
+func (mmApprove *RoleRequestServiceMock) ApproveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApprove.afterApproveCounter)
+}
+
+// ApproveBeforeCounter returns a count of RoleRequestServiceMock.Approve invocations
+func (mmApprove *RoleRequestServiceMock) ApproveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApprove.beforeApproveCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRequestServiceMock.Approve.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmApprove *mRoleRequestServiceMockApprove) Calls() []*RoleRequestServiceMockApproveParams {
+	mmApprove.mutex.RLock()
+
+	argCopy := make([]*RoleRequestServiceMockApproveParams, len(mmApprove.callArgs))
+	copy(argCopy, mmApprove.callArgs)
+
+	mmApprove.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockApproveDone returns true if the count of the Approve invocations corresponds
+// the number of defined expectations
+func (m *RoleRequestServiceMock) MinimockApproveDone() bool {
+	for _, e := range m.ApproveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ApproveMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterApproveCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcApprove != nil && mm_atomic.LoadUint64(&m.afterApproveCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockApproveInspect logs each unmet expectation
+func (m *RoleRequestServiceMock) MinimockApproveInspect() {
+	for _, e := range m.ApproveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRequestServiceMock.Approve with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ApproveMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterApproveCounter) < 1 {
+		if m.ApproveMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RoleRequestServiceMock.Approve")
+		} else {
+			m.t.Errorf("Expected call to RoleRequestServiceMock.Approve with params: %#v", *m.ApproveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcApprove != nil && mm_atomic.LoadUint64(&m.afterApproveCounter) < 1 {
+		m.t.Error("Expected call to RoleRequestServiceMock.Approve")
+	}
+}
+
+type mRoleRequestServiceMockDeny struct {
+	mock               *RoleRequestServiceMock
+	defaultExpectation *RoleRequestServiceMockDenyExpectation
+	expectations       []*RoleRequestServiceMockDenyExpectation
+
+	callArgs []*RoleRequestServiceMockDenyParams
+	mutex    sync.RWMutex
+}
+
+// RoleRequestServiceMockDenyExpectation specifies expectation struct of the RoleRequestService.Deny
+type RoleRequestServiceMockDenyExpectation struct {
+	mock      *RoleRequestServiceMock
+	params    *RoleRequestServiceMockDenyParams
+	paramPtrs *RoleRequestServiceMockDenyParamPtrs
+	results   *RoleRequestServiceMockDenyResults
+	Counter   uint64
+}
+
+// RoleRequestServiceMockDenyParams contains parameters of the RoleRequestService.Deny
+type RoleRequestServiceMockDenyParams struct {
+	ctx     context.Context
+	id      int64
+	comment string
+}
+
+// RoleRequestServiceMockDenyParamPtrs contains pointers to parameters of the RoleRequestService.Deny
+type RoleRequestServiceMockDenyParamPtrs struct {
+	ctx     *context.Context
+	id      *int64
+	comment *string
+}
+
+// RoleRequestServiceMockDenyResults contains results of the RoleRequestService.Deny
+type RoleRequestServiceMockDenyResults struct {
+	rp1 *model.RoleRequest
+	err error
+}
+
+// Expect sets up expected params for RoleRequestService.Deny
+func (mmDeny *mRoleRequestServiceMockDeny) Expect(ctx context.Context, id int64, comment string) *mRoleRequestServiceMockDeny {
+	if mmDeny.mock.funcDeny != nil {
+		mmDeny.mock.t.Fatalf("RoleRequestServiceMock.Deny mock is already set by Set")
+	}
+
+	if mmDeny.defaultExpectation == nil {
+		mmDeny.defaultExpectation = &RoleRequestServiceMockDenyExpectation{}
+	}
+
+	if mmDeny.defaultExpectation.paramPtrs != nil {
+		mmDeny.mock.t.Fatalf("RoleRequestServiceMock.Deny mock is already set by ExpectParams functions")
+	}
+
+	mmDeny.defaultExpectation.params = &RoleRequestServiceMockDenyParams{ctx, id, comment}
+	for _, e := range mmDeny.expectations {
+		if minimock.Equal(e.params, mmDeny.defaultExpectation.params) {
+			mmDeny.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeny.defaultExpectation.params)
+		}
+	}
+
+	return mmDeny
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRequestService.Deny
+func (mmDeny *mRoleRequestServiceMockDeny) ExpectCtxParam1(ctx context.Context) *mRoleRequestServiceMockDeny {
+	if mmDeny.mock.funcDeny != nil {
+		mmDeny.mock.t.Fatalf("RoleRequestServiceMock.Deny mock is already set by Set")
+	}
+
+	if mmDeny.defaultExpectation == nil {
+		mmDeny.defaultExpectation = &RoleRequestServiceMockDenyExpectation{}
+	}
+
+	if mmDeny.defaultExpectation.params != nil {
+		mmDeny.mock.t.Fatalf("RoleRequestServiceMock.Deny mock is already set by Expect")
+	}
+
+	if mmDeny.defaultExpectation.paramPtrs == nil {
+		mmDeny.defaultExpectation.paramPtrs = &RoleRequestServiceMockDenyParamPtrs{}
+	}
+	mmDeny.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeny
+}
+
+// ExpectIdParam2 sets up expected param id for RoleRequestService.Deny
+func (mmDeny *mRoleRequestServiceMockDeny) ExpectIdParam2(id int64) *mRoleRequestServiceMockDeny {
+	if mmDeny.mock.funcDeny != nil {
+		mmDeny.mock.t.Fatalf("RoleRequestServiceMock.Deny mock is already set by Set")
+	}
+
+	if mmDeny.defaultExpectation == nil {
+		mmDeny.defaultExpectation = &RoleRequestServiceMockDenyExpectation{}
+	}
+
+	if mmDeny.defaultExpectation.params != nil {
+		mmDeny.mock.t.Fatalf("RoleRequestServiceMock.Deny mock is already set by Expect")
+	}
+
+	if mmDeny.defaultExpectation.paramPtrs == nil {
+		mmDeny.defaultExpectation.paramPtrs = &RoleRequestServiceMockDenyParamPtrs{}
+	}
+	mmDeny.defaultExpectation.paramPtrs.id = &id
+
+	return mmDeny
+}
+
+// ExpectCommentParam3 sets up expected param comment for RoleRequestService.Deny
+func (mmDeny *mRoleRequestServiceMockDeny) ExpectCommentParam3(comment string) *mRoleRequestServiceMockDeny {
+	if mmDeny.mock.funcDeny != nil {
+		mmDeny.mock.t.Fatalf("RoleRequestServiceMock.Deny mock is already set by Set")
+	}
+
+	if mmDeny.defaultExpectation == nil {
+		mmDeny.defaultExpectation = &RoleRequestServiceMockDenyExpectation{}
+	}
+
+	if mmDeny.defaultExpectation.params != nil {
+		mmDeny.mock.t.Fatalf("RoleRequestServiceMock.Deny mock is already set by Expect")
+	}
+
+	if mmDeny.defaultExpectation.paramPtrs == nil {
+		mmDeny.defaultExpectation.paramPtrs = &RoleRequestServiceMockDenyParamPtrs{}
+	}
+	mmDeny.defaultExpectation.paramPtrs.comment = &comment
+
+	return mmDeny
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRequestService.Deny
+func (mmDeny *mRoleRequestServiceMockDeny) Inspect(f func(ctx context.Context, id int64, comment string)) *mRoleRequestServiceMockDeny {
+	if mmDeny.mock.inspectFuncDeny != nil {
+		mmDeny.mock.t.Fatalf("Inspect function is already set for RoleRequestServiceMock.Deny")
+	}
+
+	mmDeny.mock.inspectFuncDeny = f
+
+	return mmDeny
+}
+
+// Return sets up results that will be returned by RoleRequestService.Deny
+func (mmDeny *mRoleRequestServiceMockDeny) Return(rp1 *model.RoleRequest, err error) *RoleRequestServiceMock {
+	if mmDeny.mock.funcDeny != nil {
+		mmDeny.mock.t.Fatalf("RoleRequestServiceMock.Deny mock is already set by Set")
+	}
+
+	if mmDeny.defaultExpectation == nil {
+		mmDeny.defaultExpectation = &RoleRequestServiceMockDenyExpectation{mock: mmDeny.mock}
+	}
+	mmDeny.defaultExpectation.results = &RoleRequestServiceMockDenyResults{rp1, err}
+	return mmDeny.mock
+}
+
+// Set uses given function f to mock the RoleRequestService.Deny method
+func (mmDeny *mRoleRequestServiceMockDeny) Set(f func(ctx context.Context, id int64, comment string) (rp1 *model.RoleRequest, err error)) *RoleRequestServiceMock {
+	if mmDeny.defaultExpectation != nil {
+		mmDeny.mock.t.Fatalf("Default expectation is already set for the RoleRequestService.Deny method")
+	}
+
+	if len(mmDeny.expectations) > 0 {
+		mmDeny.mock.t.Fatalf("Some expectations are already set for the RoleRequestService.Deny method")
+	}
+
+	mmDeny.mock.funcDeny = f
+	return mmDeny.mock
+}
+
+// When sets expectation for the RoleRequestService.Deny which will trigger the result defined by the following
+// Then helper
+func (mmDeny *mRoleRequestServiceMockDeny) When(ctx context.Context, id int64, comment string) *RoleRequestServiceMockDenyExpectation {
+	if mmDeny.mock.funcDeny != nil {
+		mmDeny.mock.t.Fatalf("RoleRequestServiceMock.Deny mock is already set by Set")
+	}
+
+	expectation := &RoleRequestServiceMockDenyExpectation{
+		mock:   mmDeny.mock,
+		params: &RoleRequestServiceMockDenyParams{ctx, id, comment},
+	}
+	mmDeny.expectations = append(mmDeny.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRequestService.Deny return parameters for the expectation previously defined by the When method
+func (e *RoleRequestServiceMockDenyExpectation) Then(rp1 *model.RoleRequest, err error) *RoleRequestServiceMock {
+	e.results = &RoleRequestServiceMockDenyResults{rp1, err}
+	return e.mock
+}
+
+// Deny implements service.RoleRequestService
+func (mmDeny *RoleRequestServiceMock) Deny(ctx context.Context, id int64, comment string) (rp1 *model.RoleRequest, err error) {
+	mm_atomic.AddUint64(&mmDeny.beforeDenyCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeny.afterDenyCounter, 1)
+
+	if mmDeny.inspectFuncDeny != nil {
+		mmDeny.inspectFuncDeny(ctx, id, comment)
+	}
+
+	mm_params := RoleRequestServiceMockDenyParams{ctx, id, comment}
+
+	// Record call args
+	mmDeny.DenyMock.mutex.Lock()
+	mmDeny.DenyMock.callArgs = append(mmDeny.DenyMock.callArgs, &mm_params)
+	mmDeny.DenyMock.mutex.Unlock()
+
+	for _, e := range mmDeny.DenyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmDeny.DenyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeny.DenyMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeny.DenyMock.defaultExpectation.params
+		mm_want_ptrs := mmDeny.DenyMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRequestServiceMockDenyParams{ctx, id, comment}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeny.t.Errorf("RoleRequestServiceMock.Deny got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeny.t.Errorf("RoleRequestServiceMock.Deny got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.comment != nil && !minimock.Equal(*mm_want_ptrs.comment, mm_got.comment) {
+				mmDeny.t.Errorf("RoleRequestServiceMock.Deny got unexpected parameter comment, want: %#v, got: %#v%s\n", *mm_want_ptrs.comment, mm_got.comment, minimock.Diff(*mm_want_ptrs.comment, mm_got.comment))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeny.t.Errorf("RoleRequestServiceMock.Deny got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeny.DenyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeny.t.Fatal("No results are set for the RoleRequestServiceMock.Deny")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmDeny.funcDeny != nil {
+		return mmDeny.funcDeny(ctx, id, comment)
+	}
+	mmDeny.t.Fatalf("Unexpected call to RoleRequestServiceMock.Deny. %v %v %v", ctx, id, comment)
+	return
+}
+
+// DenyAfterCounter returns a count of finished RoleRequestServiceMock.Deny invocations
+func (mmDeny *RoleRequestServiceMock) DenyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeny.afterDenyCounter)
+}
+
+// DenyBeforeCounter returns a count of RoleRequestServiceMock.Deny invocations
+func (mmDeny *RoleRequestServiceMock) DenyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeny.beforeDenyCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRequestServiceMock.Deny.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeny *mRoleRequestServiceMockDeny) Calls() []*RoleRequestServiceMockDenyParams {
+	mmDeny.mutex.RLock()
+
+	argCopy := make([]*RoleRequestServiceMockDenyParams, len(mmDeny.callArgs))
+	copy(argCopy, mmDeny.callArgs)
+
+	mmDeny.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDenyDone returns true if the count of the Deny invocations corresponds
+// the number of defined expectations
+func (m *RoleRequestServiceMock) MinimockDenyDone() bool {
+	for _, e := range m.DenyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DenyMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDenyCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeny != nil && mm_atomic.LoadUint64(&m.afterDenyCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockDenyInspect logs each unmet expectation
+func (m *RoleRequestServiceMock) MinimockDenyInspect() {
+	for _, e := range m.DenyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRequestServiceMock.Deny with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DenyMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDenyCounter) < 1 {
+		if m.DenyMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RoleRequestServiceMock.Deny")
+		} else {
+			m.t.Errorf("Expected call to RoleRequestServiceMock.Deny with params: %#v", *m.DenyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeny != nil && mm_atomic.LoadUint64(&m.afterDenyCounter) < 1 {
+		m.t.Error("Expected call to RoleRequestServiceMock.Deny")
+	}
+}
+
+type mRoleRequestServiceMockExpireGrants struct {
+	mock               *RoleRequestServiceMock
+	defaultExpectation *RoleRequestServiceMockExpireGrantsExpectation
+	expectations       []*RoleRequestServiceMockExpireGrantsExpectation
+
+	callArgs []*RoleRequestServiceMockExpireGrantsParams
+	mutex    sync.RWMutex
+}
+
+// RoleRequestServiceMockExpireGrantsExpectation specifies expectation struct of the RoleRequestService.ExpireGrants
+type RoleRequestServiceMockExpireGrantsExpectation struct {
+	mock      *RoleRequestServiceMock
+	params    *RoleRequestServiceMockExpireGrantsParams
+	paramPtrs *RoleRequestServiceMockExpireGrantsParamPtrs
+	results   *RoleRequestServiceMockExpireGrantsResults
+	Counter   uint64
+}
+
+// RoleRequestServiceMockExpireGrantsParams contains parameters of the RoleRequestService.ExpireGrants
+type RoleRequestServiceMockExpireGrantsParams struct {
+	ctx context.Context
+}
+
+// RoleRequestServiceMockExpireGrantsParamPtrs contains pointers to parameters of the RoleRequestService.ExpireGrants
+type RoleRequestServiceMockExpireGrantsParamPtrs struct {
+	ctx *context.Context
+}
+
+// RoleRequestServiceMockExpireGrantsResults contains results of the RoleRequestService.ExpireGrants
+type RoleRequestServiceMockExpireGrantsResults struct {
+	i1  int
+	err error
+}
+
+// Expect sets up expected params for RoleRequestService.ExpireGrants
+func (mmExpireGrants *mRoleRequestServiceMockExpireGrants) Expect(ctx context.Context) *mRoleRequestServiceMockExpireGrants {
+	if mmExpireGrants.mock.funcExpireGrants != nil {
+		mmExpireGrants.mock.t.Fatalf("RoleRequestServiceMock.ExpireGrants mock is already set by Set")
+	}
+
+	if mmExpireGrants.defaultExpectation == nil {
+		mmExpireGrants.defaultExpectation = &RoleRequestServiceMockExpireGrantsExpectation{}
+	}
+
+	if mmExpireGrants.defaultExpectation.paramPtrs != nil {
+		mmExpireGrants.mock.t.Fatalf("RoleRequestServiceMock.ExpireGrants mock is already set by ExpectParams functions")
+	}
+
+	mmExpireGrants.defaultExpectation.params = &RoleRequestServiceMockExpireGrantsParams{ctx}
+	for _, e := range mmExpireGrants.expectations {
+		if minimock.Equal(e.params, mmExpireGrants.defaultExpectation.params) {
+			mmExpireGrants.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExpireGrants.defaultExpectation.params)
+		}
+	}
+
+	return mmExpireGrants
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRequestService.ExpireGrants
+func (mmExpireGrants *mRoleRequestServiceMockExpireGrants) ExpectCtxParam1(ctx context.Context) *mRoleRequestServiceMockExpireGrants {
+	if mmExpireGrants.mock.funcExpireGrants != nil {
+		mmExpireGrants.mock.t.Fatalf("RoleRequestServiceMock.ExpireGrants mock is already set by Set")
+	}
+
+	if mmExpireGrants.defaultExpectation == nil {
+		mmExpireGrants.defaultExpectation = &RoleRequestServiceMockExpireGrantsExpectation{}
+	}
+
+	if mmExpireGrants.defaultExpectation.params != nil {
+		mmExpireGrants.mock.t.Fatalf("RoleRequestServiceMock.ExpireGrants mock is already set by Expect")
+	}
+
+	if mmExpireGrants.defaultExpectation.paramPtrs == nil {
+		mmExpireGrants.defaultExpectation.paramPtrs = &RoleRequestServiceMockExpireGrantsParamPtrs{}
+	}
+	mmExpireGrants.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmExpireGrants
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRequestService.ExpireGrants
+func (mmExpireGrants *mRoleRequestServiceMockExpireGrants) Inspect(f func(ctx context.Context)) *mRoleRequestServiceMockExpireGrants {
+	if mmExpireGrants.mock.inspectFuncExpireGrants != nil {
+		mmExpireGrants.mock.t.Fatalf("Inspect function is already set for RoleRequestServiceMock.ExpireGrants")
+	}
+
+	mmExpireGrants.mock.inspectFuncExpireGrants = f
+
+	return mmExpireGrants
+}
+
+// Return sets up results that will be returned by RoleRequestService.ExpireGrants
+func (mmExpireGrants *mRoleRequestServiceMockExpireGrants) Return(i1 int, err error) *RoleRequestServiceMock {
+	if mmExpireGrants.mock.funcExpireGrants != nil {
+		mmExpireGrants.mock.t.Fatalf("RoleRequestServiceMock.ExpireGrants mock is already set by Set")
+	}
+
+	if mmExpireGrants.defaultExpectation == nil {
+		mmExpireGrants.defaultExpectation = &RoleRequestServiceMockExpireGrantsExpectation{mock: mmExpireGrants.mock}
+	}
+	mmExpireGrants.defaultExpectation.results = &RoleRequestServiceMockExpireGrantsResults{i1, err}
+	return mmExpireGrants.mock
+}
+
+// Set uses given function f to mock the RoleRequestService.ExpireGrants method
+func (mmExpireGrants *mRoleRequestServiceMockExpireGrants) Set(f func(ctx context.Context) (i1 int, err error)) *RoleRequestServiceMock {
+	if mmExpireGrants.defaultExpectation != nil {
+		mmExpireGrants.mock.t.Fatalf("Default expectation is already set for the RoleRequestService.ExpireGrants method")
+	}
+
+	if len(mmExpireGrants.expectations) > 0 {
+		mmExpireGrants.mock.t.Fatalf("Some expectations are already set for the RoleRequestService.ExpireGrants method")
+	}
+
+	mmExpireGrants.mock.funcExpireGrants = f
+	return mmExpireGrants.mock
+}
+
+// When sets expectation for the RoleRequestService.ExpireGrants which will trigger the result defined by the following
+// Then helper
+func (mmExpireGrants *mRoleRequestServiceMockExpireGrants) When(ctx context.Context) *RoleRequestServiceMockExpireGrantsExpectation {
+	if mmExpireGrants.mock.funcExpireGrants != nil {
+		mmExpireGrants.mock.t.Fatalf("RoleRequestServiceMock.ExpireGrants mock is already set by Set")
+	}
+
+	expectation := &RoleRequestServiceMockExpireGrantsExpectation{
+		mock:   mmExpireGrants.mock,
+		params: &RoleRequestServiceMockExpireGrantsParams{ctx},
+	}
+	mmExpireGrants.expectations = append(mmExpireGrants.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRequestService.ExpireGrants return parameters for the expectation previously defined by the When method
+func (e *RoleRequestServiceMockExpireGrantsExpectation) Then(i1 int, err error) *RoleRequestServiceMock {
+	e.results = &RoleRequestServiceMockExpireGrantsResults{i1, err}
+	return e.mock
+}
+
+// ExpireGrants implements service.RoleRequestService
+func (mmExpireGrants *RoleRequestServiceMock) ExpireGrants(ctx context.Context) (i1 int, err error) {
+	mm_atomic.AddUint64(&mmExpireGrants.beforeExpireGrantsCounter, 1)
+	defer mm_atomic.AddUint64(&mmExpireGrants.afterExpireGrantsCounter, 1)
+
+	if mmExpireGrants.inspectFuncExpireGrants != nil {
+		mmExpireGrants.inspectFuncExpireGrants(ctx)
+	}
+
+	mm_params := RoleRequestServiceMockExpireGrantsParams{ctx}
+
+	// Record call args
+	mmExpireGrants.ExpireGrantsMock.mutex.Lock()
+	mmExpireGrants.ExpireGrantsMock.callArgs = append(mmExpireGrants.ExpireGrantsMock.callArgs, &mm_params)
+	mmExpireGrants.ExpireGrantsMock.mutex.Unlock()
+
+	for _, e := range mmExpireGrants.ExpireGrantsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmExpireGrants.ExpireGrantsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExpireGrants.ExpireGrantsMock.defaultExpectation.Counter, 1)
+		mm_want := mmExpireGrants.ExpireGrantsMock.defaultExpectation.params
+		mm_want_ptrs := mmExpireGrants.ExpireGrantsMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRequestServiceMockExpireGrantsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExpireGrants.t.Errorf("RoleRequestServiceMock.ExpireGrants got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExpireGrants.t.Errorf("RoleRequestServiceMock.ExpireGrants got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExpireGrants.ExpireGrantsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExpireGrants.t.Fatal("No results are set for the RoleRequestServiceMock.ExpireGrants")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmExpireGrants.funcExpireGrants != nil {
+		return mmExpireGrants.funcExpireGrants(ctx)
+	}
+	mmExpireGrants.t.Fatalf("Unexpected call to RoleRequestServiceMock.ExpireGrants. %v", ctx)
+	return
+}
+
+// ExpireGrantsAfterCounter returns a count of finished RoleRequestServiceMock.ExpireGrants invocations
+func (mmExpireGrants *RoleRequestServiceMock) ExpireGrantsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExpireGrants.afterExpireGrantsCounter)
+}
+
+// ExpireGrantsBeforeCounter returns a count of RoleRequestServiceMock.ExpireGrants invocations
+func (mmExpireGrants *RoleRequestServiceMock) ExpireGrantsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExpireGrants.beforeExpireGrantsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRequestServiceMock.ExpireGrants.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExpireGrants *mRoleRequestServiceMockExpireGrants) Calls() []*RoleRequestServiceMockExpireGrantsParams {
+	mmExpireGrants.mutex.RLock()
+
+	argCopy := make([]*RoleRequestServiceMockExpireGrantsParams, len(mmExpireGrants.callArgs))
+	copy(argCopy, mmExpireGrants.callArgs)
+
+	mmExpireGrants.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExpireGrantsDone returns true if the count of the ExpireGrants invocations corresponds
+// the number of defined expectations
+func (m *RoleRequestServiceMock) MinimockExpireGrantsDone() bool {
+	for _, e := range m.ExpireGrantsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExpireGrantsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterExpireGrantsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExpireGrants != nil && mm_atomic.LoadUint64(&m.afterExpireGrantsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockExpireGrantsInspect logs each unmet expectation
+func (m *RoleRequestServiceMock) MinimockExpireGrantsInspect() {
+	for _, e := range m.ExpireGrantsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRequestServiceMock.ExpireGrants with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExpireGrantsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterExpireGrantsCounter) < 1 {
+		if m.ExpireGrantsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RoleRequestServiceMock.ExpireGrants")
+		} else {
+			m.t.Errorf("Expected call to RoleRequestServiceMock.ExpireGrants with params: %#v", *m.ExpireGrantsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExpireGrants != nil && mm_atomic.LoadUint64(&m.afterExpireGrantsCounter) < 1 {
+		m.t.Error("Expected call to RoleRequestServiceMock.ExpireGrants")
+	}
+}
+
+type mRoleRequestServiceMockGet struct {
+	mock               *RoleRequestServiceMock
+	defaultExpectation *RoleRequestServiceMockGetExpectation
+	expectations       []*RoleRequestServiceMockGetExpectation
+
+	callArgs []*RoleRequestServiceMockGetParams
+	mutex    sync.RWMutex
+}
+
+// RoleRequestServiceMockGetExpectation specifies expectation struct of the RoleRequestService.Get
+type RoleRequestServiceMockGetExpectation struct {
+	mock      *RoleRequestServiceMock
+	params    *RoleRequestServiceMockGetParams
+	paramPtrs *RoleRequestServiceMockGetParamPtrs
+	results   *RoleRequestServiceMockGetResults
+	Counter   uint64
+}
+
+// RoleRequestServiceMockGetParams contains parameters of the RoleRequestService.Get
+type RoleRequestServiceMockGetParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// RoleRequestServiceMockGetParamPtrs contains pointers to parameters of the RoleRequestService.Get
+type RoleRequestServiceMockGetParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// RoleRequestServiceMockGetResults contains results of the RoleRequestService.Get
+type RoleRequestServiceMockGetResults struct {
+	rp1 *model.RoleRequest
+	err error
+}
+
+// Expect sets up expected params for RoleRequestService.Get
+func (mmGet *mRoleRequestServiceMockGet) Expect(ctx context.Context, id int64) *mRoleRequestServiceMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RoleRequestServiceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RoleRequestServiceMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("RoleRequestServiceMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &RoleRequestServiceMockGetParams{ctx, id}
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRequestService.Get
+func (mmGet *mRoleRequestServiceMockGet) ExpectCtxParam1(ctx context.Context) *mRoleRequestServiceMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RoleRequestServiceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RoleRequestServiceMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("RoleRequestServiceMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &RoleRequestServiceMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGet
+}
+
+// ExpectIdParam2 sets up expected param id for RoleRequestService.Get
+func (mmGet *mRoleRequestServiceMockGet) ExpectIdParam2(id int64) *mRoleRequestServiceMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RoleRequestServiceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RoleRequestServiceMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("RoleRequestServiceMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &RoleRequestServiceMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.id = &id
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRequestService.Get
+func (mmGet *mRoleRequestServiceMockGet) Inspect(f func(ctx context.Context, id int64)) *mRoleRequestServiceMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for RoleRequestServiceMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by RoleRequestService.Get
+func (mmGet *mRoleRequestServiceMockGet) Return(rp1 *model.RoleRequest, err error) *RoleRequestServiceMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RoleRequestServiceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RoleRequestServiceMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &RoleRequestServiceMockGetResults{rp1, err}
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the RoleRequestService.Get method
+func (mmGet *mRoleRequestServiceMockGet) Set(f func(ctx context.Context, id int64) (rp1 *model.RoleRequest, err error)) *RoleRequestServiceMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the RoleRequestService.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the RoleRequestService.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	return mmGet.mock
+}
+
+// When sets expectation for the RoleRequestService.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mRoleRequestServiceMockGet) When(ctx context.Context, id int64) *RoleRequestServiceMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RoleRequestServiceMock.Get mock is already set by Set")
+	}
+
+	expectation := &RoleRequestServiceMockGetExpectation{
+		mock:   mmGet.mock,
+		params: &RoleRequestServiceMockGetParams{ctx, id},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRequestService.Get return parameters for the expectation previously defined by the When method
+func (e *RoleRequestServiceMockGetExpectation) Then(rp1 *model.RoleRequest, err error) *RoleRequestServiceMock {
+	e.results = &RoleRequestServiceMockGetResults{rp1, err}
+	return e.mock
+}
+
+// Get implements service.RoleRequestService
+func (mmGet *RoleRequestServiceMock) Get(ctx context.Context, id int64) (rp1 *model.RoleRequest, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, id)
+	}
+
+	mm_params := RoleRequestServiceMockGetParams{ctx, id}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRequestServiceMockGetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("RoleRequestServiceMock.Get got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGet.t.Errorf("RoleRequestServiceMock.Get got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("RoleRequestServiceMock.Get got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the RoleRequestServiceMock.Get")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, id)
+	}
+	mmGet.t.Fatalf("Unexpected call to RoleRequestServiceMock.Get. %v %v", ctx, id)
+	return
+}
+
+// GetAfterCounter returns a count of finished RoleRequestServiceMock.Get invocations
+func (mmGet *RoleRequestServiceMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of RoleRequestServiceMock.Get invocations
+func (mmGet *RoleRequestServiceMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRequestServiceMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mRoleRequestServiceMockGet) Calls() []*RoleRequestServiceMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*RoleRequestServiceMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *RoleRequestServiceMock) MinimockGetDone() bool {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && mm_atomic.LoadUint64(&m.afterGetCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *RoleRequestServiceMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRequestServiceMock.Get with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetCounter) < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RoleRequestServiceMock.Get")
+		} else {
+			m.t.Errorf("Expected call to RoleRequestServiceMock.Get with params: %#v", *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && mm_atomic.LoadUint64(&m.afterGetCounter) < 1 {
+		m.t.Error("Expected call to RoleRequestServiceMock.Get")
+	}
+}
+
+type mRoleRequestServiceMockList struct {
+	mock               *RoleRequestServiceMock
+	defaultExpectation *RoleRequestServiceMockListExpectation
+	expectations       []*RoleRequestServiceMockListExpectation
+
+	callArgs []*RoleRequestServiceMockListParams
+	mutex    sync.RWMutex
+}
+
+// RoleRequestServiceMockListExpectation specifies expectation struct of the RoleRequestService.List
+type RoleRequestServiceMockListExpectation struct {
+	mock      *RoleRequestServiceMock
+	params    *RoleRequestServiceMockListParams
+	paramPtrs *RoleRequestServiceMockListParamPtrs
+	results   *RoleRequestServiceMockListResults
+	Counter   uint64
+}
+
+// RoleRequestServiceMockListParams contains parameters of the RoleRequestService.List
+type RoleRequestServiceMockListParams struct {
+	ctx       context.Context
+	pageToken string
+	filter    model.RoleRequestFilter
+}
+
+// RoleRequestServiceMockListParamPtrs contains pointers to parameters of the RoleRequestService.List
+type RoleRequestServiceMockListParamPtrs struct {
+	ctx       *context.Context
+	pageToken *string
+	filter    *model.RoleRequestFilter
+}
+
+// RoleRequestServiceMockListResults contains results of the RoleRequestService.List
+type RoleRequestServiceMockListResults struct {
+	rp1 *model.RoleRequestPage
+	err error
+}
+
+// Expect sets up expected params for RoleRequestService.List
+func (mmList *mRoleRequestServiceMockList) Expect(ctx context.Context, pageToken string, filter model.RoleRequestFilter) *mRoleRequestServiceMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RoleRequestServiceMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &RoleRequestServiceMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("RoleRequestServiceMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &RoleRequestServiceMockListParams{ctx, pageToken, filter}
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRequestService.List
+func (mmList *mRoleRequestServiceMockList) ExpectCtxParam1(ctx context.Context) *mRoleRequestServiceMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RoleRequestServiceMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &RoleRequestServiceMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("RoleRequestServiceMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &RoleRequestServiceMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmList
+}
+
+// ExpectPageTokenParam2 sets up expected param pageToken for RoleRequestService.List
+func (mmList *mRoleRequestServiceMockList) ExpectPageTokenParam2(pageToken string) *mRoleRequestServiceMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RoleRequestServiceMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &RoleRequestServiceMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("RoleRequestServiceMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &RoleRequestServiceMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.pageToken = &pageToken
+
+	return mmList
+}
+
+// ExpectFilterParam3 sets up expected param filter for RoleRequestService.List
+func (mmList *mRoleRequestServiceMockList) ExpectFilterParam3(filter model.RoleRequestFilter) *mRoleRequestServiceMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RoleRequestServiceMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &RoleRequestServiceMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("RoleRequestServiceMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &RoleRequestServiceMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.filter = &filter
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRequestService.List
+func (mmList *mRoleRequestServiceMockList) Inspect(f func(ctx context.Context, pageToken string, filter model.RoleRequestFilter)) *mRoleRequestServiceMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for RoleRequestServiceMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by RoleRequestService.List
+func (mmList *mRoleRequestServiceMockList) Return(rp1 *model.RoleRequestPage, err error) *RoleRequestServiceMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RoleRequestServiceMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &RoleRequestServiceMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &RoleRequestServiceMockListResults{rp1, err}
+	return mmList.mock
+}
+
+// Set uses given function f to mock the RoleRequestService.List method
+func (mmList *mRoleRequestServiceMockList) Set(f func(ctx context.Context, pageToken string, filter model.RoleRequestFilter) (rp1 *model.RoleRequestPage, err error)) *RoleRequestServiceMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the RoleRequestService.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the RoleRequestService.List method")
+	}
+
+	mmList.mock.funcList = f
+	return mmList.mock
+}
+
+// When sets expectation for the RoleRequestService.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mRoleRequestServiceMockList) When(ctx context.Context, pageToken string, filter model.RoleRequestFilter) *RoleRequestServiceMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RoleRequestServiceMock.List mock is already set by Set")
+	}
+
+	expectation := &RoleRequestServiceMockListExpectation{
+		mock:   mmList.mock,
+		params: &RoleRequestServiceMockListParams{ctx, pageToken, filter},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRequestService.List return parameters for the expectation previously defined by the When method
+func (e *RoleRequestServiceMockListExpectation) Then(rp1 *model.RoleRequestPage, err error) *RoleRequestServiceMock {
+	e.results = &RoleRequestServiceMockListResults{rp1, err}
+	return e.mock
+}
+
+// List implements service.RoleRequestService
+func (mmList *RoleRequestServiceMock) List(ctx context.Context, pageToken string, filter model.RoleRequestFilter) (rp1 *model.RoleRequestPage, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx, pageToken, filter)
+	}
+
+	mm_params := RoleRequestServiceMockListParams{ctx, pageToken, filter}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRequestServiceMockListParams{ctx, pageToken, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("RoleRequestServiceMock.List got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.pageToken != nil && !minimock.Equal(*mm_want_ptrs.pageToken, mm_got.pageToken) {
+				mmList.t.Errorf("RoleRequestServiceMock.List got unexpected parameter pageToken, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageToken, mm_got.pageToken, minimock.Diff(*mm_want_ptrs.pageToken, mm_got.pageToken))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmList.t.Errorf("RoleRequestServiceMock.List got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("RoleRequestServiceMock.List got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the RoleRequestServiceMock.List")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx, pageToken, filter)
+	}
+	mmList.t.Fatalf("Unexpected call to RoleRequestServiceMock.List. %v %v %v", ctx, pageToken, filter)
+	return
+}
+
+// ListAfterCounter returns a count of finished RoleRequestServiceMock.List invocations
+func (mmList *RoleRequestServiceMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of RoleRequestServiceMock.List invocations
+func (mmList *RoleRequestServiceMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRequestServiceMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mRoleRequestServiceMockList) Calls() []*RoleRequestServiceMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*RoleRequestServiceMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *RoleRequestServiceMock) MinimockListDone() bool {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && mm_atomic.LoadUint64(&m.afterListCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *RoleRequestServiceMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRequestServiceMock.List with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListCounter) < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RoleRequestServiceMock.List")
+		} else {
+			m.t.Errorf("Expected call to RoleRequestServiceMock.List with params: %#v", *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && mm_atomic.LoadUint64(&m.afterListCounter) < 1 {
+		m.t.Error("Expected call to RoleRequestServiceMock.List")
+	}
+}
+
+type mRoleRequestServiceMockRequest struct {
+	mock               *RoleRequestServiceMock
+	defaultExpectation *RoleRequestServiceMockRequestExpectation
+	expectations       []*RoleRequestServiceMockRequestExpectation
+
+	callArgs []*RoleRequestServiceMockRequestParams
+	mutex    sync.RWMutex
+}
+
+// RoleRequestServiceMockRequestExpectation specifies expectation struct of the RoleRequestService.Request
+type RoleRequestServiceMockRequestExpectation struct {
+	mock      *RoleRequestServiceMock
+	params    *RoleRequestServiceMockRequestParams
+	paramPtrs *RoleRequestServiceMockRequestParamPtrs
+	results   *RoleRequestServiceMockRequestResults
+	Counter   uint64
+}
+
+// RoleRequestServiceMockRequestParams contains parameters of the RoleRequestService.Request
+type RoleRequestServiceMockRequestParams struct {
+	ctx context.Context
+	req *model.CreateRoleRequest
+}
+
+// RoleRequestServiceMockRequestParamPtrs contains pointers to parameters of the RoleRequestService.Request
+type RoleRequestServiceMockRequestParamPtrs struct {
+	ctx *context.Context
+	req **model.CreateRoleRequest
+}
+
+// RoleRequestServiceMockRequestResults contains results of the RoleRequestService.Request
+type RoleRequestServiceMockRequestResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for RoleRequestService.Request
+func (mmRequest *mRoleRequestServiceMockRequest) Expect(ctx context.Context, req *model.CreateRoleRequest) *mRoleRequestServiceMockRequest {
+	if mmRequest.mock.funcRequest != nil {
+		mmRequest.mock.t.Fatalf("RoleRequestServiceMock.Request mock is already set by Set")
+	}
+
+	if mmRequest.defaultExpectation == nil {
+		mmRequest.defaultExpectation = &RoleRequestServiceMockRequestExpectation{}
+	}
+
+	if mmRequest.defaultExpectation.paramPtrs != nil {
+		mmRequest.mock.t.Fatalf("RoleRequestServiceMock.Request mock is already set by ExpectParams functions")
+	}
+
+	mmRequest.defaultExpectation.params = &RoleRequestServiceMockRequestParams{ctx, req}
+	for _, e := range mmRequest.expectations {
+		if minimock.Equal(e.params, mmRequest.defaultExpectation.params) {
+			mmRequest.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRequest.defaultExpectation.params)
+		}
+	}
+
+	return mmRequest
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRequestService.Request
+func (mmRequest *mRoleRequestServiceMockRequest) ExpectCtxParam1(ctx context.Context) *mRoleRequestServiceMockRequest {
+	if mmRequest.mock.funcRequest != nil {
+		mmRequest.mock.t.Fatalf("RoleRequestServiceMock.Request mock is already set by Set")
+	}
+
+	if mmRequest.defaultExpectation == nil {
+		mmRequest.defaultExpectation = &RoleRequestServiceMockRequestExpectation{}
+	}
+
+	if mmRequest.defaultExpectation.params != nil {
+		mmRequest.mock.t.Fatalf("RoleRequestServiceMock.Request mock is already set by Expect")
+	}
+
+	if mmRequest.defaultExpectation.paramPtrs == nil {
+		mmRequest.defaultExpectation.paramPtrs = &RoleRequestServiceMockRequestParamPtrs{}
+	}
+	mmRequest.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRequest
+}
+
+// ExpectReqParam2 sets up expected param req for RoleRequestService.Request
+func (mmRequest *mRoleRequestServiceMockRequest) ExpectReqParam2(req *model.CreateRoleRequest) *mRoleRequestServiceMockRequest {
+	if mmRequest.mock.funcRequest != nil {
+		mmRequest.mock.t.Fatalf("RoleRequestServiceMock.Request mock is already set by Set")
+	}
+
+	if mmRequest.defaultExpectation == nil {
+		mmRequest.defaultExpectation = &RoleRequestServiceMockRequestExpectation{}
+	}
+
+	if mmRequest.defaultExpectation.params != nil {
+		mmRequest.mock.t.Fatalf("RoleRequestServiceMock.Request mock is already set by Expect")
+	}
+
+	if mmRequest.defaultExpectation.paramPtrs == nil {
+		mmRequest.defaultExpectation.paramPtrs = &RoleRequestServiceMockRequestParamPtrs{}
+	}
+	mmRequest.defaultExpectation.paramPtrs.req = &req
+
+	return mmRequest
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRequestService.Request
+func (mmRequest *mRoleRequestServiceMockRequest) Inspect(f func(ctx context.Context, req *model.CreateRoleRequest)) *mRoleRequestServiceMockRequest {
+	if mmRequest.mock.inspectFuncRequest != nil {
+		mmRequest.mock.t.Fatalf("Inspect function is already set for RoleRequestServiceMock.Request")
+	}
+
+	mmRequest.mock.inspectFuncRequest = f
+
+	return mmRequest
+}
+
+// Return sets up results that will be returned by RoleRequestService.Request
+func (mmRequest *mRoleRequestServiceMockRequest) Return(i1 int64, err error) *RoleRequestServiceMock {
+	if mmRequest.mock.funcRequest != nil {
+		mmRequest.mock.t.Fatalf("RoleRequestServiceMock.Request mock is already set by Set")
+	}
+
+	if mmRequest.defaultExpectation == nil {
+		mmRequest.defaultExpectation = &RoleRequestServiceMockRequestExpectation{mock: mmRequest.mock}
+	}
+	mmRequest.defaultExpectation.results = &RoleRequestServiceMockRequestResults{i1, err}
+	return mmRequest.mock
+}
+
+// Set uses given function f to mock the RoleRequestService.Request method
+func (mmRequest *mRoleRequestServiceMockRequest) Set(f func(ctx context.Context, req *model.CreateRoleRequest) (i1 int64, err error)) *RoleRequestServiceMock {
+	if mmRequest.defaultExpectation != nil {
+		mmRequest.mock.t.Fatalf("Default expectation is already set for the RoleRequestService.Request method")
+	}
+
+	if len(mmRequest.expectations) > 0 {
+		mmRequest.mock.t.Fatalf("Some expectations are already set for the RoleRequestService.Request method")
+	}
+
+	mmRequest.mock.funcRequest = f
+	return mmRequest.mock
+}
+
+// When sets expectation for the RoleRequestService.Request which will trigger the result defined by the following
+// Then helper
+func (mmRequest *mRoleRequestServiceMockRequest) When(ctx context.Context, req *model.CreateRoleRequest) *RoleRequestServiceMockRequestExpectation {
+	if mmRequest.mock.funcRequest != nil {
+		mmRequest.mock.t.Fatalf("RoleRequestServiceMock.Request mock is already set by Set")
+	}
+
+	expectation := &RoleRequestServiceMockRequestExpectation{
+		mock:   mmRequest.mock,
+		params: &RoleRequestServiceMockRequestParams{ctx, req},
+	}
+	mmRequest.expectations = append(mmRequest.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRequestService.Request return parameters for the expectation previously defined by the When method
+func (e *RoleRequestServiceMockRequestExpectation) Then(i1 int64, err error) *RoleRequestServiceMock {
+	e.results = &RoleRequestServiceMockRequestResults{i1, err}
+	return e.mock
+}
+
+// Request implements service.RoleRequestService
+func (mmRequest *RoleRequestServiceMock) Request(ctx context.Context, req *model.CreateRoleRequest) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmRequest.beforeRequestCounter, 1)
+	defer mm_atomic.AddUint64(&mmRequest.afterRequestCounter, 1)
+
+	if mmRequest.inspectFuncRequest != nil {
+		mmRequest.inspectFuncRequest(ctx, req)
+	}
+
+	mm_params := RoleRequestServiceMockRequestParams{ctx, req}
+
+	// Record call args
+	mmRequest.RequestMock.mutex.Lock()
+	mmRequest.RequestMock.callArgs = append(mmRequest.RequestMock.callArgs, &mm_params)
+	mmRequest.RequestMock.mutex.Unlock()
+
+	for _, e := range mmRequest.RequestMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmRequest.RequestMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRequest.RequestMock.defaultExpectation.Counter, 1)
+		mm_want := mmRequest.RequestMock.defaultExpectation.params
+		mm_want_ptrs := mmRequest.RequestMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRequestServiceMockRequestParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRequest.t.Errorf("RoleRequestServiceMock.Request got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmRequest.t.Errorf("RoleRequestServiceMock.Request got unexpected parameter req, want: %#v, got: %#v%s\n", *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRequest.t.Errorf("RoleRequestServiceMock.Request got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRequest.RequestMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRequest.t.Fatal("No results are set for the RoleRequestServiceMock.Request")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmRequest.funcRequest != nil {
+		return mmRequest.funcRequest(ctx, req)
+	}
+	mmRequest.t.Fatalf("Unexpected call to RoleRequestServiceMock.Request. %v %v", ctx, req)
+	return
+}
+
+// RequestAfterCounter returns a count of finished RoleRequestServiceMock.Request invocations
+func (mmRequest *RoleRequestServiceMock) RequestAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequest.afterRequestCounter)
+}
+
+// RequestBeforeCounter returns a count of RoleRequestServiceMock.Request invocations
+func (mmRequest *RoleRequestServiceMock) RequestBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequest.beforeRequestCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRequestServiceMock.Request.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRequest *mRoleRequestServiceMockRequest) Calls() []*RoleRequestServiceMockRequestParams {
+	mmRequest.mutex.RLock()
+
+	argCopy := make([]*RoleRequestServiceMockRequestParams, len(mmRequest.callArgs))
+	copy(argCopy, mmRequest.callArgs)
+
+	mmRequest.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRequestDone returns true if the count of the Request invocations corresponds
+// the number of defined expectations
+func (m *RoleRequestServiceMock) MinimockRequestDone() bool {
+	for _, e := range m.RequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RequestMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRequestCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRequest != nil && mm_atomic.LoadUint64(&m.afterRequestCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockRequestInspect logs each unmet expectation
+func (m *RoleRequestServiceMock) MinimockRequestInspect() {
+	for _, e := range m.RequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRequestServiceMock.Request with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RequestMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRequestCounter) < 1 {
+		if m.RequestMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RoleRequestServiceMock.Request")
+		} else {
+			m.t.Errorf("Expected call to RoleRequestServiceMock.Request with params: %#v", *m.RequestMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRequest != nil && mm_atomic.LoadUint64(&m.afterRequestCounter) < 1 {
+		m.t.Error("Expected call to RoleRequestServiceMock.Request")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RoleRequestServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockApproveInspect()
+
+			m.MinimockDenyInspect()
+
+			m.MinimockExpireGrantsInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockListInspect()
+
+			m.MinimockRequestInspect()
+			m.t.FailNow()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RoleRequestServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RoleRequestServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockApproveDone() &&
+		m.MinimockDenyDone() &&
+		m.MinimockExpireGrantsDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockListDone() &&
+		m.MinimockRequestDone()
+}
@@ -37,6 +37,18 @@ type UserServiceMock struct {
 	beforeGetCounter uint64
 	GetMock          mUserServiceMockGet
 
+	funcSetRoles          func(ctx context.Context, id int64, roles []model.Role) (err error)
+	inspectFuncSetRoles   func(ctx context.Context, id int64, roles []model.Role)
+	afterSetRolesCounter  uint64
+	beforeSetRolesCounter uint64
+	SetRolesMock          mUserServiceMockSetRoles
+
+	funcSignUp          func(ctx context.Context, user *model.CreateUser) (i1 int64, err error)
+	inspectFuncSignUp   func(ctx context.Context, user *model.CreateUser)
+	afterSignUpCounter  uint64
+	beforeSignUpCounter uint64
+	SignUpMock          mUserServiceMockSignUp
+
 	funcUpdate          func(ctx context.Context, user *model.UpdateUser) (err error)
 	inspectFuncUpdate   func(ctx context.Context, user *model.UpdateUser)
 	afterUpdateCounter  uint64
@@ -61,6 +73,12 @@ func NewUserServiceMock(t minimock.Tester) *UserServiceMock {
 	m.GetMock = mUserServiceMockGet{mock: m}
 	m.GetMock.callArgs = []*UserServiceMockGetParams{}
 
+	m.SetRolesMock = mUserServiceMockSetRoles{mock: m}
+	m.SetRolesMock.callArgs = []*UserServiceMockSetRolesParams{}
+
+	m.SignUpMock = mUserServiceMockSignUp{mock: m}
+	m.SignUpMock.callArgs = []*UserServiceMockSignUpParams{}
+
 	m.UpdateMock = mUserServiceMockUpdate{mock: m}
 	m.UpdateMock.callArgs = []*UserServiceMockUpdateParams{}
 
@@ -923,6 +941,603 @@ func (m *UserServiceMock) MinimockGetInspect() {
 	}
 }
 
+type mUserServiceMockSetRoles struct {
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockSetRolesExpectation
+	expectations       []*UserServiceMockSetRolesExpectation
+
+	callArgs []*UserServiceMockSetRolesParams
+	mutex    sync.RWMutex
+}
+
+// UserServiceMockSetRolesExpectation specifies expectation struct of the UserService.SetRoles
+type UserServiceMockSetRolesExpectation struct {
+	mock      *UserServiceMock
+	params    *UserServiceMockSetRolesParams
+	paramPtrs *UserServiceMockSetRolesParamPtrs
+	results   *UserServiceMockSetRolesResults
+	Counter   uint64
+}
+
+// UserServiceMockSetRolesParams contains parameters of the UserService.SetRoles
+type UserServiceMockSetRolesParams struct {
+	ctx   context.Context
+	id    int64
+	roles []model.Role
+}
+
+// UserServiceMockSetRolesParamPtrs contains pointers to parameters of the UserService.SetRoles
+type UserServiceMockSetRolesParamPtrs struct {
+	ctx   *context.Context
+	id    *int64
+	roles *[]model.Role
+}
+
+// UserServiceMockSetRolesResults contains results of the UserService.SetRoles
+type UserServiceMockSetRolesResults struct {
+	err error
+}
+
+// Expect sets up expected params for UserService.SetRoles
+func (mmSetRoles *mUserServiceMockSetRoles) Expect(ctx context.Context, id int64, roles []model.Role) *mUserServiceMockSetRoles {
+	if mmSetRoles.mock.funcSetRoles != nil {
+		mmSetRoles.mock.t.Fatalf("UserServiceMock.SetRoles mock is already set by Set")
+	}
+
+	if mmSetRoles.defaultExpectation == nil {
+		mmSetRoles.defaultExpectation = &UserServiceMockSetRolesExpectation{}
+	}
+
+	if mmSetRoles.defaultExpectation.paramPtrs != nil {
+		mmSetRoles.mock.t.Fatalf("UserServiceMock.SetRoles mock is already set by ExpectParams functions")
+	}
+
+	mmSetRoles.defaultExpectation.params = &UserServiceMockSetRolesParams{ctx, id, roles}
+	for _, e := range mmSetRoles.expectations {
+		if minimock.Equal(e.params, mmSetRoles.defaultExpectation.params) {
+			mmSetRoles.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetRoles.defaultExpectation.params)
+		}
+	}
+
+	return mmSetRoles
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.SetRoles
+func (mmSetRoles *mUserServiceMockSetRoles) ExpectCtxParam1(ctx context.Context) *mUserServiceMockSetRoles {
+	if mmSetRoles.mock.funcSetRoles != nil {
+		mmSetRoles.mock.t.Fatalf("UserServiceMock.SetRoles mock is already set by Set")
+	}
+
+	if mmSetRoles.defaultExpectation == nil {
+		mmSetRoles.defaultExpectation = &UserServiceMockSetRolesExpectation{}
+	}
+
+	if mmSetRoles.defaultExpectation.params != nil {
+		mmSetRoles.mock.t.Fatalf("UserServiceMock.SetRoles mock is already set by Expect")
+	}
+
+	if mmSetRoles.defaultExpectation.paramPtrs == nil {
+		mmSetRoles.defaultExpectation.paramPtrs = &UserServiceMockSetRolesParamPtrs{}
+	}
+	mmSetRoles.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmSetRoles
+}
+
+// ExpectIdParam2 sets up expected param id for UserService.SetRoles
+func (mmSetRoles *mUserServiceMockSetRoles) ExpectIdParam2(id int64) *mUserServiceMockSetRoles {
+	if mmSetRoles.mock.funcSetRoles != nil {
+		mmSetRoles.mock.t.Fatalf("UserServiceMock.SetRoles mock is already set by Set")
+	}
+
+	if mmSetRoles.defaultExpectation == nil {
+		mmSetRoles.defaultExpectation = &UserServiceMockSetRolesExpectation{}
+	}
+
+	if mmSetRoles.defaultExpectation.params != nil {
+		mmSetRoles.mock.t.Fatalf("UserServiceMock.SetRoles mock is already set by Expect")
+	}
+
+	if mmSetRoles.defaultExpectation.paramPtrs == nil {
+		mmSetRoles.defaultExpectation.paramPtrs = &UserServiceMockSetRolesParamPtrs{}
+	}
+	mmSetRoles.defaultExpectation.paramPtrs.id = &id
+
+	return mmSetRoles
+}
+
+// ExpectRolesParam3 sets up expected param roles for UserService.SetRoles
+func (mmSetRoles *mUserServiceMockSetRoles) ExpectRolesParam3(roles []model.Role) *mUserServiceMockSetRoles {
+	if mmSetRoles.mock.funcSetRoles != nil {
+		mmSetRoles.mock.t.Fatalf("UserServiceMock.SetRoles mock is already set by Set")
+	}
+
+	if mmSetRoles.defaultExpectation == nil {
+		mmSetRoles.defaultExpectation = &UserServiceMockSetRolesExpectation{}
+	}
+
+	if mmSetRoles.defaultExpectation.params != nil {
+		mmSetRoles.mock.t.Fatalf("UserServiceMock.SetRoles mock is already set by Expect")
+	}
+
+	if mmSetRoles.defaultExpectation.paramPtrs == nil {
+		mmSetRoles.defaultExpectation.paramPtrs = &UserServiceMockSetRolesParamPtrs{}
+	}
+	mmSetRoles.defaultExpectation.paramPtrs.roles = &roles
+
+	return mmSetRoles
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.SetRoles
+func (mmSetRoles *mUserServiceMockSetRoles) Inspect(f func(ctx context.Context, id int64, roles []model.Role)) *mUserServiceMockSetRoles {
+	if mmSetRoles.mock.inspectFuncSetRoles != nil {
+		mmSetRoles.mock.t.Fatalf("Inspect function is already set for UserServiceMock.SetRoles")
+	}
+
+	mmSetRoles.mock.inspectFuncSetRoles = f
+
+	return mmSetRoles
+}
+
+// Return sets up results that will be returned by UserService.SetRoles
+func (mmSetRoles *mUserServiceMockSetRoles) Return(err error) *UserServiceMock {
+	if mmSetRoles.mock.funcSetRoles != nil {
+		mmSetRoles.mock.t.Fatalf("UserServiceMock.SetRoles mock is already set by Set")
+	}
+
+	if mmSetRoles.defaultExpectation == nil {
+		mmSetRoles.defaultExpectation = &UserServiceMockSetRolesExpectation{mock: mmSetRoles.mock}
+	}
+	mmSetRoles.defaultExpectation.results = &UserServiceMockSetRolesResults{err}
+	return mmSetRoles.mock
+}
+
+// Set uses given function f to mock the UserService.SetRoles method
+func (mmSetRoles *mUserServiceMockSetRoles) Set(f func(ctx context.Context, id int64, roles []model.Role) (err error)) *UserServiceMock {
+	if mmSetRoles.defaultExpectation != nil {
+		mmSetRoles.mock.t.Fatalf("Default expectation is already set for the UserService.SetRoles method")
+	}
+
+	if len(mmSetRoles.expectations) > 0 {
+		mmSetRoles.mock.t.Fatalf("Some expectations are already set for the UserService.SetRoles method")
+	}
+
+	mmSetRoles.mock.funcSetRoles = f
+	return mmSetRoles.mock
+}
+
+// When sets expectation for the UserService.SetRoles which will trigger the result defined by the following
+// Then helper
+func (mmSetRoles *mUserServiceMockSetRoles) When(ctx context.Context, id int64, roles []model.Role) *UserServiceMockSetRolesExpectation {
+	if mmSetRoles.mock.funcSetRoles != nil {
+		mmSetRoles.mock.t.Fatalf("UserServiceMock.SetRoles mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockSetRolesExpectation{
+		mock:   mmSetRoles.mock,
+		params: &UserServiceMockSetRolesParams{ctx, id, roles},
+	}
+	mmSetRoles.expectations = append(mmSetRoles.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.SetRoles return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockSetRolesExpectation) Then(err error) *UserServiceMock {
+	e.results = &UserServiceMockSetRolesResults{err}
+	return e.mock
+}
+
+// SetRoles implements service.UserService
+func (mmSetRoles *UserServiceMock) SetRoles(ctx context.Context, id int64, roles []model.Role) (err error) {
+	mm_atomic.AddUint64(&mmSetRoles.beforeSetRolesCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetRoles.afterSetRolesCounter, 1)
+
+	if mmSetRoles.inspectFuncSetRoles != nil {
+		mmSetRoles.inspectFuncSetRoles(ctx, id, roles)
+	}
+
+	mm_params := UserServiceMockSetRolesParams{ctx, id, roles}
+
+	// Record call args
+	mmSetRoles.SetRolesMock.mutex.Lock()
+	mmSetRoles.SetRolesMock.callArgs = append(mmSetRoles.SetRolesMock.callArgs, &mm_params)
+	mmSetRoles.SetRolesMock.mutex.Unlock()
+
+	for _, e := range mmSetRoles.SetRolesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetRoles.SetRolesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetRoles.SetRolesMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetRoles.SetRolesMock.defaultExpectation.params
+		mm_want_ptrs := mmSetRoles.SetRolesMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockSetRolesParams{ctx, id, roles}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetRoles.t.Errorf("UserServiceMock.SetRoles got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmSetRoles.t.Errorf("UserServiceMock.SetRoles got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.roles != nil && !minimock.Equal(*mm_want_ptrs.roles, mm_got.roles) {
+				mmSetRoles.t.Errorf("UserServiceMock.SetRoles got unexpected parameter roles, want: %#v, got: %#v%s\n", *mm_want_ptrs.roles, mm_got.roles, minimock.Diff(*mm_want_ptrs.roles, mm_got.roles))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetRoles.t.Errorf("UserServiceMock.SetRoles got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetRoles.SetRolesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetRoles.t.Fatal("No results are set for the UserServiceMock.SetRoles")
+		}
+		return (*mm_results).err
+	}
+	if mmSetRoles.funcSetRoles != nil {
+		return mmSetRoles.funcSetRoles(ctx, id, roles)
+	}
+	mmSetRoles.t.Fatalf("Unexpected call to UserServiceMock.SetRoles. %v %v %v", ctx, id, roles)
+	return
+}
+
+// SetRolesAfterCounter returns a count of finished UserServiceMock.SetRoles invocations
+func (mmSetRoles *UserServiceMock) SetRolesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetRoles.afterSetRolesCounter)
+}
+
+// SetRolesBeforeCounter returns a count of UserServiceMock.SetRoles invocations
+func (mmSetRoles *UserServiceMock) SetRolesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetRoles.beforeSetRolesCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.SetRoles.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetRoles *mUserServiceMockSetRoles) Calls() []*UserServiceMockSetRolesParams {
+	mmSetRoles.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockSetRolesParams, len(mmSetRoles.callArgs))
+	copy(argCopy, mmSetRoles.callArgs)
+
+	mmSetRoles.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetRolesDone returns true if the count of the SetRoles invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockSetRolesDone() bool {
+	for _, e := range m.SetRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetRolesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetRolesCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetRoles != nil && mm_atomic.LoadUint64(&m.afterSetRolesCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSetRolesInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockSetRolesInspect() {
+	for _, e := range m.SetRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.SetRoles with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetRolesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetRolesCounter) < 1 {
+		if m.SetRolesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.SetRoles")
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.SetRoles with params: %#v", *m.SetRolesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetRoles != nil && mm_atomic.LoadUint64(&m.afterSetRolesCounter) < 1 {
+		m.t.Error("Expected call to UserServiceMock.SetRoles")
+	}
+}
+
+type mUserServiceMockSignUp struct {
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockSignUpExpectation
+	expectations       []*UserServiceMockSignUpExpectation
+
+	callArgs []*UserServiceMockSignUpParams
+	mutex    sync.RWMutex
+}
+
+// UserServiceMockSignUpExpectation specifies expectation struct of the UserService.SignUp
+type UserServiceMockSignUpExpectation struct {
+	mock      *UserServiceMock
+	params    *UserServiceMockSignUpParams
+	paramPtrs *UserServiceMockSignUpParamPtrs
+	results   *UserServiceMockSignUpResults
+	Counter   uint64
+}
+
+// UserServiceMockSignUpParams contains parameters of the UserService.SignUp
+type UserServiceMockSignUpParams struct {
+	ctx  context.Context
+	user *model.CreateUser
+}
+
+// UserServiceMockSignUpParamPtrs contains pointers to parameters of the UserService.SignUp
+type UserServiceMockSignUpParamPtrs struct {
+	ctx  *context.Context
+	user **model.CreateUser
+}
+
+// UserServiceMockSignUpResults contains results of the UserService.SignUp
+type UserServiceMockSignUpResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for UserService.SignUp
+func (mmSignUp *mUserServiceMockSignUp) Expect(ctx context.Context, user *model.CreateUser) *mUserServiceMockSignUp {
+	if mmSignUp.mock.funcSignUp != nil {
+		mmSignUp.mock.t.Fatalf("UserServiceMock.SignUp mock is already set by Set")
+	}
+
+	if mmSignUp.defaultExpectation == nil {
+		mmSignUp.defaultExpectation = &UserServiceMockSignUpExpectation{}
+	}
+
+	if mmSignUp.defaultExpectation.paramPtrs != nil {
+		mmSignUp.mock.t.Fatalf("UserServiceMock.SignUp mock is already set by ExpectParams functions")
+	}
+
+	mmSignUp.defaultExpectation.params = &UserServiceMockSignUpParams{ctx, user}
+	for _, e := range mmSignUp.expectations {
+		if minimock.Equal(e.params, mmSignUp.defaultExpectation.params) {
+			mmSignUp.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSignUp.defaultExpectation.params)
+		}
+	}
+
+	return mmSignUp
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.SignUp
+func (mmSignUp *mUserServiceMockSignUp) ExpectCtxParam1(ctx context.Context) *mUserServiceMockSignUp {
+	if mmSignUp.mock.funcSignUp != nil {
+		mmSignUp.mock.t.Fatalf("UserServiceMock.SignUp mock is already set by Set")
+	}
+
+	if mmSignUp.defaultExpectation == nil {
+		mmSignUp.defaultExpectation = &UserServiceMockSignUpExpectation{}
+	}
+
+	if mmSignUp.defaultExpectation.params != nil {
+		mmSignUp.mock.t.Fatalf("UserServiceMock.SignUp mock is already set by Expect")
+	}
+
+	if mmSignUp.defaultExpectation.paramPtrs == nil {
+		mmSignUp.defaultExpectation.paramPtrs = &UserServiceMockSignUpParamPtrs{}
+	}
+	mmSignUp.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmSignUp
+}
+
+// ExpectUserParam2 sets up expected param user for UserService.SignUp
+func (mmSignUp *mUserServiceMockSignUp) ExpectUserParam2(user *model.CreateUser) *mUserServiceMockSignUp {
+	if mmSignUp.mock.funcSignUp != nil {
+		mmSignUp.mock.t.Fatalf("UserServiceMock.SignUp mock is already set by Set")
+	}
+
+	if mmSignUp.defaultExpectation == nil {
+		mmSignUp.defaultExpectation = &UserServiceMockSignUpExpectation{}
+	}
+
+	if mmSignUp.defaultExpectation.params != nil {
+		mmSignUp.mock.t.Fatalf("UserServiceMock.SignUp mock is already set by Expect")
+	}
+
+	if mmSignUp.defaultExpectation.paramPtrs == nil {
+		mmSignUp.defaultExpectation.paramPtrs = &UserServiceMockSignUpParamPtrs{}
+	}
+	mmSignUp.defaultExpectation.paramPtrs.user = &user
+
+	return mmSignUp
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.SignUp
+func (mmSignUp *mUserServiceMockSignUp) Inspect(f func(ctx context.Context, user *model.CreateUser)) *mUserServiceMockSignUp {
+	if mmSignUp.mock.inspectFuncSignUp != nil {
+		mmSignUp.mock.t.Fatalf("Inspect function is already set for UserServiceMock.SignUp")
+	}
+
+	mmSignUp.mock.inspectFuncSignUp = f
+
+	return mmSignUp
+}
+
+// Return sets up results that will be returned by UserService.SignUp
+func (mmSignUp *mUserServiceMockSignUp) Return(i1 int64, err error) *UserServiceMock {
+	if mmSignUp.mock.funcSignUp != nil {
+		mmSignUp.mock.t.Fatalf("UserServiceMock.SignUp mock is already set by Set")
+	}
+
+	if mmSignUp.defaultExpectation == nil {
+		mmSignUp.defaultExpectation = &UserServiceMockSignUpExpectation{mock: mmSignUp.mock}
+	}
+	mmSignUp.defaultExpectation.results = &UserServiceMockSignUpResults{i1, err}
+	return mmSignUp.mock
+}
+
+// Set uses given function f to mock the UserService.SignUp method
+func (mmSignUp *mUserServiceMockSignUp) Set(f func(ctx context.Context, user *model.CreateUser) (i1 int64, err error)) *UserServiceMock {
+	if mmSignUp.defaultExpectation != nil {
+		mmSignUp.mock.t.Fatalf("Default expectation is already set for the UserService.SignUp method")
+	}
+
+	if len(mmSignUp.expectations) > 0 {
+		mmSignUp.mock.t.Fatalf("Some expectations are already set for the UserService.SignUp method")
+	}
+
+	mmSignUp.mock.funcSignUp = f
+	return mmSignUp.mock
+}
+
+// When sets expectation for the UserService.SignUp which will trigger the result defined by the following
+// Then helper
+func (mmSignUp *mUserServiceMockSignUp) When(ctx context.Context, user *model.CreateUser) *UserServiceMockSignUpExpectation {
+	if mmSignUp.mock.funcSignUp != nil {
+		mmSignUp.mock.t.Fatalf("UserServiceMock.SignUp mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockSignUpExpectation{
+		mock:   mmSignUp.mock,
+		params: &UserServiceMockSignUpParams{ctx, user},
+	}
+	mmSignUp.expectations = append(mmSignUp.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.SignUp return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockSignUpExpectation) Then(i1 int64, err error) *UserServiceMock {
+	e.results = &UserServiceMockSignUpResults{i1, err}
+	return e.mock
+}
+
+// SignUp implements service.UserService
+func (mmSignUp *UserServiceMock) SignUp(ctx context.Context, user *model.CreateUser) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmSignUp.beforeSignUpCounter, 1)
+	defer mm_atomic.AddUint64(&mmSignUp.afterSignUpCounter, 1)
+
+	if mmSignUp.inspectFuncSignUp != nil {
+		mmSignUp.inspectFuncSignUp(ctx, user)
+	}
+
+	mm_params := UserServiceMockSignUpParams{ctx, user}
+
+	// Record call args
+	mmSignUp.SignUpMock.mutex.Lock()
+	mmSignUp.SignUpMock.callArgs = append(mmSignUp.SignUpMock.callArgs, &mm_params)
+	mmSignUp.SignUpMock.mutex.Unlock()
+
+	for _, e := range mmSignUp.SignUpMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmSignUp.SignUpMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSignUp.SignUpMock.defaultExpectation.Counter, 1)
+		mm_want := mmSignUp.SignUpMock.defaultExpectation.params
+		mm_want_ptrs := mmSignUp.SignUpMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockSignUpParams{ctx, user}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSignUp.t.Errorf("UserServiceMock.SignUp got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.user != nil && !minimock.Equal(*mm_want_ptrs.user, mm_got.user) {
+				mmSignUp.t.Errorf("UserServiceMock.SignUp got unexpected parameter user, want: %#v, got: %#v%s\n", *mm_want_ptrs.user, mm_got.user, minimock.Diff(*mm_want_ptrs.user, mm_got.user))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSignUp.t.Errorf("UserServiceMock.SignUp got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSignUp.SignUpMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSignUp.t.Fatal("No results are set for the UserServiceMock.SignUp")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmSignUp.funcSignUp != nil {
+		return mmSignUp.funcSignUp(ctx, user)
+	}
+	mmSignUp.t.Fatalf("Unexpected call to UserServiceMock.SignUp. %v %v", ctx, user)
+	return
+}
+
+// SignUpAfterCounter returns a count of finished UserServiceMock.SignUp invocations
+func (mmSignUp *UserServiceMock) SignUpAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSignUp.afterSignUpCounter)
+}
+
+// SignUpBeforeCounter returns a count of UserServiceMock.SignUp invocations
+func (mmSignUp *UserServiceMock) SignUpBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSignUp.beforeSignUpCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.SignUp.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSignUp *mUserServiceMockSignUp) Calls() []*UserServiceMockSignUpParams {
+	mmSignUp.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockSignUpParams, len(mmSignUp.callArgs))
+	copy(argCopy, mmSignUp.callArgs)
+
+	mmSignUp.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSignUpDone returns true if the count of the SignUp invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockSignUpDone() bool {
+	for _, e := range m.SignUpMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SignUpMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSignUpCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSignUp != nil && mm_atomic.LoadUint64(&m.afterSignUpCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSignUpInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockSignUpInspect() {
+	for _, e := range m.SignUpMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.SignUp with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SignUpMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSignUpCounter) < 1 {
+		if m.SignUpMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.SignUp")
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.SignUp with params: %#v", *m.SignUpMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSignUp != nil && mm_atomic.LoadUint64(&m.afterSignUpCounter) < 1 {
+		m.t.Error("Expected call to UserServiceMock.SignUp")
+	}
+}
+
 type mUserServiceMockUpdate struct {
 	mock               *UserServiceMock
 	defaultExpectation *UserServiceMockUpdateExpectation
@@ -1217,6 +1832,10 @@ func (m *UserServiceMock) MinimockFinish() {
 
 			m.MinimockGetInspect()
 
+			m.MinimockSetRolesInspect()
+
+			m.MinimockSignUpInspect()
+
 			m.MinimockUpdateInspect()
 			m.t.FailNow()
 		}
@@ -1245,5 +1864,7 @@ func (m *UserServiceMock) minimockDone() bool {
 		m.MinimockCreateDone() &&
 		m.MinimockDeleteDone() &&
 		m.MinimockGetDone() &&
+		m.MinimockSetRolesDone() &&
+		m.MinimockSignUpDone() &&
 		m.MinimockUpdateDone()
 }
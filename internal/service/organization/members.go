@@ -0,0 +1,97 @@
+package organization
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/arifullov/auth/internal/authctx"
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+	"github.com/arifullov/auth/internal/sys/validate"
+)
+
+func (s *serv) AddMember(ctx context.Context, slug string, userID int64, roles []model.Role) error {
+	err := validate.Validate(
+		ctx,
+		callerManages(slug),
+		rolesAreGrantable(roles),
+		s.rolesExist(roles),
+	)
+	if err != nil {
+		return err
+	}
+
+	org, err := s.orgRepository.GetBySlug(ctx, slug)
+	if err != nil {
+		return err
+	}
+	return s.orgRepository.AddMember(ctx, &model.OrganizationMember{
+		OrganizationID: org.ID,
+		UserID:         userID,
+		Roles:          roles,
+	})
+}
+
+func (s *serv) RemoveMember(ctx context.Context, slug string, userID int64) error {
+	if err := validate.Validate(ctx, callerManages(slug)); err != nil {
+		return err
+	}
+
+	org, err := s.orgRepository.GetBySlug(ctx, slug)
+	if err != nil {
+		return err
+	}
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		// Access tokens issued for the organization stay valid until revoked. Tokens are
+		// revoked first, users are only found in the organization while they are members.
+		if errTx := s.userRepository.RevokeTokens(ctx, userID, time.Now()); errTx != nil {
+			return errTx
+		}
+		return s.orgRepository.RemoveMember(ctx, org.ID, userID)
+	})
+}
+
+// callerManages requires a token issued outside of organizations or for the organization itself.
+func callerManages(slug string) validate.Condition {
+	return func(ctx context.Context) error {
+		if tenant := authctx.Tenant(ctx); tenant != "" && tenant != slug {
+			return sys.NewCommonError(codes.PermissionDenied, "token is not valid for the organization")
+		}
+		return nil
+	}
+}
+
+// rolesAreGrantable requires the caller to hold each of the roles, so nobody can grant
+// a role in the organization higher than their own.
+func rolesAreGrantable(roles []model.Role) validate.Condition {
+	return func(ctx context.Context) error {
+		claims, ok := authctx.Claims(ctx)
+		if !ok {
+			return sys.NewCommonError(codes.Unauthenticated, "caller is not authenticated")
+		}
+		for _, role := range roles {
+			if !claims.HasRole(role) {
+				return sys.NewCommonError(codes.PermissionDenied, fmt.Sprintf("role %s cannot be granted by the caller", role))
+			}
+		}
+		return nil
+	}
+}
+
+func (s *serv) rolesExist(roles []model.Role) validate.Condition {
+	return func(ctx context.Context) error {
+		existing, err := s.roleRepository.GetExisting(ctx, roles)
+		if err != nil {
+			return err
+		}
+		for _, role := range roles {
+			if !slices.Contains(existing, role) {
+				return validate.NewValidationErrors(fmt.Sprintf("unknown role %s", role))
+			}
+		}
+		return nil
+	}
+}
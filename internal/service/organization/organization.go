@@ -0,0 +1,31 @@
+package organization
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/arifullov/auth/internal/authctx"
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+)
+
+func (s *serv) Create(ctx context.Context, org *model.Organization) (int64, error) {
+	// Admins of an organization must not create organizations they would not belong to.
+	if authctx.Tenant(ctx) != "" {
+		return 0, sys.NewCommonError(codes.PermissionDenied, "organizations cannot be created within an organization")
+	}
+	return s.orgRepository.Create(ctx, org)
+}
+
+func (s *serv) List(ctx context.Context) ([]model.Organization, error) {
+	claims, ok := authctx.Claims(ctx)
+	if !ok {
+		return nil, sys.NewCommonError(codes.Unauthenticated, "caller is not authenticated")
+	}
+	userID, err := strconv.ParseInt(claims.Subject, 10, 64)
+	if err != nil {
+		return nil, sys.NewCommonError(codes.PermissionDenied, "token does not identify a user")
+	}
+	return s.orgRepository.ListByUser(ctx, userID)
+}
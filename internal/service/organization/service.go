@@ -0,0 +1,28 @@
+package organization
+
+import (
+	"github.com/arifullov/auth/internal/client/db"
+	"github.com/arifullov/auth/internal/repository"
+	"github.com/arifullov/auth/internal/service"
+)
+
+type serv struct {
+	orgRepository  repository.OrganizationRepository
+	userRepository repository.UserRepository
+	roleRepository repository.RoleRepository
+	txManager      db.TxManager
+}
+
+func NewOrganizationService(
+	orgRepository repository.OrganizationRepository,
+	userRepository repository.UserRepository,
+	roleRepository repository.RoleRepository,
+	txManager db.TxManager,
+) service.OrganizationService {
+	return &serv{
+		orgRepository:  orgRepository,
+		userRepository: userRepository,
+		roleRepository: roleRepository,
+		txManager:      txManager,
+	}
+}
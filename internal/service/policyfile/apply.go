@@ -0,0 +1,99 @@
+package policyfile
+
+import (
+	"context"
+
+	"github.com/arifullov/auth/internal/model"
+)
+
+// Apply makes the stored policy equal to the document in one transaction. Rules are deleted
+// before the roles they may refer to, and roles are created before the rules granted to them.
+func (s *serv) Apply(ctx context.Context, doc *model.PolicyDocument) (*model.PolicyPlan, error) {
+	normalize(doc)
+	if err := validateDocument(ctx, doc); err != nil {
+		return nil, err
+	}
+
+	var plan *model.PolicyPlan
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		plan, errTx = s.plan(ctx, doc)
+		if errTx != nil {
+			return errTx
+		}
+
+		for _, change := range plan.Rules {
+			if change.Kind != model.PolicyChangeDelete {
+				continue
+			}
+			if errTx = s.accessRepository.DeleteRouteRule(ctx, change.Before.ID); errTx != nil {
+				return errTx
+			}
+			if errTx = s.auditRule(ctx, model.AuditActionDelete, change.Before.ID, change.Before, nil); errTx != nil {
+				return errTx
+			}
+		}
+
+		for _, change := range plan.Roles {
+			if change.Kind != model.PolicyChangeDelete {
+				continue
+			}
+			if errTx = s.roleRepository.Delete(ctx, change.Before.Name); errTx != nil {
+				return errTx
+			}
+			if errTx = s.auditRole(ctx, model.AuditActionDelete, change.Before.Name, change.Before, nil); errTx != nil {
+				return errTx
+			}
+		}
+		for _, change := range plan.Roles {
+			if change.Kind != model.PolicyChangeCreate {
+				continue
+			}
+			if errTx = s.roleRepository.Create(ctx, change.After); errTx != nil {
+				return errTx
+			}
+		}
+		// Parents may be created by this plan as well, so they are set once every role exists.
+		for _, change := range plan.Roles {
+			if change.Kind == model.PolicyChangeDelete {
+				continue
+			}
+			if errTx = s.roleRepository.Update(ctx, change.After); errTx != nil {
+				return errTx
+			}
+			action := model.AuditActionUpdate
+			if change.Kind == model.PolicyChangeCreate {
+				action = model.AuditActionCreate
+			}
+			if errTx = s.auditRole(ctx, action, change.After.Name, change.Before, change.After); errTx != nil {
+				return errTx
+			}
+		}
+
+		for _, change := range plan.Rules {
+			switch change.Kind {
+			case model.PolicyChangeUpdate:
+				if errTx = s.accessRepository.UpdateRouteRule(ctx, change.After); errTx != nil {
+					return errTx
+				}
+				if errTx = s.auditRule(ctx, model.AuditActionUpdate, change.After.ID, change.Before, change.After); errTx != nil {
+					return errTx
+				}
+			case model.PolicyChangeCreate:
+				change.After.ID, errTx = s.accessRepository.CreateRouteRule(ctx, change.After)
+				if errTx != nil {
+					return errTx
+				}
+				if errTx = s.auditRule(ctx, model.AuditActionCreate, change.After.ID, nil, change.After); errTx != nil {
+					return errTx
+				}
+			default:
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
@@ -0,0 +1,49 @@
+package policyfile
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/arifullov/auth/internal/model"
+)
+
+// Policy files are applied on startup and by the policy command, neither has a caller
+// to attribute the changes to.
+
+func (s *serv) auditRole(ctx context.Context, action string, name model.Role, before *model.RoleDefinition, after *model.RoleDefinition) error {
+	record := &model.AuditRecord{
+		Actor:        model.AuditActorSystem,
+		Action:       action,
+		ResourceType: model.AuditResourceRole,
+		ResourceID:   string(name),
+		CreatedAt:    time.Now(),
+	}
+	// Typed nil pointers would be stored as JSON null instead of SQL NULL.
+	if before != nil {
+		record.Before = before
+	}
+	if after != nil {
+		record.After = after
+	}
+	_, err := s.auditRepository.Create(ctx, record)
+	return err
+}
+
+func (s *serv) auditRule(ctx context.Context, action string, id int64, before *model.RouteRule, after *model.RouteRule) error {
+	record := &model.AuditRecord{
+		Actor:        model.AuditActorSystem,
+		Action:       action,
+		ResourceType: model.AuditResourceRouteRule,
+		ResourceID:   strconv.FormatInt(id, 10),
+		CreatedAt:    time.Now(),
+	}
+	if before != nil {
+		record.Before = before
+	}
+	if after != nil {
+		record.After = after
+	}
+	_, err := s.auditRepository.Create(ctx, record)
+	return err
+}
@@ -0,0 +1,127 @@
+package policyfile
+
+import (
+	"context"
+	"slices"
+
+	"github.com/arifullov/auth/internal/model"
+)
+
+type ruleKey struct {
+	route  string
+	method string
+	role   model.Role
+}
+
+func keyOf(rule model.RouteRule) ruleKey {
+	return ruleKey{route: rule.Route, method: rule.Method, role: rule.Role}
+}
+
+func (s *serv) Plan(ctx context.Context, doc *model.PolicyDocument) (*model.PolicyPlan, error) {
+	normalize(doc)
+	if err := validateDocument(ctx, doc); err != nil {
+		return nil, err
+	}
+	return s.plan(ctx, doc)
+}
+
+func (s *serv) Export(ctx context.Context) (*model.PolicyDocument, error) {
+	roles, err := s.roleRepository.ListDefinitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := s.accessRepository.ListRouteRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Ids are not part of the document, rules are matched by route, method and role.
+	for i := range rules {
+		rules[i].ID = 0
+	}
+	return &model.PolicyDocument{
+		Roles: roles,
+		Rules: rules,
+	}, nil
+}
+
+// plan compares the normalized document with the stored policy.
+func (s *serv) plan(ctx context.Context, doc *model.PolicyDocument) (*model.PolicyPlan, error) {
+	currentRoles, err := s.roleRepository.ListDefinitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	currentRules, err := s.accessRepository.ListRouteRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// The database orders names by its collation, the document is ordered bytewise.
+	for i := range currentRoles {
+		slices.Sort(currentRoles[i].Parents)
+		slices.Sort(currentRoles[i].Permissions)
+	}
+
+	return &model.PolicyPlan{
+		Roles: diffRoles(currentRoles, doc.Roles),
+		Rules: diffRules(currentRules, doc.Rules),
+	}, nil
+}
+
+func diffRoles(current []model.RoleDefinition, wanted []model.RoleDefinition) []model.RoleChange {
+	stored := make(map[model.Role]*model.RoleDefinition, len(current))
+	for i := range current {
+		stored[current[i].Name] = &current[i]
+	}
+
+	var changes []model.RoleChange
+	for i := range wanted {
+		want := &wanted[i]
+		before, ok := stored[want.Name]
+		delete(stored, want.Name)
+		switch {
+		case !ok:
+			changes = append(changes, model.RoleChange{Kind: model.PolicyChangeCreate, After: want})
+		case before.Description != want.Description ||
+			!slices.Equal(before.Parents, want.Parents) ||
+			!slices.Equal(before.Permissions, want.Permissions):
+			changes = append(changes, model.RoleChange{Kind: model.PolicyChangeUpdate, Before: before, After: want})
+		default:
+		}
+	}
+	// Roles still left in stored are not in the document.
+	for i := range current {
+		if before, ok := stored[current[i].Name]; ok {
+			changes = append(changes, model.RoleChange{Kind: model.PolicyChangeDelete, Before: before})
+		}
+	}
+	return changes
+}
+
+func diffRules(current []model.RouteRule, wanted []model.RouteRule) []model.RuleChange {
+	want := make(map[ruleKey]*model.RouteRule, len(wanted))
+	for i := range wanted {
+		want[keyOf(wanted[i])] = &wanted[i]
+	}
+
+	var changes []model.RuleChange
+	for i := range current {
+		before := &current[i]
+		after, ok := want[keyOf(*before)]
+		delete(want, keyOf(*before))
+		switch {
+		case !ok:
+			changes = append(changes, model.RuleChange{Kind: model.PolicyChangeDelete, Before: before})
+		case after.Effect != before.Effect || after.OwnerField != before.OwnerField || after.Condition != before.Condition ||
+			!slices.Equal(after.Scopes, before.Scopes):
+			after.ID = before.ID
+			changes = append(changes, model.RuleChange{Kind: model.PolicyChangeUpdate, Before: before, After: after})
+		default:
+		}
+	}
+	// Rules still left in want are not stored yet.
+	for i := range wanted {
+		if after, ok := want[keyOf(wanted[i])]; ok {
+			changes = append(changes, model.RuleChange{Kind: model.PolicyChangeCreate, After: after})
+		}
+	}
+	return changes
+}
@@ -0,0 +1,28 @@
+package policyfile
+
+import (
+	"github.com/arifullov/auth/internal/client/db"
+	"github.com/arifullov/auth/internal/repository"
+	"github.com/arifullov/auth/internal/service"
+)
+
+type serv struct {
+	roleRepository   repository.RoleRepository
+	accessRepository repository.AccessRepository
+	auditRepository  repository.AuditRepository
+	txManager        db.TxManager
+}
+
+func NewPolicyFileService(
+	roleRepository repository.RoleRepository,
+	accessRepository repository.AccessRepository,
+	auditRepository repository.AuditRepository,
+	txManager db.TxManager,
+) service.PolicyFileService {
+	return &serv{
+		roleRepository:   roleRepository,
+		accessRepository: accessRepository,
+		auditRepository:  auditRepository,
+		txManager:        txManager,
+	}
+}
@@ -0,0 +1,137 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	txManagerMocks "github.com/arifullov/auth/internal/client/db/mocks"
+	"github.com/arifullov/auth/internal/model"
+	repositoryMocks "github.com/arifullov/auth/internal/repository/mocks"
+	"github.com/arifullov/auth/internal/service/policyfile"
+	"github.com/arifullov/auth/internal/sys/validate"
+)
+
+func TestPlan(t *testing.T) {
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		storedRoles = []model.RoleDefinition{
+			{Name: "admin", Description: "Administrator", Parents: []model.Role{"user"}},
+			{Name: "legacy", Permissions: []string{"reports.read"}},
+			{Name: "user", Description: "Regular user", Parents: []model.Role{}},
+		}
+		storedRules = []model.RouteRule{
+			{ID: 1, Route: "/user_v1.UserV1/**", Method: model.AnyMethod, Role: "admin", Effect: model.AllowEffect},
+			{ID: 2, Route: "/user_v1.UserV1/Get", Method: model.AnyMethod, Role: "user", Effect: model.AllowEffect},
+			{ID: 3, Route: "/reports/**", Method: model.AnyMethod, Role: "legacy", Effect: model.AllowEffect},
+		}
+	)
+
+	tests := []struct {
+		name string
+		doc  *model.PolicyDocument
+		want *model.PolicyPlan
+		// err is the validation error, if any.
+		err string
+	}{
+		{
+			name: "unchanged policy",
+			doc: &model.PolicyDocument{
+				Roles: []model.RoleDefinition{
+					{Name: "user", Description: "Regular user"},
+					{Name: "admin", Description: "Administrator", Parents: []model.Role{"user"}},
+					{Name: "legacy", Permissions: []string{"reports.read"}},
+				},
+				Rules: []model.RouteRule{
+					{Route: "/user_v1.UserV1/**", Role: "admin"},
+					{Route: "/user_v1.UserV1/Get", Method: "*", Role: "user"},
+					{Route: "/reports/**", Role: "legacy"},
+				},
+			},
+			want: &model.PolicyPlan{},
+		},
+		{
+			name: "changed policy",
+			doc: &model.PolicyDocument{
+				Roles: []model.RoleDefinition{
+					{Name: "user", Description: "Regular user"},
+					{Name: "admin", Description: "Administrator", Parents: []model.Role{"auditor", "user"}},
+					{Name: "auditor", Parents: []model.Role{"user"}, Permissions: []string{"audit.read"}},
+				},
+				Rules: []model.RouteRule{
+					{Route: "/user_v1.UserV1/**", Role: "admin"},
+					{Route: "/user_v1.UserV1/Get", Role: "user", OwnerField: "id"},
+					{Route: "/audit_v1.AuditV1/**", Role: "auditor"},
+				},
+			},
+			want: &model.PolicyPlan{
+				Roles: []model.RoleChange{
+					{
+						Kind:   model.PolicyChangeUpdate,
+						Before: &storedRoles[0],
+						After:  &model.RoleDefinition{Name: "admin", Description: "Administrator", Parents: []model.Role{"auditor", "user"}},
+					},
+					{
+						Kind:  model.PolicyChangeCreate,
+						After: &model.RoleDefinition{Name: "auditor", Parents: []model.Role{"user"}, Permissions: []string{"audit.read"}},
+					},
+					{Kind: model.PolicyChangeDelete, Before: &storedRoles[1]},
+				},
+				Rules: []model.RuleChange{
+					{
+						Kind:   model.PolicyChangeUpdate,
+						Before: &storedRules[1],
+						After:  &model.RouteRule{ID: 2, Route: "/user_v1.UserV1/Get", Method: model.AnyMethod, Role: "user", Effect: model.AllowEffect, OwnerField: "id"},
+					},
+					{Kind: model.PolicyChangeDelete, Before: &storedRules[2]},
+					{
+						Kind:  model.PolicyChangeCreate,
+						After: &model.RouteRule{Route: "/audit_v1.AuditV1/**", Method: model.AnyMethod, Role: "auditor", Effect: model.AllowEffect},
+					},
+				},
+			},
+		},
+		{
+			name: "inheritance cycle",
+			doc: &model.PolicyDocument{
+				Roles: []model.RoleDefinition{
+					{Name: "user", Parents: []model.Role{"admin"}},
+					{Name: "admin", Parents: []model.Role{"user"}},
+				},
+			},
+			err: "role user inherits from itself",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			roleRepositoryMock := repositoryMocks.NewRoleRepositoryMock(mc)
+			accessRepositoryMock := repositoryMocks.NewAccessRepositoryMock(mc)
+			if tt.err == "" {
+				roleRepositoryMock.ListDefinitionsMock.Expect(ctx).Return(storedRoles, nil)
+				accessRepositoryMock.ListRouteRulesMock.Expect(ctx).Return(storedRules, nil)
+			}
+
+			service := policyfile.NewPolicyFileService(
+				roleRepositoryMock,
+				accessRepositoryMock,
+				repositoryMocks.NewAuditRepositoryMock(mc),
+				txManagerMocks.NewTxManagerMock(mc),
+			)
+
+			plan, err := service.Plan(ctx, tt.doc)
+			if tt.err != "" {
+				require.True(t, validate.IsValidationError(err))
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, plan)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package policyfile
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/policy"
+	"github.com/arifullov/auth/internal/sys/validate"
+)
+
+// normalize fills the defaults of the rules and orders the parents and permissions of the
+// roles, so that the document compares equal to the stored policy it was exported from.
+func normalize(doc *model.PolicyDocument) {
+	for i := range doc.Roles {
+		role := &doc.Roles[i]
+		role.Name = model.Role(strings.TrimSpace(string(role.Name)))
+		slices.Sort(role.Parents)
+		role.Parents = slices.Compact(role.Parents)
+		slices.Sort(role.Permissions)
+		role.Permissions = slices.Compact(role.Permissions)
+	}
+	for i := range doc.Rules {
+		rule := &doc.Rules[i]
+		rule.Method = strings.ToUpper(strings.TrimSpace(rule.Method))
+		if rule.Method == "" {
+			rule.Method = model.AnyMethod
+		}
+		if rule.Effect == "" {
+			rule.Effect = model.AllowEffect
+		}
+		slices.Sort(rule.Scopes)
+		rule.Scopes = slices.Compact(rule.Scopes)
+	}
+}
+
+func validateDocument(ctx context.Context, doc *model.PolicyDocument) error {
+	return validate.Validate(
+		ctx,
+		rolesAreValid(doc.Roles),
+		rulesAreValid(doc.Roles, doc.Rules),
+	)
+}
+
+// rolesAreValid requires unique role names and parents defined in the document without cycles.
+func rolesAreValid(roles []model.RoleDefinition) validate.Condition {
+	return func(ctx context.Context) error {
+		parents := make(map[model.Role][]model.Role, len(roles))
+		for _, role := range roles {
+			if role.Name == "" {
+				return validate.NewValidationErrors("role name is required")
+			}
+			if _, ok := parents[role.Name]; ok {
+				return validate.NewValidationErrors(fmt.Sprintf("role %s is defined more than once", role.Name))
+			}
+			parents[role.Name] = role.Parents
+		}
+		for _, role := range roles {
+			for _, parent := range role.Parents {
+				if _, ok := parents[parent]; !ok {
+					return validate.NewValidationErrors(fmt.Sprintf("role %s inherits from undefined role %s", role.Name, parent))
+				}
+			}
+		}
+		if cycle := findCycle(roles, parents); cycle != "" {
+			return validate.NewValidationErrors(fmt.Sprintf("role %s inherits from itself", cycle))
+		}
+		return nil
+	}
+}
+
+// findCycle returns a role on a cycle of the inheritance, empty when there is none.
+// Roles are walked in the order of the document so the same role is reported every time.
+func findCycle(roles []model.RoleDefinition, parents map[model.Role][]model.Role) model.Role {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[model.Role]int, len(parents))
+
+	var visit func(role model.Role) model.Role
+	visit = func(role model.Role) model.Role {
+		switch state[role] {
+		case visiting:
+			return role
+		case visited:
+			return ""
+		}
+		state[role] = visiting
+		for _, parent := range parents[role] {
+			if cycle := visit(parent); cycle != "" {
+				return cycle
+			}
+		}
+		state[role] = visited
+		return ""
+	}
+
+	for _, role := range roles {
+		if cycle := visit(role.Name); cycle != "" {
+			return cycle
+		}
+	}
+	return ""
+}
+
+// rulesAreValid requires valid rules for roles defined in the document, one per route, method and role.
+func rulesAreValid(roles []model.RoleDefinition, rules []model.RouteRule) validate.Condition {
+	return func(ctx context.Context) error {
+		defined := make(map[model.Role]struct{}, len(roles))
+		for _, role := range roles {
+			defined[role.Name] = struct{}{}
+		}
+		seen := make(map[ruleKey]struct{}, len(rules))
+		for _, rule := range rules {
+			if _, ok := defined[rule.Role]; !ok && rule.Role != "" {
+				return validate.NewValidationErrors(fmt.Sprintf("rule for route %s refers to undefined role %s", rule.Route, rule.Role))
+			}
+			if err := policy.ValidateRule(rule); err != nil {
+				return validate.NewValidationErrors(err.Error())
+			}
+			if _, ok := seen[keyOf(rule)]; ok {
+				return validate.NewValidationErrors(fmt.Sprintf("duplicate rule for route %s", rule.Route))
+			}
+			seen[keyOf(rule)] = struct{}{}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,42 @@
+package relation
+
+import (
+	"context"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/sys/validate"
+)
+
+func (s *serv) Check(ctx context.Context, object model.RelationObject, relation string, userID string, consistency model.RelationConsistency) (bool, string, error) {
+	if err := validate.Validate(ctx, s.objectRelationIsValid(object, relation)); err != nil {
+		return false, "", err
+	}
+
+	revision, err := s.snapshot(ctx, consistency)
+	if err != nil {
+		return false, "", err
+	}
+
+	allowed, err := s.checker.Check(ctx, object, relation, userID, revision)
+	if err != nil {
+		return false, "", err
+	}
+	return allowed, encodeToken(revision), nil
+}
+
+func (s *serv) Expand(ctx context.Context, object model.RelationObject, relation string, consistency model.RelationConsistency) (*model.RelationExpandNode, string, error) {
+	if err := validate.Validate(ctx, s.objectRelationIsValid(object, relation)); err != nil {
+		return nil, "", err
+	}
+
+	revision, err := s.snapshot(ctx, consistency)
+	if err != nil {
+		return nil, "", err
+	}
+
+	node, err := s.checker.Expand(ctx, object, relation, revision)
+	if err != nil {
+		return nil, "", err
+	}
+	return node, encodeToken(revision), nil
+}
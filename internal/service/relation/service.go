@@ -0,0 +1,28 @@
+package relation
+
+import (
+	"github.com/arifullov/auth/internal/client/db"
+	"github.com/arifullov/auth/internal/relation"
+	"github.com/arifullov/auth/internal/repository"
+	"github.com/arifullov/auth/internal/service"
+)
+
+type serv struct {
+	relationRepository repository.RelationRepository
+	schema             *relation.Schema
+	checker            *relation.Checker
+	txManager          db.TxManager
+}
+
+func NewRelationService(
+	relationRepository repository.RelationRepository,
+	schema *relation.Schema,
+	txManager db.TxManager,
+) service.RelationService {
+	return &serv{
+		relationRepository: relationRepository,
+		schema:             schema,
+		checker:            relation.NewChecker(schema, relationRepository),
+		txManager:          txManager,
+	}
+}
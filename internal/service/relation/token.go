@@ -0,0 +1,82 @@
+package relation
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"strings"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+)
+
+// encodeToken returns the consistency token of the revision.
+func encodeToken(revision int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(revision, 10)))
+}
+
+func decodeToken(token string) (int64, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, sys.NewCommonError(codes.InvalidArgument, "invalid consistency token")
+	}
+	revision, err := strconv.ParseInt(string(b), 10, 64)
+	if err != nil || revision < 0 {
+		return 0, sys.NewCommonError(codes.InvalidArgument, "invalid consistency token")
+	}
+	return revision, nil
+}
+
+// encodePageToken keeps the revision in the page token so that every page is read at the same snapshot.
+func encodePageToken(revision int64, lastID int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(revision, 10) + ":" + strconv.FormatInt(lastID, 10)))
+}
+
+func decodePageToken(pageToken string) (int64, int64, error) {
+	b, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return 0, 0, sys.NewCommonError(codes.InvalidArgument, "invalid page token")
+	}
+	rawRevision, rawLastID, ok := strings.Cut(string(b), ":")
+	if !ok {
+		return 0, 0, sys.NewCommonError(codes.InvalidArgument, "invalid page token")
+	}
+	revision, err := strconv.ParseInt(rawRevision, 10, 64)
+	if err != nil || revision < 0 {
+		return 0, 0, sys.NewCommonError(codes.InvalidArgument, "invalid page token")
+	}
+	lastID, err := strconv.ParseInt(rawLastID, 10, 64)
+	if err != nil || lastID < 0 {
+		return 0, 0, sys.NewCommonError(codes.InvalidArgument, "invalid page token")
+	}
+	return revision, lastID, nil
+}
+
+// snapshot returns the revision reads are evaluated at.
+func (s *serv) snapshot(ctx context.Context, consistency model.RelationConsistency) (int64, error) {
+	latest, err := s.relationRepository.GetRevision(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	token := consistency.AtLeastAsFresh
+	if consistency.AtExactSnapshot != "" {
+		token = consistency.AtExactSnapshot
+	}
+	if token == "" {
+		return latest, nil
+	}
+
+	revision, err := decodeToken(token)
+	if err != nil {
+		return 0, err
+	}
+	if revision > latest {
+		return 0, sys.NewCommonError(codes.FailedPrecondition, "consistency token is ahead of the latest snapshot")
+	}
+	if consistency.AtExactSnapshot != "" {
+		return revision, nil
+	}
+	return latest, nil
+}
@@ -0,0 +1,82 @@
+package relation
+
+import (
+	"context"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+	"github.com/arifullov/auth/internal/sys/validate"
+)
+
+const (
+	defaultPageSize = 100
+)
+
+func (s *serv) WriteTuples(ctx context.Context, writes []model.RelationTuple, deletes []model.RelationTuple) (string, error) {
+	if len(writes) == 0 && len(deletes) == 0 {
+		return "", sys.NewCommonError(codes.InvalidArgument, "no relation tuples to write or delete")
+	}
+	err := validate.Validate(
+		ctx,
+		s.tuplesAreValid(writes),
+		s.tuplesAreValid(deletes),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	var revision int64
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		revision, errTx = s.relationRepository.NextRevision(ctx)
+		if errTx != nil {
+			return errTx
+		}
+		if errTx = s.relationRepository.DeleteTuples(ctx, deletes, revision); errTx != nil {
+			return errTx
+		}
+		return s.relationRepository.CreateTuples(ctx, writes, revision)
+	})
+	if err != nil {
+		return "", err
+	}
+	return encodeToken(revision), nil
+}
+
+func (s *serv) ReadTuples(ctx context.Context, filter model.RelationTupleFilter, pageToken string, consistency model.RelationConsistency) (*model.RelationTuplePage, error) {
+	var (
+		revision int64
+		err      error
+	)
+	if pageToken != "" {
+		revision, filter.AfterID, err = decodePageToken(pageToken)
+	} else {
+		revision, err = s.snapshot(ctx, consistency)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := filter.Limit
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	// One extra tuple tells whether there is a next page.
+	filter.Limit = pageSize + 1
+	tuples, err := s.relationRepository.FindTuples(ctx, filter, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &model.RelationTuplePage{
+		Tuples: tuples,
+		Token:  encodeToken(revision),
+	}
+	if uint64(len(tuples)) > pageSize {
+		page.Tuples = tuples[:pageSize]
+		page.NextPageToken = encodePageToken(revision, page.Tuples[pageSize-1].ID)
+	}
+	return page, nil
+}
@@ -0,0 +1,28 @@
+package relation
+
+import (
+	"context"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/sys/validate"
+)
+
+func (s *serv) tuplesAreValid(tuples []model.RelationTuple) validate.Condition {
+	return func(ctx context.Context) error {
+		for _, tuple := range tuples {
+			if err := s.schema.ValidateTuple(tuple); err != nil {
+				return validate.NewValidationErrors(err.Error())
+			}
+		}
+		return nil
+	}
+}
+
+func (s *serv) objectRelationIsValid(object model.RelationObject, relation string) validate.Condition {
+	return func(ctx context.Context) error {
+		if err := s.schema.ValidateObjectRelation(object, relation); err != nil {
+			return validate.NewValidationErrors(err.Error())
+		}
+		return nil
+	}
+}
@@ -0,0 +1,83 @@
+package rolerequest
+
+import (
+	"context"
+	"time"
+
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+)
+
+// Approve grants the requested role until the request expires.
+func (s *serv) Approve(ctx context.Context, id int64, comment string) (*model.RoleRequest, error) {
+	return s.decide(ctx, id, model.RoleRequestApproved, comment)
+}
+
+func (s *serv) Deny(ctx context.Context, id int64, comment string) (*model.RoleRequest, error) {
+	return s.decide(ctx, id, model.RoleRequestDenied, comment)
+}
+
+func (s *serv) decide(ctx context.Context, id int64, status model.RoleRequestStatus, comment string) (*model.RoleRequest, error) {
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.checkApprover(ctx, caller); err != nil {
+		return nil, err
+	}
+
+	action := model.AuditActionDeny
+	if status == model.RoleRequestApproved {
+		action = model.AuditActionApprove
+	}
+
+	var decided *model.RoleRequest
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		before, errTx := s.roleRequestRepository.Get(ctx, id)
+		if errTx != nil {
+			return errTx
+		}
+		if before.UserID == caller.id {
+			return sys.NewCommonError(codes.PermissionDenied, "role requests cannot be decided by the requester")
+		}
+
+		decided, errTx = s.roleRequestRepository.Decide(ctx, &model.RoleRequestDecision{
+			ID:         id,
+			Status:     status,
+			ApproverID: caller.id,
+			Comment:    comment,
+			DecidedAt:  time.Now(),
+		})
+		if errTx != nil {
+			return errTx
+		}
+		if status == model.RoleRequestApproved {
+			errTx = s.roleRequestRepository.Grant(ctx, decided.UserID, decided.Role, *decided.ExpiresAt)
+			if errTx != nil {
+				return errTx
+			}
+		}
+		return s.audit(ctx, caller.username, action, id, before, decided)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return decided, nil
+}
+
+// checkApprover requires the caller to be a member of the approver group.
+func (s *serv) checkApprover(ctx context.Context, caller *caller) error {
+	group := s.config.ApproverGroup()
+	if group == "" {
+		return sys.NewCommonError(codes.PermissionDenied, "role request approvals are disabled")
+	}
+	member, err := s.groupRepository.IsMember(ctx, group, caller.id)
+	if err != nil {
+		return err
+	}
+	if !member {
+		return sys.NewCommonError(codes.PermissionDenied, "caller is not a role request approver")
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package rolerequest
+
+import (
+	"context"
+	"time"
+
+	"github.com/arifullov/auth/internal/model"
+)
+
+// ExpireGrants removes the expired role grants and revokes the tokens of their users,
+// since the tokens still carry the roles. It returns the number of removed grants.
+func (s *serv) ExpireGrants(ctx context.Context) (int, error) {
+	var removed int
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		now := time.Now()
+		grants, errTx := s.roleRequestRepository.DeleteExpiredGrants(ctx, now)
+		if errTx != nil {
+			return errTx
+		}
+		removed = len(grants)
+
+		revoked := make(map[int64]struct{}, len(grants))
+		for _, grant := range grants {
+			if _, ok := revoked[grant.UserID]; ok {
+				continue
+			}
+			revoked[grant.UserID] = struct{}{}
+			if errTx = s.userRepository.RevokeTokens(ctx, grant.UserID, now); errTx != nil {
+				return errTx
+			}
+		}
+
+		ids, errTx := s.roleRequestRepository.ExpireRequests(ctx, now)
+		if errTx != nil {
+			return errTx
+		}
+		for _, id := range ids {
+			if errTx = s.audit(ctx, model.AuditActorSystem, model.AuditActionExpire, id, nil, nil); errTx != nil {
+				return errTx
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return removed, nil
+}
@@ -0,0 +1,189 @@
+package rolerequest
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/arifullov/auth/internal/authctx"
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+	"github.com/arifullov/auth/internal/sys/validate"
+)
+
+const (
+	defaultPageSize = 50
+)
+
+func (s *serv) Request(ctx context.Context, req *model.CreateRoleRequest) (int64, error) {
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	req.UserID = caller.id
+
+	err = validate.Validate(
+		ctx,
+		justificationIsNotEmpty(req.Justification),
+		s.roleIsRequestable(req.Role),
+		s.durationIsAllowed(req.Duration),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	var id int64
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		id, errTx = s.roleRequestRepository.Create(ctx, req)
+		if errTx != nil {
+			return errTx
+		}
+		return s.audit(ctx, caller.username, model.AuditActionRequest, id, nil, &model.RoleRequest{
+			ID:            id,
+			UserID:        req.UserID,
+			Role:          req.Role,
+			Justification: req.Justification,
+			Duration:      req.Duration,
+			Status:        model.RoleRequestPending,
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// Get returns the request to the requester and to approvers.
+func (s *serv) Get(ctx context.Context, id int64) (*model.RoleRequest, error) {
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.roleRequestRepository.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserID == caller.id {
+		return req, nil
+	}
+	if err = s.checkApprover(ctx, caller); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// List returns the requests of the caller, approvers see the requests of every user.
+func (s *serv) List(ctx context.Context, pageToken string, filter model.RoleRequestFilter) (*model.RoleRequestPage, error) {
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if filter.UserID != caller.id {
+		if err = s.checkApprover(ctx, caller); err != nil {
+			if filter.UserID != 0 {
+				return nil, err
+			}
+			filter.UserID = caller.id
+		}
+	}
+
+	afterID, err := decodePageToken(pageToken)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := filter.Limit
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	// One extra request tells whether there is a next page.
+	filter.AfterID = afterID
+	filter.Limit = pageSize + 1
+	reqs, err := s.roleRequestRepository.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &model.RoleRequestPage{
+		Requests: reqs,
+	}
+	if uint64(len(reqs)) > pageSize {
+		page.Requests = reqs[:pageSize]
+		page.NextPageToken = encodePageToken(page.Requests[pageSize-1].ID)
+	}
+	return page, nil
+}
+
+type caller struct {
+	id       int64
+	username string
+}
+
+func callerFromContext(ctx context.Context) (*caller, error) {
+	claims, ok := authctx.Claims(ctx)
+	if !ok {
+		return nil, sys.NewCommonError(codes.Unauthenticated, "caller is not authenticated")
+	}
+	id, err := strconv.ParseInt(claims.Subject, 10, 64)
+	if err != nil {
+		return nil, sys.NewCommonError(codes.PermissionDenied, "token does not identify a user")
+	}
+	return &caller{id: id, username: claims.Username}, nil
+}
+
+func justificationIsNotEmpty(justification string) validate.Condition {
+	return func(ctx context.Context) error {
+		if strings.TrimSpace(justification) == "" {
+			return validate.NewValidationErrors("justification is required")
+		}
+		return nil
+	}
+}
+
+// roleIsRequestable requires the role to be configured as requestable, the repository
+// refuses roles that do not exist.
+func (s *serv) roleIsRequestable(role model.Role) validate.Condition {
+	return func(ctx context.Context) error {
+		if !slices.Contains(s.config.RequestableRoles(), string(role)) {
+			return validate.NewValidationErrors(fmt.Sprintf("role %q may not be requested", role))
+		}
+		return nil
+	}
+}
+
+func (s *serv) durationIsAllowed(duration time.Duration) validate.Condition {
+	return func(ctx context.Context) error {
+		if duration < time.Second {
+			return validate.NewValidationErrors("duration must be at least a second")
+		}
+		if duration > s.config.MaxDuration() {
+			return validate.NewValidationErrors(fmt.Sprintf("duration must not exceed %s", s.config.MaxDuration()))
+		}
+		return nil
+	}
+}
+
+func encodePageToken(lastID int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(lastID, 10)))
+}
+
+func decodePageToken(pageToken string) (int64, error) {
+	if pageToken == "" {
+		return 0, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return 0, sys.NewCommonError(codes.InvalidArgument, "invalid page token")
+	}
+	lastID, err := strconv.ParseInt(string(b), 10, 64)
+	if err != nil || lastID < 0 {
+		return 0, sys.NewCommonError(codes.InvalidArgument, "invalid page token")
+	}
+	return lastID, nil
+}
@@ -0,0 +1,35 @@
+package rolerequest
+
+import (
+	"github.com/arifullov/auth/internal/client/db"
+	"github.com/arifullov/auth/internal/config"
+	"github.com/arifullov/auth/internal/repository"
+	"github.com/arifullov/auth/internal/service"
+)
+
+type serv struct {
+	roleRequestRepository repository.RoleRequestRepository
+	groupRepository       repository.GroupRepository
+	userRepository        repository.UserRepository
+	auditRepository       repository.AuditRepository
+	txManager             db.TxManager
+	config                config.RoleRequestConfig
+}
+
+func NewRoleRequestService(
+	roleRequestRepository repository.RoleRequestRepository,
+	groupRepository repository.GroupRepository,
+	userRepository repository.UserRepository,
+	auditRepository repository.AuditRepository,
+	txManager db.TxManager,
+	config config.RoleRequestConfig,
+) service.RoleRequestService {
+	return &serv{
+		roleRequestRepository: roleRequestRepository,
+		groupRepository:       groupRepository,
+		userRepository:        userRepository,
+		auditRepository:       auditRepository,
+		txManager:             txManager,
+		config:                config,
+	}
+}
@@ -0,0 +1,118 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arifullov/auth/internal/authctx"
+	"github.com/arifullov/auth/internal/client/db"
+	txManagerMocks "github.com/arifullov/auth/internal/client/db/mocks"
+	"github.com/arifullov/auth/internal/model"
+	repositoryMocks "github.com/arifullov/auth/internal/repository/mocks"
+	"github.com/arifullov/auth/internal/service/rolerequest"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+)
+
+const approverGroup = "approvers"
+
+type roleRequestConfig struct{}
+
+func (roleRequestConfig) ApproverGroup() string         { return approverGroup }
+func (roleRequestConfig) MaxDuration() time.Duration    { return 8 * time.Hour }
+func (roleRequestConfig) RequestableRoles() []string    { return []string{"auditor"} }
+func (roleRequestConfig) ExpiryInterval() time.Duration { return time.Minute }
+
+func TestApprove(t *testing.T) {
+	var (
+		mc = minimock.NewController(t)
+
+		id         int64 = 3
+		requester  int64 = 7
+		approver   int64 = 9
+		expiresAt        = time.Now().Add(time.Hour)
+		roleName         = model.Role("auditor")
+		pending          = &model.RoleRequest{ID: id, UserID: requester, Role: roleName, Duration: time.Hour, Status: model.RoleRequestPending}
+		approvedAt       = time.Now()
+		approved         = &model.RoleRequest{ID: id, UserID: requester, Role: roleName, Duration: time.Hour, Status: model.RoleRequestApproved, ApproverID: approver, DecidedAt: &approvedAt, ExpiresAt: &expiresAt}
+	)
+
+	tests := []struct {
+		name     string
+		callerID string
+		member   bool
+		// loaded is whether the request is loaded and checked against the caller.
+		loaded  bool
+		granted bool
+		want    *model.RoleRequest
+		err     error
+	}{
+		{
+			name:     "approver grants the role",
+			callerID: "9",
+			member:   true,
+			loaded:   true,
+			granted:  true,
+			want:     approved,
+		},
+		{
+			name:     "caller is not an approver",
+			callerID: "9",
+			err:      sys.NewCommonError(codes.PermissionDenied, "caller is not a role request approver"),
+		},
+		{
+			name:     "approver approves own request",
+			callerID: "7",
+			member:   true,
+			loaded:   true,
+			err:      sys.NewCommonError(codes.PermissionDenied, "role requests cannot be decided by the requester"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := authctx.WithClaims(context.Background(), &model.UserClaims{
+				RegisteredClaims: jwt.RegisteredClaims{Subject: tt.callerID},
+				Username:         "approver@example.com",
+			})
+
+			roleRequestRepositoryMock := repositoryMocks.NewRoleRequestRepositoryMock(mc)
+			groupRepositoryMock := repositoryMocks.NewGroupRepositoryMock(mc)
+			userRepositoryMock := repositoryMocks.NewUserRepositoryMock(mc)
+			auditRepositoryMock := repositoryMocks.NewAuditRepositoryMock(mc)
+			txManagerMock := txManagerMocks.NewTxManagerMock(mc)
+
+			groupRepositoryMock.IsMemberMock.Return(tt.member, nil)
+			if tt.loaded {
+				txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) error {
+					return f(ctx)
+				})
+				roleRequestRepositoryMock.GetMock.Return(pending, nil)
+			}
+			if tt.granted {
+				roleRequestRepositoryMock.DecideMock.Return(approved, nil)
+				roleRequestRepositoryMock.GrantMock.Expect(minimock.AnyContext, requester, roleName, expiresAt).Return(nil)
+				auditRepositoryMock.CreateMock.Return(1, nil)
+			}
+
+			service := rolerequest.NewRoleRequestService(
+				roleRequestRepositoryMock,
+				groupRepositoryMock,
+				userRepositoryMock,
+				auditRepositoryMock,
+				txManagerMock,
+				roleRequestConfig{},
+			)
+
+			got, err := service.Approve(ctx, id, "approved for the incident")
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arifullov/auth/internal/authctx"
+	"github.com/arifullov/auth/internal/client/db"
+	txManagerMocks "github.com/arifullov/auth/internal/client/db/mocks"
+	"github.com/arifullov/auth/internal/model"
+	repositoryMocks "github.com/arifullov/auth/internal/repository/mocks"
+	"github.com/arifullov/auth/internal/service/rolerequest"
+)
+
+func TestRequest(t *testing.T) {
+	var (
+		mc = minimock.NewController(t)
+
+		id        int64 = 3
+		requester int64 = 7
+		ctx             = authctx.WithClaims(context.Background(), &model.UserClaims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: "7"},
+			Username:         "user@example.com",
+		})
+	)
+
+	tests := []struct {
+		name    string
+		role    model.Role
+		created bool
+		wantErr bool
+	}{
+		{
+			name:    "requestable role",
+			role:    "auditor",
+			created: true,
+		},
+		{
+			name:    "role is not requestable",
+			role:    model.AdminRole,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			req := &model.CreateRoleRequest{Role: tt.role, Justification: "incident", Duration: time.Hour}
+
+			roleRequestRepositoryMock := repositoryMocks.NewRoleRequestRepositoryMock(mc)
+			auditRepositoryMock := repositoryMocks.NewAuditRepositoryMock(mc)
+			txManagerMock := txManagerMocks.NewTxManagerMock(mc)
+			if tt.created {
+				txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) error {
+					return f(ctx)
+				})
+				roleRequestRepositoryMock.CreateMock.Expect(minimock.AnyContext, req).Return(id, nil)
+				auditRepositoryMock.CreateMock.Return(1, nil)
+			}
+
+			service := rolerequest.NewRoleRequestService(
+				roleRequestRepositoryMock,
+				repositoryMocks.NewGroupRepositoryMock(mc),
+				repositoryMocks.NewUserRepositoryMock(mc),
+				auditRepositoryMock,
+				txManagerMock,
+				roleRequestConfig{},
+			)
+
+			got, err := service.Request(ctx, req)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, id, got)
+			require.Equal(t, requester, req.UserID)
+		})
+	}
+}
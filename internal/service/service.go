@@ -8,19 +8,129 @@ import (
 
 //go:generate sh -c "rm -rf mocks && mkdir -p mocks"
 //go:generate minimock -i UserService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i AccessService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i RelationService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i OrganizationService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i GroupService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i RoleRequestService -o ./mocks/ -s "_minimock.go"
 type UserService interface {
+	// SignUp registers a user with the default role whatever roles are requested.
+	SignUp(ctx context.Context, user *model.CreateUser) (int64, error)
+	// Create registers a user on behalf of the caller, who must hold every role being granted.
 	Create(ctx context.Context, user *model.CreateUser) (int64, error)
 	Get(ctx context.Context, id int64) (*model.User, error)
 	Update(ctx context.Context, user *model.UpdateUser) error
 	Delete(ctx context.Context, id int64) error
+	// SetRoles replaces the roles of the user and revokes the tokens issued to them.
+	SetRoles(ctx context.Context, id int64, roles []model.Role) error
 }
 
 type AuthService interface {
-	Login(ctx context.Context, username string, password string) (string, error)
+	// Login issues a refresh token, tenant is the organization picked by the user, may be empty.
+	Login(ctx context.Context, username string, password string, tenant string, scope model.ScopeRequest) (string, error)
 	GetRefreshToken(ctx context.Context, oldRefreshToken string) (string, error)
 	GetAccessToken(ctx context.Context, refreshToken string) (string, error)
+	ExchangeToken(ctx context.Context, exchange *model.TokenExchange) (*model.ExchangedToken, error)
+}
+
+type DeviceService interface {
+	Authorize(ctx context.Context, scope model.ScopeRequest) (*model.DeviceAuthorization, error)
+	Approve(ctx context.Context, accessToken string, userCode string, approved bool) error
+	Token(ctx context.Context, grantType string, deviceCode string, clientID string) (*model.TokenPair, error)
+}
+
+type FederatedService interface {
+	// Start begins a federated login, the binding must be kept by the browser that started it.
+	Start(ctx context.Context, provider string) (*model.FederatedLogin, error)
+	// Finish completes the login started by Start with the binding of the browser, it issues a refresh token.
+	Finish(ctx context.Context, provider string, code string, state string, binding string) (string, error)
 }
 
 type AccessService interface {
-	Check(ctx context.Context, accessToken string, endpointAddress string) error
+	// Check authorizes a call of an endpoint by the owner of the access token.
+	Check(ctx context.Context, accessToken string, req *model.AccessRequest) error
+	// Authorize is Check returning the verified claims of the caller.
+	Authorize(ctx context.Context, accessToken string, req *model.AccessRequest) (*model.UserClaims, error)
+	// BatchCheck decides every request for the owner of the access token, an invalid token
+	// fails the whole batch while denials are reported per request.
+	BatchCheck(ctx context.Context, accessToken string, reqs []model.AccessRequest) ([]model.AccessDecision, error)
+	// ListAccessibleRoutes returns the route patterns the user may call, the owner of the token
+	// when userID is zero. Listing the routes of another user requires the admin role.
+	ListAccessibleRoutes(ctx context.Context, accessToken string, userID int64, pageToken string, filter model.RouteGrantFilter) (*model.RouteGrantPage, error)
+	// ListPrincipalsForRoute returns the roles and the users that may call the route, it requires the admin role.
+	ListPrincipalsForRoute(ctx context.Context, accessToken string, route string, method string, pageToken string, limit uint64) (*model.RoutePrincipals, error)
+}
+
+// AccessAdminService manages route rules, every method requires an access token of an admin.
+type AccessAdminService interface {
+	ListRouteRules(ctx context.Context, accessToken string, pageToken string, filter model.RouteRuleFilter) (*model.RouteRulePage, error)
+	GetRouteRule(ctx context.Context, accessToken string, id int64) (*model.RouteRule, error)
+	GrantRouteRule(ctx context.Context, accessToken string, rule *model.RouteRule) (*model.RouteRule, error)
+	UpdateRouteRule(ctx context.Context, accessToken string, rule *model.RouteRule) (*model.RouteRule, error)
+	RevokeRouteRule(ctx context.Context, accessToken string, id int64) error
+	ReplaceRouteRules(ctx context.Context, accessToken string, rules []model.RouteRule) (*model.ReplaceResult, error)
+}
+
+// RelationService stores relation tuples and evaluates relations over them. Reads are
+// evaluated at the snapshot selected by the consistency and return its token.
+type RelationService interface {
+	Check(ctx context.Context, object model.RelationObject, relation string, userID string, consistency model.RelationConsistency) (bool, string, error)
+	// WriteTuples deletes and then writes the tuples in one transaction, it returns the token of the write.
+	WriteTuples(ctx context.Context, writes []model.RelationTuple, deletes []model.RelationTuple) (string, error)
+	// ReadTuples pages through the tuples matching the filter, later pages are read at the snapshot of the first one.
+	ReadTuples(ctx context.Context, filter model.RelationTupleFilter, pageToken string, consistency model.RelationConsistency) (*model.RelationTuplePage, error)
+	Expand(ctx context.Context, object model.RelationObject, relation string, consistency model.RelationConsistency) (*model.RelationExpandNode, string, error)
+}
+
+// OrganizationService manages organizations and their members. Callers with a token issued
+// for an organization manage only that organization.
+type OrganizationService interface {
+	Create(ctx context.Context, org *model.Organization) (int64, error)
+	AddMember(ctx context.Context, slug string, userID int64, roles []model.Role) error
+	// RemoveMember removes the user from the organization and revokes their tokens.
+	RemoveMember(ctx context.Context, slug string, userID int64) error
+	// List returns the organizations the caller is a member of.
+	List(ctx context.Context) ([]model.Organization, error)
+}
+
+// GroupService manages groups of users sharing role bindings. Groups are shared by all
+// organizations, so they are only managed with tokens issued outside of organizations.
+type GroupService interface {
+	Create(ctx context.Context, group *model.CreateGroup) (int64, error)
+	Get(ctx context.Context, id int64) (*model.Group, error)
+	Delete(ctx context.Context, id int64) error
+	SetRoles(ctx context.Context, id int64, roles []model.Role) error
+	AddMember(ctx context.Context, groupID int64, userID int64) error
+	RemoveMember(ctx context.Context, groupID int64, userID int64) error
+	// AddParent nests the group in the parent group, nesting must not create a cycle.
+	AddParent(ctx context.Context, groupID int64, parentID int64) error
+	RemoveParent(ctx context.Context, groupID int64, parentID int64) error
+	// ListEffectiveRoles returns the roles of the user, each with the path granting it.
+	ListEffectiveRoles(ctx context.Context, userID int64) ([]model.EffectiveRole, error)
+}
+
+// RoleRequestService lets users request a role for a limited time. Members of the approver
+// group decide on the requests, approved roles are removed once expired.
+type RoleRequestService interface {
+	// Request asks for the role on behalf of the caller.
+	Request(ctx context.Context, req *model.CreateRoleRequest) (int64, error)
+	Approve(ctx context.Context, id int64, comment string) (*model.RoleRequest, error)
+	Deny(ctx context.Context, id int64, comment string) (*model.RoleRequest, error)
+	Get(ctx context.Context, id int64) (*model.RoleRequest, error)
+	// List returns the requests of the caller, approvers may list the requests of any user.
+	List(ctx context.Context, pageToken string, filter model.RoleRequestFilter) (*model.RoleRequestPage, error)
+	// ExpireGrants removes the expired grants and returns their number.
+	ExpireGrants(ctx context.Context) (int, error)
+}
+
+// PolicyFileService keeps the stored roles and route rules in line with a policy document,
+// anything missing from the document is removed. It is used by the policy command and on
+// startup rather than through the API.
+type PolicyFileService interface {
+	// Plan returns the changes applying the document would make.
+	Plan(ctx context.Context, doc *model.PolicyDocument) (*model.PolicyPlan, error)
+	// Apply makes the changes of the plan in one transaction and returns them.
+	Apply(ctx context.Context, doc *model.PolicyDocument) (*model.PolicyPlan, error)
+	// Export returns the stored policy as a document.
+	Export(ctx context.Context) (*model.PolicyDocument, error)
 }
@@ -5,26 +5,46 @@ import (
 	"net/mail"
 	"time"
 
+	"github.com/arifullov/auth/internal/authctx"
 	"github.com/arifullov/auth/internal/model"
 	"github.com/arifullov/auth/internal/sys/validate"
 	"github.com/arifullov/auth/internal/utils"
 )
 
+func (s *serv) SignUp(ctx context.Context, user *model.CreateUser) (int64, error) {
+	user.Roles = []model.Role{model.UserRole}
+	err := validate.Validate(
+		ctx,
+		emailIsValid(user.Email),
+		passwordIsEqual(user.Password, user.PasswordConfirm),
+	)
+	if err != nil {
+		return 0, err
+	}
+	return s.create(ctx, user)
+}
+
 func (s *serv) Create(ctx context.Context, user *model.CreateUser) (int64, error) {
 	err := validate.Validate(
 		ctx,
+		rolesAreGrantable(user.Roles),
 		emailIsValid(user.Email),
 		passwordIsEqual(user.Password, user.PasswordConfirm),
+		s.rolesExist(user.Roles),
 	)
 	if err != nil {
 		return 0, err
 	}
+	return s.create(ctx, user)
+}
+
+func (s *serv) create(ctx context.Context, user *model.CreateUser) (int64, error) {
 	passwordHash := utils.MakePbkdf2SHA256(user.Password)
 	now := time.Now()
 	user.PasswordHash = passwordHash
 	user.CreatedAt = now
 	user.UpdatedAt = now
-	id, err := s.userRepository.Create(ctx, user)
+	id, err := s.userRepository.Create(ctx, user, authctx.Tenant(ctx))
 	if err != nil {
 		return 0, err
 	}
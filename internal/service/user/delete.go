@@ -2,10 +2,12 @@ package user
 
 import (
 	"context"
+
+	"github.com/arifullov/auth/internal/authctx"
 )
 
 func (s *serv) Delete(ctx context.Context, id int64) error {
-	if err := s.userRepository.Delete(ctx, id); err != nil {
+	if err := s.userRepository.Delete(ctx, id, authctx.Tenant(ctx)); err != nil {
 		return err
 	}
 	return nil
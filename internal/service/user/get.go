@@ -3,11 +3,12 @@ package user
 import (
 	"context"
 
+	"github.com/arifullov/auth/internal/authctx"
 	"github.com/arifullov/auth/internal/model"
 )
 
 func (s *serv) Get(ctx context.Context, id int64) (*model.User, error) {
-	user, err := s.userRepository.Get(ctx, id)
+	user, err := s.userRepository.Get(ctx, id, authctx.Tenant(ctx))
 	if err != nil {
 		return nil, err
 	}
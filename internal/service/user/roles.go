@@ -0,0 +1,75 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/arifullov/auth/internal/authctx"
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+	"github.com/arifullov/auth/internal/sys/validate"
+)
+
+func (s *serv) SetRoles(ctx context.Context, id int64, roles []model.Role) error {
+	err := validate.Validate(
+		ctx,
+		rolesAreGrantable(roles),
+		s.rolesExist(roles),
+	)
+	if err != nil {
+		return err
+	}
+
+	tenant := authctx.Tenant(ctx)
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		user, errTx := s.userRepository.Get(ctx, id, tenant)
+		if errTx != nil {
+			return errTx
+		}
+		// Revoking the roles of a user ranked higher is as much an escalation as granting them.
+		if errTx = rolesAreGrantable(user.Roles)(ctx); errTx != nil {
+			return errTx
+		}
+
+		if errTx = s.userRepository.SetRoles(ctx, id, roles, tenant); errTx != nil {
+			return errTx
+		}
+		// Tokens carry the roles, so the ones issued before the change must not be used anymore.
+		return s.userRepository.RevokeTokens(ctx, id, time.Now())
+	})
+}
+
+// rolesAreGrantable requires the caller to hold each of the roles, assigned or inherited,
+// so nobody can grant a role higher than their own.
+func rolesAreGrantable(roles []model.Role) validate.Condition {
+	return func(ctx context.Context) error {
+		claims, ok := authctx.Claims(ctx)
+		if !ok {
+			return sys.NewCommonError(codes.Unauthenticated, "caller is not authenticated")
+		}
+		for _, role := range roles {
+			if !claims.HasRole(role) {
+				return sys.NewCommonError(codes.PermissionDenied, fmt.Sprintf("role %s cannot be granted by the caller", role))
+			}
+		}
+		return nil
+	}
+}
+
+func (s *serv) rolesExist(roles []model.Role) validate.Condition {
+	return func(ctx context.Context) error {
+		existing, err := s.roleRepository.GetExisting(ctx, roles)
+		if err != nil {
+			return err
+		}
+		for _, role := range roles {
+			if !slices.Contains(existing, role) {
+				return validate.NewValidationErrors(fmt.Sprintf("unknown role %s", role))
+			}
+		}
+		return nil
+	}
+}
@@ -8,15 +8,18 @@ import (
 
 type serv struct {
 	userRepository repository.UserRepository
+	roleRepository repository.RoleRepository
 	txManager      db.TxManager
 }
 
 func NewUserService(
 	userRepository repository.UserRepository,
+	roleRepository repository.RoleRepository,
 	txManager db.TxManager,
 ) service.UserService {
 	return &serv{
 		userRepository: userRepository,
+		roleRepository: roleRepository,
 		txManager:      txManager,
 	}
 }
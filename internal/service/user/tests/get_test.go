@@ -40,7 +40,7 @@ func TestGet(t *testing.T) {
 			ID:        id,
 			Name:      name,
 			Email:     email,
-			Role:      model.AdminRole,
+			Roles:     []model.Role{model.AdminRole},
 			CreatedAt: createdAt,
 			UpdatedAt: createdAt,
 		}
@@ -64,7 +64,7 @@ func TestGet(t *testing.T) {
 			err:  nil,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repositoryMocks.NewUserRepositoryMock(mc)
-				mock.GetMock.Expect(ctx, id).Return(userObj, nil)
+				mock.GetMock.Expect(ctx, id, "").Return(userObj, nil)
 				return mock
 			},
 			txManagerMock: func(mc *minimock.Controller) db.TxManager {
@@ -81,7 +81,7 @@ func TestGet(t *testing.T) {
 			err:  repositoryErr,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repositoryMocks.NewUserRepositoryMock(mc)
-				mock.GetMock.Expect(ctx, id).Return(nil, repositoryErr)
+				mock.GetMock.Expect(ctx, id, "").Return(nil, repositoryErr)
 				return mock
 			},
 			txManagerMock: func(mc *minimock.Controller) db.TxManager {
@@ -94,7 +94,7 @@ func TestGet(t *testing.T) {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			userRepositoryMock := tt.userRepositoryMock(mc)
-			service := user.NewUserService(userRepositoryMock, tt.txManagerMock(mc))
+			service := user.NewUserService(userRepositoryMock, nil, tt.txManagerMock(mc))
 
 			newUser, err := service.Get(tt.args.ctx, tt.args.id)
 			require.Equal(t, tt.err, err)
@@ -0,0 +1,113 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arifullov/auth/internal/authctx"
+	"github.com/arifullov/auth/internal/client/db"
+	txManagerMocks "github.com/arifullov/auth/internal/client/db/mocks"
+	"github.com/arifullov/auth/internal/model"
+	repositoryMocks "github.com/arifullov/auth/internal/repository/mocks"
+	"github.com/arifullov/auth/internal/service/user"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+)
+
+const superRole model.Role = "super"
+
+func TestSetRoles(t *testing.T) {
+	type args struct {
+		callerRoles []model.Role
+		targetRoles []model.Role
+		roles       []model.Role
+		// tenant is the organization the caller token was issued for.
+		tenant string
+	}
+
+	var (
+		mc = minimock.NewController(t)
+
+		id int64 = 7
+	)
+
+	tests := []struct {
+		name string
+		args args
+		err  error
+		// loaded is whether validation passes and the target user is loaded.
+		loaded  bool
+		revoked bool
+	}{
+		{
+			name: "admin grants admin",
+			args: args{
+				callerRoles: []model.Role{model.AdminRole, model.UserRole},
+				targetRoles: []model.Role{model.UserRole},
+				roles:       []model.Role{model.AdminRole},
+			},
+			loaded:  true,
+			revoked: true,
+		},
+		{
+			name: "organization admin grants admin in the organization",
+			args: args{
+				callerRoles: []model.Role{model.AdminRole, model.UserRole},
+				targetRoles: []model.Role{model.UserRole},
+				roles:       []model.Role{model.AdminRole},
+				tenant:      "acme",
+			},
+			loaded:  true,
+			revoked: true,
+		},
+		{
+			name: "role higher than own",
+			args: args{
+				callerRoles: []model.Role{model.AdminRole, model.UserRole},
+				targetRoles: []model.Role{model.UserRole},
+				roles:       []model.Role{superRole},
+			},
+			err: sys.NewCommonError(codes.PermissionDenied, "role super cannot be granted by the caller"),
+		},
+		{
+			name: "target ranked higher",
+			args: args{
+				callerRoles: []model.Role{model.AdminRole, model.UserRole},
+				targetRoles: []model.Role{superRole},
+				roles:       []model.Role{model.UserRole},
+			},
+			err:    sys.NewCommonError(codes.PermissionDenied, "role super cannot be granted by the caller"),
+			loaded: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := authctx.WithClaims(context.Background(), &model.UserClaims{Roles: tt.args.callerRoles, Tenant: tt.args.tenant})
+
+			userRepositoryMock := repositoryMocks.NewUserRepositoryMock(mc)
+			roleRepositoryMock := repositoryMocks.NewRoleRepositoryMock(mc)
+			txManagerMock := txManagerMocks.NewTxManagerMock(mc)
+			if tt.loaded {
+				userRepositoryMock.GetMock.Expect(ctx, id, tt.args.tenant).Return(&model.User{ID: id, Roles: tt.args.targetRoles}, nil)
+				roleRepositoryMock.GetExistingMock.Return([]model.Role{model.UserRole, model.AdminRole, superRole}, nil)
+				txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) error {
+					return f(ctx)
+				})
+			}
+			if tt.revoked {
+				userRepositoryMock.SetRolesMock.Expect(ctx, id, tt.args.roles, tt.args.tenant).Return(nil)
+				userRepositoryMock.RevokeTokensMock.Return(nil)
+			}
+
+			service := user.NewUserService(userRepositoryMock, roleRepositoryMock, txManagerMock)
+
+			err := service.SetRoles(ctx, id, tt.args.roles)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
@@ -3,11 +3,12 @@ package user
 import (
 	"context"
 
+	"github.com/arifullov/auth/internal/authctx"
 	"github.com/arifullov/auth/internal/model"
 )
 
 func (s *serv) Update(ctx context.Context, user *model.UpdateUser) error {
-	if err := s.userRepository.Update(ctx, user); err != nil {
+	if err := s.userRepository.Update(ctx, user, authctx.Tenant(ctx)); err != nil {
 		return err
 	}
 	return nil
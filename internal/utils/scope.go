@@ -0,0 +1,35 @@
+package utils
+
+import (
+	"github.com/arifullov/auth/internal/config"
+	"github.com/arifullov/auth/internal/model"
+	"github.com/arifullov/auth/internal/sys"
+	"github.com/arifullov/auth/internal/sys/codes"
+)
+
+// ResolveClient returns the client a token is requested for, nil for first-party logins.
+// Client ids that are not registered are rejected.
+func ResolveClient(clients config.OAuthClientsConfig, clientID string) (*model.OAuthClient, error) {
+	if clientID == "" {
+		return nil, nil
+	}
+	for _, client := range clients.Clients() {
+		if client.ClientID == clientID {
+			return &model.OAuthClient{ID: clientID, Scopes: client.Scopes}, nil
+		}
+	}
+	return nil, sys.NewCommonError(codes.Unauthenticated, "invalid_client")
+}
+
+// GrantScopes returns what a token is issued for, see model.GrantScopes.
+func GrantScopes(clients config.OAuthClientsConfig, req model.ScopeRequest, permissions []string) (model.TokenGrant, error) {
+	client, err := ResolveClient(clients, req.ClientID)
+	if err != nil {
+		return model.TokenGrant{}, err
+	}
+	scopes, ok := model.GrantScopes(client, req.Scopes, permissions)
+	if !ok {
+		return model.TokenGrant{}, sys.NewCommonError(codes.InvalidArgument, "invalid_scope")
+	}
+	return model.TokenGrant{ClientID: req.ClientID, Scopes: scopes}, nil
+}
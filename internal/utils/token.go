@@ -2,6 +2,7 @@ package utils
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -10,14 +11,45 @@ import (
 	"github.com/arifullov/auth/internal/model"
 )
 
-func GenerateToken(user *model.User, secretKey []byte, duration time.Duration) (string, error) {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, model.UserClaims{
+// GenerateToken issues a refresh token of the user for the tenant, empty outside of organizations.
+// The scopes of the grant are narrowed again whenever an access token is issued from it.
+func GenerateToken(user *model.User, tenant string, grant model.TokenGrant, secretKey []byte, duration time.Duration) (string, error) {
+	now := time.Now()
+	return SignClaims(model.UserClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(duration)),
+			Subject:   strconv.FormatInt(user.ID, 10),
+			ExpiresAt: jwt.NewNumericDate(now.Add(duration)),
+			IssuedAt:  jwt.NewNumericDate(now),
 		},
 		Username: user.Email,
-		Role:     user.Role,
-	})
+		Roles:    user.Roles,
+		Tenant:   tenant,
+		Scope:    grant.Scope(),
+		ClientID: grant.ClientID,
+	}, secretKey)
+}
+
+// GenerateAccessToken issues a token carrying the effective roles and permissions of the user,
+// so resource servers can authorize requests without querying roles.
+func GenerateAccessToken(user *model.User, access *model.UserAccess, grant model.TokenGrant, secretKey []byte, duration time.Duration) (string, error) {
+	now := time.Now()
+	return SignClaims(model.UserClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   strconv.FormatInt(user.ID, 10),
+			ExpiresAt: jwt.NewNumericDate(now.Add(duration)),
+			IssuedAt:  jwt.NewNumericDate(now),
+		},
+		Username:    user.Email,
+		Roles:       access.Roles,
+		Permissions: access.Permissions,
+		Tenant:      access.Tenant,
+		Scope:       grant.Scope(),
+		ClientID:    grant.ClientID,
+	}, secretKey)
+}
+
+func SignClaims(claims model.UserClaims, secretKey []byte) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
 	tokenString, err := token.SignedString(secretKey)
 	if err != nil {
@@ -0,0 +1,22 @@
+-- +goose Up
+create type device_code_status as enum('pending', 'approved', 'denied');
+
+create table device_codes (
+    id serial primary key,
+    device_code_hash text not null,
+    user_code text not null,
+    client_id text not null,
+    status device_code_status not null default 'pending',
+    user_id integer references users (id) on delete cascade,
+    poll_interval integer not null,
+    expires_at timestamptz not null,
+    last_polled_at timestamptz,
+    created_at timestamptz not null default now(),
+    unique (device_code_hash),
+    unique (user_code)
+);
+
+-- +goose Down
+drop table device_codes;
+
+drop type device_code_status;
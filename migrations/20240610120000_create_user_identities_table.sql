@@ -0,0 +1,13 @@
+-- +goose Up
+create table user_identities (
+    id serial primary key,
+    user_id integer not null references users (id) on delete cascade,
+    provider text not null,
+    subject text not null,
+    email text not null,
+    created_at timestamptz not null default now(),
+    unique (provider, subject)
+);
+
+-- +goose Down
+drop table user_identities;
@@ -0,0 +1,13 @@
+-- +goose Up
+create type route_effect as enum ('allow', 'deny');
+
+alter table route_accesses
+    add column method text not null default '*',
+    add column effect route_effect not null default 'allow';
+
+-- +goose Down
+alter table route_accesses
+    drop column effect,
+    drop column method;
+
+drop type route_effect;
@@ -0,0 +1,78 @@
+-- +goose Up
+create table roles (
+    id serial primary key,
+    name text not null,
+    description text not null default '',
+    created_at timestamptz not null default now(),
+    unique (name)
+);
+
+-- A role inherits the permissions and route grants of its parent roles.
+create table role_parents (
+    role_id integer not null references roles (id) on delete cascade,
+    parent_id integer not null references roles (id) on delete cascade,
+    primary key (role_id, parent_id),
+    check (role_id <> parent_id)
+);
+
+create table permissions (
+    id serial primary key,
+    name text not null,
+    description text not null default '',
+    unique (name)
+);
+
+create table role_permissions (
+    role_id integer not null references roles (id) on delete cascade,
+    permission_id integer not null references permissions (id) on delete cascade,
+    primary key (role_id, permission_id)
+);
+
+create table user_roles (
+    user_id integer not null references users (id) on delete cascade,
+    role_id integer not null references roles (id) on delete cascade,
+    primary key (user_id, role_id)
+);
+
+insert into roles (name, description) values
+    ('user', 'Regular user'),
+    ('admin', 'Administrator');
+
+insert into user_roles (user_id, role_id)
+select u.id, r.id
+from users u
+join roles r on r.name = u.role::text;
+
+alter table route_accesses
+    alter column role type text using role::text,
+    add constraint route_accesses_role_fkey foreign key (role) references roles (name) on update cascade on delete cascade;
+
+alter table users drop column role;
+
+drop type user_role;
+
+-- +goose Down
+create type user_role as enum('user', 'admin');
+
+alter table users add column role user_role not null default 'user';
+
+update users u
+set role = 'admin'
+where exists (
+    select 1
+    from user_roles ur
+    join roles r on r.id = ur.role_id
+    where ur.user_id = u.id and r.name = 'admin'
+);
+
+alter table route_accesses drop constraint route_accesses_role_fkey;
+
+delete from route_accesses where role not in ('user', 'admin');
+
+alter table route_accesses alter column role type user_role using role::user_role;
+
+drop table user_roles;
+drop table role_permissions;
+drop table permissions;
+drop table role_parents;
+drop table roles;
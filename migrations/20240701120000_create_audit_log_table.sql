@@ -0,0 +1,16 @@
+-- +goose Up
+create table audit_log (
+    id serial primary key,
+    actor text not null,
+    action text not null,
+    resource_type text not null,
+    resource_id text not null,
+    before jsonb,
+    after jsonb,
+    created_at timestamptz not null default now()
+);
+
+create index audit_log_resource_idx on audit_log (resource_type, resource_id);
+
+-- +goose Down
+drop table audit_log;
@@ -0,0 +1,32 @@
+-- +goose Up
+-- policy_version is bumped on every change of the route policy so replicas can tell
+-- whether their cached policy is current, the new version is sent to policy_changed listeners.
+create table policy_version (
+    id boolean primary key default true check (id),
+    version bigint not null
+);
+
+insert into policy_version (version) values (1);
+
+-- +goose StatementBegin
+create function notify_policy_changed() returns trigger as $$
+declare
+    new_version bigint;
+begin
+    update policy_version set version = version + 1 returning version into new_version;
+    perform pg_notify('policy_changed', new_version::text);
+    return null;
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+
+create trigger route_accesses_policy_changed
+    after insert or update or delete or truncate on route_accesses
+    for each statement execute function notify_policy_changed();
+
+-- +goose Down
+drop trigger route_accesses_policy_changed on route_accesses;
+
+drop function notify_policy_changed();
+
+drop table policy_version;
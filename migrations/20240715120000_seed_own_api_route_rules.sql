@@ -0,0 +1,17 @@
+-- +goose Up
+-- Route rules for the gRPC methods of this service, methods listed in
+-- publicMethods of the app are called without a token and need no rules.
+insert into route_accesses (route, method, role, effect) values
+    ('/user_v1.UserV1/**', '*', 'admin', 'allow'),
+    ('/auth_v1.AuthV1/ApproveDevice', '*', 'user', 'allow'),
+    ('/auth_v1.AuthV1/ApproveDevice', '*', 'admin', 'allow'),
+    ('/access_admin_v1.AccessAdminV1/**', '*', 'admin', 'allow');
+
+-- +goose Down
+delete from route_accesses
+where (route, method, role, effect) in (
+    ('/user_v1.UserV1/**', '*', 'admin', 'allow'),
+    ('/auth_v1.AuthV1/ApproveDevice', '*', 'user', 'allow'),
+    ('/auth_v1.AuthV1/ApproveDevice', '*', 'admin', 'allow'),
+    ('/access_admin_v1.AccessAdminV1/**', '*', 'admin', 'allow')
+);
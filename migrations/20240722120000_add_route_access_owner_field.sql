@@ -0,0 +1,21 @@
+-- +goose Up
+alter table route_accesses add column owner_field text not null default '';
+
+-- Users may read and update their own record, admins any record. Admin rules are
+-- repeated here because the most specific rules decide over /user_v1.UserV1/**.
+insert into route_accesses (route, method, role, effect, owner_field) values
+    ('/user_v1.UserV1/Get', '*', 'user', 'allow', 'id'),
+    ('/user_v1.UserV1/Get', '*', 'admin', 'allow', ''),
+    ('/user_v1.UserV1/Update', '*', 'user', 'allow', 'id'),
+    ('/user_v1.UserV1/Update', '*', 'admin', 'allow', '');
+
+-- +goose Down
+delete from route_accesses
+where (route, method, role, effect, owner_field) in (
+    ('/user_v1.UserV1/Get', '*', 'user', 'allow', 'id'),
+    ('/user_v1.UserV1/Get', '*', 'admin', 'allow', ''),
+    ('/user_v1.UserV1/Update', '*', 'user', 'allow', 'id'),
+    ('/user_v1.UserV1/Update', '*', 'admin', 'allow', '')
+);
+
+alter table route_accesses drop column owner_field;
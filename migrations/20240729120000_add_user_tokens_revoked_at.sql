@@ -0,0 +1,34 @@
+-- +goose Up
+alter table users add column tokens_revoked_at timestamptz;
+
+-- +goose StatementBegin
+create function notify_tokens_revoked() returns trigger as $$
+begin
+    perform pg_notify('tokens_revoked', new.id::text);
+    return null;
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+
+create trigger users_tokens_revoked
+    after update of tokens_revoked_at on users
+    for each row execute function notify_tokens_revoked();
+
+-- Roles can only be granted by holders, admins hold the user role through inheritance.
+insert into role_parents (role_id, parent_id)
+select a.id, u.id
+from roles a, roles u
+where a.name = 'admin' and u.name = 'user'
+on conflict do nothing;
+
+-- +goose Down
+delete from role_parents
+using roles a, roles u
+where role_parents.role_id = a.id and role_parents.parent_id = u.id
+    and a.name = 'admin' and u.name = 'user';
+
+drop trigger users_tokens_revoked on users;
+
+drop function notify_tokens_revoked();
+
+alter table users drop column tokens_revoked_at;
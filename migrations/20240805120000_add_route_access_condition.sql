@@ -0,0 +1,5 @@
+-- +goose Up
+alter table route_accesses add column condition text not null default '';
+
+-- +goose Down
+alter table route_accesses drop column condition;
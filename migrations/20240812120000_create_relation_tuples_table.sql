@@ -0,0 +1,35 @@
+-- +goose Up
+-- Tuples are never updated in place: a write creates rows at a new revision and a delete
+-- stamps them with the revision of the deletion, so reads can be evaluated at any snapshot.
+create table relation_tuples (
+    id bigserial primary key,
+    namespace text not null,
+    object_id text not null,
+    relation text not null,
+    subject_user_id text not null default '',
+    subject_namespace text not null default '',
+    subject_object_id text not null default '',
+    subject_relation text not null default '',
+    created_revision bigint not null,
+    deleted_revision bigint,
+    check (deleted_revision is null or deleted_revision > created_revision)
+);
+
+create unique index relation_tuples_live_key on relation_tuples (
+    namespace, object_id, relation, subject_user_id, subject_namespace, subject_object_id, subject_relation
+) where deleted_revision is null;
+
+create index relation_tuples_subject_idx on relation_tuples (subject_namespace, subject_object_id, subject_relation);
+
+-- relation_revision is bumped by every write, the row lock taken by the bump serializes
+-- writes so revisions become visible in order.
+create table relation_revision (
+    id boolean primary key default true check (id),
+    revision bigint not null
+);
+
+insert into relation_revision (revision) values (0);
+
+-- +goose Down
+drop table relation_revision;
+drop table relation_tuples;
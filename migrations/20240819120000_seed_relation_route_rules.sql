@@ -0,0 +1,14 @@
+-- +goose Up
+-- Relation tuples are managed by admins, users check the relations of their own subject.
+insert into route_accesses (route, method, role, effect, owner_field) values
+    ('/relation_v1.RelationV1/**', '*', 'admin', 'allow', ''),
+    ('/relation_v1.RelationV1/CheckRelation', '*', 'user', 'allow', 'user_id'),
+    ('/relation_v1.RelationV1/CheckRelation', '*', 'admin', 'allow', '');
+
+-- +goose Down
+delete from route_accesses
+where (route, method, role, effect, owner_field) in (
+    ('/relation_v1.RelationV1/**', '*', 'admin', 'allow', ''),
+    ('/relation_v1.RelationV1/CheckRelation', '*', 'user', 'allow', 'user_id'),
+    ('/relation_v1.RelationV1/CheckRelation', '*', 'admin', 'allow', '')
+);
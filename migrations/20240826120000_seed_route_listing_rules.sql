@@ -0,0 +1,15 @@
+-- +goose Up
+-- Users list the routes they may call themselves, listing those of others and the
+-- principals of a route is checked for the admin role by the service.
+insert into route_accesses (route, method, role, effect) values
+    ('/access_v1.AccessV1/ListAccessibleRoutes', '*', 'user', 'allow'),
+    ('/access_v1.AccessV1/ListAccessibleRoutes', '*', 'admin', 'allow'),
+    ('/access_v1.AccessV1/ListPrincipalsForRoute', '*', 'admin', 'allow');
+
+-- +goose Down
+delete from route_accesses
+where (route, method, role, effect) in (
+    ('/access_v1.AccessV1/ListAccessibleRoutes', '*', 'user', 'allow'),
+    ('/access_v1.AccessV1/ListAccessibleRoutes', '*', 'admin', 'allow'),
+    ('/access_v1.AccessV1/ListPrincipalsForRoute', '*', 'admin', 'allow')
+);
@@ -0,0 +1,43 @@
+-- +goose Up
+-- Organizations are the tenants sharing this service. Roles in user_roles apply in every
+-- organization, roles in organization_member_roles only in tokens issued for that organization.
+create table organizations (
+    id serial primary key,
+    slug text not null,
+    name text not null,
+    created_at timestamptz not null default now(),
+    unique (slug)
+);
+
+create table organization_members (
+    organization_id integer not null references organizations (id) on delete cascade,
+    user_id integer not null references users (id) on delete cascade,
+    created_at timestamptz not null default now(),
+    primary key (organization_id, user_id)
+);
+
+create index organization_members_user_id_idx on organization_members (user_id);
+
+create table organization_member_roles (
+    organization_id integer not null,
+    user_id integer not null,
+    role_id integer not null references roles (id) on delete cascade,
+    primary key (organization_id, user_id, role_id),
+    foreign key (organization_id, user_id) references organization_members (organization_id, user_id) on delete cascade
+);
+
+-- Organizations are managed by admins, members list the organizations they belong to.
+insert into route_accesses (route, method, role, effect) values
+    ('/organization_v1.OrganizationV1/**', '*', 'admin', 'allow'),
+    ('/organization_v1.OrganizationV1/ListOrganizations', '*', 'user', 'allow');
+
+-- +goose Down
+delete from route_accesses
+where (route, method, role, effect) in (
+    ('/organization_v1.OrganizationV1/**', '*', 'admin', 'allow'),
+    ('/organization_v1.OrganizationV1/ListOrganizations', '*', 'user', 'allow')
+);
+
+drop table organization_member_roles;
+drop table organization_members;
+drop table organizations;
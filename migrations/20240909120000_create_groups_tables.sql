@@ -0,0 +1,50 @@
+-- +goose Up
+-- Members of a group get the roles bound to the group and to every group it is nested in.
+create table groups (
+    id serial primary key,
+    name text not null,
+    description text not null default '',
+    created_at timestamptz not null default now(),
+    unique (name)
+);
+
+-- A group is nested in its parent groups, inserts refuse to close a cycle.
+create table group_parents (
+    group_id integer not null references groups (id) on delete cascade,
+    parent_id integer not null references groups (id) on delete cascade,
+    primary key (group_id, parent_id),
+    check (group_id <> parent_id)
+);
+
+create table group_members (
+    group_id integer not null references groups (id) on delete cascade,
+    user_id integer not null references users (id) on delete cascade,
+    primary key (group_id, user_id)
+);
+
+create index group_members_user_id_idx on group_members (user_id);
+
+create table group_roles (
+    group_id integer not null references groups (id) on delete cascade,
+    role_id integer not null references roles (id) on delete cascade,
+    primary key (group_id, role_id)
+);
+
+-- Groups are managed by admins, users may list their own effective roles.
+insert into route_accesses (route, method, role, effect, owner_field) values
+    ('/group_v1.GroupV1/**', '*', 'admin', 'allow', ''),
+    ('/group_v1.GroupV1/ListEffectiveRoles', '*', 'user', 'allow', 'user_id'),
+    ('/group_v1.GroupV1/ListEffectiveRoles', '*', 'admin', 'allow', '');
+
+-- +goose Down
+delete from route_accesses
+where (route, method, role, effect, owner_field) in (
+    ('/group_v1.GroupV1/**', '*', 'admin', 'allow', ''),
+    ('/group_v1.GroupV1/ListEffectiveRoles', '*', 'user', 'allow', 'user_id'),
+    ('/group_v1.GroupV1/ListEffectiveRoles', '*', 'admin', 'allow', '')
+);
+
+drop table group_roles;
+drop table group_members;
+drop table group_parents;
+drop table groups;
@@ -0,0 +1,41 @@
+-- +goose Up
+-- Roles granted through approved requests expire, expired grants are removed by a job and
+-- ignored until then.
+alter table user_roles add column expires_at timestamptz;
+
+create index user_roles_expires_at_idx on user_roles (expires_at) where expires_at is not null;
+
+create table role_requests (
+    id bigserial primary key,
+    user_id integer not null references users (id) on delete cascade,
+    role_id integer not null references roles (id) on delete cascade,
+    justification text not null,
+    duration_seconds bigint not null check (duration_seconds > 0),
+    status text not null default 'pending',
+    approver_id integer references users (id) on delete set null,
+    decision_comment text not null default '',
+    created_at timestamptz not null default now(),
+    decided_at timestamptz,
+    expires_at timestamptz
+);
+
+-- A user has at most one pending request per role.
+create unique index role_requests_pending_key on role_requests (user_id, role_id) where status = 'pending';
+
+create index role_requests_expires_at_idx on role_requests (expires_at) where status = 'approved';
+
+-- Everyone may request roles and see their requests, approvals are authorized by the service.
+insert into route_accesses (route, method, role, effect) values
+    ('/role_request_v1.RoleRequestV1/**', '*', 'user', 'allow'),
+    ('/role_request_v1.RoleRequestV1/**', '*', 'admin', 'allow');
+
+-- +goose Down
+delete from route_accesses
+where (route, method, role, effect) in (
+    ('/role_request_v1.RoleRequestV1/**', '*', 'user', 'allow'),
+    ('/role_request_v1.RoleRequestV1/**', '*', 'admin', 'allow')
+);
+
+drop table role_requests;
+
+alter table user_roles drop column expires_at;
@@ -0,0 +1,24 @@
+-- +goose Up
+-- Rules may require scopes in addition to a role or, without a role, instead of it.
+alter table route_accesses
+    add column scopes text[] not null default '{}',
+    alter column role drop not null,
+    add constraint route_accesses_role_or_scopes_check check (role is not null or cardinality(scopes) > 0);
+
+-- A route and method have at most one rule without a role.
+create unique index route_accesses_route_method_any_role_key on route_accesses (route, method) where role is null;
+
+-- Scopes requested by the device, granted when the user approves it.
+alter table device_codes add column scope text not null default '';
+
+-- +goose Down
+alter table device_codes drop column scope;
+
+drop index route_accesses_route_method_any_role_key;
+
+delete from route_accesses where role is null;
+
+alter table route_accesses
+    drop constraint route_accesses_role_or_scopes_check,
+    alter column role set not null,
+    drop column scopes;
@@ -0,0 +1,8 @@
+-- +goose Up
+create table federated_states (
+    id text primary key,
+    expires_at timestamptz not null
+);
+
+-- +goose Down
+drop table federated_states;
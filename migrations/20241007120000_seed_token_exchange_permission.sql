@@ -0,0 +1,8 @@
+-- +goose Up
+-- Service principals are users whose roles grant tokens:exchange, only they act in token exchanges.
+insert into permissions (name, description)
+values ('tokens:exchange', 'Act on behalf of users in token exchanges')
+on conflict (name) do nothing;
+
+-- +goose Down
+delete from permissions where name = 'tokens:exchange';
@@ -0,0 +1,21 @@
+-- +goose Up
+-- A rule is identified by every column deciding over requests, so allow and deny rules and
+-- rules with different owners or conditions may share a route, method and role. Identical
+-- rules are redundant, the oldest one is kept.
+delete from route_accesses a
+using route_accesses b
+where a.id > b.id
+    and a.route = b.route
+    and a.method = b.method
+    and a.role is not distinct from b.role
+    and a.effect = b.effect
+    and a.owner_field = b.owner_field
+    and a.condition = b.condition
+    and a.scopes = b.scopes;
+
+-- Rules without a role are keyed by an empty role, nulls are distinct in unique indexes.
+create unique index route_accesses_rule_key
+    on route_accesses (route, method, coalesce(role, ''), effect, owner_field, condition, scopes);
+
+-- +goose Down
+drop index route_accesses_rule_key;
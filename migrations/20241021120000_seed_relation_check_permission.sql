@@ -0,0 +1,15 @@
+-- +goose Up
+-- Services check relations of any subject with a token granting relations:check.
+insert into permissions (name, description)
+values ('relations:check', 'Check relations of any subject')
+on conflict (name) do nothing;
+
+insert into route_accesses (route, method, role, effect, owner_field, scopes) values
+    ('/relation_v1.RelationV1/CheckRelation', '*', null, 'allow', '', '{relations:check}');
+
+-- +goose Down
+delete from route_accesses
+where (route, method, effect, owner_field, scopes) = ('/relation_v1.RelationV1/CheckRelation', '*', 'allow', '', '{relations:check}')
+    and role is null;
+
+delete from permissions where name = 'relations:check';
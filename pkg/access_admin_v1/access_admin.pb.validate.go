@@ -0,0 +1,1403 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: access_admin.proto
+
+package access_admin_v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on RouteRuleInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RouteRuleInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RouteRuleInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RouteRuleInfoMultiError, or
+// nil if none found.
+func (m *RouteRuleInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RouteRuleInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetRoute()) > 512 {
+		err := RouteRuleInfoValidationError{
+			field:  "Route",
+			reason: "value length must be at most 512 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !strings.HasPrefix(m.GetRoute(), "/") {
+		err := RouteRuleInfoValidationError{
+			field:  "Route",
+			reason: "value does not have prefix \"/\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetMethod()) > 16 {
+		err := RouteRuleInfoValidationError{
+			field:  "Method",
+			reason: "value length must be at most 16 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) > 50 {
+		err := RouteRuleInfoValidationError{
+			field:  "Role",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := RouteEffect_name[int32(m.GetEffect())]; !ok {
+		err := RouteRuleInfoValidationError{
+			field:  "Effect",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOwnerField()) > 128 {
+		err := RouteRuleInfoValidationError{
+			field:  "OwnerField",
+			reason: "value length must be at most 128 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCondition()) > 2048 {
+		err := RouteRuleInfoValidationError{
+			field:  "Condition",
+			reason: "value length must be at most 2048 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetScopes()) > 20 {
+		err := RouteRuleInfoValidationError{
+			field:  "Scopes",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetScopes() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 128 {
+			err := RouteRuleInfoValidationError{
+				field:  fmt.Sprintf("Scopes[%v]", idx),
+				reason: "value length must be between 1 and 128 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RouteRuleInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// RouteRuleInfoMultiError is an error wrapping multiple validation errors
+// returned by RouteRuleInfo.ValidateAll() if the designated constraints
+// aren't met.
+type RouteRuleInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RouteRuleInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RouteRuleInfoMultiError) AllErrors() []error { return m }
+
+// RouteRuleInfoValidationError is the validation error returned by
+// RouteRuleInfo.Validate if the designated constraints aren't met.
+type RouteRuleInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RouteRuleInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RouteRuleInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RouteRuleInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RouteRuleInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RouteRuleInfoValidationError) ErrorName() string { return "RouteRuleInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RouteRuleInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRouteRuleInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RouteRuleInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RouteRuleInfoValidationError{}
+
+// Validate checks the field values on RouteRule with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RouteRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RouteRule with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RouteRuleMultiError, or nil
+// if none found.
+func (m *RouteRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RouteRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RouteRuleValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RouteRuleValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RouteRuleValidationError{
+				field:  "Info",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RouteRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// RouteRuleMultiError is an error wrapping multiple validation errors returned
+// by RouteRule.ValidateAll() if the designated constraints aren't met.
+type RouteRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RouteRuleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RouteRuleMultiError) AllErrors() []error { return m }
+
+// RouteRuleValidationError is the validation error returned by
+// RouteRule.Validate if the designated constraints aren't met.
+type RouteRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RouteRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RouteRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RouteRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RouteRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RouteRuleValidationError) ErrorName() string { return "RouteRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RouteRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRouteRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RouteRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RouteRuleValidationError{}
+
+// Validate checks the field values on ListRouteRulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRouteRulesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRouteRulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRouteRulesRequestMultiError, or nil if none found.
+func (m *ListRouteRulesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRouteRulesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetPageSize(); val < 0 || val > 500 {
+		err := ListRouteRulesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 500]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	// no validation rules for RoutePrefix
+
+	// no validation rules for Role
+
+	if len(errors) > 0 {
+		return ListRouteRulesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRouteRulesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListRouteRulesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListRouteRulesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRouteRulesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRouteRulesRequestMultiError) AllErrors() []error { return m }
+
+// ListRouteRulesRequestValidationError is the validation error returned by
+// ListRouteRulesRequest.Validate if the designated constraints aren't met.
+type ListRouteRulesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRouteRulesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRouteRulesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRouteRulesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRouteRulesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRouteRulesRequestValidationError) ErrorName() string {
+	return "ListRouteRulesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRouteRulesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRouteRulesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRouteRulesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRouteRulesRequestValidationError{}
+
+// Validate checks the field values on ListRouteRulesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRouteRulesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRouteRulesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRouteRulesResponseMultiError, or nil if none found.
+func (m *ListRouteRulesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRouteRulesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRouteRulesResponseValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRouteRulesResponseValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRouteRulesResponseValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListRouteRulesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRouteRulesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListRouteRulesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListRouteRulesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRouteRulesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRouteRulesResponseMultiError) AllErrors() []error { return m }
+
+// ListRouteRulesResponseValidationError is the validation error returned by
+// ListRouteRulesResponse.Validate if the designated constraints aren't met.
+type ListRouteRulesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRouteRulesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRouteRulesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRouteRulesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRouteRulesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRouteRulesResponseValidationError) ErrorName() string {
+	return "ListRouteRulesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRouteRulesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRouteRulesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRouteRulesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRouteRulesResponseValidationError{}
+
+// Validate checks the field values on GetRouteRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRouteRuleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRouteRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRouteRuleRequestMultiError, or nil if none found.
+func (m *GetRouteRuleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRouteRuleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() < 1 {
+		err := GetRouteRuleRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetRouteRuleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRouteRuleRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRouteRuleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetRouteRuleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRouteRuleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRouteRuleRequestMultiError) AllErrors() []error { return m }
+
+// GetRouteRuleRequestValidationError is the validation error returned by
+// GetRouteRuleRequest.Validate if the designated constraints aren't met.
+type GetRouteRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRouteRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRouteRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRouteRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRouteRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRouteRuleRequestValidationError) ErrorName() string {
+	return "GetRouteRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRouteRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRouteRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRouteRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRouteRuleRequestValidationError{}
+
+// Validate checks the field values on GrantRouteRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GrantRouteRuleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GrantRouteRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GrantRouteRuleRequestMultiError, or nil if none found.
+func (m *GrantRouteRuleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GrantRouteRuleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetInfo() == nil {
+		err := GrantRouteRuleRequestValidationError{
+			field:  "Info",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GrantRouteRuleRequestValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GrantRouteRuleRequestValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GrantRouteRuleRequestValidationError{
+				field:  "Info",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GrantRouteRuleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GrantRouteRuleRequestMultiError is an error wrapping multiple validation
+// errors returned by GrantRouteRuleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GrantRouteRuleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GrantRouteRuleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GrantRouteRuleRequestMultiError) AllErrors() []error { return m }
+
+// GrantRouteRuleRequestValidationError is the validation error returned by
+// GrantRouteRuleRequest.Validate if the designated constraints aren't met.
+type GrantRouteRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GrantRouteRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GrantRouteRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GrantRouteRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GrantRouteRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GrantRouteRuleRequestValidationError) ErrorName() string {
+	return "GrantRouteRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GrantRouteRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGrantRouteRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GrantRouteRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GrantRouteRuleRequestValidationError{}
+
+// Validate checks the field values on UpdateRouteRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateRouteRuleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRouteRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateRouteRuleRequestMultiError, or nil if none found.
+func (m *UpdateRouteRuleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRouteRuleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() < 1 {
+		err := UpdateRouteRuleRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetInfo() == nil {
+		err := UpdateRouteRuleRequestValidationError{
+			field:  "Info",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateRouteRuleRequestValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateRouteRuleRequestValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRouteRuleRequestValidationError{
+				field:  "Info",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateRouteRuleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateRouteRuleRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateRouteRuleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateRouteRuleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRouteRuleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRouteRuleRequestMultiError) AllErrors() []error { return m }
+
+// UpdateRouteRuleRequestValidationError is the validation error returned by
+// UpdateRouteRuleRequest.Validate if the designated constraints aren't met.
+type UpdateRouteRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRouteRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRouteRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRouteRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRouteRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRouteRuleRequestValidationError) ErrorName() string {
+	return "UpdateRouteRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRouteRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRouteRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRouteRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRouteRuleRequestValidationError{}
+
+// Validate checks the field values on RevokeRouteRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeRouteRuleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeRouteRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeRouteRuleRequestMultiError, or nil if none found.
+func (m *RevokeRouteRuleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeRouteRuleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() < 1 {
+		err := RevokeRouteRuleRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RevokeRouteRuleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeRouteRuleRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeRouteRuleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeRouteRuleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeRouteRuleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeRouteRuleRequestMultiError) AllErrors() []error { return m }
+
+// RevokeRouteRuleRequestValidationError is the validation error returned by
+// RevokeRouteRuleRequest.Validate if the designated constraints aren't met.
+type RevokeRouteRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeRouteRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeRouteRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeRouteRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeRouteRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeRouteRuleRequestValidationError) ErrorName() string {
+	return "RevokeRouteRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeRouteRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeRouteRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeRouteRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeRouteRuleRequestValidationError{}
+
+// Validate checks the field values on ReplaceRouteRulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplaceRouteRulesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplaceRouteRulesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplaceRouteRulesRequestMultiError, or nil if none found.
+func (m *ReplaceRouteRulesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplaceRouteRulesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetRules()) > 10000 {
+		err := ReplaceRouteRulesRequestValidationError{
+			field:  "Rules",
+			reason: "value must contain no more than 10000 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReplaceRouteRulesRequestValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReplaceRouteRulesRequestValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReplaceRouteRulesRequestValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReplaceRouteRulesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplaceRouteRulesRequestMultiError is an error wrapping multiple validation
+// errors returned by ReplaceRouteRulesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReplaceRouteRulesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplaceRouteRulesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplaceRouteRulesRequestMultiError) AllErrors() []error { return m }
+
+// ReplaceRouteRulesRequestValidationError is the validation error returned by
+// ReplaceRouteRulesRequest.Validate if the designated constraints aren't met.
+type ReplaceRouteRulesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplaceRouteRulesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplaceRouteRulesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplaceRouteRulesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplaceRouteRulesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplaceRouteRulesRequestValidationError) ErrorName() string {
+	return "ReplaceRouteRulesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplaceRouteRulesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplaceRouteRulesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplaceRouteRulesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplaceRouteRulesRequestValidationError{}
+
+// Validate checks the field values on ReplaceRouteRulesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplaceRouteRulesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplaceRouteRulesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplaceRouteRulesResponseMultiError, or nil if none found.
+func (m *ReplaceRouteRulesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplaceRouteRulesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Created
+
+	// no validation rules for Updated
+
+	// no validation rules for Deleted
+
+	if len(errors) > 0 {
+		return ReplaceRouteRulesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplaceRouteRulesResponseMultiError is an error wrapping multiple validation
+// errors returned by ReplaceRouteRulesResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ReplaceRouteRulesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplaceRouteRulesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplaceRouteRulesResponseMultiError) AllErrors() []error { return m }
+
+// ReplaceRouteRulesResponseValidationError is the validation error returned by
+// ReplaceRouteRulesResponse.Validate if the designated constraints aren't met.
+type ReplaceRouteRulesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplaceRouteRulesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplaceRouteRulesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplaceRouteRulesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplaceRouteRulesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplaceRouteRulesResponseValidationError) ErrorName() string {
+	return "ReplaceRouteRulesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplaceRouteRulesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplaceRouteRulesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplaceRouteRulesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplaceRouteRulesResponseValidationError{}
@@ -0,0 +1,297 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.1
+// source: access_admin.proto
+
+package access_admin_v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	AccessAdminV1_ListRouteRules_FullMethodName    = "/access_admin_v1.AccessAdminV1/ListRouteRules"
+	AccessAdminV1_GetRouteRule_FullMethodName      = "/access_admin_v1.AccessAdminV1/GetRouteRule"
+	AccessAdminV1_GrantRouteRule_FullMethodName    = "/access_admin_v1.AccessAdminV1/GrantRouteRule"
+	AccessAdminV1_UpdateRouteRule_FullMethodName   = "/access_admin_v1.AccessAdminV1/UpdateRouteRule"
+	AccessAdminV1_RevokeRouteRule_FullMethodName   = "/access_admin_v1.AccessAdminV1/RevokeRouteRule"
+	AccessAdminV1_ReplaceRouteRules_FullMethodName = "/access_admin_v1.AccessAdminV1/ReplaceRouteRules"
+)
+
+// AccessAdminV1Client is the client API for AccessAdminV1 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AccessAdminV1Client interface {
+	ListRouteRules(ctx context.Context, in *ListRouteRulesRequest, opts ...grpc.CallOption) (*ListRouteRulesResponse, error)
+	GetRouteRule(ctx context.Context, in *GetRouteRuleRequest, opts ...grpc.CallOption) (*RouteRule, error)
+	GrantRouteRule(ctx context.Context, in *GrantRouteRuleRequest, opts ...grpc.CallOption) (*RouteRule, error)
+	UpdateRouteRule(ctx context.Context, in *UpdateRouteRuleRequest, opts ...grpc.CallOption) (*RouteRule, error)
+	RevokeRouteRule(ctx context.Context, in *RevokeRouteRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ReplaceRouteRules replaces all route rules with the given set in one transaction.
+	ReplaceRouteRules(ctx context.Context, in *ReplaceRouteRulesRequest, opts ...grpc.CallOption) (*ReplaceRouteRulesResponse, error)
+}
+
+type accessAdminV1Client struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAccessAdminV1Client(cc grpc.ClientConnInterface) AccessAdminV1Client {
+	return &accessAdminV1Client{cc}
+}
+
+func (c *accessAdminV1Client) ListRouteRules(ctx context.Context, in *ListRouteRulesRequest, opts ...grpc.CallOption) (*ListRouteRulesResponse, error) {
+	out := new(ListRouteRulesResponse)
+	err := c.cc.Invoke(ctx, AccessAdminV1_ListRouteRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessAdminV1Client) GetRouteRule(ctx context.Context, in *GetRouteRuleRequest, opts ...grpc.CallOption) (*RouteRule, error) {
+	out := new(RouteRule)
+	err := c.cc.Invoke(ctx, AccessAdminV1_GetRouteRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessAdminV1Client) GrantRouteRule(ctx context.Context, in *GrantRouteRuleRequest, opts ...grpc.CallOption) (*RouteRule, error) {
+	out := new(RouteRule)
+	err := c.cc.Invoke(ctx, AccessAdminV1_GrantRouteRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessAdminV1Client) UpdateRouteRule(ctx context.Context, in *UpdateRouteRuleRequest, opts ...grpc.CallOption) (*RouteRule, error) {
+	out := new(RouteRule)
+	err := c.cc.Invoke(ctx, AccessAdminV1_UpdateRouteRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessAdminV1Client) RevokeRouteRule(ctx context.Context, in *RevokeRouteRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AccessAdminV1_RevokeRouteRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessAdminV1Client) ReplaceRouteRules(ctx context.Context, in *ReplaceRouteRulesRequest, opts ...grpc.CallOption) (*ReplaceRouteRulesResponse, error) {
+	out := new(ReplaceRouteRulesResponse)
+	err := c.cc.Invoke(ctx, AccessAdminV1_ReplaceRouteRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AccessAdminV1Server is the server API for AccessAdminV1 service.
+// All implementations must embed UnimplementedAccessAdminV1Server
+// for forward compatibility
+type AccessAdminV1Server interface {
+	ListRouteRules(context.Context, *ListRouteRulesRequest) (*ListRouteRulesResponse, error)
+	GetRouteRule(context.Context, *GetRouteRuleRequest) (*RouteRule, error)
+	GrantRouteRule(context.Context, *GrantRouteRuleRequest) (*RouteRule, error)
+	UpdateRouteRule(context.Context, *UpdateRouteRuleRequest) (*RouteRule, error)
+	RevokeRouteRule(context.Context, *RevokeRouteRuleRequest) (*emptypb.Empty, error)
+	// ReplaceRouteRules replaces all route rules with the given set in one transaction.
+	ReplaceRouteRules(context.Context, *ReplaceRouteRulesRequest) (*ReplaceRouteRulesResponse, error)
+	mustEmbedUnimplementedAccessAdminV1Server()
+}
+
+// UnimplementedAccessAdminV1Server must be embedded to have forward compatible implementations.
+type UnimplementedAccessAdminV1Server struct {
+}
+
+func (UnimplementedAccessAdminV1Server) ListRouteRules(context.Context, *ListRouteRulesRequest) (*ListRouteRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRouteRules not implemented")
+}
+func (UnimplementedAccessAdminV1Server) GetRouteRule(context.Context, *GetRouteRuleRequest) (*RouteRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRouteRule not implemented")
+}
+func (UnimplementedAccessAdminV1Server) GrantRouteRule(context.Context, *GrantRouteRuleRequest) (*RouteRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantRouteRule not implemented")
+}
+func (UnimplementedAccessAdminV1Server) UpdateRouteRule(context.Context, *UpdateRouteRuleRequest) (*RouteRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRouteRule not implemented")
+}
+func (UnimplementedAccessAdminV1Server) RevokeRouteRule(context.Context, *RevokeRouteRuleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRouteRule not implemented")
+}
+func (UnimplementedAccessAdminV1Server) ReplaceRouteRules(context.Context, *ReplaceRouteRulesRequest) (*ReplaceRouteRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceRouteRules not implemented")
+}
+func (UnimplementedAccessAdminV1Server) mustEmbedUnimplementedAccessAdminV1Server() {}
+
+// UnsafeAccessAdminV1Server may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AccessAdminV1Server will
+// result in compilation errors.
+type UnsafeAccessAdminV1Server interface {
+	mustEmbedUnimplementedAccessAdminV1Server()
+}
+
+func RegisterAccessAdminV1Server(s grpc.ServiceRegistrar, srv AccessAdminV1Server) {
+	s.RegisterService(&AccessAdminV1_ServiceDesc, srv)
+}
+
+func _AccessAdminV1_ListRouteRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRouteRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessAdminV1Server).ListRouteRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessAdminV1_ListRouteRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessAdminV1Server).ListRouteRules(ctx, req.(*ListRouteRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessAdminV1_GetRouteRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRouteRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessAdminV1Server).GetRouteRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessAdminV1_GetRouteRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessAdminV1Server).GetRouteRule(ctx, req.(*GetRouteRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessAdminV1_GrantRouteRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantRouteRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessAdminV1Server).GrantRouteRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessAdminV1_GrantRouteRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessAdminV1Server).GrantRouteRule(ctx, req.(*GrantRouteRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessAdminV1_UpdateRouteRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRouteRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessAdminV1Server).UpdateRouteRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessAdminV1_UpdateRouteRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessAdminV1Server).UpdateRouteRule(ctx, req.(*UpdateRouteRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessAdminV1_RevokeRouteRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeRouteRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessAdminV1Server).RevokeRouteRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessAdminV1_RevokeRouteRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessAdminV1Server).RevokeRouteRule(ctx, req.(*RevokeRouteRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessAdminV1_ReplaceRouteRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceRouteRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessAdminV1Server).ReplaceRouteRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessAdminV1_ReplaceRouteRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessAdminV1Server).ReplaceRouteRules(ctx, req.(*ReplaceRouteRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AccessAdminV1_ServiceDesc is the grpc.ServiceDesc for AccessAdminV1 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AccessAdminV1_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "access_admin_v1.AccessAdminV1",
+	HandlerType: (*AccessAdminV1Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListRouteRules",
+			Handler:    _AccessAdminV1_ListRouteRules_Handler,
+		},
+		{
+			MethodName: "GetRouteRule",
+			Handler:    _AccessAdminV1_GetRouteRule_Handler,
+		},
+		{
+			MethodName: "GrantRouteRule",
+			Handler:    _AccessAdminV1_GrantRouteRule_Handler,
+		},
+		{
+			MethodName: "UpdateRouteRule",
+			Handler:    _AccessAdminV1_UpdateRouteRule_Handler,
+		},
+		{
+			MethodName: "RevokeRouteRule",
+			Handler:    _AccessAdminV1_RevokeRouteRule_Handler,
+		},
+		{
+			MethodName: "ReplaceRouteRules",
+			Handler:    _AccessAdminV1_ReplaceRouteRules_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "access_admin.proto",
+}
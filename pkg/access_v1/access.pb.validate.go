@@ -0,0 +1,1775 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: access.proto
+
+package access_v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CheckRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CheckRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CheckRequestMultiError, or
+// nil if none found.
+func (m *CheckRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EndpointAddress
+
+	// no validation rules for Audience
+
+	// no validation rules for Method
+
+	// no validation rules for Fields
+
+	// no validation rules for Ip
+
+	// no validation rules for Headers
+
+	// no validation rules for Tenant
+
+	if len(errors) > 0 {
+		return CheckRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckRequestMultiError is an error wrapping multiple validation errors
+// returned by CheckRequest.ValidateAll() if the designated constraints aren't met.
+type CheckRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckRequestMultiError) AllErrors() []error { return m }
+
+// CheckRequestValidationError is the validation error returned by
+// CheckRequest.Validate if the designated constraints aren't met.
+type CheckRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckRequestValidationError) ErrorName() string { return "CheckRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CheckRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckRequestValidationError{}
+
+// Validate checks the field values on BatchCheckRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BatchCheckRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchCheckRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchCheckRequestMultiError, or nil if none found.
+func (m *BatchCheckRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchCheckRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetChecks()); l < 1 || l > 100 {
+		err := BatchCheckRequestValidationError{
+			field:  "Checks",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetChecks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchCheckRequestValidationError{
+						field:  fmt.Sprintf("Checks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchCheckRequestValidationError{
+						field:  fmt.Sprintf("Checks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchCheckRequestValidationError{
+					field:  fmt.Sprintf("Checks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Explain
+
+	if len(errors) > 0 {
+		return BatchCheckRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchCheckRequestMultiError is an error wrapping multiple validation errors
+// returned by BatchCheckRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BatchCheckRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchCheckRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchCheckRequestMultiError) AllErrors() []error { return m }
+
+// BatchCheckRequestValidationError is the validation error returned by
+// BatchCheckRequest.Validate if the designated constraints aren't met.
+type BatchCheckRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchCheckRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchCheckRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchCheckRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchCheckRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchCheckRequestValidationError) ErrorName() string {
+	return "BatchCheckRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchCheckRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchCheckRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchCheckRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchCheckRequestValidationError{}
+
+// Validate checks the field values on MatchedRule with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MatchedRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MatchedRule with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MatchedRuleMultiError, or
+// nil if none found.
+func (m *MatchedRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MatchedRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Route
+
+	// no validation rules for Method
+
+	// no validation rules for Role
+
+	// no validation rules for Effect
+
+	if len(errors) > 0 {
+		return MatchedRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// MatchedRuleMultiError is an error wrapping multiple validation errors
+// returned by MatchedRule.ValidateAll() if the designated constraints aren't met.
+type MatchedRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MatchedRuleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MatchedRuleMultiError) AllErrors() []error { return m }
+
+// MatchedRuleValidationError is the validation error returned by
+// MatchedRule.Validate if the designated constraints aren't met.
+type MatchedRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MatchedRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MatchedRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MatchedRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MatchedRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MatchedRuleValidationError) ErrorName() string { return "MatchedRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MatchedRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMatchedRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MatchedRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MatchedRuleValidationError{}
+
+// Validate checks the field values on Explanation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Explanation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Explanation with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExplanationMultiError, or
+// nil if none found.
+func (m *Explanation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Explanation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExplanationValidationError{
+					field:  "Rule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExplanationValidationError{
+					field:  "Rule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExplanationValidationError{
+				field:  "Rule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return ExplanationMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExplanationMultiError is an error wrapping multiple validation errors
+// returned by Explanation.ValidateAll() if the designated constraints aren't met.
+type ExplanationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExplanationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExplanationMultiError) AllErrors() []error { return m }
+
+// ExplanationValidationError is the validation error returned by
+// Explanation.Validate if the designated constraints aren't met.
+type ExplanationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExplanationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExplanationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExplanationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExplanationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExplanationValidationError) ErrorName() string { return "ExplanationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExplanationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExplanation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExplanationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExplanationValidationError{}
+
+// Validate checks the field values on CheckResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CheckResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CheckResultMultiError, or
+// nil if none found.
+func (m *CheckResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Allowed
+
+	if all {
+		switch v := interface{}(m.GetExplanation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CheckResultValidationError{
+					field:  "Explanation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CheckResultValidationError{
+					field:  "Explanation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExplanation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CheckResultValidationError{
+				field:  "Explanation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CheckResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckResultMultiError is an error wrapping multiple validation errors
+// returned by CheckResult.ValidateAll() if the designated constraints aren't met.
+type CheckResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckResultMultiError) AllErrors() []error { return m }
+
+// CheckResultValidationError is the validation error returned by
+// CheckResult.Validate if the designated constraints aren't met.
+type CheckResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckResultValidationError) ErrorName() string { return "CheckResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CheckResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckResultValidationError{}
+
+// Validate checks the field values on BatchCheckResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchCheckResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchCheckResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchCheckResponseMultiError, or nil if none found.
+func (m *BatchCheckResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchCheckResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchCheckResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchCheckResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchCheckResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchCheckResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchCheckResponseMultiError is an error wrapping multiple validation errors
+// returned by BatchCheckResponse.ValidateAll() if the designated constraints
+// aren't met.
+type BatchCheckResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchCheckResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchCheckResponseMultiError) AllErrors() []error { return m }
+
+// BatchCheckResponseValidationError is the validation error returned by
+// BatchCheckResponse.Validate if the designated constraints aren't met.
+type BatchCheckResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchCheckResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchCheckResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchCheckResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchCheckResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchCheckResponseValidationError) ErrorName() string {
+	return "BatchCheckResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchCheckResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchCheckResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchCheckResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchCheckResponseValidationError{}
+
+// Validate checks the field values on RouteGrant with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RouteGrant) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RouteGrant with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RouteGrantMultiError, or
+// nil if none found.
+func (m *RouteGrant) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RouteGrant) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Route
+
+	// no validation rules for Method
+
+	// no validation rules for RuleId
+
+	// no validation rules for Role
+
+	// no validation rules for Conditional
+
+	if len(errors) > 0 {
+		return RouteGrantMultiError(errors)
+	}
+
+	return nil
+}
+
+// RouteGrantMultiError is an error wrapping multiple validation errors
+// returned by RouteGrant.ValidateAll() if the designated constraints aren't met.
+type RouteGrantMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RouteGrantMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RouteGrantMultiError) AllErrors() []error { return m }
+
+// RouteGrantValidationError is the validation error returned by
+// RouteGrant.Validate if the designated constraints aren't met.
+type RouteGrantValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RouteGrantValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RouteGrantValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RouteGrantValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RouteGrantValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RouteGrantValidationError) ErrorName() string { return "RouteGrantValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RouteGrantValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRouteGrant.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RouteGrantValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RouteGrantValidationError{}
+
+// Validate checks the field values on ListAccessibleRoutesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAccessibleRoutesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAccessibleRoutesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAccessibleRoutesRequestMultiError, or nil if none found.
+func (m *ListAccessibleRoutesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAccessibleRoutesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() < 0 {
+		err := ListAccessibleRoutesRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRoutePrefix()) > 512 {
+		err := ListAccessibleRoutesRequestValidationError{
+			field:  "RoutePrefix",
+			reason: "value length must be at most 512 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 500 {
+		err := ListAccessibleRoutesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 500]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListAccessibleRoutesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAccessibleRoutesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListAccessibleRoutesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListAccessibleRoutesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAccessibleRoutesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAccessibleRoutesRequestMultiError) AllErrors() []error { return m }
+
+// ListAccessibleRoutesRequestValidationError is the validation error returned
+// by ListAccessibleRoutesRequest.Validate if the designated constraints
+// aren't met.
+type ListAccessibleRoutesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAccessibleRoutesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAccessibleRoutesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAccessibleRoutesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAccessibleRoutesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAccessibleRoutesRequestValidationError) ErrorName() string {
+	return "ListAccessibleRoutesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAccessibleRoutesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAccessibleRoutesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAccessibleRoutesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAccessibleRoutesRequestValidationError{}
+
+// Validate checks the field values on ListAccessibleRoutesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAccessibleRoutesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAccessibleRoutesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAccessibleRoutesResponseMultiError, or nil if none found.
+func (m *ListAccessibleRoutesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAccessibleRoutesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRoutes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAccessibleRoutesResponseValidationError{
+						field:  fmt.Sprintf("Routes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAccessibleRoutesResponseValidationError{
+						field:  fmt.Sprintf("Routes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAccessibleRoutesResponseValidationError{
+					field:  fmt.Sprintf("Routes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListAccessibleRoutesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAccessibleRoutesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListAccessibleRoutesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListAccessibleRoutesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAccessibleRoutesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAccessibleRoutesResponseMultiError) AllErrors() []error { return m }
+
+// ListAccessibleRoutesResponseValidationError is the validation error returned
+// by ListAccessibleRoutesResponse.Validate if the designated constraints
+// aren't met.
+type ListAccessibleRoutesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAccessibleRoutesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAccessibleRoutesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAccessibleRoutesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAccessibleRoutesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAccessibleRoutesResponseValidationError) ErrorName() string {
+	return "ListAccessibleRoutesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAccessibleRoutesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAccessibleRoutesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAccessibleRoutesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAccessibleRoutesResponseValidationError{}
+
+// Validate checks the field values on ListPrincipalsForRouteRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPrincipalsForRouteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPrincipalsForRouteRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListPrincipalsForRouteRequestMultiError, or nil if none found.
+func (m *ListPrincipalsForRouteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPrincipalsForRouteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetRoute()) > 512 {
+		err := ListPrincipalsForRouteRequestValidationError{
+			field:  "Route",
+			reason: "value length must be at most 512 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !strings.HasPrefix(m.GetRoute(), "/") {
+		err := ListPrincipalsForRouteRequestValidationError{
+			field:  "Route",
+			reason: "value does not have prefix \"/\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetMethod()) > 16 {
+		err := ListPrincipalsForRouteRequestValidationError{
+			field:  "Method",
+			reason: "value length must be at most 16 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 500 {
+		err := ListPrincipalsForRouteRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 500]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListPrincipalsForRouteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPrincipalsForRouteRequestMultiError is an error wrapping multiple
+// validation errors returned by ListPrincipalsForRouteRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListPrincipalsForRouteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPrincipalsForRouteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPrincipalsForRouteRequestMultiError) AllErrors() []error { return m }
+
+// ListPrincipalsForRouteRequestValidationError is the validation error
+// returned by ListPrincipalsForRouteRequest.Validate if the designated
+// constraints aren't met.
+type ListPrincipalsForRouteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPrincipalsForRouteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPrincipalsForRouteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPrincipalsForRouteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPrincipalsForRouteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPrincipalsForRouteRequestValidationError) ErrorName() string {
+	return "ListPrincipalsForRouteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPrincipalsForRouteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPrincipalsForRouteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPrincipalsForRouteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPrincipalsForRouteRequestValidationError{}
+
+// Validate checks the field values on RolePrincipal with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RolePrincipal) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RolePrincipal with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RolePrincipalMultiError, or
+// nil if none found.
+func (m *RolePrincipal) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RolePrincipal) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Role
+
+	if all {
+		switch v := interface{}(m.GetGrant()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RolePrincipalValidationError{
+					field:  "Grant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RolePrincipalValidationError{
+					field:  "Grant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGrant()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RolePrincipalValidationError{
+				field:  "Grant",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RolePrincipalMultiError(errors)
+	}
+
+	return nil
+}
+
+// RolePrincipalMultiError is an error wrapping multiple validation errors
+// returned by RolePrincipal.ValidateAll() if the designated constraints
+// aren't met.
+type RolePrincipalMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RolePrincipalMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RolePrincipalMultiError) AllErrors() []error { return m }
+
+// RolePrincipalValidationError is the validation error returned by
+// RolePrincipal.Validate if the designated constraints aren't met.
+type RolePrincipalValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RolePrincipalValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RolePrincipalValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RolePrincipalValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RolePrincipalValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RolePrincipalValidationError) ErrorName() string { return "RolePrincipalValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RolePrincipalValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRolePrincipal.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RolePrincipalValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RolePrincipalValidationError{}
+
+// Validate checks the field values on UserPrincipal with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserPrincipal) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserPrincipal with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserPrincipalMultiError, or
+// nil if none found.
+func (m *UserPrincipal) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserPrincipal) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetGrant()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserPrincipalValidationError{
+					field:  "Grant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserPrincipalValidationError{
+					field:  "Grant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGrant()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserPrincipalValidationError{
+				field:  "Grant",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserPrincipalMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserPrincipalMultiError is an error wrapping multiple validation errors
+// returned by UserPrincipal.ValidateAll() if the designated constraints
+// aren't met.
+type UserPrincipalMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserPrincipalMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserPrincipalMultiError) AllErrors() []error { return m }
+
+// UserPrincipalValidationError is the validation error returned by
+// UserPrincipal.Validate if the designated constraints aren't met.
+type UserPrincipalValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserPrincipalValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserPrincipalValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserPrincipalValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserPrincipalValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserPrincipalValidationError) ErrorName() string { return "UserPrincipalValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserPrincipalValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserPrincipal.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserPrincipalValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserPrincipalValidationError{}
+
+// Validate checks the field values on ListPrincipalsForRouteResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPrincipalsForRouteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPrincipalsForRouteResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListPrincipalsForRouteResponseMultiError, or nil if none found.
+func (m *ListPrincipalsForRouteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPrincipalsForRouteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRoles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPrincipalsForRouteResponseValidationError{
+						field:  fmt.Sprintf("Roles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPrincipalsForRouteResponseValidationError{
+						field:  fmt.Sprintf("Roles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPrincipalsForRouteResponseValidationError{
+					field:  fmt.Sprintf("Roles[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPrincipalsForRouteResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPrincipalsForRouteResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPrincipalsForRouteResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListPrincipalsForRouteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPrincipalsForRouteResponseMultiError is an error wrapping multiple
+// validation errors returned by ListPrincipalsForRouteResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListPrincipalsForRouteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPrincipalsForRouteResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPrincipalsForRouteResponseMultiError) AllErrors() []error { return m }
+
+// ListPrincipalsForRouteResponseValidationError is the validation error
+// returned by ListPrincipalsForRouteResponse.Validate if the designated
+// constraints aren't met.
+type ListPrincipalsForRouteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPrincipalsForRouteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPrincipalsForRouteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPrincipalsForRouteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPrincipalsForRouteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPrincipalsForRouteResponseValidationError) ErrorName() string {
+	return "ListPrincipalsForRouteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPrincipalsForRouteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPrincipalsForRouteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPrincipalsForRouteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPrincipalsForRouteResponseValidationError{}
@@ -20,14 +20,28 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	AccessV1_Check_FullMethodName = "/access_v1.AccessV1/Check"
+	AccessV1_Check_FullMethodName                  = "/access_v1.AccessV1/Check"
+	AccessV1_BatchCheck_FullMethodName             = "/access_v1.AccessV1/BatchCheck"
+	AccessV1_ListAccessibleRoutes_FullMethodName   = "/access_v1.AccessV1/ListAccessibleRoutes"
+	AccessV1_ListPrincipalsForRoute_FullMethodName = "/access_v1.AccessV1/ListPrincipalsForRoute"
 )
 
 // AccessV1Client is the client API for AccessV1 service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AccessV1Client interface {
+	// Check fails with PERMISSION_DENIED when the call is not allowed. When only scopes are
+	// missing the message is the WWW-Authenticate challenge to send to the client, e.g.
+	// Bearer error="insufficient_scope", scope="orders:write".
 	Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// BatchCheck authorizes several calls for the owner of one token, e.g. every endpoint
+	// a page links to. Denials are reported per check instead of failing the call.
+	BatchCheck(ctx context.Context, in *BatchCheckRequest, opts ...grpc.CallOption) (*BatchCheckResponse, error)
+	// ListAccessibleRoutes returns the route patterns of the policy a user may call, e.g. to
+	// build menus. Listing the routes of another user requires the admin role.
+	ListAccessibleRoutes(ctx context.Context, in *ListAccessibleRoutesRequest, opts ...grpc.CallOption) (*ListAccessibleRoutesResponse, error)
+	// ListPrincipalsForRoute returns the roles and the users that may call a route, it requires the admin role.
+	ListPrincipalsForRoute(ctx context.Context, in *ListPrincipalsForRouteRequest, opts ...grpc.CallOption) (*ListPrincipalsForRouteResponse, error)
 }
 
 type accessV1Client struct {
@@ -47,11 +61,49 @@ func (c *accessV1Client) Check(ctx context.Context, in *CheckRequest, opts ...gr
 	return out, nil
 }
 
+func (c *accessV1Client) BatchCheck(ctx context.Context, in *BatchCheckRequest, opts ...grpc.CallOption) (*BatchCheckResponse, error) {
+	out := new(BatchCheckResponse)
+	err := c.cc.Invoke(ctx, AccessV1_BatchCheck_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessV1Client) ListAccessibleRoutes(ctx context.Context, in *ListAccessibleRoutesRequest, opts ...grpc.CallOption) (*ListAccessibleRoutesResponse, error) {
+	out := new(ListAccessibleRoutesResponse)
+	err := c.cc.Invoke(ctx, AccessV1_ListAccessibleRoutes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessV1Client) ListPrincipalsForRoute(ctx context.Context, in *ListPrincipalsForRouteRequest, opts ...grpc.CallOption) (*ListPrincipalsForRouteResponse, error) {
+	out := new(ListPrincipalsForRouteResponse)
+	err := c.cc.Invoke(ctx, AccessV1_ListPrincipalsForRoute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccessV1Server is the server API for AccessV1 service.
 // All implementations must embed UnimplementedAccessV1Server
 // for forward compatibility
 type AccessV1Server interface {
+	// Check fails with PERMISSION_DENIED when the call is not allowed. When only scopes are
+	// missing the message is the WWW-Authenticate challenge to send to the client, e.g.
+	// Bearer error="insufficient_scope", scope="orders:write".
 	Check(context.Context, *CheckRequest) (*emptypb.Empty, error)
+	// BatchCheck authorizes several calls for the owner of one token, e.g. every endpoint
+	// a page links to. Denials are reported per check instead of failing the call.
+	BatchCheck(context.Context, *BatchCheckRequest) (*BatchCheckResponse, error)
+	// ListAccessibleRoutes returns the route patterns of the policy a user may call, e.g. to
+	// build menus. Listing the routes of another user requires the admin role.
+	ListAccessibleRoutes(context.Context, *ListAccessibleRoutesRequest) (*ListAccessibleRoutesResponse, error)
+	// ListPrincipalsForRoute returns the roles and the users that may call a route, it requires the admin role.
+	ListPrincipalsForRoute(context.Context, *ListPrincipalsForRouteRequest) (*ListPrincipalsForRouteResponse, error)
 	mustEmbedUnimplementedAccessV1Server()
 }
 
@@ -62,6 +114,15 @@ type UnimplementedAccessV1Server struct {
 func (UnimplementedAccessV1Server) Check(context.Context, *CheckRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Check not implemented")
 }
+func (UnimplementedAccessV1Server) BatchCheck(context.Context, *BatchCheckRequest) (*BatchCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCheck not implemented")
+}
+func (UnimplementedAccessV1Server) ListAccessibleRoutes(context.Context, *ListAccessibleRoutesRequest) (*ListAccessibleRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccessibleRoutes not implemented")
+}
+func (UnimplementedAccessV1Server) ListPrincipalsForRoute(context.Context, *ListPrincipalsForRouteRequest) (*ListPrincipalsForRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPrincipalsForRoute not implemented")
+}
 func (UnimplementedAccessV1Server) mustEmbedUnimplementedAccessV1Server() {}
 
 // UnsafeAccessV1Server may be embedded to opt out of forward compatibility for this service.
@@ -93,6 +154,60 @@ func _AccessV1_Check_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccessV1_BatchCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessV1Server).BatchCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessV1_BatchCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessV1Server).BatchCheck(ctx, req.(*BatchCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessV1_ListAccessibleRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccessibleRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessV1Server).ListAccessibleRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessV1_ListAccessibleRoutes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessV1Server).ListAccessibleRoutes(ctx, req.(*ListAccessibleRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessV1_ListPrincipalsForRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPrincipalsForRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessV1Server).ListPrincipalsForRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessV1_ListPrincipalsForRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessV1Server).ListPrincipalsForRoute(ctx, req.(*ListPrincipalsForRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AccessV1_ServiceDesc is the grpc.ServiceDesc for AccessV1 service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -104,6 +219,18 @@ var AccessV1_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Check",
 			Handler:    _AccessV1_Check_Handler,
 		},
+		{
+			MethodName: "BatchCheck",
+			Handler:    _AccessV1_BatchCheck_Handler,
+		},
+		{
+			MethodName: "ListAccessibleRoutes",
+			Handler:    _AccessV1_ListAccessibleRoutes_Handler,
+		},
+		{
+			MethodName: "ListPrincipalsForRoute",
+			Handler:    _AccessV1_ListPrincipalsForRoute_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "access.proto",
@@ -0,0 +1,1755 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: auth.proto
+
+package auth_v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on LoginRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LoginRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LoginRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LoginRequestMultiError, or
+// nil if none found.
+func (m *LoginRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LoginRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Username
+
+	// no validation rules for Password
+
+	// no validation rules for Tenant
+
+	// no validation rules for ClientId
+
+	// no validation rules for Scope
+
+	if len(errors) > 0 {
+		return LoginRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LoginRequestMultiError is an error wrapping multiple validation errors
+// returned by LoginRequest.ValidateAll() if the designated constraints aren't met.
+type LoginRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LoginRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LoginRequestMultiError) AllErrors() []error { return m }
+
+// LoginRequestValidationError is the validation error returned by
+// LoginRequest.Validate if the designated constraints aren't met.
+type LoginRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LoginRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LoginRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LoginRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LoginRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LoginRequestValidationError) ErrorName() string { return "LoginRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LoginRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLoginRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LoginRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LoginRequestValidationError{}
+
+// Validate checks the field values on LoginResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LoginResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LoginResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LoginResponseMultiError, or
+// nil if none found.
+func (m *LoginResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LoginResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RefreshToken
+
+	if len(errors) > 0 {
+		return LoginResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// LoginResponseMultiError is an error wrapping multiple validation errors
+// returned by LoginResponse.ValidateAll() if the designated constraints
+// aren't met.
+type LoginResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LoginResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LoginResponseMultiError) AllErrors() []error { return m }
+
+// LoginResponseValidationError is the validation error returned by
+// LoginResponse.Validate if the designated constraints aren't met.
+type LoginResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LoginResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LoginResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LoginResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LoginResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LoginResponseValidationError) ErrorName() string { return "LoginResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LoginResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLoginResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LoginResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LoginResponseValidationError{}
+
+// Validate checks the field values on GetRefreshTokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRefreshTokenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRefreshTokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRefreshTokenRequestMultiError, or nil if none found.
+func (m *GetRefreshTokenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRefreshTokenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OldRefreshToken
+
+	if len(errors) > 0 {
+		return GetRefreshTokenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRefreshTokenRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRefreshTokenRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetRefreshTokenRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRefreshTokenRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRefreshTokenRequestMultiError) AllErrors() []error { return m }
+
+// GetRefreshTokenRequestValidationError is the validation error returned by
+// GetRefreshTokenRequest.Validate if the designated constraints aren't met.
+type GetRefreshTokenRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRefreshTokenRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRefreshTokenRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRefreshTokenRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRefreshTokenRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRefreshTokenRequestValidationError) ErrorName() string {
+	return "GetRefreshTokenRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRefreshTokenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRefreshTokenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRefreshTokenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRefreshTokenRequestValidationError{}
+
+// Validate checks the field values on GetRefreshTokenResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRefreshTokenResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRefreshTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRefreshTokenResponseMultiError, or nil if none found.
+func (m *GetRefreshTokenResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRefreshTokenResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RefreshToken
+
+	if len(errors) > 0 {
+		return GetRefreshTokenResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRefreshTokenResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRefreshTokenResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetRefreshTokenResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRefreshTokenResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRefreshTokenResponseMultiError) AllErrors() []error { return m }
+
+// GetRefreshTokenResponseValidationError is the validation error returned by
+// GetRefreshTokenResponse.Validate if the designated constraints aren't met.
+type GetRefreshTokenResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRefreshTokenResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRefreshTokenResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRefreshTokenResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRefreshTokenResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRefreshTokenResponseValidationError) ErrorName() string {
+	return "GetRefreshTokenResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRefreshTokenResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRefreshTokenResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRefreshTokenResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRefreshTokenResponseValidationError{}
+
+// Validate checks the field values on GetAccessTokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAccessTokenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAccessTokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAccessTokenRequestMultiError, or nil if none found.
+func (m *GetAccessTokenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAccessTokenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RefreshToken
+
+	if len(errors) > 0 {
+		return GetAccessTokenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAccessTokenRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAccessTokenRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAccessTokenRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAccessTokenRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAccessTokenRequestMultiError) AllErrors() []error { return m }
+
+// GetAccessTokenRequestValidationError is the validation error returned by
+// GetAccessTokenRequest.Validate if the designated constraints aren't met.
+type GetAccessTokenRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAccessTokenRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAccessTokenRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAccessTokenRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAccessTokenRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAccessTokenRequestValidationError) ErrorName() string {
+	return "GetAccessTokenRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAccessTokenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAccessTokenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAccessTokenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAccessTokenRequestValidationError{}
+
+// Validate checks the field values on GetAccessTokenResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAccessTokenResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAccessTokenResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAccessTokenResponseMultiError, or nil if none found.
+func (m *GetAccessTokenResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAccessTokenResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccessToken
+
+	if len(errors) > 0 {
+		return GetAccessTokenResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAccessTokenResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAccessTokenResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetAccessTokenResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAccessTokenResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAccessTokenResponseMultiError) AllErrors() []error { return m }
+
+// GetAccessTokenResponseValidationError is the validation error returned by
+// GetAccessTokenResponse.Validate if the designated constraints aren't met.
+type GetAccessTokenResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAccessTokenResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAccessTokenResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAccessTokenResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAccessTokenResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAccessTokenResponseValidationError) ErrorName() string {
+	return "GetAccessTokenResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAccessTokenResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAccessTokenResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAccessTokenResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAccessTokenResponseValidationError{}
+
+// Validate checks the field values on ExchangeTokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExchangeTokenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExchangeTokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExchangeTokenRequestMultiError, or nil if none found.
+func (m *ExchangeTokenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExchangeTokenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GrantType
+
+	// no validation rules for SubjectToken
+
+	// no validation rules for SubjectTokenType
+
+	// no validation rules for ActorToken
+
+	// no validation rules for ActorTokenType
+
+	// no validation rules for Scope
+
+	// no validation rules for RequestedTokenType
+
+	// no validation rules for Tenant
+
+	if len(errors) > 0 {
+		return ExchangeTokenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExchangeTokenRequestMultiError is an error wrapping multiple validation
+// errors returned by ExchangeTokenRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExchangeTokenRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExchangeTokenRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExchangeTokenRequestMultiError) AllErrors() []error { return m }
+
+// ExchangeTokenRequestValidationError is the validation error returned by
+// ExchangeTokenRequest.Validate if the designated constraints aren't met.
+type ExchangeTokenRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExchangeTokenRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExchangeTokenRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExchangeTokenRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExchangeTokenRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExchangeTokenRequestValidationError) ErrorName() string {
+	return "ExchangeTokenRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExchangeTokenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExchangeTokenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExchangeTokenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExchangeTokenRequestValidationError{}
+
+// Validate checks the field values on ExchangeTokenResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExchangeTokenResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExchangeTokenResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExchangeTokenResponseMultiError, or nil if none found.
+func (m *ExchangeTokenResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExchangeTokenResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccessToken
+
+	// no validation rules for IssuedTokenType
+
+	// no validation rules for TokenType
+
+	// no validation rules for ExpiresIn
+
+	// no validation rules for Scope
+
+	if len(errors) > 0 {
+		return ExchangeTokenResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExchangeTokenResponseMultiError is an error wrapping multiple validation
+// errors returned by ExchangeTokenResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExchangeTokenResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExchangeTokenResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExchangeTokenResponseMultiError) AllErrors() []error { return m }
+
+// ExchangeTokenResponseValidationError is the validation error returned by
+// ExchangeTokenResponse.Validate if the designated constraints aren't met.
+type ExchangeTokenResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExchangeTokenResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExchangeTokenResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExchangeTokenResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExchangeTokenResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExchangeTokenResponseValidationError) ErrorName() string {
+	return "ExchangeTokenResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExchangeTokenResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExchangeTokenResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExchangeTokenResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExchangeTokenResponseValidationError{}
+
+// Validate checks the field values on DeviceAuthorizeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeviceAuthorizeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeviceAuthorizeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeviceAuthorizeRequestMultiError, or nil if none found.
+func (m *DeviceAuthorizeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeviceAuthorizeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClientId
+
+	// no validation rules for Scope
+
+	if len(errors) > 0 {
+		return DeviceAuthorizeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeviceAuthorizeRequestMultiError is an error wrapping multiple validation
+// errors returned by DeviceAuthorizeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeviceAuthorizeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeviceAuthorizeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeviceAuthorizeRequestMultiError) AllErrors() []error { return m }
+
+// DeviceAuthorizeRequestValidationError is the validation error returned by
+// DeviceAuthorizeRequest.Validate if the designated constraints aren't met.
+type DeviceAuthorizeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeviceAuthorizeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeviceAuthorizeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeviceAuthorizeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeviceAuthorizeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeviceAuthorizeRequestValidationError) ErrorName() string {
+	return "DeviceAuthorizeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeviceAuthorizeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeviceAuthorizeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeviceAuthorizeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeviceAuthorizeRequestValidationError{}
+
+// Validate checks the field values on DeviceAuthorizeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeviceAuthorizeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeviceAuthorizeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeviceAuthorizeResponseMultiError, or nil if none found.
+func (m *DeviceAuthorizeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeviceAuthorizeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeviceCode
+
+	// no validation rules for UserCode
+
+	// no validation rules for VerificationUri
+
+	// no validation rules for VerificationUriComplete
+
+	// no validation rules for ExpiresIn
+
+	// no validation rules for Interval
+
+	if len(errors) > 0 {
+		return DeviceAuthorizeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeviceAuthorizeResponseMultiError is an error wrapping multiple validation
+// errors returned by DeviceAuthorizeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeviceAuthorizeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeviceAuthorizeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeviceAuthorizeResponseMultiError) AllErrors() []error { return m }
+
+// DeviceAuthorizeResponseValidationError is the validation error returned by
+// DeviceAuthorizeResponse.Validate if the designated constraints aren't met.
+type DeviceAuthorizeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeviceAuthorizeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeviceAuthorizeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeviceAuthorizeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeviceAuthorizeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeviceAuthorizeResponseValidationError) ErrorName() string {
+	return "DeviceAuthorizeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeviceAuthorizeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeviceAuthorizeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeviceAuthorizeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeviceAuthorizeResponseValidationError{}
+
+// Validate checks the field values on ApproveDeviceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveDeviceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveDeviceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveDeviceRequestMultiError, or nil if none found.
+func (m *ApproveDeviceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveDeviceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserCode
+
+	// no validation rules for Deny
+
+	if len(errors) > 0 {
+		return ApproveDeviceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveDeviceRequestMultiError is an error wrapping multiple validation
+// errors returned by ApproveDeviceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ApproveDeviceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveDeviceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveDeviceRequestMultiError) AllErrors() []error { return m }
+
+// ApproveDeviceRequestValidationError is the validation error returned by
+// ApproveDeviceRequest.Validate if the designated constraints aren't met.
+type ApproveDeviceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveDeviceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveDeviceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveDeviceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveDeviceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveDeviceRequestValidationError) ErrorName() string {
+	return "ApproveDeviceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveDeviceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveDeviceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveDeviceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveDeviceRequestValidationError{}
+
+// Validate checks the field values on DeviceTokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeviceTokenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeviceTokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeviceTokenRequestMultiError, or nil if none found.
+func (m *DeviceTokenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeviceTokenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GrantType
+
+	// no validation rules for DeviceCode
+
+	// no validation rules for ClientId
+
+	if len(errors) > 0 {
+		return DeviceTokenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeviceTokenRequestMultiError is an error wrapping multiple validation errors
+// returned by DeviceTokenRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeviceTokenRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeviceTokenRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeviceTokenRequestMultiError) AllErrors() []error { return m }
+
+// DeviceTokenRequestValidationError is the validation error returned by
+// DeviceTokenRequest.Validate if the designated constraints aren't met.
+type DeviceTokenRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeviceTokenRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeviceTokenRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeviceTokenRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeviceTokenRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeviceTokenRequestValidationError) ErrorName() string {
+	return "DeviceTokenRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeviceTokenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeviceTokenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeviceTokenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeviceTokenRequestValidationError{}
+
+// Validate checks the field values on DeviceTokenResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeviceTokenResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeviceTokenResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeviceTokenResponseMultiError, or nil if none found.
+func (m *DeviceTokenResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeviceTokenResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RefreshToken
+
+	// no validation rules for AccessToken
+
+	// no validation rules for TokenType
+
+	// no validation rules for ExpiresIn
+
+	if len(errors) > 0 {
+		return DeviceTokenResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeviceTokenResponseMultiError is an error wrapping multiple validation
+// errors returned by DeviceTokenResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeviceTokenResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeviceTokenResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeviceTokenResponseMultiError) AllErrors() []error { return m }
+
+// DeviceTokenResponseValidationError is the validation error returned by
+// DeviceTokenResponse.Validate if the designated constraints aren't met.
+type DeviceTokenResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeviceTokenResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeviceTokenResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeviceTokenResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeviceTokenResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeviceTokenResponseValidationError) ErrorName() string {
+	return "DeviceTokenResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeviceTokenResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeviceTokenResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeviceTokenResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeviceTokenResponseValidationError{}
+
+// Validate checks the field values on StartFederatedLoginRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartFederatedLoginRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartFederatedLoginRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartFederatedLoginRequestMultiError, or nil if none found.
+func (m *StartFederatedLoginRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartFederatedLoginRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Provider
+
+	if len(errors) > 0 {
+		return StartFederatedLoginRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartFederatedLoginRequestMultiError is an error wrapping multiple
+// validation errors returned by StartFederatedLoginRequest.ValidateAll() if
+// the designated constraints aren't met.
+type StartFederatedLoginRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartFederatedLoginRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartFederatedLoginRequestMultiError) AllErrors() []error { return m }
+
+// StartFederatedLoginRequestValidationError is the validation error returned
+// by StartFederatedLoginRequest.Validate if the designated constraints aren't met.
+type StartFederatedLoginRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartFederatedLoginRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartFederatedLoginRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartFederatedLoginRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartFederatedLoginRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartFederatedLoginRequestValidationError) ErrorName() string {
+	return "StartFederatedLoginRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartFederatedLoginRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartFederatedLoginRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartFederatedLoginRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartFederatedLoginRequestValidationError{}
+
+// Validate checks the field values on StartFederatedLoginResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartFederatedLoginResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartFederatedLoginResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartFederatedLoginResponseMultiError, or nil if none found.
+func (m *StartFederatedLoginResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartFederatedLoginResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AuthorizationUrl
+
+	if len(errors) > 0 {
+		return StartFederatedLoginResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartFederatedLoginResponseMultiError is an error wrapping multiple
+// validation errors returned by StartFederatedLoginResponse.ValidateAll() if
+// the designated constraints aren't met.
+type StartFederatedLoginResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartFederatedLoginResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartFederatedLoginResponseMultiError) AllErrors() []error { return m }
+
+// StartFederatedLoginResponseValidationError is the validation error returned
+// by StartFederatedLoginResponse.Validate if the designated constraints
+// aren't met.
+type StartFederatedLoginResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartFederatedLoginResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartFederatedLoginResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartFederatedLoginResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartFederatedLoginResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartFederatedLoginResponseValidationError) ErrorName() string {
+	return "StartFederatedLoginResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartFederatedLoginResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartFederatedLoginResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartFederatedLoginResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartFederatedLoginResponseValidationError{}
+
+// Validate checks the field values on FinishFederatedLoginRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FinishFederatedLoginRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FinishFederatedLoginRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FinishFederatedLoginRequestMultiError, or nil if none found.
+func (m *FinishFederatedLoginRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FinishFederatedLoginRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Provider
+
+	// no validation rules for Code
+
+	// no validation rules for State
+
+	if len(errors) > 0 {
+		return FinishFederatedLoginRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FinishFederatedLoginRequestMultiError is an error wrapping multiple
+// validation errors returned by FinishFederatedLoginRequest.ValidateAll() if
+// the designated constraints aren't met.
+type FinishFederatedLoginRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FinishFederatedLoginRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FinishFederatedLoginRequestMultiError) AllErrors() []error { return m }
+
+// FinishFederatedLoginRequestValidationError is the validation error returned
+// by FinishFederatedLoginRequest.Validate if the designated constraints
+// aren't met.
+type FinishFederatedLoginRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FinishFederatedLoginRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FinishFederatedLoginRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FinishFederatedLoginRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FinishFederatedLoginRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FinishFederatedLoginRequestValidationError) ErrorName() string {
+	return "FinishFederatedLoginRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FinishFederatedLoginRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFinishFederatedLoginRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FinishFederatedLoginRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FinishFederatedLoginRequestValidationError{}
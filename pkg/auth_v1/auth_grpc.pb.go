@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,9 +20,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	AuthV1_Login_FullMethodName           = "/auth_v1.AuthV1/Login"
-	AuthV1_GetRefreshToken_FullMethodName = "/auth_v1.AuthV1/GetRefreshToken"
-	AuthV1_GetAccessToken_FullMethodName  = "/auth_v1.AuthV1/GetAccessToken"
+	AuthV1_Login_FullMethodName                = "/auth_v1.AuthV1/Login"
+	AuthV1_GetRefreshToken_FullMethodName      = "/auth_v1.AuthV1/GetRefreshToken"
+	AuthV1_GetAccessToken_FullMethodName       = "/auth_v1.AuthV1/GetAccessToken"
+	AuthV1_ExchangeToken_FullMethodName        = "/auth_v1.AuthV1/ExchangeToken"
+	AuthV1_DeviceAuthorize_FullMethodName      = "/auth_v1.AuthV1/DeviceAuthorize"
+	AuthV1_ApproveDevice_FullMethodName        = "/auth_v1.AuthV1/ApproveDevice"
+	AuthV1_DeviceToken_FullMethodName          = "/auth_v1.AuthV1/DeviceToken"
+	AuthV1_StartFederatedLogin_FullMethodName  = "/auth_v1.AuthV1/StartFederatedLogin"
+	AuthV1_FinishFederatedLogin_FullMethodName = "/auth_v1.AuthV1/FinishFederatedLogin"
 )
 
 // AuthV1Client is the client API for AuthV1 service.
@@ -31,6 +38,12 @@ type AuthV1Client interface {
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 	GetRefreshToken(ctx context.Context, in *GetRefreshTokenRequest, opts ...grpc.CallOption) (*GetRefreshTokenResponse, error)
 	GetAccessToken(ctx context.Context, in *GetAccessTokenRequest, opts ...grpc.CallOption) (*GetAccessTokenResponse, error)
+	ExchangeToken(ctx context.Context, in *ExchangeTokenRequest, opts ...grpc.CallOption) (*ExchangeTokenResponse, error)
+	DeviceAuthorize(ctx context.Context, in *DeviceAuthorizeRequest, opts ...grpc.CallOption) (*DeviceAuthorizeResponse, error)
+	ApproveDevice(ctx context.Context, in *ApproveDeviceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeviceToken(ctx context.Context, in *DeviceTokenRequest, opts ...grpc.CallOption) (*DeviceTokenResponse, error)
+	StartFederatedLogin(ctx context.Context, in *StartFederatedLoginRequest, opts ...grpc.CallOption) (*StartFederatedLoginResponse, error)
+	FinishFederatedLogin(ctx context.Context, in *FinishFederatedLoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 }
 
 type authV1Client struct {
@@ -68,6 +81,60 @@ func (c *authV1Client) GetAccessToken(ctx context.Context, in *GetAccessTokenReq
 	return out, nil
 }
 
+func (c *authV1Client) ExchangeToken(ctx context.Context, in *ExchangeTokenRequest, opts ...grpc.CallOption) (*ExchangeTokenResponse, error) {
+	out := new(ExchangeTokenResponse)
+	err := c.cc.Invoke(ctx, AuthV1_ExchangeToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authV1Client) DeviceAuthorize(ctx context.Context, in *DeviceAuthorizeRequest, opts ...grpc.CallOption) (*DeviceAuthorizeResponse, error) {
+	out := new(DeviceAuthorizeResponse)
+	err := c.cc.Invoke(ctx, AuthV1_DeviceAuthorize_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authV1Client) ApproveDevice(ctx context.Context, in *ApproveDeviceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthV1_ApproveDevice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authV1Client) DeviceToken(ctx context.Context, in *DeviceTokenRequest, opts ...grpc.CallOption) (*DeviceTokenResponse, error) {
+	out := new(DeviceTokenResponse)
+	err := c.cc.Invoke(ctx, AuthV1_DeviceToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authV1Client) StartFederatedLogin(ctx context.Context, in *StartFederatedLoginRequest, opts ...grpc.CallOption) (*StartFederatedLoginResponse, error) {
+	out := new(StartFederatedLoginResponse)
+	err := c.cc.Invoke(ctx, AuthV1_StartFederatedLogin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authV1Client) FinishFederatedLogin(ctx context.Context, in *FinishFederatedLoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, AuthV1_FinishFederatedLogin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthV1Server is the server API for AuthV1 service.
 // All implementations must embed UnimplementedAuthV1Server
 // for forward compatibility
@@ -75,6 +142,12 @@ type AuthV1Server interface {
 	Login(context.Context, *LoginRequest) (*LoginResponse, error)
 	GetRefreshToken(context.Context, *GetRefreshTokenRequest) (*GetRefreshTokenResponse, error)
 	GetAccessToken(context.Context, *GetAccessTokenRequest) (*GetAccessTokenResponse, error)
+	ExchangeToken(context.Context, *ExchangeTokenRequest) (*ExchangeTokenResponse, error)
+	DeviceAuthorize(context.Context, *DeviceAuthorizeRequest) (*DeviceAuthorizeResponse, error)
+	ApproveDevice(context.Context, *ApproveDeviceRequest) (*emptypb.Empty, error)
+	DeviceToken(context.Context, *DeviceTokenRequest) (*DeviceTokenResponse, error)
+	StartFederatedLogin(context.Context, *StartFederatedLoginRequest) (*StartFederatedLoginResponse, error)
+	FinishFederatedLogin(context.Context, *FinishFederatedLoginRequest) (*LoginResponse, error)
 	mustEmbedUnimplementedAuthV1Server()
 }
 
@@ -91,6 +164,24 @@ func (UnimplementedAuthV1Server) GetRefreshToken(context.Context, *GetRefreshTok
 func (UnimplementedAuthV1Server) GetAccessToken(context.Context, *GetAccessTokenRequest) (*GetAccessTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAccessToken not implemented")
 }
+func (UnimplementedAuthV1Server) ExchangeToken(context.Context, *ExchangeTokenRequest) (*ExchangeTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeToken not implemented")
+}
+func (UnimplementedAuthV1Server) DeviceAuthorize(context.Context, *DeviceAuthorizeRequest) (*DeviceAuthorizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeviceAuthorize not implemented")
+}
+func (UnimplementedAuthV1Server) ApproveDevice(context.Context, *ApproveDeviceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveDevice not implemented")
+}
+func (UnimplementedAuthV1Server) DeviceToken(context.Context, *DeviceTokenRequest) (*DeviceTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeviceToken not implemented")
+}
+func (UnimplementedAuthV1Server) StartFederatedLogin(context.Context, *StartFederatedLoginRequest) (*StartFederatedLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartFederatedLogin not implemented")
+}
+func (UnimplementedAuthV1Server) FinishFederatedLogin(context.Context, *FinishFederatedLoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishFederatedLogin not implemented")
+}
 func (UnimplementedAuthV1Server) mustEmbedUnimplementedAuthV1Server() {}
 
 // UnsafeAuthV1Server may be embedded to opt out of forward compatibility for this service.
@@ -158,6 +249,114 @@ func _AuthV1_GetAccessToken_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthV1_ExchangeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthV1Server).ExchangeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthV1_ExchangeToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthV1Server).ExchangeToken(ctx, req.(*ExchangeTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthV1_DeviceAuthorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceAuthorizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthV1Server).DeviceAuthorize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthV1_DeviceAuthorize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthV1Server).DeviceAuthorize(ctx, req.(*DeviceAuthorizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthV1_ApproveDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthV1Server).ApproveDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthV1_ApproveDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthV1Server).ApproveDevice(ctx, req.(*ApproveDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthV1_DeviceToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthV1Server).DeviceToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthV1_DeviceToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthV1Server).DeviceToken(ctx, req.(*DeviceTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthV1_StartFederatedLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartFederatedLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthV1Server).StartFederatedLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthV1_StartFederatedLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthV1Server).StartFederatedLogin(ctx, req.(*StartFederatedLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthV1_FinishFederatedLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishFederatedLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthV1Server).FinishFederatedLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthV1_FinishFederatedLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthV1Server).FinishFederatedLogin(ctx, req.(*FinishFederatedLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthV1_ServiceDesc is the grpc.ServiceDesc for AuthV1 service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -177,6 +376,30 @@ var AuthV1_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAccessToken",
 			Handler:    _AuthV1_GetAccessToken_Handler,
 		},
+		{
+			MethodName: "ExchangeToken",
+			Handler:    _AuthV1_ExchangeToken_Handler,
+		},
+		{
+			MethodName: "DeviceAuthorize",
+			Handler:    _AuthV1_DeviceAuthorize_Handler,
+		},
+		{
+			MethodName: "ApproveDevice",
+			Handler:    _AuthV1_ApproveDevice_Handler,
+		},
+		{
+			MethodName: "DeviceToken",
+			Handler:    _AuthV1_DeviceToken_Handler,
+		},
+		{
+			MethodName: "StartFederatedLogin",
+			Handler:    _AuthV1_StartFederatedLogin_Handler,
+		},
+		{
+			MethodName: "FinishFederatedLogin",
+			Handler:    _AuthV1_FinishFederatedLogin_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "auth.proto",
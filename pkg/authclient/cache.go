@@ -0,0 +1,94 @@
+package authclient
+
+import (
+	"crypto/sha256"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/arifullov/auth/pkg/access_v1"
+)
+
+// decisionKey identifies what a decision depends on, except for the headers used by rule
+// conditions. Tokens are hashed so they are not kept in memory.
+func decisionKey(accessToken string, req *access_v1.CheckRequest) [sha256.Size]byte {
+	return sha256.Sum256([]byte(strings.Join([]string{
+		accessToken, req.GetMethod(), req.GetEndpointAddress(), encodeFields(req.GetFields()),
+		req.GetAudience(), req.GetTenant(), req.GetIp(),
+	}, "\x00")))
+}
+
+func encodeFields(fields map[string]string) string {
+	names := make([]string, 0, len(fields))
+	for name, value := range fields {
+		names = append(names, name+"="+value)
+	}
+	slices.Sort(names)
+	return strings.Join(names, "&")
+}
+
+type cachedDecision struct {
+	// err is nil for an allowed request.
+	err       error
+	expiresAt time.Time
+}
+
+// decisionCache keeps decisions for a short time, never longer than the token is valid.
+// When full, expired decisions are dropped and, if none has expired, the whole cache.
+type decisionCache struct {
+	ttl  time.Duration
+	size int
+
+	mu        sync.Mutex
+	decisions map[[sha256.Size]byte]cachedDecision
+}
+
+func newDecisionCache(ttl time.Duration, size int) *decisionCache {
+	return &decisionCache{
+		ttl:       ttl,
+		size:      size,
+		decisions: make(map[[sha256.Size]byte]cachedDecision),
+	}
+}
+
+// get finds an unexpired decision, a nil cache finds none.
+func (c *decisionCache) get(key [sha256.Size]byte) (error, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached, ok := c.decisions[key]
+	if !ok || time.Now().After(cached.expiresAt) {
+		return nil, false
+	}
+	return cached.err, true
+}
+
+// put keeps the decision for the cache TTL or until tokenExpiresAt, when set and earlier.
+func (c *decisionCache) put(key [sha256.Size]byte, err error, tokenExpiresAt time.Time) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	expiresAt := now.Add(c.ttl)
+	if !tokenExpiresAt.IsZero() && tokenExpiresAt.Before(expiresAt) {
+		expiresAt = tokenExpiresAt
+	}
+	if len(c.decisions) >= c.size {
+		for k, cached := range c.decisions {
+			if now.After(cached.expiresAt) {
+				delete(c.decisions, k)
+			}
+		}
+		if len(c.decisions) >= c.size {
+			clear(c.decisions)
+		}
+	}
+	c.decisions[key] = cachedDecision{err: err, expiresAt: expiresAt}
+}
@@ -0,0 +1,71 @@
+package authclient
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Claims are the claims of the access tokens issued by the auth service.
+type Claims struct {
+	jwt.RegisteredClaims
+	Username    string   `json:"username"`
+	Roles       []string `json:"roles,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+	Scope       string   `json:"scope,omitempty"`
+	// ClientID is the client the token was issued to, empty for first-party logins.
+	ClientID string `json:"client_id,omitempty"`
+	Act      *Actor `json:"act,omitempty"`
+	// Tenant is the slug of the organization the token was issued for.
+	Tenant string `json:"tenant,omitempty"`
+}
+
+// Actor is the party acting on behalf of the subject of an exchanged token.
+type Actor struct {
+	Username string `json:"sub"`
+	Act      *Actor `json:"act,omitempty"`
+}
+
+// HasRole reports whether the token grants the role, assigned or inherited.
+func (c *Claims) HasRole(role string) bool {
+	return slices.Contains(c.Roles, role)
+}
+
+// HasPermission reports whether the token grants the permission.
+func (c *Claims) HasPermission(permission string) bool {
+	return slices.Contains(c.Permissions, permission)
+}
+
+// HasScope reports whether the token grants the scope, tokens issued without scopes to
+// first-party logins grant the permissions of the user.
+func (c *Claims) HasScope(scope string) bool {
+	if c.Scope == "" && c.ClientID == "" {
+		return c.HasPermission(scope)
+	}
+	return slices.Contains(strings.Fields(c.Scope), scope)
+}
+
+type claimsKey struct{}
+
+// WithClaims returns a context carrying the claims of the authorized caller.
+func WithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// ClaimsFromContext returns the claims the interceptors put in the context of an authorized call.
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*Claims)
+	return claims, ok
+}
+
+// parseUnverified reads the claims of a token without checking its signature, it is only
+// used for tokens verified by the auth service.
+func parseUnverified(token string) (*Claims, error) {
+	claims := &Claims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
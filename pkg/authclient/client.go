@@ -0,0 +1,235 @@
+// Package authclient is the Go client of the auth service: typed wrappers of its gRPC API,
+// access tokens refreshed from a refresh token and server interceptors authorizing calls
+// with the auth service or by verifying tokens locally.
+package authclient
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sony/gobreaker/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/arifullov/auth/pkg/access_v1"
+	"github.com/arifullov/auth/pkg/auth_v1"
+	"github.com/arifullov/auth/pkg/user_v1"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+	// tenantHeader names the organization a call is made in.
+	tenantHeader = "x-tenant"
+)
+
+// Client calls the auth service. Check decisions are cached when a cache is configured
+// and every call goes through a circuit breaker, so callers fail fast with Unavailable
+// while the auth service is down.
+type Client struct {
+	auth   auth_v1.AuthV1Client
+	access access_v1.AccessV1Client
+	user   user_v1.UserV1Client
+
+	breaker *gobreaker.CircuitBreaker[any]
+	cache   *decisionCache
+}
+
+type options struct {
+	breaker   gobreaker.Settings
+	cacheTTL  time.Duration
+	cacheSize int
+}
+
+// Option configures a Client.
+type Option func(*options)
+
+// WithDecisionCache reuses Check decisions for the same token and request for ttl,
+// keeping at most size of them. Revoked tokens stay accepted until their decisions expire.
+func WithDecisionCache(ttl time.Duration, size int) Option {
+	return func(o *options) {
+		o.cacheTTL = ttl
+		o.cacheSize = size
+	}
+}
+
+// WithCircuitBreaker replaces the default circuit breaker settings, IsSuccessful is always
+// set so that only failures of the auth service itself trip the breaker.
+func WithCircuitBreaker(settings gobreaker.Settings) Option {
+	return func(o *options) {
+		o.breaker = settings
+	}
+}
+
+// New returns a client of the auth service reachable through cc.
+func New(cc grpc.ClientConnInterface, opts ...Option) *Client {
+	o := options{
+		breaker: gobreaker.Settings{
+			Name:        "auth",
+			MaxRequests: 3,
+			Timeout:     5 * time.Second,
+			ReadyToTrip: func(counts gobreaker.Counts) bool {
+				failureRatio := float64(counts.TotalFailures) / float64(counts.Requests)
+				return counts.Requests >= 3 && failureRatio >= 0.6
+			},
+		},
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	o.breaker.IsSuccessful = isSuccessful
+
+	c := &Client{
+		auth:    auth_v1.NewAuthV1Client(cc),
+		access:  access_v1.NewAccessV1Client(cc),
+		user:    user_v1.NewUserV1Client(cc),
+		breaker: gobreaker.NewCircuitBreaker[any](o.breaker),
+	}
+	if o.cacheTTL > 0 {
+		c.cache = newDecisionCache(o.cacheTTL, o.cacheSize)
+	}
+	return c
+}
+
+// Auth returns the AuthV1 client for calls without a wrapper, they bypass the circuit breaker.
+func (c *Client) Auth() auth_v1.AuthV1Client {
+	return c.auth
+}
+
+// Access returns the AccessV1 client for calls without a wrapper, they bypass the circuit breaker.
+func (c *Client) Access() access_v1.AccessV1Client {
+	return c.access
+}
+
+// User returns the UserV1 client for calls without a wrapper, they bypass the circuit breaker.
+func (c *Client) User() user_v1.UserV1Client {
+	return c.user
+}
+
+// Login returns a refresh token of the user, tenant is the slug of the organization
+// to log in to, empty to log in outside of organizations.
+func (c *Client) Login(ctx context.Context, username, password, tenant string) (string, error) {
+	res, err := call(c, func() (*auth_v1.LoginResponse, error) {
+		return c.auth.Login(ctx, &auth_v1.LoginRequest{
+			Username: username,
+			Password: password,
+			Tenant:   tenant,
+		})
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.GetRefreshToken(), nil
+}
+
+// RefreshToken exchanges a refresh token for a new one.
+func (c *Client) RefreshToken(ctx context.Context, refreshToken string) (string, error) {
+	res, err := call(c, func() (*auth_v1.GetRefreshTokenResponse, error) {
+		return c.auth.GetRefreshToken(ctx, &auth_v1.GetRefreshTokenRequest{OldRefreshToken: refreshToken})
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.GetRefreshToken(), nil
+}
+
+// AccessToken issues an access token for the owner of the refresh token.
+func (c *Client) AccessToken(ctx context.Context, refreshToken string) (string, error) {
+	res, err := call(c, func() (*auth_v1.GetAccessTokenResponse, error) {
+		return c.auth.GetAccessToken(ctx, &auth_v1.GetAccessTokenRequest{RefreshToken: refreshToken})
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.GetAccessToken(), nil
+}
+
+// Check asks whether the owner of the access token may make the request, it fails with
+// Unauthenticated or PermissionDenied when not.
+func (c *Client) Check(ctx context.Context, accessToken string, req *access_v1.CheckRequest) error {
+	key := decisionKey(accessToken, req)
+	if err, ok := c.cache.get(key); ok {
+		return err
+	}
+
+	_, err := call(c, func() (any, error) {
+		return c.access.Check(WithAccessToken(ctx, accessToken), req)
+	})
+	if err == nil || isDecision(err) {
+		c.cache.put(key, err, tokenExpiry(accessToken))
+	}
+	return err
+}
+
+// Authorize checks the request with the auth service and returns the claims of the token.
+func (c *Client) Authorize(ctx context.Context, accessToken string, req *access_v1.CheckRequest) (*Claims, error) {
+	if err := c.Check(ctx, accessToken, req); err != nil {
+		return nil, err
+	}
+	claims, err := parseUnverified(accessToken)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid access token")
+	}
+	return claims, nil
+}
+
+// GetUser returns the user, the call is authorized with the access token.
+func (c *Client) GetUser(ctx context.Context, accessToken string, id int64) (*user_v1.GetResponse, error) {
+	return call(c, func() (*user_v1.GetResponse, error) {
+		return c.user.Get(WithAccessToken(ctx, accessToken), &user_v1.GetRequest{Id: id})
+	})
+}
+
+// WithAccessToken returns a context sending the access token with outgoing calls.
+func WithAccessToken(ctx context.Context, accessToken string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, authorizationHeader, bearerPrefix+accessToken)
+}
+
+// call runs fn through the circuit breaker, an open breaker fails with Unavailable.
+func call[T any](c *Client, fn func() (T, error)) (T, error) {
+	res, err := c.breaker.Execute(func() (any, error) {
+		return fn()
+	})
+	if errors.Is(err, gobreaker.ErrOpenState) || errors.Is(err, gobreaker.ErrTooManyRequests) {
+		var zero T
+		return zero, status.Error(codes.Unavailable, "auth service unavailable")
+	}
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	typed, _ := res.(T)
+	return typed, nil
+}
+
+// isSuccessful counts only failures of the auth service, rejected requests are answers.
+func isSuccessful(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Internal, codes.Unknown:
+		return false
+	default:
+		return true
+	}
+}
+
+// isDecision reports whether the error is a denial rather than a failure to decide.
+func isDecision(err error) bool {
+	switch status.Code(err) {
+	case codes.Unauthenticated, codes.PermissionDenied:
+		return true
+	default:
+		return false
+	}
+}
+
+// tokenExpiry returns when the token expires, zero when it does not say.
+func tokenExpiry(token string) time.Time {
+	claims, err := parseUnverified(token)
+	if err != nil || claims.ExpiresAt == nil {
+		return time.Time{}
+	}
+	return claims.ExpiresAt.Time
+}
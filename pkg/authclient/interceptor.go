@@ -0,0 +1,189 @@
+package authclient
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/arifullov/auth/pkg/access_v1"
+)
+
+// Authorizer decides whether the owner of an access token may make a request, Client asks
+// the auth service and LocalVerifier only verifies the token.
+type Authorizer interface {
+	Authorize(ctx context.Context, accessToken string, req *access_v1.CheckRequest) (*Claims, error)
+}
+
+// Interceptor authorizes incoming calls of a service and puts the claims of the caller
+// in the context of the handler, see ClaimsFromContext.
+type Interceptor struct {
+	authorizer    Authorizer
+	audience      string
+	publicMethods map[string]struct{}
+}
+
+// NewInterceptor returns an interceptor authorizing every call except publicMethods, full
+// gRPC method names callable without a token. Audience is the name of the service tokens
+// must be issued for, empty to accept only tokens without an audience.
+func NewInterceptor(authorizer Authorizer, audience string, publicMethods ...string) *Interceptor {
+	public := make(map[string]struct{}, len(publicMethods))
+	for _, method := range publicMethods {
+		public[method] = struct{}{}
+	}
+	return &Interceptor{
+		authorizer:    authorizer,
+		audience:      audience,
+		publicMethods: public,
+	}
+}
+
+func (i *Interceptor) Unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if _, ok := i.publicMethods[info.FullMethod]; ok {
+		return handler(ctx, req)
+	}
+
+	ctx, err := i.authorize(ctx, info.FullMethod, requestFields(req))
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// Stream authorizes a streaming call once when it starts, request messages are not
+// available yet so ownership rules see no fields.
+func (i *Interceptor) Stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if _, ok := i.publicMethods[info.FullMethod]; ok {
+		return handler(srv, ss)
+	}
+
+	ctx, err := i.authorize(ss.Context(), info.FullMethod, nil)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+}
+
+func (i *Interceptor) authorize(ctx context.Context, fullMethod string, fields map[string]string) (context.Context, error) {
+	accessToken, err := bearerToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := requestHeaders(ctx)
+	claims, err := i.authorizer.Authorize(ctx, accessToken, &access_v1.CheckRequest{
+		EndpointAddress: fullMethod,
+		Audience:        i.audience,
+		Fields:          fields,
+		Ip:              peerIP(ctx),
+		Headers:         headers,
+		Tenant:          headers[tenantHeader],
+	})
+	if err != nil {
+		return nil, err
+	}
+	return WithClaims(ctx, claims), nil
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func bearerToken(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	authHeader := md.Get(authorizationHeader)
+	if len(authHeader) == 0 {
+		return "", status.Error(codes.Unauthenticated, "authorization header is not provided")
+	}
+
+	accessToken, ok := strings.CutPrefix(authHeader[0], bearerPrefix)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "invalid authorization header format")
+	}
+	return accessToken, nil
+}
+
+// peerIP returns the address of the direct client.
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+// requestHeaders returns the first value of each metadata key except the authorization header.
+func requestHeaders(ctx context.Context) map[string]string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	headers := make(map[string]string, len(md))
+	for name, values := range md {
+		if name == authorizationHeader || len(values) == 0 {
+			continue
+		}
+		headers[name] = values[0]
+	}
+	return headers
+}
+
+// requestFields flattens the set scalar fields of a request message for ownership rules,
+// nested fields are named with dots and wrapper messages such as google.protobuf.Int64Value
+// resolve to their value. Repeated and map fields are skipped.
+func requestFields(req any) map[string]string {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+	fields := make(map[string]string)
+	addFields(fields, "", msg.ProtoReflect())
+	return fields
+}
+
+func addFields(fields map[string]string, prefix string, msg protoreflect.Message) {
+	msg.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if fd.IsList() || fd.IsMap() {
+			return true
+		}
+		name := prefix + string(fd.Name())
+		if fd.Kind() != protoreflect.MessageKind && fd.Kind() != protoreflect.GroupKind {
+			fields[name] = scalarString(value)
+			return true
+		}
+
+		nested := value.Message()
+		if valueFd := nested.Descriptor().Fields().ByName("value"); valueFd != nil &&
+			nested.Descriptor().Fields().Len() == 1 && valueFd.Kind() != protoreflect.MessageKind {
+			fields[name] = scalarString(nested.Get(valueFd))
+			return true
+		}
+		addFields(fields, name+".", nested)
+		return true
+	})
+}
+
+func scalarString(value protoreflect.Value) string {
+	switch v := value.Interface().(type) {
+	case protoreflect.EnumNumber:
+		return fmt.Sprint(int32(v))
+	case []byte:
+		return string(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
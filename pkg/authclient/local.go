@@ -0,0 +1,56 @@
+package authclient
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/arifullov/auth/pkg/access_v1"
+)
+
+// LocalVerifier authorizes calls by verifying access tokens with the secret the auth service
+// signs them with, without calling it. Route rules are not evaluated, handlers check the
+// roles and permissions of the claims themselves.
+type LocalVerifier struct {
+	secret []byte
+}
+
+// NewLocalVerifier returns a verifier of tokens signed with the HMAC secret.
+func NewLocalVerifier(secret []byte) *LocalVerifier {
+	return &LocalVerifier{secret: secret}
+}
+
+// Verify checks the signature and the expiry of the token and returns its claims.
+func (v *LocalVerifier) Verify(accessToken string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(accessToken, claims, func(token *jwt.Token) (any, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return v.secret, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid access token")
+	}
+	return claims, nil
+}
+
+// Authorize verifies the token and checks that it was issued for the audience and the
+// tenant of the request, tokens without an audience are valid for any service.
+func (v *LocalVerifier) Authorize(_ context.Context, accessToken string, req *access_v1.CheckRequest) (*Claims, error) {
+	claims, err := v.Verify(accessToken)
+	if err != nil {
+		return nil, err
+	}
+	if len(claims.Audience) > 0 && !slices.Contains(claims.Audience, req.GetAudience()) {
+		return nil, status.Error(codes.PermissionDenied, "token is issued for another audience")
+	}
+	if req.GetTenant() != "" && claims.Tenant != req.GetTenant() {
+		return nil, status.Error(codes.PermissionDenied, "token is issued for another organization")
+	}
+	return claims, nil
+}
@@ -0,0 +1,217 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/sony/gobreaker/v2"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/arifullov/auth/pkg/access_v1"
+	"github.com/arifullov/auth/pkg/authclient"
+)
+
+var secret = []byte("secret")
+
+// accessServer answers Check with err and counts the calls.
+type accessServer struct {
+	access_v1.UnimplementedAccessV1Server
+	err   error
+	calls atomic.Int32
+}
+
+func (s *accessServer) Check(ctx context.Context, _ *access_v1.CheckRequest) (*emptypb.Empty, error) {
+	s.calls.Add(1)
+	md, _ := metadata.FromIncomingContext(ctx)
+	if len(md.Get("authorization")) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "authorization header is not provided")
+	}
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func dial(t *testing.T, srv access_v1.AccessV1Server) *grpc.ClientConn {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	access_v1.RegisterAccessV1Server(server, srv)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func token(t *testing.T, claims authclient.Claims) string {
+	t.Helper()
+
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(secret)
+	require.NoError(t, err)
+	return signed
+}
+
+func TestCheck(t *testing.T) {
+	ctx := context.Background()
+	accessToken := token(t, authclient.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: "42", ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour))},
+		Roles:            []string{"user"},
+	})
+	req := &access_v1.CheckRequest{EndpointAddress: "/orders/42", Method: "GET"}
+
+	tests := []struct {
+		name      string
+		serverErr error
+		opts      []authclient.Option
+		checks    int
+		code      codes.Code
+		calls     int32
+	}{
+		{
+			name:   "allowed without cache",
+			checks: 3,
+			code:   codes.OK,
+			calls:  3,
+		},
+		{
+			name:   "allowed decision cached",
+			opts:   []authclient.Option{authclient.WithDecisionCache(time.Minute, 10)},
+			checks: 3,
+			code:   codes.OK,
+			calls:  1,
+		},
+		{
+			name:      "denied decision cached",
+			serverErr: status.Error(codes.PermissionDenied, "access denied"),
+			opts:      []authclient.Option{authclient.WithDecisionCache(time.Minute, 10)},
+			checks:    3,
+			code:      codes.PermissionDenied,
+			calls:     1,
+		},
+		{
+			name:      "failures not cached",
+			serverErr: status.Error(codes.Internal, "internal error"),
+			opts: []authclient.Option{
+				authclient.WithDecisionCache(time.Minute, 10),
+				authclient.WithCircuitBreaker(gobreaker.Settings{Name: "test"}),
+			},
+			checks: 3,
+			code:   codes.Internal,
+			calls:  3,
+		},
+		{
+			name:      "open breaker fails fast",
+			serverErr: status.Error(codes.Unavailable, "connection refused"),
+			opts: []authclient.Option{
+				authclient.WithCircuitBreaker(gobreaker.Settings{
+					Name:        "test",
+					Timeout:     time.Minute,
+					ReadyToTrip: func(counts gobreaker.Counts) bool { return counts.ConsecutiveFailures >= 2 },
+				}),
+			},
+			checks: 5,
+			code:   codes.Unavailable,
+			calls:  2,
+		},
+		{
+			name:      "denials do not trip breaker",
+			serverErr: status.Error(codes.PermissionDenied, "access denied"),
+			opts: []authclient.Option{
+				authclient.WithCircuitBreaker(gobreaker.Settings{
+					Name:        "test",
+					Timeout:     time.Minute,
+					ReadyToTrip: func(counts gobreaker.Counts) bool { return counts.ConsecutiveFailures >= 2 },
+				}),
+			},
+			checks: 5,
+			code:   codes.PermissionDenied,
+			calls:  5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := &accessServer{err: tt.serverErr}
+			client := authclient.New(dial(t, srv), tt.opts...)
+			for range tt.checks {
+				err := client.Check(ctx, accessToken, req)
+				require.Equal(t, tt.code, status.Code(err))
+			}
+			require.Equal(t, tt.calls, srv.calls.Load())
+		})
+	}
+}
+
+func TestLocalInterceptor(t *testing.T) {
+	valid := token(t, authclient.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: "42", ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour))},
+		Roles:            []string{"user"},
+	})
+	expired := token(t, authclient.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: "42", ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Hour))},
+	})
+	otherAudience := token(t, authclient.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: "42", Audience: jwt.ClaimStrings{"billing"}},
+	})
+
+	tests := []struct {
+		name   string
+		method string
+		header string
+		code   codes.Code
+		sub    string
+	}{
+		{name: "valid token", method: "/orders.OrdersV1/Get", header: "Bearer " + valid, code: codes.OK, sub: "42"},
+		{name: "public method", method: "/orders.OrdersV1/List", code: codes.OK},
+		{name: "missing token", method: "/orders.OrdersV1/Get", code: codes.Unauthenticated},
+		{name: "not bearer", method: "/orders.OrdersV1/Get", header: "Basic dXNlcg==", code: codes.Unauthenticated},
+		{name: "expired token", method: "/orders.OrdersV1/Get", header: "Bearer " + expired, code: codes.Unauthenticated},
+		{name: "other audience", method: "/orders.OrdersV1/Get", header: "Bearer " + otherAudience, code: codes.PermissionDenied},
+	}
+
+	interceptor := authclient.NewInterceptor(authclient.NewLocalVerifier(secret), "orders", "/orders.OrdersV1/List")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if tt.header != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tt.header))
+			}
+
+			var sub string
+			_, err := interceptor.Unary(ctx, &access_v1.CheckRequest{}, &grpc.UnaryServerInfo{FullMethod: tt.method},
+				func(ctx context.Context, _ any) (any, error) {
+					if claims, ok := authclient.ClaimsFromContext(ctx); ok {
+						sub = claims.Subject
+					}
+					return nil, nil
+				})
+			require.Equal(t, tt.code, status.Code(err))
+			require.Equal(t, tt.sub, sub)
+		})
+	}
+}
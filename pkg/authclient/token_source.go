@@ -0,0 +1,89 @@
+package authclient
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// expiryLeeway is how long before its expiry a token is replaced, so it does not expire in flight.
+const expiryLeeway = 30 * time.Second
+
+// TokenSource keeps an access token issued from a refresh token, replacing it before it
+// expires. The refresh token is rotated the same way. A TokenSource is also gRPC per-RPC
+// credentials, so it can be passed to grpc.WithPerRPCCredentials.
+type TokenSource struct {
+	client *Client
+	// requireTransportSecurity is false only for plaintext connections, e.g. in tests.
+	requireTransportSecurity bool
+
+	mu                    sync.Mutex
+	refreshToken          string
+	refreshTokenExpiresAt time.Time
+	accessToken           string
+	accessTokenExpiresAt  time.Time
+}
+
+// NewTokenSource returns a token source refreshing access tokens with the refresh token.
+func (c *Client) NewTokenSource(refreshToken string) *TokenSource {
+	return &TokenSource{
+		client:                   c,
+		requireTransportSecurity: true,
+		refreshToken:             refreshToken,
+		refreshTokenExpiresAt:    tokenExpiry(refreshToken),
+	}
+}
+
+// AllowInsecure lets the token source send tokens over plaintext connections.
+func (s *TokenSource) AllowInsecure() *TokenSource {
+	s.requireTransportSecurity = false
+	return s
+}
+
+// Token returns a valid access token, issuing a new one when the current one is about to expire.
+func (s *TokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if s.accessToken != "" && !expiresSoon(s.accessTokenExpiresAt, now) {
+		return s.accessToken, nil
+	}
+
+	if expiresSoon(s.refreshTokenExpiresAt, now) {
+		refreshToken, err := s.client.RefreshToken(ctx, s.refreshToken)
+		if err != nil {
+			return "", err
+		}
+		s.refreshToken = refreshToken
+		s.refreshTokenExpiresAt = tokenExpiry(refreshToken)
+	}
+
+	accessToken, err := s.client.AccessToken(ctx, s.refreshToken)
+	if err != nil {
+		return "", err
+	}
+	s.accessToken = accessToken
+	s.accessTokenExpiresAt = tokenExpiry(accessToken)
+	return accessToken, nil
+}
+
+// GetRequestMetadata implements credentials.PerRPCCredentials.
+func (s *TokenSource) GetRequestMetadata(ctx context.Context, _ ...string) (map[string]string, error) {
+	accessToken, err := s.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{authorizationHeader: bearerPrefix + accessToken}, nil
+}
+
+// RequireTransportSecurity implements credentials.PerRPCCredentials.
+func (s *TokenSource) RequireTransportSecurity() bool {
+	return s.requireTransportSecurity
+}
+
+// expiresSoon reports whether a token expiring at expiresAt must be replaced, tokens
+// without an expiry never are.
+func expiresSoon(expiresAt, now time.Time) bool {
+	return !expiresAt.IsZero() && !now.Add(expiryLeeway).Before(expiresAt)
+}
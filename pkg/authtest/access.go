@@ -0,0 +1,136 @@
+package authtest
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/arifullov/auth/pkg/access_v1"
+	"github.com/arifullov/auth/pkg/authclient"
+)
+
+// CheckFunc decides a Check of a caller with a valid token, a nil error allows the call.
+type CheckFunc func(claims *authclient.Claims, req *access_v1.CheckRequest) error
+
+// rule is a scripted decision for the endpoints matching the pattern.
+type rule struct {
+	endpoint string
+	roles    []string
+	allow    bool
+}
+
+// matches reports whether the endpoint matches the pattern, patterns ending with * match by prefix.
+func (r rule) matches(endpoint string) bool {
+	if prefix, ok := strings.CutSuffix(r.endpoint, "*"); ok {
+		return strings.HasPrefix(endpoint, prefix)
+	}
+	return r.endpoint == endpoint
+}
+
+// Allow allows callers with any of the roles, or any caller without roles, to call the
+// endpoints matching the pattern, e.g. /orders_v1.OrdersV1/Get or /orders_v1.OrdersV1/*.
+// The most recently scripted rule matching an endpoint decides, calls no rule matches
+// are denied.
+func (s *Server) Allow(endpoint string, roles ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rules = append(s.rules, rule{endpoint: endpoint, roles: roles, allow: true})
+}
+
+// AllowAll allows any caller with a valid token to call any endpoint.
+func (s *Server) AllowAll() {
+	s.Allow("*")
+}
+
+// Deny denies every caller to call the endpoints matching the pattern.
+func (s *Server) Deny(endpoint string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rules = append(s.rules, rule{endpoint: endpoint})
+}
+
+// SetCheckFunc decides every Check with fn instead of the scripted rules, nil restores them.
+func (s *Server) SetCheckFunc(fn CheckFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.checkFunc = fn
+}
+
+func (s *Server) decide(claims *authclient.Claims, req *access_v1.CheckRequest) error {
+	s.mu.Lock()
+	checkFunc := s.checkFunc
+	rules := slices.Clone(s.rules)
+	s.mu.Unlock()
+
+	if checkFunc != nil {
+		return checkFunc(claims, req)
+	}
+	for i := len(rules) - 1; i >= 0; i-- {
+		r := rules[i]
+		if !r.matches(req.GetEndpointAddress()) {
+			continue
+		}
+		if !r.allow {
+			return status.Error(codes.PermissionDenied, "access denied")
+		}
+		if len(r.roles) > 0 && !slices.ContainsFunc(r.roles, claims.HasRole) {
+			return status.Error(codes.PermissionDenied, "access denied")
+		}
+		return nil
+	}
+	return status.Error(codes.PermissionDenied, "no rule matches the route")
+}
+
+type accessServer struct {
+	access_v1.UnimplementedAccessV1Server
+	server *Server
+}
+
+func (a *accessServer) Check(ctx context.Context, req *access_v1.CheckRequest) (*emptypb.Empty, error) {
+	claims, err := a.server.callerClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = a.server.decide(claims, req); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (a *accessServer) BatchCheck(ctx context.Context, req *access_v1.BatchCheckRequest) (*access_v1.BatchCheckResponse, error) {
+	claims, err := a.server.callerClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*access_v1.CheckResult, 0, len(req.GetChecks()))
+	for _, check := range req.GetChecks() {
+		results = append(results, &access_v1.CheckResult{Allowed: a.server.decide(claims, check) == nil})
+	}
+	return &access_v1.BatchCheckResponse{Results: results}, nil
+}
+
+// callerClaims verifies the bearer token of the call.
+func (s *Server) callerClaims(ctx context.Context) (*authclient.Claims, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	authHeader := md.Get("authorization")
+	if len(authHeader) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "authorization header is not provided")
+	}
+	accessToken, ok := strings.CutPrefix(authHeader[0], "Bearer ")
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid authorization header format")
+	}
+	claims, err := verify(accessToken, s.accessSecret)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid access token")
+	}
+	return claims, nil
+}
@@ -0,0 +1,59 @@
+package authtest
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/arifullov/auth/pkg/auth_v1"
+	"github.com/arifullov/auth/pkg/authclient"
+)
+
+// authServer issues tokens to the users added with AddUser, the other methods are unimplemented.
+type authServer struct {
+	auth_v1.UnimplementedAuthV1Server
+	server *Server
+}
+
+func (a *authServer) Login(_ context.Context, req *auth_v1.LoginRequest) (*auth_v1.LoginResponse, error) {
+	s := a.server
+	s.mu.Lock()
+	u, ok := s.users[req.GetUsername()]
+	s.mu.Unlock()
+	if !ok || u.password != req.GetPassword() {
+		return nil, status.Error(codes.Unauthenticated, "invalid username or password")
+	}
+
+	claims := u.claims
+	claims.Tenant = req.GetTenant()
+	return &auth_v1.LoginResponse{RefreshToken: sign(withDefaults(claims), s.refreshSecret)}, nil
+}
+
+func (a *authServer) GetRefreshToken(_ context.Context, req *auth_v1.GetRefreshTokenRequest) (*auth_v1.GetRefreshTokenResponse, error) {
+	claims, err := a.refreshClaims(req.GetOldRefreshToken())
+	if err != nil {
+		return nil, err
+	}
+	return &auth_v1.GetRefreshTokenResponse{RefreshToken: sign(withDefaults(claims), a.server.refreshSecret)}, nil
+}
+
+func (a *authServer) GetAccessToken(_ context.Context, req *auth_v1.GetAccessTokenRequest) (*auth_v1.GetAccessTokenResponse, error) {
+	claims, err := a.refreshClaims(req.GetRefreshToken())
+	if err != nil {
+		return nil, err
+	}
+	return &auth_v1.GetAccessTokenResponse{AccessToken: a.server.MintToken(claims)}, nil
+}
+
+// refreshClaims verifies the refresh token and returns its claims without the timestamps,
+// so tokens issued from them get new ones.
+func (a *authServer) refreshClaims(refreshToken string) (authclient.Claims, error) {
+	claims, err := verify(refreshToken, a.server.refreshSecret)
+	if err != nil {
+		return authclient.Claims{}, status.Error(codes.Unauthenticated, "invalid refresh token")
+	}
+	claims.IssuedAt = nil
+	claims.ExpiresAt = nil
+	return *claims, nil
+}
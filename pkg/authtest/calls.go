@@ -0,0 +1,109 @@
+package authtest
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/arifullov/auth/pkg/access_v1"
+	"github.com/arifullov/auth/pkg/authclient"
+)
+
+// Call is a call made to the fake.
+type Call struct {
+	// Method is the full gRPC method name, e.g. access_v1.AccessV1_Check_FullMethodName.
+	Method  string
+	Request proto.Message
+	// Claims of the bearer token of the call, nil without a valid one.
+	Claims *authclient.Claims
+	Err    error
+}
+
+func (s *Server) record(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	res, err := handler(ctx, req)
+
+	claims, _ := s.callerClaims(ctx)
+	msg, _ := req.(proto.Message)
+	s.mu.Lock()
+	s.calls = append(s.calls, Call{
+		Method:  info.FullMethod,
+		Request: proto.Clone(msg),
+		Claims:  claims,
+		Err:     err,
+	})
+	s.mu.Unlock()
+	return res, err
+}
+
+// Calls returns the calls made to the fake in the order they were made.
+func (s *Server) Calls() []Call {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.calls)
+}
+
+// CallsTo returns the calls of the method, a full gRPC method name.
+func (s *Server) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range s.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// Checks returns the requests of the Check calls made to the fake.
+func (s *Server) Checks() []*access_v1.CheckRequest {
+	var checks []*access_v1.CheckRequest
+	for _, c := range s.CallsTo(access_v1.AccessV1_Check_FullMethodName) {
+		checks = append(checks, c.Request.(*access_v1.CheckRequest))
+	}
+	return checks
+}
+
+// Reset forgets the recorded calls, scripted decisions and users are kept.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls = nil
+}
+
+// AssertCalls fails the test unless the method was called n times.
+func (s *Server) AssertCalls(t testing.TB, method string, n int) {
+	t.Helper()
+
+	if calls := s.CallsTo(method); len(calls) != n {
+		t.Errorf("authtest: %s called %d times, want %d", method, len(calls), n)
+	}
+}
+
+// AssertChecked fails the test unless Check was called for the endpoint and decided with
+// the code, codes.OK for an allowed call.
+func (s *Server) AssertChecked(t testing.TB, endpoint string, code codes.Code) {
+	t.Helper()
+
+	var decided []string
+	for _, c := range s.CallsTo(access_v1.AccessV1_Check_FullMethodName) {
+		if c.Request.(*access_v1.CheckRequest).GetEndpointAddress() != endpoint {
+			continue
+		}
+		got := status.Code(c.Err)
+		if got == code {
+			return
+		}
+		decided = append(decided, got.String())
+	}
+	if len(decided) == 0 {
+		t.Errorf("authtest: %s is not checked", endpoint)
+		return
+	}
+	t.Errorf("authtest: %s is checked with %v, want %s", endpoint, decided, code)
+}
@@ -0,0 +1,149 @@
+// Package authtest runs an in-process fake of the auth service for tests of services that
+// depend on it. The fake issues tokens with any claims, answers Check as scripted by the
+// test and records the calls made to it.
+package authtest
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/arifullov/auth/pkg/access_v1"
+	"github.com/arifullov/auth/pkg/auth_v1"
+	"github.com/arifullov/auth/pkg/authclient"
+)
+
+const bufSize = 1 << 20
+
+// Server is the fake auth service, it serves AuthV1 and AccessV1.
+type Server struct {
+	accessSecret  []byte
+	refreshSecret []byte
+
+	lis  net.Listener
+	buf  *bufconn.Listener
+	grpc *grpc.Server
+	conn *grpc.ClientConn
+
+	mu        sync.Mutex
+	users     map[string]user
+	rules     []rule
+	checkFunc CheckFunc
+	calls     []Call
+}
+
+type options struct {
+	secret   []byte
+	loopback bool
+}
+
+// Option configures a Server.
+type Option func(*options)
+
+// WithSecret sets the HMAC secret access tokens are signed with, e.g. to verify them
+// with authclient.LocalVerifier configured as in production.
+func WithSecret(secret []byte) Option {
+	return func(o *options) {
+		o.secret = secret
+	}
+}
+
+// WithLoopback serves on a random port of 127.0.0.1 instead of an in-memory listener,
+// for code that dials an address itself.
+func WithLoopback() Option {
+	return func(o *options) {
+		o.loopback = true
+	}
+}
+
+// New starts the fake, it is stopped when the test ends.
+func New(t testing.TB, opts ...Option) *Server {
+	t.Helper()
+
+	o := options{secret: []byte("authtest-access-secret")}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	s := &Server{
+		accessSecret:  o.secret,
+		refreshSecret: []byte("authtest-refresh-secret"),
+		users:         make(map[string]user),
+	}
+
+	if o.loopback {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatalf("authtest: listen: %s", err)
+		}
+		s.lis = lis
+	} else {
+		s.buf = bufconn.Listen(bufSize)
+		s.lis = s.buf
+	}
+
+	s.grpc = grpc.NewServer(grpc.UnaryInterceptor(s.record))
+	auth_v1.RegisterAuthV1Server(s.grpc, &authServer{server: s})
+	access_v1.RegisterAccessV1Server(s.grpc, &accessServer{server: s})
+	go func() { _ = s.grpc.Serve(s.lis) }()
+
+	conn, err := grpc.NewClient(s.target(), s.dialOptions()...)
+	if err != nil {
+		s.grpc.Stop()
+		t.Fatalf("authtest: dial: %s", err)
+	}
+	s.conn = conn
+
+	t.Cleanup(func() {
+		_ = s.conn.Close()
+		s.grpc.Stop()
+	})
+	return s
+}
+
+// Addr returns the address the fake listens on, only reachable over the network WithLoopback.
+func (s *Server) Addr() string {
+	return s.lis.Addr().String()
+}
+
+// Conn returns a connection to the fake.
+func (s *Server) Conn() *grpc.ClientConn {
+	return s.conn
+}
+
+// Dial returns a new connection to the fake, opts are added to the options needed to reach it.
+func (s *Server) Dial(opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	return grpc.NewClient(s.target(), append(s.dialOptions(), opts...)...)
+}
+
+// Client returns an SDK client of the fake.
+func (s *Server) Client(opts ...authclient.Option) *authclient.Client {
+	return authclient.New(s.conn, opts...)
+}
+
+// Secret returns the secret access tokens are signed with.
+func (s *Server) Secret() []byte {
+	return s.accessSecret
+}
+
+func (s *Server) target() string {
+	if s.buf != nil {
+		return "passthrough:///authtest"
+	}
+	return s.Addr()
+}
+
+func (s *Server) dialOptions() []grpc.DialOption {
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if s.buf != nil {
+		opts = append(opts, grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return s.buf.DialContext(ctx)
+		}))
+	}
+	return opts
+}
@@ -0,0 +1,145 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/arifullov/auth/pkg/access_v1"
+	"github.com/arifullov/auth/pkg/auth_v1"
+	"github.com/arifullov/auth/pkg/authclient"
+	"github.com/arifullov/auth/pkg/authtest"
+)
+
+func TestScriptedCheck(t *testing.T) {
+	tests := []struct {
+		name     string
+		script   func(s *authtest.Server)
+		roles    []string
+		endpoint string
+		code     codes.Code
+	}{
+		{
+			name:     "denied without rules",
+			script:   func(*authtest.Server) {},
+			endpoint: "/orders_v1.OrdersV1/Get",
+			code:     codes.PermissionDenied,
+		},
+		{
+			name:     "allowed for any caller",
+			script:   func(s *authtest.Server) { s.AllowAll() },
+			endpoint: "/orders_v1.OrdersV1/Get",
+			code:     codes.OK,
+		},
+		{
+			name:     "allowed for role",
+			script:   func(s *authtest.Server) { s.Allow("/orders_v1.OrdersV1/*", "admin", "user") },
+			roles:    []string{"user"},
+			endpoint: "/orders_v1.OrdersV1/Get",
+			code:     codes.OK,
+		},
+		{
+			name:     "role not granted",
+			script:   func(s *authtest.Server) { s.Allow("/orders_v1.OrdersV1/*", "admin") },
+			roles:    []string{"user"},
+			endpoint: "/orders_v1.OrdersV1/Get",
+			code:     codes.PermissionDenied,
+		},
+		{
+			name: "latest rule decides",
+			script: func(s *authtest.Server) {
+				s.AllowAll()
+				s.Deny("/orders_v1.OrdersV1/Delete")
+			},
+			endpoint: "/orders_v1.OrdersV1/Delete",
+			code:     codes.PermissionDenied,
+		},
+		{
+			name: "check func",
+			script: func(s *authtest.Server) {
+				s.SetCheckFunc(func(claims *authclient.Claims, req *access_v1.CheckRequest) error {
+					if req.GetFields()["user_id"] != claims.Subject {
+						return status.Error(codes.PermissionDenied, "not the owner")
+					}
+					return nil
+				})
+			},
+			endpoint: "/orders_v1.OrdersV1/Get",
+			code:     codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := authtest.New(t)
+			tt.script(srv)
+
+			err := srv.Client().Check(context.Background(), srv.Token("42", tt.roles...), &access_v1.CheckRequest{
+				EndpointAddress: tt.endpoint,
+				Fields:          map[string]string{"user_id": "42"},
+			})
+			require.Equal(t, tt.code, status.Code(err))
+			srv.AssertCalls(t, access_v1.AccessV1_Check_FullMethodName, 1)
+			srv.AssertChecked(t, tt.endpoint, tt.code)
+		})
+	}
+}
+
+func TestLoginAndInterceptor(t *testing.T) {
+	ctx := context.Background()
+	srv := authtest.New(t, authtest.WithLoopback())
+	srv.AddUser("alice@example.com", "password", authclient.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: "7"},
+		Roles:            []string{"user"},
+	})
+	srv.Allow("/orders_v1.OrdersV1/Get", "user")
+
+	conn, err := srv.Dial()
+	require.NoError(t, err)
+	defer conn.Close()
+	client := authclient.New(conn)
+
+	_, err = client.Login(ctx, "alice@example.com", "wrong", "")
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	refreshToken, err := client.Login(ctx, "alice@example.com", "password", "acme")
+	require.NoError(t, err)
+	accessToken, err := client.NewTokenSource(refreshToken).Token(ctx)
+	require.NoError(t, err)
+
+	interceptor := authclient.NewInterceptor(client, "")
+	incoming := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+accessToken))
+
+	var claims *authclient.Claims
+	_, err = interceptor.Unary(incoming, &access_v1.CheckRequest{}, &grpc.UnaryServerInfo{FullMethod: "/orders_v1.OrdersV1/Get"},
+		func(ctx context.Context, _ any) (any, error) {
+			claims, _ = authclient.ClaimsFromContext(ctx)
+			return nil, nil
+		})
+	require.NoError(t, err)
+	require.Equal(t, "7", claims.Subject)
+	require.Equal(t, "alice@example.com", claims.Username)
+	require.Equal(t, "acme", claims.Tenant)
+
+	_, err = interceptor.Unary(incoming, &access_v1.CheckRequest{}, &grpc.UnaryServerInfo{FullMethod: "/orders_v1.OrdersV1/Delete"},
+		func(context.Context, any) (any, error) { return nil, nil })
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	srv.AssertCalls(t, auth_v1.AuthV1_Login_FullMethodName, 2)
+	srv.AssertCalls(t, auth_v1.AuthV1_GetAccessToken_FullMethodName, 1)
+	srv.AssertChecked(t, "/orders_v1.OrdersV1/Delete", codes.PermissionDenied)
+	checks := srv.Checks()
+	require.Len(t, checks, 2)
+	require.Equal(t, "/orders_v1.OrdersV1/Get", checks[0].GetEndpointAddress())
+
+	srv.Reset()
+	require.Empty(t, srv.Calls())
+}
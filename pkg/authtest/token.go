@@ -0,0 +1,76 @@
+package authtest
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/arifullov/auth/pkg/authclient"
+)
+
+// TokenTTL is how long minted tokens are valid when the claims do not set an expiry.
+const TokenTTL = time.Hour
+
+// MintToken returns an access token with the claims, signed with the secret of the fake.
+// IssuedAt and ExpiresAt default to now and TokenTTL from now.
+func (s *Server) MintToken(claims authclient.Claims) string {
+	return sign(withDefaults(claims), s.accessSecret)
+}
+
+// Token returns an access token of the subject with the roles.
+func (s *Server) Token(subject string, roles ...string) string {
+	return s.MintToken(authclient.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: subject},
+		Username:         subject,
+		Roles:            roles,
+	})
+}
+
+type user struct {
+	password string
+	claims   authclient.Claims
+}
+
+// AddUser lets the user log in with the password, access tokens issued from the refresh
+// token carry the claims with the tenant requested at login.
+func (s *Server) AddUser(username, password string, claims authclient.Claims) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if claims.Username == "" {
+		claims.Username = username
+	}
+	s.users[username] = user{password: password, claims: claims}
+}
+
+func withDefaults(claims authclient.Claims) authclient.Claims {
+	now := time.Now()
+	if claims.IssuedAt == nil {
+		claims.IssuedAt = jwt.NewNumericDate(now)
+	}
+	if claims.ExpiresAt == nil {
+		claims.ExpiresAt = jwt.NewNumericDate(now.Add(TokenTTL))
+	}
+	return claims
+}
+
+func sign(claims authclient.Claims, secret []byte) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(secret)
+	if err != nil {
+		// Signing with HMAC fails only for secrets of the wrong type.
+		panic(fmt.Sprintf("authtest: sign token: %s", err))
+	}
+	return token
+}
+
+func verify(token string, secret []byte) (*authclient.Claims, error) {
+	claims := &authclient.Claims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (any, error) {
+		return secret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
@@ -0,0 +1,415 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.1
+// source: group.proto
+
+package group_v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	GroupV1_CreateGroup_FullMethodName        = "/group_v1.GroupV1/CreateGroup"
+	GroupV1_GetGroup_FullMethodName           = "/group_v1.GroupV1/GetGroup"
+	GroupV1_DeleteGroup_FullMethodName        = "/group_v1.GroupV1/DeleteGroup"
+	GroupV1_SetGroupRoles_FullMethodName      = "/group_v1.GroupV1/SetGroupRoles"
+	GroupV1_AddGroupMember_FullMethodName     = "/group_v1.GroupV1/AddGroupMember"
+	GroupV1_RemoveGroupMember_FullMethodName  = "/group_v1.GroupV1/RemoveGroupMember"
+	GroupV1_NestGroup_FullMethodName          = "/group_v1.GroupV1/NestGroup"
+	GroupV1_UnnestGroup_FullMethodName        = "/group_v1.GroupV1/UnnestGroup"
+	GroupV1_ListEffectiveRoles_FullMethodName = "/group_v1.GroupV1/ListEffectiveRoles"
+)
+
+// GroupV1Client is the client API for GroupV1 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GroupV1Client interface {
+	CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error)
+	GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*GetGroupResponse, error)
+	// DeleteGroup deletes the group and revokes the tokens of its members.
+	DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error)
+	// SetGroupRoles replaces the roles bound to the group and revokes the tokens of its members.
+	SetGroupRoles(ctx context.Context, in *SetGroupRolesRequest, opts ...grpc.CallOption) (*SetGroupRolesResponse, error)
+	AddGroupMember(ctx context.Context, in *AddGroupMemberRequest, opts ...grpc.CallOption) (*AddGroupMemberResponse, error)
+	RemoveGroupMember(ctx context.Context, in *RemoveGroupMemberRequest, opts ...grpc.CallOption) (*RemoveGroupMemberResponse, error)
+	// NestGroup nests the group in the parent group, fails with FAILED_PRECONDITION when
+	// the parent group is already nested in the group.
+	NestGroup(ctx context.Context, in *NestGroupRequest, opts ...grpc.CallOption) (*NestGroupResponse, error)
+	UnnestGroup(ctx context.Context, in *UnnestGroupRequest, opts ...grpc.CallOption) (*UnnestGroupResponse, error)
+	// ListEffectiveRoles returns the roles of the user with the path granting each of them.
+	ListEffectiveRoles(ctx context.Context, in *ListEffectiveRolesRequest, opts ...grpc.CallOption) (*ListEffectiveRolesResponse, error)
+}
+
+type groupV1Client struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGroupV1Client(cc grpc.ClientConnInterface) GroupV1Client {
+	return &groupV1Client{cc}
+}
+
+func (c *groupV1Client) CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error) {
+	out := new(CreateGroupResponse)
+	err := c.cc.Invoke(ctx, GroupV1_CreateGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupV1Client) GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*GetGroupResponse, error) {
+	out := new(GetGroupResponse)
+	err := c.cc.Invoke(ctx, GroupV1_GetGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupV1Client) DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error) {
+	out := new(DeleteGroupResponse)
+	err := c.cc.Invoke(ctx, GroupV1_DeleteGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupV1Client) SetGroupRoles(ctx context.Context, in *SetGroupRolesRequest, opts ...grpc.CallOption) (*SetGroupRolesResponse, error) {
+	out := new(SetGroupRolesResponse)
+	err := c.cc.Invoke(ctx, GroupV1_SetGroupRoles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupV1Client) AddGroupMember(ctx context.Context, in *AddGroupMemberRequest, opts ...grpc.CallOption) (*AddGroupMemberResponse, error) {
+	out := new(AddGroupMemberResponse)
+	err := c.cc.Invoke(ctx, GroupV1_AddGroupMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupV1Client) RemoveGroupMember(ctx context.Context, in *RemoveGroupMemberRequest, opts ...grpc.CallOption) (*RemoveGroupMemberResponse, error) {
+	out := new(RemoveGroupMemberResponse)
+	err := c.cc.Invoke(ctx, GroupV1_RemoveGroupMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupV1Client) NestGroup(ctx context.Context, in *NestGroupRequest, opts ...grpc.CallOption) (*NestGroupResponse, error) {
+	out := new(NestGroupResponse)
+	err := c.cc.Invoke(ctx, GroupV1_NestGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupV1Client) UnnestGroup(ctx context.Context, in *UnnestGroupRequest, opts ...grpc.CallOption) (*UnnestGroupResponse, error) {
+	out := new(UnnestGroupResponse)
+	err := c.cc.Invoke(ctx, GroupV1_UnnestGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupV1Client) ListEffectiveRoles(ctx context.Context, in *ListEffectiveRolesRequest, opts ...grpc.CallOption) (*ListEffectiveRolesResponse, error) {
+	out := new(ListEffectiveRolesResponse)
+	err := c.cc.Invoke(ctx, GroupV1_ListEffectiveRoles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GroupV1Server is the server API for GroupV1 service.
+// All implementations must embed UnimplementedGroupV1Server
+// for forward compatibility
+type GroupV1Server interface {
+	CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error)
+	GetGroup(context.Context, *GetGroupRequest) (*GetGroupResponse, error)
+	// DeleteGroup deletes the group and revokes the tokens of its members.
+	DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error)
+	// SetGroupRoles replaces the roles bound to the group and revokes the tokens of its members.
+	SetGroupRoles(context.Context, *SetGroupRolesRequest) (*SetGroupRolesResponse, error)
+	AddGroupMember(context.Context, *AddGroupMemberRequest) (*AddGroupMemberResponse, error)
+	RemoveGroupMember(context.Context, *RemoveGroupMemberRequest) (*RemoveGroupMemberResponse, error)
+	// NestGroup nests the group in the parent group, fails with FAILED_PRECONDITION when
+	// the parent group is already nested in the group.
+	NestGroup(context.Context, *NestGroupRequest) (*NestGroupResponse, error)
+	UnnestGroup(context.Context, *UnnestGroupRequest) (*UnnestGroupResponse, error)
+	// ListEffectiveRoles returns the roles of the user with the path granting each of them.
+	ListEffectiveRoles(context.Context, *ListEffectiveRolesRequest) (*ListEffectiveRolesResponse, error)
+	mustEmbedUnimplementedGroupV1Server()
+}
+
+// UnimplementedGroupV1Server must be embedded to have forward compatible implementations.
+type UnimplementedGroupV1Server struct {
+}
+
+func (UnimplementedGroupV1Server) CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+}
+func (UnimplementedGroupV1Server) GetGroup(context.Context, *GetGroupRequest) (*GetGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroup not implemented")
+}
+func (UnimplementedGroupV1Server) DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
+}
+func (UnimplementedGroupV1Server) SetGroupRoles(context.Context, *SetGroupRolesRequest) (*SetGroupRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupRoles not implemented")
+}
+func (UnimplementedGroupV1Server) AddGroupMember(context.Context, *AddGroupMemberRequest) (*AddGroupMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGroupMember not implemented")
+}
+func (UnimplementedGroupV1Server) RemoveGroupMember(context.Context, *RemoveGroupMemberRequest) (*RemoveGroupMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGroupMember not implemented")
+}
+func (UnimplementedGroupV1Server) NestGroup(context.Context, *NestGroupRequest) (*NestGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NestGroup not implemented")
+}
+func (UnimplementedGroupV1Server) UnnestGroup(context.Context, *UnnestGroupRequest) (*UnnestGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnnestGroup not implemented")
+}
+func (UnimplementedGroupV1Server) ListEffectiveRoles(context.Context, *ListEffectiveRolesRequest) (*ListEffectiveRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEffectiveRoles not implemented")
+}
+func (UnimplementedGroupV1Server) mustEmbedUnimplementedGroupV1Server() {}
+
+// UnsafeGroupV1Server may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GroupV1Server will
+// result in compilation errors.
+type UnsafeGroupV1Server interface {
+	mustEmbedUnimplementedGroupV1Server()
+}
+
+func RegisterGroupV1Server(s grpc.ServiceRegistrar, srv GroupV1Server) {
+	s.RegisterService(&GroupV1_ServiceDesc, srv)
+}
+
+func _GroupV1_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupV1Server).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupV1_CreateGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupV1Server).CreateGroup(ctx, req.(*CreateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupV1_GetGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupV1Server).GetGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupV1_GetGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupV1Server).GetGroup(ctx, req.(*GetGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupV1_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupV1Server).DeleteGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupV1_DeleteGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupV1Server).DeleteGroup(ctx, req.(*DeleteGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupV1_SetGroupRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupV1Server).SetGroupRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupV1_SetGroupRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupV1Server).SetGroupRoles(ctx, req.(*SetGroupRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupV1_AddGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGroupMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupV1Server).AddGroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupV1_AddGroupMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupV1Server).AddGroupMember(ctx, req.(*AddGroupMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupV1_RemoveGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveGroupMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupV1Server).RemoveGroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupV1_RemoveGroupMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupV1Server).RemoveGroupMember(ctx, req.(*RemoveGroupMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupV1_NestGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NestGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupV1Server).NestGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupV1_NestGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupV1Server).NestGroup(ctx, req.(*NestGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupV1_UnnestGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnnestGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupV1Server).UnnestGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupV1_UnnestGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupV1Server).UnnestGroup(ctx, req.(*UnnestGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupV1_ListEffectiveRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEffectiveRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupV1Server).ListEffectiveRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupV1_ListEffectiveRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupV1Server).ListEffectiveRoles(ctx, req.(*ListEffectiveRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GroupV1_ServiceDesc is the grpc.ServiceDesc for GroupV1 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GroupV1_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "group_v1.GroupV1",
+	HandlerType: (*GroupV1Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateGroup",
+			Handler:    _GroupV1_CreateGroup_Handler,
+		},
+		{
+			MethodName: "GetGroup",
+			Handler:    _GroupV1_GetGroup_Handler,
+		},
+		{
+			MethodName: "DeleteGroup",
+			Handler:    _GroupV1_DeleteGroup_Handler,
+		},
+		{
+			MethodName: "SetGroupRoles",
+			Handler:    _GroupV1_SetGroupRoles_Handler,
+		},
+		{
+			MethodName: "AddGroupMember",
+			Handler:    _GroupV1_AddGroupMember_Handler,
+		},
+		{
+			MethodName: "RemoveGroupMember",
+			Handler:    _GroupV1_RemoveGroupMember_Handler,
+		},
+		{
+			MethodName: "NestGroup",
+			Handler:    _GroupV1_NestGroup_Handler,
+		},
+		{
+			MethodName: "UnnestGroup",
+			Handler:    _GroupV1_UnnestGroup_Handler,
+		},
+		{
+			MethodName: "ListEffectiveRoles",
+			Handler:    _GroupV1_ListEffectiveRoles_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "group.proto",
+}
@@ -0,0 +1,2263 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: relation.proto
+
+package relation_v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on RelationObject with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RelationObject) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationObject with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RelationObjectMultiError,
+// or nil if none found.
+func (m *RelationObject) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationObject) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetNamespace()); l < 1 || l > 64 {
+		err := RelationObjectValidationError{
+			field:  "Namespace",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetId()); l < 1 || l > 256 {
+		err := RelationObjectValidationError{
+			field:  "Id",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RelationObjectMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationObjectMultiError is an error wrapping multiple validation errors
+// returned by RelationObject.ValidateAll() if the designated constraints
+// aren't met.
+type RelationObjectMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationObjectMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationObjectMultiError) AllErrors() []error { return m }
+
+// RelationObjectValidationError is the validation error returned by
+// RelationObject.Validate if the designated constraints aren't met.
+type RelationObjectValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationObjectValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationObjectValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationObjectValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationObjectValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationObjectValidationError) ErrorName() string { return "RelationObjectValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RelationObjectValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationObject.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationObjectValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationObjectValidationError{}
+
+// Validate checks the field values on SubjectSet with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SubjectSet) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubjectSet with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SubjectSetMultiError, or
+// nil if none found.
+func (m *SubjectSet) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubjectSet) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetObject() == nil {
+		err := SubjectSetValidationError{
+			field:  "Object",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetObject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubjectSetValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SubjectSetValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetObject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubjectSetValidationError{
+				field:  "Object",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetRelation()) > 64 {
+		err := SubjectSetValidationError{
+			field:  "Relation",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SubjectSetMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubjectSetMultiError is an error wrapping multiple validation errors
+// returned by SubjectSet.ValidateAll() if the designated constraints aren't met.
+type SubjectSetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubjectSetMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubjectSetMultiError) AllErrors() []error { return m }
+
+// SubjectSetValidationError is the validation error returned by
+// SubjectSet.Validate if the designated constraints aren't met.
+type SubjectSetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubjectSetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubjectSetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubjectSetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubjectSetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubjectSetValidationError) ErrorName() string { return "SubjectSetValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SubjectSetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubjectSet.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubjectSetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubjectSetValidationError{}
+
+// Validate checks the field values on Subject with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Subject) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Subject with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SubjectMultiError, or nil if none found.
+func (m *Subject) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Subject) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofSubjectPresent := false
+	switch v := m.Subject.(type) {
+	case *Subject_UserId:
+		if v == nil {
+			err := SubjectValidationError{
+				field:  "Subject",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofSubjectPresent = true
+
+		if l := utf8.RuneCountInString(m.GetUserId()); l < 1 || l > 64 {
+			err := SubjectValidationError{
+				field:  "UserId",
+				reason: "value length must be between 1 and 64 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *Subject_Set:
+		if v == nil {
+			err := SubjectValidationError{
+				field:  "Subject",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofSubjectPresent = true
+
+		if all {
+			switch v := interface{}(m.GetSet()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SubjectValidationError{
+						field:  "Set",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SubjectValidationError{
+						field:  "Set",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSet()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SubjectValidationError{
+					field:  "Set",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofSubjectPresent {
+		err := SubjectValidationError{
+			field:  "Subject",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SubjectMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubjectMultiError is an error wrapping multiple validation errors returned
+// by Subject.ValidateAll() if the designated constraints aren't met.
+type SubjectMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubjectMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubjectMultiError) AllErrors() []error { return m }
+
+// SubjectValidationError is the validation error returned by Subject.Validate
+// if the designated constraints aren't met.
+type SubjectValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubjectValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubjectValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubjectValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubjectValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubjectValidationError) ErrorName() string { return "SubjectValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SubjectValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubject.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubjectValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubjectValidationError{}
+
+// Validate checks the field values on RelationTuple with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RelationTuple) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationTuple with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RelationTupleMultiError, or
+// nil if none found.
+func (m *RelationTuple) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationTuple) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetObject() == nil {
+		err := RelationTupleValidationError{
+			field:  "Object",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetObject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationTupleValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationTupleValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetObject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationTupleValidationError{
+				field:  "Object",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetRelation()); l < 1 || l > 64 {
+		err := RelationTupleValidationError{
+			field:  "Relation",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSubject() == nil {
+		err := RelationTupleValidationError{
+			field:  "Subject",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSubject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationTupleValidationError{
+					field:  "Subject",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationTupleValidationError{
+					field:  "Subject",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationTupleValidationError{
+				field:  "Subject",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RelationTupleMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationTupleMultiError is an error wrapping multiple validation errors
+// returned by RelationTuple.ValidateAll() if the designated constraints
+// aren't met.
+type RelationTupleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationTupleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationTupleMultiError) AllErrors() []error { return m }
+
+// RelationTupleValidationError is the validation error returned by
+// RelationTuple.Validate if the designated constraints aren't met.
+type RelationTupleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationTupleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationTupleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationTupleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationTupleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationTupleValidationError) ErrorName() string { return "RelationTupleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RelationTupleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationTuple.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationTupleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationTupleValidationError{}
+
+// Validate checks the field values on Consistency with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Consistency) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Consistency with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ConsistencyMultiError, or
+// nil if none found.
+func (m *Consistency) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Consistency) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Requirement.(type) {
+	case *Consistency_AtLeastAsFresh:
+		if v == nil {
+			err := ConsistencyValidationError{
+				field:  "Requirement",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for AtLeastAsFresh
+	case *Consistency_AtExactSnapshot:
+		if v == nil {
+			err := ConsistencyValidationError{
+				field:  "Requirement",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for AtExactSnapshot
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return ConsistencyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConsistencyMultiError is an error wrapping multiple validation errors
+// returned by Consistency.ValidateAll() if the designated constraints aren't met.
+type ConsistencyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConsistencyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConsistencyMultiError) AllErrors() []error { return m }
+
+// ConsistencyValidationError is the validation error returned by
+// Consistency.Validate if the designated constraints aren't met.
+type ConsistencyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConsistencyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConsistencyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConsistencyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConsistencyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConsistencyValidationError) ErrorName() string { return "ConsistencyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConsistencyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConsistency.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConsistencyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConsistencyValidationError{}
+
+// Validate checks the field values on CheckRelationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckRelationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckRelationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckRelationRequestMultiError, or nil if none found.
+func (m *CheckRelationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckRelationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetObject() == nil {
+		err := CheckRelationRequestValidationError{
+			field:  "Object",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetObject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CheckRelationRequestValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CheckRelationRequestValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetObject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CheckRelationRequestValidationError{
+				field:  "Object",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetRelation()); l < 1 || l > 64 {
+		err := CheckRelationRequestValidationError{
+			field:  "Relation",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetUserId()); l < 1 || l > 64 {
+		err := CheckRelationRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetConsistency()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CheckRelationRequestValidationError{
+					field:  "Consistency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CheckRelationRequestValidationError{
+					field:  "Consistency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConsistency()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CheckRelationRequestValidationError{
+				field:  "Consistency",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CheckRelationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckRelationRequestMultiError is an error wrapping multiple validation
+// errors returned by CheckRelationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CheckRelationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckRelationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckRelationRequestMultiError) AllErrors() []error { return m }
+
+// CheckRelationRequestValidationError is the validation error returned by
+// CheckRelationRequest.Validate if the designated constraints aren't met.
+type CheckRelationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckRelationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckRelationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckRelationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckRelationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckRelationRequestValidationError) ErrorName() string {
+	return "CheckRelationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckRelationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckRelationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckRelationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckRelationRequestValidationError{}
+
+// Validate checks the field values on CheckRelationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckRelationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckRelationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckRelationResponseMultiError, or nil if none found.
+func (m *CheckRelationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckRelationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Allowed
+
+	// no validation rules for CheckedAt
+
+	if len(errors) > 0 {
+		return CheckRelationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckRelationResponseMultiError is an error wrapping multiple validation
+// errors returned by CheckRelationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CheckRelationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckRelationResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckRelationResponseMultiError) AllErrors() []error { return m }
+
+// CheckRelationResponseValidationError is the validation error returned by
+// CheckRelationResponse.Validate if the designated constraints aren't met.
+type CheckRelationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckRelationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckRelationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckRelationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckRelationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckRelationResponseValidationError) ErrorName() string {
+	return "CheckRelationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckRelationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckRelationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckRelationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckRelationResponseValidationError{}
+
+// Validate checks the field values on WriteTuplesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WriteTuplesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WriteTuplesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WriteTuplesRequestMultiError, or nil if none found.
+func (m *WriteTuplesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WriteTuplesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetWrites()) > 100 {
+		err := WriteTuplesRequestValidationError{
+			field:  "Writes",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetWrites() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WriteTuplesRequestValidationError{
+						field:  fmt.Sprintf("Writes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WriteTuplesRequestValidationError{
+						field:  fmt.Sprintf("Writes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WriteTuplesRequestValidationError{
+					field:  fmt.Sprintf("Writes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(m.GetDeletes()) > 100 {
+		err := WriteTuplesRequestValidationError{
+			field:  "Deletes",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetDeletes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WriteTuplesRequestValidationError{
+						field:  fmt.Sprintf("Deletes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WriteTuplesRequestValidationError{
+						field:  fmt.Sprintf("Deletes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WriteTuplesRequestValidationError{
+					field:  fmt.Sprintf("Deletes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WriteTuplesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WriteTuplesRequestMultiError is an error wrapping multiple validation errors
+// returned by WriteTuplesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WriteTuplesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WriteTuplesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WriteTuplesRequestMultiError) AllErrors() []error { return m }
+
+// WriteTuplesRequestValidationError is the validation error returned by
+// WriteTuplesRequest.Validate if the designated constraints aren't met.
+type WriteTuplesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WriteTuplesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WriteTuplesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WriteTuplesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WriteTuplesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WriteTuplesRequestValidationError) ErrorName() string {
+	return "WriteTuplesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WriteTuplesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWriteTuplesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WriteTuplesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WriteTuplesRequestValidationError{}
+
+// Validate checks the field values on WriteTuplesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WriteTuplesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WriteTuplesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WriteTuplesResponseMultiError, or nil if none found.
+func (m *WriteTuplesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WriteTuplesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WrittenAt
+
+	if len(errors) > 0 {
+		return WriteTuplesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WriteTuplesResponseMultiError is an error wrapping multiple validation
+// errors returned by WriteTuplesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WriteTuplesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WriteTuplesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WriteTuplesResponseMultiError) AllErrors() []error { return m }
+
+// WriteTuplesResponseValidationError is the validation error returned by
+// WriteTuplesResponse.Validate if the designated constraints aren't met.
+type WriteTuplesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WriteTuplesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WriteTuplesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WriteTuplesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WriteTuplesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WriteTuplesResponseValidationError) ErrorName() string {
+	return "WriteTuplesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WriteTuplesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWriteTuplesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WriteTuplesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WriteTuplesResponseValidationError{}
+
+// Validate checks the field values on ReadTuplesRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReadTuplesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadTuplesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadTuplesRequestMultiError, or nil if none found.
+func (m *ReadTuplesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadTuplesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetNamespace()) > 64 {
+		err := ReadTuplesRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetObjectId()) > 256 {
+		err := ReadTuplesRequestValidationError{
+			field:  "ObjectId",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRelation()) > 64 {
+		err := ReadTuplesRequestValidationError{
+			field:  "Relation",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSubject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReadTuplesRequestValidationError{
+					field:  "Subject",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReadTuplesRequestValidationError{
+					field:  "Subject",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReadTuplesRequestValidationError{
+				field:  "Subject",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 1000 {
+		err := ReadTuplesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if all {
+		switch v := interface{}(m.GetConsistency()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReadTuplesRequestValidationError{
+					field:  "Consistency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReadTuplesRequestValidationError{
+					field:  "Consistency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConsistency()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReadTuplesRequestValidationError{
+				field:  "Consistency",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReadTuplesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadTuplesRequestMultiError is an error wrapping multiple validation errors
+// returned by ReadTuplesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ReadTuplesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadTuplesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadTuplesRequestMultiError) AllErrors() []error { return m }
+
+// ReadTuplesRequestValidationError is the validation error returned by
+// ReadTuplesRequest.Validate if the designated constraints aren't met.
+type ReadTuplesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadTuplesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadTuplesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadTuplesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadTuplesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadTuplesRequestValidationError) ErrorName() string {
+	return "ReadTuplesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadTuplesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadTuplesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadTuplesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadTuplesRequestValidationError{}
+
+// Validate checks the field values on ReadTuplesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReadTuplesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadTuplesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadTuplesResponseMultiError, or nil if none found.
+func (m *ReadTuplesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadTuplesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTuples() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReadTuplesResponseValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReadTuplesResponseValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReadTuplesResponseValidationError{
+					field:  fmt.Sprintf("Tuples[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for ReadAt
+
+	if len(errors) > 0 {
+		return ReadTuplesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadTuplesResponseMultiError is an error wrapping multiple validation errors
+// returned by ReadTuplesResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ReadTuplesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadTuplesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadTuplesResponseMultiError) AllErrors() []error { return m }
+
+// ReadTuplesResponseValidationError is the validation error returned by
+// ReadTuplesResponse.Validate if the designated constraints aren't met.
+type ReadTuplesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadTuplesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadTuplesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadTuplesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadTuplesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadTuplesResponseValidationError) ErrorName() string {
+	return "ReadTuplesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadTuplesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadTuplesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadTuplesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadTuplesResponseValidationError{}
+
+// Validate checks the field values on ExpandRelationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExpandRelationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExpandRelationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExpandRelationRequestMultiError, or nil if none found.
+func (m *ExpandRelationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExpandRelationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetObject() == nil {
+		err := ExpandRelationRequestValidationError{
+			field:  "Object",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetObject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExpandRelationRequestValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExpandRelationRequestValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetObject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExpandRelationRequestValidationError{
+				field:  "Object",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetRelation()); l < 1 || l > 64 {
+		err := ExpandRelationRequestValidationError{
+			field:  "Relation",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetConsistency()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExpandRelationRequestValidationError{
+					field:  "Consistency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExpandRelationRequestValidationError{
+					field:  "Consistency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConsistency()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExpandRelationRequestValidationError{
+				field:  "Consistency",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExpandRelationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExpandRelationRequestMultiError is an error wrapping multiple validation
+// errors returned by ExpandRelationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExpandRelationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExpandRelationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExpandRelationRequestMultiError) AllErrors() []error { return m }
+
+// ExpandRelationRequestValidationError is the validation error returned by
+// ExpandRelationRequest.Validate if the designated constraints aren't met.
+type ExpandRelationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExpandRelationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExpandRelationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExpandRelationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExpandRelationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExpandRelationRequestValidationError) ErrorName() string {
+	return "ExpandRelationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExpandRelationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExpandRelationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExpandRelationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExpandRelationRequestValidationError{}
+
+// Validate checks the field values on ExpandNode with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExpandNode) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExpandNode with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExpandNodeMultiError, or
+// nil if none found.
+func (m *ExpandNode) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExpandNode) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Operation
+
+	if all {
+		switch v := interface{}(m.GetObject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExpandNodeValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExpandNodeValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetObject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExpandNodeValidationError{
+				field:  "Object",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Relation
+
+	for idx, item := range m.GetSubjects() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExpandNodeValidationError{
+						field:  fmt.Sprintf("Subjects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExpandNodeValidationError{
+						field:  fmt.Sprintf("Subjects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExpandNodeValidationError{
+					field:  fmt.Sprintf("Subjects[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetChildren() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExpandNodeValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExpandNodeValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExpandNodeValidationError{
+					field:  fmt.Sprintf("Children[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ExpandNodeMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExpandNodeMultiError is an error wrapping multiple validation errors
+// returned by ExpandNode.ValidateAll() if the designated constraints aren't met.
+type ExpandNodeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExpandNodeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExpandNodeMultiError) AllErrors() []error { return m }
+
+// ExpandNodeValidationError is the validation error returned by
+// ExpandNode.Validate if the designated constraints aren't met.
+type ExpandNodeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExpandNodeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExpandNodeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExpandNodeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExpandNodeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExpandNodeValidationError) ErrorName() string { return "ExpandNodeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExpandNodeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExpandNode.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExpandNodeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExpandNodeValidationError{}
+
+// Validate checks the field values on ExpandRelationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExpandRelationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExpandRelationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExpandRelationResponseMultiError, or nil if none found.
+func (m *ExpandRelationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExpandRelationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTree()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExpandRelationResponseValidationError{
+					field:  "Tree",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExpandRelationResponseValidationError{
+					field:  "Tree",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTree()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExpandRelationResponseValidationError{
+				field:  "Tree",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ExpandedAt
+
+	if len(errors) > 0 {
+		return ExpandRelationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExpandRelationResponseMultiError is an error wrapping multiple validation
+// errors returned by ExpandRelationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExpandRelationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExpandRelationResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExpandRelationResponseMultiError) AllErrors() []error { return m }
+
+// ExpandRelationResponseValidationError is the validation error returned by
+// ExpandRelationResponse.Validate if the designated constraints aren't met.
+type ExpandRelationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExpandRelationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExpandRelationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExpandRelationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExpandRelationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExpandRelationResponseValidationError) ErrorName() string {
+	return "ExpandRelationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExpandRelationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExpandRelationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExpandRelationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExpandRelationResponseValidationError{}
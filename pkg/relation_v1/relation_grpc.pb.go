@@ -0,0 +1,226 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.1
+// source: relation.proto
+
+package relation_v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	RelationV1_CheckRelation_FullMethodName  = "/relation_v1.RelationV1/CheckRelation"
+	RelationV1_WriteTuples_FullMethodName    = "/relation_v1.RelationV1/WriteTuples"
+	RelationV1_ReadTuples_FullMethodName     = "/relation_v1.RelationV1/ReadTuples"
+	RelationV1_ExpandRelation_FullMethodName = "/relation_v1.RelationV1/ExpandRelation"
+)
+
+// RelationV1Client is the client API for RelationV1 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RelationV1Client interface {
+	// CheckRelation tells whether the user has the relation to the object.
+	CheckRelation(ctx context.Context, in *CheckRelationRequest, opts ...grpc.CallOption) (*CheckRelationResponse, error)
+	// WriteTuples deletes and then writes the tuples in one transaction.
+	WriteTuples(ctx context.Context, in *WriteTuplesRequest, opts ...grpc.CallOption) (*WriteTuplesResponse, error)
+	ReadTuples(ctx context.Context, in *ReadTuplesRequest, opts ...grpc.CallOption) (*ReadTuplesResponse, error)
+	// ExpandRelation returns the userset tree of the relation of the object.
+	ExpandRelation(ctx context.Context, in *ExpandRelationRequest, opts ...grpc.CallOption) (*ExpandRelationResponse, error)
+}
+
+type relationV1Client struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRelationV1Client(cc grpc.ClientConnInterface) RelationV1Client {
+	return &relationV1Client{cc}
+}
+
+func (c *relationV1Client) CheckRelation(ctx context.Context, in *CheckRelationRequest, opts ...grpc.CallOption) (*CheckRelationResponse, error) {
+	out := new(CheckRelationResponse)
+	err := c.cc.Invoke(ctx, RelationV1_CheckRelation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationV1Client) WriteTuples(ctx context.Context, in *WriteTuplesRequest, opts ...grpc.CallOption) (*WriteTuplesResponse, error) {
+	out := new(WriteTuplesResponse)
+	err := c.cc.Invoke(ctx, RelationV1_WriteTuples_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationV1Client) ReadTuples(ctx context.Context, in *ReadTuplesRequest, opts ...grpc.CallOption) (*ReadTuplesResponse, error) {
+	out := new(ReadTuplesResponse)
+	err := c.cc.Invoke(ctx, RelationV1_ReadTuples_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationV1Client) ExpandRelation(ctx context.Context, in *ExpandRelationRequest, opts ...grpc.CallOption) (*ExpandRelationResponse, error) {
+	out := new(ExpandRelationResponse)
+	err := c.cc.Invoke(ctx, RelationV1_ExpandRelation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RelationV1Server is the server API for RelationV1 service.
+// All implementations must embed UnimplementedRelationV1Server
+// for forward compatibility
+type RelationV1Server interface {
+	// CheckRelation tells whether the user has the relation to the object.
+	CheckRelation(context.Context, *CheckRelationRequest) (*CheckRelationResponse, error)
+	// WriteTuples deletes and then writes the tuples in one transaction.
+	WriteTuples(context.Context, *WriteTuplesRequest) (*WriteTuplesResponse, error)
+	ReadTuples(context.Context, *ReadTuplesRequest) (*ReadTuplesResponse, error)
+	// ExpandRelation returns the userset tree of the relation of the object.
+	ExpandRelation(context.Context, *ExpandRelationRequest) (*ExpandRelationResponse, error)
+	mustEmbedUnimplementedRelationV1Server()
+}
+
+// UnimplementedRelationV1Server must be embedded to have forward compatible implementations.
+type UnimplementedRelationV1Server struct {
+}
+
+func (UnimplementedRelationV1Server) CheckRelation(context.Context, *CheckRelationRequest) (*CheckRelationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckRelation not implemented")
+}
+func (UnimplementedRelationV1Server) WriteTuples(context.Context, *WriteTuplesRequest) (*WriteTuplesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteTuples not implemented")
+}
+func (UnimplementedRelationV1Server) ReadTuples(context.Context, *ReadTuplesRequest) (*ReadTuplesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadTuples not implemented")
+}
+func (UnimplementedRelationV1Server) ExpandRelation(context.Context, *ExpandRelationRequest) (*ExpandRelationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpandRelation not implemented")
+}
+func (UnimplementedRelationV1Server) mustEmbedUnimplementedRelationV1Server() {}
+
+// UnsafeRelationV1Server may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RelationV1Server will
+// result in compilation errors.
+type UnsafeRelationV1Server interface {
+	mustEmbedUnimplementedRelationV1Server()
+}
+
+func RegisterRelationV1Server(s grpc.ServiceRegistrar, srv RelationV1Server) {
+	s.RegisterService(&RelationV1_ServiceDesc, srv)
+}
+
+func _RelationV1_CheckRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckRelationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationV1Server).CheckRelation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationV1_CheckRelation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationV1Server).CheckRelation(ctx, req.(*CheckRelationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationV1_WriteTuples_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteTuplesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationV1Server).WriteTuples(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationV1_WriteTuples_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationV1Server).WriteTuples(ctx, req.(*WriteTuplesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationV1_ReadTuples_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadTuplesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationV1Server).ReadTuples(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationV1_ReadTuples_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationV1Server).ReadTuples(ctx, req.(*ReadTuplesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationV1_ExpandRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpandRelationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationV1Server).ExpandRelation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationV1_ExpandRelation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationV1Server).ExpandRelation(ctx, req.(*ExpandRelationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RelationV1_ServiceDesc is the grpc.ServiceDesc for RelationV1 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RelationV1_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "relation_v1.RelationV1",
+	HandlerType: (*RelationV1Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CheckRelation",
+			Handler:    _RelationV1_CheckRelation_Handler,
+		},
+		{
+			MethodName: "WriteTuples",
+			Handler:    _RelationV1_WriteTuples_Handler,
+		},
+		{
+			MethodName: "ReadTuples",
+			Handler:    _RelationV1_ReadTuples_Handler,
+		},
+		{
+			MethodName: "ExpandRelation",
+			Handler:    _RelationV1_ExpandRelation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "relation.proto",
+}
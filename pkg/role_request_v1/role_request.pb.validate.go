@@ -0,0 +1,1488 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: role_request.proto
+
+package role_request_v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on RoleRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RoleRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RoleRequestMultiError, or
+// nil if none found.
+func (m *RoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for Role
+
+	// no validation rules for Justification
+
+	// no validation rules for DurationSeconds
+
+	// no validation rules for Status
+
+	// no validation rules for ApproverId
+
+	// no validation rules for DecisionComment
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoleRequestValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoleRequestValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoleRequestValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDecidedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoleRequestValidationError{
+					field:  "DecidedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoleRequestValidationError{
+					field:  "DecidedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDecidedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoleRequestValidationError{
+				field:  "DecidedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoleRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoleRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoleRequestValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RoleRequestMultiError is an error wrapping multiple validation errors
+// returned by RoleRequest.ValidateAll() if the designated constraints aren't met.
+type RoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoleRequestMultiError) AllErrors() []error { return m }
+
+// RoleRequestValidationError is the validation error returned by
+// RoleRequest.Validate if the designated constraints aren't met.
+type RoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoleRequestValidationError) ErrorName() string { return "RoleRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoleRequestValidationError{}
+
+// Validate checks the field values on RequestRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestRoleRequestMultiError, or nil if none found.
+func (m *RequestRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := RequestRoleRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetJustification()); l < 1 || l > 1024 {
+		err := RequestRoleRequestValidationError{
+			field:  "Justification",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetDurationSeconds() <= 0 {
+		err := RequestRoleRequestValidationError{
+			field:  "DurationSeconds",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RequestRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestRoleRequestMultiError is an error wrapping multiple validation errors
+// returned by RequestRoleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RequestRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestRoleRequestMultiError) AllErrors() []error { return m }
+
+// RequestRoleRequestValidationError is the validation error returned by
+// RequestRoleRequest.Validate if the designated constraints aren't met.
+type RequestRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestRoleRequestValidationError) ErrorName() string {
+	return "RequestRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestRoleRequestValidationError{}
+
+// Validate checks the field values on RequestRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestRoleResponseMultiError, or nil if none found.
+func (m *RequestRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return RequestRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestRoleResponseMultiError is an error wrapping multiple validation
+// errors returned by RequestRoleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RequestRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestRoleResponseMultiError) AllErrors() []error { return m }
+
+// RequestRoleResponseValidationError is the validation error returned by
+// RequestRoleResponse.Validate if the designated constraints aren't met.
+type RequestRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestRoleResponseValidationError) ErrorName() string {
+	return "RequestRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestRoleResponseValidationError{}
+
+// Validate checks the field values on ApproveRoleRequestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveRoleRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveRoleRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveRoleRequestRequestMultiError, or nil if none found.
+func (m *ApproveRoleRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveRoleRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := ApproveRoleRequestRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetComment()) > 1024 {
+		err := ApproveRoleRequestRequestValidationError{
+			field:  "Comment",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ApproveRoleRequestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveRoleRequestRequestMultiError is an error wrapping multiple validation
+// errors returned by ApproveRoleRequestRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ApproveRoleRequestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveRoleRequestRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveRoleRequestRequestMultiError) AllErrors() []error { return m }
+
+// ApproveRoleRequestRequestValidationError is the validation error returned by
+// ApproveRoleRequestRequest.Validate if the designated constraints aren't met.
+type ApproveRoleRequestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveRoleRequestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveRoleRequestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveRoleRequestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveRoleRequestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveRoleRequestRequestValidationError) ErrorName() string {
+	return "ApproveRoleRequestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveRoleRequestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveRoleRequestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveRoleRequestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveRoleRequestRequestValidationError{}
+
+// Validate checks the field values on ApproveRoleRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveRoleRequestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveRoleRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveRoleRequestResponseMultiError, or nil if none found.
+func (m *ApproveRoleRequestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveRoleRequestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApproveRoleRequestResponseValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApproveRoleRequestResponseValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApproveRoleRequestResponseValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ApproveRoleRequestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveRoleRequestResponseMultiError is an error wrapping multiple
+// validation errors returned by ApproveRoleRequestResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ApproveRoleRequestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveRoleRequestResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveRoleRequestResponseMultiError) AllErrors() []error { return m }
+
+// ApproveRoleRequestResponseValidationError is the validation error returned
+// by ApproveRoleRequestResponse.Validate if the designated constraints aren't met.
+type ApproveRoleRequestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveRoleRequestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveRoleRequestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveRoleRequestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveRoleRequestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveRoleRequestResponseValidationError) ErrorName() string {
+	return "ApproveRoleRequestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveRoleRequestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveRoleRequestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveRoleRequestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveRoleRequestResponseValidationError{}
+
+// Validate checks the field values on DenyRoleRequestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DenyRoleRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DenyRoleRequestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DenyRoleRequestRequestMultiError, or nil if none found.
+func (m *DenyRoleRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DenyRoleRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := DenyRoleRequestRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetComment()) > 1024 {
+		err := DenyRoleRequestRequestValidationError{
+			field:  "Comment",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DenyRoleRequestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DenyRoleRequestRequestMultiError is an error wrapping multiple validation
+// errors returned by DenyRoleRequestRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DenyRoleRequestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DenyRoleRequestRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DenyRoleRequestRequestMultiError) AllErrors() []error { return m }
+
+// DenyRoleRequestRequestValidationError is the validation error returned by
+// DenyRoleRequestRequest.Validate if the designated constraints aren't met.
+type DenyRoleRequestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DenyRoleRequestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DenyRoleRequestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DenyRoleRequestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DenyRoleRequestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DenyRoleRequestRequestValidationError) ErrorName() string {
+	return "DenyRoleRequestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DenyRoleRequestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDenyRoleRequestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DenyRoleRequestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DenyRoleRequestRequestValidationError{}
+
+// Validate checks the field values on DenyRoleRequestResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DenyRoleRequestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DenyRoleRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DenyRoleRequestResponseMultiError, or nil if none found.
+func (m *DenyRoleRequestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DenyRoleRequestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DenyRoleRequestResponseValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DenyRoleRequestResponseValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DenyRoleRequestResponseValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DenyRoleRequestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DenyRoleRequestResponseMultiError is an error wrapping multiple validation
+// errors returned by DenyRoleRequestResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DenyRoleRequestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DenyRoleRequestResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DenyRoleRequestResponseMultiError) AllErrors() []error { return m }
+
+// DenyRoleRequestResponseValidationError is the validation error returned by
+// DenyRoleRequestResponse.Validate if the designated constraints aren't met.
+type DenyRoleRequestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DenyRoleRequestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DenyRoleRequestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DenyRoleRequestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DenyRoleRequestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DenyRoleRequestResponseValidationError) ErrorName() string {
+	return "DenyRoleRequestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DenyRoleRequestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDenyRoleRequestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DenyRoleRequestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DenyRoleRequestResponseValidationError{}
+
+// Validate checks the field values on GetRoleRequestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRoleRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRoleRequestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRoleRequestRequestMultiError, or nil if none found.
+func (m *GetRoleRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRoleRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetRoleRequestRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetRoleRequestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRoleRequestRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRoleRequestRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetRoleRequestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRoleRequestRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRoleRequestRequestMultiError) AllErrors() []error { return m }
+
+// GetRoleRequestRequestValidationError is the validation error returned by
+// GetRoleRequestRequest.Validate if the designated constraints aren't met.
+type GetRoleRequestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRoleRequestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRoleRequestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRoleRequestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRoleRequestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRoleRequestRequestValidationError) ErrorName() string {
+	return "GetRoleRequestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRoleRequestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRoleRequestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRoleRequestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRoleRequestRequestValidationError{}
+
+// Validate checks the field values on GetRoleRequestResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRoleRequestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRoleRequestResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRoleRequestResponseMultiError, or nil if none found.
+func (m *GetRoleRequestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRoleRequestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRoleRequestResponseValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRoleRequestResponseValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRoleRequestResponseValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetRoleRequestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRoleRequestResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRoleRequestResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetRoleRequestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRoleRequestResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRoleRequestResponseMultiError) AllErrors() []error { return m }
+
+// GetRoleRequestResponseValidationError is the validation error returned by
+// GetRoleRequestResponse.Validate if the designated constraints aren't met.
+type GetRoleRequestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRoleRequestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRoleRequestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRoleRequestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRoleRequestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRoleRequestResponseValidationError) ErrorName() string {
+	return "GetRoleRequestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRoleRequestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRoleRequestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRoleRequestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRoleRequestResponseValidationError{}
+
+// Validate checks the field values on ListRoleRequestsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRoleRequestsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRoleRequestsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRoleRequestsRequestMultiError, or nil if none found.
+func (m *ListRoleRequestsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRoleRequestsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Status
+
+	if m.GetPageSize() > 500 {
+		err := ListRoleRequestsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 500",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListRoleRequestsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRoleRequestsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListRoleRequestsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListRoleRequestsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRoleRequestsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRoleRequestsRequestMultiError) AllErrors() []error { return m }
+
+// ListRoleRequestsRequestValidationError is the validation error returned by
+// ListRoleRequestsRequest.Validate if the designated constraints aren't met.
+type ListRoleRequestsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRoleRequestsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRoleRequestsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRoleRequestsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRoleRequestsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRoleRequestsRequestValidationError) ErrorName() string {
+	return "ListRoleRequestsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRoleRequestsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRoleRequestsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRoleRequestsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRoleRequestsRequestValidationError{}
+
+// Validate checks the field values on ListRoleRequestsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRoleRequestsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRoleRequestsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRoleRequestsResponseMultiError, or nil if none found.
+func (m *ListRoleRequestsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRoleRequestsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRequests() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRoleRequestsResponseValidationError{
+						field:  fmt.Sprintf("Requests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRoleRequestsResponseValidationError{
+						field:  fmt.Sprintf("Requests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRoleRequestsResponseValidationError{
+					field:  fmt.Sprintf("Requests[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListRoleRequestsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRoleRequestsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListRoleRequestsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListRoleRequestsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRoleRequestsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRoleRequestsResponseMultiError) AllErrors() []error { return m }
+
+// ListRoleRequestsResponseValidationError is the validation error returned by
+// ListRoleRequestsResponse.Validate if the designated constraints aren't met.
+type ListRoleRequestsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRoleRequestsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRoleRequestsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRoleRequestsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRoleRequestsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRoleRequestsResponseValidationError) ErrorName() string {
+	return "ListRoleRequestsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRoleRequestsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRoleRequestsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRoleRequestsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRoleRequestsResponseValidationError{}
@@ -0,0 +1,265 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.1
+// source: role_request.proto
+
+package role_request_v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	RoleRequestV1_RequestRole_FullMethodName        = "/role_request_v1.RoleRequestV1/RequestRole"
+	RoleRequestV1_ApproveRoleRequest_FullMethodName = "/role_request_v1.RoleRequestV1/ApproveRoleRequest"
+	RoleRequestV1_DenyRoleRequest_FullMethodName    = "/role_request_v1.RoleRequestV1/DenyRoleRequest"
+	RoleRequestV1_GetRoleRequest_FullMethodName     = "/role_request_v1.RoleRequestV1/GetRoleRequest"
+	RoleRequestV1_ListRoleRequests_FullMethodName   = "/role_request_v1.RoleRequestV1/ListRoleRequests"
+)
+
+// RoleRequestV1Client is the client API for RoleRequestV1 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RoleRequestV1Client interface {
+	// RequestRole asks for the role on behalf of the caller.
+	RequestRole(ctx context.Context, in *RequestRoleRequest, opts ...grpc.CallOption) (*RequestRoleResponse, error)
+	// ApproveRoleRequest grants the role until the requested duration elapses, fails with
+	// FAILED_PRECONDITION when the request is already decided.
+	ApproveRoleRequest(ctx context.Context, in *ApproveRoleRequestRequest, opts ...grpc.CallOption) (*ApproveRoleRequestResponse, error)
+	DenyRoleRequest(ctx context.Context, in *DenyRoleRequestRequest, opts ...grpc.CallOption) (*DenyRoleRequestResponse, error)
+	GetRoleRequest(ctx context.Context, in *GetRoleRequestRequest, opts ...grpc.CallOption) (*GetRoleRequestResponse, error)
+	// ListRoleRequests returns the requests of the caller, approvers may list the requests of any user.
+	ListRoleRequests(ctx context.Context, in *ListRoleRequestsRequest, opts ...grpc.CallOption) (*ListRoleRequestsResponse, error)
+}
+
+type roleRequestV1Client struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRoleRequestV1Client(cc grpc.ClientConnInterface) RoleRequestV1Client {
+	return &roleRequestV1Client{cc}
+}
+
+func (c *roleRequestV1Client) RequestRole(ctx context.Context, in *RequestRoleRequest, opts ...grpc.CallOption) (*RequestRoleResponse, error) {
+	out := new(RequestRoleResponse)
+	err := c.cc.Invoke(ctx, RoleRequestV1_RequestRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleRequestV1Client) ApproveRoleRequest(ctx context.Context, in *ApproveRoleRequestRequest, opts ...grpc.CallOption) (*ApproveRoleRequestResponse, error) {
+	out := new(ApproveRoleRequestResponse)
+	err := c.cc.Invoke(ctx, RoleRequestV1_ApproveRoleRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleRequestV1Client) DenyRoleRequest(ctx context.Context, in *DenyRoleRequestRequest, opts ...grpc.CallOption) (*DenyRoleRequestResponse, error) {
+	out := new(DenyRoleRequestResponse)
+	err := c.cc.Invoke(ctx, RoleRequestV1_DenyRoleRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleRequestV1Client) GetRoleRequest(ctx context.Context, in *GetRoleRequestRequest, opts ...grpc.CallOption) (*GetRoleRequestResponse, error) {
+	out := new(GetRoleRequestResponse)
+	err := c.cc.Invoke(ctx, RoleRequestV1_GetRoleRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleRequestV1Client) ListRoleRequests(ctx context.Context, in *ListRoleRequestsRequest, opts ...grpc.CallOption) (*ListRoleRequestsResponse, error) {
+	out := new(ListRoleRequestsResponse)
+	err := c.cc.Invoke(ctx, RoleRequestV1_ListRoleRequests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RoleRequestV1Server is the server API for RoleRequestV1 service.
+// All implementations must embed UnimplementedRoleRequestV1Server
+// for forward compatibility
+type RoleRequestV1Server interface {
+	// RequestRole asks for the role on behalf of the caller.
+	RequestRole(context.Context, *RequestRoleRequest) (*RequestRoleResponse, error)
+	// ApproveRoleRequest grants the role until the requested duration elapses, fails with
+	// FAILED_PRECONDITION when the request is already decided.
+	ApproveRoleRequest(context.Context, *ApproveRoleRequestRequest) (*ApproveRoleRequestResponse, error)
+	DenyRoleRequest(context.Context, *DenyRoleRequestRequest) (*DenyRoleRequestResponse, error)
+	GetRoleRequest(context.Context, *GetRoleRequestRequest) (*GetRoleRequestResponse, error)
+	// ListRoleRequests returns the requests of the caller, approvers may list the requests of any user.
+	ListRoleRequests(context.Context, *ListRoleRequestsRequest) (*ListRoleRequestsResponse, error)
+	mustEmbedUnimplementedRoleRequestV1Server()
+}
+
+// UnimplementedRoleRequestV1Server must be embedded to have forward compatible implementations.
+type UnimplementedRoleRequestV1Server struct {
+}
+
+func (UnimplementedRoleRequestV1Server) RequestRole(context.Context, *RequestRoleRequest) (*RequestRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestRole not implemented")
+}
+func (UnimplementedRoleRequestV1Server) ApproveRoleRequest(context.Context, *ApproveRoleRequestRequest) (*ApproveRoleRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveRoleRequest not implemented")
+}
+func (UnimplementedRoleRequestV1Server) DenyRoleRequest(context.Context, *DenyRoleRequestRequest) (*DenyRoleRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenyRoleRequest not implemented")
+}
+func (UnimplementedRoleRequestV1Server) GetRoleRequest(context.Context, *GetRoleRequestRequest) (*GetRoleRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoleRequest not implemented")
+}
+func (UnimplementedRoleRequestV1Server) ListRoleRequests(context.Context, *ListRoleRequestsRequest) (*ListRoleRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoleRequests not implemented")
+}
+func (UnimplementedRoleRequestV1Server) mustEmbedUnimplementedRoleRequestV1Server() {}
+
+// UnsafeRoleRequestV1Server may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RoleRequestV1Server will
+// result in compilation errors.
+type UnsafeRoleRequestV1Server interface {
+	mustEmbedUnimplementedRoleRequestV1Server()
+}
+
+func RegisterRoleRequestV1Server(s grpc.ServiceRegistrar, srv RoleRequestV1Server) {
+	s.RegisterService(&RoleRequestV1_ServiceDesc, srv)
+}
+
+func _RoleRequestV1_RequestRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleRequestV1Server).RequestRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleRequestV1_RequestRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleRequestV1Server).RequestRole(ctx, req.(*RequestRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleRequestV1_ApproveRoleRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveRoleRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleRequestV1Server).ApproveRoleRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleRequestV1_ApproveRoleRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleRequestV1Server).ApproveRoleRequest(ctx, req.(*ApproveRoleRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleRequestV1_DenyRoleRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenyRoleRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleRequestV1Server).DenyRoleRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleRequestV1_DenyRoleRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleRequestV1Server).DenyRoleRequest(ctx, req.(*DenyRoleRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleRequestV1_GetRoleRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoleRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleRequestV1Server).GetRoleRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleRequestV1_GetRoleRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleRequestV1Server).GetRoleRequest(ctx, req.(*GetRoleRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleRequestV1_ListRoleRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoleRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleRequestV1Server).ListRoleRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleRequestV1_ListRoleRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleRequestV1Server).ListRoleRequests(ctx, req.(*ListRoleRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RoleRequestV1_ServiceDesc is the grpc.ServiceDesc for RoleRequestV1 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RoleRequestV1_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "role_request_v1.RoleRequestV1",
+	HandlerType: (*RoleRequestV1Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RequestRole",
+			Handler:    _RoleRequestV1_RequestRole_Handler,
+		},
+		{
+			MethodName: "ApproveRoleRequest",
+			Handler:    _RoleRequestV1_ApproveRoleRequest_Handler,
+		},
+		{
+			MethodName: "DenyRoleRequest",
+			Handler:    _RoleRequestV1_DenyRoleRequest_Handler,
+		},
+		{
+			MethodName: "GetRoleRequest",
+			Handler:    _RoleRequestV1_GetRoleRequest_Handler,
+		},
+		{
+			MethodName: "ListRoleRequests",
+			Handler:    _RoleRequestV1_ListRoleRequests_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "role_request.proto",
+}
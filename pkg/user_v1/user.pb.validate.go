@@ -104,6 +104,48 @@ func (m *CreateRequest) validate(all bool) error {
 
 	// no validation rules for Role
 
+	if len(m.GetRoles()) > 20 {
+		err := CreateRequestValidationError{
+			field:  "Roles",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_CreateRequest_Roles_Unique := make(map[string]struct{}, len(m.GetRoles()))
+
+	for idx, item := range m.GetRoles() {
+		_, _ = idx, item
+
+		if _, exists := _CreateRequest_Roles_Unique[item]; exists {
+			err := CreateRequestValidationError{
+				field:  fmt.Sprintf("Roles[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_CreateRequest_Roles_Unique[item] = struct{}{}
+		}
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 50 {
+			err := CreateRequestValidationError{
+				field:  fmt.Sprintf("Roles[%v]", idx),
+				reason: "value length must be between 1 and 50 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return CreateRequestMultiError(errors)
 	}
@@ -232,6 +274,201 @@ var _ interface {
 	ErrorName() string
 } = CreateRequestValidationError{}
 
+// Validate checks the field values on SignUpRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SignUpRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SignUpRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SignUpRequestMultiError, or
+// nil if none found.
+func (m *SignUpRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SignUpRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 50 {
+		err := SignUpRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = SignUpRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetPassword()); l < 8 || l > 32 {
+		err := SignUpRequestValidationError{
+			field:  "Password",
+			reason: "value length must be between 8 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetPasswordConfirm()); l < 8 || l > 32 {
+		err := SignUpRequestValidationError{
+			field:  "PasswordConfirm",
+			reason: "value length must be between 8 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SignUpRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *SignUpRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *SignUpRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// SignUpRequestMultiError is an error wrapping multiple validation errors
+// returned by SignUpRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SignUpRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SignUpRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SignUpRequestMultiError) AllErrors() []error { return m }
+
+// SignUpRequestValidationError is the validation error returned by
+// SignUpRequest.Validate if the designated constraints aren't met.
+type SignUpRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SignUpRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SignUpRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SignUpRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SignUpRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SignUpRequestValidationError) ErrorName() string { return "SignUpRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SignUpRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSignUpRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SignUpRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SignUpRequestValidationError{}
+
 // Validate checks the field values on CreateResponse with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -893,3 +1130,158 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = DeleteRequestValidationError{}
+
+// Validate checks the field values on SetUserRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetUserRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetUserRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetUserRoleRequestMultiError, or nil if none found.
+func (m *SetUserRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetUserRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() < 1 {
+		err := SetUserRoleRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetRoles()); l < 1 || l > 20 {
+		err := SetUserRoleRequestValidationError{
+			field:  "Roles",
+			reason: "value must contain between 1 and 20 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_SetUserRoleRequest_Roles_Unique := make(map[string]struct{}, len(m.GetRoles()))
+
+	for idx, item := range m.GetRoles() {
+		_, _ = idx, item
+
+		if _, exists := _SetUserRoleRequest_Roles_Unique[item]; exists {
+			err := SetUserRoleRequestValidationError{
+				field:  fmt.Sprintf("Roles[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_SetUserRoleRequest_Roles_Unique[item] = struct{}{}
+		}
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 50 {
+			err := SetUserRoleRequestValidationError{
+				field:  fmt.Sprintf("Roles[%v]", idx),
+				reason: "value length must be between 1 and 50 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SetUserRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetUserRoleRequestMultiError is an error wrapping multiple validation errors
+// returned by SetUserRoleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SetUserRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetUserRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetUserRoleRequestMultiError) AllErrors() []error { return m }
+
+// SetUserRoleRequestValidationError is the validation error returned by
+// SetUserRoleRequest.Validate if the designated constraints aren't met.
+type SetUserRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetUserRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetUserRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetUserRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetUserRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetUserRoleRequestValidationError) ErrorName() string {
+	return "SetUserRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetUserRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetUserRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetUserRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetUserRoleRequestValidationError{}